@@ -91,6 +91,14 @@ const (
 
 	// PachdLogReaderRole is a role which grants the ability to pull pachd logs
 	PachdLogReaderRole = "pachdLogReader"
+
+	// PipelineOperatorRole is a role which grants the ability to start, stop
+	// and rerun a pipeline and read its logs, without access to its data
+	PipelineOperatorRole = "pipelineOperator"
+
+	// AuditLogReaderRole is a role which grants the ability to read the audit
+	// log of API calls
+	AuditLogReaderRole = "auditLogReader"
 )
 
 var (
@@ -288,3 +296,40 @@ func GetAuthToken(ctx context.Context) (string, error) {
 	}
 	return md[ContextTokenKey][0], nil
 }
+
+// BranchResourceName returns the name of the BRANCH resource covering the
+// branches of 'repo' that match 'pattern'.
+func BranchResourceName(repo, pattern string) string {
+	return repo + "@" + pattern
+}
+
+// ParseBranchResourceName splits the name of a BRANCH resource into its repo
+// and branch pattern.
+func ParseBranchResourceName(name string) (repo, pattern string, err error) {
+	parts := strings.SplitN(name, "@", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid branch resource %q, expected <repo>@<branch>", name)
+	}
+	return parts[0], parts[1], nil
+}
+
+// PathResourceName returns the name of the PATH resource covering the files
+// of 'repo' under 'prefix'.
+func PathResourceName(repo, prefix string) string {
+	return repo + ":" + prefix
+}
+
+// ParsePathResourceName splits the name of a PATH resource into its repo and
+// path prefix. The prefix is returned in canonical form, with a leading slash
+// and without a trailing one.
+func ParsePathResourceName(name string) (repo, prefix string, err error) {
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid path resource %q, expected <repo>:<path>", name)
+	}
+	prefix = "/" + strings.Trim(parts[1], "/")
+	if prefix == "/" {
+		return "", "", errors.Errorf("invalid path resource %q, use a repo role binding to grant access to the whole repo", name)
+	}
+	return parts[0], prefix, nil
+}
@@ -8,8 +8,9 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
+	identity "github.com/pachyderm/pachyderm/v2/src/identity"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -53,6 +54,10 @@ const (
 	Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS         Permission = 140
 	Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS            Permission = 142
 	Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN             Permission = 147
+	Permission_CLUSTER_AUTH_MODIFY_ROLES                  Permission = 150
+	Permission_CLUSTER_AUTH_LIST_AUDIT_EVENTS             Permission = 151
+	Permission_CLUSTER_AUTH_EXPORT_CONFIG                 Permission = 152
+	Permission_CLUSTER_AUTH_IMPORT_CONFIG                 Permission = 153
 	Permission_CLUSTER_ENTERPRISE_ACTIVATE                Permission = 114
 	Permission_CLUSTER_ENTERPRISE_HEARTBEAT               Permission = 115
 	Permission_CLUSTER_ENTERPRISE_GET_CODE                Permission = 116
@@ -83,6 +88,9 @@ const (
 	Permission_SECRET_DELETE               Permission = 145
 	Permission_SECRET_INSPECT              Permission = 146
 	Permission_CLUSTER_DELETE_ALL          Permission = 138
+	Permission_CLUSTER_STORAGE_GC          Permission = 154
+	Permission_CLUSTER_STORAGE_INSPECT     Permission = 155
+	Permission_CLUSTER_STORAGE_SCRUB       Permission = 156
 	Permission_REPO_READ                   Permission = 200
 	Permission_REPO_WRITE                  Permission = 201
 	Permission_REPO_MODIFY_BINDINGS        Permission = 202
@@ -99,6 +107,11 @@ const (
 	Permission_REPO_REMOVE_PIPELINE_READER Permission = 213
 	Permission_REPO_ADD_PIPELINE_WRITER    Permission = 214
 	Permission_PIPELINE_LIST_JOB           Permission = 301
+	Permission_PIPELINE_UPDATE_SPEC        Permission = 302
+	Permission_PIPELINE_START_STOP         Permission = 303
+	Permission_PIPELINE_RUN_DATUM          Permission = 304
+	Permission_PIPELINE_READ_LOGS          Permission = 305
+	Permission_PIPELINE_READ_SECRETS       Permission = 306
 )
 
 var Permission_name = map[int32]string{
@@ -120,6 +133,10 @@ var Permission_name = map[int32]string{
 	140: "CLUSTER_AUTH_DELETE_EXPIRED_TOKENS",
 	142: "CLUSTER_AUTH_REVOKE_USER_TOKENS",
 	147: "CLUSTER_AUTH_ROTATE_ROOT_TOKEN",
+	150: "CLUSTER_AUTH_MODIFY_ROLES",
+	151: "CLUSTER_AUTH_LIST_AUDIT_EVENTS",
+	152: "CLUSTER_AUTH_EXPORT_CONFIG",
+	153: "CLUSTER_AUTH_IMPORT_CONFIG",
 	114: "CLUSTER_ENTERPRISE_ACTIVATE",
 	115: "CLUSTER_ENTERPRISE_HEARTBEAT",
 	116: "CLUSTER_ENTERPRISE_GET_CODE",
@@ -149,6 +166,9 @@ var Permission_name = map[int32]string{
 	145: "SECRET_DELETE",
 	146: "SECRET_INSPECT",
 	138: "CLUSTER_DELETE_ALL",
+	154: "CLUSTER_STORAGE_GC",
+	155: "CLUSTER_STORAGE_INSPECT",
+	156: "CLUSTER_STORAGE_SCRUB",
 	200: "REPO_READ",
 	201: "REPO_WRITE",
 	202: "REPO_MODIFY_BINDINGS",
@@ -165,6 +185,11 @@ var Permission_name = map[int32]string{
 	213: "REPO_REMOVE_PIPELINE_READER",
 	214: "REPO_ADD_PIPELINE_WRITER",
 	301: "PIPELINE_LIST_JOB",
+	302: "PIPELINE_UPDATE_SPEC",
+	303: "PIPELINE_START_STOP",
+	304: "PIPELINE_RUN_DATUM",
+	305: "PIPELINE_READ_LOGS",
+	306: "PIPELINE_READ_SECRETS",
 }
 
 var Permission_value = map[string]int32{
@@ -186,6 +211,10 @@ var Permission_value = map[string]int32{
 	"CLUSTER_AUTH_DELETE_EXPIRED_TOKENS":         140,
 	"CLUSTER_AUTH_REVOKE_USER_TOKENS":            142,
 	"CLUSTER_AUTH_ROTATE_ROOT_TOKEN":             147,
+	"CLUSTER_AUTH_MODIFY_ROLES":                  150,
+	"CLUSTER_AUTH_LIST_AUDIT_EVENTS":             151,
+	"CLUSTER_AUTH_EXPORT_CONFIG":                 152,
+	"CLUSTER_AUTH_IMPORT_CONFIG":                 153,
 	"CLUSTER_ENTERPRISE_ACTIVATE":                114,
 	"CLUSTER_ENTERPRISE_HEARTBEAT":               115,
 	"CLUSTER_ENTERPRISE_GET_CODE":                116,
@@ -215,6 +244,9 @@ var Permission_value = map[string]int32{
 	"SECRET_DELETE":                              145,
 	"SECRET_INSPECT":                             146,
 	"CLUSTER_DELETE_ALL":                         138,
+	"CLUSTER_STORAGE_GC":                         154,
+	"CLUSTER_STORAGE_INSPECT":                    155,
+	"CLUSTER_STORAGE_SCRUB":                      156,
 	"REPO_READ":                                  200,
 	"REPO_WRITE":                                 201,
 	"REPO_MODIFY_BINDINGS":                       202,
@@ -231,6 +263,11 @@ var Permission_value = map[string]int32{
 	"REPO_REMOVE_PIPELINE_READER":                213,
 	"REPO_ADD_PIPELINE_WRITER":                   214,
 	"PIPELINE_LIST_JOB":                          301,
+	"PIPELINE_UPDATE_SPEC":                       302,
+	"PIPELINE_START_STOP":                        303,
+	"PIPELINE_RUN_DATUM":                         304,
+	"PIPELINE_READ_LOGS":                         305,
+	"PIPELINE_READ_SECRETS":                      306,
 }
 
 func (x Permission) String() string {
@@ -249,6 +286,12 @@ const (
 	ResourceType_CLUSTER               ResourceType = 1
 	ResourceType_REPO                  ResourceType = 2
 	ResourceType_SPEC_REPO             ResourceType = 3
+	// BRANCH resources are named "<repo>@<branch pattern>"
+	ResourceType_BRANCH ResourceType = 4
+	// PATH resources are named "<repo>:<path prefix>"
+	ResourceType_PATH ResourceType = 5
+	// PIPELINE resources are named after the pipeline
+	ResourceType_PIPELINE ResourceType = 6
 )
 
 var ResourceType_name = map[int32]string{
@@ -256,6 +299,9 @@ var ResourceType_name = map[int32]string{
 	1: "CLUSTER",
 	2: "REPO",
 	3: "SPEC_REPO",
+	4: "BRANCH",
+	5: "PATH",
+	6: "PIPELINE",
 }
 
 var ResourceType_value = map[string]int32{
@@ -263,6 +309,9 @@ var ResourceType_value = map[string]int32{
 	"CLUSTER":               1,
 	"REPO":                  2,
 	"SPEC_REPO":             3,
+	"BRANCH":                4,
+	"PATH":                  5,
+	"PIPELINE":              6,
 }
 
 func (x ResourceType) String() string {
@@ -273,6 +322,44 @@ func (ResourceType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_712ec48c1eaf43a2, []int{1}
 }
 
+// ConfigChangeOp is the operation that importing a ClusterAuthConfig performs
+// on one item of the cluster's configuration
+type ConfigChangeOp int32
+
+const (
+	ConfigChangeOp_CONFIG_CHANGE_OP_UNKNOWN ConfigChangeOp = 0
+	ConfigChangeOp_CONFIG_CREATE            ConfigChangeOp = 1
+	ConfigChangeOp_CONFIG_UPDATE            ConfigChangeOp = 2
+	ConfigChangeOp_CONFIG_DELETE            ConfigChangeOp = 3
+	// CONFIG_SKIP is for items in the imported config that can't be applied,
+	// e.g. role bindings on repos that don't exist in the cluster
+	ConfigChangeOp_CONFIG_SKIP ConfigChangeOp = 4
+)
+
+var ConfigChangeOp_name = map[int32]string{
+	0: "CONFIG_CHANGE_OP_UNKNOWN",
+	1: "CONFIG_CREATE",
+	2: "CONFIG_UPDATE",
+	3: "CONFIG_DELETE",
+	4: "CONFIG_SKIP",
+}
+
+var ConfigChangeOp_value = map[string]int32{
+	"CONFIG_CHANGE_OP_UNKNOWN": 0,
+	"CONFIG_CREATE":            1,
+	"CONFIG_UPDATE":            2,
+	"CONFIG_DELETE":            3,
+	"CONFIG_SKIP":              4,
+}
+
+func (x ConfigChangeOp) String() string {
+	return proto.EnumName(ConfigChangeOp_name, int32(x))
+}
+
+func (ConfigChangeOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{2}
+}
+
 // ActivateRequest enables authentication on the cluster. It issues an auth token
 // with no expiration for the irrevocable admin user `pach:root`.
 type ActivateRequest struct {
@@ -834,12 +921,14 @@ var xxx_messageInfo_SetConfigurationResponse proto.InternalMessageInfo
 type TokenInfo struct {
 	// Subject (i.e. Pachyderm account) that a given token authorizes.
 	// See the note at the top of the doc for an explanation of subject structure.
-	Subject              string     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	Expiration           *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
-	HashedToken          string     `protobuf:"bytes,3,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty" db:"token_hash"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Subject     string     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Expiration  *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
+	HashedToken string     `protobuf:"bytes,3,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty" db:"token_hash"`
+	// If set, the token only grants the permissions in 'scope'
+	Scope                *TokenScope `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
@@ -896,6 +985,82 @@ func (m *TokenInfo) GetHashedToken() string {
 	return ""
 }
 
+func (m *TokenInfo) GetScope() *TokenScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+// TokenScope restricts what a token can do, in addition to the role bindings
+// of its subject. An operation is only permitted if it requires permissions
+// granted by the scope's permissions or roles, on one of the scope's
+// resources. An empty list of permissions and roles, or of resources, doesn't
+// restrict them.
+type TokenScope struct {
+	Permissions []Permission `protobuf:"varint,1,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	Roles       []string     `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	// A REPO resource also covers the branches and paths in the repo
+	Resources            []*Resource `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TokenScope) Reset()         { *m = TokenScope{} }
+func (m *TokenScope) String() string { return proto.CompactTextString(m) }
+func (*TokenScope) ProtoMessage()    {}
+func (*TokenScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{12}
+}
+func (m *TokenScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenScope.Merge(m, src)
+}
+func (m *TokenScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenScope proto.InternalMessageInfo
+
+func (m *TokenScope) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *TokenScope) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *TokenScope) GetResources() []*Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
 type AuthenticateRequest struct {
 	// This is the session state that Pachyderm creates in order to keep track of
 	// information related to the current OIDC session.
@@ -911,7 +1076,7 @@ func (m *AuthenticateRequest) Reset()         { *m = AuthenticateRequest{} }
 func (m *AuthenticateRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateRequest) ProtoMessage()    {}
 func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{12}
+	return fileDescriptor_712ec48c1eaf43a2, []int{13}
 }
 func (m *AuthenticateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1133,7 @@ func (m *AuthenticateResponse) Reset()         { *m = AuthenticateResponse{} }
 func (m *AuthenticateResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateResponse) ProtoMessage()    {}
 func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{13}
+	return fileDescriptor_712ec48c1eaf43a2, []int{14}
 }
 func (m *AuthenticateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1014,7 +1179,7 @@ func (m *WhoAmIRequest) Reset()         { *m = WhoAmIRequest{} }
 func (m *WhoAmIRequest) String() string { return proto.CompactTextString(m) }
 func (*WhoAmIRequest) ProtoMessage()    {}
 func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{14}
+	return fileDescriptor_712ec48c1eaf43a2, []int{15}
 }
 func (m *WhoAmIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,18 +1209,20 @@ func (m *WhoAmIRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_WhoAmIRequest proto.InternalMessageInfo
 
 type WhoAmIResponse struct {
-	Username             string     `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Expiration           *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Username   string     `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
+	// The scope of the caller's token, if it's restricted
+	Scope                *TokenScope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *WhoAmIResponse) Reset()         { *m = WhoAmIResponse{} }
 func (m *WhoAmIResponse) String() string { return proto.CompactTextString(m) }
 func (*WhoAmIResponse) ProtoMessage()    {}
 func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{15}
+	return fileDescriptor_712ec48c1eaf43a2, []int{16}
 }
 func (m *WhoAmIResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,6 +1265,13 @@ func (m *WhoAmIResponse) GetExpiration() *time.Time {
 	return nil
 }
 
+func (m *WhoAmIResponse) GetScope() *TokenScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
 type GetRolesForPermissionRequest struct {
 	Permission           Permission `protobuf:"varint,1,opt,name=permission,proto3,enum=auth_v2.Permission" json:"permission,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -1109,7 +1283,7 @@ func (m *GetRolesForPermissionRequest) Reset()         { *m = GetRolesForPermiss
 func (m *GetRolesForPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRolesForPermissionRequest) ProtoMessage()    {}
 func (*GetRolesForPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{16}
+	return fileDescriptor_712ec48c1eaf43a2, []int{17}
 }
 func (m *GetRolesForPermissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,7 +1330,7 @@ func (m *GetRolesForPermissionResponse) Reset()         { *m = GetRolesForPermis
 func (m *GetRolesForPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRolesForPermissionResponse) ProtoMessage()    {}
 func (*GetRolesForPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{17}
+	return fileDescriptor_712ec48c1eaf43a2, []int{18}
 }
 func (m *GetRolesForPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,26 +1366,28 @@ func (m *GetRolesForPermissionResponse) GetRoles() []*Role {
 	return nil
 }
 
-// Roles represents the set of roles a principal has
-type Roles struct {
-	Roles                map[string]bool `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type CreateRoleRequest struct {
+	// role is the custom role to create. If its resource_types are empty, the
+	// role applies to every type of resource that all of its permissions can be
+	// granted on.
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Roles) Reset()         { *m = Roles{} }
-func (m *Roles) String() string { return proto.CompactTextString(m) }
-func (*Roles) ProtoMessage()    {}
-func (*Roles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{18}
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{19}
 }
-func (m *Roles) XXX_Unmarshal(b []byte) error {
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Roles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Roles.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1221,46 +1397,43 @@ func (m *Roles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Roles) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Roles.Merge(m, src)
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
 }
-func (m *Roles) XXX_Size() int {
+func (m *CreateRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Roles) XXX_DiscardUnknown() {
-	xxx_messageInfo_Roles.DiscardUnknown(m)
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Roles proto.InternalMessageInfo
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
 
-func (m *Roles) GetRoles() map[string]bool {
+func (m *CreateRoleRequest) GetRole() *Role {
 	if m != nil {
-		return m.Roles
+		return m.Role
 	}
 	return nil
 }
 
-// RoleBinding represents the set of roles principals have on a given Resource
-type RoleBinding struct {
-	// principal -> roles. All principal names include the structured prefix indicating their type.
-	Entries              map[string]*Roles `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type CreateRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RoleBinding) Reset()         { *m = RoleBinding{} }
-func (m *RoleBinding) String() string { return proto.CompactTextString(m) }
-func (*RoleBinding) ProtoMessage()    {}
-func (*RoleBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{19}
+func (m *CreateRoleResponse) Reset()         { *m = CreateRoleResponse{} }
+func (m *CreateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleResponse) ProtoMessage()    {}
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{20}
 }
-func (m *RoleBinding) XXX_Unmarshal(b []byte) error {
+func (m *CreateRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RoleBinding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateRoleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1270,46 +1443,40 @@ func (m *RoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RoleBinding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoleBinding.Merge(m, src)
+func (m *CreateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleResponse.Merge(m, src)
 }
-func (m *RoleBinding) XXX_Size() int {
+func (m *CreateRoleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RoleBinding) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoleBinding.DiscardUnknown(m)
+func (m *CreateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RoleBinding proto.InternalMessageInfo
-
-func (m *RoleBinding) GetEntries() map[string]*Roles {
-	if m != nil {
-		return m.Entries
-	}
-	return nil
-}
+var xxx_messageInfo_CreateRoleResponse proto.InternalMessageInfo
 
-// Resource represents any resource that has role-bindings in the system
-type Resource struct {
-	Type                 ResourceType `protobuf:"varint,1,opt,name=type,proto3,enum=auth_v2.ResourceType" json:"type,omitempty"`
-	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type UpdateRoleRequest struct {
+	// role is the custom role to update. If its resource_types are empty, the
+	// role applies to every type of resource that all of its permissions can be
+	// granted on.
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Resource) Reset()         { *m = Resource{} }
-func (m *Resource) String() string { return proto.CompactTextString(m) }
-func (*Resource) ProtoMessage()    {}
-func (*Resource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{20}
+func (m *UpdateRoleRequest) Reset()         { *m = UpdateRoleRequest{} }
+func (m *UpdateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleRequest) ProtoMessage()    {}
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{21}
 }
-func (m *Resource) XXX_Unmarshal(b []byte) error {
+func (m *UpdateRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Resource.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1319,51 +1486,43 @@ func (m *Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Resource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Resource.Merge(m, src)
+func (m *UpdateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleRequest.Merge(m, src)
 }
-func (m *Resource) XXX_Size() int {
+func (m *UpdateRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Resource) XXX_DiscardUnknown() {
-	xxx_messageInfo_Resource.DiscardUnknown(m)
+func (m *UpdateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Resource proto.InternalMessageInfo
-
-func (m *Resource) GetType() ResourceType {
-	if m != nil {
-		return m.Type
-	}
-	return ResourceType_RESOURCE_TYPE_UNKNOWN
-}
+var xxx_messageInfo_UpdateRoleRequest proto.InternalMessageInfo
 
-func (m *Resource) GetName() string {
+func (m *UpdateRoleRequest) GetRole() *Role {
 	if m != nil {
-		return m.Name
+		return m.Role
 	}
-	return ""
+	return nil
 }
 
-type Users struct {
-	Usernames            map[string]bool `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type UpdateRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Users) Reset()         { *m = Users{} }
-func (m *Users) String() string { return proto.CompactTextString(m) }
-func (*Users) ProtoMessage()    {}
-func (*Users) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{21}
+func (m *UpdateRoleResponse) Reset()         { *m = UpdateRoleResponse{} }
+func (m *UpdateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleResponse) ProtoMessage()    {}
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{22}
 }
-func (m *Users) XXX_Unmarshal(b []byte) error {
+func (m *UpdateRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Users) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Users.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateRoleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1373,44 +1532,37 @@ func (m *Users) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Users) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Users.Merge(m, src)
+func (m *UpdateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleResponse.Merge(m, src)
 }
-func (m *Users) XXX_Size() int {
+func (m *UpdateRoleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Users) XXX_DiscardUnknown() {
-	xxx_messageInfo_Users.DiscardUnknown(m)
+func (m *UpdateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Users proto.InternalMessageInfo
-
-func (m *Users) GetUsernames() map[string]bool {
-	if m != nil {
-		return m.Usernames
-	}
-	return nil
-}
+var xxx_messageInfo_UpdateRoleResponse proto.InternalMessageInfo
 
-type Groups struct {
-	Groups               map[string]bool `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type DeleteRoleRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Groups) Reset()         { *m = Groups{} }
-func (m *Groups) String() string { return proto.CompactTextString(m) }
-func (*Groups) ProtoMessage()    {}
-func (*Groups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{22}
+func (m *DeleteRoleRequest) Reset()         { *m = DeleteRoleRequest{} }
+func (m *DeleteRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoleRequest) ProtoMessage()    {}
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{23}
 }
-func (m *Groups) XXX_Unmarshal(b []byte) error {
+func (m *DeleteRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Groups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Groups.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1420,46 +1572,43 @@ func (m *Groups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Groups) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Groups.Merge(m, src)
+func (m *DeleteRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoleRequest.Merge(m, src)
 }
-func (m *Groups) XXX_Size() int {
+func (m *DeleteRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Groups) XXX_DiscardUnknown() {
-	xxx_messageInfo_Groups.DiscardUnknown(m)
+func (m *DeleteRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Groups proto.InternalMessageInfo
+var xxx_messageInfo_DeleteRoleRequest proto.InternalMessageInfo
 
-func (m *Groups) GetGroups() map[string]bool {
+func (m *DeleteRoleRequest) GetName() string {
 	if m != nil {
-		return m.Groups
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type Role struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Permissions          []Permission   `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
-	ResourceTypes        []ResourceType `protobuf:"varint,3,rep,packed,name=resource_types,json=resourceTypes,proto3,enum=auth_v2.ResourceType" json:"resource_types,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type DeleteRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Role) Reset()         { *m = Role{} }
-func (m *Role) String() string { return proto.CompactTextString(m) }
-func (*Role) ProtoMessage()    {}
-func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{23}
+func (m *DeleteRoleResponse) Reset()         { *m = DeleteRoleResponse{} }
+func (m *DeleteRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoleResponse) ProtoMessage()    {}
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{24}
 }
-func (m *Role) XXX_Unmarshal(b []byte) error {
+func (m *DeleteRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteRoleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1469,60 +1618,36 @@ func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Role) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Role.Merge(m, src)
+func (m *DeleteRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoleResponse.Merge(m, src)
 }
-func (m *Role) XXX_Size() int {
+func (m *DeleteRoleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Role) XXX_DiscardUnknown() {
-	xxx_messageInfo_Role.DiscardUnknown(m)
+func (m *DeleteRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Role proto.InternalMessageInfo
-
-func (m *Role) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *Role) GetPermissions() []Permission {
-	if m != nil {
-		return m.Permissions
-	}
-	return nil
-}
-
-func (m *Role) GetResourceTypes() []ResourceType {
-	if m != nil {
-		return m.ResourceTypes
-	}
-	return nil
-}
+var xxx_messageInfo_DeleteRoleResponse proto.InternalMessageInfo
 
-type AuthorizeRequest struct {
-	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	// permissions are the operations the caller is attempting to perform
-	Permissions          []Permission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type ListRoleRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthorizeRequest) Reset()         { *m = AuthorizeRequest{} }
-func (m *AuthorizeRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthorizeRequest) ProtoMessage()    {}
-func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{24}
+func (m *ListRoleRequest) Reset()         { *m = ListRoleRequest{} }
+func (m *ListRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRoleRequest) ProtoMessage()    {}
+func (*ListRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{25}
 }
-func (m *AuthorizeRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthorizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthorizeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1532,58 +1657,37 @@ func (m *AuthorizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AuthorizeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthorizeRequest.Merge(m, src)
+func (m *ListRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoleRequest.Merge(m, src)
 }
-func (m *AuthorizeRequest) XXX_Size() int {
+func (m *ListRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthorizeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthorizeRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AuthorizeRequest proto.InternalMessageInfo
-
-func (m *AuthorizeRequest) GetResource() *Resource {
-	if m != nil {
-		return m.Resource
-	}
-	return nil
+func (m *ListRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoleRequest.DiscardUnknown(m)
 }
 
-func (m *AuthorizeRequest) GetPermissions() []Permission {
-	if m != nil {
-		return m.Permissions
-	}
-	return nil
-}
+var xxx_messageInfo_ListRoleRequest proto.InternalMessageInfo
 
-type AuthorizeResponse struct {
-	// authorized is true if the caller has the require permissions
-	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
-	// satisfied is the set of permission that the principal has
-	Satisfied []Permission `protobuf:"varint,2,rep,packed,name=satisfied,proto3,enum=auth_v2.Permission" json:"satisfied,omitempty"`
-	// missing is the set of permissions that the principal lacks
-	Missing []Permission `protobuf:"varint,3,rep,packed,name=missing,proto3,enum=auth_v2.Permission" json:"missing,omitempty"`
-	// principal is the principal the request was evaluated for
-	Principal            string   `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+type ListRoleResponse struct {
+	Roles                []*Role  `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthorizeResponse) Reset()         { *m = AuthorizeResponse{} }
-func (m *AuthorizeResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthorizeResponse) ProtoMessage()    {}
-func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{25}
+func (m *ListRoleResponse) Reset()         { *m = ListRoleResponse{} }
+func (m *ListRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRoleResponse) ProtoMessage()    {}
+func (*ListRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{26}
 }
-func (m *AuthorizeResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthorizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthorizeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListRoleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1593,66 +1697,45 @@ func (m *AuthorizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *AuthorizeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthorizeResponse.Merge(m, src)
+func (m *ListRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoleResponse.Merge(m, src)
 }
-func (m *AuthorizeResponse) XXX_Size() int {
+func (m *ListRoleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthorizeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthorizeResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AuthorizeResponse proto.InternalMessageInfo
-
-func (m *AuthorizeResponse) GetAuthorized() bool {
-	if m != nil {
-		return m.Authorized
-	}
-	return false
+func (m *ListRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoleResponse.DiscardUnknown(m)
 }
 
-func (m *AuthorizeResponse) GetSatisfied() []Permission {
-	if m != nil {
-		return m.Satisfied
-	}
-	return nil
-}
+var xxx_messageInfo_ListRoleResponse proto.InternalMessageInfo
 
-func (m *AuthorizeResponse) GetMissing() []Permission {
+func (m *ListRoleResponse) GetRoles() []*Role {
 	if m != nil {
-		return m.Missing
+		return m.Roles
 	}
 	return nil
 }
 
-func (m *AuthorizeResponse) GetPrincipal() string {
-	if m != nil {
-		return m.Principal
-	}
-	return ""
-}
-
-// GetPermissions evaluates the current user's permissions on a resource
-type GetPermissionsRequest struct {
-	Resource             *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+// Roles represents the set of roles a principal has
+type Roles struct {
+	Roles                map[string]bool `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
-func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPermissionsRequest) ProtoMessage()    {}
-func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{26}
+func (m *Roles) Reset()         { *m = Roles{} }
+func (m *Roles) String() string { return proto.CompactTextString(m) }
+func (*Roles) ProtoMessage()    {}
+func (*Roles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{27}
 }
-func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *Roles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Roles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetPermissionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Roles.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1662,47 +1745,58 @@ func (m *GetPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetPermissionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPermissionsRequest.Merge(m, src)
+func (m *Roles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Roles.Merge(m, src)
 }
-func (m *GetPermissionsRequest) XXX_Size() int {
+func (m *Roles) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetPermissionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPermissionsRequest.DiscardUnknown(m)
+func (m *Roles) XXX_DiscardUnknown() {
+	xxx_messageInfo_Roles.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPermissionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_Roles proto.InternalMessageInfo
 
-func (m *GetPermissionsRequest) GetResource() *Resource {
+func (m *Roles) GetRoles() map[string]bool {
 	if m != nil {
-		return m.Resource
+		return m.Roles
 	}
 	return nil
 }
 
-// GetPermissionsForPrincipal evaluates an arbitrary principal's permissions
-// on a resource
-type GetPermissionsForPrincipalRequest struct {
-	Resource             *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Principal            string    `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+// RoleBinding represents the set of roles principals have on a given Resource
+type RoleBinding struct {
+	// principal -> roles. All principal names include the structured prefix indicating their type.
+	Entries map[string]*Roles `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// branch pattern -> role binding. Only set on REPO role bindings. The roles
+	// in a branch binding are granted in addition to the repo-level roles, for
+	// operations on branches of the repo matching the pattern (e.g. "dev/*").
+	Branches map[string]*RoleBinding `protobuf:"bytes,2,rep,name=branches,proto3" json:"branches,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// path prefix -> role binding. Only set on REPO role bindings. Files under a
+	// path prefix with a binding are restricted: only cluster-level roles and
+	// the roles in matching path bindings grant access to them.
+	Paths map[string]*RoleBinding `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// pipeline is the role binding for the pipeline that writes to the repo,
+	// if any. Only set on REPO role bindings. The roles in it are granted in
+	// addition to the repo-level roles, for operations on the pipeline.
+	Pipeline             *RoleBinding `protobuf:"bytes,4,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *GetPermissionsForPrincipalRequest) Reset()         { *m = GetPermissionsForPrincipalRequest{} }
-func (m *GetPermissionsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPermissionsForPrincipalRequest) ProtoMessage()    {}
-func (*GetPermissionsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{27}
+func (m *RoleBinding) Reset()         { *m = RoleBinding{} }
+func (m *RoleBinding) String() string { return proto.CompactTextString(m) }
+func (*RoleBinding) ProtoMessage()    {}
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{28}
 }
-func (m *GetPermissionsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
+func (m *RoleBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetPermissionsForPrincipalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetPermissionsForPrincipalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RoleBinding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1712,54 +1806,67 @@ func (m *GetPermissionsForPrincipalRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *GetPermissionsForPrincipalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPermissionsForPrincipalRequest.Merge(m, src)
+func (m *RoleBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleBinding.Merge(m, src)
 }
-func (m *GetPermissionsForPrincipalRequest) XXX_Size() int {
+func (m *RoleBinding) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetPermissionsForPrincipalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPermissionsForPrincipalRequest.DiscardUnknown(m)
+func (m *RoleBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleBinding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPermissionsForPrincipalRequest proto.InternalMessageInfo
+var xxx_messageInfo_RoleBinding proto.InternalMessageInfo
 
-func (m *GetPermissionsForPrincipalRequest) GetResource() *Resource {
+func (m *RoleBinding) GetEntries() map[string]*Roles {
 	if m != nil {
-		return m.Resource
+		return m.Entries
 	}
 	return nil
 }
 
-func (m *GetPermissionsForPrincipalRequest) GetPrincipal() string {
+func (m *RoleBinding) GetBranches() map[string]*RoleBinding {
 	if m != nil {
-		return m.Principal
+		return m.Branches
 	}
-	return ""
+	return nil
 }
 
-type GetPermissionsResponse struct {
-	// permissions is the set of permissions the principal has
-	Permissions []Permission `protobuf:"varint,1,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
-	// roles is the set of roles the principal has
-	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RoleBinding) GetPaths() map[string]*RoleBinding {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
 }
 
-func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{} }
-func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPermissionsResponse) ProtoMessage()    {}
-func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{28}
+func (m *RoleBinding) GetPipeline() *RoleBinding {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
 }
-func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
+
+// Resource represents any resource that has role-bindings in the system
+type Resource struct {
+	Type                 ResourceType `protobuf:"varint,1,opt,name=type,proto3,enum=auth_v2.ResourceType" json:"type,omitempty"`
+	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Resource) Reset()         { *m = Resource{} }
+func (m *Resource) String() string { return proto.CompactTextString(m) }
+func (*Resource) ProtoMessage()    {}
+func (*Resource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{29}
+}
+func (m *Resource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetPermissionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Resource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1769,57 +1876,51 @@ func (m *GetPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetPermissionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPermissionsResponse.Merge(m, src)
+func (m *Resource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resource.Merge(m, src)
 }
-func (m *GetPermissionsResponse) XXX_Size() int {
+func (m *Resource) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetPermissionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPermissionsResponse.DiscardUnknown(m)
+func (m *Resource) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPermissionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_Resource proto.InternalMessageInfo
 
-func (m *GetPermissionsResponse) GetPermissions() []Permission {
+func (m *Resource) GetType() ResourceType {
 	if m != nil {
-		return m.Permissions
+		return m.Type
 	}
-	return nil
+	return ResourceType_RESOURCE_TYPE_UNKNOWN
 }
 
-func (m *GetPermissionsResponse) GetRoles() []string {
+func (m *Resource) GetName() string {
 	if m != nil {
-		return m.Roles
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type ModifyRoleBindingRequest struct {
-	// resource is the resource to modify the role bindings on
-	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	// principal is the principal to modify the roles binding for
-	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
-	// roles is the set of roles for principal - an empty list
-	// removes all role bindings
-	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Users struct {
+	Usernames            map[string]bool `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ModifyRoleBindingRequest) Reset()         { *m = ModifyRoleBindingRequest{} }
-func (m *ModifyRoleBindingRequest) String() string { return proto.CompactTextString(m) }
-func (*ModifyRoleBindingRequest) ProtoMessage()    {}
-func (*ModifyRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{29}
+func (m *Users) Reset()         { *m = Users{} }
+func (m *Users) String() string { return proto.CompactTextString(m) }
+func (*Users) ProtoMessage()    {}
+func (*Users) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{30}
 }
-func (m *ModifyRoleBindingRequest) XXX_Unmarshal(b []byte) error {
+func (m *Users) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ModifyRoleBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Users) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ModifyRoleBindingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Users.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1829,57 +1930,96 @@ func (m *ModifyRoleBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ModifyRoleBindingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyRoleBindingRequest.Merge(m, src)
+func (m *Users) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Users.Merge(m, src)
 }
-func (m *ModifyRoleBindingRequest) XXX_Size() int {
+func (m *Users) XXX_Size() int {
 	return m.Size()
 }
-func (m *ModifyRoleBindingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyRoleBindingRequest.DiscardUnknown(m)
+func (m *Users) XXX_DiscardUnknown() {
+	xxx_messageInfo_Users.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyRoleBindingRequest proto.InternalMessageInfo
+var xxx_messageInfo_Users proto.InternalMessageInfo
 
-func (m *ModifyRoleBindingRequest) GetResource() *Resource {
+func (m *Users) GetUsernames() map[string]bool {
 	if m != nil {
-		return m.Resource
+		return m.Usernames
 	}
 	return nil
 }
 
-func (m *ModifyRoleBindingRequest) GetPrincipal() string {
-	if m != nil {
-		return m.Principal
+type Groups struct {
+	Groups               map[string]bool `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Groups) Reset()         { *m = Groups{} }
+func (m *Groups) String() string { return proto.CompactTextString(m) }
+func (*Groups) ProtoMessage()    {}
+func (*Groups) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{31}
+}
+func (m *Groups) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Groups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Groups.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *Groups) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Groups.Merge(m, src)
+}
+func (m *Groups) XXX_Size() int {
+	return m.Size()
+}
+func (m *Groups) XXX_DiscardUnknown() {
+	xxx_messageInfo_Groups.DiscardUnknown(m)
 }
 
-func (m *ModifyRoleBindingRequest) GetRoles() []string {
+var xxx_messageInfo_Groups proto.InternalMessageInfo
+
+func (m *Groups) GetGroups() map[string]bool {
 	if m != nil {
-		return m.Roles
+		return m.Groups
 	}
 	return nil
 }
 
-type ModifyRoleBindingResponse struct {
+type Role struct {
+	Name          string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   []Permission   `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	ResourceTypes []ResourceType `protobuf:"varint,3,rep,packed,name=resource_types,json=resourceTypes,proto3,enum=auth_v2.ResourceType" json:"resource_types,omitempty"`
+	// custom is true for roles created with CreateRole, as opposed to the
+	// roles built into Pachyderm.
+	Custom               bool     `protobuf:"varint,4,opt,name=custom,proto3" json:"custom,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ModifyRoleBindingResponse) Reset()         { *m = ModifyRoleBindingResponse{} }
-func (m *ModifyRoleBindingResponse) String() string { return proto.CompactTextString(m) }
-func (*ModifyRoleBindingResponse) ProtoMessage()    {}
-func (*ModifyRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{30}
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{32}
 }
-func (m *ModifyRoleBindingResponse) XXX_Unmarshal(b []byte) error {
+func (m *Role) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ModifyRoleBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ModifyRoleBindingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Role.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1889,37 +2029,67 @@ func (m *ModifyRoleBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ModifyRoleBindingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyRoleBindingResponse.Merge(m, src)
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
 }
-func (m *ModifyRoleBindingResponse) XXX_Size() int {
+func (m *Role) XXX_Size() int {
 	return m.Size()
 }
-func (m *ModifyRoleBindingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyRoleBindingResponse.DiscardUnknown(m)
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyRoleBindingResponse proto.InternalMessageInfo
+var xxx_messageInfo_Role proto.InternalMessageInfo
 
-type GetRoleBindingRequest struct {
-	Resource             *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *GetRoleBindingRequest) Reset()         { *m = GetRoleBindingRequest{} }
-func (m *GetRoleBindingRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRoleBindingRequest) ProtoMessage()    {}
-func (*GetRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{31}
+func (m *Role) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
 }
-func (m *GetRoleBindingRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *Role) GetResourceTypes() []ResourceType {
+	if m != nil {
+		return m.ResourceTypes
+	}
+	return nil
+}
+
+func (m *Role) GetCustom() bool {
+	if m != nil {
+		return m.Custom
+	}
+	return false
+}
+
+type AuthorizeRequest struct {
+	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// permissions are the operations the caller is attempting to perform
+	Permissions          []Permission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AuthorizeRequest) Reset()         { *m = AuthorizeRequest{} }
+func (m *AuthorizeRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeRequest) ProtoMessage()    {}
+func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{33}
+}
+func (m *AuthorizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRoleBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthorizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRoleBindingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthorizeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1929,44 +2099,58 @@ func (m *GetRoleBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetRoleBindingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRoleBindingRequest.Merge(m, src)
+func (m *AuthorizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeRequest.Merge(m, src)
 }
-func (m *GetRoleBindingRequest) XXX_Size() int {
+func (m *AuthorizeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRoleBindingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRoleBindingRequest.DiscardUnknown(m)
+func (m *AuthorizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRoleBindingRequest proto.InternalMessageInfo
+var xxx_messageInfo_AuthorizeRequest proto.InternalMessageInfo
 
-func (m *GetRoleBindingRequest) GetResource() *Resource {
+func (m *AuthorizeRequest) GetResource() *Resource {
 	if m != nil {
 		return m.Resource
 	}
 	return nil
 }
 
-type GetRoleBindingResponse struct {
-	Binding              *RoleBinding `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *AuthorizeRequest) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
 }
 
-func (m *GetRoleBindingResponse) Reset()         { *m = GetRoleBindingResponse{} }
-func (m *GetRoleBindingResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRoleBindingResponse) ProtoMessage()    {}
-func (*GetRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{32}
+type AuthorizeResponse struct {
+	// authorized is true if the caller has the require permissions
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	// satisfied is the set of permission that the principal has
+	Satisfied []Permission `protobuf:"varint,2,rep,packed,name=satisfied,proto3,enum=auth_v2.Permission" json:"satisfied,omitempty"`
+	// missing is the set of permissions that the principal lacks
+	Missing []Permission `protobuf:"varint,3,rep,packed,name=missing,proto3,enum=auth_v2.Permission" json:"missing,omitempty"`
+	// principal is the principal the request was evaluated for
+	Principal            string   `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetRoleBindingResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *AuthorizeResponse) Reset()         { *m = AuthorizeResponse{} }
+func (m *AuthorizeResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeResponse) ProtoMessage()    {}
+func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{34}
+}
+func (m *AuthorizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRoleBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthorizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRoleBindingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthorizeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1976,72 +2160,66 @@ func (m *GetRoleBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetRoleBindingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRoleBindingResponse.Merge(m, src)
+func (m *AuthorizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeResponse.Merge(m, src)
 }
-func (m *GetRoleBindingResponse) XXX_Size() int {
+func (m *AuthorizeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRoleBindingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRoleBindingResponse.DiscardUnknown(m)
+func (m *AuthorizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRoleBindingResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuthorizeResponse proto.InternalMessageInfo
 
-func (m *GetRoleBindingResponse) GetBinding() *RoleBinding {
+func (m *AuthorizeResponse) GetAuthorized() bool {
 	if m != nil {
-		return m.Binding
+		return m.Authorized
+	}
+	return false
+}
+
+func (m *AuthorizeResponse) GetSatisfied() []Permission {
+	if m != nil {
+		return m.Satisfied
 	}
 	return nil
 }
 
-// SessionInfo stores information associated with one OIDC authentication
-// session (i.e. a single instance of a single user logging in). Sessions are
-// short-lived and stored in the 'oidc-authns' collection, keyed by the OIDC
-// 'state' token (30-character CSPRNG-generated string). 'GetOIDCLogin'
-// generates and inserts entries, then /authorization-code/callback retrieves
-// an access token from the ID provider and uses it to retrive the caller's
-// email and store it in 'email', and finally Authorize() returns a Pachyderm
-// token identified with that email address as a subject in Pachyderm.
-type SessionInfo struct {
-	// nonce is used by /authorization-code/callback to validate session
-	// continuity with the IdP after a user has arrived there from GetOIDCLogin().
-	// This is a 30-character CSPRNG-generated string.
-	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// email contains the email adddress associated with a user in their OIDC ID
-	// provider. Currently users are identified with their email address rather
-	// than their OIDC subject identifier to make switching between OIDC ID
-	// providers easier for users, and to make user identities more easily
-	// comprehensible in Pachyderm. The OIDC spec doesn't require that users'
-	// emails be present or unique, but we think this will be preferable in
-	// practice.
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	// conversion_err indicates whether an error was encountered while exchanging
-	// an auth code for an access token, or while obtaining a user's email (in
-	// /authorization-code/callback). Storing the error state here allows any
-	// sibling calls to Authenticate() (i.e. using the same OIDC state token) to
-	// notify their caller that an error has occurred. We avoid passing the caller
-	// any details of the error (which are logged by Pachyderm) to avoid giving
-	// information to a user who has network access to Pachyderm but not an
-	// account in the OIDC provider.
-	ConversionErr        bool     `protobuf:"varint,3,opt,name=conversion_err,json=conversionErr,proto3" json:"conversion_err,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AuthorizeResponse) GetMissing() []Permission {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
 }
 
-func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
-func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
-func (*SessionInfo) ProtoMessage()    {}
-func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{33}
+func (m *AuthorizeResponse) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
 }
-func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
+
+// GetPermissions evaluates the current user's permissions on a resource
+type GetPermissionsRequest struct {
+	Resource             *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
+func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsRequest) ProtoMessage()    {}
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{35}
+}
+func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SessionInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetPermissionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2051,57 +2229,47 @@ func (m *SessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SessionInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SessionInfo.Merge(m, src)
+func (m *GetPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsRequest.Merge(m, src)
 }
-func (m *SessionInfo) XXX_Size() int {
+func (m *GetPermissionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SessionInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_SessionInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SessionInfo proto.InternalMessageInfo
-
-func (m *SessionInfo) GetNonce() string {
-	if m != nil {
-		return m.Nonce
-	}
-	return ""
+func (m *GetPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsRequest.DiscardUnknown(m)
 }
 
-func (m *SessionInfo) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
-}
+var xxx_messageInfo_GetPermissionsRequest proto.InternalMessageInfo
 
-func (m *SessionInfo) GetConversionErr() bool {
+func (m *GetPermissionsRequest) GetResource() *Resource {
 	if m != nil {
-		return m.ConversionErr
+		return m.Resource
 	}
-	return false
+	return nil
 }
 
-type GetOIDCLoginRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// GetPermissionsForPrincipal evaluates an arbitrary principal's permissions
+// on a resource
+type GetPermissionsForPrincipalRequest struct {
+	Resource             *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Principal            string    `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *GetOIDCLoginRequest) Reset()         { *m = GetOIDCLoginRequest{} }
-func (m *GetOIDCLoginRequest) String() string { return proto.CompactTextString(m) }
-func (*GetOIDCLoginRequest) ProtoMessage()    {}
-func (*GetOIDCLoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{34}
+func (m *GetPermissionsForPrincipalRequest) Reset()         { *m = GetPermissionsForPrincipalRequest{} }
+func (m *GetPermissionsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsForPrincipalRequest) ProtoMessage()    {}
+func (*GetPermissionsForPrincipalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{36}
 }
-func (m *GetOIDCLoginRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetPermissionsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetOIDCLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetPermissionsForPrincipalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetOIDCLoginRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetPermissionsForPrincipalRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2111,39 +2279,54 @@ func (m *GetOIDCLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetOIDCLoginRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOIDCLoginRequest.Merge(m, src)
+func (m *GetPermissionsForPrincipalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsForPrincipalRequest.Merge(m, src)
 }
-func (m *GetOIDCLoginRequest) XXX_Size() int {
+func (m *GetPermissionsForPrincipalRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetOIDCLoginRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOIDCLoginRequest.DiscardUnknown(m)
+func (m *GetPermissionsForPrincipalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsForPrincipalRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOIDCLoginRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetPermissionsForPrincipalRequest proto.InternalMessageInfo
 
-type GetOIDCLoginResponse struct {
-	// The login URL generated for the OIDC object
-	LoginURL             string   `protobuf:"bytes,1,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
-	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+func (m *GetPermissionsForPrincipalRequest) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *GetPermissionsForPrincipalRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+type GetPermissionsResponse struct {
+	// permissions is the set of permissions the principal has
+	Permissions []Permission `protobuf:"varint,1,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	// roles is the set of roles the principal has
+	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetOIDCLoginResponse) Reset()         { *m = GetOIDCLoginResponse{} }
-func (m *GetOIDCLoginResponse) String() string { return proto.CompactTextString(m) }
-func (*GetOIDCLoginResponse) ProtoMessage()    {}
-func (*GetOIDCLoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{35}
+func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{} }
+func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPermissionsResponse) ProtoMessage()    {}
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{37}
 }
-func (m *GetOIDCLoginResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetOIDCLoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetOIDCLoginResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetPermissionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2153,56 +2336,57 @@ func (m *GetOIDCLoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetOIDCLoginResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOIDCLoginResponse.Merge(m, src)
+func (m *GetPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPermissionsResponse.Merge(m, src)
 }
-func (m *GetOIDCLoginResponse) XXX_Size() int {
+func (m *GetPermissionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetOIDCLoginResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOIDCLoginResponse.DiscardUnknown(m)
+func (m *GetPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPermissionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOIDCLoginResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPermissionsResponse proto.InternalMessageInfo
 
-func (m *GetOIDCLoginResponse) GetLoginURL() string {
+func (m *GetPermissionsResponse) GetPermissions() []Permission {
 	if m != nil {
-		return m.LoginURL
+		return m.Permissions
 	}
-	return ""
+	return nil
 }
 
-func (m *GetOIDCLoginResponse) GetState() string {
+func (m *GetPermissionsResponse) GetRoles() []string {
 	if m != nil {
-		return m.State
+		return m.Roles
 	}
-	return ""
+	return nil
 }
 
-type GetRobotTokenRequest struct {
-	// The returned token will allow the caller to access resources as this
-	// robot user
-	Robot string `protobuf:"bytes,1,opt,name=robot,proto3" json:"robot,omitempty"`
-	// ttl indicates the requested (approximate) remaining lifetime of this token,
-	// in seconds
-	TTL                  int64    `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+type ModifyRoleBindingRequest struct {
+	// resource is the resource to modify the role bindings on
+	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// principal is the principal to modify the roles binding for
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// roles is the set of roles for principal - an empty list
+	// removes all role bindings
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRobotTokenRequest) Reset()         { *m = GetRobotTokenRequest{} }
-func (m *GetRobotTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRobotTokenRequest) ProtoMessage()    {}
-func (*GetRobotTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{36}
+func (m *ModifyRoleBindingRequest) Reset()         { *m = ModifyRoleBindingRequest{} }
+func (m *ModifyRoleBindingRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyRoleBindingRequest) ProtoMessage()    {}
+func (*ModifyRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{38}
 }
-func (m *GetRobotTokenRequest) XXX_Unmarshal(b []byte) error {
+func (m *ModifyRoleBindingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRobotTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ModifyRoleBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRobotTokenRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ModifyRoleBindingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2212,52 +2396,57 @@ func (m *GetRobotTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetRobotTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRobotTokenRequest.Merge(m, src)
+func (m *ModifyRoleBindingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyRoleBindingRequest.Merge(m, src)
 }
-func (m *GetRobotTokenRequest) XXX_Size() int {
+func (m *ModifyRoleBindingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRobotTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRobotTokenRequest.DiscardUnknown(m)
+func (m *ModifyRoleBindingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyRoleBindingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRobotTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_ModifyRoleBindingRequest proto.InternalMessageInfo
 
-func (m *GetRobotTokenRequest) GetRobot() string {
+func (m *ModifyRoleBindingRequest) GetResource() *Resource {
 	if m != nil {
-		return m.Robot
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *ModifyRoleBindingRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
 	}
 	return ""
 }
 
-func (m *GetRobotTokenRequest) GetTTL() int64 {
+func (m *ModifyRoleBindingRequest) GetRoles() []string {
 	if m != nil {
-		return m.TTL
+		return m.Roles
 	}
-	return 0
+	return nil
 }
 
-type GetRobotTokenResponse struct {
-	// A new auth token for the requested robot
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+type ModifyRoleBindingResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRobotTokenResponse) Reset()         { *m = GetRobotTokenResponse{} }
-func (m *GetRobotTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRobotTokenResponse) ProtoMessage()    {}
-func (*GetRobotTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{37}
+func (m *ModifyRoleBindingResponse) Reset()         { *m = ModifyRoleBindingResponse{} }
+func (m *ModifyRoleBindingResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyRoleBindingResponse) ProtoMessage()    {}
+func (*ModifyRoleBindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{39}
 }
-func (m *GetRobotTokenResponse) XXX_Unmarshal(b []byte) error {
+func (m *ModifyRoleBindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRobotTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ModifyRoleBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRobotTokenResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ModifyRoleBindingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2267,44 +2456,37 @@ func (m *GetRobotTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetRobotTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRobotTokenResponse.Merge(m, src)
+func (m *ModifyRoleBindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyRoleBindingResponse.Merge(m, src)
 }
-func (m *GetRobotTokenResponse) XXX_Size() int {
+func (m *ModifyRoleBindingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRobotTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRobotTokenResponse.DiscardUnknown(m)
+func (m *ModifyRoleBindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyRoleBindingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRobotTokenResponse proto.InternalMessageInfo
-
-func (m *GetRobotTokenResponse) GetToken() string {
-	if m != nil {
-		return m.Token
-	}
-	return ""
-}
+var xxx_messageInfo_ModifyRoleBindingResponse proto.InternalMessageInfo
 
-type RevokeAuthTokenRequest struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetRoleBindingRequest struct {
+	Resource             *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *RevokeAuthTokenRequest) Reset()         { *m = RevokeAuthTokenRequest{} }
-func (m *RevokeAuthTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*RevokeAuthTokenRequest) ProtoMessage()    {}
-func (*RevokeAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+func (m *GetRoleBindingRequest) Reset()         { *m = GetRoleBindingRequest{} }
+func (m *GetRoleBindingRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoleBindingRequest) ProtoMessage()    {}
+func (*GetRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{40}
 }
-func (m *RevokeAuthTokenRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetRoleBindingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RevokeAuthTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRoleBindingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RevokeAuthTokenRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRoleBindingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2314,43 +2496,44 @@ func (m *RevokeAuthTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *RevokeAuthTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RevokeAuthTokenRequest.Merge(m, src)
+func (m *GetRoleBindingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoleBindingRequest.Merge(m, src)
 }
-func (m *RevokeAuthTokenRequest) XXX_Size() int {
+func (m *GetRoleBindingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RevokeAuthTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RevokeAuthTokenRequest.DiscardUnknown(m)
+func (m *GetRoleBindingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoleBindingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RevokeAuthTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetRoleBindingRequest proto.InternalMessageInfo
 
-func (m *RevokeAuthTokenRequest) GetToken() string {
+func (m *GetRoleBindingRequest) GetResource() *Resource {
 	if m != nil {
-		return m.Token
+		return m.Resource
 	}
-	return ""
+	return nil
 }
 
-type RevokeAuthTokenResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetRoleBindingResponse struct {
+	Binding              *RoleBinding `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *RevokeAuthTokenResponse) Reset()         { *m = RevokeAuthTokenResponse{} }
-func (m *RevokeAuthTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*RevokeAuthTokenResponse) ProtoMessage()    {}
-func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{39}
+func (m *GetRoleBindingResponse) Reset()         { *m = GetRoleBindingResponse{} }
+func (m *GetRoleBindingResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoleBindingResponse) ProtoMessage()    {}
+func (*GetRoleBindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{41}
 }
-func (m *RevokeAuthTokenResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetRoleBindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RevokeAuthTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRoleBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RevokeAuthTokenResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRoleBindingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2360,38 +2543,72 @@ func (m *RevokeAuthTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *RevokeAuthTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RevokeAuthTokenResponse.Merge(m, src)
+func (m *GetRoleBindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoleBindingResponse.Merge(m, src)
 }
-func (m *RevokeAuthTokenResponse) XXX_Size() int {
+func (m *GetRoleBindingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RevokeAuthTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RevokeAuthTokenResponse.DiscardUnknown(m)
+func (m *GetRoleBindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoleBindingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RevokeAuthTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRoleBindingResponse proto.InternalMessageInfo
 
-type SetGroupsForUserRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Groups               []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+func (m *GetRoleBindingResponse) GetBinding() *RoleBinding {
+	if m != nil {
+		return m.Binding
+	}
+	return nil
+}
+
+// SessionInfo stores information associated with one OIDC authentication
+// session (i.e. a single instance of a single user logging in). Sessions are
+// short-lived and stored in the 'oidc-authns' collection, keyed by the OIDC
+// 'state' token (30-character CSPRNG-generated string). 'GetOIDCLogin'
+// generates and inserts entries, then /authorization-code/callback retrieves
+// an access token from the ID provider and uses it to retrive the caller's
+// email and store it in 'email', and finally Authorize() returns a Pachyderm
+// token identified with that email address as a subject in Pachyderm.
+type SessionInfo struct {
+	// nonce is used by /authorization-code/callback to validate session
+	// continuity with the IdP after a user has arrived there from GetOIDCLogin().
+	// This is a 30-character CSPRNG-generated string.
+	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// email contains the email adddress associated with a user in their OIDC ID
+	// provider. Currently users are identified with their email address rather
+	// than their OIDC subject identifier to make switching between OIDC ID
+	// providers easier for users, and to make user identities more easily
+	// comprehensible in Pachyderm. The OIDC spec doesn't require that users'
+	// emails be present or unique, but we think this will be preferable in
+	// practice.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// conversion_err indicates whether an error was encountered while exchanging
+	// an auth code for an access token, or while obtaining a user's email (in
+	// /authorization-code/callback). Storing the error state here allows any
+	// sibling calls to Authenticate() (i.e. using the same OIDC state token) to
+	// notify their caller that an error has occurred. We avoid passing the caller
+	// any details of the error (which are logged by Pachyderm) to avoid giving
+	// information to a user who has network access to Pachyderm but not an
+	// account in the OIDC provider.
+	ConversionErr        bool     `protobuf:"varint,3,opt,name=conversion_err,json=conversionErr,proto3" json:"conversion_err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetGroupsForUserRequest) Reset()         { *m = SetGroupsForUserRequest{} }
-func (m *SetGroupsForUserRequest) String() string { return proto.CompactTextString(m) }
-func (*SetGroupsForUserRequest) ProtoMessage()    {}
-func (*SetGroupsForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{40}
+func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
+func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
+func (*SessionInfo) ProtoMessage()    {}
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{42}
 }
-func (m *SetGroupsForUserRequest) XXX_Unmarshal(b []byte) error {
+func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetGroupsForUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetGroupsForUserRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SessionInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2401,50 +2618,57 @@ func (m *SetGroupsForUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *SetGroupsForUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetGroupsForUserRequest.Merge(m, src)
+func (m *SessionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionInfo.Merge(m, src)
 }
-func (m *SetGroupsForUserRequest) XXX_Size() int {
+func (m *SessionInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetGroupsForUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetGroupsForUserRequest.DiscardUnknown(m)
+func (m *SessionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetGroupsForUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_SessionInfo proto.InternalMessageInfo
 
-func (m *SetGroupsForUserRequest) GetUsername() string {
+func (m *SessionInfo) GetNonce() string {
 	if m != nil {
-		return m.Username
+		return m.Nonce
 	}
 	return ""
 }
 
-func (m *SetGroupsForUserRequest) GetGroups() []string {
+func (m *SessionInfo) GetEmail() string {
 	if m != nil {
-		return m.Groups
+		return m.Email
 	}
-	return nil
+	return ""
 }
 
-type SetGroupsForUserResponse struct {
+func (m *SessionInfo) GetConversionErr() bool {
+	if m != nil {
+		return m.ConversionErr
+	}
+	return false
+}
+
+type GetOIDCLoginRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetGroupsForUserResponse) Reset()         { *m = SetGroupsForUserResponse{} }
-func (m *SetGroupsForUserResponse) String() string { return proto.CompactTextString(m) }
-func (*SetGroupsForUserResponse) ProtoMessage()    {}
-func (*SetGroupsForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{41}
+func (m *GetOIDCLoginRequest) Reset()         { *m = GetOIDCLoginRequest{} }
+func (m *GetOIDCLoginRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOIDCLoginRequest) ProtoMessage()    {}
+func (*GetOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{43}
 }
-func (m *SetGroupsForUserResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetOIDCLoginRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetGroupsForUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetOIDCLoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetGroupsForUserResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetOIDCLoginRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2454,39 +2678,39 @@ func (m *SetGroupsForUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *SetGroupsForUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetGroupsForUserResponse.Merge(m, src)
+func (m *GetOIDCLoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOIDCLoginRequest.Merge(m, src)
 }
-func (m *SetGroupsForUserResponse) XXX_Size() int {
+func (m *GetOIDCLoginRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetGroupsForUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetGroupsForUserResponse.DiscardUnknown(m)
+func (m *GetOIDCLoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOIDCLoginRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetGroupsForUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetOIDCLoginRequest proto.InternalMessageInfo
 
-type ModifyMembersRequest struct {
-	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	Add                  []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
-	Remove               []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+type GetOIDCLoginResponse struct {
+	// The login URL generated for the OIDC object
+	LoginURL             string   `protobuf:"bytes,1,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ModifyMembersRequest) Reset()         { *m = ModifyMembersRequest{} }
-func (m *ModifyMembersRequest) String() string { return proto.CompactTextString(m) }
-func (*ModifyMembersRequest) ProtoMessage()    {}
-func (*ModifyMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{42}
+func (m *GetOIDCLoginResponse) Reset()         { *m = GetOIDCLoginResponse{} }
+func (m *GetOIDCLoginResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOIDCLoginResponse) ProtoMessage()    {}
+func (*GetOIDCLoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{44}
 }
-func (m *ModifyMembersRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetOIDCLoginResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ModifyMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetOIDCLoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ModifyMembersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetOIDCLoginResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2496,57 +2720,58 @@ func (m *ModifyMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ModifyMembersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyMembersRequest.Merge(m, src)
+func (m *GetOIDCLoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOIDCLoginResponse.Merge(m, src)
 }
-func (m *ModifyMembersRequest) XXX_Size() int {
+func (m *GetOIDCLoginResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ModifyMembersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyMembersRequest.DiscardUnknown(m)
+func (m *GetOIDCLoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOIDCLoginResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyMembersRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetOIDCLoginResponse proto.InternalMessageInfo
 
-func (m *ModifyMembersRequest) GetGroup() string {
+func (m *GetOIDCLoginResponse) GetLoginURL() string {
 	if m != nil {
-		return m.Group
+		return m.LoginURL
 	}
 	return ""
 }
 
-func (m *ModifyMembersRequest) GetAdd() []string {
-	if m != nil {
-		return m.Add
-	}
-	return nil
-}
-
-func (m *ModifyMembersRequest) GetRemove() []string {
+func (m *GetOIDCLoginResponse) GetState() string {
 	if m != nil {
-		return m.Remove
+		return m.State
 	}
-	return nil
+	return ""
 }
 
-type ModifyMembersResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetRobotTokenRequest struct {
+	// The returned token will allow the caller to access resources as this
+	// robot user
+	Robot string `protobuf:"bytes,1,opt,name=robot,proto3" json:"robot,omitempty"`
+	// ttl indicates the requested (approximate) remaining lifetime of this token,
+	// in seconds
+	TTL int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// If set, the returned token is restricted to this scope
+	Scope                *TokenScope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ModifyMembersResponse) Reset()         { *m = ModifyMembersResponse{} }
-func (m *ModifyMembersResponse) String() string { return proto.CompactTextString(m) }
-func (*ModifyMembersResponse) ProtoMessage()    {}
-func (*ModifyMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{43}
+func (m *GetRobotTokenRequest) Reset()         { *m = GetRobotTokenRequest{} }
+func (m *GetRobotTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRobotTokenRequest) ProtoMessage()    {}
+func (*GetRobotTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{45}
 }
-func (m *ModifyMembersResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetRobotTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ModifyMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRobotTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ModifyMembersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRobotTokenRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2556,36 +2781,59 @@ func (m *ModifyMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ModifyMembersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyMembersResponse.Merge(m, src)
+func (m *GetRobotTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRobotTokenRequest.Merge(m, src)
 }
-func (m *ModifyMembersResponse) XXX_Size() int {
+func (m *GetRobotTokenRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ModifyMembersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyMembersResponse.DiscardUnknown(m)
+func (m *GetRobotTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRobotTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyMembersResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRobotTokenRequest proto.InternalMessageInfo
 
-type GetGroupsRequest struct {
+func (m *GetRobotTokenRequest) GetRobot() string {
+	if m != nil {
+		return m.Robot
+	}
+	return ""
+}
+
+func (m *GetRobotTokenRequest) GetTTL() int64 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *GetRobotTokenRequest) GetScope() *TokenScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+type GetRobotTokenResponse struct {
+	// A new auth token for the requested robot
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetGroupsRequest) Reset()         { *m = GetGroupsRequest{} }
-func (m *GetGroupsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetGroupsRequest) ProtoMessage()    {}
-func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{44}
+func (m *GetRobotTokenResponse) Reset()         { *m = GetRobotTokenResponse{} }
+func (m *GetRobotTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRobotTokenResponse) ProtoMessage()    {}
+func (*GetRobotTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{46}
 }
-func (m *GetGroupsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetRobotTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRobotTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetGroupsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRobotTokenResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2595,37 +2843,49 @@ func (m *GetGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetGroupsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGroupsRequest.Merge(m, src)
+func (m *GetRobotTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRobotTokenResponse.Merge(m, src)
 }
-func (m *GetGroupsRequest) XXX_Size() int {
+func (m *GetRobotTokenResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetGroupsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGroupsRequest.DiscardUnknown(m)
+func (m *GetRobotTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRobotTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGroupsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetRobotTokenResponse proto.InternalMessageInfo
 
-type GetGroupsForPrincipalRequest struct {
-	Principal            string   `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+func (m *GetRobotTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// GetScopedTokenRequest creates a token for the caller that is restricted to
+// 'scope'. The token can't outlive the caller's own token.
+type GetScopedTokenRequest struct {
+	Scope *TokenScope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	// ttl indicates the requested (approximate) remaining lifetime of this token,
+	// in seconds
+	TTL                  int64    `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetGroupsForPrincipalRequest) Reset()         { *m = GetGroupsForPrincipalRequest{} }
-func (m *GetGroupsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
-func (*GetGroupsForPrincipalRequest) ProtoMessage()    {}
-func (*GetGroupsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{45}
+func (m *GetScopedTokenRequest) Reset()         { *m = GetScopedTokenRequest{} }
+func (m *GetScopedTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScopedTokenRequest) ProtoMessage()    {}
+func (*GetScopedTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{47}
 }
-func (m *GetGroupsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetScopedTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetGroupsForPrincipalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetScopedTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetGroupsForPrincipalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetScopedTokenRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2635,44 +2895,51 @@ func (m *GetGroupsForPrincipalRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetGroupsForPrincipalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGroupsForPrincipalRequest.Merge(m, src)
+func (m *GetScopedTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScopedTokenRequest.Merge(m, src)
 }
-func (m *GetGroupsForPrincipalRequest) XXX_Size() int {
+func (m *GetScopedTokenRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetGroupsForPrincipalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGroupsForPrincipalRequest.DiscardUnknown(m)
+func (m *GetScopedTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScopedTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGroupsForPrincipalRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetScopedTokenRequest proto.InternalMessageInfo
 
-func (m *GetGroupsForPrincipalRequest) GetPrincipal() string {
+func (m *GetScopedTokenRequest) GetScope() *TokenScope {
 	if m != nil {
-		return m.Principal
+		return m.Scope
 	}
-	return ""
+	return nil
 }
 
-type GetGroupsResponse struct {
-	Groups               []string `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+func (m *GetScopedTokenRequest) GetTTL() int64 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+type GetScopedTokenResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetGroupsResponse) Reset()         { *m = GetGroupsResponse{} }
-func (m *GetGroupsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetGroupsResponse) ProtoMessage()    {}
-func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{46}
+func (m *GetScopedTokenResponse) Reset()         { *m = GetScopedTokenResponse{} }
+func (m *GetScopedTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScopedTokenResponse) ProtoMessage()    {}
+func (*GetScopedTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{48}
 }
-func (m *GetGroupsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetScopedTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetScopedTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetGroupsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetScopedTokenResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2682,44 +2949,46 @@ func (m *GetGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetGroupsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGroupsResponse.Merge(m, src)
+func (m *GetScopedTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScopedTokenResponse.Merge(m, src)
 }
-func (m *GetGroupsResponse) XXX_Size() int {
+func (m *GetScopedTokenResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetGroupsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGroupsResponse.DiscardUnknown(m)
+func (m *GetScopedTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScopedTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGroupsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetScopedTokenResponse proto.InternalMessageInfo
 
-func (m *GetGroupsResponse) GetGroups() []string {
+func (m *GetScopedTokenResponse) GetToken() string {
 	if m != nil {
-		return m.Groups
+		return m.Token
 	}
-	return nil
+	return ""
 }
 
-type GetUsersRequest struct {
-	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+// ListAuthTokensRequest lists the tokens issued to 'subject', or to the caller
+// if it's empty
+type ListAuthTokensRequest struct {
+	Subject              string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
-func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
-func (*GetUsersRequest) ProtoMessage()    {}
-func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{47}
+func (m *ListAuthTokensRequest) Reset()         { *m = ListAuthTokensRequest{} }
+func (m *ListAuthTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuthTokensRequest) ProtoMessage()    {}
+func (*ListAuthTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{49}
 }
-func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListAuthTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUsersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListAuthTokensRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2729,44 +2998,44 @@ func (m *GetUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GetUsersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUsersRequest.Merge(m, src)
+func (m *ListAuthTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthTokensRequest.Merge(m, src)
 }
-func (m *GetUsersRequest) XXX_Size() int {
+func (m *ListAuthTokensRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUsersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUsersRequest.DiscardUnknown(m)
+func (m *ListAuthTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthTokensRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUsersRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListAuthTokensRequest proto.InternalMessageInfo
 
-func (m *GetUsersRequest) GetGroup() string {
+func (m *ListAuthTokensRequest) GetSubject() string {
 	if m != nil {
-		return m.Group
+		return m.Subject
 	}
 	return ""
 }
 
-type GetUsersResponse struct {
-	Usernames            []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListAuthTokensResponse struct {
+	Tokens               []*TokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
-func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
-func (*GetUsersResponse) ProtoMessage()    {}
-func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{48}
+func (m *ListAuthTokensResponse) Reset()         { *m = ListAuthTokensResponse{} }
+func (m *ListAuthTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuthTokensResponse) ProtoMessage()    {}
+func (*ListAuthTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{50}
 }
-func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListAuthTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUsersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListAuthTokensResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2776,45 +3045,47 @@ func (m *GetUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetUsersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUsersResponse.Merge(m, src)
+func (m *ListAuthTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthTokensResponse.Merge(m, src)
 }
-func (m *GetUsersResponse) XXX_Size() int {
+func (m *ListAuthTokensResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUsersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUsersResponse.DiscardUnknown(m)
+func (m *ListAuthTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthTokensResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUsersResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListAuthTokensResponse proto.InternalMessageInfo
 
-func (m *GetUsersResponse) GetUsernames() []string {
+func (m *ListAuthTokensResponse) GetTokens() []*TokenInfo {
 	if m != nil {
-		return m.Usernames
+		return m.Tokens
 	}
 	return nil
 }
 
-// ExtractAuthTokens returns all the hashed robot tokens that have been issued.
-// User tokens are not extracted as they can be recreated by logging in.
-type ExtractAuthTokensRequest struct {
+// RevokeAuthTokenRequest revokes a token, identified by either its value or
+// the hash returned by ListAuthTokens
+type RevokeAuthTokenRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HashedToken          string   `protobuf:"bytes,2,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExtractAuthTokensRequest) Reset()         { *m = ExtractAuthTokensRequest{} }
-func (m *ExtractAuthTokensRequest) String() string { return proto.CompactTextString(m) }
-func (*ExtractAuthTokensRequest) ProtoMessage()    {}
-func (*ExtractAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{49}
+func (m *RevokeAuthTokenRequest) Reset()         { *m = RevokeAuthTokenRequest{} }
+func (m *RevokeAuthTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAuthTokenRequest) ProtoMessage()    {}
+func (*RevokeAuthTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{51}
 }
-func (m *ExtractAuthTokensRequest) XXX_Unmarshal(b []byte) error {
+func (m *RevokeAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExtractAuthTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevokeAuthTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExtractAuthTokensRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevokeAuthTokenRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2824,37 +3095,50 @@ func (m *ExtractAuthTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ExtractAuthTokensRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExtractAuthTokensRequest.Merge(m, src)
+func (m *RevokeAuthTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAuthTokenRequest.Merge(m, src)
 }
-func (m *ExtractAuthTokensRequest) XXX_Size() int {
+func (m *RevokeAuthTokenRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExtractAuthTokensRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExtractAuthTokensRequest.DiscardUnknown(m)
+func (m *RevokeAuthTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAuthTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExtractAuthTokensRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevokeAuthTokenRequest proto.InternalMessageInfo
 
-type ExtractAuthTokensResponse struct {
-	Tokens               []*TokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *RevokeAuthTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-func (m *ExtractAuthTokensResponse) Reset()         { *m = ExtractAuthTokensResponse{} }
-func (m *ExtractAuthTokensResponse) String() string { return proto.CompactTextString(m) }
-func (*ExtractAuthTokensResponse) ProtoMessage()    {}
-func (*ExtractAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{50}
+func (m *RevokeAuthTokenRequest) GetHashedToken() string {
+	if m != nil {
+		return m.HashedToken
+	}
+	return ""
 }
-func (m *ExtractAuthTokensResponse) XXX_Unmarshal(b []byte) error {
+
+type RevokeAuthTokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAuthTokenResponse) Reset()         { *m = RevokeAuthTokenResponse{} }
+func (m *RevokeAuthTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAuthTokenResponse) ProtoMessage()    {}
+func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{52}
+}
+func (m *RevokeAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExtractAuthTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevokeAuthTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExtractAuthTokensResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevokeAuthTokenResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2864,45 +3148,38 @@ func (m *ExtractAuthTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ExtractAuthTokensResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExtractAuthTokensResponse.Merge(m, src)
+func (m *RevokeAuthTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAuthTokenResponse.Merge(m, src)
 }
-func (m *ExtractAuthTokensResponse) XXX_Size() int {
+func (m *RevokeAuthTokenResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExtractAuthTokensResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExtractAuthTokensResponse.DiscardUnknown(m)
+func (m *RevokeAuthTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAuthTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExtractAuthTokensResponse proto.InternalMessageInfo
-
-func (m *ExtractAuthTokensResponse) GetTokens() []*TokenInfo {
-	if m != nil {
-		return m.Tokens
-	}
-	return nil
-}
+var xxx_messageInfo_RevokeAuthTokenResponse proto.InternalMessageInfo
 
-// RestoreAuthToken inserts a hashed token that has previously been extracted.
-type RestoreAuthTokenRequest struct {
-	Token                *TokenInfo `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type SetGroupsForUserRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Groups               []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RestoreAuthTokenRequest) Reset()         { *m = RestoreAuthTokenRequest{} }
-func (m *RestoreAuthTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*RestoreAuthTokenRequest) ProtoMessage()    {}
-func (*RestoreAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{51}
+func (m *SetGroupsForUserRequest) Reset()         { *m = SetGroupsForUserRequest{} }
+func (m *SetGroupsForUserRequest) String() string { return proto.CompactTextString(m) }
+func (*SetGroupsForUserRequest) ProtoMessage()    {}
+func (*SetGroupsForUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{53}
 }
-func (m *RestoreAuthTokenRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetGroupsForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RestoreAuthTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetGroupsForUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RestoreAuthTokenRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetGroupsForUserRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2912,43 +3189,50 @@ func (m *RestoreAuthTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *RestoreAuthTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreAuthTokenRequest.Merge(m, src)
+func (m *SetGroupsForUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupsForUserRequest.Merge(m, src)
 }
-func (m *RestoreAuthTokenRequest) XXX_Size() int {
+func (m *SetGroupsForUserRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RestoreAuthTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestoreAuthTokenRequest.DiscardUnknown(m)
+func (m *SetGroupsForUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupsForUserRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RestoreAuthTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetGroupsForUserRequest proto.InternalMessageInfo
 
-func (m *RestoreAuthTokenRequest) GetToken() *TokenInfo {
+func (m *SetGroupsForUserRequest) GetUsername() string {
 	if m != nil {
-		return m.Token
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SetGroupsForUserRequest) GetGroups() []string {
+	if m != nil {
+		return m.Groups
 	}
 	return nil
 }
 
-type RestoreAuthTokenResponse struct {
+type SetGroupsForUserResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RestoreAuthTokenResponse) Reset()         { *m = RestoreAuthTokenResponse{} }
-func (m *RestoreAuthTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*RestoreAuthTokenResponse) ProtoMessage()    {}
-func (*RestoreAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{52}
+func (m *SetGroupsForUserResponse) Reset()         { *m = SetGroupsForUserResponse{} }
+func (m *SetGroupsForUserResponse) String() string { return proto.CompactTextString(m) }
+func (*SetGroupsForUserResponse) ProtoMessage()    {}
+func (*SetGroupsForUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{54}
 }
-func (m *RestoreAuthTokenResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetGroupsForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RestoreAuthTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetGroupsForUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RestoreAuthTokenResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetGroupsForUserResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2958,37 +3242,39 @@ func (m *RestoreAuthTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *RestoreAuthTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreAuthTokenResponse.Merge(m, src)
+func (m *SetGroupsForUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupsForUserResponse.Merge(m, src)
 }
-func (m *RestoreAuthTokenResponse) XXX_Size() int {
+func (m *SetGroupsForUserResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RestoreAuthTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestoreAuthTokenResponse.DiscardUnknown(m)
+func (m *SetGroupsForUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupsForUserResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RestoreAuthTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetGroupsForUserResponse proto.InternalMessageInfo
 
-type RevokeAuthTokensForUserRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+type ModifyMembersRequest struct {
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Add                  []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove               []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RevokeAuthTokensForUserRequest) Reset()         { *m = RevokeAuthTokensForUserRequest{} }
-func (m *RevokeAuthTokensForUserRequest) String() string { return proto.CompactTextString(m) }
-func (*RevokeAuthTokensForUserRequest) ProtoMessage()    {}
-func (*RevokeAuthTokensForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{53}
+func (m *ModifyMembersRequest) Reset()         { *m = ModifyMembersRequest{} }
+func (m *ModifyMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyMembersRequest) ProtoMessage()    {}
+func (*ModifyMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{55}
 }
-func (m *RevokeAuthTokensForUserRequest) XXX_Unmarshal(b []byte) error {
+func (m *ModifyMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RevokeAuthTokensForUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ModifyMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RevokeAuthTokensForUserRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ModifyMembersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2998,43 +3284,57 @@ func (m *RevokeAuthTokensForUserRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *RevokeAuthTokensForUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RevokeAuthTokensForUserRequest.Merge(m, src)
+func (m *ModifyMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyMembersRequest.Merge(m, src)
 }
-func (m *RevokeAuthTokensForUserRequest) XXX_Size() int {
+func (m *ModifyMembersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RevokeAuthTokensForUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RevokeAuthTokensForUserRequest.DiscardUnknown(m)
+func (m *ModifyMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyMembersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RevokeAuthTokensForUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_ModifyMembersRequest proto.InternalMessageInfo
 
-func (m *RevokeAuthTokensForUserRequest) GetUsername() string {
+func (m *ModifyMembersRequest) GetGroup() string {
 	if m != nil {
-		return m.Username
+		return m.Group
 	}
 	return ""
 }
 
-type RevokeAuthTokensForUserResponse struct {
+func (m *ModifyMembersRequest) GetAdd() []string {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *ModifyMembersRequest) GetRemove() []string {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+type ModifyMembersResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RevokeAuthTokensForUserResponse) Reset()         { *m = RevokeAuthTokensForUserResponse{} }
-func (m *RevokeAuthTokensForUserResponse) String() string { return proto.CompactTextString(m) }
-func (*RevokeAuthTokensForUserResponse) ProtoMessage()    {}
-func (*RevokeAuthTokensForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{54}
+func (m *ModifyMembersResponse) Reset()         { *m = ModifyMembersResponse{} }
+func (m *ModifyMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyMembersResponse) ProtoMessage()    {}
+func (*ModifyMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{56}
 }
-func (m *RevokeAuthTokensForUserResponse) XXX_Unmarshal(b []byte) error {
+func (m *ModifyMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RevokeAuthTokensForUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ModifyMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RevokeAuthTokensForUserResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ModifyMembersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3044,36 +3344,36 @@ func (m *RevokeAuthTokensForUserResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *RevokeAuthTokensForUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RevokeAuthTokensForUserResponse.Merge(m, src)
+func (m *ModifyMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyMembersResponse.Merge(m, src)
 }
-func (m *RevokeAuthTokensForUserResponse) XXX_Size() int {
+func (m *ModifyMembersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RevokeAuthTokensForUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RevokeAuthTokensForUserResponse.DiscardUnknown(m)
+func (m *ModifyMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyMembersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RevokeAuthTokensForUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_ModifyMembersResponse proto.InternalMessageInfo
 
-type DeleteExpiredAuthTokensRequest struct {
+type GetGroupsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteExpiredAuthTokensRequest) Reset()         { *m = DeleteExpiredAuthTokensRequest{} }
-func (m *DeleteExpiredAuthTokensRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteExpiredAuthTokensRequest) ProtoMessage()    {}
-func (*DeleteExpiredAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{55}
-}
-func (m *DeleteExpiredAuthTokensRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetGroupsRequest) Reset()         { *m = GetGroupsRequest{} }
+func (m *GetGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGroupsRequest) ProtoMessage()    {}
+func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{57}
+}
+func (m *GetGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteExpiredAuthTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteExpiredAuthTokensRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetGroupsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3083,36 +3383,37 @@ func (m *DeleteExpiredAuthTokensRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *DeleteExpiredAuthTokensRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteExpiredAuthTokensRequest.Merge(m, src)
+func (m *GetGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupsRequest.Merge(m, src)
 }
-func (m *DeleteExpiredAuthTokensRequest) XXX_Size() int {
+func (m *GetGroupsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteExpiredAuthTokensRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteExpiredAuthTokensRequest.DiscardUnknown(m)
+func (m *GetGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteExpiredAuthTokensRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetGroupsRequest proto.InternalMessageInfo
 
-type DeleteExpiredAuthTokensResponse struct {
+type GetGroupsForPrincipalRequest struct {
+	Principal            string   `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteExpiredAuthTokensResponse) Reset()         { *m = DeleteExpiredAuthTokensResponse{} }
-func (m *DeleteExpiredAuthTokensResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteExpiredAuthTokensResponse) ProtoMessage()    {}
-func (*DeleteExpiredAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{56}
+func (m *GetGroupsForPrincipalRequest) Reset()         { *m = GetGroupsForPrincipalRequest{} }
+func (m *GetGroupsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGroupsForPrincipalRequest) ProtoMessage()    {}
+func (*GetGroupsForPrincipalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{58}
 }
-func (m *DeleteExpiredAuthTokensResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetGroupsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteExpiredAuthTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetGroupsForPrincipalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteExpiredAuthTokensResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetGroupsForPrincipalRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3122,2724 +3423,2875 @@ func (m *DeleteExpiredAuthTokensResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *DeleteExpiredAuthTokensResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteExpiredAuthTokensResponse.Merge(m, src)
+func (m *GetGroupsForPrincipalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupsForPrincipalRequest.Merge(m, src)
 }
-func (m *DeleteExpiredAuthTokensResponse) XXX_Size() int {
+func (m *GetGroupsForPrincipalRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteExpiredAuthTokensResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteExpiredAuthTokensResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DeleteExpiredAuthTokensResponse proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterEnum("auth_v2.Permission", Permission_name, Permission_value)
-	proto.RegisterEnum("auth_v2.ResourceType", ResourceType_name, ResourceType_value)
-	proto.RegisterType((*ActivateRequest)(nil), "auth_v2.ActivateRequest")
-	proto.RegisterType((*ActivateResponse)(nil), "auth_v2.ActivateResponse")
-	proto.RegisterType((*DeactivateRequest)(nil), "auth_v2.DeactivateRequest")
-	proto.RegisterType((*DeactivateResponse)(nil), "auth_v2.DeactivateResponse")
-	proto.RegisterType((*RotateRootTokenRequest)(nil), "auth_v2.RotateRootTokenRequest")
-	proto.RegisterType((*RotateRootTokenResponse)(nil), "auth_v2.RotateRootTokenResponse")
-	proto.RegisterType((*OIDCConfig)(nil), "auth_v2.OIDCConfig")
-	proto.RegisterType((*GetConfigurationRequest)(nil), "auth_v2.GetConfigurationRequest")
-	proto.RegisterType((*GetConfigurationResponse)(nil), "auth_v2.GetConfigurationResponse")
-	proto.RegisterType((*SetConfigurationRequest)(nil), "auth_v2.SetConfigurationRequest")
-	proto.RegisterType((*SetConfigurationResponse)(nil), "auth_v2.SetConfigurationResponse")
-	proto.RegisterType((*TokenInfo)(nil), "auth_v2.TokenInfo")
-	proto.RegisterType((*AuthenticateRequest)(nil), "auth_v2.AuthenticateRequest")
-	proto.RegisterType((*AuthenticateResponse)(nil), "auth_v2.AuthenticateResponse")
-	proto.RegisterType((*WhoAmIRequest)(nil), "auth_v2.WhoAmIRequest")
-	proto.RegisterType((*WhoAmIResponse)(nil), "auth_v2.WhoAmIResponse")
-	proto.RegisterType((*GetRolesForPermissionRequest)(nil), "auth_v2.GetRolesForPermissionRequest")
-	proto.RegisterType((*GetRolesForPermissionResponse)(nil), "auth_v2.GetRolesForPermissionResponse")
-	proto.RegisterType((*Roles)(nil), "auth_v2.Roles")
-	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Roles.RolesEntry")
-	proto.RegisterType((*RoleBinding)(nil), "auth_v2.RoleBinding")
-	proto.RegisterMapType((map[string]*Roles)(nil), "auth_v2.RoleBinding.EntriesEntry")
-	proto.RegisterType((*Resource)(nil), "auth_v2.Resource")
-	proto.RegisterType((*Users)(nil), "auth_v2.Users")
-	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Users.UsernamesEntry")
-	proto.RegisterType((*Groups)(nil), "auth_v2.Groups")
-	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Groups.GroupsEntry")
-	proto.RegisterType((*Role)(nil), "auth_v2.Role")
-	proto.RegisterType((*AuthorizeRequest)(nil), "auth_v2.AuthorizeRequest")
-	proto.RegisterType((*AuthorizeResponse)(nil), "auth_v2.AuthorizeResponse")
-	proto.RegisterType((*GetPermissionsRequest)(nil), "auth_v2.GetPermissionsRequest")
-	proto.RegisterType((*GetPermissionsForPrincipalRequest)(nil), "auth_v2.GetPermissionsForPrincipalRequest")
-	proto.RegisterType((*GetPermissionsResponse)(nil), "auth_v2.GetPermissionsResponse")
-	proto.RegisterType((*ModifyRoleBindingRequest)(nil), "auth_v2.ModifyRoleBindingRequest")
-	proto.RegisterType((*ModifyRoleBindingResponse)(nil), "auth_v2.ModifyRoleBindingResponse")
-	proto.RegisterType((*GetRoleBindingRequest)(nil), "auth_v2.GetRoleBindingRequest")
-	proto.RegisterType((*GetRoleBindingResponse)(nil), "auth_v2.GetRoleBindingResponse")
-	proto.RegisterType((*SessionInfo)(nil), "auth_v2.SessionInfo")
-	proto.RegisterType((*GetOIDCLoginRequest)(nil), "auth_v2.GetOIDCLoginRequest")
-	proto.RegisterType((*GetOIDCLoginResponse)(nil), "auth_v2.GetOIDCLoginResponse")
-	proto.RegisterType((*GetRobotTokenRequest)(nil), "auth_v2.GetRobotTokenRequest")
-	proto.RegisterType((*GetRobotTokenResponse)(nil), "auth_v2.GetRobotTokenResponse")
-	proto.RegisterType((*RevokeAuthTokenRequest)(nil), "auth_v2.RevokeAuthTokenRequest")
-	proto.RegisterType((*RevokeAuthTokenResponse)(nil), "auth_v2.RevokeAuthTokenResponse")
-	proto.RegisterType((*SetGroupsForUserRequest)(nil), "auth_v2.SetGroupsForUserRequest")
-	proto.RegisterType((*SetGroupsForUserResponse)(nil), "auth_v2.SetGroupsForUserResponse")
-	proto.RegisterType((*ModifyMembersRequest)(nil), "auth_v2.ModifyMembersRequest")
-	proto.RegisterType((*ModifyMembersResponse)(nil), "auth_v2.ModifyMembersResponse")
-	proto.RegisterType((*GetGroupsRequest)(nil), "auth_v2.GetGroupsRequest")
-	proto.RegisterType((*GetGroupsForPrincipalRequest)(nil), "auth_v2.GetGroupsForPrincipalRequest")
-	proto.RegisterType((*GetGroupsResponse)(nil), "auth_v2.GetGroupsResponse")
-	proto.RegisterType((*GetUsersRequest)(nil), "auth_v2.GetUsersRequest")
-	proto.RegisterType((*GetUsersResponse)(nil), "auth_v2.GetUsersResponse")
-	proto.RegisterType((*ExtractAuthTokensRequest)(nil), "auth_v2.ExtractAuthTokensRequest")
-	proto.RegisterType((*ExtractAuthTokensResponse)(nil), "auth_v2.ExtractAuthTokensResponse")
-	proto.RegisterType((*RestoreAuthTokenRequest)(nil), "auth_v2.RestoreAuthTokenRequest")
-	proto.RegisterType((*RestoreAuthTokenResponse)(nil), "auth_v2.RestoreAuthTokenResponse")
-	proto.RegisterType((*RevokeAuthTokensForUserRequest)(nil), "auth_v2.RevokeAuthTokensForUserRequest")
-	proto.RegisterType((*RevokeAuthTokensForUserResponse)(nil), "auth_v2.RevokeAuthTokensForUserResponse")
-	proto.RegisterType((*DeleteExpiredAuthTokensRequest)(nil), "auth_v2.DeleteExpiredAuthTokensRequest")
-	proto.RegisterType((*DeleteExpiredAuthTokensResponse)(nil), "auth_v2.DeleteExpiredAuthTokensResponse")
+func (m *GetGroupsForPrincipalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupsForPrincipalRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
+var xxx_messageInfo_GetGroupsForPrincipalRequest proto.InternalMessageInfo
 
-var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2821 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x59, 0x77, 0xdb, 0xc6,
-	0xf5, 0x37, 0x24, 0x5b, 0x22, 0xaf, 0x36, 0x78, 0x44, 0x49, 0x14, 0x64, 0x89, 0x12, 0x1c, 0xc7,
-	0xcb, 0xff, 0x1f, 0x29, 0x51, 0x9a, 0xd6, 0x49, 0xdc, 0x07, 0x2e, 0x10, 0x8d, 0x84, 0x22, 0x79,
-	0x00, 0xd0, 0x8e, 0x7b, 0x7a, 0x8a, 0x52, 0xe4, 0x58, 0x42, 0x2d, 0x11, 0x0c, 0x00, 0xaa, 0x56,
-	0xda, 0xb4, 0x4d, 0xf7, 0x3d, 0x69, 0xd3, 0xf6, 0x5b, 0xf4, 0xa5, 0xfd, 0x12, 0xe9, 0x9e, 0xae,
-	0x8f, 0x6e, 0x8e, 0x3e, 0x42, 0x1f, 0xfa, 0xdc, 0x33, 0x83, 0x01, 0x30, 0x00, 0x41, 0xd9, 0x49,
-	0x4e, 0x5e, 0x24, 0xcc, 0xbd, 0xbf, 0xbb, 0xcc, 0x9d, 0x3b, 0x83, 0x3b, 0x17, 0x84, 0xb9, 0xf6,
-	0xc0, 0x3b, 0xd8, 0x22, 0x7f, 0x36, 0xfb, 0x8e, 0xed, 0xd9, 0x68, 0x92, 0x3c, 0x9b, 0xc7, 0xdb,
-	0x52, 0x6e, 0xdf, 0xde, 0xb7, 0x29, 0x6d, 0x8b, 0x3c, 0xf9, 0x6c, 0xa9, 0xb0, 0x6f, 0xdb, 0xfb,
-	0x87, 0x78, 0x8b, 0x8e, 0xf6, 0x06, 0xf7, 0xb7, 0x3c, 0xeb, 0x08, 0xbb, 0x5e, 0xfb, 0xa8, 0xef,
-	0x03, 0xe4, 0x67, 0x61, 0xae, 0xd8, 0xf1, 0xac, 0xe3, 0xb6, 0x87, 0x35, 0xfc, 0xfa, 0x00, 0xbb,
-	0x1e, 0x5a, 0x05, 0x70, 0x6c, 0xdb, 0x33, 0x3d, 0xfb, 0x01, 0xee, 0xe5, 0x85, 0x75, 0xe1, 0x5a,
-	0x56, 0xcb, 0x12, 0x8a, 0x41, 0x08, 0xf2, 0x73, 0x20, 0x46, 0x12, 0x6e, 0xdf, 0xee, 0xb9, 0x98,
-	0x88, 0xf4, 0xdb, 0x9d, 0x83, 0xb8, 0x08, 0xa1, 0xf8, 0x22, 0xf3, 0x70, 0xb1, 0x82, 0xdb, 0x71,
-	0x33, 0x72, 0x0e, 0x10, 0x4f, 0xf4, 0x35, 0xc9, 0x9f, 0x81, 0x45, 0xcd, 0xf6, 0x08, 0x25, 0x30,
-	0xf8, 0x84, 0x6e, 0xdd, 0x84, 0xa5, 0x21, 0xc1, 0xc8, 0xbb, 0xb3, 0x24, 0x3f, 0x18, 0x03, 0x68,
-	0xa8, 0x95, 0x72, 0xd9, 0xee, 0xdd, 0xb7, 0xf6, 0xd1, 0x22, 0x4c, 0x58, 0xae, 0x3b, 0xc0, 0x0e,
-	0x43, 0xb2, 0x11, 0xba, 0x0e, 0xd9, 0xce, 0xa1, 0x85, 0x7b, 0x9e, 0x69, 0x75, 0xf3, 0x63, 0x84,
-	0x55, 0x9a, 0x3e, 0x7d, 0x54, 0xc8, 0x94, 0x29, 0x51, 0xad, 0x68, 0x19, 0x9f, 0xad, 0x76, 0xd1,
-	0x65, 0x98, 0x61, 0x50, 0x17, 0x77, 0x1c, 0xec, 0xe5, 0xc7, 0xa9, 0xa6, 0x69, 0x9f, 0xa8, 0x53,
-	0x1a, 0xda, 0x86, 0x69, 0x07, 0x77, 0x2d, 0x07, 0x77, 0x3c, 0x73, 0xe0, 0x58, 0xf9, 0xf3, 0x54,
-	0xe5, 0xdc, 0xe9, 0xa3, 0xc2, 0x94, 0xc6, 0xe8, 0x2d, 0x4d, 0xd5, 0xa6, 0x02, 0x50, 0xcb, 0xb1,
-	0x88, 0x6f, 0x6e, 0xc7, 0xee, 0x63, 0x37, 0x7f, 0x61, 0x7d, 0x9c, 0xf8, 0xe6, 0x8f, 0xd0, 0xa7,
-	0x60, 0xd1, 0xc1, 0xaf, 0x0f, 0x2c, 0x07, 0x9b, 0xf8, 0xa8, 0x6d, 0x1d, 0x9a, 0xc7, 0xd8, 0xb1,
-	0xee, 0x5b, 0xb8, 0x9b, 0x9f, 0x58, 0x17, 0xae, 0x65, 0xb4, 0x1c, 0xe3, 0x2a, 0x84, 0x79, 0x87,
-	0xf1, 0xd0, 0x75, 0x10, 0x0f, 0xed, 0x4e, 0xfb, 0xf0, 0xc0, 0x76, 0x3d, 0x93, 0xcd, 0x79, 0x92,
-	0xe2, 0xe7, 0x42, 0xba, 0xea, 0x4f, 0xfe, 0xb3, 0xb0, 0x32, 0x70, 0xb1, 0x63, 0xb6, 0x3b, 0x1d,
-	0xec, 0xba, 0xd6, 0xde, 0x21, 0x66, 0x02, 0x26, 0x01, 0xe5, 0x33, 0x74, 0x7e, 0x79, 0x02, 0x29,
-	0x86, 0x08, 0x5f, 0xf4, 0xb6, 0xed, 0x7a, 0xf2, 0x32, 0x2c, 0x55, 0xb1, 0xe7, 0x07, 0x78, 0xe0,
-	0xb4, 0x3d, 0xcb, 0x0e, 0x96, 0x55, 0x6e, 0x41, 0x7e, 0x98, 0xc5, 0x16, 0xee, 0x45, 0x98, 0xe9,
-	0xf0, 0x0c, 0xba, 0x22, 0x53, 0xdb, 0xf3, 0x9b, 0x2c, 0xe9, 0x37, 0xa3, 0x65, 0xd3, 0xe2, 0x48,
-	0xd9, 0x80, 0x25, 0x3d, 0xdd, 0xe2, 0xc7, 0xd1, 0x2a, 0x41, 0x5e, 0x1f, 0xe1, 0xac, 0xfc, 0x1b,
-	0x01, 0xb2, 0x34, 0xa1, 0xd4, 0xde, 0x7d, 0x1b, 0xe5, 0x61, 0xd2, 0x1d, 0xec, 0x7d, 0x09, 0x77,
-	0x3c, 0x96, 0x46, 0xc1, 0x10, 0xe9, 0x00, 0xf8, 0x61, 0xdf, 0x62, 0xb6, 0xc7, 0xa8, 0x6d, 0x69,
-	0xd3, 0xdf, 0xa7, 0x9b, 0xc1, 0x3e, 0xdd, 0x34, 0x82, 0x7d, 0x5a, 0x5a, 0xfa, 0xcf, 0xa3, 0xc2,
-	0x5c, 0x77, 0xef, 0x25, 0x39, 0x92, 0x92, 0xdf, 0xf9, 0x77, 0x41, 0xd0, 0x38, 0x35, 0xe8, 0xd3,
-	0x30, 0x7d, 0xd0, 0x76, 0x0f, 0x70, 0x97, 0x25, 0x39, 0x4d, 0xb8, 0xd2, 0x7c, 0x20, 0x4a, 0x89,
-	0x26, 0x41, 0xc8, 0xda, 0x94, 0x0f, 0xf4, 0x73, 0xff, 0x0b, 0x30, 0x5f, 0x1c, 0x78, 0x07, 0xb8,
-	0xe7, 0x59, 0x1d, 0xee, 0x08, 0xf8, 0x7f, 0x00, 0xdb, 0xea, 0x76, 0x4c, 0x97, 0x6c, 0x28, 0x7f,
-	0x02, 0xa5, 0x99, 0xd3, 0x47, 0x85, 0x2c, 0x09, 0x8d, 0x4e, 0x77, 0x59, 0x96, 0x00, 0xe8, 0x23,
-	0x5a, 0x86, 0x8c, 0x15, 0x18, 0x1e, 0xf3, 0x27, 0x6b, 0x31, 0xfd, 0x2f, 0x40, 0x2e, 0xae, 0xff,
-	0xc9, 0x0e, 0x8c, 0x39, 0x98, 0xb9, 0x7b, 0x60, 0x17, 0x8f, 0xd4, 0x20, 0x4b, 0xde, 0x12, 0x60,
-	0x36, 0xa0, 0x30, 0x15, 0x12, 0x64, 0x48, 0xbe, 0xf5, 0xda, 0x47, 0xcc, 0x43, 0x2d, 0x1c, 0x7f,
-	0x22, 0x31, 0x96, 0x75, 0xb8, 0x54, 0xc5, 0x9e, 0x66, 0x1f, 0x62, 0x77, 0xc7, 0x76, 0x9a, 0xd8,
-	0x39, 0xb2, 0x5c, 0x97, 0xcb, 0xab, 0xe7, 0x01, 0xfa, 0x21, 0x91, 0xba, 0x34, 0xcb, 0x25, 0x15,
-	0x87, 0xe7, 0x60, 0x72, 0x05, 0x56, 0x47, 0x28, 0x65, 0xd3, 0xbc, 0x0c, 0x17, 0x1c, 0xc2, 0xcd,
-	0x0b, 0xeb, 0xe3, 0xd7, 0xa6, 0xb6, 0x67, 0x42, 0x85, 0x44, 0x46, 0xf3, 0x79, 0xb2, 0x03, 0x17,
-	0xa8, 0x0a, 0xb4, 0x15, 0x47, 0x2f, 0xc7, 0xd0, 0xae, 0xff, 0x57, 0xe9, 0x79, 0xce, 0x09, 0x93,
-	0x94, 0x6e, 0x02, 0x44, 0x44, 0x24, 0xc2, 0xf8, 0x03, 0x7c, 0xc2, 0xc2, 0x49, 0x1e, 0x51, 0x0e,
-	0x2e, 0x1c, 0xb7, 0x0f, 0x07, 0x98, 0x06, 0x31, 0xa3, 0xf9, 0x83, 0x97, 0xc6, 0x6e, 0x0a, 0xf2,
-	0xaf, 0x04, 0x98, 0x22, 0xa2, 0x25, 0xab, 0xd7, 0xb5, 0x7a, 0xfb, 0xe8, 0x65, 0x98, 0xc4, 0x3d,
-	0xcf, 0xb1, 0x42, 0xe3, 0x1b, 0x31, 0xe3, 0x0c, 0xb6, 0xa9, 0xf8, 0x18, 0xdf, 0x89, 0x40, 0x42,
-	0x7a, 0x05, 0xa6, 0x79, 0x46, 0x8a, 0x23, 0x4f, 0xf1, 0x8e, 0x4c, 0x6d, 0xcf, 0xc6, 0x67, 0xc6,
-	0x3b, 0xa6, 0x42, 0x46, 0xc3, 0xae, 0x3d, 0x70, 0x3a, 0x18, 0x5d, 0x87, 0xf3, 0xde, 0x49, 0x1f,
-	0xb3, 0xd5, 0x58, 0x88, 0x84, 0x18, 0xc0, 0x38, 0xe9, 0x63, 0x8d, 0x42, 0x10, 0x82, 0xf3, 0x34,
-	0x97, 0xfc, 0x0c, 0xa6, 0xcf, 0xf2, 0x37, 0x05, 0xb8, 0xd0, 0x72, 0xb1, 0xe3, 0xa2, 0x97, 0x21,
-	0x1b, 0x64, 0x57, 0x30, 0xbf, 0xd5, 0x50, 0x1b, 0x85, 0xd0, 0xbf, 0x94, 0xef, 0xcf, 0x2d, 0xc2,
-	0x4b, 0xb7, 0x60, 0x36, 0xce, 0xfc, 0x50, 0x81, 0x7e, 0x08, 0x13, 0x55, 0xc7, 0x1e, 0xf4, 0x5d,
-	0xf4, 0x3c, 0x4c, 0xec, 0xd3, 0x27, 0xe6, 0xc1, 0x4a, 0xe8, 0x81, 0x0f, 0x60, 0xff, 0x7c, 0xfb,
-	0x0c, 0x2a, 0xbd, 0x08, 0x53, 0x1c, 0xf9, 0x43, 0x59, 0x7e, 0x5b, 0x80, 0xf3, 0x24, 0xbc, 0x61,
-	0x6c, 0x84, 0x28, 0x36, 0xe8, 0x05, 0x98, 0x8a, 0xf2, 0xd8, 0xcd, 0x8f, 0xad, 0x8f, 0x8f, 0xca,
-	0x77, 0x1e, 0x87, 0x6e, 0xc1, 0xac, 0xc3, 0x82, 0x6f, 0x92, 0xb8, 0xbb, 0xf9, 0x71, 0x2a, 0x39,
-	0x62, 0x6d, 0x66, 0x1c, 0x6e, 0xe4, 0xca, 0x0f, 0x41, 0x24, 0xe7, 0x89, 0xed, 0x58, 0x6f, 0x84,
-	0x87, 0xd5, 0x33, 0x90, 0x09, 0x40, 0xec, 0x28, 0xbf, 0x38, 0xa4, 0x4b, 0x0b, 0x21, 0x1f, 0xd1,
-	0x6f, 0xf9, 0xb7, 0x02, 0x5c, 0xe4, 0x4c, 0xb3, 0xdd, 0xb9, 0x06, 0xd0, 0x0e, 0x88, 0x5d, 0x6a,
-	0x3d, 0xa3, 0x71, 0x14, 0xf4, 0x1c, 0x64, 0xdd, 0xb6, 0x67, 0xb9, 0xf4, 0x5d, 0x7c, 0x86, 0xa9,
-	0x08, 0x85, 0x9e, 0x81, 0x49, 0x4a, 0xed, 0xed, 0xb3, 0xc8, 0xa4, 0x0a, 0x04, 0x18, 0x74, 0x09,
-	0xb2, 0x7d, 0xc7, 0xea, 0x75, 0xac, 0x7e, 0xfb, 0xd0, 0xaf, 0x21, 0xb4, 0x88, 0x20, 0xef, 0xc0,
-	0x42, 0x15, 0x7b, 0x91, 0x9c, 0xfb, 0xd1, 0x82, 0x26, 0xf7, 0x61, 0x23, 0xae, 0x87, 0x1c, 0x56,
-	0x81, 0x95, 0x8f, 0xb8, 0x10, 0x31, 0xcf, 0xc7, 0x92, 0x9e, 0x63, 0x58, 0x4c, 0x7a, 0xce, 0x62,
-	0x9e, 0x58, 0x40, 0xe1, 0x09, 0x13, 0x2f, 0x17, 0x1c, 0x8d, 0x63, 0xb4, 0x74, 0x62, 0x27, 0xe7,
-	0x9b, 0x90, 0xdf, 0xb5, 0xbb, 0xd6, 0xfd, 0x13, 0xee, 0x8c, 0xfa, 0x24, 0xe6, 0x13, 0x99, 0x1f,
-	0xe7, 0xcd, 0xaf, 0xc0, 0x72, 0x8a, 0x79, 0x56, 0x51, 0xf8, 0x8b, 0xf7, 0xb1, 0x1d, 0x93, 0x6f,
-	0xd3, 0x50, 0xa6, 0x58, 0x40, 0x9b, 0x30, 0xb9, 0xe7, 0x93, 0x98, 0x9e, 0x5c, 0xda, 0x99, 0xad,
-	0x05, 0x20, 0xf9, 0x8b, 0x30, 0xa5, 0x63, 0x1a, 0x4f, 0x5a, 0xe4, 0xe4, 0xe0, 0x42, 0xcf, 0xee,
-	0x75, 0x82, 0x73, 0xc1, 0x1f, 0x10, 0x2a, 0x2d, 0x42, 0x59, 0x0c, 0xfc, 0x01, 0xba, 0x02, 0xb3,
-	0x1d, 0xbb, 0x77, 0x8c, 0x1d, 0x22, 0x6d, 0x62, 0xc7, 0xa1, 0x35, 0x4a, 0x86, 0x56, 0x58, 0x8c,
-	0xaa, 0x38, 0x8e, 0xbc, 0x00, 0xf3, 0x55, 0xec, 0x91, 0x32, 0xa3, 0x66, 0xef, 0x5b, 0x61, 0x95,
-	0x78, 0x17, 0x72, 0x71, 0x32, 0x9b, 0xc0, 0x75, 0xc8, 0x1e, 0x12, 0x82, 0x39, 0x70, 0x0e, 0x59,
-	0x9d, 0x42, 0x8b, 0x72, 0x8a, 0x6a, 0x69, 0x35, 0x2d, 0x43, 0xd9, 0x2d, 0x87, 0x2e, 0x80, 0x5f,
-	0xce, 0x30, 0xb7, 0xe8, 0x40, 0xae, 0x52, 0xc5, 0x9a, 0xbd, 0x97, 0xb8, 0x6d, 0xd0, 0xe5, 0xda,
-	0xb3, 0x83, 0xea, 0xcd, 0x1f, 0xa0, 0x65, 0x18, 0xf7, 0x3c, 0x7f, 0x62, 0xe3, 0xa5, 0xc9, 0xd3,
-	0x47, 0x85, 0x71, 0xc3, 0xa8, 0x69, 0x84, 0x26, 0x3f, 0xc3, 0x16, 0x6b, 0x2f, 0x79, 0xfb, 0xc8,
-	0xc1, 0x05, 0xbe, 0xca, 0xf1, 0x07, 0xf2, 0x26, 0x2c, 0x6a, 0xf8, 0xd8, 0x7e, 0x80, 0xc9, 0x99,
-	0x92, 0xb4, 0x9c, 0x82, 0x5f, 0x86, 0xa5, 0x21, 0x3c, 0x4b, 0x93, 0x5d, 0x5a, 0xea, 0xfa, 0x67,
-	0xfc, 0x8e, 0xed, 0x90, 0x37, 0x4d, 0xa0, 0xeb, 0xac, 0x1a, 0x69, 0x31, 0x7c, 0x99, 0xf8, 0x1b,
-	0x82, 0x8d, 0x58, 0x8d, 0x9b, 0x50, 0xc7, 0x4c, 0xdd, 0x81, 0x9c, 0x9f, 0xae, 0xbb, 0xf8, 0x68,
-	0x0f, 0x3b, 0x2e, 0xe7, 0x33, 0x95, 0x0e, 0x7c, 0xa6, 0x03, 0xf2, 0xaa, 0x69, 0x77, 0xbb, 0x4c,
-	0x3d, 0x79, 0x24, 0x36, 0x1d, 0x7c, 0x64, 0x1f, 0x63, 0xb6, 0x0b, 0xd8, 0x48, 0x5e, 0x82, 0x85,
-	0x84, 0x5e, 0x66, 0x10, 0x81, 0x58, 0x0d, 0x9c, 0x09, 0x72, 0xe1, 0x16, 0xad, 0xc3, 0x42, 0x07,
-	0x87, 0x8e, 0xa1, 0xd8, 0x3e, 0x14, 0x92, 0xe7, 0xca, 0xff, 0xc1, 0x45, 0x4e, 0x23, 0x5b, 0xa3,
-	0xc5, 0xd8, 0x8b, 0x35, 0x8a, 0xc5, 0x55, 0x98, 0xab, 0x62, 0x8f, 0xbe, 0xde, 0xcf, 0x9c, 0xaa,
-	0xfc, 0x2c, 0xf5, 0x93, 0x01, 0x99, 0xd2, 0x4b, 0xc9, 0x92, 0x21, 0xcb, 0xd5, 0x04, 0x24, 0xcc,
-	0xca, 0x43, 0xcf, 0x69, 0x77, 0xbc, 0x70, 0x45, 0xc3, 0x19, 0x56, 0x61, 0x39, 0x85, 0xc7, 0xd4,
-	0xde, 0x80, 0x09, 0x9a, 0x12, 0x41, 0x11, 0x80, 0xc2, 0x2d, 0x1b, 0xde, 0x3e, 0x34, 0x86, 0x90,
-	0xcb, 0x24, 0x6b, 0x5c, 0xcf, 0x76, 0x86, 0xd3, 0xec, 0x1a, 0x9f, 0x66, 0xe9, 0x5a, 0x58, 0xea,
-	0x49, 0x90, 0x1f, 0x56, 0xc2, 0xd6, 0xe7, 0x16, 0xac, 0x25, 0xd2, 0xf2, 0x43, 0xa4, 0xa0, 0xbc,
-	0x01, 0x85, 0x91, 0xd2, 0xcc, 0xc0, 0x3a, 0xac, 0x55, 0xf0, 0x21, 0xf6, 0xb0, 0x42, 0x0a, 0x71,
-	0xdc, 0x1d, 0x0e, 0xd6, 0x06, 0x14, 0x46, 0x22, 0x7c, 0x25, 0x37, 0xde, 0x9d, 0x03, 0x88, 0x5e,
-	0x0b, 0x68, 0x11, 0x50, 0x53, 0xd1, 0x76, 0x55, 0x5d, 0x57, 0x1b, 0x75, 0xb3, 0x55, 0x7f, 0xb5,
-	0xde, 0xb8, 0x5b, 0x17, 0xcf, 0xa1, 0x15, 0x58, 0x2a, 0xd7, 0x5a, 0xba, 0xa1, 0x68, 0xe6, 0x6e,
-	0xa3, 0xa2, 0xee, 0xdc, 0x33, 0x4b, 0x6a, 0xbd, 0xa2, 0xd6, 0xab, 0xba, 0xd8, 0x45, 0x79, 0xc8,
-	0x05, 0xcc, 0xaa, 0x62, 0x44, 0x1c, 0x8c, 0x56, 0x60, 0x91, 0xe7, 0x34, 0x8b, 0xe5, 0xdb, 0x15,
-	0xb3, 0xd6, 0xa8, 0xea, 0xe2, 0x2f, 0x04, 0xb4, 0x0c, 0x0b, 0x01, 0xb3, 0xd8, 0x32, 0x6e, 0x9b,
-	0xc5, 0xb2, 0xa1, 0xde, 0x29, 0x1a, 0x8a, 0x78, 0x9f, 0x37, 0x47, 0x59, 0x15, 0x25, 0x64, 0xee,
-	0x0f, 0x31, 0x89, 0xe6, 0x72, 0xa3, 0xbe, 0xa3, 0x56, 0xc5, 0x83, 0x21, 0xa6, 0x1e, 0x31, 0x2d,
-	0xb4, 0x01, 0x97, 0x86, 0x24, 0xb5, 0x46, 0xa9, 0x61, 0x98, 0x46, 0xe3, 0x55, 0xa5, 0x2e, 0xfe,
-	0x48, 0x40, 0x57, 0x60, 0x23, 0x06, 0x61, 0xb3, 0xad, 0x6a, 0x8d, 0x56, 0xd3, 0xdc, 0x55, 0x76,
-	0x4b, 0x8a, 0xa6, 0x8b, 0x47, 0xa9, 0x3e, 0x50, 0x8c, 0x2e, 0xf6, 0xd0, 0x7a, 0x8a, 0x19, 0x5f,
-	0x41, 0x4b, 0x27, 0xe2, 0x36, 0x2a, 0xc0, 0x4a, 0x0c, 0xa1, 0xbc, 0x66, 0x68, 0xc5, 0x32, 0x73,
-	0x43, 0x17, 0xfb, 0x68, 0x0d, 0xa4, 0x18, 0x40, 0x53, 0x74, 0xa3, 0xa1, 0x29, 0xcc, 0xcf, 0xd7,
-	0xd1, 0x16, 0xdc, 0x18, 0x32, 0x11, 0x2d, 0x9c, 0x6e, 0xee, 0x34, 0x34, 0xb3, 0xa9, 0xa9, 0xf5,
-	0xb2, 0xda, 0x2c, 0xd6, 0xc4, 0x9f, 0x08, 0xe8, 0x2a, 0xc8, 0x89, 0x88, 0xd6, 0x14, 0x43, 0x31,
-	0x95, 0xd7, 0x9a, 0xaa, 0xa6, 0x54, 0x02, 0xc3, 0x3f, 0x16, 0xd0, 0x53, 0x50, 0x48, 0x58, 0xbe,
-	0xd3, 0x78, 0x55, 0xa1, 0x9e, 0x07, 0xa8, 0x9f, 0x0a, 0xe8, 0x32, 0xac, 0xc5, 0x51, 0x0d, 0xa3,
-	0x68, 0x28, 0xa6, 0xd6, 0x08, 0x63, 0xf9, 0xae, 0xc0, 0xcf, 0x52, 0xa9, 0x1b, 0x8a, 0xd6, 0xd4,
-	0x54, 0x5d, 0x89, 0x96, 0xd9, 0xe1, 0x03, 0xc5, 0x01, 0x6e, 0x2b, 0x45, 0xcd, 0x28, 0x29, 0x45,
-	0x43, 0x74, 0x47, 0xa8, 0xf0, 0x57, 0xbc, 0xa2, 0x88, 0x1e, 0xda, 0x80, 0xd5, 0x14, 0x00, 0x97,
-	0x2f, 0x03, 0xb4, 0x0a, 0xf9, 0x14, 0x48, 0xb3, 0xd8, 0xd2, 0x15, 0xf1, 0x97, 0x31, 0x2f, 0xd5,
-	0x8a, 0x52, 0x37, 0x54, 0xe3, 0x1e, 0x9f, 0x35, 0xc7, 0xa9, 0x00, 0x2e, 0xe7, 0xbe, 0x9c, 0x0a,
-	0x28, 0x6b, 0x0a, 0x09, 0x88, 0x5a, 0x69, 0x8a, 0x0f, 0x53, 0x01, 0xad, 0x66, 0x25, 0x00, 0x9c,
-	0xf0, 0xcb, 0x1d, 0x02, 0x6a, 0xaa, 0x6e, 0x10, 0xb6, 0x2e, 0xbe, 0x81, 0x2e, 0x45, 0x53, 0x88,
-	0xb9, 0x40, 0xa4, 0xbf, 0x92, 0xaa, 0x9e, 0xad, 0x2f, 0x01, 0x7c, 0x15, 0x5d, 0x85, 0xcb, 0xa3,
-	0x1c, 0x24, 0x75, 0x83, 0x59, 0xae, 0xa9, 0x4a, 0xdd, 0x10, 0xdf, 0x4c, 0x05, 0x32, 0x47, 0x79,
-	0xe0, 0xd7, 0xd0, 0xd3, 0x51, 0x3a, 0xc5, 0x1d, 0xe6, 0x60, 0xba, 0xf8, 0x75, 0x74, 0x05, 0xd6,
-	0x53, 0x1d, 0xe7, 0xb5, 0x7d, 0x43, 0x40, 0xd7, 0x52, 0xec, 0xb2, 0x19, 0xf0, 0xc8, 0xb7, 0x04,
-	0xb4, 0x04, 0x28, 0x40, 0x56, 0x94, 0x52, 0xab, 0x6a, 0x56, 0x5a, 0xbb, 0x4d, 0xf1, 0x5b, 0x02,
-	0xbf, 0xca, 0x35, 0xb5, 0xac, 0xd4, 0xf9, 0x4c, 0xfb, 0x76, 0x2a, 0x3b, 0xcc, 0xa2, 0xef, 0x08,
-	0x68, 0x3d, 0x0a, 0x61, 0x28, 0x5d, 0xa9, 0x98, 0x8c, 0x26, 0x7e, 0x37, 0x96, 0xf1, 0x01, 0x82,
-	0x45, 0x26, 0x00, 0x7d, 0x2f, 0x15, 0xc4, 0xa6, 0x11, 0x80, 0xbe, 0x2f, 0x20, 0x39, 0x4a, 0xd9,
-	0x00, 0x44, 0x43, 0xc7, 0x88, 0xba, 0xf8, 0x03, 0x01, 0x49, 0xd1, 0xd9, 0xc8, 0x16, 0x4a, 0x57,
-	0xca, 0x9a, 0x62, 0x88, 0x6f, 0x93, 0x73, 0x33, 0x17, 0xc9, 0xeb, 0x06, 0xe3, 0xe8, 0xe2, 0x3b,
-	0x02, 0x42, 0x30, 0xe3, 0x8f, 0x98, 0x59, 0xf1, 0x67, 0x02, 0x9a, 0x87, 0x59, 0x46, 0x53, 0xeb,
-	0x7a, 0x53, 0x29, 0x1b, 0xe2, 0xcf, 0x13, 0x61, 0xa4, 0x0e, 0x16, 0x6b, 0x35, 0xf1, 0x87, 0x02,
-	0x9a, 0x85, 0xac, 0xa6, 0x34, 0x1b, 0xa6, 0xa6, 0x14, 0x2b, 0xe2, 0x7b, 0x02, 0x9a, 0x03, 0xa0,
-	0xe3, 0xbb, 0x9a, 0x6a, 0x28, 0xe2, 0xef, 0xa8, 0x75, 0x4a, 0x48, 0xbe, 0x06, 0x7e, 0x2f, 0x20,
-	0x11, 0xa6, 0x28, 0x8b, 0xd9, 0xfe, 0x83, 0x80, 0xf2, 0x30, 0x4f, 0x29, 0xcc, 0xb2, 0x59, 0x6e,
-	0xec, 0xee, 0xaa, 0x86, 0xf8, 0x47, 0x01, 0x2d, 0x80, 0x48, 0x39, 0xfe, 0xcc, 0x7d, 0xf2, 0x9f,
-	0xa8, 0x5f, 0x9c, 0x8a, 0x80, 0xf1, 0xe7, 0x88, 0xc1, 0xa2, 0x51, 0xd2, 0x8a, 0xf5, 0xf2, 0x6d,
-	0xf1, 0x2f, 0x09, 0x45, 0x8c, 0xfc, 0xfe, 0x90, 0x22, 0xc6, 0xf8, 0xab, 0x80, 0x16, 0xe1, 0x62,
-	0xcc, 0xa5, 0x1d, 0xb5, 0xa6, 0x88, 0x7f, 0xa3, 0x61, 0x8a, 0xf4, 0x50, 0xe2, 0xdf, 0x69, 0xd6,
-	0x50, 0x22, 0xc9, 0x85, 0xa6, 0xda, 0x54, 0x6a, 0x6a, 0x5d, 0xa1, 0xa1, 0x51, 0x34, 0xf1, 0x1f,
-	0x34, 0x6b, 0x58, 0xb0, 0x76, 0x1b, 0x77, 0x94, 0x21, 0xc4, 0x3f, 0x47, 0x28, 0xa0, 0xb1, 0xd4,
-	0xc4, 0x7f, 0x51, 0x67, 0x42, 0x2a, 0x35, 0xfc, 0x4a, 0xa3, 0x24, 0xfe, 0x7a, 0xec, 0x46, 0x03,
-	0xa6, 0xf9, 0xab, 0x3e, 0x79, 0x55, 0x6a, 0x8a, 0xde, 0x68, 0x69, 0x65, 0xc5, 0x34, 0xee, 0x35,
-	0x15, 0xee, 0xcd, 0x3c, 0x05, 0x93, 0x41, 0x6e, 0x09, 0x28, 0x03, 0xe7, 0x89, 0x39, 0x71, 0x0c,
-	0xcd, 0x40, 0x96, 0xcc, 0xcf, 0xa4, 0xc3, 0xf1, 0xed, 0xff, 0x8a, 0x30, 0x5e, 0x6c, 0xaa, 0xa8,
-	0x08, 0x99, 0xe0, 0x0b, 0x05, 0xca, 0x87, 0x75, 0x4d, 0xe2, 0x33, 0x87, 0xb4, 0x9c, 0xc2, 0x61,
-	0x45, 0xc7, 0x39, 0x54, 0x05, 0x88, 0x3e, 0x4e, 0x20, 0x29, 0x84, 0x0e, 0x7d, 0xc6, 0x90, 0x56,
-	0x52, 0x79, 0xa1, 0xa2, 0x7b, 0xb4, 0x30, 0x8c, 0x75, 0x8c, 0xd1, 0x7a, 0xd4, 0xb6, 0x49, 0x6f,
-	0x51, 0x4b, 0x1b, 0x67, 0x20, 0x78, 0xd5, 0xfa, 0x68, 0xd5, 0xfa, 0x63, 0x55, 0xeb, 0xa3, 0x55,
-	0xef, 0xc2, 0x34, 0xdf, 0xb6, 0x45, 0x97, 0xa2, 0x58, 0x0d, 0x77, 0x8b, 0xa5, 0xd5, 0x11, 0xdc,
-	0x50, 0x5d, 0x05, 0xb2, 0x61, 0xeb, 0x04, 0x2d, 0xc7, 0xd0, 0x7c, 0x27, 0x47, 0x92, 0xd2, 0x58,
-	0xa1, 0x16, 0x1d, 0x66, 0xe3, 0x1d, 0x01, 0xb4, 0xc6, 0x87, 0x69, 0xb8, 0xc9, 0x21, 0x15, 0x46,
-	0xf2, 0x43, 0xa5, 0x0f, 0x40, 0x1a, 0xdd, 0xd8, 0x40, 0x37, 0x46, 0x28, 0x48, 0xb9, 0x76, 0x3c,
-	0x89, 0xb1, 0x97, 0x61, 0xc2, 0x6f, 0x62, 0xa3, 0xc5, 0x10, 0x1c, 0xeb, 0x73, 0x4b, 0x4b, 0x43,
-	0xf4, 0x50, 0xf8, 0x20, 0xec, 0x06, 0xc4, 0x3b, 0xc5, 0xe8, 0x0a, 0x6f, 0x78, 0x64, 0x7b, 0x5a,
-	0x7a, 0xfa, 0x71, 0xb0, 0xd0, 0xd2, 0xe7, 0xe1, 0xe2, 0x50, 0x53, 0x02, 0x45, 0x79, 0x33, 0xaa,
-	0x5f, 0x22, 0xc9, 0x67, 0x41, 0x12, 0xcb, 0xc8, 0xab, 0x5e, 0x4b, 0x7a, 0x96, 0xd0, 0x5b, 0x18,
-	0xc9, 0xe7, 0x13, 0x96, 0xef, 0x0f, 0x70, 0x09, 0x9b, 0xd2, 0x4d, 0xe0, 0x12, 0x36, 0xad, 0xa9,
-	0x20, 0x9f, 0x43, 0x4d, 0x98, 0x89, 0x5d, 0xe6, 0xd1, 0x6a, 0xdc, 0x85, 0x44, 0xb7, 0x40, 0x5a,
-	0x1b, 0xc5, 0x0e, 0x35, 0xde, 0x81, 0xb9, 0xc4, 0x55, 0x07, 0x15, 0xb8, 0x9e, 0x4d, 0x5a, 0x27,
-	0x40, 0x5a, 0x1f, 0x0d, 0x08, 0xf5, 0xf6, 0x86, 0xfa, 0x02, 0xc1, 0x15, 0x0a, 0x5d, 0x1d, 0x25,
-	0x9e, 0xb8, 0xa2, 0x49, 0xd7, 0x1e, 0x0f, 0x4c, 0x1c, 0x3a, 0xb1, 0xee, 0x40, 0xfc, 0xd0, 0x49,
-	0xeb, 0x43, 0xc4, 0x0f, 0x9d, 0xf4, 0xd6, 0x02, 0x0d, 0x7a, 0xac, 0x09, 0xc0, 0x05, 0x3d, 0xad,
-	0xe9, 0xc0, 0x05, 0x3d, 0xbd, 0x77, 0x40, 0xcf, 0x9d, 0xf0, 0xae, 0xcf, 0x9d, 0x3b, 0xc9, 0x8e,
-	0x02, 0x77, 0xee, 0x0c, 0xb5, 0x06, 0xe8, 0x76, 0x58, 0x48, 0xed, 0x37, 0xc4, 0x37, 0xde, 0xc8,
-	0x7e, 0xc4, 0x63, 0xb4, 0x17, 0x21, 0x13, 0x74, 0x0e, 0xb8, 0x97, 0x55, 0xa2, 0xeb, 0x20, 0x2d,
-	0xa7, 0x70, 0xf8, 0xfd, 0x3a, 0xd4, 0x2e, 0xe0, 0xf6, 0xeb, 0xa8, 0x36, 0x03, 0xb7, 0x5f, 0x47,
-	0x76, 0x1b, 0xfc, 0x15, 0x4f, 0x5e, 0xff, 0x11, 0x9f, 0x99, 0xa9, 0xed, 0x05, 0x6e, 0xc5, 0x47,
-	0xf6, 0x0e, 0x68, 0xf2, 0x8e, 0xb8, 0xba, 0x73, 0xc9, 0x7b, 0xf6, 0xf5, 0x9f, 0x4b, 0xde, 0xc7,
-	0x74, 0x01, 0xd8, 0x26, 0x8c, 0xff, 0x46, 0x80, 0xdf, 0x84, 0xa9, 0x3f, 0x3b, 0xe0, 0x37, 0x61,
-	0xfa, 0xcf, 0x0b, 0xe4, 0x73, 0xa5, 0x9b, 0xef, 0x9d, 0xae, 0x09, 0xef, 0x9f, 0xae, 0x09, 0x1f,
-	0x9c, 0xae, 0x09, 0x9f, 0xbb, 0xb1, 0x6f, 0x79, 0x07, 0x83, 0xbd, 0xcd, 0x8e, 0x7d, 0xb4, 0xd5,
-	0x6f, 0x77, 0x0e, 0x4e, 0xba, 0xd8, 0xe1, 0x9f, 0x8e, 0xb7, 0xb7, 0x5c, 0xa7, 0x43, 0x7f, 0xc4,
-	0xb1, 0x37, 0x41, 0x3f, 0x46, 0x3e, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x2a, 0x5a,
-	0x32, 0xd8, 0x21, 0x00, 0x00,
+func (m *GetGroupsForPrincipalRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	// Activate/Deactivate the auth API. 'Activate' sets an initial set of admins
-	// for the Pachyderm cluster, and 'Deactivate' removes all ACLs, tokens, and
-	// admins from the Pachyderm cluster, making all data publicly accessable
-	Activate(ctx context.Context, in *ActivateRequest, opts ...grpc.CallOption) (*ActivateResponse, error)
-	Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*DeactivateResponse, error)
-	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
-	SetConfiguration(ctx context.Context, in *SetConfigurationRequest, opts ...grpc.CallOption) (*SetConfigurationResponse, error)
-	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
-	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
-	GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
-	GetPermissionsForPrincipal(ctx context.Context, in *GetPermissionsForPrincipalRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
-	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
-	GetRolesForPermission(ctx context.Context, in *GetRolesForPermissionRequest, opts ...grpc.CallOption) (*GetRolesForPermissionResponse, error)
-	ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error)
-	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error)
-	GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error)
-	GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error)
-	RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error)
-	RevokeAuthTokensForUser(ctx context.Context, in *RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAuthTokensForUserResponse, error)
-	SetGroupsForUser(ctx context.Context, in *SetGroupsForUserRequest, opts ...grpc.CallOption) (*SetGroupsForUserResponse, error)
-	ModifyMembers(ctx context.Context, in *ModifyMembersRequest, opts ...grpc.CallOption) (*ModifyMembersResponse, error)
-	GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
-	GetGroupsForPrincipal(ctx context.Context, in *GetGroupsForPrincipalRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
-	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
-	ExtractAuthTokens(ctx context.Context, in *ExtractAuthTokensRequest, opts ...grpc.CallOption) (*ExtractAuthTokensResponse, error)
-	RestoreAuthToken(ctx context.Context, in *RestoreAuthTokenRequest, opts ...grpc.CallOption) (*RestoreAuthTokenResponse, error)
-	DeleteExpiredAuthTokens(ctx context.Context, in *DeleteExpiredAuthTokensRequest, opts ...grpc.CallOption) (*DeleteExpiredAuthTokensResponse, error)
-	RotateRootToken(ctx context.Context, in *RotateRootTokenRequest, opts ...grpc.CallOption) (*RotateRootTokenResponse, error)
+type GetGroupsResponse struct {
+	Groups               []string `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *GetGroupsResponse) Reset()         { *m = GetGroupsResponse{} }
+func (m *GetGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGroupsResponse) ProtoMessage()    {}
+func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{59}
 }
-
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+func (m *GetGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) Activate(ctx context.Context, in *ActivateRequest, opts ...grpc.CallOption) (*ActivateResponse, error) {
-	out := new(ActivateResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/Activate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *aPIClient) Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*DeactivateResponse, error) {
-	out := new(DeactivateResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/Deactivate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupsResponse.Merge(m, src)
 }
-
-func (c *aPIClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
-	out := new(GetConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetGroupsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *aPIClient) SetConfiguration(ctx context.Context, in *SetConfigurationRequest, opts ...grpc.CallOption) (*SetConfigurationResponse, error) {
-	out := new(SetConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/SetConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupsResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
-	out := new(AuthenticateResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/Authenticate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetGroupsResponse proto.InternalMessageInfo
+
+func (m *GetGroupsResponse) GetGroups() []string {
+	if m != nil {
+		return m.Groups
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error) {
-	out := new(AuthorizeResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/Authorize", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetUsersRequest struct {
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
-	out := new(GetPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetPermissions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
+func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUsersRequest) ProtoMessage()    {}
+func (*GetUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{60}
 }
-
-func (c *aPIClient) GetPermissionsForPrincipal(ctx context.Context, in *GetPermissionsForPrincipalRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
-	out := new(GetPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetPermissionsForPrincipal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error) {
-	out := new(WhoAmIResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/WhoAmI", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUsersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *aPIClient) GetRolesForPermission(ctx context.Context, in *GetRolesForPermissionRequest, opts ...grpc.CallOption) (*GetRolesForPermissionResponse, error) {
-	out := new(GetRolesForPermissionResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetRolesForPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsersRequest.Merge(m, src)
 }
-
-func (c *aPIClient) ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error) {
-	out := new(ModifyRoleBindingResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/ModifyRoleBinding", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetUsersRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *aPIClient) GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error) {
-	out := new(GetRoleBindingResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetRoleBinding", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsersRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error) {
-	out := new(GetOIDCLoginResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetOIDCLogin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_GetUsersRequest proto.InternalMessageInfo
 
-func (c *aPIClient) GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error) {
-	out := new(GetRobotTokenResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetRobotToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetUsersRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error) {
-	out := new(RevokeAuthTokenResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/RevokeAuthToken", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetUsersResponse struct {
+	Usernames            []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) RevokeAuthTokensForUser(ctx context.Context, in *RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAuthTokensForUserResponse, error) {
-	out := new(RevokeAuthTokensForUserResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/RevokeAuthTokensForUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
+func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUsersResponse) ProtoMessage()    {}
+func (*GetUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{61}
 }
-
-func (c *aPIClient) SetGroupsForUser(ctx context.Context, in *SetGroupsForUserRequest, opts ...grpc.CallOption) (*SetGroupsForUserResponse, error) {
-	out := new(SetGroupsForUserResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/SetGroupsForUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUsersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsersResponse.Merge(m, src)
+}
+func (m *GetUsersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsersResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) ModifyMembers(ctx context.Context, in *ModifyMembersRequest, opts ...grpc.CallOption) (*ModifyMembersResponse, error) {
-	out := new(ModifyMembersResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/ModifyMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetUsersResponse proto.InternalMessageInfo
+
+func (m *GetUsersResponse) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
-	out := new(GetGroupsResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ExtractAuthTokens returns all the hashed robot tokens that have been issued.
+// User tokens are not extracted as they can be recreated by logging in.
+type ExtractAuthTokensRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) GetGroupsForPrincipal(ctx context.Context, in *GetGroupsForPrincipalRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
-	out := new(GetGroupsResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetGroupsForPrincipal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExtractAuthTokensRequest) Reset()         { *m = ExtractAuthTokensRequest{} }
+func (m *ExtractAuthTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtractAuthTokensRequest) ProtoMessage()    {}
+func (*ExtractAuthTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{62}
+}
+func (m *ExtractAuthTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtractAuthTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtractAuthTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ExtractAuthTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtractAuthTokensRequest.Merge(m, src)
+}
+func (m *ExtractAuthTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtractAuthTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtractAuthTokensRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
-	out := new(GetUsersResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/GetUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_ExtractAuthTokensRequest proto.InternalMessageInfo
+
+type ExtractAuthTokensResponse struct {
+	Tokens               []*TokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (c *aPIClient) ExtractAuthTokens(ctx context.Context, in *ExtractAuthTokensRequest, opts ...grpc.CallOption) (*ExtractAuthTokensResponse, error) {
-	out := new(ExtractAuthTokensResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/ExtractAuthTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExtractAuthTokensResponse) Reset()         { *m = ExtractAuthTokensResponse{} }
+func (m *ExtractAuthTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ExtractAuthTokensResponse) ProtoMessage()    {}
+func (*ExtractAuthTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{63}
+}
+func (m *ExtractAuthTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtractAuthTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtractAuthTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ExtractAuthTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtractAuthTokensResponse.Merge(m, src)
+}
+func (m *ExtractAuthTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtractAuthTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtractAuthTokensResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) RestoreAuthToken(ctx context.Context, in *RestoreAuthTokenRequest, opts ...grpc.CallOption) (*RestoreAuthTokenResponse, error) {
-	out := new(RestoreAuthTokenResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/RestoreAuthToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExtractAuthTokensResponse proto.InternalMessageInfo
+
+func (m *ExtractAuthTokensResponse) GetTokens() []*TokenInfo {
+	if m != nil {
+		return m.Tokens
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) DeleteExpiredAuthTokens(ctx context.Context, in *DeleteExpiredAuthTokensRequest, opts ...grpc.CallOption) (*DeleteExpiredAuthTokensResponse, error) {
-	out := new(DeleteExpiredAuthTokensResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/DeleteExpiredAuthTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RestoreAuthToken inserts a hashed token that has previously been extracted.
+type RestoreAuthTokenRequest struct {
+	Token                *TokenInfo `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (c *aPIClient) RotateRootToken(ctx context.Context, in *RotateRootTokenRequest, opts ...grpc.CallOption) (*RotateRootTokenResponse, error) {
-	out := new(RotateRootTokenResponse)
-	err := c.cc.Invoke(ctx, "/auth_v2.API/RotateRootToken", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RestoreAuthTokenRequest) Reset()         { *m = RestoreAuthTokenRequest{} }
+func (m *RestoreAuthTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreAuthTokenRequest) ProtoMessage()    {}
+func (*RestoreAuthTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{64}
 }
-
-// APIServer is the server API for API service.
-type APIServer interface {
-	// Activate/Deactivate the auth API. 'Activate' sets an initial set of admins
-	// for the Pachyderm cluster, and 'Deactivate' removes all ACLs, tokens, and
-	// admins from the Pachyderm cluster, making all data publicly accessable
-	Activate(context.Context, *ActivateRequest) (*ActivateResponse, error)
-	Deactivate(context.Context, *DeactivateRequest) (*DeactivateResponse, error)
-	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
-	SetConfiguration(context.Context, *SetConfigurationRequest) (*SetConfigurationResponse, error)
-	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
-	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
-	GetPermissions(context.Context, *GetPermissionsRequest) (*GetPermissionsResponse, error)
-	GetPermissionsForPrincipal(context.Context, *GetPermissionsForPrincipalRequest) (*GetPermissionsResponse, error)
-	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
-	GetRolesForPermission(context.Context, *GetRolesForPermissionRequest) (*GetRolesForPermissionResponse, error)
-	ModifyRoleBinding(context.Context, *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error)
-	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*GetRoleBindingResponse, error)
-	GetOIDCLogin(context.Context, *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error)
-	GetRobotToken(context.Context, *GetRobotTokenRequest) (*GetRobotTokenResponse, error)
-	RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error)
-	RevokeAuthTokensForUser(context.Context, *RevokeAuthTokensForUserRequest) (*RevokeAuthTokensForUserResponse, error)
-	SetGroupsForUser(context.Context, *SetGroupsForUserRequest) (*SetGroupsForUserResponse, error)
-	ModifyMembers(context.Context, *ModifyMembersRequest) (*ModifyMembersResponse, error)
-	GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error)
-	GetGroupsForPrincipal(context.Context, *GetGroupsForPrincipalRequest) (*GetGroupsResponse, error)
-	GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error)
-	ExtractAuthTokens(context.Context, *ExtractAuthTokensRequest) (*ExtractAuthTokensResponse, error)
-	RestoreAuthToken(context.Context, *RestoreAuthTokenRequest) (*RestoreAuthTokenResponse, error)
-	DeleteExpiredAuthTokens(context.Context, *DeleteExpiredAuthTokensRequest) (*DeleteExpiredAuthTokensResponse, error)
-	RotateRootToken(context.Context, *RotateRootTokenRequest) (*RotateRootTokenResponse, error)
+func (m *RestoreAuthTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// UnimplementedAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+func (m *RestoreAuthTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreAuthTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (*UnimplementedAPIServer) Activate(ctx context.Context, req *ActivateRequest) (*ActivateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+func (m *RestoreAuthTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreAuthTokenRequest.Merge(m, src)
 }
-func (*UnimplementedAPIServer) Deactivate(ctx context.Context, req *DeactivateRequest) (*DeactivateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deactivate not implemented")
+func (m *RestoreAuthTokenRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAPIServer) GetConfiguration(ctx context.Context, req *GetConfigurationRequest) (*GetConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
+func (m *RestoreAuthTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreAuthTokenRequest.DiscardUnknown(m)
 }
-func (*UnimplementedAPIServer) SetConfiguration(ctx context.Context, req *SetConfigurationRequest) (*SetConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetConfiguration not implemented")
+
+var xxx_messageInfo_RestoreAuthTokenRequest proto.InternalMessageInfo
+
+func (m *RestoreAuthTokenRequest) GetToken() *TokenInfo {
+	if m != nil {
+		return m.Token
+	}
+	return nil
 }
-func (*UnimplementedAPIServer) Authenticate(ctx context.Context, req *AuthenticateRequest) (*AuthenticateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+
+type RestoreAuthTokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAPIServer) Authorize(ctx context.Context, req *AuthorizeRequest) (*AuthorizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+
+func (m *RestoreAuthTokenResponse) Reset()         { *m = RestoreAuthTokenResponse{} }
+func (m *RestoreAuthTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreAuthTokenResponse) ProtoMessage()    {}
+func (*RestoreAuthTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{65}
 }
-func (*UnimplementedAPIServer) GetPermissions(ctx context.Context, req *GetPermissionsRequest) (*GetPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
+func (m *RestoreAuthTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAPIServer) GetPermissionsForPrincipal(ctx context.Context, req *GetPermissionsForPrincipalRequest) (*GetPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionsForPrincipal not implemented")
+func (m *RestoreAuthTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreAuthTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAPIServer) WhoAmI(ctx context.Context, req *WhoAmIRequest) (*WhoAmIResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
+func (m *RestoreAuthTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreAuthTokenResponse.Merge(m, src)
 }
-func (*UnimplementedAPIServer) GetRolesForPermission(ctx context.Context, req *GetRolesForPermissionRequest) (*GetRolesForPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRolesForPermission not implemented")
+func (m *RestoreAuthTokenResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAPIServer) ModifyRoleBinding(ctx context.Context, req *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyRoleBinding not implemented")
+func (m *RestoreAuthTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreAuthTokenResponse.DiscardUnknown(m)
 }
-func (*UnimplementedAPIServer) GetRoleBinding(ctx context.Context, req *GetRoleBindingRequest) (*GetRoleBindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoleBinding not implemented")
+
+var xxx_messageInfo_RestoreAuthTokenResponse proto.InternalMessageInfo
+
+type RevokeAuthTokensForUserRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAPIServer) GetOIDCLogin(ctx context.Context, req *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCLogin not implemented")
+
+func (m *RevokeAuthTokensForUserRequest) Reset()         { *m = RevokeAuthTokensForUserRequest{} }
+func (m *RevokeAuthTokensForUserRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAuthTokensForUserRequest) ProtoMessage()    {}
+func (*RevokeAuthTokensForUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{66}
 }
-func (*UnimplementedAPIServer) GetRobotToken(ctx context.Context, req *GetRobotTokenRequest) (*GetRobotTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRobotToken not implemented")
+func (m *RevokeAuthTokensForUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAPIServer) RevokeAuthToken(ctx context.Context, req *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthToken not implemented")
+func (m *RevokeAuthTokensForUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeAuthTokensForUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAPIServer) RevokeAuthTokensForUser(ctx context.Context, req *RevokeAuthTokensForUserRequest) (*RevokeAuthTokensForUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthTokensForUser not implemented")
+func (m *RevokeAuthTokensForUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAuthTokensForUserRequest.Merge(m, src)
 }
-func (*UnimplementedAPIServer) SetGroupsForUser(ctx context.Context, req *SetGroupsForUserRequest) (*SetGroupsForUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetGroupsForUser not implemented")
+func (m *RevokeAuthTokensForUserRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAPIServer) ModifyMembers(ctx context.Context, req *ModifyMembersRequest) (*ModifyMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyMembers not implemented")
+func (m *RevokeAuthTokensForUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAuthTokensForUserRequest.DiscardUnknown(m)
 }
-func (*UnimplementedAPIServer) GetGroups(ctx context.Context, req *GetGroupsRequest) (*GetGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroups not implemented")
+
+var xxx_messageInfo_RevokeAuthTokensForUserRequest proto.InternalMessageInfo
+
+func (m *RevokeAuthTokensForUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
-func (*UnimplementedAPIServer) GetGroupsForPrincipal(ctx context.Context, req *GetGroupsForPrincipalRequest) (*GetGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsForPrincipal not implemented")
+
+type RevokeAuthTokensForUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAPIServer) GetUsers(ctx context.Context, req *GetUsersRequest) (*GetUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+
+func (m *RevokeAuthTokensForUserResponse) Reset()         { *m = RevokeAuthTokensForUserResponse{} }
+func (m *RevokeAuthTokensForUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAuthTokensForUserResponse) ProtoMessage()    {}
+func (*RevokeAuthTokensForUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{67}
 }
-func (*UnimplementedAPIServer) ExtractAuthTokens(ctx context.Context, req *ExtractAuthTokensRequest) (*ExtractAuthTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtractAuthTokens not implemented")
+func (m *RevokeAuthTokensForUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAPIServer) RestoreAuthToken(ctx context.Context, req *RestoreAuthTokenRequest) (*RestoreAuthTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreAuthToken not implemented")
+func (m *RevokeAuthTokensForUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeAuthTokensForUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAPIServer) DeleteExpiredAuthTokens(ctx context.Context, req *DeleteExpiredAuthTokensRequest) (*DeleteExpiredAuthTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpiredAuthTokens not implemented")
+func (m *RevokeAuthTokensForUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAuthTokensForUserResponse.Merge(m, src)
 }
-func (*UnimplementedAPIServer) RotateRootToken(ctx context.Context, req *RotateRootTokenRequest) (*RotateRootTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RotateRootToken not implemented")
+func (m *RevokeAuthTokensForUserResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+func (m *RevokeAuthTokensForUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAuthTokensForUserResponse.DiscardUnknown(m)
 }
 
-func _API_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).Activate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/Activate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Activate(ctx, req.(*ActivateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_RevokeAuthTokensForUserResponse proto.InternalMessageInfo
+
+type DeleteExpiredAuthTokensRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).Deactivate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/Deactivate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Deactivate(ctx, req.(*DeactivateRequest))
+func (m *DeleteExpiredAuthTokensRequest) Reset()         { *m = DeleteExpiredAuthTokensRequest{} }
+func (m *DeleteExpiredAuthTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteExpiredAuthTokensRequest) ProtoMessage()    {}
+func (*DeleteExpiredAuthTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{68}
+}
+func (m *DeleteExpiredAuthTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteExpiredAuthTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteExpiredAuthTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeleteExpiredAuthTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExpiredAuthTokensRequest.Merge(m, src)
+}
+func (m *DeleteExpiredAuthTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteExpiredAuthTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExpiredAuthTokensRequest.DiscardUnknown(m)
 }
 
-func _API_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_DeleteExpiredAuthTokensRequest proto.InternalMessageInfo
+
+type DeleteExpiredAuthTokensResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_SetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).SetConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/SetConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SetConfiguration(ctx, req.(*SetConfigurationRequest))
+func (m *DeleteExpiredAuthTokensResponse) Reset()         { *m = DeleteExpiredAuthTokensResponse{} }
+func (m *DeleteExpiredAuthTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteExpiredAuthTokensResponse) ProtoMessage()    {}
+func (*DeleteExpiredAuthTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{69}
+}
+func (m *DeleteExpiredAuthTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteExpiredAuthTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteExpiredAuthTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeleteExpiredAuthTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExpiredAuthTokensResponse.Merge(m, src)
+}
+func (m *DeleteExpiredAuthTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteExpiredAuthTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExpiredAuthTokensResponse.DiscardUnknown(m)
 }
 
-func _API_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthenticateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).Authenticate(ctx, in)
+var xxx_messageInfo_DeleteExpiredAuthTokensResponse proto.InternalMessageInfo
+
+// AuditEvent records a call to the Pachyderm API made while auth is active
+type AuditEvent struct {
+	Id   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time *types.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// principal is the subject that made the call. It's empty if the caller
+	// couldn't be authenticated.
+	Principal string `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	// method is the full name of the RPC, e.g. "/pfs_v2.API/CreateRepo"
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// resource is the resource named in the request, if any
+	Resource *Resource `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	// allowed is false if the call was denied for lack of authentication or
+	// permissions
+	Allowed bool `protobuf:"varint,6,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// request is a JSON summary of the request, with secret fields redacted
+	Request              string   `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{70}
+}
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/Authenticate",
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Authenticate(ctx, req.(*AuthenticateRequest))
+	return 0
+}
+
+func (m *AuditEvent) GetTime() *types.Timestamp {
+	if m != nil {
+		return m.Time
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthorizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AuditEvent) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
 	}
-	if interceptor == nil {
-		return srv.(APIServer).Authorize(ctx, in)
+	return ""
+}
+
+func (m *AuditEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/Authorize",
+	return ""
+}
+
+func (m *AuditEvent) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Authorize(ctx, req.(*AuthorizeRequest))
+	return nil
+}
+
+func (m *AuditEvent) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _API_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPermissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AuditEvent) GetRequest() string {
+	if m != nil {
+		return m.Request
 	}
-	if interceptor == nil {
-		return srv.(APIServer).GetPermissions(ctx, in)
+	return ""
+}
+
+func (m *AuditEvent) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetPermissions",
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	// Only events at or after 'since' and before 'until' are returned, if set
+	Since     *types.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until     *types.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	Principal string           `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	// If set, only events for this resource are returned. An empty name matches
+	// every resource of the type.
+	Resource   *Resource `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	DeniedOnly bool      `protobuf:"varint,5,opt,name=denied_only,json=deniedOnly,proto3" json:"denied_only,omitempty"`
+	// The maximum number of events to return, most recent first. Defaults to
+	// 1000.
+	Limit                int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{71}
+}
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetPermissions(ctx, req.(*GetPermissionsRequest))
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetSince() *types.Timestamp {
+	if m != nil {
+		return m.Since
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_GetPermissionsForPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPermissionsForPrincipalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListAuditEventsRequest) GetUntil() *types.Timestamp {
+	if m != nil {
+		return m.Until
 	}
-	if interceptor == nil {
-		return srv.(APIServer).GetPermissionsForPrincipal(ctx, in)
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetPermissionsForPrincipal",
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetPermissionsForPrincipal(ctx, req.(*GetPermissionsForPrincipalRequest))
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetDeniedOnly() bool {
+	if m != nil {
+		return m.DeniedOnly
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _API_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhoAmIRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListAuditEventsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	if interceptor == nil {
-		return srv.(APIServer).WhoAmI(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/WhoAmI",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).WhoAmI(ctx, req.(*WhoAmIRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _API_GetRolesForPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRolesForPermissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetRolesForPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetRolesForPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetRolesForPermission(ctx, req.(*GetRolesForPermissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ListAuditEventsResponse struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func _API_ModifyRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyRoleBindingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ModifyRoleBinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/ModifyRoleBinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ModifyRoleBinding(ctx, req.(*ModifyRoleBindingRequest))
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{72}
+}
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
 }
 
-func _API_GetRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoleBindingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetRoleBinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetRoleBinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetRoleBinding(ctx, req.(*GetRoleBindingRequest))
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_GetOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOIDCLoginRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetOIDCLogin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetOIDCLogin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetOIDCLogin(ctx, req.(*GetOIDCLoginRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ResourceRoleBinding is the role binding on a single resource
+type ResourceRoleBinding struct {
+	Resource             *Resource    `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Binding              *RoleBinding `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func _API_GetRobotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRobotTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetRobotToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetRobotToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetRobotToken(ctx, req.(*GetRobotTokenRequest))
+func (m *ResourceRoleBinding) Reset()         { *m = ResourceRoleBinding{} }
+func (m *ResourceRoleBinding) String() string { return proto.CompactTextString(m) }
+func (*ResourceRoleBinding) ProtoMessage()    {}
+func (*ResourceRoleBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{73}
+}
+func (m *ResourceRoleBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceRoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceRoleBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ResourceRoleBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRoleBinding.Merge(m, src)
+}
+func (m *ResourceRoleBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceRoleBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRoleBinding.DiscardUnknown(m)
 }
 
-func _API_RevokeAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeAuthTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).RevokeAuthToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/RevokeAuthToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RevokeAuthToken(ctx, req.(*RevokeAuthTokenRequest))
+var xxx_messageInfo_ResourceRoleBinding proto.InternalMessageInfo
+
+func (m *ResourceRoleBinding) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_RevokeAuthTokensForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeAuthTokensForUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).RevokeAuthTokensForUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/RevokeAuthTokensForUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RevokeAuthTokensForUser(ctx, req.(*RevokeAuthTokensForUserRequest))
+func (m *ResourceRoleBinding) GetBinding() *RoleBinding {
+	if m != nil {
+		return m.Binding
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_SetGroupsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGroupsForUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// GroupMembers is the set of users in a group
+type GroupMembers struct {
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupMembers) Reset()         { *m = GroupMembers{} }
+func (m *GroupMembers) String() string { return proto.CompactTextString(m) }
+func (*GroupMembers) ProtoMessage()    {}
+func (*GroupMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{74}
+}
+func (m *GroupMembers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupMembers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(APIServer).SetGroupsForUser(ctx, in)
+}
+func (m *GroupMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMembers.Merge(m, src)
+}
+func (m *GroupMembers) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMembers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMembers proto.InternalMessageInfo
+
+func (m *GroupMembers) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/SetGroupsForUser",
+	return ""
+}
+
+func (m *GroupMembers) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SetGroupsForUser(ctx, req.(*SetGroupsForUserRequest))
+	return nil
+}
+
+// ClusterAuthConfig is a snapshot of a cluster's auth and identity
+// configuration. Role bindings granted to pipelines and to internal users
+// aren't included, as they're managed by pachd. Secrets are either omitted or
+// encrypted, see ExportConfigRequest.
+type ClusterAuthConfig struct {
+	AuthConfig   *OIDCConfig            `protobuf:"bytes,1,opt,name=auth_config,json=authConfig,proto3" json:"auth_config,omitempty"`
+	Roles        []*Role                `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Groups       []*GroupMembers        `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	RoleBindings []*ResourceRoleBinding `protobuf:"bytes,4,rep,name=role_bindings,json=roleBindings,proto3" json:"role_bindings,omitempty"`
+	// identity_server_config is unset if the cluster has no identity server,
+	// in which case the identity configuration is left alone on import.
+	IdentityServerConfig *identity.IdentityServerConfig `protobuf:"bytes,5,opt,name=identity_server_config,json=identityServerConfig,proto3" json:"identity_server_config,omitempty"`
+	IdpConnectors        []*identity.IDPConnector       `protobuf:"bytes,6,rep,name=idp_connectors,json=idpConnectors,proto3" json:"idp_connectors,omitempty"`
+	OidcClients          []*identity.OIDCClient         `protobuf:"bytes,7,rep,name=oidc_clients,json=oidcClients,proto3" json:"oidc_clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ClusterAuthConfig) Reset()         { *m = ClusterAuthConfig{} }
+func (m *ClusterAuthConfig) String() string { return proto.CompactTextString(m) }
+func (*ClusterAuthConfig) ProtoMessage()    {}
+func (*ClusterAuthConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{75}
+}
+func (m *ClusterAuthConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterAuthConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterAuthConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ClusterAuthConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterAuthConfig.Merge(m, src)
+}
+func (m *ClusterAuthConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterAuthConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterAuthConfig.DiscardUnknown(m)
 }
 
-func _API_ModifyMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyMembersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ClusterAuthConfig proto.InternalMessageInfo
+
+func (m *ClusterAuthConfig) GetAuthConfig() *OIDCConfig {
+	if m != nil {
+		return m.AuthConfig
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ModifyMembers(ctx, in)
+	return nil
+}
+
+func (m *ClusterAuthConfig) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/ModifyMembers",
+	return nil
+}
+
+func (m *ClusterAuthConfig) GetGroups() []*GroupMembers {
+	if m != nil {
+		return m.Groups
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ModifyMembers(ctx, req.(*ModifyMembersRequest))
+	return nil
+}
+
+func (m *ClusterAuthConfig) GetRoleBindings() []*ResourceRoleBinding {
+	if m != nil {
+		return m.RoleBindings
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_GetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ClusterAuthConfig) GetIdentityServerConfig() *identity.IdentityServerConfig {
+	if m != nil {
+		return m.IdentityServerConfig
 	}
-	if interceptor == nil {
-		return srv.(APIServer).GetGroups(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetGroups",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetGroups(ctx, req.(*GetGroupsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_GetGroupsForPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupsForPrincipalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetGroupsForPrincipal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetGroupsForPrincipal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetGroupsForPrincipal(ctx, req.(*GetGroupsForPrincipalRequest))
+func (m *ClusterAuthConfig) GetIdpConnectors() []*identity.IDPConnector {
+	if m != nil {
+		return m.IdpConnectors
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUsersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GetUsers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/GetUsers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetUsers(ctx, req.(*GetUsersRequest))
+func (m *ClusterAuthConfig) GetOidcClients() []*identity.OIDCClient {
+	if m != nil {
+		return m.OidcClients
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_ExtractAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtractAuthTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ExtractAuthTokens(ctx, in)
+type ExportConfigRequest struct {
+	// encryption_key is a base64-encoded curve25519 public key. If it's set,
+	// secrets are exported encrypted to it, otherwise they're omitted.
+	EncryptionKey        string   `protobuf:"bytes,1,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportConfigRequest) Reset()         { *m = ExportConfigRequest{} }
+func (m *ExportConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportConfigRequest) ProtoMessage()    {}
+func (*ExportConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{76}
+}
+func (m *ExportConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/ExtractAuthTokens",
+}
+func (m *ExportConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportConfigRequest.Merge(m, src)
+}
+func (m *ExportConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportConfigRequest proto.InternalMessageInfo
+
+func (m *ExportConfigRequest) GetEncryptionKey() string {
+	if m != nil {
+		return m.EncryptionKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ExtractAuthTokens(ctx, req.(*ExtractAuthTokensRequest))
+	return ""
+}
+
+type ExportConfigResponse struct {
+	Config               *ClusterAuthConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExportConfigResponse) Reset()         { *m = ExportConfigResponse{} }
+func (m *ExportConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportConfigResponse) ProtoMessage()    {}
+func (*ExportConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{77}
+}
+func (m *ExportConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ExportConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportConfigResponse.Merge(m, src)
+}
+func (m *ExportConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportConfigResponse.DiscardUnknown(m)
 }
 
-func _API_RestoreAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreAuthTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ExportConfigResponse proto.InternalMessageInfo
+
+func (m *ExportConfigResponse) GetConfig() *ClusterAuthConfig {
+	if m != nil {
+		return m.Config
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RestoreAuthToken(ctx, in)
+	return nil
+}
+
+type ConfigChange struct {
+	// kind is the kind of item that's changed, e.g. "role_binding" or
+	// "idp_connector"
+	Kind string         `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Op   ConfigChangeOp `protobuf:"varint,3,opt,name=op,proto3,enum=auth_v2.ConfigChangeOp" json:"op,omitempty"`
+	// reason explains why a change is skipped
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigChange) Reset()         { *m = ConfigChange{} }
+func (m *ConfigChange) String() string { return proto.CompactTextString(m) }
+func (*ConfigChange) ProtoMessage()    {}
+func (*ConfigChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{78}
+}
+func (m *ConfigChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/RestoreAuthToken",
+}
+func (m *ConfigChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigChange.Merge(m, src)
+}
+func (m *ConfigChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigChange proto.InternalMessageInfo
+
+func (m *ConfigChange) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RestoreAuthToken(ctx, req.(*RestoreAuthTokenRequest))
+	return ""
+}
+
+func (m *ConfigChange) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _API_DeleteExpiredAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteExpiredAuthTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ConfigChange) GetOp() ConfigChangeOp {
+	if m != nil {
+		return m.Op
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteExpiredAuthTokens(ctx, in)
+	return ConfigChangeOp_CONFIG_CHANGE_OP_UNKNOWN
+}
+
+func (m *ConfigChange) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/DeleteExpiredAuthTokens",
+	return ""
+}
+
+type ImportConfigRequest struct {
+	Config *ClusterAuthConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// decryption_key is the base64-encoded curve25519 private key that the
+	// secrets in config were encrypted to, if they were. Secrets that are
+	// omitted from config keep their current values.
+	DecryptionKey string `protobuf:"bytes,2,opt,name=decryption_key,json=decryptionKey,proto3" json:"decryption_key,omitempty"`
+	// If dry_run is set, the changes are computed but not applied
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportConfigRequest) Reset()         { *m = ImportConfigRequest{} }
+func (m *ImportConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportConfigRequest) ProtoMessage()    {}
+func (*ImportConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{79}
+}
+func (m *ImportConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteExpiredAuthTokens(ctx, req.(*DeleteExpiredAuthTokensRequest))
+}
+func (m *ImportConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportConfigRequest.Merge(m, src)
+}
+func (m *ImportConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportConfigRequest proto.InternalMessageInfo
+
+func (m *ImportConfigRequest) GetConfig() *ClusterAuthConfig {
+	if m != nil {
+		return m.Config
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_RotateRootToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RotateRootTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ImportConfigRequest) GetDecryptionKey() string {
+	if m != nil {
+		return m.DecryptionKey
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RotateRootToken(ctx, in)
+	return ""
+}
+
+func (m *ImportConfigRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auth_v2.API/RotateRootToken",
+	return false
+}
+
+type ImportConfigResponse struct {
+	Changes              []*ConfigChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ImportConfigResponse) Reset()         { *m = ImportConfigResponse{} }
+func (m *ImportConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportConfigResponse) ProtoMessage()    {}
+func (*ImportConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{80}
+}
+func (m *ImportConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RotateRootToken(ctx, req.(*RotateRootTokenRequest))
+}
+func (m *ImportConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportConfigResponse.Merge(m, src)
+}
+func (m *ImportConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportConfigResponse proto.InternalMessageInfo
+
+func (m *ImportConfigResponse) GetChanges() []*ConfigChange {
+	if m != nil {
+		return m.Changes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "auth_v2.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Activate",
-			Handler:    _API_Activate_Handler,
-		},
-		{
-			MethodName: "Deactivate",
-			Handler:    _API_Deactivate_Handler,
-		},
-		{
-			MethodName: "GetConfiguration",
-			Handler:    _API_GetConfiguration_Handler,
-		},
-		{
-			MethodName: "SetConfiguration",
-			Handler:    _API_SetConfiguration_Handler,
-		},
-		{
-			MethodName: "Authenticate",
-			Handler:    _API_Authenticate_Handler,
-		},
-		{
-			MethodName: "Authorize",
-			Handler:    _API_Authorize_Handler,
-		},
-		{
-			MethodName: "GetPermissions",
-			Handler:    _API_GetPermissions_Handler,
-		},
-		{
-			MethodName: "GetPermissionsForPrincipal",
-			Handler:    _API_GetPermissionsForPrincipal_Handler,
-		},
-		{
-			MethodName: "WhoAmI",
-			Handler:    _API_WhoAmI_Handler,
-		},
-		{
-			MethodName: "GetRolesForPermission",
-			Handler:    _API_GetRolesForPermission_Handler,
-		},
-		{
-			MethodName: "ModifyRoleBinding",
-			Handler:    _API_ModifyRoleBinding_Handler,
-		},
-		{
-			MethodName: "GetRoleBinding",
-			Handler:    _API_GetRoleBinding_Handler,
-		},
-		{
-			MethodName: "GetOIDCLogin",
-			Handler:    _API_GetOIDCLogin_Handler,
-		},
-		{
-			MethodName: "GetRobotToken",
-			Handler:    _API_GetRobotToken_Handler,
-		},
-		{
-			MethodName: "RevokeAuthToken",
-			Handler:    _API_RevokeAuthToken_Handler,
-		},
-		{
-			MethodName: "RevokeAuthTokensForUser",
-			Handler:    _API_RevokeAuthTokensForUser_Handler,
-		},
-		{
-			MethodName: "SetGroupsForUser",
-			Handler:    _API_SetGroupsForUser_Handler,
-		},
-		{
-			MethodName: "ModifyMembers",
-			Handler:    _API_ModifyMembers_Handler,
-		},
-		{
-			MethodName: "GetGroups",
-			Handler:    _API_GetGroups_Handler,
-		},
-		{
-			MethodName: "GetGroupsForPrincipal",
-			Handler:    _API_GetGroupsForPrincipal_Handler,
-		},
-		{
-			MethodName: "GetUsers",
-			Handler:    _API_GetUsers_Handler,
-		},
-		{
-			MethodName: "ExtractAuthTokens",
-			Handler:    _API_ExtractAuthTokens_Handler,
-		},
-		{
-			MethodName: "RestoreAuthToken",
-			Handler:    _API_RestoreAuthToken_Handler,
-		},
-		{
-			MethodName: "DeleteExpiredAuthTokens",
-			Handler:    _API_DeleteExpiredAuthTokens_Handler,
-		},
-		{
-			MethodName: "RotateRootToken",
-			Handler:    _API_RotateRootToken_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "auth/auth.proto",
+func init() {
+	proto.RegisterEnum("auth_v2.Permission", Permission_name, Permission_value)
+	proto.RegisterEnum("auth_v2.ResourceType", ResourceType_name, ResourceType_value)
+	proto.RegisterEnum("auth_v2.ConfigChangeOp", ConfigChangeOp_name, ConfigChangeOp_value)
+	proto.RegisterType((*ActivateRequest)(nil), "auth_v2.ActivateRequest")
+	proto.RegisterType((*ActivateResponse)(nil), "auth_v2.ActivateResponse")
+	proto.RegisterType((*DeactivateRequest)(nil), "auth_v2.DeactivateRequest")
+	proto.RegisterType((*DeactivateResponse)(nil), "auth_v2.DeactivateResponse")
+	proto.RegisterType((*RotateRootTokenRequest)(nil), "auth_v2.RotateRootTokenRequest")
+	proto.RegisterType((*RotateRootTokenResponse)(nil), "auth_v2.RotateRootTokenResponse")
+	proto.RegisterType((*OIDCConfig)(nil), "auth_v2.OIDCConfig")
+	proto.RegisterType((*GetConfigurationRequest)(nil), "auth_v2.GetConfigurationRequest")
+	proto.RegisterType((*GetConfigurationResponse)(nil), "auth_v2.GetConfigurationResponse")
+	proto.RegisterType((*SetConfigurationRequest)(nil), "auth_v2.SetConfigurationRequest")
+	proto.RegisterType((*SetConfigurationResponse)(nil), "auth_v2.SetConfigurationResponse")
+	proto.RegisterType((*TokenInfo)(nil), "auth_v2.TokenInfo")
+	proto.RegisterType((*TokenScope)(nil), "auth_v2.TokenScope")
+	proto.RegisterType((*AuthenticateRequest)(nil), "auth_v2.AuthenticateRequest")
+	proto.RegisterType((*AuthenticateResponse)(nil), "auth_v2.AuthenticateResponse")
+	proto.RegisterType((*WhoAmIRequest)(nil), "auth_v2.WhoAmIRequest")
+	proto.RegisterType((*WhoAmIResponse)(nil), "auth_v2.WhoAmIResponse")
+	proto.RegisterType((*GetRolesForPermissionRequest)(nil), "auth_v2.GetRolesForPermissionRequest")
+	proto.RegisterType((*GetRolesForPermissionResponse)(nil), "auth_v2.GetRolesForPermissionResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "auth_v2.CreateRoleRequest")
+	proto.RegisterType((*CreateRoleResponse)(nil), "auth_v2.CreateRoleResponse")
+	proto.RegisterType((*UpdateRoleRequest)(nil), "auth_v2.UpdateRoleRequest")
+	proto.RegisterType((*UpdateRoleResponse)(nil), "auth_v2.UpdateRoleResponse")
+	proto.RegisterType((*DeleteRoleRequest)(nil), "auth_v2.DeleteRoleRequest")
+	proto.RegisterType((*DeleteRoleResponse)(nil), "auth_v2.DeleteRoleResponse")
+	proto.RegisterType((*ListRoleRequest)(nil), "auth_v2.ListRoleRequest")
+	proto.RegisterType((*ListRoleResponse)(nil), "auth_v2.ListRoleResponse")
+	proto.RegisterType((*Roles)(nil), "auth_v2.Roles")
+	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Roles.RolesEntry")
+	proto.RegisterType((*RoleBinding)(nil), "auth_v2.RoleBinding")
+	proto.RegisterMapType((map[string]*RoleBinding)(nil), "auth_v2.RoleBinding.BranchesEntry")
+	proto.RegisterMapType((map[string]*Roles)(nil), "auth_v2.RoleBinding.EntriesEntry")
+	proto.RegisterMapType((map[string]*RoleBinding)(nil), "auth_v2.RoleBinding.PathsEntry")
+	proto.RegisterType((*Resource)(nil), "auth_v2.Resource")
+	proto.RegisterType((*Users)(nil), "auth_v2.Users")
+	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Users.UsernamesEntry")
+	proto.RegisterType((*Groups)(nil), "auth_v2.Groups")
+	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Groups.GroupsEntry")
+	proto.RegisterType((*Role)(nil), "auth_v2.Role")
+	proto.RegisterType((*AuthorizeRequest)(nil), "auth_v2.AuthorizeRequest")
+	proto.RegisterType((*AuthorizeResponse)(nil), "auth_v2.AuthorizeResponse")
+	proto.RegisterType((*GetPermissionsRequest)(nil), "auth_v2.GetPermissionsRequest")
+	proto.RegisterType((*GetPermissionsForPrincipalRequest)(nil), "auth_v2.GetPermissionsForPrincipalRequest")
+	proto.RegisterType((*GetPermissionsResponse)(nil), "auth_v2.GetPermissionsResponse")
+	proto.RegisterType((*ModifyRoleBindingRequest)(nil), "auth_v2.ModifyRoleBindingRequest")
+	proto.RegisterType((*ModifyRoleBindingResponse)(nil), "auth_v2.ModifyRoleBindingResponse")
+	proto.RegisterType((*GetRoleBindingRequest)(nil), "auth_v2.GetRoleBindingRequest")
+	proto.RegisterType((*GetRoleBindingResponse)(nil), "auth_v2.GetRoleBindingResponse")
+	proto.RegisterType((*SessionInfo)(nil), "auth_v2.SessionInfo")
+	proto.RegisterType((*GetOIDCLoginRequest)(nil), "auth_v2.GetOIDCLoginRequest")
+	proto.RegisterType((*GetOIDCLoginResponse)(nil), "auth_v2.GetOIDCLoginResponse")
+	proto.RegisterType((*GetRobotTokenRequest)(nil), "auth_v2.GetRobotTokenRequest")
+	proto.RegisterType((*GetRobotTokenResponse)(nil), "auth_v2.GetRobotTokenResponse")
+	proto.RegisterType((*GetScopedTokenRequest)(nil), "auth_v2.GetScopedTokenRequest")
+	proto.RegisterType((*GetScopedTokenResponse)(nil), "auth_v2.GetScopedTokenResponse")
+	proto.RegisterType((*ListAuthTokensRequest)(nil), "auth_v2.ListAuthTokensRequest")
+	proto.RegisterType((*ListAuthTokensResponse)(nil), "auth_v2.ListAuthTokensResponse")
+	proto.RegisterType((*RevokeAuthTokenRequest)(nil), "auth_v2.RevokeAuthTokenRequest")
+	proto.RegisterType((*RevokeAuthTokenResponse)(nil), "auth_v2.RevokeAuthTokenResponse")
+	proto.RegisterType((*SetGroupsForUserRequest)(nil), "auth_v2.SetGroupsForUserRequest")
+	proto.RegisterType((*SetGroupsForUserResponse)(nil), "auth_v2.SetGroupsForUserResponse")
+	proto.RegisterType((*ModifyMembersRequest)(nil), "auth_v2.ModifyMembersRequest")
+	proto.RegisterType((*ModifyMembersResponse)(nil), "auth_v2.ModifyMembersResponse")
+	proto.RegisterType((*GetGroupsRequest)(nil), "auth_v2.GetGroupsRequest")
+	proto.RegisterType((*GetGroupsForPrincipalRequest)(nil), "auth_v2.GetGroupsForPrincipalRequest")
+	proto.RegisterType((*GetGroupsResponse)(nil), "auth_v2.GetGroupsResponse")
+	proto.RegisterType((*GetUsersRequest)(nil), "auth_v2.GetUsersRequest")
+	proto.RegisterType((*GetUsersResponse)(nil), "auth_v2.GetUsersResponse")
+	proto.RegisterType((*ExtractAuthTokensRequest)(nil), "auth_v2.ExtractAuthTokensRequest")
+	proto.RegisterType((*ExtractAuthTokensResponse)(nil), "auth_v2.ExtractAuthTokensResponse")
+	proto.RegisterType((*RestoreAuthTokenRequest)(nil), "auth_v2.RestoreAuthTokenRequest")
+	proto.RegisterType((*RestoreAuthTokenResponse)(nil), "auth_v2.RestoreAuthTokenResponse")
+	proto.RegisterType((*RevokeAuthTokensForUserRequest)(nil), "auth_v2.RevokeAuthTokensForUserRequest")
+	proto.RegisterType((*RevokeAuthTokensForUserResponse)(nil), "auth_v2.RevokeAuthTokensForUserResponse")
+	proto.RegisterType((*DeleteExpiredAuthTokensRequest)(nil), "auth_v2.DeleteExpiredAuthTokensRequest")
+	proto.RegisterType((*DeleteExpiredAuthTokensResponse)(nil), "auth_v2.DeleteExpiredAuthTokensResponse")
+	proto.RegisterType((*AuditEvent)(nil), "auth_v2.AuditEvent")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "auth_v2.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "auth_v2.ListAuditEventsResponse")
+	proto.RegisterType((*ResourceRoleBinding)(nil), "auth_v2.ResourceRoleBinding")
+	proto.RegisterType((*GroupMembers)(nil), "auth_v2.GroupMembers")
+	proto.RegisterType((*ClusterAuthConfig)(nil), "auth_v2.ClusterAuthConfig")
+	proto.RegisterType((*ExportConfigRequest)(nil), "auth_v2.ExportConfigRequest")
+	proto.RegisterType((*ExportConfigResponse)(nil), "auth_v2.ExportConfigResponse")
+	proto.RegisterType((*ConfigChange)(nil), "auth_v2.ConfigChange")
+	proto.RegisterType((*ImportConfigRequest)(nil), "auth_v2.ImportConfigRequest")
+	proto.RegisterType((*ImportConfigResponse)(nil), "auth_v2.ImportConfigResponse")
 }
 
-func (m *ActivateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
+
+var fileDescriptor_712ec48c1eaf43a2 = []byte{
+	// 3971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xe9, 0x77, 0xdb, 0x48,
+	0x72, 0x1f, 0x90, 0x3a, 0xa8, 0xd2, 0x05, 0xb5, 0x2e, 0x0a, 0xba, 0xe1, 0x9d, 0xb5, 0xc7, 0x9b,
+	0x91, 0x66, 0x34, 0x3b, 0xb3, 0xb3, 0x33, 0x4e, 0x5e, 0x28, 0x12, 0xa6, 0x31, 0x96, 0x48, 0x6e,
+	0x03, 0xb4, 0x67, 0xf2, 0x92, 0x20, 0x14, 0xd9, 0x96, 0x10, 0x53, 0x04, 0x07, 0x00, 0x35, 0xd6,
+	0x26, 0x9b, 0x6c, 0xee, 0xec, 0xe6, 0xda, 0xdc, 0xe7, 0xf7, 0x7c, 0xc9, 0x9d, 0xbc, 0x97, 0x7f,
+	0x61, 0x73, 0x6f, 0xce, 0x6f, 0x71, 0xf6, 0xf9, 0x2f, 0xc8, 0xcb, 0xd7, 0x7c, 0xc9, 0xeb, 0x46,
+	0x03, 0x68, 0x80, 0xa0, 0x64, 0x7b, 0xb2, 0x5f, 0x64, 0x76, 0xd5, 0xaf, 0xaa, 0xab, 0xab, 0xab,
+	0xab, 0x8f, 0x82, 0x61, 0xbe, 0x35, 0xf0, 0xcf, 0xf6, 0xe9, 0x9f, 0xbd, 0xbe, 0xeb, 0xf8, 0x0e,
+	0x9a, 0xa4, 0xbf, 0xad, 0x8b, 0x03, 0x65, 0xe9, 0xd4, 0x39, 0x75, 0x18, 0x6d, 0x9f, 0xfe, 0x0a,
+	0xd8, 0xca, 0xf6, 0xa9, 0xe3, 0x9c, 0x76, 0xc9, 0x3e, 0x6b, 0x9d, 0x0c, 0x1e, 0xed, 0xfb, 0xf6,
+	0x39, 0xf1, 0xfc, 0xd6, 0x79, 0x9f, 0x03, 0x56, 0xed, 0x0e, 0xe9, 0xf9, 0xb6, 0x7f, 0xb9, 0x1f,
+	0xfe, 0x08, 0x18, 0xea, 0x1b, 0x30, 0x5f, 0x6a, 0xfb, 0xf6, 0x45, 0xcb, 0x27, 0x98, 0x7c, 0x3c,
+	0x20, 0x9e, 0x8f, 0x36, 0x01, 0x5c, 0xc7, 0xf1, 0x2d, 0xdf, 0x79, 0x4c, 0x7a, 0x45, 0x69, 0x47,
+	0xba, 0x35, 0x85, 0xa7, 0x28, 0xc5, 0xa4, 0x04, 0xf5, 0x4d, 0x90, 0x63, 0x09, 0xaf, 0xef, 0xf4,
+	0x3c, 0x42, 0x45, 0xfa, 0xad, 0xf6, 0x59, 0x52, 0x84, 0x52, 0x02, 0x91, 0x45, 0x58, 0xa8, 0x90,
+	0x56, 0xb2, 0x1b, 0x75, 0x09, 0x90, 0x48, 0x0c, 0x34, 0xa9, 0x5f, 0x80, 0x15, 0xec, 0xf8, 0x94,
+	0x12, 0x76, 0xf8, 0x9c, 0x66, 0xbd, 0x0b, 0xab, 0x43, 0x82, 0xb1, 0x75, 0x57, 0x49, 0x7e, 0x3b,
+	0x07, 0x50, 0xd7, 0x2b, 0xe5, 0xb2, 0xd3, 0x7b, 0x64, 0x9f, 0xa2, 0x15, 0x98, 0xb0, 0x3d, 0x6f,
+	0x40, 0x5c, 0x8e, 0xe4, 0x2d, 0xf4, 0x1a, 0x4c, 0xb5, 0xbb, 0x36, 0xe9, 0xf9, 0x96, 0xdd, 0x29,
+	0xe6, 0x28, 0xeb, 0x70, 0xe6, 0xd9, 0xd3, 0xed, 0x42, 0x99, 0x11, 0xf5, 0x0a, 0x2e, 0x04, 0x6c,
+	0xbd, 0x83, 0x6e, 0xc0, 0x2c, 0x87, 0x7a, 0xa4, 0xed, 0x12, 0xbf, 0x98, 0x67, 0x9a, 0x66, 0x02,
+	0xa2, 0xc1, 0x68, 0xe8, 0x00, 0x66, 0x5c, 0xd2, 0xb1, 0x5d, 0xd2, 0xf6, 0xad, 0x81, 0x6b, 0x17,
+	0xc7, 0x98, 0xca, 0xf9, 0x67, 0x4f, 0xb7, 0xa7, 0x31, 0xa7, 0x37, 0xb1, 0x8e, 0xa7, 0x43, 0x50,
+	0xd3, 0xb5, 0xa9, 0x6d, 0x5e, 0xdb, 0xe9, 0x13, 0xaf, 0x38, 0xbe, 0x93, 0xa7, 0xb6, 0x05, 0x2d,
+	0xf4, 0x79, 0x58, 0x71, 0xc9, 0xc7, 0x03, 0xdb, 0x25, 0x16, 0x39, 0x6f, 0xd9, 0x5d, 0xeb, 0x82,
+	0xb8, 0xf6, 0x23, 0x9b, 0x74, 0x8a, 0x13, 0x3b, 0xd2, 0xad, 0x02, 0x5e, 0xe2, 0x5c, 0x8d, 0x32,
+	0x1f, 0x70, 0x1e, 0x7a, 0x0d, 0xe4, 0xae, 0xd3, 0x6e, 0x75, 0xcf, 0x1c, 0xcf, 0xb7, 0xf8, 0x98,
+	0x27, 0x19, 0x7e, 0x3e, 0xa2, 0xeb, 0xc1, 0xe0, 0xbf, 0x1b, 0xd6, 0x07, 0x1e, 0x71, 0xad, 0x56,
+	0xbb, 0x4d, 0x3c, 0xcf, 0x3e, 0xe9, 0x12, 0x2e, 0x60, 0x51, 0x50, 0xb1, 0xc0, 0xc6, 0x57, 0xa4,
+	0x90, 0x52, 0x84, 0x08, 0x44, 0xef, 0x39, 0x9e, 0xaf, 0xae, 0xc1, 0x6a, 0x95, 0xf8, 0x81, 0x83,
+	0x07, 0x6e, 0xcb, 0xb7, 0x9d, 0x70, 0x5a, 0xd5, 0x26, 0x14, 0x87, 0x59, 0x7c, 0xe2, 0xbe, 0x08,
+	0xb3, 0x6d, 0x91, 0xc1, 0x66, 0x64, 0xfa, 0x60, 0x71, 0x8f, 0xaf, 0x86, 0xbd, 0x78, 0xda, 0x70,
+	0x12, 0xa9, 0x9a, 0xb0, 0x6a, 0x64, 0xf7, 0xf8, 0x69, 0xb4, 0x2a, 0x50, 0x34, 0x46, 0x18, 0xab,
+	0xfe, 0xa7, 0x04, 0x53, 0x2c, 0xa0, 0xf4, 0xde, 0x23, 0x07, 0x15, 0x61, 0xd2, 0x1b, 0x9c, 0xfc,
+	0x30, 0x69, 0xfb, 0x3c, 0x8c, 0xc2, 0x26, 0x32, 0x00, 0xc8, 0x93, 0xbe, 0xcd, 0xfb, 0xce, 0xb1,
+	0xbe, 0x95, 0xbd, 0x60, 0x01, 0xef, 0x85, 0x0b, 0x78, 0xcf, 0x0c, 0x17, 0xf0, 0xe1, 0xea, 0xff,
+	0x3c, 0xdd, 0x9e, 0xef, 0x9c, 0xbc, 0xa7, 0xc6, 0x52, 0xea, 0x37, 0xfe, 0x6b, 0x5b, 0xc2, 0x82,
+	0x1a, 0xf4, 0x0e, 0xcc, 0x9c, 0xb5, 0xbc, 0x33, 0xd2, 0xe1, 0x41, 0xce, 0x02, 0xee, 0x70, 0x31,
+	0x14, 0x65, 0x44, 0x8b, 0x22, 0x54, 0x3c, 0x1d, 0x00, 0x99, 0xa9, 0xe8, 0x35, 0x18, 0x67, 0x21,
+	0x54, 0x1c, 0x4b, 0xf9, 0x80, 0xb1, 0x0d, 0xca, 0xc2, 0x01, 0x42, 0xfd, 0xba, 0x04, 0x10, 0x53,
+	0xd1, 0xdb, 0x30, 0xdd, 0x27, 0xee, 0xb9, 0xed, 0x79, 0xb6, 0xd3, 0xf3, 0x8a, 0xd2, 0x4e, 0xfe,
+	0xd6, 0x9c, 0x20, 0xdf, 0x88, 0x78, 0x58, 0xc4, 0xa1, 0x25, 0x18, 0x77, 0x9d, 0x2e, 0xf1, 0x8a,
+	0x39, 0x16, 0xc0, 0x41, 0x03, 0xed, 0xc3, 0x94, 0x4b, 0x3c, 0x67, 0xe0, 0xb6, 0x89, 0x57, 0xcc,
+	0xef, 0xe4, 0x6f, 0x4d, 0x1f, 0x2c, 0x44, 0xaa, 0x30, 0xe7, 0xe0, 0x18, 0xa3, 0xfe, 0x20, 0x2c,
+	0x96, 0x06, 0xfe, 0x19, 0x4d, 0x65, 0x6d, 0x21, 0x75, 0x7d, 0x17, 0x80, 0x63, 0x77, 0xda, 0x96,
+	0x47, 0x13, 0x41, 0xe0, 0xf8, 0xc3, 0xd9, 0x67, 0x4f, 0xb7, 0xa7, 0xe8, 0x94, 0x1a, 0x94, 0x88,
+	0xa7, 0x28, 0x80, 0xfd, 0x44, 0x6b, 0x50, 0xb0, 0x43, 0x87, 0xe5, 0x82, 0x49, 0xb2, 0x03, 0xbf,
+	0xa8, 0x6f, 0xc3, 0x52, 0x52, 0xff, 0xf3, 0x25, 0xba, 0x79, 0x98, 0x7d, 0x78, 0xe6, 0x94, 0xce,
+	0xf5, 0x30, 0xba, 0xff, 0x48, 0x82, 0xb9, 0x90, 0xc2, 0x55, 0x28, 0x50, 0xa0, 0xeb, 0xa4, 0xd7,
+	0x3a, 0xe7, 0x16, 0xe2, 0xa8, 0xfd, 0x9d, 0x89, 0x8d, 0x68, 0x8e, 0xf3, 0xd7, 0xce, 0xb1, 0x01,
+	0x1b, 0x55, 0xe2, 0x63, 0x3a, 0x27, 0x77, 0x1d, 0x57, 0x98, 0x43, 0xee, 0xdf, 0xb7, 0x00, 0xe2,
+	0xc9, 0x64, 0xd6, 0x8f, 0x98, 0x73, 0x01, 0xa6, 0x56, 0x60, 0x73, 0x84, 0x52, 0xee, 0x91, 0x1b,
+	0x61, 0x4c, 0x48, 0x6c, 0xe6, 0x67, 0xe3, 0x99, 0x77, 0xba, 0x84, 0x87, 0x88, 0xfa, 0x0e, 0x2c,
+	0x94, 0x5d, 0xc2, 0xf2, 0x7b, 0x37, 0x9a, 0xef, 0x5d, 0x18, 0xa3, 0x5c, 0xbe, 0x82, 0x53, 0x82,
+	0x8c, 0x45, 0xb7, 0x19, 0x51, 0x8e, 0x2f, 0xd6, 0x77, 0x60, 0xa1, 0xd9, 0xef, 0xbc, 0x94, 0x36,
+	0x51, 0x8e, 0x6b, 0xbb, 0x49, 0xf7, 0xb7, 0x2e, 0x49, 0x6a, 0x43, 0x30, 0x26, 0xcc, 0x31, 0xfb,
+	0x1d, 0xec, 0x79, 0x5d, 0x92, 0x12, 0x5f, 0x80, 0xf9, 0x23, 0xdb, 0xf3, 0x05, 0x61, 0xf5, 0x0b,
+	0x20, 0xc7, 0xa4, 0x17, 0x71, 0x93, 0x0b, 0xe3, 0x98, 0x2f, 0xa9, 0x04, 0x7a, 0x2d, 0x81, 0xf6,
+	0x82, 0xbf, 0x5a, 0xcf, 0x77, 0x2f, 0xb9, 0xa4, 0xf2, 0x2e, 0x40, 0x4c, 0x44, 0x32, 0xe4, 0x1f,
+	0x93, 0x4b, 0x6e, 0x3c, 0xfd, 0x49, 0x57, 0xee, 0x45, 0xab, 0x3b, 0x20, 0x2c, 0x2c, 0x0b, 0x38,
+	0x68, 0xbc, 0x97, 0x7b, 0x57, 0x52, 0x9f, 0xe5, 0x61, 0x9a, 0x8a, 0x1e, 0xda, 0xbd, 0x8e, 0xdd,
+	0x3b, 0x45, 0xef, 0xc3, 0x24, 0xe9, 0xf9, 0xae, 0x1d, 0x75, 0xbe, 0x9b, 0xe8, 0x9c, 0xc3, 0xf6,
+	0xb4, 0x00, 0x13, 0x18, 0x11, 0x4a, 0xa0, 0xef, 0x81, 0xc2, 0x89, 0xdb, 0xea, 0xb5, 0xcf, 0x78,
+	0x8e, 0x98, 0x3e, 0x50, 0x33, 0xa5, 0x0f, 0x39, 0x28, 0x10, 0x8f, 0x64, 0xd0, 0xdb, 0x30, 0xde,
+	0x6f, 0xf9, 0x67, 0x61, 0x1a, 0xd9, 0xce, 0x14, 0x6e, 0x50, 0x04, 0x1f, 0x3d, 0x43, 0xa3, 0x37,
+	0xa0, 0xd0, 0xb7, 0xfb, 0xa4, 0x6b, 0xf7, 0xc2, 0x5c, 0xb8, 0x94, 0x25, 0x89, 0x23, 0x94, 0xf2,
+	0x01, 0xcc, 0x88, 0x23, 0xc8, 0xf0, 0xd8, 0x67, 0x44, 0x8f, 0x4d, 0x1f, 0xcc, 0x25, 0xa7, 0x40,
+	0xf0, 0xa0, 0xf2, 0x25, 0x98, 0x4d, 0x8c, 0x27, 0x43, 0xd9, 0xed, 0xa4, 0xb2, 0x6c, 0xeb, 0x04,
+	0x95, 0x35, 0x80, 0x78, 0x94, 0x9f, 0x5e, 0x9f, 0xaa, 0x43, 0x21, 0x4c, 0xc4, 0xe8, 0x35, 0x18,
+	0xf3, 0x2f, 0xfb, 0x84, 0x27, 0x80, 0xe5, 0xa1, 0x4c, 0x6d, 0x5e, 0xf6, 0x09, 0x66, 0x90, 0x68,
+	0x15, 0xe4, 0x84, 0x55, 0xf0, 0x93, 0x12, 0x8c, 0x37, 0x3d, 0xe2, 0x7a, 0xe8, 0x7d, 0x98, 0x0a,
+	0x73, 0x5f, 0x18, 0x2b, 0x9b, 0x91, 0x36, 0x06, 0xd9, 0x6b, 0x86, 0xfc, 0x60, 0xba, 0x62, 0xbc,
+	0x72, 0x07, 0xe6, 0x92, 0xcc, 0x17, 0x0a, 0xda, 0x27, 0x30, 0x51, 0x75, 0x9d, 0x41, 0xdf, 0x43,
+	0x6f, 0xc1, 0xc4, 0x29, 0xfb, 0xc5, 0x2d, 0x58, 0x8f, 0x2c, 0x08, 0x00, 0xfc, 0x9f, 0xa0, 0x7f,
+	0x0e, 0x55, 0xbe, 0x08, 0xd3, 0x02, 0xf9, 0x85, 0x7a, 0xfe, 0x43, 0x09, 0xc6, 0xa8, 0x93, 0xb3,
+	0x32, 0x44, 0x7a, 0x5b, 0xcd, 0x3d, 0xe7, 0xb6, 0x7a, 0x07, 0xe6, 0xc2, 0xcd, 0xd1, 0xa2, 0x7e,
+	0x0f, 0xc2, 0x7f, 0xe4, 0xdc, 0xcc, 0xba, 0x42, 0xcb, 0xa3, 0xc7, 0xca, 0xf6, 0xc0, 0xf3, 0x9d,
+	0x73, 0x16, 0xfa, 0x05, 0xcc, 0x5b, 0xea, 0x13, 0x90, 0xe9, 0x2e, 0xe8, 0xb8, 0xf6, 0x97, 0xa3,
+	0xb4, 0xf6, 0x3a, 0x14, 0x42, 0x61, 0x9e, 0x28, 0x33, 0x76, 0xea, 0x08, 0xf2, 0x92, 0xe3, 0x51,
+	0xff, 0x52, 0x82, 0x05, 0xa1, 0x6b, 0x9e, 0x01, 0xb7, 0x00, 0x5a, 0x21, 0xb1, 0xc3, 0x7a, 0x2f,
+	0x60, 0x81, 0x82, 0xde, 0x84, 0x29, 0xaf, 0xe5, 0xdb, 0x1e, 0x3b, 0xf9, 0x5e, 0xd1, 0x55, 0x8c,
+	0x42, 0xaf, 0xc3, 0x24, 0xa3, 0xf6, 0x4e, 0x8b, 0xf9, 0xd1, 0x02, 0x21, 0x06, 0x6d, 0xc0, 0x54,
+	0xdf, 0xb5, 0x7b, 0x6d, 0xbb, 0xdf, 0xea, 0x06, 0x27, 0x76, 0x1c, 0x13, 0xd4, 0xbb, 0xb0, 0x5c,
+	0x25, 0x7e, 0x2c, 0xe7, 0xbd, 0x9c, 0xd3, 0xd4, 0x3e, 0xec, 0x26, 0xf5, 0xd0, 0x7d, 0x33, 0xec,
+	0xe5, 0x25, 0x27, 0x22, 0x61, 0x79, 0x2e, 0x6d, 0x39, 0x81, 0x95, 0xb4, 0xe5, 0xdc, 0xe7, 0xff,
+	0x9f, 0xe7, 0x3c, 0xf5, 0x2b, 0x50, 0x3c, 0x76, 0x3a, 0xf6, 0xa3, 0x4b, 0x31, 0xc9, 0x7c, 0x07,
+	0xc6, 0x13, 0x77, 0x9f, 0x17, 0xbb, 0x5f, 0x87, 0xb5, 0x8c, 0xee, 0xf9, 0x2e, 0x1c, 0x4c, 0xde,
+	0xa7, 0x36, 0x4c, 0xbd, 0x07, 0x2b, 0x69, 0x3d, 0xdc, 0x95, 0x7b, 0x30, 0x79, 0x12, 0x90, 0x8a,
+	0xd2, 0x15, 0x49, 0x37, 0x04, 0xa9, 0x3f, 0x04, 0xd3, 0x06, 0x61, 0xfe, 0x64, 0x57, 0x8a, 0x25,
+	0x18, 0xef, 0x39, 0xbd, 0x76, 0x98, 0x2f, 0x82, 0x06, 0xa5, 0xb2, 0x2b, 0x1f, 0xf7, 0x41, 0xd0,
+	0x40, 0xaf, 0xc2, 0x5c, 0xdb, 0xe9, 0x5d, 0x10, 0x97, 0x4a, 0x5b, 0xc4, 0x75, 0xd9, 0xe1, 0xaf,
+	0x80, 0x67, 0x63, 0xaa, 0xe6, 0xba, 0xea, 0x32, 0x2c, 0x56, 0x89, 0x4f, 0x0f, 0xc7, 0x47, 0xce,
+	0xa9, 0x1d, 0xdd, 0xc9, 0x1e, 0xc2, 0x52, 0x92, 0xcc, 0x07, 0xf0, 0x1a, 0x4c, 0x75, 0x29, 0xc1,
+	0x1a, 0xb8, 0xdd, 0xa2, 0x14, 0x5f, 0x81, 0x19, 0xaa, 0x89, 0x8f, 0x70, 0x81, 0xb1, 0x9b, 0x2e,
+	0x9b, 0x80, 0xe0, 0x10, 0xce, 0xcd, 0x62, 0x0d, 0xd5, 0x65, 0x8a, 0xb1, 0x73, 0x92, 0xba, 0xdb,
+	0xb3, 0xe9, 0x3a, 0x71, 0xc2, 0xbb, 0x52, 0xd0, 0x40, 0x6b, 0x90, 0xf7, 0xfd, 0x60, 0x60, 0xf9,
+	0xc3, 0xc9, 0x67, 0x4f, 0xb7, 0xf3, 0xa6, 0x79, 0x84, 0x29, 0xed, 0x45, 0xce, 0xb4, 0xaf, 0xc3,
+	0x72, 0xaa, 0x4f, 0x3e, 0x9a, 0x25, 0x18, 0x17, 0x8f, 0xf1, 0x41, 0x43, 0xfd, 0x01, 0x06, 0x67,
+	0x1a, 0x3a, 0x09, 0x1b, 0xa3, 0x2e, 0xa5, 0xeb, 0xba, 0xbc, 0xc2, 0x70, 0x75, 0x8f, 0x45, 0x47,
+	0x42, 0xfd, 0x95, 0xe6, 0xbc, 0x09, 0xcb, 0xf4, 0x20, 0x48, 0x73, 0x21, 0x83, 0x47, 0x29, 0x65,
+	0xe4, 0x05, 0x53, 0xad, 0xc0, 0x4a, 0x5a, 0x84, 0x77, 0x71, 0x1b, 0x26, 0x98, 0xd6, 0x70, 0xa7,
+	0x43, 0xc9, 0x31, 0xd0, 0x28, 0xc3, 0x1c, 0xa1, 0x7e, 0x09, 0x56, 0x30, 0xb9, 0x70, 0x1e, 0x93,
+	0x48, 0x8f, 0x30, 0x59, 0xc3, 0x86, 0xa2, 0xdd, 0xd4, 0x0d, 0x34, 0x98, 0x77, 0xf1, 0xb2, 0x49,
+	0x5f, 0x01, 0x86, 0x54, 0xf2, 0xc5, 0x77, 0xcc, 0xae, 0xeb, 0xc1, 0x8e, 0x7a, 0xd7, 0x71, 0xe9,
+	0xbe, 0x1e, 0x76, 0x77, 0xd5, 0x7d, 0x69, 0x25, 0xda, 0xba, 0x83, 0x34, 0xc3, 0x5b, 0xfc, 0x9e,
+	0x9e, 0x52, 0xc7, 0xbb, 0x7a, 0x00, 0x4b, 0x41, 0x12, 0x38, 0x26, 0xe7, 0x27, 0xc4, 0xf5, 0x84,
+	0x61, 0x31, 0xe9, 0x70, 0x58, 0xac, 0x41, 0x37, 0xf6, 0x56, 0xa7, 0xc3, 0xd5, 0xd3, 0x9f, 0xb4,
+	0x4f, 0x97, 0x9c, 0x3b, 0x17, 0x84, 0xe7, 0x16, 0xde, 0x52, 0x57, 0x61, 0x39, 0xa5, 0x97, 0x77,
+	0x88, 0x40, 0xae, 0x86, 0xc6, 0x84, 0x2b, 0xec, 0x0e, 0x6c, 0x44, 0xb4, 0xac, 0xe4, 0x9e, 0xc8,
+	0x6e, 0x52, 0x3a, 0x5b, 0x7f, 0x0e, 0x16, 0x04, 0x8d, 0x7c, 0x72, 0x57, 0x12, 0xc7, 0x98, 0xd8,
+	0x17, 0x37, 0x61, 0xbe, 0x4a, 0x7c, 0x76, 0x98, 0xba, 0x72, 0xa8, 0xea, 0x1b, 0x20, 0xc7, 0x40,
+	0xae, 0x74, 0x23, 0x7d, 0x40, 0x9b, 0x12, 0x4e, 0x60, 0xd4, 0xcd, 0xda, 0x13, 0xdf, 0x6d, 0xb5,
+	0x87, 0xe3, 0x53, 0xad, 0xc2, 0x5a, 0x06, 0xef, 0x25, 0x02, 0xb1, 0x4c, 0xa3, 0xc6, 0xf3, 0x1d,
+	0x77, 0x38, 0x12, 0x6f, 0x89, 0x91, 0x98, 0xad, 0x85, 0x2f, 0x23, 0x05, 0x8a, 0xc3, 0x4a, 0xf8,
+	0xfc, 0xdc, 0x81, 0xad, 0x54, 0x58, 0xbe, 0x40, 0x08, 0xaa, 0xbb, 0xb0, 0x3d, 0x52, 0x9a, 0x77,
+	0xb0, 0x03, 0x5b, 0xc1, 0xad, 0x4f, 0xa3, 0x97, 0x72, 0xd2, 0x19, 0x76, 0xd6, 0x2e, 0x6c, 0x8f,
+	0x44, 0x70, 0x25, 0xff, 0x2b, 0x01, 0x94, 0x06, 0x1d, 0xdb, 0xd7, 0x2e, 0x48, 0xcf, 0x47, 0x73,
+	0x90, 0xb3, 0x83, 0x23, 0x50, 0x1e, 0xe7, 0xec, 0x0e, 0xda, 0x83, 0x31, 0xfa, 0xe6, 0x7b, 0xfd,
+	0x9b, 0x01, 0x66, 0xb8, 0x64, 0x80, 0xe5, 0xd3, 0xdb, 0xe7, 0x0a, 0x4c, 0x9c, 0x13, 0xff, 0xcc,
+	0xe9, 0xf0, 0x33, 0x0e, 0x6f, 0x25, 0xb6, 0xc2, 0xf1, 0xeb, 0xf7, 0xe8, 0x22, 0x4c, 0xb6, 0xba,
+	0x5d, 0xe7, 0x93, 0xe8, 0x1d, 0x32, 0x6c, 0x52, 0x8e, 0x1b, 0x8c, 0x9d, 0xbd, 0x38, 0x4e, 0xe1,
+	0x49, 0x37, 0x8e, 0x4d, 0xe2, 0xba, 0x8e, 0xcb, 0xdf, 0x14, 0x83, 0x86, 0xfa, 0xd5, 0x5c, 0x98,
+	0xd4, 0x42, 0x0f, 0x44, 0xc1, 0xfc, 0x06, 0x8c, 0x7b, 0x76, 0x2f, 0xda, 0x9b, 0xaf, 0x1a, 0x7a,
+	0x00, 0xa4, 0x12, 0x83, 0x9e, 0xcf, 0xb7, 0xcc, 0x6b, 0x24, 0x18, 0xf0, 0x1a, 0x6f, 0x89, 0x5e,
+	0x19, 0xbb, 0xde, 0x2b, 0xdb, 0x30, 0xdd, 0x21, 0x3d, 0x9b, 0x74, 0x2c, 0xa7, 0xd7, 0xbd, 0x64,
+	0x7e, 0x2c, 0x60, 0x08, 0x48, 0xf5, 0x5e, 0x97, 0x5d, 0x1d, 0xba, 0xf6, 0xb9, 0xed, 0x33, 0xa7,
+	0xe5, 0x71, 0xd0, 0x50, 0xef, 0xc2, 0xea, 0x90, 0x07, 0xf8, 0x72, 0xfa, 0x1c, 0x4c, 0x10, 0x46,
+	0xe1, 0xcb, 0x29, 0xde, 0x9b, 0x62, 0x34, 0xe6, 0x10, 0xd5, 0x87, 0xc5, 0xc8, 0x28, 0xe1, 0xd2,
+	0xfe, 0x82, 0xc7, 0x2f, 0xe1, 0x2c, 0x93, 0x7b, 0x9e, 0xb3, 0xcc, 0x21, 0xcc, 0xb0, 0x7c, 0xc5,
+	0x93, 0xe3, 0x88, 0x6c, 0x9b, 0x48, 0x37, 0xb9, 0x74, 0xba, 0xf9, 0xeb, 0x3c, 0x2c, 0x94, 0xbb,
+	0x03, 0xcf, 0x27, 0x2e, 0x5d, 0x20, 0xfc, 0xbd, 0xfe, 0xf3, 0x30, 0xcd, 0x7a, 0x0e, 0x5e, 0x6a,
+	0xaf, 0x7a, 0xcc, 0x65, 0x57, 0x05, 0x2e, 0x75, 0x43, 0x3c, 0x9f, 0x8e, 0x78, 0x4c, 0x41, 0xaf,
+	0x47, 0x29, 0x35, 0x78, 0x4c, 0x58, 0x4e, 0xde, 0x0c, 0xc3, 0x44, 0xcf, 0x41, 0xa8, 0x04, 0xb3,
+	0x54, 0xce, 0xe2, 0x63, 0xf6, 0x8a, 0x63, 0x4c, 0x6a, 0x63, 0xd8, 0x8f, 0x82, 0x87, 0x66, 0xdc,
+	0xb8, 0xe1, 0xa1, 0x87, 0xb0, 0x12, 0x16, 0x68, 0x2c, 0x8f, 0xb8, 0x17, 0xc4, 0x0d, 0xc7, 0x15,
+	0x2c, 0xb7, 0xdd, 0xbd, 0x88, 0x7d, 0x71, 0xb0, 0xa7, 0xf3, 0xdf, 0x06, 0x43, 0xf2, 0x51, 0x2e,
+	0xd9, 0x19, 0x54, 0xf4, 0xbd, 0x30, 0x67, 0x77, 0xfa, 0x54, 0x59, 0x8f, 0xb4, 0x7d, 0xc7, 0xf5,
+	0x8a, 0x13, 0xfc, 0x5d, 0x28, 0xa1, 0xb0, 0xd2, 0x28, 0x87, 0x08, 0x3c, 0x6b, 0x77, 0xfa, 0x51,
+	0xcb, 0x43, 0xef, 0xc1, 0x0c, 0x7b, 0x5b, 0x0d, 0x8a, 0x18, 0x5e, 0x71, 0x92, 0xc9, 0xaf, 0x26,
+	0xe4, 0x99, 0xb3, 0x19, 0x1f, 0x4f, 0x53, 0x70, 0xf0, 0xdb, 0x53, 0xef, 0xc0, 0xa2, 0xf6, 0xa4,
+	0xef, 0xb8, 0xfc, 0xe9, 0x3c, 0x5c, 0xba, 0xaf, 0xc2, 0x1c, 0xe9, 0xb5, 0xdd, 0xcb, 0xbe, 0x4f,
+	0x4f, 0xa9, 0xf1, 0x05, 0x7a, 0x36, 0xa6, 0xde, 0x27, 0x97, 0xea, 0x07, 0xb0, 0x94, 0x94, 0xe6,
+	0x61, 0x7f, 0x00, 0x13, 0x89, 0x49, 0x57, 0x22, 0x47, 0x0f, 0x45, 0x09, 0xe6, 0x48, 0xf5, 0x13,
+	0x98, 0x09, 0x28, 0xe5, 0xb3, 0x56, 0xef, 0x94, 0xdd, 0xc1, 0x1f, 0xdb, 0xbd, 0x4e, 0x78, 0x07,
+	0xa7, 0xbf, 0xb3, 0xde, 0x2c, 0xd0, 0x4d, 0xc8, 0x39, 0x7d, 0xb6, 0xf4, 0xe7, 0x0e, 0x56, 0xe3,
+	0x7e, 0x04, 0x55, 0xf5, 0x3e, 0xce, 0x39, 0xfd, 0xe0, 0x78, 0xd0, 0xf2, 0x9c, 0x5e, 0x98, 0x3a,
+	0x83, 0x96, 0xfa, 0x35, 0x09, 0x16, 0xf5, 0xf3, 0x61, 0x1f, 0xbc, 0xc4, 0x20, 0xa8, 0xdf, 0x3a,
+	0x24, 0xe1, 0xb7, 0xc0, 0xd4, 0xd9, 0x98, 0x7a, 0x9f, 0x5c, 0xa2, 0x55, 0x98, 0xec, 0xb8, 0x97,
+	0x96, 0x3b, 0xe8, 0xf1, 0xd3, 0xff, 0x44, 0xc7, 0xbd, 0xc4, 0x83, 0x9e, 0x5a, 0x85, 0x25, 0xfd,
+	0x3c, 0xc3, 0xa1, 0xfb, 0x30, 0xd9, 0x66, 0x63, 0x09, 0x13, 0xc9, 0x72, 0xe6, 0x48, 0x71, 0x88,
+	0xba, 0xfd, 0xdf, 0x0b, 0x00, 0xf1, 0x0d, 0x10, 0xad, 0x00, 0x6a, 0x68, 0xf8, 0x58, 0x37, 0x0c,
+	0xbd, 0x5e, 0xb3, 0x9a, 0xb5, 0xfb, 0xb5, 0xfa, 0xc3, 0x9a, 0xfc, 0x0a, 0x5a, 0x87, 0xd5, 0xf2,
+	0x51, 0xd3, 0x30, 0x35, 0x6c, 0x1d, 0xd7, 0x2b, 0xfa, 0xdd, 0x8f, 0xac, 0x43, 0xbd, 0x56, 0xd1,
+	0x6b, 0x55, 0x43, 0xa6, 0x5b, 0xc1, 0x52, 0xc8, 0xac, 0x6a, 0x66, 0xcc, 0x21, 0x68, 0x1d, 0x56,
+	0x44, 0x4e, 0xa3, 0x54, 0xbe, 0x57, 0xb1, 0x8e, 0xea, 0x55, 0x43, 0xfe, 0x0d, 0x09, 0xad, 0xc1,
+	0x72, 0xc8, 0x2c, 0x35, 0xcd, 0x7b, 0x56, 0xa9, 0x6c, 0xea, 0x0f, 0x4a, 0xa6, 0x26, 0x3f, 0x12,
+	0xbb, 0x63, 0xac, 0x8a, 0x16, 0x31, 0x4f, 0x87, 0x98, 0x54, 0x73, 0xb9, 0x5e, 0xbb, 0xab, 0x57,
+	0xe5, 0xb3, 0x21, 0xa6, 0x11, 0x33, 0x6d, 0xb4, 0x0b, 0x1b, 0x43, 0x92, 0xb8, 0x7e, 0x58, 0x37,
+	0x2d, 0xb3, 0x7e, 0x5f, 0xab, 0xc9, 0xbf, 0x20, 0xa1, 0x57, 0x61, 0x37, 0x01, 0xe1, 0xa3, 0xad,
+	0xe2, 0x7a, 0xb3, 0x61, 0x1d, 0x6b, 0xc7, 0x87, 0x1a, 0x36, 0xe4, 0xf3, 0x4c, 0x1b, 0x18, 0xc6,
+	0x90, 0x7b, 0x68, 0x07, 0x36, 0xb2, 0x99, 0x56, 0xd3, 0xa0, 0xe2, 0x0e, 0xda, 0x86, 0xf5, 0x04,
+	0x42, 0xfb, 0xd0, 0xc4, 0xa5, 0x32, 0x37, 0xc3, 0x90, 0xfb, 0x68, 0x0b, 0x94, 0x04, 0x00, 0x6b,
+	0x86, 0x59, 0xc7, 0x1a, 0xb7, 0xf3, 0x63, 0xb4, 0x0f, 0xb7, 0x87, 0xba, 0x88, 0x27, 0xce, 0xb0,
+	0xee, 0xd6, 0xb1, 0xd5, 0xc0, 0x7a, 0xad, 0xac, 0x37, 0x4a, 0x47, 0xf2, 0x2f, 0x49, 0xe8, 0x26,
+	0xa8, 0x29, 0x8f, 0x1e, 0x69, 0xa6, 0x66, 0x69, 0x1f, 0x36, 0x74, 0xac, 0x55, 0xc2, 0x8e, 0x7f,
+	0x51, 0x42, 0x9f, 0x81, 0xed, 0x54, 0xcf, 0x0f, 0xea, 0xf7, 0x35, 0x66, 0x79, 0x88, 0xfa, 0x65,
+	0x09, 0xdd, 0x80, 0xad, 0x24, 0xaa, 0x6e, 0x96, 0x4c, 0xcd, 0xc2, 0xf5, 0xc8, 0x97, 0xbf, 0x2e,
+	0xa1, 0x2d, 0x58, 0xcb, 0xf2, 0x25, 0xae, 0x1f, 0x69, 0x86, 0xfc, 0x5b, 0xc3, 0x4a, 0x8e, 0x74,
+	0xc3, 0xb4, 0x4a, 0xcd, 0x8a, 0x6e, 0x5a, 0xda, 0x03, 0xad, 0x66, 0x1a, 0xf2, 0x6f, 0x4b, 0x68,
+	0x3b, 0xe5, 0x09, 0xed, 0xc3, 0x46, 0x1d, 0x47, 0x73, 0xfa, 0x3b, 0xc3, 0x00, 0xfd, 0x58, 0x04,
+	0xfc, 0xae, 0x24, 0x3a, 0x5b, 0xab, 0x99, 0x1a, 0x6e, 0x60, 0xdd, 0xd0, 0xe2, 0x68, 0x73, 0xc5,
+	0xf9, 0x12, 0x00, 0xf7, 0xb4, 0x12, 0x36, 0x0f, 0xb5, 0x92, 0x29, 0x7b, 0x23, 0x54, 0x04, 0x81,
+	0x57, 0xd1, 0x64, 0x5a, 0x78, 0xd8, 0xcc, 0x00, 0x08, 0x61, 0x3b, 0x40, 0x9b, 0x50, 0xcc, 0x80,
+	0x34, 0x4a, 0x4d, 0x43, 0x93, 0x7f, 0x33, 0x61, 0xa5, 0x5e, 0xd1, 0x6a, 0xa6, 0x6e, 0x7e, 0x24,
+	0x06, 0xef, 0x45, 0x26, 0x40, 0x08, 0xfd, 0x4f, 0x32, 0x01, 0x65, 0xac, 0xd1, 0x79, 0xd1, 0x2b,
+	0x0d, 0xf9, 0x49, 0x26, 0xa0, 0xd9, 0xa8, 0x84, 0x80, 0x4b, 0x31, 0xea, 0x22, 0x00, 0x9b, 0x14,
+	0xbd, 0xd2, 0x30, 0xe4, 0x2f, 0xa3, 0x0d, 0x28, 0x0e, 0xf1, 0xa9, 0x09, 0x54, 0xfa, 0x47, 0x32,
+	0xd5, 0xf3, 0x30, 0xa3, 0x80, 0x1f, 0x45, 0x37, 0xe1, 0xc6, 0x28, 0x03, 0xe9, 0xb6, 0x63, 0x95,
+	0x8f, 0x74, 0xad, 0x66, 0xca, 0x5f, 0xc9, 0x04, 0x72, 0x43, 0x45, 0xe0, 0x8f, 0xa1, 0xcf, 0x82,
+	0x3a, 0x04, 0x64, 0x06, 0x0b, 0x30, 0x43, 0xfe, 0x71, 0xf4, 0x2a, 0xec, 0x64, 0x1a, 0x2e, 0x6a,
+	0xfb, 0xaa, 0x84, 0x6e, 0xc1, 0x8d, 0x51, 0x23, 0x10, 0x91, 0x3f, 0x21, 0xa1, 0x55, 0x40, 0x21,
+	0xb2, 0xa2, 0x1d, 0x36, 0xab, 0x56, 0xa5, 0x79, 0xdc, 0x90, 0x7f, 0x4a, 0x12, 0x67, 0xf9, 0x48,
+	0x2f, 0x6b, 0x35, 0x31, 0xd2, 0x7e, 0x3a, 0x93, 0x1d, 0x45, 0xd1, 0xcf, 0x48, 0x68, 0x07, 0xd6,
+	0xd3, 0xec, 0x52, 0xa5, 0x62, 0x71, 0x9a, 0xfc, 0xb3, 0x89, 0x35, 0x13, 0x22, 0xb8, 0x67, 0x42,
+	0xd0, 0xcf, 0x65, 0x82, 0xf8, 0x30, 0x42, 0xd0, 0xcf, 0x4b, 0x48, 0x85, 0xcd, 0x34, 0x88, 0xb9,
+	0x8e, 0x13, 0x0d, 0xf9, 0x6b, 0x12, 0x52, 0xe2, 0x14, 0xcd, 0x27, 0xca, 0xd0, 0xca, 0x58, 0x33,
+	0xe5, 0x5f, 0xa1, 0xe9, 0x7b, 0x29, 0x96, 0x37, 0x4c, 0xce, 0x31, 0xe4, 0x6f, 0x48, 0x08, 0xc1,
+	0x6c, 0xd0, 0xe2, 0xdd, 0xca, 0xbf, 0x2a, 0xa1, 0x45, 0x98, 0xe3, 0x34, 0xbd, 0x66, 0x34, 0xb4,
+	0xb2, 0x29, 0xff, 0x5a, 0xca, 0x8d, 0xcc, 0xc0, 0xd2, 0xd1, 0x91, 0xfc, 0xf5, 0x04, 0x83, 0x26,
+	0xbe, 0x52, 0x55, 0xb3, 0xaa, 0x65, 0xf9, 0xf7, 0x24, 0xb4, 0x01, 0xab, 0x69, 0x46, 0xa8, 0xef,
+	0xf7, 0x13, 0xf6, 0x86, 0x5c, 0xa3, 0x8c, 0x9b, 0x87, 0xf2, 0x1f, 0x48, 0x68, 0x0e, 0xa6, 0xb0,
+	0xd6, 0xa8, 0x5b, 0x58, 0x2b, 0x55, 0xe4, 0x6f, 0x4a, 0x68, 0x1e, 0x80, 0xb5, 0x1f, 0x62, 0xdd,
+	0xd4, 0xe4, 0xbf, 0x61, 0x03, 0x62, 0x84, 0xf4, 0x06, 0xf7, 0xb7, 0x12, 0x92, 0x61, 0x9a, 0xb1,
+	0xf8, 0x70, 0xfe, 0x4e, 0x42, 0x45, 0x58, 0x64, 0x14, 0xde, 0xb9, 0x55, 0xae, 0x1f, 0x1f, 0xeb,
+	0xa6, 0xfc, 0xf7, 0x12, 0x5a, 0x06, 0x99, 0x71, 0x02, 0x67, 0x06, 0xe4, 0x7f, 0x60, 0x23, 0x12,
+	0x54, 0x84, 0x8c, 0x7f, 0x8c, 0x19, 0xdc, 0xc1, 0x87, 0xb8, 0x54, 0x2b, 0xdf, 0x93, 0xff, 0x29,
+	0xa5, 0x88, 0x93, 0xbf, 0x35, 0xa4, 0x88, 0x33, 0xfe, 0x59, 0x42, 0x2b, 0xb0, 0x90, 0x30, 0xe9,
+	0xae, 0x7e, 0xa4, 0xc9, 0xff, 0xc2, 0x3c, 0x1f, 0xeb, 0x61, 0xc4, 0x7f, 0x65, 0x81, 0xc8, 0x88,
+	0x34, 0xbc, 0x1a, 0x7a, 0x43, 0x3b, 0xd2, 0x6b, 0x1a, 0x73, 0x8d, 0x86, 0xe5, 0x7f, 0x63, 0x81,
+	0xc8, 0x9d, 0x75, 0x5c, 0x7f, 0xa0, 0x0d, 0x21, 0xfe, 0x7d, 0x84, 0x02, 0xe6, 0x4b, 0x2c, 0xff,
+	0x07, 0x33, 0x26, 0xa2, 0xb2, 0x8e, 0x3f, 0xa8, 0x1f, 0xca, 0x7f, 0x9c, 0xa3, 0x4e, 0x8e, 0xe8,
+	0x3c, 0x70, 0xa9, 0xb5, 0xf2, 0x9f, 0xe4, 0xa8, 0x4b, 0x23, 0x96, 0x61, 0x96, 0xb0, 0x49, 0xe7,
+	0xb0, 0x21, 0xff, 0x69, 0x8e, 0x0e, 0x39, 0xb6, 0xa0, 0x59, 0xb3, 0x2a, 0x25, 0xb3, 0x79, 0x2c,
+	0xff, 0x59, 0x8a, 0xa1, 0x95, 0xf8, 0xd9, 0xe2, 0xcf, 0x73, 0x34, 0x10, 0x92, 0x8c, 0x30, 0x3a,
+	0xff, 0x22, 0x77, 0xdb, 0x83, 0x19, 0xb1, 0x94, 0x42, 0xcf, 0x21, 0x58, 0x33, 0xea, 0x4d, 0x5c,
+	0xd6, 0x2c, 0xf3, 0xa3, 0x86, 0x26, 0x1c, 0x7b, 0xa6, 0x61, 0x32, 0x5c, 0x31, 0x12, 0x2a, 0xc0,
+	0x18, 0x1d, 0xb1, 0x9c, 0x43, 0xb3, 0x30, 0x45, 0x8d, 0xb6, 0x58, 0x33, 0x8f, 0x00, 0x26, 0xf8,
+	0x2c, 0x8c, 0x51, 0x50, 0xa3, 0x64, 0xde, 0x93, 0xc7, 0xd1, 0x0c, 0x14, 0x42, 0x13, 0xe4, 0x89,
+	0xdb, 0x4f, 0x60, 0x2e, 0x79, 0xd4, 0x64, 0xc9, 0x94, 0xa5, 0x6e, 0xab, 0x7c, 0xaf, 0x54, 0xab,
+	0x6a, 0x56, 0xbd, 0x21, 0xf4, 0xbc, 0x00, 0xb3, 0x21, 0x97, 0xc5, 0x85, 0x2c, 0x09, 0xa4, 0xc0,
+	0x71, 0x72, 0x4e, 0x20, 0xf1, 0xc8, 0xcc, 0xa3, 0x79, 0x98, 0xe6, 0x24, 0xe3, 0xbe, 0xde, 0x90,
+	0xc7, 0x0e, 0xfe, 0x6a, 0x05, 0xf2, 0xa5, 0x86, 0x8e, 0x4a, 0x50, 0x08, 0xbf, 0xfa, 0x42, 0xc5,
+	0xf8, 0x7a, 0x99, 0xfc, 0xa6, 0x4b, 0x59, 0xcb, 0xe0, 0xf0, 0xf7, 0x8b, 0x57, 0x50, 0x15, 0x20,
+	0xfe, 0xe0, 0x0b, 0xc5, 0xe7, 0xdc, 0xa1, 0x4f, 0xc3, 0x94, 0xf5, 0x4c, 0x5e, 0xa4, 0xe8, 0x23,
+	0xf6, 0x50, 0x95, 0xf8, 0x0a, 0x07, 0xed, 0xc4, 0x57, 0xb3, 0xec, 0xcf, 0x7e, 0x94, 0xdd, 0x2b,
+	0x10, 0xa2, 0x6a, 0x63, 0xb4, 0x6a, 0xe3, 0x5a, 0xd5, 0xc6, 0x68, 0xd5, 0xc7, 0x30, 0x23, 0x7e,
+	0x52, 0x82, 0x36, 0x84, 0x4b, 0xfa, 0xd0, 0x97, 0x2c, 0xca, 0xe6, 0x08, 0x6e, 0xa4, 0xae, 0x02,
+	0x53, 0x51, 0x81, 0x0c, 0xad, 0x25, 0xd0, 0x62, 0xbd, 0x4e, 0x51, 0xb2, 0x58, 0x91, 0x16, 0x03,
+	0xe6, 0x92, 0x75, 0x1f, 0xb4, 0x25, 0xba, 0x69, 0xb8, 0x94, 0xa5, 0x6c, 0x8f, 0xe4, 0x47, 0x4a,
+	0x1f, 0x83, 0x32, 0xba, 0x7c, 0x85, 0x6e, 0x8f, 0x50, 0x90, 0xf1, 0x0c, 0xfa, 0x3c, 0x9d, 0xbd,
+	0x0f, 0x13, 0xc1, 0x07, 0x36, 0x68, 0x25, 0x02, 0x27, 0xbe, 0xc1, 0x51, 0x56, 0x87, 0xe8, 0x91,
+	0xf0, 0x59, 0x54, 0xf3, 0x49, 0x7e, 0x9a, 0x82, 0x5e, 0x15, 0x3b, 0x1e, 0xf9, 0x3d, 0x8c, 0xf2,
+	0xd9, 0xeb, 0x60, 0x62, 0xf0, 0xc7, 0x9f, 0xa1, 0x08, 0xc1, 0x3f, 0xf4, 0x4d, 0x8b, 0xb2, 0x9e,
+	0xc9, 0x13, 0x15, 0xc5, 0x5f, 0xa0, 0x08, 0x8a, 0x86, 0x3e, 0x67, 0x51, 0xd6, 0x33, 0x79, 0xc9,
+	0xe5, 0xd8, 0x25, 0x43, 0x8a, 0x86, 0xbe, 0x64, 0x51, 0xd6, 0x33, 0x79, 0x91, 0xa2, 0x12, 0x14,
+	0xc2, 0x6f, 0x55, 0x84, 0xd4, 0x90, 0xfa, 0xa2, 0x45, 0x59, 0xcb, 0xe0, 0x44, 0x2a, 0xbe, 0x1f,
+	0x16, 0x86, 0x0a, 0x73, 0x28, 0x5e, 0x55, 0xa3, 0x6a, 0x86, 0x8a, 0x7a, 0x15, 0x24, 0x15, 0xe4,
+	0xa2, 0xea, 0xad, 0xf4, 0xbc, 0xa5, 0xf4, 0x6e, 0x8f, 0xe4, 0x8b, 0xcb, 0x59, 0xac, 0x91, 0x09,
+	0xcb, 0x39, 0xa3, 0xa2, 0xa6, 0x6c, 0x8e, 0xe0, 0x46, 0xea, 0x1a, 0x30, 0x9b, 0xa8, 0x52, 0xa1,
+	0xcd, 0xa4, 0x09, 0xa9, 0x8a, 0x99, 0xb2, 0x35, 0x8a, 0x9d, 0x1a, 0xb5, 0x50, 0x69, 0x4a, 0x8e,
+	0x7a, 0xb8, 0xc2, 0xa5, 0x6c, 0x8f, 0xe4, 0x8b, 0x4a, 0x93, 0xb5, 0x25, 0x41, 0x69, 0x66, 0x9d,
+	0x4a, 0xd9, 0x1e, 0xc9, 0x8f, 0x94, 0x3e, 0x80, 0xf9, 0xd4, 0x13, 0x3a, 0xda, 0x16, 0xde, 0xcc,
+	0xb2, 0x8a, 0x50, 0xca, 0xce, 0x68, 0x40, 0xa4, 0xb7, 0x37, 0x54, 0x6f, 0x0a, 0x9f, 0xe6, 0xd1,
+	0xcd, 0x51, 0xe2, 0xa9, 0xa7, 0x7f, 0xe5, 0xd6, 0xf5, 0xc0, 0xd4, 0xe6, 0x91, 0xa8, 0x3a, 0x25,
+	0x37, 0x8f, 0xac, 0xfa, 0x96, 0xb2, 0x7b, 0x05, 0x42, 0x0c, 0x8f, 0x44, 0x71, 0x49, 0x08, 0x8f,
+	0xac, 0x62, 0x96, 0xb2, 0x35, 0x8a, 0x2d, 0xee, 0x1f, 0x51, 0x0d, 0x49, 0xd8, 0x3f, 0xd2, 0x95,
+	0x2a, 0x45, 0xc9, 0x62, 0x09, 0x0b, 0x77, 0x39, 0xb3, 0x8e, 0x95, 0x4c, 0xa0, 0x23, 0xeb, 0x5c,
+	0xd7, 0x68, 0x2f, 0x41, 0x21, 0xac, 0x48, 0x09, 0x99, 0x25, 0x55, 0xcd, 0x52, 0xd6, 0x32, 0x38,
+	0x62, 0x66, 0x19, 0x2a, 0x43, 0x09, 0x99, 0x65, 0x54, 0xf9, 0x4a, 0x51, 0xaf, 0x82, 0x88, 0x33,
+	0x9e, 0x2e, 0x2b, 0x21, 0x31, 0x32, 0x33, 0xcb, 0x56, 0xca, 0xee, 0x15, 0x08, 0x31, 0x78, 0x47,
+	0x94, 0x84, 0x84, 0xe0, 0xbd, 0xba, 0xac, 0xa4, 0xdc, 0xba, 0x1e, 0x98, 0x58, 0x84, 0xc9, 0xef,
+	0xe7, 0xc5, 0x45, 0x98, 0xf9, 0x49, 0xbe, 0xb2, 0x33, 0x1a, 0x20, 0xea, 0x4d, 0x95, 0x2d, 0x50,
+	0x3a, 0x25, 0xa4, 0x4b, 0x3a, 0xca, 0xce, 0x68, 0x80, 0x98, 0x7f, 0xc5, 0x47, 0x61, 0x21, 0xff,
+	0x66, 0xbc, 0x34, 0x2b, 0x9b, 0x23, 0xb8, 0xa2, 0x3a, 0xfd, 0x3c, 0x53, 0x9d, 0x7e, 0x7e, 0x95,
+	0xba, 0xac, 0x77, 0x54, 0xf5, 0x95, 0xc3, 0x77, 0xbf, 0xf9, 0x6c, 0x4b, 0xfa, 0xd6, 0xb3, 0x2d,
+	0xe9, 0xdb, 0xcf, 0xb6, 0xa4, 0xef, 0xbb, 0x7d, 0x6a, 0xfb, 0x67, 0x83, 0x93, 0xbd, 0xb6, 0x73,
+	0xbe, 0x4f, 0x3f, 0x16, 0xbe, 0xec, 0x10, 0x57, 0xfc, 0x75, 0x71, 0xb0, 0xef, 0xb9, 0x6d, 0xf6,
+	0xff, 0x3d, 0x4e, 0x26, 0x58, 0x15, 0xea, 0xad, 0xff, 0x1b, 0x00, 0xef, 0x8e, 0xb4, 0x86, 0x03,
+	0x32, 0x00, 0x00,
 }
 
-func (m *ActivateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	// Activate/Deactivate the auth API. 'Activate' sets an initial set of admins
+	// for the Pachyderm cluster, and 'Deactivate' removes all ACLs, tokens, and
+	// admins from the Pachyderm cluster, making all data publicly accessable
+	Activate(ctx context.Context, in *ActivateRequest, opts ...grpc.CallOption) (*ActivateResponse, error)
+	Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*DeactivateResponse, error)
+	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
+	SetConfiguration(ctx context.Context, in *SetConfigurationRequest, opts ...grpc.CallOption) (*SetConfigurationResponse, error)
+	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
+	GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
+	GetPermissionsForPrincipal(ctx context.Context, in *GetPermissionsForPrincipalRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
+	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
+	GetRolesForPermission(ctx context.Context, in *GetRolesForPermissionRequest, opts ...grpc.CallOption) (*GetRolesForPermissionResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error)
+	ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error)
+	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error)
+	GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error)
+	GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error)
+	GetScopedToken(ctx context.Context, in *GetScopedTokenRequest, opts ...grpc.CallOption) (*GetScopedTokenResponse, error)
+	ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error)
+	RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error)
+	RevokeAuthTokensForUser(ctx context.Context, in *RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAuthTokensForUserResponse, error)
+	SetGroupsForUser(ctx context.Context, in *SetGroupsForUserRequest, opts ...grpc.CallOption) (*SetGroupsForUserResponse, error)
+	ModifyMembers(ctx context.Context, in *ModifyMembersRequest, opts ...grpc.CallOption) (*ModifyMembersResponse, error)
+	GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
+	GetGroupsForPrincipal(ctx context.Context, in *GetGroupsForPrincipalRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
+	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
+	ExtractAuthTokens(ctx context.Context, in *ExtractAuthTokensRequest, opts ...grpc.CallOption) (*ExtractAuthTokensResponse, error)
+	RestoreAuthToken(ctx context.Context, in *RestoreAuthTokenRequest, opts ...grpc.CallOption) (*RestoreAuthTokenResponse, error)
+	DeleteExpiredAuthTokens(ctx context.Context, in *DeleteExpiredAuthTokensRequest, opts ...grpc.CallOption) (*DeleteExpiredAuthTokensResponse, error)
+	RotateRootToken(ctx context.Context, in *RotateRootTokenRequest, opts ...grpc.CallOption) (*RotateRootTokenResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error)
+	ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error)
 }
 
-func (m *ActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RootToken) > 0 {
-		i -= len(m.RootToken)
-		copy(dAtA[i:], m.RootToken)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.RootToken)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type aPIClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ActivateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) Activate(ctx context.Context, in *ActivateRequest, opts ...grpc.CallOption) (*ActivateResponse, error) {
+	out := new(ActivateResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/Activate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ActivateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*DeactivateResponse, error) {
+	out := new(DeactivateResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/Deactivate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
+	out := new(GetConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PachToken) > 0 {
-		i -= len(m.PachToken)
-		copy(dAtA[i:], m.PachToken)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.PachToken)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *aPIClient) SetConfiguration(ctx context.Context, in *SetConfigurationRequest, opts ...grpc.CallOption) (*SetConfigurationResponse, error) {
+	out := new(SetConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/SetConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DeactivateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/Authenticate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeactivateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error) {
+	out := new(AuthorizeResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/Authorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DeactivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
+	out := new(GetPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DeactivateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) GetPermissionsForPrincipal(ctx context.Context, in *GetPermissionsForPrincipalRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
+	out := new(GetPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetPermissionsForPrincipal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeactivateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error) {
+	out := new(WhoAmIResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/WhoAmI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DeactivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) GetRolesForPermission(ctx context.Context, in *GetRolesForPermissionRequest, opts ...grpc.CallOption) (*GetRolesForPermissionResponse, error) {
+	out := new(GetRolesForPermissionResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetRolesForPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RotateRootTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/CreateRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RotateRootTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error) {
+	out := new(UpdateRoleResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/UpdateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RotateRootTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RootToken) > 0 {
-		i -= len(m.RootToken)
-		copy(dAtA[i:], m.RootToken)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.RootToken)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *aPIClient) ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error) {
+	out := new(ListRoleResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ListRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RotateRootTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error) {
+	out := new(ModifyRoleBindingResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ModifyRoleBinding", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RotateRootTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error) {
+	out := new(GetRoleBindingResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RotateRootTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error) {
+	out := new(GetOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetOIDCLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RootToken) > 0 {
-		i -= len(m.RootToken)
-		copy(dAtA[i:], m.RootToken)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.RootToken)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *aPIClient) GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error) {
+	out := new(GetRobotTokenResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetRobotToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) GetScopedToken(ctx context.Context, in *GetScopedTokenRequest, opts ...grpc.CallOption) (*GetScopedTokenResponse, error) {
+	out := new(GetScopedTokenResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetScopedToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error) {
+	out := new(ListAuthTokensResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ListAuthTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OIDCConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error) {
+	out := new(RevokeAuthTokenResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/RevokeAuthToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UserAccessibleIssuerHost) > 0 {
-		i -= len(m.UserAccessibleIssuerHost)
-		copy(dAtA[i:], m.UserAccessibleIssuerHost)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.UserAccessibleIssuerHost)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+func (c *aPIClient) RevokeAuthTokensForUser(ctx context.Context, in *RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAuthTokensForUserResponse, error) {
+	out := new(RevokeAuthTokensForUserResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/RevokeAuthTokensForUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LocalhostIssuer {
-		i--
-		if m.LocalhostIssuer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	return out, nil
+}
+
+func (c *aPIClient) SetGroupsForUser(ctx context.Context, in *SetGroupsForUserRequest, opts ...grpc.CallOption) (*SetGroupsForUserResponse, error) {
+	out := new(SetGroupsForUserResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/SetGroupsForUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.RequireEmailVerified {
-		i--
-		if m.RequireEmailVerified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	return out, nil
+}
+
+func (c *aPIClient) ModifyMembers(ctx context.Context, in *ModifyMembersRequest, opts ...grpc.CallOption) (*ModifyMembersResponse, error) {
+	out := new(ModifyMembersResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ModifyMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Scopes[iNdEx])
-			copy(dAtA[i:], m.Scopes[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Scopes[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.RedirectURI) > 0 {
-		i -= len(m.RedirectURI)
-		copy(dAtA[i:], m.RedirectURI)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.RedirectURI)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ClientSecret) > 0 {
-		i -= len(m.ClientSecret)
-		copy(dAtA[i:], m.ClientSecret)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.ClientSecret)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
+	out := new(GetGroupsResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetGroups", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) GetGroupsForPrincipal(ctx context.Context, in *GetGroupsForPrincipalRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
+	out := new(GetGroupsResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetGroupsForPrincipal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Configuration != nil {
-		{
-			size, err := m.Configuration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SetConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
+	out := new(GetUsersResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Configuration != nil {
-		{
-			size, err := m.Configuration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SetConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) ExtractAuthTokens(ctx context.Context, in *ExtractAuthTokensRequest, opts ...grpc.CallOption) (*ExtractAuthTokensResponse, error) {
+	out := new(ExtractAuthTokensResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ExtractAuthTokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SetConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) RestoreAuthToken(ctx context.Context, in *RestoreAuthTokenRequest, opts ...grpc.CallOption) (*RestoreAuthTokenResponse, error) {
+	out := new(RestoreAuthTokenResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/RestoreAuthToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TokenInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) DeleteExpiredAuthTokens(ctx context.Context, in *DeleteExpiredAuthTokensRequest, opts ...grpc.CallOption) (*DeleteExpiredAuthTokensResponse, error) {
+	out := new(DeleteExpiredAuthTokensResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/DeleteExpiredAuthTokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TokenInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.HashedToken) > 0 {
-		i -= len(m.HashedToken)
-		copy(dAtA[i:], m.HashedToken)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.HashedToken)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Expiration != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintAuth(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0xa
+func (c *aPIClient) RotateRootToken(ctx context.Context, in *RotateRootTokenRequest, opts ...grpc.CallOption) (*RotateRootTokenResponse, error) {
+	out := new(RotateRootTokenResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/RotateRootToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ListAuditEvents", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *AuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.IdToken) > 0 {
-		i -= len(m.IdToken)
-		copy(dAtA[i:], m.IdToken)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.IdToken)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OIDCState) > 0 {
-		i -= len(m.OIDCState)
-		copy(dAtA[i:], m.OIDCState)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.OIDCState)))
-		i--
-		dAtA[i] = 0xa
+func (c *aPIClient) ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error) {
+	out := new(ExportConfigResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ExportConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AuthenticateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *aPIClient) ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error) {
+	out := new(ImportConfigResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ImportConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// APIServer is the server API for API service.
+type APIServer interface {
+	// Activate/Deactivate the auth API. 'Activate' sets an initial set of admins
+	// for the Pachyderm cluster, and 'Deactivate' removes all ACLs, tokens, and
+	// admins from the Pachyderm cluster, making all data publicly accessable
+	Activate(context.Context, *ActivateRequest) (*ActivateResponse, error)
+	Deactivate(context.Context, *DeactivateRequest) (*DeactivateResponse, error)
+	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
+	SetConfiguration(context.Context, *SetConfigurationRequest) (*SetConfigurationResponse, error)
+	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	GetPermissions(context.Context, *GetPermissionsRequest) (*GetPermissionsResponse, error)
+	GetPermissionsForPrincipal(context.Context, *GetPermissionsForPrincipalRequest) (*GetPermissionsResponse, error)
+	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
+	GetRolesForPermission(context.Context, *GetRolesForPermissionRequest) (*GetRolesForPermissionResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
+	ModifyRoleBinding(context.Context, *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error)
+	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*GetRoleBindingResponse, error)
+	GetOIDCLogin(context.Context, *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error)
+	GetRobotToken(context.Context, *GetRobotTokenRequest) (*GetRobotTokenResponse, error)
+	GetScopedToken(context.Context, *GetScopedTokenRequest) (*GetScopedTokenResponse, error)
+	ListAuthTokens(context.Context, *ListAuthTokensRequest) (*ListAuthTokensResponse, error)
+	RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error)
+	RevokeAuthTokensForUser(context.Context, *RevokeAuthTokensForUserRequest) (*RevokeAuthTokensForUserResponse, error)
+	SetGroupsForUser(context.Context, *SetGroupsForUserRequest) (*SetGroupsForUserResponse, error)
+	ModifyMembers(context.Context, *ModifyMembersRequest) (*ModifyMembersResponse, error)
+	GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error)
+	GetGroupsForPrincipal(context.Context, *GetGroupsForPrincipalRequest) (*GetGroupsResponse, error)
+	GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error)
+	ExtractAuthTokens(context.Context, *ExtractAuthTokensRequest) (*ExtractAuthTokensResponse, error)
+	RestoreAuthToken(context.Context, *RestoreAuthTokenRequest) (*RestoreAuthTokenResponse, error)
+	DeleteExpiredAuthTokens(context.Context, *DeleteExpiredAuthTokensRequest) (*DeleteExpiredAuthTokensResponse, error)
+	RotateRootToken(context.Context, *RotateRootTokenRequest) (*RotateRootTokenResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	ExportConfig(context.Context, *ExportConfigRequest) (*ExportConfigResponse, error)
+	ImportConfig(context.Context, *ImportConfigRequest) (*ImportConfigResponse, error)
 }
 
-func (m *AuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PachToken) > 0 {
-		i -= len(m.PachToken)
-		copy(dAtA[i:], m.PachToken)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.PachToken)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
 }
 
-func (m *WhoAmIRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAPIServer) Activate(ctx context.Context, req *ActivateRequest) (*ActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
 }
-
-func (m *WhoAmIRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedAPIServer) Deactivate(ctx context.Context, req *DeactivateRequest) (*DeactivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deactivate not implemented")
 }
-
-func (m *WhoAmIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedAPIServer) GetConfiguration(ctx context.Context, req *GetConfigurationRequest) (*GetConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
-
-func (m *WhoAmIResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAPIServer) SetConfiguration(ctx context.Context, req *SetConfigurationRequest) (*SetConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfiguration not implemented")
+}
+func (*UnimplementedAPIServer) Authenticate(ctx context.Context, req *AuthenticateRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (*UnimplementedAPIServer) Authorize(ctx context.Context, req *AuthorizeRequest) (*AuthorizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (*UnimplementedAPIServer) GetPermissions(ctx context.Context, req *GetPermissionsRequest) (*GetPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
+}
+func (*UnimplementedAPIServer) GetPermissionsForPrincipal(ctx context.Context, req *GetPermissionsForPrincipalRequest) (*GetPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionsForPrincipal not implemented")
+}
+func (*UnimplementedAPIServer) WhoAmI(ctx context.Context, req *WhoAmIRequest) (*WhoAmIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
+}
+func (*UnimplementedAPIServer) GetRolesForPermission(ctx context.Context, req *GetRolesForPermissionRequest) (*GetRolesForPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolesForPermission not implemented")
+}
+func (*UnimplementedAPIServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedAPIServer) UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (*UnimplementedAPIServer) DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (*UnimplementedAPIServer) ListRole(ctx context.Context, req *ListRoleRequest) (*ListRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRole not implemented")
+}
+func (*UnimplementedAPIServer) ModifyRoleBinding(ctx context.Context, req *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyRoleBinding not implemented")
+}
+func (*UnimplementedAPIServer) GetRoleBinding(ctx context.Context, req *GetRoleBindingRequest) (*GetRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleBinding not implemented")
+}
+func (*UnimplementedAPIServer) GetOIDCLogin(ctx context.Context, req *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCLogin not implemented")
+}
+func (*UnimplementedAPIServer) GetRobotToken(ctx context.Context, req *GetRobotTokenRequest) (*GetRobotTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRobotToken not implemented")
+}
+func (*UnimplementedAPIServer) GetScopedToken(ctx context.Context, req *GetScopedTokenRequest) (*GetScopedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScopedToken not implemented")
+}
+func (*UnimplementedAPIServer) ListAuthTokens(ctx context.Context, req *ListAuthTokensRequest) (*ListAuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthTokens not implemented")
+}
+func (*UnimplementedAPIServer) RevokeAuthToken(ctx context.Context, req *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthToken not implemented")
+}
+func (*UnimplementedAPIServer) RevokeAuthTokensForUser(ctx context.Context, req *RevokeAuthTokensForUserRequest) (*RevokeAuthTokensForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthTokensForUser not implemented")
+}
+func (*UnimplementedAPIServer) SetGroupsForUser(ctx context.Context, req *SetGroupsForUserRequest) (*SetGroupsForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupsForUser not implemented")
+}
+func (*UnimplementedAPIServer) ModifyMembers(ctx context.Context, req *ModifyMembersRequest) (*ModifyMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyMembers not implemented")
+}
+func (*UnimplementedAPIServer) GetGroups(ctx context.Context, req *GetGroupsRequest) (*GetGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroups not implemented")
+}
+func (*UnimplementedAPIServer) GetGroupsForPrincipal(ctx context.Context, req *GetGroupsForPrincipalRequest) (*GetGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsForPrincipal not implemented")
+}
+func (*UnimplementedAPIServer) GetUsers(ctx context.Context, req *GetUsersRequest) (*GetUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
+func (*UnimplementedAPIServer) ExtractAuthTokens(ctx context.Context, req *ExtractAuthTokensRequest) (*ExtractAuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtractAuthTokens not implemented")
+}
+func (*UnimplementedAPIServer) RestoreAuthToken(ctx context.Context, req *RestoreAuthTokenRequest) (*RestoreAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAuthToken not implemented")
+}
+func (*UnimplementedAPIServer) DeleteExpiredAuthTokens(ctx context.Context, req *DeleteExpiredAuthTokensRequest) (*DeleteExpiredAuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpiredAuthTokens not implemented")
+}
+func (*UnimplementedAPIServer) RotateRootToken(ctx context.Context, req *RotateRootTokenRequest) (*RotateRootTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateRootToken not implemented")
+}
+func (*UnimplementedAPIServer) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (*UnimplementedAPIServer) ExportConfig(ctx context.Context, req *ExportConfigRequest) (*ExportConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConfig not implemented")
+}
+func (*UnimplementedAPIServer) ImportConfig(ctx context.Context, req *ImportConfigRequest) (*ImportConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportConfig not implemented")
 }
 
-func (m *WhoAmIResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
 }
 
-func (m *WhoAmIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Expiration != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintAuth(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).Activate(ctx, in)
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/Activate",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Activate(ctx, req.(*ActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRolesForPermissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRolesForPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetRolesForPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).Deactivate(ctx, in)
 	}
-	if m.Permission != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/Deactivate",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Deactivate(ctx, req.(*DeactivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRolesForPermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRolesForPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetRolesForPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).GetConfiguration(ctx, in)
 	}
-	if len(m.Roles) > 0 {
-		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuth(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetConfiguration",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Roles) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_SetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigurationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Roles) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Roles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).SetConfiguration(ctx, in)
 	}
-	if len(m.Roles) > 0 {
-		for k := range m.Roles {
-			v := m.Roles[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/SetConfiguration",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetConfiguration(ctx, req.(*SetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RoleBinding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RoleBinding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).Authenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/Authenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Authenticate(ctx, req.(*AuthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RoleBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Entries) > 0 {
-		for k := range m.Entries {
-			v := m.Entries[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintAuth(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(APIServer).Authorize(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/Authorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Authorize(ctx, req.(*AuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Resource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).GetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPermissions(ctx, req.(*GetPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_GetPermissionsForPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsForPrincipalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPermissionsForPrincipal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetPermissionsForPrincipal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPermissionsForPrincipal(ctx, req.(*GetPermissionsForPrincipalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoAmIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).WhoAmI(ctx, in)
 	}
-	if m.Type != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/WhoAmI",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WhoAmI(ctx, req.(*WhoAmIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Users) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_GetRolesForPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolesForPermissionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).GetRolesForPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetRolesForPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetRolesForPermission(ctx, req.(*GetRolesForPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Users) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Users) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Usernames) > 0 {
-		for k := range m.Usernames {
-			v := m.Usernames[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(APIServer).CreateRole(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Groups) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Groups) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Groups) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).UpdateRole(ctx, in)
 	}
-	if len(m.Groups) > 0 {
-		for k := range m.Groups {
-			v := m.Groups[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/UpdateRole",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Role) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Role) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_ListRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ResourceTypes) > 0 {
-		dAtA7 := make([]byte, len(m.ResourceTypes)*10)
-		var j6 int
-		for _, num := range m.ResourceTypes {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintAuth(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).ListRole(ctx, in)
 	}
-	if len(m.Permissions) > 0 {
-		dAtA9 := make([]byte, len(m.Permissions)*10)
-		var j8 int
-		for _, num := range m.Permissions {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintAuth(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ListRole",
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListRole(ctx, req.(*ListRoleRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AuthorizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_ModifyRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyRoleBindingRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AuthorizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).ModifyRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ModifyRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ModifyRoleBinding(ctx, req.(*ModifyRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AuthorizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_GetRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Permissions) > 0 {
-		dAtA11 := make([]byte, len(m.Permissions)*10)
-		var j10 int
-		for _, num := range m.Permissions {
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
-			}
-			dAtA11[j10] = uint8(num)
-			j10++
-		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintAuth(dAtA, i, uint64(j10))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).GetRoleBinding(ctx, in)
 	}
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetRoleBinding",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetRoleBinding(ctx, req.(*GetRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AuthorizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_GetOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCLoginRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).GetOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetOIDCLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetOIDCLogin(ctx, req.(*GetOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AuthorizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_GetRobotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRobotTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetRobotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetRobotToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetRobotToken(ctx, req.(*GetRobotTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AuthorizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Principal) > 0 {
-		i -= len(m.Principal)
-		copy(dAtA[i:], m.Principal)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
-		i--
-		dAtA[i] = 0x22
+func _API_GetScopedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScopedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Missing) > 0 {
-		dAtA14 := make([]byte, len(m.Missing)*10)
-		var j13 int
-		for _, num := range m.Missing {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintAuth(dAtA, i, uint64(j13))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).GetScopedToken(ctx, in)
 	}
-	if len(m.Satisfied) > 0 {
-		dAtA16 := make([]byte, len(m.Satisfied)*10)
-		var j15 int
-		for _, num := range m.Satisfied {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
-		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintAuth(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetScopedToken",
 	}
-	if m.Authorized {
-		i--
-		if m.Authorized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetScopedToken(ctx, req.(*GetScopedTokenRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPermissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_ListAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthTokensRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).ListAuthTokens(ctx, in)
 	}
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ListAuthTokens",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListAuthTokens(ctx, req.(*ListAuthTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPermissionsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RevokeAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAuthTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetPermissionsForPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetPermissionsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).RevokeAuthToken(ctx, in)
 	}
-	if len(m.Principal) > 0 {
-		i -= len(m.Principal)
-		copy(dAtA[i:], m.Principal)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/RevokeAuthToken",
 	}
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevokeAuthToken(ctx, req.(*RevokeAuthTokenRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RevokeAuthTokensForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAuthTokensForUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).RevokeAuthTokensForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/RevokeAuthTokensForUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevokeAuthTokensForUser(ctx, req.(*RevokeAuthTokensForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_SetGroupsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Roles) > 0 {
-		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Roles[iNdEx])
-			copy(dAtA[i:], m.Roles[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Roles[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(APIServer).SetGroupsForUser(ctx, in)
 	}
-	if len(m.Permissions) > 0 {
-		dAtA20 := make([]byte, len(m.Permissions)*10)
-		var j19 int
-		for _, num := range m.Permissions {
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
-		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintAuth(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/SetGroupsForUser",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetGroupsForUser(ctx, req.(*SetGroupsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyRoleBindingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_ModifyMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyMembersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).ModifyMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ModifyMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ModifyMembers(ctx, req.(*ModifyMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyRoleBindingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_GetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroups(ctx, req.(*GetGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyRoleBindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_GetGroupsForPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsForPrincipalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Roles) > 0 {
-		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Roles[iNdEx])
-			copy(dAtA[i:], m.Roles[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Roles[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(APIServer).GetGroupsForPrincipal(ctx, in)
 	}
-	if len(m.Principal) > 0 {
-		i -= len(m.Principal)
-		copy(dAtA[i:], m.Principal)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetGroupsForPrincipal",
 	}
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetGroupsForPrincipal(ctx, req.(*GetGroupsForPrincipalRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ModifyRoleBindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ModifyRoleBindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModifyRoleBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).GetUsers(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsers(ctx, req.(*GetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRoleBindingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_ExtractAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractAuthTokensRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRoleBindingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetRoleBindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).ExtractAuthTokens(ctx, in)
 	}
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ExtractAuthTokens",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExtractAuthTokens(ctx, req.(*ExtractAuthTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRoleBindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RestoreAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAuthTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRoleBindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetRoleBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).RestoreAuthToken(ctx, in)
 	}
-	if m.Binding != nil {
-		{
-			size, err := m.Binding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/RestoreAuthToken",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RestoreAuthToken(ctx, req.(*RestoreAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_DeleteExpiredAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpiredAuthTokensRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SessionInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).DeleteExpiredAuthTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/DeleteExpiredAuthTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteExpiredAuthTokens(ctx, req.(*DeleteExpiredAuthTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_RotateRootToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRootTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ConversionErr {
-		i--
-		if m.ConversionErr {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(APIServer).RotateRootToken(ctx, in)
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/RotateRootToken",
 	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RotateRootToken(ctx, req.(*RotateRootTokenRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetOIDCLoginRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetOIDCLoginRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetOIDCLoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_ExportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(APIServer).ExportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ExportConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExportConfig(ctx, req.(*ExportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetOIDCLoginResponse) Marshal() (dAtA []byte, err error) {
+func _API_ImportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ImportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ImportConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ImportConfig(ctx, req.(*ImportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "auth_v2.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Activate",
+			Handler:    _API_Activate_Handler,
+		},
+		{
+			MethodName: "Deactivate",
+			Handler:    _API_Deactivate_Handler,
+		},
+		{
+			MethodName: "GetConfiguration",
+			Handler:    _API_GetConfiguration_Handler,
+		},
+		{
+			MethodName: "SetConfiguration",
+			Handler:    _API_SetConfiguration_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _API_Authenticate_Handler,
+		},
+		{
+			MethodName: "Authorize",
+			Handler:    _API_Authorize_Handler,
+		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _API_GetPermissions_Handler,
+		},
+		{
+			MethodName: "GetPermissionsForPrincipal",
+			Handler:    _API_GetPermissionsForPrincipal_Handler,
+		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _API_WhoAmI_Handler,
+		},
+		{
+			MethodName: "GetRolesForPermission",
+			Handler:    _API_GetRolesForPermission_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _API_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _API_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _API_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRole",
+			Handler:    _API_ListRole_Handler,
+		},
+		{
+			MethodName: "ModifyRoleBinding",
+			Handler:    _API_ModifyRoleBinding_Handler,
+		},
+		{
+			MethodName: "GetRoleBinding",
+			Handler:    _API_GetRoleBinding_Handler,
+		},
+		{
+			MethodName: "GetOIDCLogin",
+			Handler:    _API_GetOIDCLogin_Handler,
+		},
+		{
+			MethodName: "GetRobotToken",
+			Handler:    _API_GetRobotToken_Handler,
+		},
+		{
+			MethodName: "GetScopedToken",
+			Handler:    _API_GetScopedToken_Handler,
+		},
+		{
+			MethodName: "ListAuthTokens",
+			Handler:    _API_ListAuthTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAuthToken",
+			Handler:    _API_RevokeAuthToken_Handler,
+		},
+		{
+			MethodName: "RevokeAuthTokensForUser",
+			Handler:    _API_RevokeAuthTokensForUser_Handler,
+		},
+		{
+			MethodName: "SetGroupsForUser",
+			Handler:    _API_SetGroupsForUser_Handler,
+		},
+		{
+			MethodName: "ModifyMembers",
+			Handler:    _API_ModifyMembers_Handler,
+		},
+		{
+			MethodName: "GetGroups",
+			Handler:    _API_GetGroups_Handler,
+		},
+		{
+			MethodName: "GetGroupsForPrincipal",
+			Handler:    _API_GetGroupsForPrincipal_Handler,
+		},
+		{
+			MethodName: "GetUsers",
+			Handler:    _API_GetUsers_Handler,
+		},
+		{
+			MethodName: "ExtractAuthTokens",
+			Handler:    _API_ExtractAuthTokens_Handler,
+		},
+		{
+			MethodName: "RestoreAuthToken",
+			Handler:    _API_RestoreAuthToken_Handler,
+		},
+		{
+			MethodName: "DeleteExpiredAuthTokens",
+			Handler:    _API_DeleteExpiredAuthTokens_Handler,
+		},
+		{
+			MethodName: "RotateRootToken",
+			Handler:    _API_RotateRootToken_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _API_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ExportConfig",
+			Handler:    _API_ExportConfig_Handler,
+		},
+		{
+			MethodName: "ImportConfig",
+			Handler:    _API_ImportConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/auth.proto",
+}
+
+func (m *ActivateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5849,12 +6301,12 @@ func (m *GetOIDCLoginResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOIDCLoginResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActivateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetOIDCLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5863,24 +6315,17 @@ func (m *GetOIDCLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.State)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LoginURL) > 0 {
-		i -= len(m.LoginURL)
-		copy(dAtA[i:], m.LoginURL)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.LoginURL)))
+	if len(m.RootToken) > 0 {
+		i -= len(m.RootToken)
+		copy(dAtA[i:], m.RootToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.RootToken)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetRobotTokenRequest) Marshal() (dAtA []byte, err error) {
+func (m *ActivateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5890,12 +6335,12 @@ func (m *GetRobotTokenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRobotTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActivateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5904,22 +6349,17 @@ func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TTL != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.TTL))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Robot) > 0 {
-		i -= len(m.Robot)
-		copy(dAtA[i:], m.Robot)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Robot)))
+	if len(m.PachToken) > 0 {
+		i -= len(m.PachToken)
+		copy(dAtA[i:], m.PachToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.PachToken)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetRobotTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeactivateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5929,12 +6369,12 @@ func (m *GetRobotTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRobotTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeactivateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetRobotTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeactivateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5943,17 +6383,10 @@ func (m *GetRobotTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeactivateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5963,12 +6396,12 @@ func (m *RevokeAuthTokenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeactivateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeactivateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5977,17 +6410,10 @@ func (m *RevokeAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *RotateRootTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5997,12 +6423,12 @@ func (m *RevokeAuthTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RotateRootTokenRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RotateRootTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6011,10 +6437,17 @@ func (m *RevokeAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RootToken) > 0 {
+		i -= len(m.RootToken)
+		copy(dAtA[i:], m.RootToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.RootToken)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetGroupsForUserRequest) Marshal() (dAtA []byte, err error) {
+func (m *RotateRootTokenResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6024,12 +6457,12 @@ func (m *SetGroupsForUserRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetGroupsForUserRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RotateRootTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetGroupsForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RotateRootTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6038,26 +6471,17 @@ func (m *SetGroupsForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Groups[iNdEx])
-			copy(dAtA[i:], m.Groups[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+	if len(m.RootToken) > 0 {
+		i -= len(m.RootToken)
+		copy(dAtA[i:], m.RootToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.RootToken)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetGroupsForUserResponse) Marshal() (dAtA []byte, err error) {
+func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6067,12 +6491,12 @@ func (m *SetGroupsForUserResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetGroupsForUserResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetGroupsForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OIDCConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6081,62 +6505,74 @@ func (m *SetGroupsForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ModifyMembersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.UserAccessibleIssuerHost) > 0 {
+		i -= len(m.UserAccessibleIssuerHost)
+		copy(dAtA[i:], m.UserAccessibleIssuerHost)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.UserAccessibleIssuerHost)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ModifyMembersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModifyMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.LocalhostIssuer {
+		i--
+		if m.LocalhostIssuer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Remove) > 0 {
-		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Remove[iNdEx])
-			copy(dAtA[i:], m.Remove[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Remove[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.RequireEmailVerified {
+		i--
+		if m.RequireEmailVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Add) > 0 {
-		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Add[iNdEx])
-			copy(dAtA[i:], m.Add[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Add[iNdEx])))
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Scopes[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+	if len(m.RedirectURI) > 0 {
+		i -= len(m.RedirectURI)
+		copy(dAtA[i:], m.RedirectURI)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.RedirectURI)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientSecret) > 0 {
+		i -= len(m.ClientSecret)
+		copy(dAtA[i:], m.ClientSecret)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.ClientSecret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Issuer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModifyMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetConfigurationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6146,12 +6582,12 @@ func (m *ModifyMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModifyMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModifyMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6163,7 +6599,7 @@ func (m *ModifyMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetConfigurationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6173,12 +6609,12 @@ func (m *GetGroupsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6187,10 +6623,22 @@ func (m *GetGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Configuration != nil {
+		{
+			size, err := m.Configuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetConfigurationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6200,12 +6648,12 @@ func (m *GetGroupsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGroupsForPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetGroupsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6214,17 +6662,22 @@ func (m *GetGroupsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Principal) > 0 {
-		i -= len(m.Principal)
-		copy(dAtA[i:], m.Principal)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+	if m.Configuration != nil {
+		{
+			size, err := m.Configuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetConfigurationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6234,12 +6687,12 @@ func (m *GetGroupsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6248,19 +6701,10 @@ func (m *GetGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Groups[iNdEx])
-			copy(dAtA[i:], m.Groups[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
+func (m *TokenInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6270,12 +6714,12 @@ func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetUsersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6284,17 +6728,46 @@ func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HashedToken) > 0 {
+		i -= len(m.HashedToken)
+		copy(dAtA[i:], m.HashedToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.HashedToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Expiration != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintAuth(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Subject)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetUsersResponse) Marshal() (dAtA []byte, err error) {
+func (m *TokenScope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6304,12 +6777,12 @@ func (m *GetUsersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetUsersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenScope) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6318,19 +6791,51 @@ func (m *GetUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Usernames) > 0 {
-		for iNdEx := len(m.Usernames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Usernames[iNdEx])
-			copy(dAtA[i:], m.Usernames[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Usernames[iNdEx])))
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Permissions) > 0 {
+		dAtA6 := make([]byte, len(m.Permissions)*10)
+		var j5 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintAuth(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExtractAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6340,12 +6845,12 @@ func (m *ExtractAuthTokensRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExtractAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExtractAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6354,25 +6859,39 @@ func (m *ExtractAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ExtractAuthTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.IdToken) > 0 {
+		i -= len(m.IdToken)
+		copy(dAtA[i:], m.IdToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.IdToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OIDCState) > 0 {
+		i -= len(m.OIDCState)
+		copy(dAtA[i:], m.OIDCState)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.OIDCState)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ExtractAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExtractAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6381,24 +6900,17 @@ func (m *ExtractAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuth(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.PachToken) > 0 {
+		i -= len(m.PachToken)
+		copy(dAtA[i:], m.PachToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.PachToken)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+func (m *WhoAmIRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6408,12 +6920,12 @@ func (m *RestoreAuthTokenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WhoAmIRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WhoAmIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6422,9 +6934,36 @@ func (m *RestoreAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Token != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *WhoAmIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhoAmIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhoAmIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Scope != nil {
 		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6432,12 +6971,29 @@ func (m *RestoreAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintAuth(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Expiration != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintAuth(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetRolesForPermissionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6447,12 +7003,12 @@ func (m *RestoreAuthTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRolesForPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRolesForPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6461,10 +7017,15 @@ func (m *RestoreAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Permission != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokensForUserRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetRolesForPermissionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6474,12 +7035,12 @@ func (m *RevokeAuthTokensForUserRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokensForUserRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRolesForPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokensForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRolesForPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6488,17 +7049,24 @@ func (m *RevokeAuthTokensForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokensForUserResponse) Marshal() (dAtA []byte, err error) {
+func (m *CreateRoleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6508,12 +7076,12 @@ func (m *RevokeAuthTokensForUserResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokensForUserResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateRoleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokensForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6522,10 +7090,22 @@ func (m *RevokeAuthTokensForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Role != nil {
+		{
+			size, err := m.Role.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteExpiredAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6535,12 +7115,12 @@ func (m *DeleteExpiredAuthTokensRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteExpiredAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteExpiredAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6552,7 +7132,7 @@ func (m *DeleteExpiredAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteExpiredAuthTokensResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRoleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6562,12 +7142,12 @@ func (m *DeleteExpiredAuthTokensResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteExpiredAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRoleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteExpiredAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6576,1043 +7156,6864 @@ func (m *DeleteExpiredAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Role != nil {
+		{
+			size, err := m.Role.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpdateRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ActivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RootToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *UpdateRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PachToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeactivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeactivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RotateRootTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RootToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RotateRootTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RootToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ListRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OIDCConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.ClientSecret)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.RedirectURI)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if len(m.Scopes) > 0 {
-		for _, s := range m.Scopes {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
-		}
-	}
-	if m.RequireEmailVerified {
-		n += 2
-	}
-	if m.LocalhostIssuer {
-		n += 2
-	}
-	l = len(m.UserAccessibleIssuerHost)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Configuration != nil {
-		l = m.Configuration.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ListRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Configuration != nil {
-		l = m.Configuration.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SetConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Roles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TokenInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.Expiration != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.HashedToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Roles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthenticateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Roles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OIDCState)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.IdToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Roles) > 0 {
+		for k := range m.Roles {
+			v := m.Roles[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthenticateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PachToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RoleBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WhoAmIRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RoleBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *WhoAmIResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.Paths) > 0 {
+		for k := range m.Paths {
+			v := m.Paths[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintAuth(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Expiration != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.Branches) > 0 {
+		for k := range m.Branches {
+			v := m.Branches[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintAuth(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Entries) > 0 {
+		for k := range m.Entries {
+			v := m.Entries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintAuth(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRolesForPermissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovAuth(uint64(m.Permission))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRolesForPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Roles) > 0 {
-		for _, e := range m.Roles {
-			l = e.Size()
-			n += 1 + l + sovAuth(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Roles) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Roles) > 0 {
-		for k, v := range m.Roles {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RoleBinding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Users) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Users) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Users) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for k, v := range m.Entries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Usernames) > 0 {
+		for k := range m.Usernames {
+			v := m.Usernames[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
-			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Resource) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Groups) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Groups) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Groups) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovAuth(uint64(m.Type))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *Users) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Usernames) > 0 {
-		for k, v := range m.Usernames {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+	if len(m.Groups) > 0 {
+		for k := range m.Groups {
+			v := m.Groups[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAuth(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Groups) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Groups) > 0 {
-		for k, v := range m.Groups {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Role) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Role) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Role) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Permissions) > 0 {
-		l = 0
-		for _, e := range m.Permissions {
-			l += sovAuth(uint64(e))
+	if m.Custom {
+		i--
+		if m.Custom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovAuth(uint64(l)) + l
+		i--
+		dAtA[i] = 0x20
 	}
 	if len(m.ResourceTypes) > 0 {
-		l = 0
-		for _, e := range m.ResourceTypes {
-			l += sovAuth(uint64(e))
+		dAtA16 := make([]byte, len(m.ResourceTypes)*10)
+		var j15 int
+		for _, num := range m.ResourceTypes {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		n += 1 + sovAuth(uint64(l)) + l
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintAuth(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Permissions) > 0 {
+		dAtA18 := make([]byte, len(m.Permissions)*10)
+		var j17 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintAuth(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthorizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuthorizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Permissions) > 0 {
-		l = 0
-		for _, e := range m.Permissions {
-			l += sovAuth(uint64(e))
+		dAtA20 := make([]byte, len(m.Permissions)*10)
+		var j19 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		n += 1 + sovAuth(uint64(l)) + l
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintAuth(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthorizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuthorizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Authorized {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Satisfied) > 0 {
-		l = 0
-		for _, e := range m.Satisfied {
-			l += sovAuth(uint64(e))
-		}
-		n += 1 + sovAuth(uint64(l)) + l
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Missing) > 0 {
-		l = 0
-		for _, e := range m.Missing {
-			l += sovAuth(uint64(e))
+		dAtA23 := make([]byte, len(m.Missing)*10)
+		var j22 int
+		for _, num := range m.Missing {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
 		}
-		n += 1 + sovAuth(uint64(l)) + l
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintAuth(dAtA, i, uint64(j22))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Principal)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.Satisfied) > 0 {
+		dAtA25 := make([]byte, len(m.Satisfied)*10)
+		var j24 int
+		for _, num := range m.Satisfied {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintAuth(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPermissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPermissionsForPrincipalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPermissionsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPermissionsForPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPermissionsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Principal)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Permissions) > 0 {
-		l = 0
-		for _, e := range m.Permissions {
-			l += sovAuth(uint64(e))
-		}
-		n += 1 + sovAuth(uint64(l)) + l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Roles) > 0 {
-		for _, s := range m.Roles {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Permissions) > 0 {
+		dAtA29 := make([]byte, len(m.Permissions)*10)
+		var j28 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j28++
+			}
+			dAtA29[j28] = uint8(num)
+			j28++
+		}
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintAuth(dAtA, i, uint64(j28))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ModifyRoleBindingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModifyRoleBindingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyRoleBindingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyRoleBindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.Principal)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Roles) > 0 {
-		for _, s := range m.Roles {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ModifyRoleBindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModifyRoleBindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyRoleBindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyRoleBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRoleBindingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRoleBindingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoleBindingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoleBindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRoleBindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRoleBindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoleBindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoleBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Binding != nil {
-		l = m.Binding.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Binding != nil {
+		{
+			size, err := m.Binding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SessionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.ConversionErr {
-		n += 2
+		i--
+		if m.ConversionErr {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetOIDCLoginRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetOIDCLoginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOIDCLoginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOIDCLoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetOIDCLoginResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetOIDCLoginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOIDCLoginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOIDCLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LoginURL)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.LoginURL) > 0 {
+		i -= len(m.LoginURL)
+		copy(dAtA[i:], m.LoginURL)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LoginURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRobotTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRobotTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRobotTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Robot)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TTL != 0 {
-		n += 1 + sovAuth(uint64(m.TTL))
+		i = encodeVarintAuth(dAtA, i, uint64(m.TTL))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Robot) > 0 {
+		i -= len(m.Robot)
+		copy(dAtA[i:], m.Robot)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Robot)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRobotTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRobotTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRobotTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRobotTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetScopedTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *GetScopedTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetGroupsForUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetScopedTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Groups) > 0 {
-		for _, s := range m.Groups {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
-		}
+	if m.TTL != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TTL))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SetGroupsForUserResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetScopedTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetScopedTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetScopedTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ModifyMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if len(m.Add) > 0 {
-		for _, s := range m.Add {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
-		}
-	}
-	if len(m.Remove) > 0 {
-		for _, s := range m.Remove {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ModifyMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAuthTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RevokeAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.HashedToken) > 0 {
+		i -= len(m.HashedToken)
+		copy(dAtA[i:], m.HashedToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.HashedToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsForPrincipalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RevokeAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Principal)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetGroupsForUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGroupsForUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetGroupsForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Groups) > 0 {
-		for _, s := range m.Groups {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUsersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetGroupsForUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGroupsForUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetGroupsForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUsersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModifyMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Usernames) > 0 {
-		for _, s := range m.Usernames {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModifyMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExtractAuthTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExtractAuthTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetGroupsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGroupsForPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGroupsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovAuth(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUsersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUsersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUsersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Usernames) > 0 {
+		for iNdEx := len(m.Usernames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Usernames[iNdEx])
+			copy(dAtA[i:], m.Usernames[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Usernames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtractAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtractAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtractAuthTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtractAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAuthTokensForUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokensForUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokensForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAuthTokensForUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokensForUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokensForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteExpiredAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteExpiredAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteExpiredAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteExpiredAuthTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteExpiredAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteExpiredAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Request) > 0 {
+		i -= len(m.Request)
+		copy(dAtA[i:], m.Request)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Request)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DeniedOnly {
+		i--
+		if m.DeniedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Until != nil {
+		{
+			size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceRoleBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceRoleBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceRoleBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Binding != nil {
+		{
+			size, err := m.Binding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Usernames) > 0 {
+		for iNdEx := len(m.Usernames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Usernames[iNdEx])
+			copy(dAtA[i:], m.Usernames[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Usernames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterAuthConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterAuthConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterAuthConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OidcClients) > 0 {
+		for iNdEx := len(m.OidcClients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OidcClients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.IdpConnectors) > 0 {
+		for iNdEx := len(m.IdpConnectors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdpConnectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.IdentityServerConfig != nil {
+		{
+			size, err := m.IdentityServerConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RoleBindings) > 0 {
+		for iNdEx := len(m.RoleBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AuthConfig != nil {
+		{
+			size, err := m.AuthConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Op != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DecryptionKey) > 0 {
+		i -= len(m.DecryptionKey)
+		copy(dAtA[i:], m.DecryptionKey)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.DecryptionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PachToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateRootTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateRootTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OIDCConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.RedirectURI)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.RequireEmailVerified {
+		n += 2
+	}
+	if m.LocalhostIssuer {
+		n += 2
+	}
+	l = len(m.UserAccessibleIssuerHost)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configuration != nil {
+		l = m.Configuration.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configuration != nil {
+		l = m.Configuration.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.HashedToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TokenScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthenticateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OIDCState)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.IdToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthenticateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PachToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WhoAmIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WhoAmIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRolesForPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovAuth(uint64(m.Permission))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRolesForPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = m.Role.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = m.Role.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Roles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for k, v := range m.Roles {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RoleBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for k, v := range m.Entries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovAuth(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Branches) > 0 {
+		for k, v := range m.Branches {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovAuth(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Paths) > 0 {
+		for k, v := range m.Paths {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovAuth(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+		}
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Resource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovAuth(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Users) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usernames) > 0 {
+		for k, v := range m.Usernames {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Groups) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Role) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
+	if len(m.ResourceTypes) > 0 {
+		l = 0
+		for _, e := range m.ResourceTypes {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
+	if m.Custom {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthorizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthorizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorized {
+		n += 2
+	}
+	if len(m.Satisfied) > 0 {
+		l = 0
+		for _, e := range m.Satisfied {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
+	if len(m.Missing) > 0 {
+		l = 0
+		for _, e := range m.Missing {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPermissionsForPrincipalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyRoleBindingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyRoleBindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRoleBindingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRoleBindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Binding != nil {
+		l = m.Binding.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.ConversionErr {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOIDCLoginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOIDCLoginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LoginURL)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRobotTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Robot)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.TTL != 0 {
+		n += 1 + sovAuth(uint64(m.TTL))
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRobotTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetScopedTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.TTL != 0 {
+		n += 1 + sovAuth(uint64(m.TTL))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetScopedTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuthTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuthTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeAuthTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.HashedToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeAuthTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetGroupsForUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetGroupsForUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGroupsForPrincipalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetUsersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetUsersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usernames) > 0 {
+		for _, s := range m.Usernames {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExtractAuthTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExtractAuthTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreAuthTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreAuthTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeAuthTokensForUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeAuthTokensForUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteExpiredAuthTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteExpiredAuthTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuditEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuth(uint64(m.Id))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuditEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.DeniedOnly {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAuth(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuditEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceRoleBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Binding != nil {
+		l = m.Binding.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Usernames) > 0 {
+		for _, s := range m.Usernames {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterAuthConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthConfig != nil {
+		l = m.AuthConfig.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.RoleBindings) > 0 {
+		for _, e := range m.RoleBindings {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.IdentityServerConfig != nil {
+		l = m.IdentityServerConfig.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.IdpConnectors) > 0 {
+		for _, e := range m.IdpConnectors {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.OidcClients) > 0 {
+		for _, e := range m.OidcClients {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfigChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovAuth(uint64(m.Op))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.DecryptionKey)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAuth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuth(x uint64) (n int) {
+	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PachToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PachToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateRootTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateRootTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateRootTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateRootTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateRootTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateRootTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireEmailVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireEmailVerified = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalhostIssuer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LocalhostIssuer = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAccessibleIssuerHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAccessibleIssuerHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Configuration == nil {
+				m.Configuration = &OIDCConfig{}
+			}
+			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Configuration == nil {
+				m.Configuration = &OIDCConfig{}
+			}
+			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashedToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashedToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &TokenScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &Resource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OIDCState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PachToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PachToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhoAmIRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhoAmIRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhoAmIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhoAmIResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhoAmIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &TokenScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRolesForPermissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRolesForPermissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRolesForPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRolesForPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRolesForPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRolesForPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Role == nil {
+				m.Role = &Role{}
+			}
+			if err := m.Role.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Role == nil {
+				m.Role = &Role{}
+			}
+			if err := m.Role.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Roles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Roles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Roles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Roles == nil {
+				m.Roles = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Roles[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RestoreAuthTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RestoreAuthTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RevokeAuthTokensForUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RevokeAuthTokensForUserResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *DeleteExpiredAuthTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DeleteExpiredAuthTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAuth(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuth(x uint64) (n int) {
-	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
+func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7635,17 +14036,146 @@ func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entries == nil {
+				m.Entries = make(map[string]*Roles)
+			}
+			var mapkey string
+			var mapvalue *Roles
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Roles{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -7655,80 +14185,255 @@ func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootToken = string(dAtA[iNdEx:postIndex])
+			if m.Branches == nil {
+				m.Branches = make(map[string]*RoleBinding)
+			}
+			var mapkey string
+			var mapvalue *RoleBinding
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RoleBinding{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Branches[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActivateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Paths == nil {
+				m.Paths = make(map[string]*RoleBinding)
+			}
+			var mapkey string
+			var mapvalue *RoleBinding
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RoleBinding{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Paths[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PachToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -7738,126 +14443,28 @@ func (m *ActivateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PachToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeactivateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pipeline == nil {
+				m.Pipeline = &RoleBinding{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeactivateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -7880,7 +14487,7 @@ func (m *DeactivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RotateRootTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *Resource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7903,15 +14510,34 @@ func (m *RotateRootTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RotateRootTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RotateRootTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ResourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7939,7 +14565,7 @@ func (m *RotateRootTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootToken = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7963,7 +14589,7 @@ func (m *RotateRootTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RotateRootTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *Users) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7986,17 +14612,17 @@ func (m *RotateRootTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RotateRootTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Users: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RotateRootTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8006,23 +14632,106 @@ func (m *RotateRootTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootToken = string(dAtA[iNdEx:postIndex])
+			if m.Usernames == nil {
+				m.Usernames = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Usernames[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8046,7 +14755,7 @@ func (m *RotateRootTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
+func (m *Groups) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8069,145 +14778,17 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientSecret = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedirectURI", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RedirectURI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8217,95 +14798,106 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireEmailVerified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequireEmailVerified = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalhostIssuer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LocalhostIssuer = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAccessibleIssuerHost", wireType)
+			if m.Groups == nil {
+				m.Groups = make(map[string]bool)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UserAccessibleIssuerHost = string(dAtA[iNdEx:postIndex])
+			m.Groups[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8329,58 +14921,7 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *Role) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8403,17 +14944,17 @@ func (m *GetConfigurationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8423,84 +14964,167 @@ func (m *GetConfigurationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Configuration == nil {
-				m.Configuration = &OIDCConfig{}
-			}
-			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v ResourceType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResourceType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ResourceTypes = append(m.ResourceTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ResourceTypes) == 0 {
+					m.ResourceTypes = make([]ResourceType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResourceType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResourceType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ResourceTypes = append(m.ResourceTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTypes", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Custom", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8510,79 +15134,12 @@ func (m *SetConfigurationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Configuration == nil {
-				m.Configuration = &OIDCConfig{}
-			}
-			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetConfigurationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetConfigurationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Custom = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -8605,7 +15162,7 @@ func (m *SetConfigurationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8628,47 +15185,15 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8695,45 +15220,82 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
+			if m.Resource == nil {
+				m.Resource = &Resource{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashedToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
+		case 2:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HashedToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -8756,7 +15318,7 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
+func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8779,17 +15341,17 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthenticateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8799,27 +15361,153 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
+			m.Authorized = bool(v != 0)
+		case 2:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Satisfied = append(m.Satisfied, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Satisfied) == 0 {
+					m.Satisfied = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Satisfied = append(m.Satisfied, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Missing = append(m.Missing, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Missing) == 0 {
+					m.Missing = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Missing = append(m.Missing, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
 			}
-			m.OIDCState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8847,7 +15535,7 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdToken = string(dAtA[iNdEx:postIndex])
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8871,7 +15559,7 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8894,17 +15582,17 @@ func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthenticateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPermissionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PachToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8914,75 +15602,28 @@ func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PachToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WhoAmIRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Resource == nil {
+				m.Resource = &Resource{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WhoAmIRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhoAmIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9005,7 +15646,7 @@ func (m *WhoAmIRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9028,17 +15669,17 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhoAmIResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhoAmIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9048,29 +15689,33 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9080,27 +15725,23 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9124,7 +15765,7 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRolesForPermissionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9147,17 +15788,86 @@ func (m *GetRolesForPermissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRolesForPermissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPermissionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRolesForPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			m.Permission = 0
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9167,11 +15877,24 @@ func (m *GetRolesForPermissionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= Permission(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9194,7 +15917,7 @@ func (m *GetRolesForPermissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRolesForPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9217,15 +15940,15 @@ func (m *GetRolesForPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRolesForPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyRoleBindingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRolesForPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9249,14 +15972,131 @@ func (m *GetRolesForPermissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, &Role{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModifyRoleBindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModifyRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9279,7 +16119,7 @@ func (m *GetRolesForPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Roles) Unmarshal(dAtA []byte) error {
+func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9302,15 +16142,15 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Roles: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRoleBindingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Roles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9337,91 +16177,12 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Roles == nil {
-				m.Roles = make(map[string]bool)
+			if m.Resource == nil {
+				m.Resource = &Resource{}
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Roles[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9445,7 +16206,7 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleBinding) Unmarshal(dAtA []byte) error {
+func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9468,15 +16229,15 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleBinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRoleBindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9503,105 +16264,12 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Entries == nil {
-				m.Entries = make(map[string]*Roles)
+			if m.Binding == nil {
+				m.Binding = &RoleBinding{}
 			}
-			var mapkey string
-			var mapvalue *Roles
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Roles{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Binding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Entries[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9625,7 +16293,7 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Resource) Unmarshal(dAtA []byte) error {
+func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9648,17 +16316,17 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9668,14 +16336,27 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ResourceType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9703,8 +16384,28 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionErr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConversionErr = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9727,7 +16428,7 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Users) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9750,127 +16451,12 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCLoginRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Usernames == nil {
-				m.Usernames = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Usernames[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9893,7 +16479,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Groups) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9916,17 +16502,17 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCLoginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCLoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoginURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9936,106 +16522,55 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[string]bool)
+			m.LoginURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Groups[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10059,7 +16594,7 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Role) Unmarshal(dAtA []byte) error {
+func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10082,15 +16617,15 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRobotTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRobotTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Robot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10118,146 +16653,146 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Robot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Permissions = append(m.Permissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Permissions = append(m.Permissions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
 		case 3:
-			if wireType == 0 {
-				var v ResourceType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= ResourceType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
 				}
-				m.ResourceTypes = append(m.ResourceTypes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &TokenScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRobotTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRobotTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.ResourceTypes) == 0 {
-					m.ResourceTypes = make([]ResourceType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v ResourceType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ResourceType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ResourceTypes = append(m.ResourceTypes, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTypes", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10280,7 +16815,7 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetScopedTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10303,15 +16838,15 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetScopedTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetScopedTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10338,81 +16873,31 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
+			if m.Scope == nil {
+				m.Scope = &TokenScope{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Permissions = append(m.Permissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Permissions = append(m.Permissions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -10436,7 +16921,7 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
+func (m *GetScopedTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10459,173 +16944,98 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetScopedTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetScopedTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Authorized = bool(v != 0)
-		case 2:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Satisfied = append(m.Satisfied, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Satisfied) == 0 {
-					m.Satisfied = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Satisfied = append(m.Satisfied, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
-			}
-		case 3:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Missing = append(m.Missing, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Missing) == 0 {
-					m.Missing = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Missing = append(m.Missing, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAuthTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAuthTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10653,7 +17063,7 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10677,7 +17087,7 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListAuthTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10700,15 +17110,15 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAuthTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10735,10 +17145,8 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, &TokenInfo{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10764,7 +17172,7 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10787,17 +17195,17 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10807,31 +17215,27 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10859,7 +17263,7 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
+			m.HashedToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10883,7 +17287,7 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10906,84 +17310,98 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetGroupsForUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetGroupsForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Permissions = append(m.Permissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Permissions = append(m.Permissions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11011,7 +17429,7 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11035,7 +17453,7 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
+func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11058,17 +17476,68 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyRoleBindingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetGroupsForUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetGroupsForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModifyMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModifyMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -11078,31 +17547,27 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11130,11 +17595,11 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11162,7 +17627,7 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11186,7 +17651,7 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
+func (m *ModifyMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11209,10 +17674,10 @@ func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyRoleBindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11237,7 +17702,7 @@ func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
+func (m *GetGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11260,17 +17725,68 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoleBindingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -11280,27 +17796,23 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11324,7 +17836,7 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
+func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11347,17 +17859,17 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoleBindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -11367,27 +17879,23 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Binding == nil {
-				m.Binding = &RoleBinding{}
-			}
-			if err := m.Binding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11411,7 +17919,7 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionInfo) Unmarshal(dAtA []byte) error {
+func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11434,15 +17942,15 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetUsersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11470,11 +17978,62 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUsersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUsersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11502,28 +18061,8 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Usernames = append(m.Usernames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionErr", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ConversionErr = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11546,7 +18085,7 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
+func (m *ExtractAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11569,10 +18108,10 @@ func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCLoginRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtractAuthTokensRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtractAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11597,7 +18136,7 @@ func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
+func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11620,17 +18159,17 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCLoginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtractAuthTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCLoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtractAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoginURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -11640,55 +18179,25 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LoginURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Tokens = append(m.Tokens, &TokenInfo{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11712,7 +18221,7 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11735,17 +18244,17 @@ func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRobotTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreAuthTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRobotTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Robot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -11755,43 +18264,28 @@ func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Robot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			if m.Token == nil {
+				m.Token = &TokenInfo{}
 			}
-			m.TTL = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TTL |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11814,7 +18308,7 @@ func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11837,44 +18331,12 @@ func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRobotTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreAuthTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRobotTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11897,7 +18359,7 @@ func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11920,15 +18382,15 @@ func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11956,7 +18418,7 @@ func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11980,7 +18442,7 @@ func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokensForUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12003,10 +18465,10 @@ func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12031,7 +18493,7 @@ func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteExpiredAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12052,78 +18514,14 @@ func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetGroupsForUserRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetGroupsForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -12146,7 +18544,7 @@ func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteExpiredAuthTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12169,10 +18567,10 @@ func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetGroupsForUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetGroupsForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12197,7 +18595,7 @@ func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *AuditEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12220,15 +18618,70 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &types.Timestamp{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12256,11 +18709,11 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12288,11 +18741,67 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12320,7 +18829,39 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			m.Request = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12344,7 +18885,7 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *ListAuditEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12367,115 +18908,85 @@ func (m *ModifyMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAuditEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAuditEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetGroupsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Since == nil {
+				m.Since = &types.Timestamp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetGroupsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Until == nil {
+				m.Until = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
@@ -12507,6 +19018,81 @@ func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeniedOnly = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -12529,7 +19115,7 @@ func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListAuditEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12552,17 +19138,17 @@ func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAuditEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAuditEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -12572,23 +19158,25 @@ func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.Events = append(m.Events, &AuditEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12612,7 +19200,7 @@ func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
+func (m *ResourceRoleBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12635,17 +19223,17 @@ func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUsersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRoleBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -12655,23 +19243,63 @@ func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Binding == nil {
+				m.Binding = &RoleBinding{}
+			}
+			if err := m.Binding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12695,7 +19323,7 @@ func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
+func (m *GroupMembers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12718,15 +19346,15 @@ func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUsersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupMembers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUsersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12754,59 +19382,40 @@ func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Usernames = append(m.Usernames, string(dAtA[iNdEx:postIndex]))
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExtractAuthTokensRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractAuthTokensRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usernames = append(m.Usernames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -12829,7 +19438,7 @@ func (m *ExtractAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *ClusterAuthConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12852,15 +19461,15 @@ func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractAuthTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterAuthConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterAuthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12887,65 +19496,118 @@ func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &TokenInfo{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AuthConfig == nil {
+				m.AuthConfig = &OIDCConfig{}
+			}
+			if err := m.AuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
+			m.Groups = append(m.Groups, &GroupMembers{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RoleBindings = append(m.RoleBindings, &ResourceRoleBinding{})
+			if err := m.RoleBindings[len(m.RoleBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreAuthTokenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityServerConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12972,10 +19634,78 @@ func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &TokenInfo{}
+			if m.IdentityServerConfig == nil {
+				m.IdentityServerConfig = &identity.IdentityServerConfig{}
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IdentityServerConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdpConnectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdpConnectors = append(m.IdpConnectors, &identity.IDPConnector{})
+			if err := m.IdpConnectors[len(m.IdpConnectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OidcClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OidcClients = append(m.OidcClients, &identity.OIDCClient{})
+			if err := m.OidcClients[len(m.OidcClients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13001,7 +19731,7 @@ func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13020,16 +19750,48 @@ func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreAuthTokenResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -13052,7 +19814,7 @@ func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13075,17 +19837,17 @@ func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -13095,23 +19857,27 @@ func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &ClusterAuthConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13135,7 +19901,7 @@ func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokeAuthTokensForUserResponse) Unmarshal(dAtA []byte) error {
+func (m *ConfigChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13158,12 +19924,127 @@ func (m *RevokeAuthTokensForUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= ConfigChangeOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -13186,7 +20067,7 @@ func (m *RevokeAuthTokensForUserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteExpiredAuthTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13209,12 +20090,100 @@ func (m *DeleteExpiredAuthTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ClusterAuthConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecryptionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecryptionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -13237,7 +20206,7 @@ func (m *DeleteExpiredAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteExpiredAuthTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13260,12 +20229,46 @@ func (m *DeleteExpiredAuthTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &ConfigChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -68,3 +68,81 @@ func (c APIClient) ModifyRepoRoleBinding(repo, principal string, roles []string)
 	}
 	return nil
 }
+
+// GetBranchRoleBinding returns the role binding for the branches of repo
+// matching pattern.
+func (c APIClient) GetBranchRoleBinding(repo, pattern string) (*auth.RoleBinding, error) {
+	resp, err := c.GetRoleBinding(c.Ctx(), &auth.GetRoleBindingRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_BRANCH, Name: auth.BranchResourceName(repo, pattern)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Binding, nil
+}
+
+// ModifyBranchRoleBinding sets the roles principal has on the branches of repo
+// matching pattern, in addition to its roles on the repo.
+func (c APIClient) ModifyBranchRoleBinding(repo, pattern, principal string, roles []string) error {
+	_, err := c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+		Resource:  &auth.Resource{Type: auth.ResourceType_BRANCH, Name: auth.BranchResourceName(repo, pattern)},
+		Principal: principal,
+		Roles:     roles,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPipelineRoleBinding returns the role binding for pipeline.
+func (c APIClient) GetPipelineRoleBinding(pipeline string) (*auth.RoleBinding, error) {
+	resp, err := c.GetRoleBinding(c.Ctx(), &auth.GetRoleBindingRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_PIPELINE, Name: pipeline},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Binding, nil
+}
+
+// ModifyPipelineRoleBinding sets the roles principal has on pipeline, in
+// addition to its roles on the pipeline's output repo.
+func (c APIClient) ModifyPipelineRoleBinding(pipeline, principal string, roles []string) error {
+	_, err := c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+		Resource:  &auth.Resource{Type: auth.ResourceType_PIPELINE, Name: pipeline},
+		Principal: principal,
+		Roles:     roles,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPathRoleBinding returns the role binding for the files of repo under
+// prefix.
+func (c APIClient) GetPathRoleBinding(repo, prefix string) (*auth.RoleBinding, error) {
+	resp, err := c.GetRoleBinding(c.Ctx(), &auth.GetRoleBindingRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_PATH, Name: auth.PathResourceName(repo, prefix)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Binding, nil
+}
+
+// ModifyPathRoleBinding sets the roles principal has on the files of repo
+// under prefix. Once a prefix has a binding, only cluster roles and path
+// roles grant access to the files under it.
+func (c APIClient) ModifyPathRoleBinding(repo, prefix, principal string, roles []string) error {
+	_, err := c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+		Resource:  &auth.Resource{Type: auth.ResourceType_PATH, Name: auth.PathResourceName(repo, prefix)},
+		Principal: principal,
+		Roles:     roles,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import "github.com/pachyderm/pachyderm/v2/src/pfs"
 type putFileConfig struct {
 	datum  string
 	append bool
+	attrs  *pfs.FileAttributes
 }
 
 // PutFileOption configures a PutFile call.
@@ -25,6 +26,14 @@ func WithDatumPutFile(datum string) PutFileOption {
 	}
 }
 
+// WithAttributesPutFile configures the PutFile call to set the attributes
+// (mode, mtime, symlink target, content type and extended attributes) of the file.
+func WithAttributesPutFile(attrs *pfs.FileAttributes) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.attrs = attrs
+	}
+}
+
 type deleteFileConfig struct {
 	datum     string
 	recursive bool
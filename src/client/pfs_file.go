@@ -147,14 +147,16 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 				Source: &pfs.AddFile_Raw{
 					Raw: &types.BytesValue{Value: data},
 				},
+				Attributes: config.attrs,
 			})
 		}); err != nil {
 			return err
 		}
 		if emptyFile {
 			return mfc.sendPutFile(&pfs.AddFile{
-				Path:  path,
-				Datum: config.datum,
+				Path:       path,
+				Datum:      config.datum,
+				Attributes: config.attrs,
 			})
 		}
 		return nil
@@ -197,6 +199,7 @@ func (mfc *modifyFileCore) PutFileTAR(r io.Reader, opts ...PutFileOption) error
 				continue
 			}
 			p := hdr.Name
+			attrs := pfs.AttributesFromTarHeader(hdr)
 			if !config.append {
 				if err := mfc.sendDeleteFile(&pfs.DeleteFile{
 					Path:  p,
@@ -207,8 +210,9 @@ func (mfc *modifyFileCore) PutFileTAR(r io.Reader, opts ...PutFileOption) error
 			}
 			if hdr.Size == 0 {
 				if err := mfc.sendPutFile(&pfs.AddFile{
-					Path:  p,
-					Datum: config.datum,
+					Path:       p,
+					Datum:      config.datum,
+					Attributes: attrs,
 				}); err != nil {
 					return err
 				}
@@ -220,6 +224,7 @@ func (mfc *modifyFileCore) PutFileTAR(r io.Reader, opts ...PutFileOption) error
 						Source: &pfs.AddFile_Raw{
 							Raw: &types.BytesValue{Value: data},
 						},
+						Attributes: attrs,
 					})
 				}); err != nil {
 					return err
@@ -253,6 +258,7 @@ func (mfc *modifyFileCore) PutFileURL(path, url string, recursive bool, opts ...
 					Recursive: recursive,
 				},
 			},
+			Attributes: config.attrs,
 		}
 		return mfc.sendPutFile(pf)
 	})
@@ -666,14 +672,21 @@ func (c APIClient) ListFileAll(commit *pfs.Commit, path string) (_ []*pfs.FileIn
 // calling cb with each FileInfo. The pattern is documented here:
 // https://golang.org/pkg/path/filepath/#Match
 func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+	return c.GlobFileAfter(commit, pattern, "", cb)
+}
+
+// GlobFileAfter is like GlobFile, but it skips the files with paths less than
+// or equal to startAfter, without reading them if possible.
+func (c APIClient) GlobFileAfter(commit *pfs.Commit, pattern, startAfter string, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	client, err := c.PfsAPIClient.GlobFile(
 		c.Ctx(),
 		&pfs.GlobFileRequest{
-			Commit:  commit,
-			Pattern: pattern,
+			Commit:     commit,
+			Pattern:    pattern,
+			StartAfter: startAfter,
 		},
 	)
 	if err != nil {
@@ -187,6 +187,21 @@ func NewCronInputOpts(name string, repo string, spec string, overwrite bool) *pp
 	}
 }
 
+// NewObjectInput returns an input which ingests the objects under an object
+// store prefix (e.g. `s3://bucket/prefix`) on a timed schedule. It uses cron
+// syntax to specify the schedule. An object `<prefix>/path/to/obj` will be
+// exposed to jobs as `/pfs/<name>/path/to/obj`.
+func NewObjectInput(name string, url string, spec string, glob string) *pps.Input {
+	return &pps.Input{
+		Object: &pps.ObjectInput{
+			Name: name,
+			URL:  url,
+			Spec: spec,
+			Glob: glob,
+		},
+	}
+}
+
 // NewJobInput creates a pps.JobInput.
 func NewJobInput(repoName string, branchName string, commitID string, glob string) *pps.JobInput {
 	return &pps.JobInput{
@@ -529,6 +544,23 @@ func (c APIClient) InspectDatum(pipelineName string, jobID string, datumID strin
 	return datumInfo, nil
 }
 
+// ExplainDatum explains why a single datum was processed or skipped by a job.
+func (c APIClient) ExplainDatum(pipelineName string, jobID string, datumID string) (*pps.ExplainDatumResponse, error) {
+	explanation, err := c.PpsAPIClient.ExplainDatum(
+		c.Ctx(),
+		&pps.ExplainDatumRequest{
+			Datum: &pps.Datum{
+				ID:  datumID,
+				Job: NewJob(pipelineName, jobID),
+			},
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return explanation, nil
+}
+
 // LogsIter iterates through log messages returned from pps.GetLogs. Logs can
 // be fetched with 'Next()'. The log message received can be examined with
 // 'Message()', and any errors can be examined with 'Err()'.
@@ -797,6 +829,37 @@ func (c APIClient) RunCron(name string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RunCronAt makes a tick at the given time for each of a cron pipeline's cron
+// inputs, e.g. to materialize a historical tick that was missed.
+func (c APIClient) RunCronAt(name string, at time.Time) error {
+	atProto, err := types.TimestampProto(at)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = c.PpsAPIClient.RunCron(
+		c.Ctx(),
+		&pps.RunCronRequest{
+			Pipeline: NewPipeline(name),
+			At:       atProto,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ReplayDeadLetter releases the given datums from the pipeline's dead-letter
+// branch and starts a new job to reprocess them. If no datums are given,
+// every quarantined datum is replayed.
+func (c APIClient) ReplayDeadLetter(pipelineName string, datums []string) error {
+	_, err := c.PpsAPIClient.ReplayDeadLetter(
+		c.Ctx(),
+		&pps.ReplayDeadLetterRequest{
+			Pipeline: NewPipeline(pipelineName),
+			Datums:   datums,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // CreateSecret creates a secret on the cluster.
 func (c APIClient) CreateSecret(file []byte) error {
 	_, err := c.PpsAPIClient.CreateSecret(
@@ -830,6 +893,20 @@ func (c APIClient) InspectSecret(secret string) (*pps.SecretInfo, error) {
 	return secretInfo, grpcutil.ScrubGRPC(err)
 }
 
+// InspectPipelineSecret returns info about a secret used by a pipeline. It
+// only requires permission to read the pipeline's secrets, rather than
+// permission to inspect any secret in the cluster.
+func (c APIClient) InspectPipelineSecret(pipeline, secret string) (*pps.SecretInfo, error) {
+	secretInfo, err := c.PpsAPIClient.InspectSecret(
+		c.Ctx(),
+		&pps.InspectSecretRequest{
+			Secret:   &pps.Secret{Name: secret},
+			Pipeline: NewPipeline(pipeline),
+		},
+	)
+	return secretInfo, grpcutil.ScrubGRPC(err)
+}
+
 // ListSecret returns info about all Pachyderm secrets.
 func (c APIClient) ListSecret() ([]*pps.SecretInfo, error) {
 	secretInfos, err := c.PpsAPIClient.ListSecret(
@@ -47,6 +47,10 @@ func (c *unsupportedAuthBuilderClient) Authorize(_ context.Context, _ *auth_v2.A
 	return nil, unsupportedError("Authorize")
 }
 
+func (c *unsupportedAuthBuilderClient) CreateRole(_ context.Context, _ *auth_v2.CreateRoleRequest, opts ...grpc.CallOption) (*auth_v2.CreateRoleResponse, error) {
+	return nil, unsupportedError("CreateRole")
+}
+
 func (c *unsupportedAuthBuilderClient) Deactivate(_ context.Context, _ *auth_v2.DeactivateRequest, opts ...grpc.CallOption) (*auth_v2.DeactivateResponse, error) {
 	return nil, unsupportedError("Deactivate")
 }
@@ -55,6 +59,14 @@ func (c *unsupportedAuthBuilderClient) DeleteExpiredAuthTokens(_ context.Context
 	return nil, unsupportedError("DeleteExpiredAuthTokens")
 }
 
+func (c *unsupportedAuthBuilderClient) DeleteRole(_ context.Context, _ *auth_v2.DeleteRoleRequest, opts ...grpc.CallOption) (*auth_v2.DeleteRoleResponse, error) {
+	return nil, unsupportedError("DeleteRole")
+}
+
+func (c *unsupportedAuthBuilderClient) ExportConfig(_ context.Context, _ *auth_v2.ExportConfigRequest, opts ...grpc.CallOption) (*auth_v2.ExportConfigResponse, error) {
+	return nil, unsupportedError("ExportConfig")
+}
+
 func (c *unsupportedAuthBuilderClient) ExtractAuthTokens(_ context.Context, _ *auth_v2.ExtractAuthTokensRequest, opts ...grpc.CallOption) (*auth_v2.ExtractAuthTokensResponse, error) {
 	return nil, unsupportedError("ExtractAuthTokens")
 }
@@ -95,10 +107,30 @@ func (c *unsupportedAuthBuilderClient) GetRolesForPermission(_ context.Context,
 	return nil, unsupportedError("GetRolesForPermission")
 }
 
+func (c *unsupportedAuthBuilderClient) GetScopedToken(_ context.Context, _ *auth_v2.GetScopedTokenRequest, opts ...grpc.CallOption) (*auth_v2.GetScopedTokenResponse, error) {
+	return nil, unsupportedError("GetScopedToken")
+}
+
 func (c *unsupportedAuthBuilderClient) GetUsers(_ context.Context, _ *auth_v2.GetUsersRequest, opts ...grpc.CallOption) (*auth_v2.GetUsersResponse, error) {
 	return nil, unsupportedError("GetUsers")
 }
 
+func (c *unsupportedAuthBuilderClient) ImportConfig(_ context.Context, _ *auth_v2.ImportConfigRequest, opts ...grpc.CallOption) (*auth_v2.ImportConfigResponse, error) {
+	return nil, unsupportedError("ImportConfig")
+}
+
+func (c *unsupportedAuthBuilderClient) ListAuditEvents(_ context.Context, _ *auth_v2.ListAuditEventsRequest, opts ...grpc.CallOption) (*auth_v2.ListAuditEventsResponse, error) {
+	return nil, unsupportedError("ListAuditEvents")
+}
+
+func (c *unsupportedAuthBuilderClient) ListAuthTokens(_ context.Context, _ *auth_v2.ListAuthTokensRequest, opts ...grpc.CallOption) (*auth_v2.ListAuthTokensResponse, error) {
+	return nil, unsupportedError("ListAuthTokens")
+}
+
+func (c *unsupportedAuthBuilderClient) ListRole(_ context.Context, _ *auth_v2.ListRoleRequest, opts ...grpc.CallOption) (*auth_v2.ListRoleResponse, error) {
+	return nil, unsupportedError("ListRole")
+}
+
 func (c *unsupportedAuthBuilderClient) ModifyMembers(_ context.Context, _ *auth_v2.ModifyMembersRequest, opts ...grpc.CallOption) (*auth_v2.ModifyMembersResponse, error) {
 	return nil, unsupportedError("ModifyMembers")
 }
@@ -131,6 +163,10 @@ func (c *unsupportedAuthBuilderClient) SetGroupsForUser(_ context.Context, _ *au
 	return nil, unsupportedError("SetGroupsForUser")
 }
 
+func (c *unsupportedAuthBuilderClient) UpdateRole(_ context.Context, _ *auth_v2.UpdateRoleRequest, opts ...grpc.CallOption) (*auth_v2.UpdateRoleResponse, error) {
+	return nil, unsupportedError("UpdateRole")
+}
+
 func (c *unsupportedAuthBuilderClient) WhoAmI(_ context.Context, _ *auth_v2.WhoAmIRequest, opts ...grpc.CallOption) (*auth_v2.WhoAmIResponse, error) {
 	return nil, unsupportedError("WhoAmI")
 }
@@ -345,6 +381,10 @@ func (c *unsupportedPfsBuilderClient) Fsck(_ context.Context, _ *pfs_v2.FsckRequ
 	return nil, unsupportedError("Fsck")
 }
 
+func (c *unsupportedPfsBuilderClient) GarbageCollectStorage(_ context.Context, _ *pfs_v2.GarbageCollectStorageRequest, opts ...grpc.CallOption) (*pfs_v2.GarbageCollectStorageResponse, error) {
+	return nil, unsupportedError("GarbageCollectStorage")
+}
+
 func (c *unsupportedPfsBuilderClient) GetCache(_ context.Context, _ *pfs_v2.GetCacheRequest, opts ...grpc.CallOption) (*pfs_v2.GetCacheResponse, error) {
 	return nil, unsupportedError("GetCache")
 }
@@ -373,6 +413,10 @@ func (c *unsupportedPfsBuilderClient) InspectCommit(_ context.Context, _ *pfs_v2
 	return nil, unsupportedError("InspectCommit")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectCommitCompaction(_ context.Context, _ *pfs_v2.InspectCommitCompactionRequest, opts ...grpc.CallOption) (*pfs_v2.CommitCompactionInfo, error) {
+	return nil, unsupportedError("InspectCommitCompaction")
+}
+
 func (c *unsupportedPfsBuilderClient) InspectCommitSet(_ context.Context, _ *pfs_v2.InspectCommitSetRequest, opts ...grpc.CallOption) (pfs_v2.API_InspectCommitSetClient, error) {
 	return nil, unsupportedError("InspectCommitSet")
 }
@@ -421,6 +465,14 @@ func (c *unsupportedPfsBuilderClient) RenewFileSet(_ context.Context, _ *pfs_v2.
 	return nil, unsupportedError("RenewFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) ReplicationStatus(_ context.Context, _ *pfs_v2.ReplicationStatusRequest, opts ...grpc.CallOption) (*pfs_v2.ReplicationStatusResponse, error) {
+	return nil, unsupportedError("ReplicationStatus")
+}
+
+func (c *unsupportedPfsBuilderClient) RestoreStorageTrash(_ context.Context, _ *pfs_v2.RestoreStorageTrashRequest, opts ...grpc.CallOption) (*pfs_v2.RestoreStorageTrashResponse, error) {
+	return nil, unsupportedError("RestoreStorageTrash")
+}
+
 func (c *unsupportedPfsBuilderClient) RunLoadTest(_ context.Context, _ *pfs_v2.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs_v2.RunLoadTestResponse, error) {
 	return nil, unsupportedError("RunLoadTest")
 }
@@ -429,6 +481,14 @@ func (c *unsupportedPfsBuilderClient) RunLoadTestDefault(_ context.Context, _ *t
 	return nil, unsupportedError("RunLoadTestDefault")
 }
 
+func (c *unsupportedPfsBuilderClient) ScrubStorage(_ context.Context, _ *pfs_v2.ScrubStorageRequest, opts ...grpc.CallOption) (pfs_v2.API_ScrubStorageClient, error) {
+	return nil, unsupportedError("ScrubStorage")
+}
+
+func (c *unsupportedPfsBuilderClient) SetStorageGCPaused(_ context.Context, _ *pfs_v2.SetStorageGCPausedRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("SetStorageGCPaused")
+}
+
 func (c *unsupportedPfsBuilderClient) SquashCommitSet(_ context.Context, _ *pfs_v2.SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("SquashCommitSet")
 }
@@ -475,6 +535,10 @@ func (c *unsupportedPpsBuilderClient) DeleteSecret(_ context.Context, _ *pps_v2.
 	return nil, unsupportedError("DeleteSecret")
 }
 
+func (c *unsupportedPpsBuilderClient) ExplainDatum(_ context.Context, _ *pps_v2.ExplainDatumRequest, opts ...grpc.CallOption) (*pps_v2.ExplainDatumResponse, error) {
+	return nil, unsupportedError("ExplainDatum")
+}
+
 func (c *unsupportedPpsBuilderClient) GetLogs(_ context.Context, _ *pps_v2.GetLogsRequest, opts ...grpc.CallOption) (pps_v2.API_GetLogsClient, error) {
 	return nil, unsupportedError("GetLogs")
 }
@@ -527,6 +591,10 @@ func (c *unsupportedPpsBuilderClient) RenderTemplate(_ context.Context, _ *pps_v
 	return nil, unsupportedError("RenderTemplate")
 }
 
+func (c *unsupportedPpsBuilderClient) ReplayDeadLetter(_ context.Context, _ *pps_v2.ReplayDeadLetterRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("ReplayDeadLetter")
+}
+
 func (c *unsupportedPpsBuilderClient) RestartDatum(_ context.Context, _ *pps_v2.RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RestartDatum")
 }
@@ -587,6 +655,10 @@ func (c *unsupportedTransactionBuilderClient) DeleteTransaction(_ context.Contex
 	return nil, unsupportedError("DeleteTransaction")
 }
 
+func (c *unsupportedTransactionBuilderClient) DryRunTransaction(_ context.Context, _ *transaction_v2.DryRunTransactionRequest, opts ...grpc.CallOption) (*transaction_v2.DryRunTransactionResponse, error) {
+	return nil, unsupportedError("DryRunTransaction")
+}
+
 func (c *unsupportedTransactionBuilderClient) FinishTransaction(_ context.Context, _ *transaction_v2.FinishTransactionRequest, opts ...grpc.CallOption) (*transaction_v2.TransactionInfo, error) {
 	return nil, unsupportedError("FinishTransaction")
 }
@@ -126,6 +126,22 @@ func (c APIClient) FinishTransaction(txn *transaction.Transaction) (*transaction
 	return response, nil
 }
 
+// DryRunTransaction is an RPC that runs an existing transaction in the
+// Pachyderm cluster without applying it, and returns the changes each of its
+// requests would make. The transaction is left open.
+func (c APIClient) DryRunTransaction(txn *transaction.Transaction) (*transaction.DryRunTransactionResponse, error) {
+	response, err := c.TransactionAPIClient.DryRunTransaction(
+		c.Ctx(),
+		&transaction.DryRunTransactionRequest{
+			Transaction: txn,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
 // DeleteTransaction is an RPC that aborts an existing transaction in the
 // Pachyderm cluster and removes it from the cluster.
 func (c APIClient) DeleteTransaction(txn *transaction.Transaction) error {
@@ -335,3 +351,31 @@ func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePi
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{CreatePipeline: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) DropCommitSet(ctx context.Context, req *pfs.DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DropCommitSet: req})
+	return nil, nil
+}
+func (c *pfsBuilderClient) AddFileSet(ctx context.Context, req *pfs.AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{AddFileSet: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) DeletePipeline(ctx context.Context, req *pps.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeletePipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) StopPipeline(ctx context.Context, req *pps.StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StopPipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StartPipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) CreateSecret(ctx context.Context, req *pps.CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{CreateSecret: req})
+	return nil, nil
+}
+func (c *authBuilderClient) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleBindingRequest, opts ...grpc.CallOption) (*auth.ModifyRoleBindingResponse, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{ModifyRoleBinding: req})
+	return nil, nil
+}
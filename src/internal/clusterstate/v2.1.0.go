@@ -3,9 +3,17 @@ package clusterstate
 import (
 	"context"
 
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/server/auth"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
 	enterpriseserver "github.com/pachyderm/pachyderm/v2/src/server/enterprise/server"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
 )
 
 var state_2_1_0 migrations.State = state_2_0_0.
@@ -17,4 +25,29 @@ var state_2_1_0 migrations.State = state_2_0_0.
 	}).
 	Apply("create pfs cache v1", func(ctx context.Context, env migrations.Env) error {
 		return fileset.CreatePostgresCacheV1(ctx, env.Tx)
+	}).
+	Apply("create auth roles collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, authserver.RolesCollectionV0())
+	}).
+	Apply("create auth audit events table v0", func(ctx context.Context, env migrations.Env) error {
+		return auth.CreateAuditEventsTable(ctx, env.Tx)
+	}).
+	Apply("add auth token scope column v0", func(ctx context.Context, env migrations.Env) error {
+		return auth.AddAuthTokenScopeColumn(ctx, env.Tx)
+	}).
+	Apply("storage chunk replicas v0", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresReplicasV0(ctx, env.Tx)
+	}).
+	Apply("storage gc state and chunk trash v0", func(ctx context.Context, env migrations.Env) error {
+		if err := track.SetupPostgresGCStateV0(ctx, env.Tx); err != nil {
+			return err
+		}
+		return chunk.SetupPostgresTrashV0(ctx, env.Tx)
+	}).
+	Apply("pfs deferred compactions v0", func(ctx context.Context, env migrations.Env) error {
+		return pfsserver.SetupPostgresDeferredCompactionsV0(ctx, env.Tx)
+	}).
+	Apply("collections updatedat index v0", func(ctx context.Context, env migrations.Env) error {
+		collections := append(pfsdb.CollectionsV0(), ppsdb.CollectionsV0()...)
+		return col.SetupPostgresUpdatedAtIndexV0(ctx, env.Tx, collections...)
 	})
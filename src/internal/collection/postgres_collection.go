@@ -248,6 +248,17 @@ func (c *postgresReadWriteCollection) GetByIndex(index *Index, indexVal string,
 	return c.getByIndex(context.Background(), c.tx, index, indexVal, val, opts, f)
 }
 
+// List calls 'f' with each item in the collection, reading within the
+// collection's transaction.
+func (c *postgresReadWriteCollection) List(val proto.Message, opts *Options, f func(string) error) error {
+	return c.postgresCollection.list(context.Background(), nil, opts, c.tx, func(m *model) error {
+		if err := proto.Unmarshal(m.Proto, val); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return f(m.Key)
+	})
+}
+
 func (c *postgresCollection) getUniqueByIndex(ctx context.Context, q sqlx.ExtContext, index *Index, indexVal string, val proto.Message) error {
 	found := false
 	if err := c.getByIndex(ctx, q, index, indexVal, val, DefaultOptions(), func(string) error {
@@ -273,6 +284,36 @@ func (c *postgresReadWriteCollection) GetUniqueByIndex(index *Index, indexVal st
 	return c.getUniqueByIndex(context.Background(), c.tx, index, indexVal, val)
 }
 
+// ListModified relies on 'updatedat' being set to the start time of the
+// transaction that last wrote each row, and on the index on 'updatedat' (see
+// SetupPostgresUpdatedAtIndexV0). Rows are read in pages, using the last key
+// of each page as the cursor for the next.
+func (c *postgresReadWriteCollection) ListModified(val proto.Message, f func(string) error) error {
+	query := fmt.Sprintf("select key, proto from collections.%s where updatedat = now() and key > $1 order by key limit $2", c.table)
+	var last string
+	for {
+		var models []*model
+		if err := sqlx.SelectContext(context.Background(), c.tx, &models, query, last, c.listBufferCapacity); err != nil {
+			return c.mapSQLError(err, "")
+		}
+		for _, m := range models {
+			if err := proto.Unmarshal(m.Proto, val); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := f(m.Key); err != nil {
+				if errors.Is(err, errutil.ErrBreak) {
+					return nil
+				}
+				return err
+			}
+			last = m.Key
+		}
+		if len(models) < c.listBufferCapacity {
+			return nil
+		}
+	}
+}
+
 func orderToSQL(order etcd.SortOrder) (string, error) {
 	switch order {
 	case SortAscend:
@@ -194,6 +194,61 @@ func PostgresCollectionBasicTests(suite *testing.T, newCollection func(context.C
 		})
 	})
 
+	suite.Run("ReadWriteListModified", func(t *testing.T) {
+		t.Parallel()
+		_, writer := initCollection(t, newCollection)
+
+		var keys []string
+		err := writer(context.Background(), func(rw col.ReadWriteCollection) error {
+			if err := rw.Put(makeID(2), makeProto(makeID(2))); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := rw.Put(makeID(defaultCollectionSize), makeProto(makeID(defaultCollectionSize))); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := rw.Delete(makeID(3)); err != nil {
+				return errors.EnsureStack(err)
+			}
+			testProto := &col.TestItem{}
+			pgrw := rw.(col.PostgresReadWriteCollection)
+			return errors.EnsureStack(pgrw.ListModified(testProto, func(key string) error {
+				require.Equal(t, testProto.ID, key)
+				require.Equal(t, changedValue, testProto.Value)
+				keys = append(keys, key)
+				return nil
+			}))
+		})
+		require.NoError(t, err)
+		require.ElementsEqual(t, []string{makeID(2), makeID(defaultCollectionSize)}, keys)
+	})
+
+	suite.Run("ReadWriteList", func(t *testing.T) {
+		t.Parallel()
+		_, writer := initCollection(t, newCollection)
+
+		keys := make(map[string]bool)
+		err := writer(context.Background(), func(rw col.ReadWriteCollection) error {
+			if err := rw.Put(makeID(defaultCollectionSize), makeProto(makeID(defaultCollectionSize))); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := rw.Delete(makeID(3)); err != nil {
+				return errors.EnsureStack(err)
+			}
+			testProto := &col.TestItem{}
+			pgrw := rw.(col.PostgresReadWriteCollection)
+			return errors.EnsureStack(pgrw.List(testProto, col.DefaultOptions(), func(key string) error {
+				require.Equal(t, testProto.ID, key)
+				keys[key] = true
+				return nil
+			}))
+		})
+		require.NoError(t, err)
+		// The list reflects the transaction's own writes
+		require.Equal(t, defaultCollectionSize, len(keys))
+		require.True(t, keys[makeID(defaultCollectionSize)])
+		require.False(t, keys[makeID(3)])
+	})
+
 	// TODO: postgres-specific collection tests:
 	// GetRevByIndex(index *Index, indexVal string, val proto.Message, opts *Options, f func(int64) error) error
 	// DeleteByIndex(index *Index, indexVal string) error
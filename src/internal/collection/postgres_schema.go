@@ -220,3 +220,16 @@ func SetupPostgresCollections(ctx context.Context, sqlTx *pachsql.Tx, collection
 	}
 	return nil
 }
+
+// SetupPostgresUpdatedAtIndexV0 indexes the 'updatedat' column of each of
+// 'collections', which ReadWriteCollection.ListModified queries on.
+func SetupPostgresUpdatedAtIndexV0(ctx context.Context, sqlTx *pachsql.Tx, collections ...PostgresCollection) error {
+	for _, pgc := range collections {
+		col := pgc.(*postgresCollection)
+		createIndex := fmt.Sprintf("create index if not exists %s_updatedat on collections.%s (updatedat);", col.table, col.table)
+		if _, err := sqlTx.ExecContext(ctx, createIndex); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
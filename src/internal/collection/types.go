@@ -99,6 +99,16 @@ type PostgresReadWriteCollection interface {
 	// exactly one row is not found.
 	// TODO: decide if we should merge this with GetByIndex and use an `Options`.
 	GetUniqueByIndex(index *Index, indexVal string, val proto.Message) error
+
+	// List calls 'f' with each item in the collection, as seen by the current
+	// transaction. Like GetByIndex, it can have a large impact on database
+	// contention, so prefer a read-only collection if possible.
+	List(val proto.Message, opts *Options, f func(string) error) error
+
+	// ListModified calls 'f' with each item that has been created or updated
+	// in the current transaction, ordered by key. Deleted items aren't
+	// reported.
+	ListModified(val proto.Message, f func(string) error) error
 }
 
 type EtcdReadWriteCollection interface {
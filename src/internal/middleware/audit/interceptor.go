@@ -0,0 +1,267 @@
+// Package audit records the calls made to the Pachyderm API while auth is
+// active in the auth server's audit log.
+package audit
+
+import (
+	"context"
+	"io"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	authmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth"
+)
+
+// maxRequestSize is the maximum length of the request summary stored with an
+// audit event. Longer summaries are truncated.
+const maxRequestSize = 4096
+
+// unaudited contains the RPCs that are called too often, and reveal too
+// little, to be worth recording
+var unaudited = map[string]bool{
+	"/auth_v2.API/WhoAmI":                true,
+	"/versionpb_v2.API/GetVersion":       true,
+	"/admin_v2.API/InspectCluster":       true,
+	"/grpc.health.v1.Health/Check":       true,
+	"/grpc.health.v1.Health/Watch":       true,
+	"/proxy.API/Listen":                  true,
+	"/auth_v2.API/GetOIDCLogin":          true,
+	"/auth_v2.API/GetRolesForPermission": true,
+}
+
+// summarized contains the RPCs whose requests carry no secrets or file
+// contents, and so are stored with their audit events. The requests of other
+// RPCs are only stored if the logging interceptor redacts them; otherwise only
+// their method and resource are recorded.
+var summarized = map[string]bool{
+	"/pfs_v2.API/CreateRepo":               true,
+	"/pfs_v2.API/DeleteRepo":               true,
+	"/pfs_v2.API/StartCommit":              true,
+	"/pfs_v2.API/FinishCommit":             true,
+	"/pfs_v2.API/ClearCommit":              true,
+	"/pfs_v2.API/SquashCommitSet":          true,
+	"/pfs_v2.API/DropCommitSet":            true,
+	"/pfs_v2.API/CreateBranch":             true,
+	"/pfs_v2.API/DeleteBranch":             true,
+	"/pfs_v2.API/GetFile":                  true,
+	"/pfs_v2.API/GetFileTAR":               true,
+	"/pfs_v2.API/InspectFile":              true,
+	"/pfs_v2.API/ListFile":                 true,
+	"/pfs_v2.API/GlobFile":                 true,
+	"/pfs_v2.API/DiffFile":                 true,
+	"/pfs_v2.API/GarbageCollectStorage":    true,
+	"/pfs_v2.API/SetStorageGCPaused":       true,
+	"/pps_v2.API/DeleteJob":                true,
+	"/pps_v2.API/StopJob":                  true,
+	"/pps_v2.API/RestartDatum":             true,
+	"/pps_v2.API/DeletePipeline":           true,
+	"/pps_v2.API/StartPipeline":            true,
+	"/pps_v2.API/StopPipeline":             true,
+	"/pps_v2.API/RunPipeline":              true,
+	"/pps_v2.API/RunCron":                  true,
+	"/pps_v2.API/ReplayDeadLetter":         true,
+	"/pps_v2.API/DeleteSecret":             true,
+	"/pps_v2.API/InspectSecret":            true,
+	"/auth_v2.API/CreateRole":              true,
+	"/auth_v2.API/UpdateRole":              true,
+	"/auth_v2.API/DeleteRole":              true,
+	"/auth_v2.API/ModifyRoleBinding":       true,
+	"/auth_v2.API/GetRoleBinding":          true,
+	"/auth_v2.API/ModifyMembers":           true,
+	"/auth_v2.API/SetGroupsForUser":        true,
+	"/auth_v2.API/RevokeAuthTokensForUser": true,
+}
+
+// Interceptor records an audit event for each RPC made by an authenticated
+// caller, or denied for lack of authentication or permissions. It must run
+// before the auth interceptor, so that it sees the calls that are denied.
+type Interceptor struct {
+	getAuthServer func() authserver.APIServer
+}
+
+// NewInterceptor instantiates a new Interceptor
+func NewInterceptor(getAuthServer func() authserver.APIServer) *Interceptor {
+	return &Interceptor{
+		getAuthServer: getAuthServer,
+	}
+}
+
+// InterceptUnary records audit events for unary RPCs
+func (i *Interceptor) InterceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, retErr error) {
+	if unaudited[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	ctx, principal := authmw.WithPrincipal(ctx)
+	defer func() {
+		i.record(info.FullMethod, principal(), req, retErr)
+	}()
+	return handler(ctx, req)
+}
+
+// InterceptStream records audit events for streaming RPCs once the stream
+// completes. The first message received from the client is recorded as the
+// request, and the stream's error, if the handler didn't return one.
+func (i *Interceptor) InterceptStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (retErr error) {
+	if unaudited[info.FullMethod] {
+		return handler(srv, stream)
+	}
+	ctx, principal := authmw.WithPrincipal(stream.Context())
+	wrapper := &streamWrapper{ServerStream: stream, ctx: ctx}
+	defer func() {
+		err := retErr
+		if err == nil {
+			err = wrapper.err
+		}
+		i.record(info.FullMethod, principal(), wrapper.req, err)
+	}()
+	return handler(srv, wrapper)
+}
+
+func (i *Interceptor) record(method, principal string, req interface{}, err error) {
+	if auth.IsErrNotActivated(err) {
+		return
+	}
+	allowed := !isDenied(err)
+	if !allowed && principal == "" {
+		var notAuthorized *auth.ErrNotAuthorized
+		if errors.As(err, &notAuthorized) {
+			principal = notAuthorized.Subject
+		}
+	}
+	if allowed && principal == "" {
+		// auth isn't active, or the RPC doesn't require authentication
+		return
+	}
+	event := &auth.AuditEvent{
+		Time:      types.TimestampNow(),
+		Principal: principal,
+		Method:    method,
+		Resource:  resourceOf(req),
+		Allowed:   allowed,
+		Request:   summarize(method, req),
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	i.getAuthServer().RecordAuditEvent(event)
+}
+
+func isDenied(err error) bool {
+	return auth.IsErrNotAuthorized(err) || auth.IsErrNotSignedIn(err) ||
+		auth.IsErrBadToken(err) || auth.IsErrExpiredToken(err)
+}
+
+// summarize returns a JSON representation of 'req' with its secret fields
+// redacted, or "" if 'method' isn't known to be safe to summarize
+func summarize(method string, req interface{}) string {
+	if !summarized[method] && !logging.RedactsRequest(method) {
+		return ""
+	}
+	msg, ok := logging.RedactRequest(method, req).(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(msg)
+	if err != nil {
+		return ""
+	}
+	if len(s) > maxRequestSize {
+		s = s[:maxRequestSize] + "..."
+	}
+	return s
+}
+
+type resourceGetter interface{ GetResource() *auth.Resource }
+type fileGetter interface{ GetFile() *pfs.File }
+type commitGetter interface{ GetCommit() *pfs.Commit }
+type setCommitGetter interface{ GetSetCommit() *pfs.Commit }
+type branchGetter interface{ GetBranch() *pfs.Branch }
+type repoGetter interface{ GetRepo() *pfs.Repo }
+type jobGetter interface{ GetJob() *pps.Job }
+type pipelineGetter interface{ GetPipeline() *pps.Pipeline }
+
+// resourceOf returns the resource named in 'req', if any. PFS requests are
+// attributed to their repo, and PPS requests to their pipeline.
+func resourceOf(req interface{}) *auth.Resource {
+	if r, ok := req.(resourceGetter); ok && r.GetResource() != nil {
+		return r.GetResource()
+	}
+	if r, ok := req.(fileGetter); ok && r.GetFile() != nil {
+		return repoResource(r.GetFile().GetCommit().GetBranch().GetRepo())
+	}
+	if r, ok := req.(commitGetter); ok && r.GetCommit() != nil {
+		return repoResource(r.GetCommit().GetBranch().GetRepo())
+	}
+	if r, ok := req.(setCommitGetter); ok && r.GetSetCommit() != nil {
+		return repoResource(r.GetSetCommit().GetBranch().GetRepo())
+	}
+	if r, ok := req.(branchGetter); ok && r.GetBranch() != nil {
+		return repoResource(r.GetBranch().GetRepo())
+	}
+	if r, ok := req.(repoGetter); ok && r.GetRepo() != nil {
+		return repoResource(r.GetRepo())
+	}
+	if r, ok := req.(jobGetter); ok && r.GetJob() != nil {
+		return pipelineResource(r.GetJob().GetPipeline())
+	}
+	if r, ok := req.(pipelineGetter); ok && r.GetPipeline() != nil {
+		return pipelineResource(r.GetPipeline())
+	}
+	return nil
+}
+
+func repoResource(r *pfs.Repo) *auth.Resource {
+	if r == nil || r.Name == "" {
+		return nil
+	}
+	return &auth.Resource{Type: auth.ResourceType_REPO, Name: r.Name}
+}
+
+func pipelineResource(p *pps.Pipeline) *auth.Resource {
+	if p == nil || p.Name == "" {
+		return nil
+	}
+	return &auth.Resource{Type: auth.ResourceType_PIPELINE, Name: p.Name}
+}
+
+// streamWrapper sets the context of a stream and saves the first message
+// received on it, and the first error sending or receiving on it
+type streamWrapper struct {
+	grpc.ServerStream
+	ctx      context.Context
+	req      interface{}
+	received bool
+	err      error
+}
+
+func (s *streamWrapper) Context() context.Context {
+	return s.ctx
+}
+
+func (s *streamWrapper) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != io.EOF && !s.received {
+		s.req = m
+		s.received = true
+	}
+	if err != nil && err != io.EOF && s.err == nil {
+		s.err = err
+	}
+	return errors.EnsureStack(err)
+}
+
+func (s *streamWrapper) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+	return errors.EnsureStack(err)
+}
@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth"
+	"github.com/pachyderm/pachyderm/v2/src/transaction"
+)
+
+type recordingAuthServer struct {
+	authserver.APIServer
+	events []*auth.AuditEvent
+}
+
+func (s *recordingAuthServer) RecordAuditEvent(event *auth.AuditEvent) {
+	s.events = append(s.events, event)
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	server := &recordingAuthServer{}
+	i := NewInterceptor(func() authserver.APIServer { return server })
+	secret := []byte(`{"kind": "Secret", "data": {"password": "aHVudGVyMg=="}}`)
+
+	i.record("/pps_v2.API/CreateSecret", "user:alice", &pps.CreateSecretRequest{File: secret}, nil)
+	i.record("/transaction_v2.API/BatchTransaction", "user:alice", &transaction.BatchTransactionRequest{
+		Requests: []*transaction.TransactionRequest{{CreateSecret: &pps.CreateSecretRequest{File: secret}}},
+	}, nil)
+	// RPCs that aren't known to be safe are recorded without their request
+	i.record("/pps_v2.API/CreatePipeline", "user:alice", &pps.CreatePipelineRequest{
+		Pipeline:  &pps.Pipeline{Name: "p"},
+		Transform: &pps.Transform{Env: map[string]string{"PASSWORD": "hunter2"}},
+	}, nil)
+	i.record("/pfs_v2.API/CreateRepo", "user:alice", &pfs.CreateRepoRequest{Repo: &pfs.Repo{Name: "r", Type: pfs.UserRepoType}}, nil)
+
+	require.Equal(t, 4, len(server.events))
+	for _, event := range server.events {
+		require.False(t, strings.Contains(event.Request, "aHVudGVyMg"), event.Method)
+		require.False(t, strings.Contains(event.Request, "hunter2"), event.Method)
+	}
+	require.Equal(t, "", server.events[2].Request)
+	require.Equal(t, "p", server.events[2].Resource.Name)
+	require.True(t, strings.Contains(server.events[3].Request, `"name":"r"`))
+}
@@ -16,7 +16,12 @@ type authHandler func(context.Context, authiface.APIServer, string) (string, err
 
 type ContextKey string
 
-const whoAmIResultKey = ContextKey("WhoAmI")
+const (
+	whoAmIResultKey = ContextKey("WhoAmI")
+	whoAmIScopeKey  = ContextKey("WhoAmIScope")
+	principalKey    = ContextKey("Principal")
+	callerScopeKey  = ContextKey("CallerScope")
+)
 
 // authDisabledOr wraps an authHandler and permits the RPC if authHandler succeeds or
 // if auth is disabled on the cluster
@@ -42,6 +47,7 @@ func authenticated(ctx context.Context, authApi authiface.APIServer, fullMethod
 	var username string
 	if err == nil {
 		username = r.Username
+		setCallerScope(ctx, r.Scope)
 	}
 	return username, errors.EnsureStack(err)
 }
@@ -76,14 +82,63 @@ func GetWhoAmI(ctx context.Context) string {
 	return ""
 }
 
+// GetWhoAmIScope returns the scope of the token of the user cached by
+// GetWhoAmI, and false if it isn't known.
+func GetWhoAmIScope(ctx context.Context) (*auth.TokenScope, bool) {
+	if v, ok := ctx.Value(whoAmIScopeKey).(*callerScope); ok && v != nil && v.set {
+		return v.scope, true
+	}
+	return nil, false
+}
+
 func ClearWhoAmI(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, whoAmIScopeKey, (*callerScope)(nil))
 	return context.WithValue(ctx, whoAmIResultKey, "")
 }
 
-func setWhoAmI(ctx context.Context, username string) context.Context {
+func setWhoAmI(ctx context.Context, username string, scope *callerScope) context.Context {
+	ctx = context.WithValue(ctx, whoAmIScopeKey, scope)
 	return context.WithValue(ctx, whoAmIResultKey, username)
 }
 
+// callerScope is the scope of the caller's token, as found by the auth
+// handler that authenticated the caller, so that the auth server doesn't need
+// to look the token up again to serve the call.
+type callerScope struct {
+	set   bool
+	scope *auth.TokenScope
+}
+
+// withCallerScope returns a context in which an auth handler can record the
+// scope of the caller's token.
+func withCallerScope(ctx context.Context) (context.Context, *callerScope) {
+	scope := &callerScope{}
+	return context.WithValue(ctx, callerScopeKey, scope), scope
+}
+
+func setCallerScope(ctx context.Context, scope *auth.TokenScope) {
+	if s, ok := ctx.Value(callerScopeKey).(*callerScope); ok {
+		s.set = true
+		s.scope = scope
+	}
+}
+
+// WithPrincipal returns a context in which the auth interceptor records the
+// user it authenticates, and a function that returns that user once the call
+// has completed. It's used by interceptors that run before the auth
+// interceptor, and so can't see the username it caches in the context.
+func WithPrincipal(ctx context.Context) (context.Context, func() string) {
+	var principal string
+	ctx = context.WithValue(ctx, principalKey, &principal)
+	return ctx, func() string { return principal }
+}
+
+func setPrincipal(ctx context.Context, username string) {
+	if p, ok := ctx.Value(principalKey).(*string); ok {
+		*p = username
+	}
+}
+
 // AsInternalUser should never be used during user requests, only internal background jobs.
 // It gives a context a cached whoami username of form internal:<name>. It also overwrites
 // any existing metadata. As a result, this context may not be able to make additional gRPCs.
@@ -41,9 +41,12 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/GetRoleBinding":        authenticated,
 	"/auth_v2.API/ModifyRoleBinding":     authenticated,
 	"/auth_v2.API/RevokeAuthToken":       authenticated,
+	"/auth_v2.API/GetScopedToken":        authenticated,
+	"/auth_v2.API/ListAuthTokens":        authenticated,
 	"/auth_v2.API/GetGroups":             authenticated,
 	"/auth_v2.API/GetPermissions":        authenticated,
 	"/auth_v2.API/GetRolesForPermission": authenticated,
+	"/auth_v2.API/ListRole":              authenticated,
 
 	"/auth_v2.API/GetGroupsForPrincipal":      clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_GROUPS),
 	"/auth_v2.API/GetPermissionsForPrincipal": clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL),
@@ -57,8 +60,14 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/RestoreAuthToken":           clusterPermissions(auth.Permission_CLUSTER_AUTH_RESTORE_TOKEN),
 	"/auth_v2.API/Deactivate":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_DEACTIVATE),
 	"/auth_v2.API/DeleteExpiredAuthTokens":    clusterPermissions(auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS),
+	"/auth_v2.API/ListAuditEvents":            clusterPermissions(auth.Permission_CLUSTER_AUTH_LIST_AUDIT_EVENTS),
 	"/auth_v2.API/RevokeAuthTokensForUser":    clusterPermissions(auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS),
 	"/auth_v2.API/RotateRootToken":            clusterPermissions(auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN),
+	"/auth_v2.API/CreateRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/UpdateRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/DeleteRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/ExportConfig":               clusterPermissions(auth.Permission_CLUSTER_AUTH_EXPORT_CONFIG),
+	"/auth_v2.API/ImportConfig":               clusterPermissions(auth.Permission_CLUSTER_AUTH_IMPORT_CONFIG),
 
 	//
 	// Debug API
@@ -123,51 +132,57 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// TODO: Add methods to handle repo permissions
-	"/pfs_v2.API/ActivateAuth":     clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pfs_v2.API/CreateRepo":       authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectRepo":      authDisabledOr(authenticated),
-	"/pfs_v2.API/ListRepo":         authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteRepo":       authDisabledOr(authenticated),
-	"/pfs_v2.API/StartCommit":      authDisabledOr(authenticated),
-	"/pfs_v2.API/FinishCommit":     authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectCommit":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommit":       authDisabledOr(authenticated),
-	"/pfs_v2.API/SubscribeCommit":  authDisabledOr(authenticated),
-	"/pfs_v2.API/ClearCommit":      authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectCommitSet": authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommitSet":    authDisabledOr(authenticated),
-	"/pfs_v2.API/SquashCommitSet":  authDisabledOr(authenticated),
-	"/pfs_v2.API/DropCommitSet":    authDisabledOr(authenticated),
-	"/pfs_v2.API/CreateBranch":     authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectBranch":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ListBranch":       authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteBranch":     authDisabledOr(authenticated),
-	"/pfs_v2.API/ModifyFile":       authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFile":          authDisabledOr(authenticated),
+	"/pfs_v2.API/ActivateAuth":            clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pfs_v2.API/CreateRepo":              authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectRepo":             authDisabledOr(authenticated),
+	"/pfs_v2.API/ListRepo":                authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteRepo":              authDisabledOr(authenticated),
+	"/pfs_v2.API/StartCommit":             authDisabledOr(authenticated),
+	"/pfs_v2.API/FinishCommit":            authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommit":           authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommitCompaction": authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommit":              authDisabledOr(authenticated),
+	"/pfs_v2.API/SubscribeCommit":         authDisabledOr(authenticated),
+	"/pfs_v2.API/ClearCommit":             authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommitSet":        authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommitSet":           authDisabledOr(authenticated),
+	"/pfs_v2.API/SquashCommitSet":         authDisabledOr(authenticated),
+	"/pfs_v2.API/DropCommitSet":           authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateBranch":            authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectBranch":           authDisabledOr(authenticated),
+	"/pfs_v2.API/ListBranch":              authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteBranch":            authDisabledOr(authenticated),
+	"/pfs_v2.API/ModifyFile":              authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFile":                 authDisabledOr(authenticated),
 	// TODO: GetFileTAR is unauthenticated for performance reasons. Normal authentication
 	// will be applied internally when a commit is used. When a file set id is used, we lean
 	// on the capability based authentication of file sets.
-	"/pfs_v2.API/GetFileTAR":         unauthenticated,
-	"/pfs_v2.API/InspectFile":        authDisabledOr(authenticated),
-	"/pfs_v2.API/ListFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/WalkFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/GlobFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/DiffFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteAll":          authDisabledOr(authenticated),
-	"/pfs_v2.API/Fsck":               authDisabledOr(authenticated),
-	"/pfs_v2.API/CreateFileSet":      authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFileSet":         authDisabledOr(authenticated),
-	"/pfs_v2.API/AddFileSet":         authDisabledOr(authenticated),
-	"/pfs_v2.API/RenewFileSet":       authDisabledOr(authenticated),
-	"/pfs_v2.API/ComposeFileSet":     authDisabledOr(authenticated),
-	"/pfs_v2.API/CheckStorage":       authDisabledOr(authenticated),
-	"/pfs_v2.API/PutCache":           authDisabledOr(authenticated),
-	"/pfs_v2.API/GetCache":           authDisabledOr(authenticated),
-	"/pfs_v2.API/ClearCache":         authDisabledOr(authenticated),
-	"/pfs_v2.API/RunLoadTest":        authDisabledOr(authenticated),
-	"/pfs_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
-	"/pfs_v2.API/ListTask":           authDisabledOr(authenticated),
-	"/pfs_v2.API/Egress":             authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFileTAR":            unauthenticated,
+	"/pfs_v2.API/InspectFile":           authDisabledOr(authenticated),
+	"/pfs_v2.API/ListFile":              authDisabledOr(authenticated),
+	"/pfs_v2.API/WalkFile":              authDisabledOr(authenticated),
+	"/pfs_v2.API/GlobFile":              authDisabledOr(authenticated),
+	"/pfs_v2.API/DiffFile":              authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteAll":             authDisabledOr(authenticated),
+	"/pfs_v2.API/Fsck":                  authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateFileSet":         authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFileSet":            authDisabledOr(authenticated),
+	"/pfs_v2.API/AddFileSet":            authDisabledOr(authenticated),
+	"/pfs_v2.API/RenewFileSet":          authDisabledOr(authenticated),
+	"/pfs_v2.API/ComposeFileSet":        authDisabledOr(authenticated),
+	"/pfs_v2.API/CheckStorage":          authDisabledOr(authenticated),
+	"/pfs_v2.API/ScrubStorage":          authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_STORAGE_SCRUB)),
+	"/pfs_v2.API/ReplicationStatus":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_STORAGE_INSPECT)),
+	"/pfs_v2.API/GarbageCollectStorage": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_STORAGE_GC)),
+	"/pfs_v2.API/SetStorageGCPaused":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_STORAGE_GC)),
+	"/pfs_v2.API/RestoreStorageTrash":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_STORAGE_GC)),
+	"/pfs_v2.API/PutCache":              authDisabledOr(authenticated),
+	"/pfs_v2.API/GetCache":              authDisabledOr(authenticated),
+	"/pfs_v2.API/ClearCache":            authDisabledOr(authenticated),
+	"/pfs_v2.API/RunLoadTest":           authDisabledOr(authenticated),
+	"/pfs_v2.API/RunLoadTestDefault":    authDisabledOr(authenticated),
+	"/pfs_v2.API/ListTask":              authDisabledOr(authenticated),
+	"/pfs_v2.API/Egress":                authDisabledOr(authenticated),
 
 	//
 	// PPS API
@@ -175,36 +190,38 @@ var authHandlers = map[string]authHandler{
 
 	// TODO: Add per-repo permissions checks for these
 	// TODO: split GetLogs into master and not-master and add check for pipeline permissions
-	"/pps_v2.API/InspectJob":      authDisabledOr(authenticated),
-	"/pps_v2.API/ListJob":         authDisabledOr(authenticated),
-	"/pps_v2.API/ListJobStream":   authDisabledOr(authenticated),
-	"/pps_v2.API/SubscribeJob":    authDisabledOr(authenticated),
-	"/pps_v2.API/DeleteJob":       authDisabledOr(authenticated),
-	"/pps_v2.API/StopJob":         authDisabledOr(authenticated),
-	"/pps_v2.API/InspectJobSet":   authDisabledOr(authenticated),
-	"/pps_v2.API/ListJobSet":      authDisabledOr(authenticated),
-	"/pps_v2.API/InspectDatum":    authDisabledOr(authenticated),
-	"/pps_v2.API/ListDatum":       authDisabledOr(authenticated),
-	"/pps_v2.API/ListDatumStream": authDisabledOr(authenticated),
-	"/pps_v2.API/RestartDatum":    authDisabledOr(authenticated),
-	"/pps_v2.API/CreatePipeline":  authDisabledOr(authenticated),
-	"/pps_v2.API/InspectPipeline": authDisabledOr(authenticated),
-	"/pps_v2.API/DeletePipeline":  authDisabledOr(authenticated),
-	"/pps_v2.API/StartPipeline":   authDisabledOr(authenticated),
-	"/pps_v2.API/StopPipeline":    authDisabledOr(authenticated),
-	"/pps_v2.API/RunPipeline":     authDisabledOr(authenticated),
-	"/pps_v2.API/RunCron":         authDisabledOr(authenticated),
-	"/pps_v2.API/GetLogs":         authDisabledOr(authenticated),
-	"/pps_v2.API/GarbageCollect":  authDisabledOr(authenticated),
-	"/pps_v2.API/UpdateJobState":  authDisabledOr(authenticated),
-	"/pps_v2.API/ListPipeline":    authDisabledOr(authenticated),
-	"/pps_v2.API/ActivateAuth":    clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pps_v2.API/DeleteAll":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
+	"/pps_v2.API/InspectJob":       authDisabledOr(authenticated),
+	"/pps_v2.API/ListJob":          authDisabledOr(authenticated),
+	"/pps_v2.API/ListJobStream":    authDisabledOr(authenticated),
+	"/pps_v2.API/SubscribeJob":     authDisabledOr(authenticated),
+	"/pps_v2.API/DeleteJob":        authDisabledOr(authenticated),
+	"/pps_v2.API/StopJob":          authDisabledOr(authenticated),
+	"/pps_v2.API/InspectJobSet":    authDisabledOr(authenticated),
+	"/pps_v2.API/ListJobSet":       authDisabledOr(authenticated),
+	"/pps_v2.API/InspectDatum":     authDisabledOr(authenticated),
+	"/pps_v2.API/ExplainDatum":     authDisabledOr(authenticated),
+	"/pps_v2.API/ListDatum":        authDisabledOr(authenticated),
+	"/pps_v2.API/ListDatumStream":  authDisabledOr(authenticated),
+	"/pps_v2.API/RestartDatum":     authDisabledOr(authenticated),
+	"/pps_v2.API/CreatePipeline":   authDisabledOr(authenticated),
+	"/pps_v2.API/InspectPipeline":  authDisabledOr(authenticated),
+	"/pps_v2.API/DeletePipeline":   authDisabledOr(authenticated),
+	"/pps_v2.API/StartPipeline":    authDisabledOr(authenticated),
+	"/pps_v2.API/StopPipeline":     authDisabledOr(authenticated),
+	"/pps_v2.API/RunPipeline":      authDisabledOr(authenticated),
+	"/pps_v2.API/RunCron":          authDisabledOr(authenticated),
+	"/pps_v2.API/ReplayDeadLetter": authDisabledOr(authenticated),
+	"/pps_v2.API/GetLogs":          authDisabledOr(authenticated),
+	"/pps_v2.API/GarbageCollect":   authDisabledOr(authenticated),
+	"/pps_v2.API/UpdateJobState":   authDisabledOr(authenticated),
+	"/pps_v2.API/ListPipeline":     authDisabledOr(authenticated),
+	"/pps_v2.API/ActivateAuth":     clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pps_v2.API/DeleteAll":        authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 
 	"/pps_v2.API/CreateSecret":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_SECRET)),
 	"/pps_v2.API/ListSecret":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_SECRETS)),
 	"/pps_v2.API/DeleteSecret":       authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
-	"/pps_v2.API/InspectSecret":      authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
+	"/pps_v2.API/InspectSecret":      authDisabledOr(authenticated),
 	"/pps_v2.API/RunLoadTest":        authDisabledOr(authenticated),
 	"/pps_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
 	"/pps_v2.API/RenderTemplate":     authDisabledOr(authenticated),
@@ -220,6 +237,7 @@ var authHandlers = map[string]authHandler{
 	"/transaction_v2.API/DeleteTransaction":  authDisabledOr(authenticated),
 	"/transaction_v2.API/ListTransaction":    authDisabledOr(authenticated),
 	"/transaction_v2.API/FinishTransaction":  authDisabledOr(authenticated),
+	"/transaction_v2.API/DryRunTransaction":  authDisabledOr(authenticated),
 	"/transaction_v2.API/DeleteAll":          authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 
 	//
@@ -287,7 +305,8 @@ func (i *Interceptor) InterceptUnary(ctx context.Context, req interface{}, info
 		return nil, errors.Errorf("no auth function for %q, this is a bug", info.FullMethod)
 	}
 
-	username, err := a(ctx, i.getAuthServer(), info.FullMethod)
+	authCtx, scope := withCallerScope(ctx)
+	username, err := a(authCtx, i.getAuthServer(), info.FullMethod)
 
 	if err != nil {
 		logrus.WithError(err).Errorf("denied unary call %q to user %v\n", info.FullMethod, nameOrUnauthenticated(username))
@@ -295,7 +314,8 @@ func (i *Interceptor) InterceptUnary(ctx context.Context, req interface{}, info
 	}
 
 	if username != "" {
-		ctx = setWhoAmI(ctx, username)
+		setPrincipal(ctx, username)
+		ctx = setWhoAmI(ctx, username, scope)
 	}
 
 	return handler(ctx, req)
@@ -310,7 +330,8 @@ func (i *Interceptor) InterceptStream(srv interface{}, stream grpc.ServerStream,
 		return errors.Errorf("no auth function for %q, this is a bug", info.FullMethod)
 	}
 
-	username, err := a(ctx, i.getAuthServer(), info.FullMethod)
+	authCtx, scope := withCallerScope(ctx)
+	username, err := a(authCtx, i.getAuthServer(), info.FullMethod)
 
 	if err != nil {
 		logrus.WithError(err).Errorf("denied streaming call %q to user %v\n", info.FullMethod, nameOrUnauthenticated(username))
@@ -318,7 +339,8 @@ func (i *Interceptor) InterceptStream(srv interface{}, stream grpc.ServerStream,
 	}
 
 	if username != "" {
-		newCtx := setWhoAmI(ctx, username)
+		setPrincipal(ctx, username)
+		newCtx := setWhoAmI(ctx, username, scope)
 		stream = ServerStreamWrapper{stream, newCtx}
 	}
 	return handler(srv, stream)
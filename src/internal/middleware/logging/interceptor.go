@@ -19,6 +19,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/enterprise"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 	"github.com/pachyderm/pachyderm/v2/src/license"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/transaction"
 )
 
 type logConfig struct {
@@ -200,8 +202,14 @@ var endpoints = map[string]logConfig{
 	"/auth_v2.API/GetGroupsForPrincipal":      authConfig,
 	"/auth_v2.API/GetUsers":                   authConfig,
 	"/auth_v2.API/GetRolesForPermission":      authConfig,
+	"/auth_v2.API/CreateRole":                 authConfig,
+	"/auth_v2.API/UpdateRole":                 authConfig,
+	"/auth_v2.API/DeleteRole":                 authConfig,
+	"/auth_v2.API/ListRole":                   authConfig,
 	"/auth_v2.API/DeleteExpiredAuthTokens":    authConfig,
 	"/auth_v2.API/RevokeAuthTokensForUser":    authConfig,
+	"/auth_v2.API/ListAuditEvents":            authConfig,
+	"/auth_v2.API/ListAuthTokens":             authConfig,
 
 	"/auth_v2.API/WhoAmI": {
 		level: func(err error) logrus.Level {
@@ -287,6 +295,15 @@ var endpoints = map[string]logConfig{
 		},
 	},
 
+	"/auth_v2.API/GetScopedToken": {
+		level: authConfig.level,
+		transformResponse: func(r interface{}) interface{} {
+			copyResp := proto.Clone(r.(*auth.GetScopedTokenResponse)).(*auth.GetScopedTokenResponse)
+			copyResp.Token = ""
+			return copyResp
+		},
+	},
+
 	"/auth_v2.API/RevokeAuthToken": {
 		level: authConfig.level,
 		transformRequest: func(r interface{}) interface{} {
@@ -327,11 +344,93 @@ var endpoints = map[string]logConfig{
 		},
 	},
 
+	"/auth_v2.API/ExportConfig": {
+		level: authConfig.level,
+		transformResponse: func(r interface{}) interface{} {
+			copyResp := proto.Clone(r.(*auth.ExportConfigResponse)).(*auth.ExportConfigResponse)
+			copyResp.Config = nil
+			return copyResp
+		},
+	},
+
+	"/auth_v2.API/ImportConfig": {
+		level: authConfig.level,
+		transformRequest: func(r interface{}) interface{} {
+			copyReq := proto.Clone(r.(*auth.ImportConfigRequest)).(*auth.ImportConfigRequest)
+			copyReq.Config = nil
+			copyReq.DecryptionKey = ""
+			return copyReq
+		},
+	},
+
 	"/pfs_v2.API/CreateFileSet": {
 		transformRequest: func(r interface{}) interface{} {
 			return nil
 		},
 	},
+
+	"/pps_v2.API/CreateSecret": {
+		transformRequest: func(r interface{}) interface{} {
+			copyReq := proto.Clone(r.(*pps.CreateSecretRequest)).(*pps.CreateSecretRequest)
+			copyReq.File = nil
+			return copyReq
+		},
+	},
+
+	"/transaction_v2.API/BatchTransaction": {
+		transformRequest: func(r interface{}) interface{} {
+			copyReq := proto.Clone(r.(*transaction.BatchTransactionRequest)).(*transaction.BatchTransactionRequest)
+			redactTransactionRequests(copyReq.Requests...)
+			return copyReq
+		},
+		transformResponse: redactTransactionInfo,
+	},
+
+	"/transaction_v2.API/InspectTransaction": {
+		transformResponse: redactTransactionInfo,
+	},
+
+	"/transaction_v2.API/FinishTransaction": {
+		transformResponse: redactTransactionInfo,
+	},
+
+	"/transaction_v2.API/ListTransaction": {
+		transformResponse: func(r interface{}) interface{} {
+			copyResp := proto.Clone(r.(*transaction.TransactionInfos)).(*transaction.TransactionInfos)
+			for _, info := range copyResp.TransactionInfo {
+				redactTransactionRequests(info.Requests...)
+			}
+			return copyResp
+		},
+	},
+
+	"/transaction_v2.API/DryRunTransaction": {
+		transformResponse: func(r interface{}) interface{} {
+			copyResp := proto.Clone(r.(*transaction.DryRunTransactionResponse)).(*transaction.DryRunTransactionResponse)
+			for _, preview := range append(copyResp.Requests, copyResp.Finish) {
+				if preview != nil && preview.Request != nil {
+					redactTransactionRequests(preview.Request)
+				}
+			}
+			return copyResp
+		},
+	},
+}
+
+func redactTransactionInfo(r interface{}) interface{} {
+	copyResp := proto.Clone(r.(*transaction.TransactionInfo)).(*transaction.TransactionInfo)
+	redactTransactionRequests(copyResp.Requests...)
+	return copyResp
+}
+
+// redactTransactionRequests removes the secret fields of 'reqs' in place, so
+// it must only be passed copies of the requests being logged
+func redactTransactionRequests(reqs ...*transaction.TransactionRequest) {
+	for _, req := range reqs {
+		if req.CreateSecret != nil {
+			req.CreateSecret.File = nil
+		}
+	}
 }
 
 func getConfig(fullMethod string) logConfig {
@@ -341,6 +440,23 @@ func getConfig(fullMethod string) logConfig {
 	return defaultConfig
 }
 
+// RedactRequest returns 'req' with the secret fields of requests to
+// 'fullMethod' removed, as they would be logged. It may return nil if the
+// request shouldn't be recorded at all.
+func RedactRequest(fullMethod string, req interface{}) interface{} {
+	config := getConfig(fullMethod)
+	if config.transformRequest != nil && !isNilInterface(req) {
+		return config.transformRequest(req)
+	}
+	return req
+}
+
+// RedactsRequest returns whether requests to 'fullMethod' have their secret
+// fields removed by RedactRequest, rather than being returned unchanged.
+func RedactsRequest(fullMethod string) bool {
+	return getConfig(fullMethod).transformRequest != nil
+}
+
 func isNilInterface(x interface{}) bool {
 	val := reflect.ValueOf(x)
 	return x == nil || (val.Kind() == reflect.Ptr && val.IsNil())
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"path"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/pachyderm/pachyderm/v2/src/client/limit"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
@@ -27,6 +30,16 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// amazonMaxCopySize is the size of the largest object that S3 copies in a
+	// single request.
+	amazonMaxCopySize = 5 * 1024 * 1024 * 1024
+	// amazonCopyPartSize is the size of the parts of larger objects that are
+	// copied in parts.
+	amazonCopyPartSize = 1024 * 1024 * 1024
 )
 
 type amazonClient struct {
@@ -126,6 +139,7 @@ func newAmazonClient(region, bucket string, creds *AmazonCreds, cloudfrontDistri
 		uploader: s3manager.NewUploader(session, func(u *s3manager.Uploader) {
 			u.PartSize = advancedConfig.PartSize
 			u.MaxUploadParts = advancedConfig.MaxUploadParts
+			u.Concurrency = advancedConfig.UploadConcurrency
 		}),
 		advancedConfig: advancedConfig,
 	}
@@ -156,13 +170,34 @@ func newAmazonClient(region, bucket string, creds *AmazonCreds, cloudfrontDistri
 	return awsClient, nil
 }
 
+// Put uploads objects larger than the multipart threshold in parts, several at
+// a time, and smaller objects in a single request.
 func (c *amazonClient) Put(ctx context.Context, name string, r io.Reader) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	ctx, cf := context.WithCancel(ctx)
 	defer cf()
-	_, err := c.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+	parts, size, _, err := readThreshold(r)
+	if err != nil {
+		return err
+	}
+	defer releaseParts(parts)
+	if size <= multipartThreshold {
+		data := make([]byte, 0, size)
+		for _, part := range parts {
+			data = append(data, part...)
+		}
+		_, err := c.s3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			ACL:             aws.String(c.advancedConfig.UploadACL),
+			Body:            bytes.NewReader(data),
+			Bucket:          aws.String(c.bucket),
+			Key:             aws.String(name),
+			ContentEncoding: aws.String("application/octet-stream"),
+		})
+		return errors.EnsureStack(err)
+	}
+	_, err = c.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
 		ACL:             aws.String(c.advancedConfig.UploadACL),
-		Body:            r,
+		Body:            io.MultiReader(partsReader(parts), r),
 		Bucket:          aws.String(c.bucket),
 		Key:             aws.String(name),
 		ContentEncoding: aws.String("application/octet-stream"),
@@ -197,8 +232,55 @@ func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name strin
 	return fnErr
 }
 
+func (c *amazonClient) WalkInfo(ctx context.Context, name string, fn func(info *ObjectInfo) error) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	var fnErr error
+	if err := c.s3.ListObjectsPagesWithContext(ctx,
+		&s3.ListObjectsInput{
+			Bucket: aws.String(c.bucket),
+			Prefix: &name,
+		},
+		func(listObjectsOutput *s3.ListObjectsOutput, lastPage bool) bool {
+			for _, object := range listObjectsOutput.Contents {
+				key := *object.Key
+				if strings.HasPrefix(key, name) {
+					if err := fn(&ObjectInfo{
+						Name: key,
+						Size: aws.Int64Value(object.Size),
+						ETag: strings.Trim(aws.StringValue(object.ETag), `"`),
+					}); err != nil {
+						fnErr = err
+						return false
+					}
+				}
+			}
+			return true
+		},
+	); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return fnErr
+}
+
 func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
+	return c.get(ctx, name, "", w)
+}
+
+func (c *amazonClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	err := c.get(ctx, name, byteRange(offset, size), w)
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && awsErr.Code() == "InvalidRange" {
+		// The range starts at or past the end of the object.
+		return nil
+	}
+	return err
+}
+
+// get writes an object, or the part of it in byteRange if it's not empty, to
+// w.
+func (c *amazonClient) get(ctx context.Context, name string, byteRange string, w io.Writer) (retErr error) {
 	var reader io.ReadCloser
 	if c.cloudfrontDistribution != "" {
 		var resp *http.Response
@@ -216,6 +298,9 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
 
 		backoff.RetryNotify(func() (retErr error) {
 			span, _ := tracing.AddSpanToAnyExisting(ctx, "/Amazon.Cloudfront/Get")
@@ -234,6 +319,10 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 		if connErr != nil {
 			return errors.EnsureStack(connErr)
 		}
+		if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && byteRange != "" {
+			// The range starts at or past the end of the object.
+			return errors.EnsureStack(resp.Body.Close())
+		}
 		if resp.StatusCode >= 300 {
 			// Cloudfront returns 200s, and 206s as success codes
 			return errors.Errorf("cloudfront returned HTTP error code %v for url %v", resp.Status, url)
@@ -244,6 +333,9 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 			Bucket: aws.String(c.bucket),
 			Key:    aws.String(name),
 		}
+		if byteRange != "" {
+			objIn.Range = aws.String(byteRange)
+		}
 		getObjectOutput, err := c.s3.GetObjectWithContext(ctx, objIn)
 		if err != nil {
 			return errors.EnsureStack(err)
@@ -259,6 +351,99 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 	return errors.EnsureStack(err)
 }
 
+// Copy copies objects larger than the maximum size of a single copy in
+// parts, several at a time.
+func (c *amazonClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	head, err := c.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(src),
+	})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	copySource := (&url.URL{Path: path.Join(c.bucket, src)}).EscapedPath()
+	size := aws.Int64Value(head.ContentLength)
+	if size <= amazonMaxCopySize {
+		_, err := c.s3.CopyObjectWithContext(ctx, &s3.CopyObjectInput{
+			ACL:        aws.String(c.advancedConfig.UploadACL),
+			Bucket:     aws.String(c.bucket),
+			Key:        aws.String(dst),
+			CopySource: aws.String(copySource),
+		})
+		return errors.EnsureStack(err)
+	}
+	upload, err := c.s3.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		ACL:    aws.String(c.advancedConfig.UploadACL),
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(dst),
+	})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if retErr != nil {
+			if _, err := c.s3.AbortMultipartUploadWithContext(context.Background(), &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(c.bucket),
+				Key:      aws.String(dst),
+				UploadId: upload.UploadId,
+			}); err != nil {
+				log.Errorf("error aborting multipart copy of %v: %v", src, err)
+			}
+		}
+	}()
+	parts := make([]*s3.CompletedPart, (size+amazonCopyPartSize-1)/amazonCopyPartSize)
+	eg, egCtx := errgroup.WithContext(ctx)
+	limiter := limit.New(multipartConcurrency)
+	for i := range parts {
+		i := i
+		limiter.Acquire()
+		if egCtx.Err() != nil {
+			limiter.Release()
+			break
+		}
+		// S3 rejects ranges which extend past the end of the object, so the
+		// last part's range ends at the last byte.
+		offset := int64(i) * amazonCopyPartSize
+		partSize := int64(amazonCopyPartSize)
+		if offset+partSize > size {
+			partSize = size - offset
+		}
+		eg.Go(func() error {
+			defer limiter.Release()
+			res, err := c.s3.UploadPartCopyWithContext(egCtx, &s3.UploadPartCopyInput{
+				Bucket:          aws.String(c.bucket),
+				Key:             aws.String(dst),
+				CopySource:      aws.String(copySource),
+				CopySourceRange: aws.String(byteRange(offset, partSize)),
+				PartNumber:      aws.Int64(int64(i + 1)),
+				UploadId:        upload.UploadId,
+			})
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			parts[i] = &s3.CompletedPart{
+				ETag:       res.CopyPartResult.ETag,
+				PartNumber: aws.Int64(int64(i + 1)),
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := ctx.Err(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = c.s3.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(c.bucket),
+		Key:             aws.String(dst),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	return errors.EnsureStack(err)
+}
+
 func (c *amazonClient) Delete(ctx context.Context, name string) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	_, err := c.s3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
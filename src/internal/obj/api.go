@@ -10,6 +10,8 @@ type Client interface {
 	// Put writes the data from r to an object at name
 	// It should error if the object already exists or we don't have sufficient
 	// permissions to write it.
+	// Large objects are uploaded in parts, several at a time, where the backend
+	// supports it.
 	Put(ctx context.Context, name string, r io.Reader) error
 
 	// Get writes the data for an object to w
@@ -18,6 +20,19 @@ type Client interface {
 	// permission to read it.
 	Get(ctx context.Context, name string, w io.Writer) error
 
+	// GetRange writes up to size bytes of an object, starting at offset, to w.
+	// If size <= 0, it writes from offset until the end of the object.
+	// Only the bytes before the end of the object are written, so nothing is
+	// written for an offset at or past the end.
+	// It should error if the object doesn't exist or we don't have sufficient
+	// permission to read it.
+	GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) error
+
+	// Copy copies the object at src to an object at dst, without moving the
+	// data through the client where the backend supports it.
+	// It should error if the object at src doesn't exist.
+	Copy(ctx context.Context, src, dst string) error
+
 	// Delete deletes an object.
 	// It should error if the object doesn't exist or we don't have sufficient
 	// permission to delete it.
@@ -26,9 +41,22 @@ type Client interface {
 	// Walk calls `fn` with the names of objects which can be found under `prefix`.
 	Walk(ctx context.Context, prefix string, fn func(name string) error) error
 
+	// WalkInfo is like Walk, but calls `fn` with the metadata of each object
+	// rather than just its name.
+	WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) error
+
 	// Exists checks if a given object already exists
 	Exists(ctx context.Context, name string) (bool, error)
 
 	// BucketURL returns the URL of the bucket this client uses.
 	BucketURL() ObjectStoreURL
 }
+
+// ObjectInfo is the metadata for an object, as returned by WalkInfo.
+type ObjectInfo struct {
+	Name string
+	Size int64
+	// ETag identifies a version of the object's content. Its format is specific
+	// to each backend, so ETags should only be compared for equality.
+	ETag string
+}
@@ -120,10 +120,35 @@ func (c *cacheClient) getSlow(ctx context.Context, p string, w io.Writer) error
 	})
 }
 
+// GetRange serves ranges of cached objects from the cache, but doesn't add
+// objects to the cache.
+func (c *cacheClient) GetRange(ctx context.Context, p string, offset, size int64, w io.Writer) error {
+	c.doPopulateOnce(ctx) // always call before acquiring locks
+	c.mu.Lock()
+	_, exists := c.cache.Get(p)
+	c.mu.Unlock()
+	if exists {
+		// Nothing is written to w if the object was deleted from the cache
+		// since we released the lock.
+		if err := c.fast.GetRange(ctx, p, offset, size, w); !pacherr.IsNotExist(err) {
+			if err == nil {
+				cacheHitMetric.Inc()
+			}
+			return errors.EnsureStack(err)
+		}
+	}
+	cacheMissMetric.Inc()
+	return errors.EnsureStack(c.slow.GetRange(ctx, p, offset, size, w))
+}
+
 func (c *cacheClient) Put(ctx context.Context, p string, r io.Reader) error {
 	return errors.EnsureStack(c.slow.Put(ctx, p, r))
 }
 
+func (c *cacheClient) Copy(ctx context.Context, src, dst string) error {
+	return errors.EnsureStack(c.slow.Copy(ctx, src, dst))
+}
+
 func (c *cacheClient) Delete(ctx context.Context, p string) error {
 	if err := c.slow.Delete(ctx, p); err != nil {
 		return errors.EnsureStack(err)
@@ -140,6 +165,10 @@ func (c *cacheClient) Walk(ctx context.Context, p string, cb func(p string) erro
 	return errors.EnsureStack(c.slow.Walk(ctx, p, cb))
 }
 
+func (c *cacheClient) WalkInfo(ctx context.Context, p string, cb func(info *ObjectInfo) error) error {
+	return errors.EnsureStack(c.slow.WalkInfo(ctx, p, cb))
+}
+
 func (c *cacheClient) BucketURL() ObjectStoreURL {
 	return c.slow.BucketURL()
 }
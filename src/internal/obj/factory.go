@@ -96,6 +96,8 @@ const (
 	DisableSSLEnvVar     = "DISABLE_SSL"
 	NoVerifySSLEnvVar    = "NO_VERIFY_SSL"
 	LogOptionsEnvVar     = "OBJ_LOG_OPTS"
+
+	UploadConcurrencyEnvVar = "UPLOAD_CONCURRENCY"
 )
 
 const (
@@ -111,6 +113,9 @@ const (
 	DefaultPartSize = 5242880
 	// DefaultMaxUploadParts is the default maximum number of upload parts.
 	DefaultMaxUploadParts = 10000
+	// DefaultUploadConcurrency is the default number of parts of an object
+	// uploaded at a time.
+	DefaultUploadConcurrency = 10
 	// DefaultDisableSSL is the default for whether SSL should be disabled.
 	DefaultDisableSSL = false
 	// DefaultNoVerifySSL is the default for whether SSL certificate verification should be disabled.
@@ -132,6 +137,10 @@ type AmazonAdvancedConfiguration struct {
 	DisableSSL     bool   `env:"DISABLE_SSL, default=false"`
 	NoVerifySSL    bool   `env:"NO_VERIFY_SSL, default=false"`
 	LogOptions     string `env:"OBJ_LOG_OPTS, default="`
+
+	// UploadConcurrency is the number of parts of an object uploaded at a
+	// time.
+	UploadConcurrency int `env:"UPLOAD_CONCURRENCY, default=10"`
 }
 
 // NewGoogleClient creates a google client with the given bucket name.
@@ -0,0 +1,401 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+)
+
+// The operations of a Client that faults can be injected into.
+const (
+	FaultOpPut      = "put"
+	FaultOpGet      = "get"
+	FaultOpGetRange = "getrange"
+	FaultOpCopy     = "copy"
+	FaultOpDelete   = "delete"
+	FaultOpWalk     = "walk"
+	FaultOpExists   = "exists"
+)
+
+var faultOps = []string{FaultOpPut, FaultOpGet, FaultOpGetRange, FaultOpCopy, FaultOpDelete, FaultOpWalk, FaultOpExists}
+
+var errFault = errors.Errorf("injected object storage fault")
+
+// IsFaultError checks if an error was injected by a fault client.
+func IsFaultError(err error) bool {
+	return errors.Is(err, errFault)
+}
+
+// FaultLatency is the distribution of the latency injected into operations.
+// Every operation is delayed by a duration drawn uniformly from [Min, Max],
+// and, with probability TailRate, by an additional Tail, which models slow
+// outlier requests.
+type FaultLatency struct {
+	Min, Max time.Duration
+	TailRate float64
+	Tail     time.Duration
+}
+
+// FaultConfig configures the faults a fault client injects.
+type FaultConfig struct {
+	// Seed seeds the random choice of faults, so the same sequence of
+	// operations sees the same faults with the same seed.
+	Seed int64
+	// ErrorRates maps operations (FaultOpPut, FaultOpGet, etc.) to the
+	// probability that they fail with a transient error. Half of the failed
+	// puts consume part of their input and do not create the object, while
+	// the other half create the object and fail anyway, as when the response
+	// to a successful upload is lost.
+	ErrorRates map[string]float64
+	// Latency is the latency injected into every operation.
+	Latency FaultLatency
+	// TruncateRate is the probability that a get or ranged get writes only a
+	// prefix of the object before failing with a transient error.
+	TruncateRate float64
+	// VisibilityDelay is how long objects are invisible to gets, walks and
+	// existence checks after they are put or copied, which models object
+	// stores with eventually consistent reads.
+	VisibilityDelay time.Duration
+}
+
+// ParseFaultConfig parses a fault config from a comma separated list of
+// key=value pairs:
+//
+//	seed=<int>                the random seed
+//	error=<rate>              the error rate of the operations without an
+//	                          error rate of their own
+//	<op>.error=<rate>         the error rate of one operation (e.g. put.error)
+//	latency=<min>-<max>       the range of the injected latency
+//	tail=<rate>:<duration>    the rate and duration of the tail latency
+//	truncate=<rate>           the rate of truncated reads
+//	visibility=<duration>     the delay before put objects are visible
+//
+// Rates are probabilities between 0 and 1, durations are Go durations
+// (e.g. 10ms).
+func ParseFaultConfig(spec string) (*FaultConfig, error) {
+	config := &FaultConfig{ErrorRates: make(map[string]float64)}
+	var defaultRate *float64
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("invalid fault config field %q, expected key=value", field)
+		}
+		key, value := kv[0], kv[1]
+		var err error
+		switch {
+		case key == "seed":
+			config.Seed, err = strconv.ParseInt(value, 10, 64)
+		case key == "error":
+			var rate float64
+			rate, err = parseFaultRate(value)
+			defaultRate = &rate
+		case strings.HasSuffix(key, ".error"):
+			op := strings.TrimSuffix(key, ".error")
+			if !isFaultOp(op) {
+				return nil, errors.Errorf("invalid fault config operation %q, expected one of %v", op, faultOps)
+			}
+			config.ErrorRates[op], err = parseFaultRate(value)
+		case key == "latency":
+			bounds := strings.SplitN(value, "-", 2)
+			if config.Latency.Min, err = time.ParseDuration(bounds[0]); err != nil {
+				break
+			}
+			config.Latency.Max = config.Latency.Min
+			if len(bounds) == 2 {
+				config.Latency.Max, err = time.ParseDuration(bounds[1])
+			}
+			if err == nil && config.Latency.Max < config.Latency.Min {
+				err = errors.Errorf("maximum latency is less than the minimum latency")
+			}
+		case key == "tail":
+			parts := strings.SplitN(value, ":", 2)
+			if len(parts) != 2 {
+				err = errors.Errorf("expected <rate>:<duration>")
+				break
+			}
+			if config.Latency.TailRate, err = parseFaultRate(parts[0]); err != nil {
+				break
+			}
+			config.Latency.Tail, err = time.ParseDuration(parts[1])
+		case key == "truncate":
+			config.TruncateRate, err = parseFaultRate(value)
+		case key == "visibility":
+			config.VisibilityDelay, err = time.ParseDuration(value)
+		default:
+			return nil, errors.Errorf("invalid fault config key %q", key)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid fault config field %q", field)
+		}
+	}
+	if defaultRate != nil {
+		for _, op := range faultOps {
+			if _, ok := config.ErrorRates[op]; !ok {
+				config.ErrorRates[op] = *defaultRate
+			}
+		}
+	}
+	return config, nil
+}
+
+func parseFaultRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	if rate < 0 || rate > 1 {
+		return 0, errors.Errorf("rate %v is not between 0 and 1", rate)
+	}
+	return rate, nil
+}
+
+func isFaultOp(op string) bool {
+	for _, faultOp := range faultOps {
+		if op == faultOp {
+			return true
+		}
+	}
+	return false
+}
+
+type faultClient struct {
+	c      Client
+	config FaultConfig
+	mu     sync.Mutex
+	rand   *rand.Rand
+	// visibleAt maps the objects that were recently put to the time at
+	// which they become visible.
+	visibleAt map[string]time.Time
+}
+
+// NewFaultClient wraps c with a client that injects errors, latency, truncated
+// reads and delayed visibility, as configured by config, into the operations
+// of c. It is intended for testing how the system behaves when object storage
+// misbehaves.
+func NewFaultClient(c Client, config FaultConfig) Client {
+	return &faultClient{
+		c:         c,
+		config:    config,
+		rand:      rand.New(rand.NewSource(config.Seed)),
+		visibleAt: make(map[string]time.Time),
+	}
+}
+
+func (c *faultClient) float64() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rand.Float64()
+}
+
+func (c *faultClient) int63n(n int64) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rand.Int63n(n)
+}
+
+func (c *faultClient) error(op string) error {
+	return pacherr.WrapTransient(errors.Wrapf(errFault, "%s failed", op), 100*time.Millisecond)
+}
+
+// inject sleeps for the injected latency, and returns an error if op should
+// fail.
+func (c *faultClient) inject(ctx context.Context, op string) error {
+	latency := c.config.Latency
+	d := latency.Min
+	if latency.Max > latency.Min {
+		d += time.Duration(c.int63n(int64(latency.Max - latency.Min + 1)))
+	}
+	if latency.TailRate > 0 && c.float64() < latency.TailRate {
+		d += latency.Tail
+	}
+	if d > 0 {
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+	if rate := c.config.ErrorRates[op]; rate > 0 && c.float64() < rate {
+		return c.error(op)
+	}
+	return nil
+}
+
+// hide makes name invisible until the visibility delay has passed.
+func (c *faultClient) hide(name string) {
+	if c.config.VisibilityDelay <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.visibleAt[name] = time.Now().Add(c.config.VisibilityDelay)
+}
+
+func (c *faultClient) unhide(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.visibleAt, name)
+}
+
+func (c *faultClient) visible(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.visibleAt[name]
+	if !ok {
+		return true
+	}
+	if time.Now().Before(t) {
+		return false
+	}
+	delete(c.visibleAt, name)
+	return true
+}
+
+func (c *faultClient) notExist(name string) error {
+	return pacherr.NewNotExist(c.BucketURL().String(), name)
+}
+
+// truncate writes the data read by get to w, unless the read should be
+// truncated, in which case only a prefix of the data is written to w before a
+// transient error is returned.
+func (c *faultClient) truncate(op string, w io.Writer, get func(w io.Writer) error) error {
+	if c.config.TruncateRate <= 0 || c.float64() >= c.config.TruncateRate {
+		return get(w)
+	}
+	buf := &bytes.Buffer{}
+	if err := get(buf); err != nil {
+		return err
+	}
+	if buf.Len() > 0 {
+		buf.Truncate(int(c.int63n(int64(buf.Len()))))
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return pacherr.WrapTransient(errors.Wrapf(errFault, "%s truncated: %v", op, io.ErrUnexpectedEOF), 100*time.Millisecond)
+}
+
+// Put wraps the put operation.
+func (c *faultClient) Put(ctx context.Context, name string, r io.Reader) error {
+	faultErr := c.inject(ctx, FaultOpPut)
+	if faultErr != nil && c.float64() < 0.5 {
+		// The upload fails part way through.
+		if _, copyErr := io.CopyN(io.Discard, r, c.int63n(multipartPartSize)); copyErr != nil && !errors.Is(copyErr, io.EOF) {
+			return errors.EnsureStack(copyErr)
+		}
+		return faultErr
+	}
+	if err := c.c.Put(ctx, name, r); err != nil {
+		return errors.EnsureStack(err)
+	}
+	c.hide(name)
+	// The upload succeeds, but the client doesn't hear about it.
+	return faultErr
+}
+
+// Get wraps the get operation.
+func (c *faultClient) Get(ctx context.Context, name string, w io.Writer) error {
+	if err := c.inject(ctx, FaultOpGet); err != nil {
+		return err
+	}
+	if !c.visible(name) {
+		return c.notExist(name)
+	}
+	return c.truncate(FaultOpGet, w, func(w io.Writer) error {
+		return errors.EnsureStack(c.c.Get(ctx, name, w))
+	})
+}
+
+// GetRange wraps the get range operation.
+func (c *faultClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) error {
+	if err := c.inject(ctx, FaultOpGetRange); err != nil {
+		return err
+	}
+	if !c.visible(name) {
+		return c.notExist(name)
+	}
+	return c.truncate(FaultOpGetRange, w, func(w io.Writer) error {
+		return errors.EnsureStack(c.c.GetRange(ctx, name, offset, size, w))
+	})
+}
+
+// Copy wraps the copy operation.
+func (c *faultClient) Copy(ctx context.Context, src, dst string) error {
+	if err := c.inject(ctx, FaultOpCopy); err != nil {
+		return err
+	}
+	if !c.visible(src) {
+		return c.notExist(src)
+	}
+	if err := c.c.Copy(ctx, src, dst); err != nil {
+		return errors.EnsureStack(err)
+	}
+	c.hide(dst)
+	return nil
+}
+
+// Delete wraps the delete operation.
+func (c *faultClient) Delete(ctx context.Context, name string) error {
+	if err := c.inject(ctx, FaultOpDelete); err != nil {
+		return err
+	}
+	if err := c.c.Delete(ctx, name); err != nil {
+		return errors.EnsureStack(err)
+	}
+	c.unhide(name)
+	return nil
+}
+
+// Walk wraps the walk operation.
+func (c *faultClient) Walk(ctx context.Context, prefix string, fn func(name string) error) error {
+	if err := c.inject(ctx, FaultOpWalk); err != nil {
+		return err
+	}
+	return errors.EnsureStack(c.c.Walk(ctx, prefix, func(name string) error {
+		if !c.visible(name) {
+			return nil
+		}
+		return fn(name)
+	}))
+}
+
+// WalkInfo wraps the walk info operation.
+func (c *faultClient) WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) error {
+	if err := c.inject(ctx, FaultOpWalk); err != nil {
+		return err
+	}
+	return errors.EnsureStack(c.c.WalkInfo(ctx, prefix, func(info *ObjectInfo) error {
+		if !c.visible(info.Name) {
+			return nil
+		}
+		return fn(info)
+	}))
+}
+
+// Exists wraps the existence check.
+func (c *faultClient) Exists(ctx context.Context, name string) (bool, error) {
+	if err := c.inject(ctx, FaultOpExists); err != nil {
+		return false, err
+	}
+	if !c.visible(name) {
+		return false, nil
+	}
+	exists, err := c.c.Exists(ctx, name)
+	return exists, errors.EnsureStack(err)
+}
+
+func (c *faultClient) BucketURL() ObjectStoreURL {
+	return c.c.BucketURL()
+}
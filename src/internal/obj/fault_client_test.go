@@ -0,0 +1,142 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestFaultClient(t *testing.T) {
+	t.Parallel()
+	// The client must behave like any other client when it only injects
+	// latency.
+	TestSuite(t, func(t testing.TB) Client {
+		return NewFaultClient(newTestLocalClient(t), FaultConfig{Latency: FaultLatency{Max: time.Millisecond}})
+	})
+}
+
+func TestFaultClientErrors(t *testing.T) {
+	ctx := context.Background()
+	faults := func(seed int64) []bool {
+		c := NewFaultClient(newTestLocalClient(t), FaultConfig{
+			Seed:       seed,
+			ErrorRates: map[string]float64{FaultOpPut: 0.5},
+		})
+		var faults []bool
+		for i := 0; i < 100; i++ {
+			err := c.Put(ctx, "object", strings.NewReader("data"))
+			if err != nil {
+				require.True(t, IsFaultError(err))
+				require.True(t, errors.As(err, new(*pacherr.TransientError)))
+			}
+			faults = append(faults, err != nil)
+		}
+		// Other operations do not fail.
+		exists, err := c.Exists(ctx, "object")
+		require.NoError(t, err)
+		require.True(t, exists)
+		return faults
+	}
+	// The same seed injects the same faults.
+	first := faults(1)
+	require.Equal(t, first, faults(1))
+	var count int
+	for _, fault := range first {
+		if fault {
+			count++
+		}
+	}
+	require.True(t, count > 0 && count < len(first))
+}
+
+func TestFaultClientPutErrors(t *testing.T) {
+	ctx := context.Background()
+	c := NewFaultClient(newTestLocalClient(t), FaultConfig{
+		Seed:       1,
+		ErrorRates: map[string]float64{FaultOpPut: 1},
+	})
+	// Some failed puts still create the object.
+	var created, lost int
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("object-%d", i)
+		require.True(t, IsFaultError(c.Put(ctx, name, strings.NewReader("data"))))
+		exists, err := c.Exists(ctx, name)
+		require.NoError(t, err)
+		if exists {
+			created++
+		} else {
+			lost++
+		}
+	}
+	require.True(t, created > 0 && lost > 0)
+}
+
+func TestFaultClientTruncate(t *testing.T) {
+	ctx := context.Background()
+	c := NewFaultClient(newTestLocalClient(t), FaultConfig{TruncateRate: 1})
+	data := bytes.Repeat([]byte("data"), 1000)
+	require.NoError(t, c.Put(ctx, "object", bytes.NewReader(data)))
+	for _, get := range []func(*bytes.Buffer) error{
+		func(buf *bytes.Buffer) error { return c.Get(ctx, "object", buf) },
+		func(buf *bytes.Buffer) error { return c.GetRange(ctx, "object", 0, 0, buf) },
+	} {
+		buf := &bytes.Buffer{}
+		err := get(buf)
+		require.YesError(t, err)
+		require.True(t, IsFaultError(err))
+		require.True(t, buf.Len() < len(data))
+		require.Equal(t, data[:buf.Len()], buf.Bytes())
+	}
+}
+
+func TestFaultClientVisibility(t *testing.T) {
+	ctx := context.Background()
+	delay := 500 * time.Millisecond
+	c := NewFaultClient(newTestLocalClient(t), FaultConfig{VisibilityDelay: delay})
+	require.NoError(t, c.Put(ctx, "object", strings.NewReader("data")))
+	// The object is not visible right after it is put.
+	exists, err := c.Exists(ctx, "object")
+	require.NoError(t, err)
+	require.False(t, exists)
+	err = c.Get(ctx, "object", &bytes.Buffer{})
+	require.True(t, pacherr.IsNotExist(err))
+	var names []string
+	require.NoError(t, c.Walk(ctx, "", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, 0, len(names))
+	// The object is visible after the delay.
+	time.Sleep(delay)
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.Get(ctx, "object", buf))
+	require.Equal(t, "data", buf.String())
+}
+
+func TestParseFaultConfig(t *testing.T) {
+	config, err := ParseFaultConfig("seed=7, error=0.1, put.error=0.5, latency=1ms-10ms, tail=0.01:1s, truncate=0.2, visibility=2s")
+	require.NoError(t, err)
+	require.Equal(t, int64(7), config.Seed)
+	require.Equal(t, 0.5, config.ErrorRates[FaultOpPut])
+	require.Equal(t, 0.1, config.ErrorRates[FaultOpGet])
+	require.Equal(t, FaultLatency{Min: time.Millisecond, Max: 10 * time.Millisecond, TailRate: 0.01, Tail: time.Second}, config.Latency)
+	require.Equal(t, 0.2, config.TruncateRate)
+	require.Equal(t, 2*time.Second, config.VisibilityDelay)
+	// The error rate of an operation takes precedence over the default error
+	// rate, wherever it appears.
+	config, err = ParseFaultConfig("put.error=0.5, error=0.1")
+	require.NoError(t, err)
+	require.Equal(t, 0.5, config.ErrorRates[FaultOpPut])
+	require.Equal(t, 0.1, config.ErrorRates[FaultOpGet])
+	for _, spec := range []string{"error=2", "foo.error=0.1", "latency=10ms-1ms", "tail=1s", "foo=bar", "seed"} {
+		_, err := ParseFaultConfig(spec)
+		require.YesError(t, err, spec)
+	}
+}
@@ -1,9 +1,13 @@
 package obj
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -11,6 +15,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/promutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
@@ -21,6 +27,9 @@ import (
 type googleClient struct {
 	bucketName string
 	bucket     *storage.BucketHandle
+
+	sweepMu   sync.Mutex
+	lastSweep time.Time
 }
 
 func newGoogleClient(bucket string, opts []option.ClientOption) (*googleClient, error) {
@@ -57,8 +66,23 @@ func (c *googleClient) Exists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
+// Put uploads large objects in parts, several at a time, as temporary objects
+// which are then composed into the object.
 func (c *googleClient) Put(ctx context.Context, name string, r io.Reader) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
+	return putMultipart(ctx, r, func(ctx context.Context, r io.Reader, _ int64) error {
+		return c.put(ctx, name, r)
+	}, func(ctx context.Context) (multipartUpload, error) {
+		c.maybeSweepUploads(ctx)
+		return &googleUpload{
+			c:      c,
+			name:   name,
+			prefix: path.Join(googleUploadPrefix, uuid.NewWithoutDashes()),
+		}, nil
+	})
+}
+
+func (c *googleClient) put(ctx context.Context, name string, r io.Reader) error {
 	ctx, cf := context.WithCancel(ctx)
 	defer cf() // this aborts the write if the writer is not already closed
 	wc := c.bucket.Object(name).NewWriter(ctx)
@@ -86,6 +110,28 @@ func (c *googleClient) Walk(ctx context.Context, name string, fn func(name strin
 	return nil
 }
 
+func (c *googleClient) WalkInfo(ctx context.Context, name string, fn func(info *ObjectInfo) error) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	objectIter := c.bucket.Objects(ctx, &storage.Query{Prefix: name})
+	for {
+		objectAttrs, err := objectIter.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := fn(&ObjectInfo{
+			Name: objectAttrs.Name,
+			Size: objectAttrs.Size,
+			ETag: objectAttrs.Etag,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *googleClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	reader, err := c.bucket.Object(name).NewReader(ctx)
@@ -101,6 +147,35 @@ func (c *googleClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 	return errors.EnsureStack(err)
 }
 
+func (c *googleClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	if size <= 0 {
+		size = -1
+	}
+	reader, err := c.bucket.Object(name).NewRangeReader(ctx, offset, size)
+	if err != nil {
+		googleErr := &googleapi.Error{}
+		if errors.As(err, &googleErr) && googleErr.Code == http.StatusRequestedRangeNotSatisfiable {
+			// The range starts at or past the end of the object.
+			return nil
+		}
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := reader.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, reader)
+	return errors.EnsureStack(err)
+}
+
+func (c *googleClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	_, err := c.bucket.Object(dst).CopierFrom(c.bucket.Object(src)).Run(ctx)
+	return errors.EnsureStack(err)
+}
+
 func (c *googleClient) Delete(ctx context.Context, name string) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	return errors.EnsureStack(c.bucket.Object(name).Delete(ctx))
@@ -141,3 +216,120 @@ func (c *googleClient) transformError(err error, objectPath string) error {
 	}
 	return err
 }
+
+const (
+	// googleUploadPrefix is the prefix of the temporary objects for the parts
+	// of objects being uploaded.
+	googleUploadPrefix = "_multipart"
+	// googleMaxComposeSources is the maximum number of objects composed into
+	// an object at a time.
+	googleMaxComposeSources = 32
+	// googleUploadMaxAge is how long the temporary objects of an upload are
+	// kept before they're assumed to have been left behind by a process that
+	// died while uploading, and are deleted.
+	googleUploadMaxAge = 24 * time.Hour
+	// googleUploadSweepPeriod is how often a client looks for temporary
+	// objects that have been left behind.
+	googleUploadSweepPeriod = time.Hour
+)
+
+// maybeSweepUploads deletes the temporary objects of uploads that were never
+// completed or aborted, if they haven't been looked for recently. Errors are
+// logged rather than returned, since they don't affect the upload in progress.
+func (c *googleClient) maybeSweepUploads(ctx context.Context) {
+	c.sweepMu.Lock()
+	if time.Since(c.lastSweep) < googleUploadSweepPeriod {
+		c.sweepMu.Unlock()
+		return
+	}
+	c.lastSweep = time.Now()
+	c.sweepMu.Unlock()
+	objectIter := c.bucket.Objects(ctx, &storage.Query{Prefix: googleUploadPrefix + "/"})
+	for {
+		objectAttrs, err := objectIter.Next()
+		if err != nil {
+			if !errors.Is(err, iterator.Done) {
+				log.Errorf("error listing temporary upload objects: %v", err)
+			}
+			return
+		}
+		if time.Since(objectAttrs.Created) < googleUploadMaxAge {
+			continue
+		}
+		if err := c.bucket.Object(objectAttrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			log.Errorf("error deleting temporary upload object %v: %v", objectAttrs.Name, err)
+		}
+	}
+}
+
+// googleUpload uploads the parts of an object as temporary objects, which
+// are composed into the object when the upload completes.
+type googleUpload struct {
+	c      *googleClient
+	name   string
+	prefix string
+
+	mu    sync.Mutex
+	parts []string
+}
+
+func (u *googleUpload) putPart(ctx context.Context, n int, data []byte) error {
+	part := path.Join(u.prefix, fmt.Sprintf("%06d", n))
+	u.mu.Lock()
+	u.parts = append(u.parts, part)
+	u.mu.Unlock()
+	return u.c.put(ctx, part, bytes.NewReader(data))
+}
+
+// complete composes the parts into the object. Only a limited number of
+// objects can be composed at a time, so groups of parts are composed into
+// temporary objects until few enough remain.
+func (u *googleUpload) complete(ctx context.Context, numParts int) error {
+	srcs := make([]string, numParts)
+	for i := range srcs {
+		srcs[i] = path.Join(u.prefix, fmt.Sprintf("%06d", i))
+	}
+	for level := 0; len(srcs) > googleMaxComposeSources; level++ {
+		var dsts []string
+		for i := 0; i < len(srcs); i += googleMaxComposeSources {
+			end := i + googleMaxComposeSources
+			if end > len(srcs) {
+				end = len(srcs)
+			}
+			dst := path.Join(u.prefix, fmt.Sprintf("compose-%d-%06d", level, len(dsts)))
+			u.mu.Lock()
+			u.parts = append(u.parts, dst)
+			u.mu.Unlock()
+			if err := u.compose(ctx, dst, srcs[i:end]); err != nil {
+				return err
+			}
+			dsts = append(dsts, dst)
+		}
+		srcs = dsts
+	}
+	if err := u.compose(ctx, u.name, srcs); err != nil {
+		return err
+	}
+	return u.abort(ctx)
+}
+
+func (u *googleUpload) compose(ctx context.Context, dst string, srcs []string) error {
+	var handles []*storage.ObjectHandle
+	for _, src := range srcs {
+		handles = append(handles, u.c.bucket.Object(src))
+	}
+	_, err := u.c.bucket.Object(dst).ComposerFrom(handles...).Run(ctx)
+	return errors.EnsureStack(err)
+}
+
+// abort deletes the temporary objects.
+func (u *googleUpload) abort(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for _, part := range u.parts {
+		if err := u.c.bucket.Object(part).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
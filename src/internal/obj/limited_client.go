@@ -79,3 +79,14 @@ func (loc *limitedClient) Get(ctx context.Context, name string, w io.Writer) err
 	defer loc.readersSem.Release(limitClientSemCost)
 	return errors.EnsureStack(loc.Client.Get(ctx, name, w))
 }
+
+func (loc *limitedClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) error {
+	blockStartedMetric.WithLabelValues("get_range").Inc()
+	t := time.Now()
+	if err := loc.readersSem.Acquire(ctx, limitClientSemCost); err != nil {
+		return errors.EnsureStack(err)
+	}
+	blockedSecondsMetric.WithLabelValues("get_range").Observe(time.Since(t).Seconds())
+	defer loc.readersSem.Release(limitClientSemCost)
+	return errors.EnsureStack(loc.Client.GetRange(ctx, name, offset, size, w))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -73,6 +74,34 @@ func (c *fsClient) Get(ctx context.Context, name string, w io.Writer) (retErr er
 	return errors.EnsureStack(err)
 }
 
+func (c *fsClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	f, err := os.Open(c.finalPathFor(name))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer c.closeFile(&retErr, f)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var r io.Reader = f
+	if size > 0 {
+		r = io.LimitReader(f, size)
+	}
+	_, err = io.Copy(w, r)
+	return errors.EnsureStack(err)
+}
+
+func (c *fsClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	f, err := os.Open(c.finalPathFor(src))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer c.closeFile(&retErr, f)
+	return c.Put(ctx, dst, f)
+}
+
 func (c *fsClient) Delete(ctx context.Context, name string) error {
 	err := os.Remove(c.finalPathFor(name))
 	if os.IsNotExist(err) {
@@ -115,6 +144,25 @@ func (c *fsClient) Walk(ctx context.Context, prefix string, cb func(string) erro
 	return nil
 }
 
+// WalkInfo uses the modification time and size of each object's file as its
+// ETag, since objects are only ever replaced wholesale by Put.
+func (c *fsClient) WalkInfo(ctx context.Context, prefix string, cb func(*ObjectInfo) error) error {
+	return c.Walk(ctx, prefix, func(name string) error {
+		fi, err := os.Stat(c.finalPathFor(name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil // deleted while walking
+			}
+			return errors.EnsureStack(err)
+		}
+		return cb(&ObjectInfo{
+			Name: name,
+			Size: fi.Size(),
+			ETag: fmt.Sprintf("%x-%x", fi.ModTime().UnixNano(), fi.Size()),
+		})
+	})
+}
+
 func (c *fsClient) BucketURL() ObjectStoreURL {
 	return ObjectStoreURL{
 		Scheme: "local",
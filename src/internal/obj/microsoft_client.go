@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
@@ -70,6 +71,38 @@ func (c *microsoftClient) Get(_ context.Context, name string, w io.Writer) (retE
 	return errors.EnsureStack(err)
 }
 
+// TODO: should respect context
+func (c *microsoftClient) GetRange(_ context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	blobRange := &storage.BlobRange{Start: uint64(offset)}
+	if size > 0 {
+		blobRange.End = uint64(offset + size - 1)
+	}
+	r, err := c.container.GetBlobReference(name).GetRange(&storage.GetBlobRangeOptions{Range: blobRange})
+	if err != nil {
+		microsoftErr := &storage.AzureStorageServiceError{}
+		if errors.As(err, &microsoftErr) && microsoftErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			// The range starts at or past the end of the blob.
+			return nil
+		}
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := r.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, r)
+	return errors.EnsureStack(err)
+}
+
+// TODO: should respect context
+func (c *microsoftClient) Copy(_ context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	srcURL := c.container.GetBlobReference(src).GetURL()
+	return errors.EnsureStack(c.container.GetBlobReference(dst).Copy(srcURL, nil))
+}
+
 // TODO: should respect context
 func (c *microsoftClient) Delete(_ context.Context, name string) error {
 	_, err := c.container.GetBlobReference(name).DeleteIfExists(nil)
@@ -101,6 +134,34 @@ func (c *microsoftClient) Walk(_ context.Context, name string, f func(name strin
 	return nil
 }
 
+func (c *microsoftClient) WalkInfo(_ context.Context, name string, f func(info *ObjectInfo) error) error {
+	var marker string
+	for {
+		blobList, err := c.container.ListBlobs(storage.ListBlobsParameters{
+			Prefix: name,
+			Marker: marker,
+		})
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, file := range blobList.Blobs {
+			if err := f(&ObjectInfo{
+				Name: file.Name,
+				Size: file.Properties.ContentLength,
+				ETag: file.Properties.Etag,
+			}); err != nil {
+				return err
+			}
+		}
+		// NextMarker is empty when all results have been returned
+		if blobList.NextMarker == "" {
+			break
+		}
+		marker = blobList.NextMarker
+	}
+	return nil
+}
+
 // TODO: should respect context
 func (c *microsoftClient) Exists(ctx context.Context, name string) (bool, error) {
 	exists, err := c.container.GetBlobReference(name).Exists()
@@ -1,10 +1,13 @@
 package obj
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"sync"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
@@ -45,14 +48,23 @@ func newMinioClientV2(endpoint, bucket, id, secret string, secure bool) (*minioC
 	}, nil
 }
 
+// Put uploads large objects in parts, several at a time.
 func (c *minioClient) Put(ctx context.Context, name string, r io.Reader) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	opts := minio.PutObjectOptions{
 		ContentType: "application/octet-stream",
-		PartSize:    uint64(8 * 1024 * 1024),
 	}
-	_, err := c.Client.PutObjectWithContext(ctx, c.bucket, name, r, -1, opts)
-	return errors.EnsureStack(err)
+	return putMultipart(ctx, r, func(ctx context.Context, r io.Reader, size int64) error {
+		_, err := c.Client.PutObjectWithContext(ctx, c.bucket, name, r, size, opts)
+		return errors.EnsureStack(err)
+	}, func(ctx context.Context) (multipartUpload, error) {
+		core := minio.Core{Client: c.Client}
+		uploadID, err := core.NewMultipartUpload(c.bucket, name, opts)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return &minioUpload{core: core, bucket: c.bucket, name: name, uploadID: uploadID}, nil
+	})
 }
 
 // TODO: this should respect the context
@@ -73,6 +85,25 @@ func (c *minioClient) Walk(_ context.Context, name string, fn func(name string)
 	return nil
 }
 
+func (c *minioClient) WalkInfo(_ context.Context, name string, fn func(info *ObjectInfo) error) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for objInfo := range c.ListObjectsV2(c.bucket, name, true, doneCh) {
+		if objInfo.Err != nil {
+			return objInfo.Err
+		}
+		if err := fn(&ObjectInfo{
+			Name: objInfo.Key,
+			Size: objInfo.Size,
+			ETag: objInfo.ETag,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *minioClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, minio.GetObjectOptions{})
@@ -88,6 +119,40 @@ func (c *minioClient) Get(ctx context.Context, name string, w io.Writer) (retErr
 	return errors.EnsureStack(err)
 }
 
+func (c *minioClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	opts := minio.GetObjectOptions{}
+	opts.Set("Range", byteRange(offset, size))
+	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, opts)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := rc.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, rc)
+	errResp := minio.ErrorResponse{}
+	if errors.As(err, &errResp) && errResp.Code == "InvalidRange" {
+		// The range starts at or past the end of the object.
+		return nil
+	}
+	return errors.EnsureStack(err)
+}
+
+// TODO: should respect context
+func (c *minioClient) Copy(_ context.Context, src, dst string) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, src) }()
+	dstInfo, err := minio.NewDestinationInfo(c.bucket, dst, nil, nil)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	// Composing, rather than copying, copies objects too large for a single
+	// copy in parts.
+	return errors.EnsureStack(c.ComposeObject(dstInfo, []minio.SourceInfo{minio.NewSourceInfo(c.bucket, src, nil)}))
+}
+
 // TODO: should respect context
 func (c *minioClient) Delete(_ context.Context, name string) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
@@ -133,6 +198,43 @@ func (c *minioClient) transformError(err error, objectPath string) error {
 	return err
 }
 
+// minioUpload is a multipart upload of an object to an s3 compatible server.
+type minioUpload struct {
+	core     minio.Core
+	bucket   string
+	name     string
+	uploadID string
+
+	mu    sync.Mutex
+	parts []minio.CompletePart
+}
+
+func (u *minioUpload) putPart(ctx context.Context, n int, data []byte) error {
+	part, err := u.core.PutObjectPartWithContext(ctx, u.bucket, u.name, u.uploadID, n+1, bytes.NewReader(data), int64(len(data)), "", "", nil)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.parts = append(u.parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	return nil
+}
+
+func (u *minioUpload) complete(ctx context.Context, numParts int) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if len(u.parts) != numParts {
+		return errors.Errorf("multipart upload of %v has %d parts, expected %d", u.name, len(u.parts), numParts)
+	}
+	sort.Slice(u.parts, func(i, j int) bool { return u.parts[i].PartNumber < u.parts[j].PartNumber })
+	_, err := u.core.CompleteMultipartUploadWithContext(ctx, u.bucket, u.name, u.uploadID, u.parts)
+	return errors.EnsureStack(err)
+}
+
+func (u *minioUpload) abort(ctx context.Context) error {
+	return errors.EnsureStack(u.core.AbortMultipartUploadWithContext(ctx, u.bucket, u.name, u.uploadID))
+}
+
 // Sentinel error response returned if err is not
 // of type *minio.ErrorResponse.
 var sentinelErrResp = minio.ErrorResponse{}
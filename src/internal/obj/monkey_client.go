@@ -63,6 +63,22 @@ func (c *monkeyClient) Put(ctx context.Context, path string, r io.Reader) error
 	return errors.EnsureStack(c.c.Put(ctx, path, r))
 }
 
+// GetRange wraps the get range operation.
+func (c *monkeyClient) GetRange(ctx context.Context, path string, offset, size int64, w io.Writer) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return errors.EnsureStack(c.c.GetRange(ctx, path, offset, size, w))
+}
+
+// Copy wraps the copy operation.
+func (c *monkeyClient) Copy(ctx context.Context, src, dst string) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return errors.EnsureStack(c.c.Copy(ctx, src, dst))
+}
+
 // Delete wraps the delete operation.
 func (c *monkeyClient) Delete(ctx context.Context, path string) error {
 	if enabled && localRand.Float64() < failProb {
@@ -79,6 +95,14 @@ func (c *monkeyClient) Walk(ctx context.Context, dir string, walkFn func(name st
 	return errors.EnsureStack(c.c.Walk(ctx, dir, walkFn))
 }
 
+// WalkInfo wraps the walk info operation.
+func (c *monkeyClient) WalkInfo(ctx context.Context, dir string, walkFn func(info *ObjectInfo) error) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return errors.EnsureStack(c.c.WalkInfo(ctx, dir, walkFn))
+}
+
 // Exists wraps the existance check.
 func (c *monkeyClient) Exists(ctx context.Context, path string) (bool, error) {
 	if enabled && localRand.Float64() < failProb {
@@ -0,0 +1,187 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/v2/src/client/limit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+)
+
+const (
+	// multipartPartSize is the size of the parts of objects that are uploaded
+	// or downloaded in parallel.
+	multipartPartSize = 8 * 1024 * 1024
+	// multipartThreshold is the size above which objects are uploaded in
+	// parts. It's well above the maximum size of a chunk, so that chunks are
+	// uploaded in a single request, and only take up a single slot of a
+	// limited client.
+	multipartThreshold = 8 * multipartPartSize
+	// multipartConcurrency is the number of parts of an object that are
+	// uploaded or downloaded at a time.
+	multipartConcurrency = 5
+)
+
+var multipartBufPool = grpcutil.NewBufPool(multipartPartSize)
+
+// multipartUpload is the upload of an object in parts.
+type multipartUpload interface {
+	// putPart uploads part n, counting from 0, of the object.
+	putPart(ctx context.Context, n int, data []byte) error
+	// complete creates the object from its first numParts parts.
+	complete(ctx context.Context, numParts int) error
+	// abort discards the parts uploaded so far.
+	abort(ctx context.Context) error
+}
+
+// readThreshold reads the data from r a part at a time, until it has read more
+// than the multipart threshold, or all of the data. It returns the parts read,
+// which must be released with releaseParts, their total size, and whether all
+// of the data was read.
+func readThreshold(r io.Reader) (parts [][]byte, size int64, eof bool, _ error) {
+	for size <= multipartThreshold && !eof {
+		data := multipartBufPool.GetBuffer()
+		n, err := io.ReadFull(r, data)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			multipartBufPool.PutBuffer(data)
+			releaseParts(parts)
+			return nil, 0, false, errors.EnsureStack(err)
+		}
+		parts = append(parts, data[:n])
+		size += int64(n)
+		eof = n < multipartPartSize
+	}
+	return parts, size, eof, nil
+}
+
+func releaseParts(parts [][]byte) {
+	for _, part := range parts {
+		multipartBufPool.PutBuffer(part[:cap(part)])
+	}
+}
+
+func partsReader(parts [][]byte) io.Reader {
+	readers := make([]io.Reader, len(parts))
+	for i, part := range parts {
+		readers[i] = bytes.NewReader(part)
+	}
+	return io.MultiReader(readers...)
+}
+
+// putMultipart uploads the data from r. Data up to the multipart threshold is
+// uploaded with putSingle, and larger data is uploaded in parts, several at a
+// time, with the upload returned by newUpload.
+func putMultipart(ctx context.Context, r io.Reader, putSingle func(ctx context.Context, r io.Reader, size int64) error, newUpload func(ctx context.Context) (multipartUpload, error)) (retErr error) {
+	parts, size, eof, err := readThreshold(r)
+	if err != nil {
+		return err
+	}
+	defer func() { releaseParts(parts) }()
+	if size <= multipartThreshold {
+		return putSingle(ctx, partsReader(parts), size)
+	}
+	upload, err := newUpload(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := upload.abort(context.Background()); err != nil {
+				logrus.Errorf("error aborting multipart upload: %v", err)
+			}
+		}
+	}()
+	eg, egCtx := errgroup.WithContext(ctx)
+	limiter := limit.New(multipartConcurrency)
+	var numParts int
+	for {
+		var partData []byte
+		if len(parts) > 0 {
+			partData, parts = parts[0], parts[1:]
+		} else {
+			if eof {
+				break
+			}
+			partData = multipartBufPool.GetBuffer()
+			n, err := io.ReadFull(r, partData)
+			if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				multipartBufPool.PutBuffer(partData)
+				// The read error is returned, rather than any upload error.
+				_ = eg.Wait()
+				return errors.EnsureStack(err)
+			}
+			partData = partData[:n]
+			eof = n < multipartPartSize
+			if n == 0 {
+				multipartBufPool.PutBuffer(partData[:cap(partData)])
+				break
+			}
+		}
+		part := numParts
+		numParts++
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			defer multipartBufPool.PutBuffer(partData[:cap(partData)])
+			return upload.putPart(egCtx, part, partData)
+		})
+		if egCtx.Err() != nil {
+			break
+		}
+	}
+	if err := eg.Wait(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := ctx.Err(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return upload.complete(ctx, numParts)
+}
+
+// GetParallel writes an object to w, like c.Get, but downloads the object in
+// parts, several at a time, with ranged reads. The object must not change
+// while it is downloaded.
+func GetParallel(ctx context.Context, c Client, name string, w io.Writer) error {
+	first := &bytes.Buffer{}
+	if err := c.GetRange(ctx, name, 0, multipartPartSize, first); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := w.Write(first.Bytes()); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if first.Len() < multipartPartSize {
+		return nil
+	}
+	// The size of the object isn't known, so parts are downloaded until one
+	// ends before the part size.
+	parts := make([]*bytes.Buffer, multipartConcurrency)
+	for i := range parts {
+		parts[i] = &bytes.Buffer{}
+	}
+	for offset := int64(multipartPartSize); ; offset += multipartPartSize * multipartConcurrency {
+		eg, egCtx := errgroup.WithContext(ctx)
+		for i, part := range parts {
+			partOffset, part := offset+int64(i)*multipartPartSize, part
+			part.Reset()
+			eg.Go(func() error {
+				return errors.EnsureStack(c.GetRange(egCtx, name, partOffset, multipartPartSize, part))
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, part := range parts {
+			if _, err := w.Write(part.Bytes()); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if part.Len() < multipartPartSize {
+				return nil
+			}
+		}
+	}
+}
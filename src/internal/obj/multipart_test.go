@@ -0,0 +1,78 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+type testUpload struct {
+	mu       sync.Mutex
+	parts    map[int][]byte
+	data     []byte
+	failPart int
+	aborted  bool
+}
+
+func (u *testUpload) putPart(_ context.Context, n int, data []byte) error {
+	if n == u.failPart {
+		return errors.New("part failed")
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.parts[n] = append([]byte{}, data...)
+	return nil
+}
+
+func (u *testUpload) complete(_ context.Context, numParts int) error {
+	for i := 0; i < numParts; i++ {
+		u.data = append(u.data, u.parts[i]...)
+	}
+	return nil
+}
+
+func (u *testUpload) abort(context.Context) error {
+	u.aborted = true
+	return nil
+}
+
+func TestPutMultipart(t *testing.T) {
+	ctx := context.Background()
+	put := func(data []byte, failPart int) (single []byte, upload *testUpload, _ error) {
+		err := putMultipart(ctx, bytes.NewReader(data), func(_ context.Context, r io.Reader, size int64) error {
+			var err error
+			single, err = io.ReadAll(r)
+			require.Equal(t, size, int64(len(single)))
+			return errors.EnsureStack(err)
+		}, func(context.Context) (multipartUpload, error) {
+			upload = &testUpload{parts: make(map[int][]byte), failPart: failPart}
+			return upload, nil
+		})
+		return single, upload, err
+	}
+	data := bytes.Repeat([]byte{1, 2, 3, 4, 5, 6, 7, 8}, (multipartThreshold+multipartPartSize)/8+1)
+	// Objects up to the threshold, such as chunks, are uploaded in a single
+	// request.
+	for _, size := range []int{0, 1, multipartPartSize, multipartThreshold} {
+		single, upload, err := put(data[:size], -1)
+		require.NoError(t, err)
+		require.Nil(t, upload)
+		require.Equal(t, data[:size], single)
+	}
+	// Larger objects are uploaded in parts.
+	single, upload, err := put(data, -1)
+	require.NoError(t, err)
+	require.Nil(t, single)
+	require.Equal(t, 10, len(upload.parts))
+	require.Equal(t, data, upload.data)
+	require.False(t, upload.aborted)
+	// The upload is aborted if a part fails.
+	_, upload, err = put(data, 3)
+	require.YesError(t, err)
+	require.True(t, upload.aborted)
+}
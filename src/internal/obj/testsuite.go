@@ -65,6 +65,81 @@ func TestSuite(t *testing.T, newClient func(t testing.TB) Client) {
 		actualHash := pachhash.Sum(buf.Bytes())
 		require.Equal(t, expectedHash, actualHash)
 	})
+
+	t.Run("TestWalkInfo", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		prefix := randutil.UniqueString("test-walk-info-")
+		name := path.Join(prefix, "object")
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader([]byte("foo"))))
+		var infos []*ObjectInfo
+		require.NoError(t, client.WalkInfo(ctx, prefix, func(info *ObjectInfo) error {
+			infos = append(infos, info)
+			return nil
+		}))
+		require.Equal(t, 1, len(infos))
+		require.Equal(t, name, infos[0].Name)
+		require.Equal(t, int64(3), infos[0].Size)
+		require.NotEqual(t, "", infos[0].ETag)
+	})
+
+	t.Run("TestGetRange", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := randutil.UniqueString("test-get-range-")
+		data := []byte("0123456789")
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader(data)))
+		for _, test := range []struct {
+			offset, size int64
+			expected     string
+		}{
+			{0, 3, "012"},
+			{4, 2, "45"},
+			{7, 0, "789"},
+			{8, 5, "89"},
+			{10, 1, ""},
+			{12, 0, ""},
+		} {
+			buf := &bytes.Buffer{}
+			require.NoError(t, client.GetRange(ctx, name, test.offset, test.size, buf))
+			require.Equal(t, test.expected, buf.String())
+		}
+		err := client.GetRange(ctx, randutil.UniqueString("test-missing-object-"), 0, 1, &bytes.Buffer{})
+		require.YesError(t, err)
+		require.True(t, pacherr.IsNotExist(err))
+	})
+
+	t.Run("TestCopy", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		src := randutil.UniqueString("test-copy-src-")
+		dst := randutil.UniqueString("test-copy-dst-")
+		require.NoError(t, client.Put(ctx, src, bytes.NewReader([]byte("foo bar"))))
+		require.NoError(t, client.Copy(ctx, src, dst))
+		buf := &bytes.Buffer{}
+		require.NoError(t, client.Get(ctx, dst, buf))
+		require.Equal(t, "foo bar", buf.String())
+		requireExists(t, client, src, true)
+		err := client.Copy(ctx, randutil.UniqueString("test-missing-object-"), dst)
+		require.YesError(t, err)
+		require.True(t, pacherr.IsNotExist(err))
+	})
+
+	t.Run("TestMultipart", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := randutil.UniqueString("test-multipart-")
+		// Large enough to be uploaded in parts, with a partial last part.
+		expectedData, err := ioutil.ReadAll(io.LimitReader(rand.Reader, multipartThreshold+multipartPartSize+1<<20))
+		require.NoError(t, err)
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader(expectedData)))
+		buf := &bytes.Buffer{}
+		require.NoError(t, GetParallel(ctx, client, name, buf))
+		require.Equal(t, pachhash.Sum(expectedData), pachhash.Sum(buf.Bytes()))
+		buf.Reset()
+		require.NoError(t, client.Get(ctx, name, buf))
+		require.Equal(t, pachhash.Sum(expectedData), pachhash.Sum(buf.Bytes()))
+	})
 }
 
 func TestEmptyWrite(t *testing.T, client Client) {
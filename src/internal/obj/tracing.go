@@ -98,6 +98,32 @@ func (o *tracingObjClient) Get(ctx context.Context, name string, w io.Writer) (r
 	return errors.EnsureStack(err)
 }
 
+// GetRange implements the corresponding method in the Client interface
+func (o *tracingObjClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "get_range").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/GetRange",
+		"name", name, "offset", offset, "size", size)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	err := o.Client.GetRange(ctx, name, offset, size, &promutil.CountingWriter{
+		Writer:  w,
+		Counter: objectBytesReadMetrics.WithLabelValues(o.provider),
+	})
+	return errors.EnsureStack(err)
+}
+
+// Copy implements the corresponding method in the Client interface
+func (o *tracingObjClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "copy").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Copy",
+		"src", src, "dst", dst)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	return errors.EnsureStack(o.Client.Copy(ctx, src, dst))
+}
+
 // Delete implements the corresponding method in the Client interface
 func (o *tracingObjClient) Delete(ctx context.Context, name string) (retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "delete").Inc()
@@ -120,6 +146,17 @@ func (o *tracingObjClient) Walk(ctx context.Context, prefix string, fn func(name
 	return errors.EnsureStack(o.Client.Walk(ctx, prefix, fn))
 }
 
+// WalkInfo implements the corresponding method in the Client interface
+func (o *tracingObjClient) WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) (retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "walk_info").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/WalkInfo",
+		"prefix", prefix)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	return errors.EnsureStack(o.Client.WalkInfo(ctx, prefix, fn))
+}
+
 // Exists implements the corresponding method in the Client interface
 func (o *tracingObjClient) Exists(ctx context.Context, name string) (retVal bool, retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "exists").Inc()
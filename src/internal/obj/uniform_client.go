@@ -37,6 +37,22 @@ func (cc *uniformClient) Get(ctx context.Context, name string, w io.Writer) (ret
 	return errors.EnsureStack(cc.c.Get(ctx, name, w))
 }
 
+func (cc *uniformClient) GetRange(ctx context.Context, name string, offset, size int64, w io.Writer) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	name = strings.Trim(name, "/")
+	return errors.EnsureStack(cc.c.GetRange(ctx, name, offset, size, w))
+}
+
+func (cc *uniformClient) Copy(ctx context.Context, src, dst string) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	src, dst = strings.Trim(src, "/"), strings.Trim(dst, "/")
+	return errors.EnsureStack(cc.c.Copy(ctx, src, dst))
+}
+
 func (cc *uniformClient) Delete(ctx context.Context, name string) (retErr error) {
 	defer func() {
 		retErr = errors.EnsureStack(retErr)
@@ -56,6 +72,13 @@ func (cc *uniformClient) Walk(ctx context.Context, prefix string, fn func(name s
 	return errors.EnsureStack(cc.c.Walk(ctx, prefix, fn))
 }
 
+func (cc *uniformClient) WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	return errors.EnsureStack(cc.c.WalkInfo(ctx, prefix, fn))
+}
+
 func (uc *uniformClient) Exists(ctx context.Context, p string) (_ bool, retErr error) {
 	defer func() {
 		retErr = errors.EnsureStack(retErr)
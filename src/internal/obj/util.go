@@ -2,6 +2,7 @@ package obj
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -9,14 +10,27 @@ import (
 )
 
 // Copy copys an object from src at srcPath to dst at dstPath
+// Objects are copied by the object store when src and dst use the same bucket.
 func Copy(ctx context.Context, src, dst Client, srcPath, dstPath string) (retErr error) {
+	if src.BucketURL() == dst.BucketURL() {
+		return errors.EnsureStack(src.Copy(ctx, srcPath, dstPath))
+	}
 	return miscutil.WithPipe(func(w io.Writer) error {
-		return errors.EnsureStack(src.Get(ctx, srcPath, w))
+		return errors.EnsureStack(GetParallel(ctx, src, srcPath, w))
 	}, func(r io.Reader) error {
 		return errors.EnsureStack(dst.Put(ctx, dstPath, r))
 	})
 }
 
+// byteRange returns the value of an HTTP Range header for size bytes starting
+// at offset, or for all of the bytes starting at offset if size <= 0.
+func byteRange(offset, size int64) string {
+	if size <= 0 {
+		return fmt.Sprintf("bytes=%d-", offset)
+	}
+	return fmt.Sprintf("bytes=%d-%d", offset, offset+size-1)
+}
+
 type testURL struct {
 	Client
 }
@@ -0,0 +1,120 @@
+package pfsload
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+)
+
+// ChunkingResult is the outcome of chunking a dataset with a chunking
+// configuration.
+type ChunkingResult struct {
+	Config       *chunk.ChunkingConfig
+	Files        int64
+	TotalBytes   int64
+	Chunks       int64
+	UniqueChunks int64
+	UniqueBytes  int64
+	Duration     time.Duration
+}
+
+// DedupRatio returns the ratio of the total bytes to the bytes that would be
+// stored after deduplicating chunks.
+func (r *ChunkingResult) DedupRatio() float64 {
+	if r.UniqueBytes == 0 {
+		return 1
+	}
+	return float64(r.TotalBytes) / float64(r.UniqueBytes)
+}
+
+// AverageChunkSize returns the average size of the chunks produced.
+func (r *ChunkingResult) AverageChunkSize() int64 {
+	if r.Chunks == 0 {
+		return 0
+	}
+	return r.TotalBytes / r.Chunks
+}
+
+// Throughput returns the chunking throughput in bytes per second.
+func (r *ChunkingResult) Throughput() float64 {
+	if r.Duration <= 0 {
+		return 0
+	}
+	return float64(r.TotalBytes) / r.Duration.Seconds()
+}
+
+// DefaultChunkingConfigs returns the chunking configurations compared by a
+// chunking report when none are specified.
+func DefaultChunkingConfigs() []*chunk.ChunkingConfig {
+	var configs []*chunk.ChunkingConfig
+	for _, algo := range []chunk.ChunkingAlgo{chunk.Buzhash64, chunk.FastCDC} {
+		for _, averageBits := range []uint{20, chunk.DefaultAverageBits} {
+			config := chunk.DefaultChunkingConfig()
+			config.Algo = algo
+			config.AverageSize = 1 << averageBits
+			config.MinSize = config.AverageSize / 4
+			config.MaxSize = config.AverageSize * 4
+			configs = append(configs, config)
+		}
+	}
+	return configs
+}
+
+// ChunkingReport chunks the local files under root with each of the chunking
+// configurations and reports the deduplication and throughput of each.
+func ChunkingReport(root string, configs []*chunk.ChunkingConfig) ([]*ChunkingResult, error) {
+	var results []*ChunkingResult
+	for _, config := range configs {
+		if err := config.Validate(); err != nil {
+			return nil, err
+		}
+		result, err := chunkDataset(root, config)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func chunkDataset(root string, config *chunk.ChunkingConfig) (*ChunkingResult, error) {
+	result := &ChunkingResult{Config: config}
+	seen := make(map[pachhash.Output]struct{})
+	start := time.Now()
+	if err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer f.Close()
+		result.Files++
+		return config.ComputeChunks(f, func(data []byte) error {
+			if len(data) == 0 {
+				return nil
+			}
+			result.TotalBytes += int64(len(data))
+			result.Chunks++
+			sum := pachhash.Sum(data)
+			if _, ok := seen[sum]; !ok {
+				seen[sum] = struct{}{}
+				result.UniqueChunks++
+				result.UniqueBytes += int64(len(data))
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	result.Duration = time.Since(start)
+	return result, nil
+}
@@ -18,4 +18,12 @@ const (
 
 	// SpoutMarkerBranch is the branch that spouts use for keeping track of spout marker files
 	SpoutMarkerBranch = "marker"
+
+	// ObjectStateBranch is the branch that object inputs use for keeping track
+	// of the ETags of the objects they have ingested
+	ObjectStateBranch = "etags"
+
+	// DeadLetterBranch is the branch of a pipeline's meta repo that dead-letter
+	// pipelines use for keeping track of quarantined datums
+	DeadLetterBranch = "deadletter"
 )
@@ -258,6 +258,9 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 		if input.Cron != nil {
 			input.Cron.Commit = commitsetID
 		}
+		if input.Object != nil {
+			input.Object.Commit = commitsetID
+		}
 		return nil
 	})
 	return jobInput
@@ -286,6 +289,7 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		Spout:                 pipelineInfo.Details.Spout,
 		SchedulingSpec:        pipelineInfo.Details.SchedulingSpec,
 		DatumTries:            pipelineInfo.Details.DatumTries,
+		DeadLetter:            pipelineInfo.Details.DeadLetter,
 		S3Out:                 pipelineInfo.Details.S3Out,
 		Metadata:              pipelineInfo.Details.Metadata,
 		ReprocessSpec:         pipelineInfo.Details.ReprocessSpec,
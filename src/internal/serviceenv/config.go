@@ -1,5 +1,7 @@
 package serviceenv
 
+import "strings"
+
 // Configuration is the generic configuration structure used to access configuration fields.
 type Configuration struct {
 	*GlobalConfiguration
@@ -51,6 +53,10 @@ type GlobalConfiguration struct {
 	// SessionDurationMinutes it how long auth tokens are valid for, defaults to 30 days (30 * 24 * 60)
 	SessionDurationMinutes int `env:"SESSION_DURATION_MINUTES,default=43200"`
 
+	// AuditLogRetentionDays is how long the audit log of API calls is kept,
+	// defaults to a year. Setting it to 0 disables the audit log.
+	AuditLogRetentionDays int `env:"AUDIT_LOG_RETENTION_DAYS,default=365"`
+
 	IdentityServerDatabase string `env:"IDENTITY_SERVER_DATABASE,default=dex"`
 
 	// PPSSpecCommitID and PPSPipelineName are only set for workers and sidecar
@@ -115,6 +121,62 @@ type StorageConfiguration struct {
 	StorageFileSetsMaxOpen               int   `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize                 int   `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize               int   `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	// StorageChunkCachePath is a directory in which chunk objects are cached
+	// on disk, encrypted as they are in object storage. It is mounted from the
+	// host into the storage sidecar of the pipeline workers, so that the
+	// workers on a node share it. The disk cache is disabled when it is empty.
+	StorageChunkCachePath string `env:"STORAGE_CHUNK_CACHE_PATH"`
+	// StorageChunkCacheSize is the maximum number of bytes in the chunk disk
+	// cache.
+	StorageChunkCacheSize int64 `env:"STORAGE_CHUNK_CACHE_SIZE,default=10737418240"`
+	// StorageReplicaURLs is a comma separated list of URLs of object stores
+	// (e.g. s3://bucket) that chunk objects are replicated to. The replicas
+	// are also used to repair missing or corrupt objects found by the scrubber.
+	StorageReplicaURLs string `env:"STORAGE_REPLICA_URLS"`
+	// StorageReplicaFailover makes reads of chunk objects fall back to the
+	// replicas when the primary object store errors.
+	StorageReplicaFailover bool `env:"STORAGE_REPLICA_FAILOVER,default=false"`
+	// StorageReplicationPeriod is the number of seconds between passes of the
+	// chunk replicator.
+	StorageReplicationPeriod int64 `env:"STORAGE_REPLICATION_PERIOD,default=10"`
+	// StorageGCDeleteRateLimit is the maximum number of objects per second the
+	// chunk garbage collector deletes, which is unlimited when it is 0.
+	StorageGCDeleteRateLimit int64 `env:"STORAGE_GC_DELETE_RATE_LIMIT,default=0"`
+	// StorageGCTrashPeriod is the number of seconds chunk objects deleted by
+	// the garbage collector are kept under the trash/ prefix of the object
+	// store before they are purged. Objects are deleted directly when it is 0.
+	StorageGCTrashPeriod int64 `env:"STORAGE_GC_TRASH_PERIOD,default=0"`
+	// StorageScrubPeriod is the number of seconds between passes of the chunk
+	// scrubber, which is disabled when it is 0.
+	StorageScrubPeriod int64 `env:"STORAGE_SCRUB_PERIOD,default=0"`
+	// StorageScrubRateLimit is the number of bytes per second the chunk
+	// scrubber reads from object storage, which is unlimited when it is 0.
+	StorageScrubRateLimit int64 `env:"STORAGE_SCRUB_RATE_LIMIT,default=10000000"`
+	// StorageCompactionDeferSize is the number of bytes a commit must add for
+	// its compaction to be deferred to the background, which is disabled when
+	// it is 0. Repos can override it in their compaction spec.
+	StorageCompactionDeferSize int64 `env:"STORAGE_COMPACTION_DEFER_SIZE,default=0"`
+	// StorageCompactionWindow is the daily window, as "HH:MM-HH:MM" in UTC,
+	// in which deferred compactions run. They run at any time when it is
+	// empty. Repos can override it in their compaction spec.
+	StorageCompactionWindow string `env:"STORAGE_COMPACTION_WINDOW"`
+	// StorageFaultInjection configures faults (errors, latency, truncated
+	// reads, delayed visibility) that are injected into object storage
+	// operations, in the format parsed by obj.ParseFaultConfig. It is only
+	// intended for testing, and no faults are injected when it is empty.
+	StorageFaultInjection string `env:"STORAGE_FAULT_INJECTION"`
+}
+
+// ReplicaURLs returns the URLs of the object stores that chunk objects are
+// replicated to.
+func (conf *StorageConfiguration) ReplicaURLs() []string {
+	var urls []string
+	for _, u := range strings.Split(conf.StorageReplicaURLs, ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
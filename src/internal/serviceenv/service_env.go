@@ -22,6 +22,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/identity"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
@@ -48,6 +49,7 @@ type ServiceEnv interface {
 	PfsServer() pfs_server.APIServer
 	PpsServer() pps_server.APIServer
 	EnterpriseServer() enterprise_server.APIServer
+	IdentityServer() identity.APIServer
 	SetAuthServer(auth_server.APIServer)
 	SetPfsServer(pfs_server.APIServer)
 	SetPpsServer(pps_server.APIServer)
@@ -125,6 +127,7 @@ type NonblockingServiceEnv struct {
 	ppsServer        pps_server.APIServer
 	pfsServer        pfs_server.APIServer
 	enterpriseServer enterprise_server.APIServer
+	identityServer   identity.APIServer
 
 	// ctx is the background context for the environment that will be canceled
 	// when the ServiceEnv is closed - this typically only happens for orderly
@@ -543,3 +546,13 @@ func (env *NonblockingServiceEnv) EnterpriseServer() enterprise_server.APIServer
 func (env *NonblockingServiceEnv) SetEnterpriseServer(s enterprise_server.APIServer) {
 	env.enterpriseServer = s
 }
+
+// IdentityServer returns the registered Identity APIServer
+func (env *NonblockingServiceEnv) IdentityServer() identity.APIServer {
+	return env.identityServer
+}
+
+// SetIdentityServer registers an Identity APIServer with this service env
+func (env *NonblockingServiceEnv) SetIdentityServer(s identity.APIServer) {
+	env.identityServer = s
+}
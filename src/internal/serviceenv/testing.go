@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/identity"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
@@ -47,6 +48,9 @@ type TestServiceEnv struct {
 	// Enterprise is the registered pfs APIServer
 	Enterprise enterprise_server.APIServer
 
+	// Identity is the registered identity APIServer
+	Identity identity.APIServer
+
 	// Ready is a channel that blocks `GetPachClient` until it's closed.
 	// This avoids a race when we need to instantiate the server before
 	// getting a client pointing at the same server.
@@ -158,3 +162,13 @@ func (env *TestServiceEnv) EnterpriseServer() enterprise_server.APIServer {
 func (env *TestServiceEnv) SetEnterpriseServer(s enterprise_server.APIServer) {
 	env.Enterprise = s
 }
+
+// IdentityServer returns the registered Identity APIServer
+func (env *TestServiceEnv) IdentityServer() identity.APIServer {
+	return env.Identity
+}
+
+// SetIdentityServer sets the registered Identity APIServer
+func (env *TestServiceEnv) SetIdentityServer(s identity.APIServer) {
+	env.Identity = s
+}
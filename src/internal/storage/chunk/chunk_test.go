@@ -71,6 +71,86 @@ func BenchmarkComputeChunks(b *testing.B) {
 	}
 }
 
+func BenchmarkComputeChunksFastCDC(b *testing.B) {
+	seed := time.Now().UTC().UnixNano()
+	random := rand.New(rand.NewSource(seed))
+	data := randutil.Bytes(random, 100*units.MB)
+	config := DefaultChunkingConfig()
+	config.Algo = FastCDC
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, config.ComputeChunks(bytes.NewReader(data), func(_ []byte) error { return nil }))
+	}
+}
+
+func TestComputeChunks(t *testing.T) {
+	seed := time.Now().UTC().UnixNano()
+	random := rand.New(rand.NewSource(seed))
+	data := randutil.Bytes(random, 10*units.MB)
+	for _, algo := range []ChunkingAlgo{Buzhash64, FastCDC} {
+		t.Run(algo.String(), func(t *testing.T) {
+			config := &ChunkingConfig{
+				Algo:        algo,
+				AverageSize: 64 * units.KiB,
+				MinSize:     16 * units.KiB,
+				MaxSize:     256 * units.KiB,
+			}
+			require.NoError(t, config.Validate())
+			var chunks [][]byte
+			require.NoError(t, config.ComputeChunks(bytes.NewReader(data), func(chunk []byte) error {
+				chunks = append(chunks, append([]byte{}, chunk...))
+				return nil
+			}), "seed: %v", seed)
+			require.True(t, len(chunks) > 1, "seed: %v", seed)
+			for i, chunk := range chunks {
+				require.True(t, int64(len(chunk)) <= config.MaxSize, "seed: %v", seed)
+				if i < len(chunks)-1 {
+					require.True(t, int64(len(chunk)) >= config.MinSize, "seed: %v", seed)
+				}
+			}
+			require.Equal(t, data, bytes.Join(chunks, nil), "seed: %v", seed)
+			// Chunk boundaries are content defined, so an edit at the start of
+			// the data should leave most of the later chunks unchanged.
+			edited := append([]byte("edit"), data...)
+			seen := make(map[string]struct{})
+			for _, chunk := range chunks {
+				seen[string(chunk)] = struct{}{}
+			}
+			var shared int
+			require.NoError(t, config.ComputeChunks(bytes.NewReader(edited), func(chunk []byte) error {
+				if _, ok := seen[string(chunk)]; ok {
+					shared++
+				}
+				return nil
+			}), "seed: %v", seed)
+			require.True(t, shared >= len(chunks)/2, "seed: %v", seed)
+		})
+	}
+}
+
+func TestChunkingConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultChunkingConfig().Validate())
+	config := DefaultChunkingConfig()
+	config.AverageSize = 3 * units.MiB
+	require.YesError(t, config.Validate())
+	config = DefaultChunkingConfig()
+	config.MinSize = config.AverageSize * 2
+	require.YesError(t, config.Validate())
+	config = DefaultChunkingConfig()
+	config.MaxSize = config.AverageSize / 2
+	require.YesError(t, config.Validate())
+	config = DefaultChunkingConfig()
+	config.MaxSize = MaxChunkSizeLimit
+	require.NoError(t, config.Validate())
+	config.MaxSize = MaxChunkSizeLimit + 1
+	require.YesError(t, config.Validate())
+	config = DefaultChunkingConfig()
+	config.AverageSize = 1 << 27 // larger than MaxChunkSizeLimit
+	config.MaxSize = config.AverageSize
+	require.YesError(t, config.Validate())
+}
+
 // newTestStorage is like NewTestStorage except it doesn't need an external tracker
 // it is for testing this package, not for reuse.
 //func newTestStorage(t testing.TB) (obj.Client, *Storage) {
@@ -2,10 +2,12 @@ package chunk
 
 import (
 	"io"
+	"math/bits"
 
 	"github.com/chmduquesne/rollinghash/buzhash64"
 	units "github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
 const (
@@ -22,15 +24,127 @@ const (
 	DefaultSeed         = 1
 	DefaultMinChunkSize = 1 * units.MB
 	DefaultMaxChunkSize = 20 * units.MB
+	// MaxChunkSizeLimit is the largest max chunk size a repo may configure, as
+	// every chunk is held in memory while it is uploaded and downloaded.
+	MaxChunkSizeLimit = 4 * DefaultMaxChunkSize
 )
 
-// TODO: Expose configuration.
+// ChunkingAlgo is a content-defined chunking algorithm.
+type ChunkingAlgo int
+
+const (
+	// Buzhash64 splits content where a buzhash64 rolling hash over a 64 byte
+	// window matches a mask.
+	Buzhash64 ChunkingAlgo = iota
+	// FastCDC splits content with a gear hash and normalized chunking, which
+	// is faster than buzhash64 and produces chunk sizes closer to the average.
+	FastCDC
+)
+
+func (a ChunkingAlgo) String() string {
+	switch a {
+	case Buzhash64:
+		return "buzhash64"
+	case FastCDC:
+		return "fastcdc"
+	default:
+		return "unknown"
+	}
+}
+
+// ChunkingConfig configures how content is split into chunks.
+type ChunkingConfig struct {
+	Algo ChunkingAlgo
+	// AverageSize is the expected chunk size, it must be a power of two.
+	AverageSize int64
+	MinSize     int64
+	MaxSize     int64
+}
+
+// DefaultChunkingConfig returns the chunking configuration used when a repo
+// doesn't configure its own.
+func DefaultChunkingConfig() *ChunkingConfig {
+	return &ChunkingConfig{
+		Algo:        Buzhash64,
+		AverageSize: 1 << DefaultAverageBits,
+		MinSize:     DefaultMinChunkSize,
+		MaxSize:     DefaultMaxChunkSize,
+	}
+}
+
+// Validate checks that the chunking configuration is usable.
+func (c *ChunkingConfig) Validate() error {
+	if c.Algo != Buzhash64 && c.Algo != FastCDC {
+		return errors.Errorf("unknown chunking algorithm %d", c.Algo)
+	}
+	if c.AverageSize < WindowSize || bits.OnesCount64(uint64(c.AverageSize)) != 1 {
+		return errors.Errorf("average chunk size (%d) must be a power of two of at least %d", c.AverageSize, WindowSize)
+	}
+	if c.MinSize < 0 || c.MinSize > c.AverageSize {
+		return errors.Errorf("min chunk size (%d) must be between 0 and the average chunk size (%d)", c.MinSize, c.AverageSize)
+	}
+	if c.MaxSize < c.AverageSize || c.MaxSize > MaxChunkSizeLimit {
+		return errors.Errorf("max chunk size (%d) must be between the average chunk size (%d) and %d", c.MaxSize, c.AverageSize, MaxChunkSizeLimit)
+	}
+	return nil
+}
+
+func (c *ChunkingConfig) averageBits() int {
+	return bits.TrailingZeros64(uint64(c.AverageSize))
+}
+
+// ChunkingConfigFromSpec converts a repo's chunking spec to a chunking configuration,
+// filling in unset fields from the defaults. A nil spec returns a nil
+// configuration, which means the default chunking.
+func ChunkingConfigFromSpec(spec *pfs.ChunkingSpec) (*ChunkingConfig, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	config := DefaultChunkingConfig()
+	switch spec.Algorithm {
+	case pfs.ChunkingSpec_DEFAULT, pfs.ChunkingSpec_BUZHASH64:
+		config.Algo = Buzhash64
+	case pfs.ChunkingSpec_FASTCDC:
+		config.Algo = FastCDC
+	default:
+		return nil, errors.Errorf("unknown chunking algorithm %v", spec.Algorithm)
+	}
+	if spec.AverageSizeBytes > 0 {
+		config.AverageSize = spec.AverageSizeBytes
+	}
+	if spec.MinSizeBytes > 0 {
+		config.MinSize = spec.MinSizeBytes
+	} else if config.MinSize > config.AverageSize {
+		config.MinSize = config.AverageSize / 4
+	}
+	if spec.MaxSizeBytes > 0 {
+		config.MaxSize = spec.MaxSizeBytes
+	} else if config.MaxSize < config.AverageSize {
+		config.MaxSize = config.AverageSize * 4
+	}
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid chunking spec")
+	}
+	return config, nil
+}
+
+// ComputeChunks splits the content of r into chunks with the default
+// chunking configuration.
 func ComputeChunks(r io.Reader, cb func([]byte) error) error {
+	return DefaultChunkingConfig().ComputeChunks(r, cb)
+}
+
+// ComputeChunks splits the content of r into chunks, calling cb with each chunk.
+func (c *ChunkingConfig) ComputeChunks(r io.Reader, cb func([]byte) error) error {
+	var s splitter
+	switch c.Algo {
+	case FastCDC:
+		s = newFastCDCSplitter(c)
+	default:
+		s = newBuzhashSplitter(c)
+	}
 	buf := make([]byte, units.MB)
 	var chunkBuf []byte
-	hash := buzhash64.NewFromUint64Array(buzhash64.GenerateHashes(DefaultSeed))
-	resetHash(hash)
-	splitMask := uint64((1 << uint64(DefaultAverageBits)) - 1)
 	for {
 		n, err := r.Read(buf)
 		if err != nil && !errors.Is(err, io.EOF) {
@@ -39,23 +153,11 @@ func ComputeChunks(r io.Reader, cb func([]byte) error) error {
 		data := buf[:n]
 		for _, b := range data {
 			chunkBuf = append(chunkBuf, b)
-			if len(chunkBuf) >= DefaultMaxChunkSize {
-				if err := cb(chunkBuf); err != nil {
-					return err
-				}
-				resetHash(hash)
-				chunkBuf = nil
-				continue
-			}
-			hash.Roll(b)
-			if hash.Sum64()&splitMask == 0 {
-				if len(chunkBuf) < DefaultMinChunkSize {
-					continue
-				}
+			if int64(len(chunkBuf)) >= c.MaxSize || s.split(b, int64(len(chunkBuf))) {
 				if err := cb(chunkBuf); err != nil {
 					return err
 				}
-				resetHash(hash)
+				s.reset()
 				chunkBuf = nil
 			}
 		}
@@ -65,7 +167,100 @@ func ComputeChunks(r io.Reader, cb func([]byte) error) error {
 	}
 }
 
+// splitter decides where to split content into chunks.
+type splitter interface {
+	// split is called with each byte of a chunk and the size of the chunk
+	// so far, and returns true if the chunk should end after the byte.
+	split(b byte, size int64) bool
+	// reset is called at the start of each chunk.
+	reset()
+}
+
+type buzhashSplitter struct {
+	hash      *buzhash64.Buzhash64
+	splitMask uint64
+	minSize   int64
+}
+
+func newBuzhashSplitter(c *ChunkingConfig) *buzhashSplitter {
+	s := &buzhashSplitter{
+		hash:      buzhash64.NewFromUint64Array(buzhash64.GenerateHashes(DefaultSeed)),
+		splitMask: uint64((1 << uint64(c.averageBits())) - 1),
+		minSize:   c.MinSize,
+	}
+	s.reset()
+	return s
+}
+
+func (s *buzhashSplitter) split(b byte, size int64) bool {
+	s.hash.Roll(b)
+	return s.hash.Sum64()&s.splitMask == 0 && size >= s.minSize
+}
+
+func (s *buzhashSplitter) reset() {
+	resetHash(s.hash)
+}
+
 func resetHash(hash *buzhash64.Buzhash64) {
 	hash.Reset()
 	hash.Write(initialWindow)
 }
+
+// gearTable is the table of random values used by the FastCDC gear hash.
+var gearTable = func() [256]uint64 {
+	var table [256]uint64
+	// splitmix64, seeded so that chunk boundaries are stable across processes.
+	x := uint64(DefaultSeed)
+	for i := range table {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return table
+}()
+
+// fastCDCSplitter implements FastCDC with normalized chunking (level 2): a
+// harder to match mask is used before the average size and an easier one
+// after it, which narrows the distribution of chunk sizes.
+type fastCDCSplitter struct {
+	hash                 uint64
+	maskSmall, maskLarge uint64
+	minSize, averageSize int64
+}
+
+func newFastCDCSplitter(c *ChunkingConfig) *fastCDCSplitter {
+	bits := c.averageBits()
+	return &fastCDCSplitter{
+		maskSmall:   highMask(bits + 2),
+		maskLarge:   highMask(bits - 2),
+		minSize:     c.MinSize,
+		averageSize: c.AverageSize,
+	}
+}
+
+// highMask returns a mask of the n high bits, which depend on the most
+// bytes of the gear hash.
+func highMask(n int) uint64 {
+	if n <= 0 {
+		return 0
+	}
+	return ^uint64(0) << (64 - n)
+}
+
+func (s *fastCDCSplitter) split(b byte, size int64) bool {
+	// Hashing is skipped for the bytes before the min size.
+	if size <= s.minSize {
+		return false
+	}
+	s.hash = (s.hash << 1) + gearTable[b]
+	if size < s.averageSize {
+		return s.hash&s.maskSmall == 0
+	}
+	return s.hash&s.maskLarge == 0
+}
+
+func (s *fastCDCSplitter) reset() {
+	s.hash = 0
+}
@@ -160,6 +160,34 @@ func (c *trackedClient) Close() error {
 	return nil
 }
 
+var _ Client = &cachedClient{}
+
+// cachedClient is a client that caches the chunk objects it gets.
+type cachedClient struct {
+	Client
+	cache kv.GetPut
+}
+
+func newCachedClient(client Client, cache kv.GetPut) Client {
+	return &cachedClient{Client: client, cache: cache}
+}
+
+// Get gets the object for a chunk from the cache, falling back to the client.
+// Chunks are content addressed, so the cached object is the same whichever
+// generation of the object it was read from.
+func (c *cachedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) error {
+	err := c.cache.Get(ctx, chunkID, cb)
+	if !pacherr.IsNotExist(err) {
+		return errors.EnsureStack(err)
+	}
+	return c.Client.Get(ctx, chunkID, func(data []byte) error {
+		// A failure to write to the cache (e.g. because its disk is full)
+		// shouldn't fail the read.
+		_ = c.cache.Put(ctx, chunkID, data)
+		return cb(data)
+	})
+}
+
 // Check checks that there are entries for the chunk and they have successfully uploaded objects.
 func (c *trackedClient) Check(ctx context.Context, id ID, readChunk bool) error {
 	_, last, err := c.CheckEntries(ctx, id, 1, readChunk)
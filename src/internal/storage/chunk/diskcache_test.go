@@ -0,0 +1,56 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestDiskCache(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	cache, err := kv.NewDiskCache(t.TempDir(), 1e9)
+	require.NoError(t, err)
+	oc, s := NewTestStorage(t, db, tracker, WithDiskCache(cache))
+
+	data, err := io.ReadAll(io.LimitReader(rand.New(rand.NewSource(10)), 2e7))
+	require.NoError(t, err)
+	var dataRefs []*DataRef
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	buf := &bytes.Buffer{}
+	require.NoError(t, s.NewReader(ctx, dataRefs).Get(buf))
+	require.Equal(t, data, buf.Bytes())
+
+	// The cache holds the encrypted objects, keyed by chunk ID.
+	for _, dataRef := range dataRefs {
+		require.NoError(t, cache.Get(ctx, dataRef.Ref.Id, func(ctext []byte) error {
+			return verifyData(dataRef.Ref.Id, ctext)
+		}))
+	}
+
+	// Reads are served from the cache when the objects are missing.
+	require.NoError(t, oc.Walk(ctx, prefix, func(name string) error {
+		if strings.HasPrefix(name, prefix+"/") {
+			return oc.Delete(ctx, name)
+		}
+		return nil
+	}))
+	s2 := NewStorage(oc, kv.NewMemCache(10), db, tracker, WithDiskCache(cache))
+	buf.Reset()
+	require.NoError(t, s2.NewReader(ctx, dataRefs).Get(buf))
+	require.Equal(t, data, buf.Bytes())
+}
@@ -2,22 +2,96 @@ package chunk
 
 import (
 	"context"
+	"path"
+	"strings"
 	"time"
 
-	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 )
 
+const trashPrefix = "trash"
+
+var (
+	gcDeletedChunksMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_gc",
+		Name:      "deleted_chunks_total",
+		Help:      "Number of chunk objects deleted, or moved to the trash, by the garbage collector",
+	})
+	gcPurgedChunksMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_gc",
+		Name:      "purged_chunks_total",
+		Help:      "Number of chunk objects purged from the trash by the garbage collector",
+	})
+)
+
+// SetupPostgresTrashV0 sets up the table that tracks the chunk objects moved
+// to the trash by the garbage collector.
+func SetupPostgresTrashV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE storage.chunk_trash (
+		chunk_id BYTEA NOT NULL,
+		gen BIGINT NOT NULL,
+		size INT8 NOT NULL,
+		trashed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+		PRIMARY KEY(chunk_id, gen)
+	);
+	CREATE INDEX ON storage.chunk_trash (trashed_at);
+	`)
+	return errors.EnsureStack(err)
+}
+
 // GarbageCollector removes unused chunks from object storage
 type GarbageCollector struct {
 	s      *Storage
 	log    *logrus.Logger
 	period time.Duration
+	// deleteRateLimit is the maximum number of objects deleted per second,
+	// 0 means no limit.
+	deleteRateLimit int64
+	// trashPeriod is how long deleted objects are kept in the trash before
+	// they are purged, 0 means objects are deleted directly.
+	trashPeriod time.Duration
+}
+
+// GCOption configures a garbage collector.
+type GCOption func(gc *GarbageCollector)
+
+// WithDeleteRateLimit limits the number of objects the garbage collector
+// deletes per second.
+func WithDeleteRateLimit(objectsPerSecond int64) GCOption {
+	return func(gc *GarbageCollector) {
+		gc.deleteRateLimit = objectsPerSecond
+	}
+}
+
+// WithTrash makes the garbage collector move objects to the trash, where they
+// are kept for period before they are purged. Objects in the trash can be
+// copied back to recover from deleting live chunks.
+func WithTrash(period time.Duration) GCOption {
+	return func(gc *GarbageCollector) {
+		gc.trashPeriod = period
+	}
 }
 
 // NewGC returns a new garbage collector operating on s
-func NewGC(s *Storage, d time.Duration, log *logrus.Logger) *GarbageCollector {
-	return &GarbageCollector{s: s, log: log, period: d}
+func NewGC(s *Storage, d time.Duration, log *logrus.Logger, opts ...GCOption) *GarbageCollector {
+	gc := &GarbageCollector{s: s, log: log, period: d}
+	for _, opt := range opts {
+		opt(gc)
+	}
+	return gc
 }
 
 // RunForever calls RunOnce until the context is cancelled, logging any errors.
@@ -25,7 +99,7 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	ticker := time.NewTicker(gc.period)
 	defer ticker.Stop()
 	for {
-		if err := gc.RunOnce(ctx); err != nil {
+		if _, err := gc.RunOnce(ctx); err != nil && !errors.Is(err, track.ErrGCPaused) {
 			select {
 			case <-ctx.Done():
 				return err
@@ -41,48 +115,225 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	}
 }
 
-// RunOnce runs 1 cycle of garbage collection.
-func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
+// RunOnce runs 1 cycle of garbage collection, and returns the number of chunk
+// objects deleted.
+// It does nothing while garbage collection is paused, and stops with
+// track.ErrGCPaused if garbage collection is paused during the cycle.
+func (gc *GarbageCollector) RunOnce(ctx context.Context) (_ int, retErr error) {
+	if paused, err := track.IsGCPaused(ctx, gc.s.db); err != nil {
+		return 0, err
+	} else if paused {
+		gc.log.Debug("skipping chunk GC, garbage collection is paused")
+		return 0, nil
+	}
+	t := newThrottle(gc.deleteRateLimit)
+	if err := gc.purgeTrash(ctx, t); err != nil {
+		return 0, err
+	}
 	rows, err := gc.s.db.QueryxContext(ctx, `
 	SELECT chunk_id, gen, uploaded FROM storage.chunk_objects
 	WHERE tombstone = true
 	`)
 	if err != nil {
-		return errors.EnsureStack(err)
+		return 0, errors.EnsureStack(err)
 	}
 	defer func() {
 		if err := rows.Close(); retErr == nil {
 			retErr = err
 		}
 	}()
+	var count int
 	for rows.Next() {
 		var ent Entry
 		if err := rows.StructScan(&ent); err != nil {
-			return errors.EnsureStack(err)
+			return count, errors.EnsureStack(err)
 		}
 		if !ent.Uploaded {
 			gc.log.Warnf("possibility for untracked chunk %s", chunkPath(ent.ChunkID, ent.Gen))
 		}
+		if err := track.CheckGCPaused(ctx, gc.s.db); err != nil {
+			return count, err
+		}
+		if err := t.wait(ctx, 1); err != nil {
+			return count, err
+		}
 		if err := gc.deleteOne(ctx, ent); err != nil {
-			return err
+			return count, err
 		}
+		count++
+		gcDeletedChunksMetric.Inc()
 		gc.log.WithFields(logrus.Fields{
 			"chunk_id": ent.ChunkID,
 			"gen":      ent.Gen,
 		}).Infof("deleting object for chunk entry")
 	}
-	return errors.EnsureStack(rows.Err())
+	return count, errors.EnsureStack(rows.Err())
 }
 
 func (gc *GarbageCollector) deleteOne(ctx context.Context, ent Entry) error {
-	if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen); err != nil {
+	if gc.trashPeriod > 0 {
+		if err := gc.trashObject(ctx, ent.ChunkID, ent.Gen); err != nil {
+			return err
+		}
+	} else if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen); err != nil {
 		return err
 	}
 	return gc.deleteEntry(ctx, ent.ChunkID, ent.Gen)
 }
 
 func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen uint64) error {
-	return errors.EnsureStack(gc.s.store.Delete(ctx, chunkKey(chunkID, gen)))
+	key := chunkKey(chunkID, gen)
+	for _, r := range gc.s.replicas {
+		if err := r.store.Delete(ctx, key); err != nil && !pacherr.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+	}
+	return errors.EnsureStack(gc.s.store.Delete(ctx, key))
+}
+
+// trashObject moves the object for a chunk entry to the trash. The copies in
+// the replicas are kept until the object is purged from the trash.
+func (gc *GarbageCollector) trashObject(ctx context.Context, chunkID ID, gen uint64) error {
+	key := chunkKey(chunkID, gen)
+	var size int64
+	var exists bool
+	if err := gc.s.rawObjClient.WalkInfo(ctx, string(key), func(info *obj.ObjectInfo) error {
+		if info.Name == string(key) {
+			size, exists = info.Size, true
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if !exists {
+		// There is nothing to move for entries that were never uploaded.
+		return nil
+	}
+	// The object is copied by the object store, rather than through pachd.
+	if err := gc.s.rawObjClient.Copy(ctx, string(key), string(trashKey(chunkID, gen))); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := gc.s.db.ExecContext(ctx, `
+	INSERT INTO storage.chunk_trash (chunk_id, gen, size) VALUES ($1, $2, $3)
+	ON CONFLICT DO NOTHING
+	`, chunkID, gen, size); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// Delete through the store, rather than the raw store, so that the object
+	// is also evicted from any cache in front of the object store.
+	return errors.EnsureStack(gc.s.store.Delete(ctx, key))
+}
+
+// purgeTrash deletes the objects which have been in the trash for longer than
+// the trash period, along with their copies in the replicas.
+func (gc *GarbageCollector) purgeTrash(ctx context.Context, t *throttle) error {
+	var ents []Entry
+	if err := gc.s.db.SelectContext(ctx, &ents, `
+	SELECT chunk_id, gen FROM storage.chunk_trash
+	WHERE trashed_at <= CURRENT_TIMESTAMP - $1 * interval '1 microsecond'
+	`, gc.trashPeriod.Microseconds()); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, ent := range ents {
+		if err := track.CheckGCPaused(ctx, gc.s.db); err != nil {
+			return err
+		}
+		if err := t.wait(ctx, 1); err != nil {
+			return err
+		}
+		for _, r := range gc.s.replicas {
+			if err := r.store.Delete(ctx, chunkKey(ent.ChunkID, ent.Gen)); err != nil && !pacherr.IsNotExist(err) {
+				return errors.EnsureStack(err)
+			}
+		}
+		if err := gc.s.rawStore.Delete(ctx, trashKey(ent.ChunkID, ent.Gen)); err != nil && !pacherr.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+		if _, err := gc.s.db.ExecContext(ctx, `
+		DELETE FROM storage.chunk_trash WHERE chunk_id = $1 AND gen = $2
+		`, ent.ChunkID, ent.Gen); err != nil {
+			return errors.EnsureStack(err)
+		}
+		gcPurgedChunksMetric.Inc()
+		gc.log.WithFields(logrus.Fields{
+			"chunk_id": ent.ChunkID,
+			"gen":      ent.Gen,
+		}).Infof("purging object for chunk entry from trash")
+	}
+	return nil
+}
+
+// RestoreTrash moves the objects for the given chunks out of the trash, back to
+// where they were before they were deleted, and tracks them again with ttl, so
+// that file sets can reference them. Everything in the trash is restored if
+// chunkIDs is empty. It returns the number and total size of the restored
+// objects.
+func (s *Storage) RestoreTrash(ctx context.Context, chunkIDs []ID, ttl time.Duration) (int64, int64, error) {
+	if ttl <= 0 {
+		ttl = defaultChunkTTL
+	}
+	var ents []struct {
+		ChunkID ID     `db:"chunk_id"`
+		Gen     uint64 `db:"gen"`
+		Size    int64  `db:"size"`
+	}
+	if len(chunkIDs) == 0 {
+		if err := s.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, gen, size FROM storage.chunk_trash
+		ORDER BY chunk_id, gen
+		`); err != nil {
+			return 0, 0, errors.EnsureStack(err)
+		}
+	} else {
+		var ids [][]byte
+		for _, id := range chunkIDs {
+			ids = append(ids, id)
+		}
+		if err := s.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, gen, size FROM storage.chunk_trash
+		WHERE chunk_id = ANY($1)
+		ORDER BY chunk_id, gen
+		`, ids); err != nil {
+			return 0, 0, errors.EnsureStack(err)
+		}
+	}
+	var count, size int64
+	for _, ent := range ents {
+		if err := s.restoreOne(ctx, ent.ChunkID, ent.Gen, ent.Size, ttl); err != nil {
+			return count, size, err
+		}
+		count++
+		size += ent.Size
+	}
+	return count, size, nil
+}
+
+func (s *Storage) restoreOne(ctx context.Context, chunkID ID, gen uint64, size int64, ttl time.Duration) error {
+	// The object is copied back before the entry is, so that an entry never
+	// refers to a missing object.
+	if err := s.rawObjClient.Copy(ctx, string(trashKey(chunkID, gen)), string(chunkKey(chunkID, gen))); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := dbutil.WithTx(ctx, s.db, func(tx *pachsql.Tx) error {
+		// A chunk which is still tracked is kept alive by whatever references
+		// it already.
+		if err := s.tracker.CreateTx(tx, chunkID.TrackerID(), nil, ttl); err != nil && !errors.Is(err, track.ErrDifferentObjectExists) {
+			return errors.EnsureStack(err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+		INSERT INTO storage.chunk_objects (chunk_id, gen, size, uploaded) VALUES ($1, $2, $3, TRUE)
+		ON CONFLICT (chunk_id, gen) DO UPDATE SET tombstone = FALSE
+		`, chunkID, gen, size); err != nil {
+			return errors.EnsureStack(err)
+		}
+		_, err := tx.ExecContext(ctx, `
+		DELETE FROM storage.chunk_trash WHERE chunk_id = $1 AND gen = $2
+		`, chunkID, gen)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return err
+	}
+	return errors.EnsureStack(s.rawStore.Delete(ctx, trashKey(chunkID, gen)))
 }
 
 func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uint64) error {
@@ -92,3 +343,101 @@ func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uin
 	`, chunkID, gen)
 	return errors.EnsureStack(err)
 }
+
+func trashKey(chunkID ID, gen uint64) []byte {
+	return []byte(path.Join(trashPrefix, chunkPath(chunkID, gen)))
+}
+
+// Garbage is a chunk object that garbage collection would delete.
+type Garbage struct {
+	ChunkID ID     `db:"chunk_id"`
+	Gen     uint64 `db:"gen"`
+	Size    int64  `db:"size"`
+	// Tombstone is true if the chunk is already marked for deletion,
+	// otherwise it is only referenced by expired tracker objects.
+	Tombstone bool `db:"tombstone"`
+}
+
+// GCReport describes what garbage collection would delete if it ran now.
+type GCReport struct {
+	// TrackerObjects is the number of tracker objects (file sets, chunks and
+	// temporary objects) that would be deleted.
+	TrackerObjects int64
+	// ChunkCount and ChunkBytes describe the chunk objects that would be
+	// deleted.
+	ChunkCount, ChunkBytes int64
+	// TrashCount and TrashBytes describe the chunk objects in the trash.
+	TrashCount, TrashBytes int64
+	Paused                 bool
+}
+
+// GCReport computes what garbage collection would delete if it ran until
+// there is nothing left to delete, calling cb with each chunk object that
+// would be deleted.
+func (s *Storage) GCReport(ctx context.Context, cb func(*Garbage) error) (*GCReport, error) {
+	report := &GCReport{}
+	paused, err := track.IsGCPaused(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	report.Paused = paused
+	emit := func(g *Garbage) error {
+		report.ChunkCount++
+		report.ChunkBytes += g.Size
+		return cb(g)
+	}
+	var chunkIDs []ID
+	if err := track.IterateGarbage(ctx, s.tracker, func(id string) error {
+		report.TrackerObjects++
+		if !strings.HasPrefix(id, TrackerPrefix) {
+			return nil
+		}
+		chunkID, err := ParseTrackerID(id)
+		if err != nil {
+			return err
+		}
+		chunkIDs = append(chunkIDs, chunkID)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	for _, chunkID := range chunkIDs {
+		var garbage []*Garbage
+		if err := s.db.SelectContext(ctx, &garbage, `
+		SELECT chunk_id, gen, size, tombstone FROM storage.chunk_objects
+		WHERE chunk_id = $1 AND tombstone = FALSE
+		ORDER BY gen
+		`, chunkID); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		for _, g := range garbage {
+			if err := emit(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tombstoned []*Garbage
+	if err := s.db.SelectContext(ctx, &tombstoned, `
+	SELECT chunk_id, gen, size, tombstone FROM storage.chunk_objects
+	WHERE tombstone = TRUE
+	ORDER BY chunk_id, gen
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	for _, g := range tombstoned {
+		if err := emit(g); err != nil {
+			return nil, err
+		}
+	}
+	var trash struct {
+		Count int64 `db:"count"`
+		Bytes int64 `db:"bytes"`
+	}
+	if err := s.db.GetContext(ctx, &trash, `
+	SELECT COUNT(*) AS count, COALESCE(SUM(size), 0) AS bytes FROM storage.chunk_trash
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	report.TrashCount, report.TrashBytes = trash.Count, trash.Bytes
+	return report, nil
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/sirupsen/logrus"
@@ -32,7 +34,241 @@ func TestGC(t *testing.T) {
 	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
 	require.NoError(t, err)
 	// run the tracker GC
-	deleter := track.DeleterMux(func(tid string) track.Deleter {
+	require.NoError(t, newTestTrackerGC(tracker, s).RunUntilEmpty(ctx))
+
+	// run the chunk GC
+	gc := NewGC(s, time.Minute, logrus.StandardLogger())
+	deleted, err := gc.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, count, deleted)
+
+	// make sure there are no objects
+	count, err = countObjects(ctx, oc)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestGCTrash(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+
+	writeRandom(t, s)
+	count, err := countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.True(t, count > 0)
+	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+
+	// The report lists every chunk object without deleting anything.
+	var garbage []*Garbage
+	report, err := s.GCReport(ctx, func(g *Garbage) error {
+		garbage = append(garbage, g)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, count, len(garbage))
+	require.Equal(t, int64(count), report.ChunkCount)
+	require.True(t, report.ChunkBytes > 0)
+	require.False(t, report.Paused)
+
+	// Nothing is deleted while garbage collection is paused.
+	require.NoError(t, track.SetGCPaused(ctx, db, true))
+	tgc := newTestTrackerGC(tracker, s)
+	require.NoError(t, tgc.RunUntilEmpty(ctx))
+	deleted, err := NewGC(s, time.Minute, logrus.StandardLogger()).RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, deleted)
+	n, err := countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, count, n)
+	report, err = s.GCReport(ctx, func(*Garbage) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, int64(count), report.ChunkCount)
+	require.True(t, report.Paused)
+
+	// Deleted objects are moved to the trash.
+	require.NoError(t, track.SetGCPaused(ctx, db, false))
+	require.NoError(t, tgc.RunUntilEmpty(ctx))
+	trashGC := NewGC(s, time.Minute, logrus.StandardLogger(), WithTrash(time.Hour), WithDeleteRateLimit(1000))
+	deleted, err = trashGC.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, count, deleted)
+	n, err = countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	n, err = countPrefix(ctx, oc, trashPrefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, count, n)
+	report, err = s.GCReport(ctx, func(*Garbage) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, int64(0), report.ChunkCount)
+	require.Equal(t, int64(count), report.TrashCount)
+
+	// Restoring the trash puts the objects and their entries back, until
+	// they expire again.
+	restored, _, err := s.RestoreTrash(ctx, nil, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, int64(count), restored)
+	n, err = countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, count, n)
+	n, err = countPrefix(ctx, oc, trashPrefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	var entries int
+	require.NoError(t, db.GetContext(ctx, &entries, `SELECT COUNT(*) FROM storage.chunk_objects WHERE uploaded AND NOT tombstone`))
+	require.Equal(t, count, entries)
+	require.NoError(t, tgc.RunUntilEmpty(ctx))
+	deleted, err = trashGC.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, deleted)
+	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+	require.NoError(t, tgc.RunUntilEmpty(ctx))
+	deleted, err = trashGC.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, count, deleted)
+
+	// Objects are purged from the trash after the trash period.
+	_, err = NewGC(s, time.Minute, logrus.StandardLogger()).RunOnce(ctx)
+	require.NoError(t, err)
+	n, err = countPrefix(ctx, oc, trashPrefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+}
+
+func TestGCFaults(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+
+	writeRandom(t, s)
+	count, err := countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.True(t, count > 0)
+	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+	require.NoError(t, newTestTrackerGC(tracker, s).RunUntilEmpty(ctx))
+
+	// The garbage collector runs against an object store which fails a fifth
+	// of the operations, and is rerun until a pass completes.
+	faultC := obj.NewFaultClient(oc, obj.FaultConfig{
+		Seed: 1,
+		ErrorRates: map[string]float64{
+			obj.FaultOpDelete: 0.2,
+			obj.FaultOpWalk:   0.2,
+			obj.FaultOpCopy:   0.2,
+		},
+		Latency: obj.FaultLatency{Max: time.Millisecond},
+	})
+	faultS := NewStorage(faultC, kv.NewMemCache(10), db, tracker)
+	runGC := func(gc *GarbageCollector) {
+		for {
+			_, err := gc.RunOnce(ctx)
+			if err == nil {
+				return
+			}
+			require.True(t, obj.IsFaultError(err), "unexpected error: %v", err)
+		}
+	}
+	runGC(NewGC(faultS, time.Minute, logrus.StandardLogger(), WithTrash(time.Hour)))
+	// Every object was moved to the trash exactly once.
+	n, err := countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	n, err = countPrefix(ctx, oc, trashPrefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, count, n)
+	report, err := s.GCReport(ctx, func(*Garbage) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, int64(count), report.TrashCount)
+
+	runGC(NewGC(faultS, time.Minute, logrus.StandardLogger()))
+	n, err = countObjects(ctx, oc)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+}
+
+func TestGCPause(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+
+	writeRandom(t, s)
+	count, err := countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.True(t, count > 1)
+	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+
+	// Pausing stops the tracker garbage collector in the middle of a pass.
+	var objects int
+	require.NoError(t, db.GetContext(ctx, &objects, `SELECT COUNT(*) FROM storage.tracker_objects`))
+	pausing := pausingDeleter{Deleter: newTestDeleter(s), ctx: ctx, db: db}
+	deleted, err := track.NewGarbageCollector(tracker, time.Minute, pausing).RunOnce(ctx)
+	require.YesError(t, err)
+	require.True(t, errors.Is(err, track.ErrGCPaused))
+	require.Equal(t, 1, deleted)
+	var remaining int
+	require.NoError(t, db.GetContext(ctx, &remaining, `SELECT COUNT(*) FROM storage.tracker_objects`))
+	require.Equal(t, objects-1, remaining)
+	require.NoError(t, track.SetGCPaused(ctx, db, false))
+	require.NoError(t, newTestTrackerGC(tracker, s).RunUntilEmpty(ctx))
+
+	// Pausing stops the chunk garbage collector in the middle of a pass.
+	pausingS := NewStorage(&pausingClient{Client: oc, db: db}, kv.NewMemCache(10), db, tracker)
+	deleted, err = NewGC(pausingS, time.Minute, logrus.StandardLogger()).RunOnce(ctx)
+	require.YesError(t, err)
+	require.True(t, errors.Is(err, track.ErrGCPaused))
+	require.Equal(t, 1, deleted)
+	n, err := countPrefix(ctx, oc, prefix+"/")
+	require.NoError(t, err)
+	require.Equal(t, count-1, n)
+
+	// The rest is deleted once garbage collection is resumed.
+	require.NoError(t, track.SetGCPaused(ctx, db, false))
+	deleted, err = NewGC(s, time.Minute, logrus.StandardLogger()).RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, count-1, deleted)
+}
+
+// pausingDeleter pauses garbage collection after deleting an object.
+type pausingDeleter struct {
+	track.Deleter
+	ctx context.Context
+	db  *pachsql.DB
+}
+
+func (d pausingDeleter) DeleteTx(tx *pachsql.Tx, id string) error {
+	if err := d.Deleter.DeleteTx(tx, id); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return track.SetGCPaused(d.ctx, d.db, true)
+}
+
+// pausingClient pauses garbage collection after deleting an object.
+type pausingClient struct {
+	obj.Client
+	db *pachsql.DB
+}
+
+func (c *pausingClient) Delete(ctx context.Context, name string) error {
+	if err := c.Client.Delete(ctx, name); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return track.SetGCPaused(ctx, c.db, true)
+}
+
+func newTestTrackerGC(tracker track.Tracker, s *Storage) *track.GarbageCollector {
+	return track.NewGarbageCollector(tracker, time.Minute, newTestDeleter(s))
+}
+
+func newTestDeleter(s *Storage) track.Deleter {
+	return track.DeleterMux(func(tid string) track.Deleter {
 		switch {
 		case strings.HasPrefix(tid, TrackerPrefix):
 			return s.NewDeleter()
@@ -42,17 +278,19 @@ func TestGC(t *testing.T) {
 			return nil
 		}
 	})
-	tgc := track.NewGarbageCollector(tracker, time.Minute, deleter)
-	require.NoError(t, tgc.RunUntilEmpty(ctx))
-
-	// run the chunk GC
-	gc := NewGC(s, time.Minute, logrus.StandardLogger())
-	require.NoError(t, gc.RunOnce(ctx))
+}
 
-	// make sure there are no objects
-	count, err = countObjects(ctx, oc)
-	require.NoError(t, err)
-	require.Equal(t, 0, count)
+func countPrefix(ctx context.Context, client obj.Client, p string) (int, error) {
+	var count int
+	if err := client.Walk(ctx, p, func(name string) error {
+		if strings.HasPrefix(name, p) {
+			count++
+		}
+		return nil
+	}); err != nil {
+		return -1, errors.EnsureStack(err)
+	}
+	return count, nil
 }
 
 func countObjects(ctx context.Context, client obj.Client) (int, error) {
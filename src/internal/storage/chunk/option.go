@@ -3,9 +3,11 @@ package chunk
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 )
 
@@ -27,6 +29,15 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithDiskCache caches the chunk objects read from object storage in cache,
+// keyed by chunk ID. The cached objects are encrypted, and are decrypted after
+// they are read like objects read from object storage.
+func WithDiskCache(cache kv.GetPut) StorageOption {
+	return func(s *Storage) {
+		s.diskCache = cache
+	}
+}
+
 // WithSecret sets the secret used to generate chunk encryption keys
 func WithSecret(secret []byte) StorageOption {
 	return func(s *Storage) {
@@ -41,6 +52,26 @@ func WithCompression(algo CompressionAlgo) StorageOption {
 	}
 }
 
+// WithReplica adds an object store, identified by name, that chunk objects
+// are asynchronously replicated to by a Replicator. The scrubber repairs
+// missing or corrupt objects from the replicas.
+func WithReplica(name string, objC obj.Client) StorageOption {
+	return func(s *Storage) {
+		s.replicas = append(s.replicas, &replica{
+			name:  name,
+			store: kv.NewFromObjectClient(objC),
+		})
+	}
+}
+
+// WithReplicaFailover makes reads of chunk objects fall back to the replicas
+// when reading from the primary object store fails.
+func WithReplicaFailover() StorageOption {
+	return func(s *Storage) {
+		s.failover = true
+	}
+}
+
 // StorageOptions returns the chunk storage options for the config.
 func StorageOptions(conf *serviceenv.StorageConfiguration) ([]StorageOption, error) {
 	var opts []StorageOption
@@ -55,9 +86,53 @@ func StorageOptions(conf *serviceenv.StorageConfiguration) ([]StorageOption, err
 		diskCache = obj.TracingObjClient("DiskCache", diskCache)
 		opts = append(opts, WithObjectCache(diskCache, conf.StorageDiskCacheSize))
 	}
+	if conf.StorageChunkCachePath != "" {
+		diskCache, err := kv.NewDiskCache(conf.StorageChunkCachePath, conf.StorageChunkCacheSize)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithDiskCache(diskCache))
+	}
+	for _, replicaURL := range conf.ReplicaURLs() {
+		url, err := obj.ParseURL(replicaURL)
+		if err != nil {
+			return nil, err
+		}
+		replica, err := obj.NewClientFromURLAndSecret(url)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithReplica(replicaURL, replica))
+	}
+	if conf.StorageReplicaFailover {
+		opts = append(opts, WithReplicaFailover())
+	}
 	return opts, nil
 }
 
+// GCOptions returns the chunk garbage collector options for the config.
+func GCOptions(conf *serviceenv.StorageConfiguration) []GCOption {
+	var opts []GCOption
+	if conf.StorageGCDeleteRateLimit > 0 {
+		opts = append(opts, WithDeleteRateLimit(conf.StorageGCDeleteRateLimit))
+	}
+	if conf.StorageGCTrashPeriod > 0 {
+		opts = append(opts, WithTrash(time.Second*time.Duration(conf.StorageGCTrashPeriod)))
+	}
+	return opts
+}
+
+// UploaderOption configures an uploader.
+type UploaderOption func(u *Uploader)
+
+// WithChunkingConfig sets the configuration used to split uploaded content
+// into chunks.
+func WithChunkingConfig(config *ChunkingConfig) UploaderOption {
+	return func(u *Uploader) {
+		u.chunking = config
+	}
+}
+
 type BatcherOption func(b *Batcher)
 
 func WithChunkCallback(cb ChunkFunc) BatcherOption {
@@ -0,0 +1,307 @@
+package chunk
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+)
+
+const (
+	// replicationStatusTTL is how long the replication status is cached for,
+	// since computing it scans the chunk objects.
+	replicationStatusTTL = 30 * time.Second
+)
+
+// replicationBatchSize is the number of pending chunk objects listed at a
+// time. It's a variable so that tests can page through small batches.
+var replicationBatchSize = 100
+
+var (
+	replicatedChunksMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_replication",
+		Name:      "chunks_total",
+		Help:      "Number of chunk objects copied to a replica",
+	}, []string{"replica"})
+	replicatedBytesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_replication",
+		Name:      "bytes_total",
+		Help:      "Number of bytes copied to a replica",
+	}, []string{"replica"})
+	replicationLagChunksMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_replication",
+		Name:      "lag_chunks",
+		Help:      "Number of chunk objects not yet copied to a replica",
+	}, []string{"replica"})
+	replicationLagSecondsMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_replication",
+		Name:      "lag_seconds",
+		Help:      "Age of the oldest chunk object not yet copied to a replica",
+	}, []string{"replica"})
+	failoverReadsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_replication",
+		Name:      "failover_reads_total",
+		Help:      "Number of chunk objects read from a replica because the primary object store errored",
+	}, []string{"replica"})
+)
+
+// SetupPostgresReplicasV0 sets up the table that tracks which chunk objects
+// have been copied to each replica.
+func SetupPostgresReplicasV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE storage.chunk_replicas (
+		chunk_id BYTEA NOT NULL,
+		gen BIGINT NOT NULL,
+		replica VARCHAR(4096) NOT NULL,
+		replicated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+		PRIMARY KEY(chunk_id, gen, replica),
+		FOREIGN KEY(chunk_id, gen) REFERENCES storage.chunk_objects(chunk_id, gen) ON DELETE CASCADE
+	);
+	CREATE INDEX ON storage.chunk_replicas (replica);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// replica is a secondary object store holding copies of the chunk objects.
+type replica struct {
+	name  string
+	store kv.Store
+}
+
+// ReplicaStatus is the replication status of a replica.
+type ReplicaStatus struct {
+	Name string
+	// ReplicatedChunks is the number of chunk objects copied to the replica.
+	ReplicatedChunks int64
+	// PendingChunks and PendingBytes describe the chunk objects not yet
+	// copied to the replica.
+	PendingChunks, PendingBytes int64
+	// Lag is the age of the oldest chunk object not yet copied to the replica.
+	Lag time.Duration
+}
+
+// replicationStatusCache is the most recently computed replication status.
+type replicationStatusCache struct {
+	mu       sync.Mutex
+	statuses []*ReplicaStatus
+	at       time.Time
+}
+
+// ReplicationStatus returns the replication status of each replica, as of at
+// most replicationStatusTTL ago.
+func (s *Storage) ReplicationStatus(ctx context.Context) ([]*ReplicaStatus, error) {
+	s.replicationStatus.mu.Lock()
+	defer s.replicationStatus.mu.Unlock()
+	if s.replicationStatus.statuses != nil && time.Since(s.replicationStatus.at) < replicationStatusTTL {
+		return s.replicationStatus.statuses, nil
+	}
+	return s.refreshReplicationStatus(ctx)
+}
+
+// refreshReplicationStatus computes the replication status of each replica,
+// and caches it. The cache's lock must be held.
+func (s *Storage) refreshReplicationStatus(ctx context.Context) ([]*ReplicaStatus, error) {
+	var statuses []*ReplicaStatus
+	for _, r := range s.replicas {
+		status := &ReplicaStatus{Name: r.name}
+		if err := s.db.GetContext(ctx, &status.ReplicatedChunks, `
+		SELECT COUNT(*) FROM storage.chunk_replicas WHERE replica = $1
+		`, r.name); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		var pending struct {
+			Count  int64           `db:"count"`
+			Bytes  sql.NullInt64   `db:"bytes"`
+			Oldest sql.NullFloat64 `db:"oldest"`
+		}
+		if err := s.db.GetContext(ctx, &pending, `
+		SELECT COUNT(*) AS count, SUM(size) AS bytes, EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - MIN(created_at)) AS oldest
+		FROM storage.chunk_objects co
+		WHERE uploaded = TRUE AND tombstone = FALSE AND NOT EXISTS (
+			SELECT 1 FROM storage.chunk_replicas cr
+			WHERE cr.chunk_id = co.chunk_id AND cr.gen = co.gen AND cr.replica = $1
+		)
+		`, r.name); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		status.PendingChunks = pending.Count
+		status.PendingBytes = pending.Bytes.Int64
+		status.Lag = time.Duration(pending.Oldest.Float64 * float64(time.Second))
+		replicationLagChunksMetric.WithLabelValues(r.name).Set(float64(status.PendingChunks))
+		replicationLagSecondsMetric.WithLabelValues(r.name).Set(status.Lag.Seconds())
+		statuses = append(statuses, status)
+	}
+	s.replicationStatus.statuses, s.replicationStatus.at = statuses, time.Now()
+	return statuses, nil
+}
+
+// Replicator asynchronously copies chunk objects from the primary object
+// store to the replicas.
+type Replicator struct {
+	s      *Storage
+	log    *logrus.Logger
+	period time.Duration
+}
+
+// NewReplicator returns a new replicator operating on s, which copies
+// pending chunk objects every period.
+func NewReplicator(s *Storage, period time.Duration, log *logrus.Logger) *Replicator {
+	return &Replicator{s: s, log: log, period: period}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (r *Replicator) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(r.period)
+	defer ticker.Stop()
+	for {
+		if err := r.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			r.log.Errorf("during chunk replication: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce copies all of the pending chunk objects to each replica.
+func (r *Replicator) RunOnce(ctx context.Context) error {
+	for _, rep := range r.s.replicas {
+		if err := r.replicate(ctx, rep); err != nil {
+			return errors.Wrapf(err, "replicating to %v", rep.name)
+		}
+	}
+	// The status is refreshed after every pass, to keep the lag metrics up
+	// to date.
+	r.s.replicationStatus.mu.Lock()
+	defer r.s.replicationStatus.mu.Unlock()
+	_, err := r.s.refreshReplicationStatus(ctx)
+	return err
+}
+
+func (r *Replicator) replicate(ctx context.Context, rep *replica) error {
+	// The pending entries are paged through in order, so that entries which
+	// can't be replicated, because their objects are missing, are skipped
+	// rather than listed again.
+	var after struct {
+		CreatedAt time.Time `db:"created_at"`
+		ChunkID   ID        `db:"chunk_id"`
+		Gen       uint64    `db:"gen"`
+	}
+	for {
+		var ents []struct {
+			Entry
+			CreatedAt time.Time `db:"created_at"`
+		}
+		if err := r.s.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, gen, uploaded, tombstone, created_at FROM storage.chunk_objects co
+		WHERE uploaded = TRUE AND tombstone = FALSE AND NOT EXISTS (
+			SELECT 1 FROM storage.chunk_replicas cr
+			WHERE cr.chunk_id = co.chunk_id AND cr.gen = co.gen AND cr.replica = $1
+		) AND (created_at, chunk_id, gen) > ($2, $3, $4)
+		ORDER BY created_at, chunk_id, gen
+		LIMIT $5
+		`, rep.name, after.CreatedAt, []byte(after.ChunkID), after.Gen, replicationBatchSize); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, ent := range ents {
+			if err := r.replicateOne(ctx, rep, ent.Entry); err != nil {
+				return err
+			}
+			after.CreatedAt, after.ChunkID, after.Gen = ent.CreatedAt, ent.ChunkID, ent.Gen
+		}
+		if len(ents) < replicationBatchSize {
+			return nil
+		}
+	}
+}
+
+func (r *Replicator) replicateOne(ctx context.Context, rep *replica, ent Entry) error {
+	key := chunkKey(ent.ChunkID, ent.Gen)
+	var data []byte
+	if err := r.s.rawStore.Get(ctx, key, func(x []byte) error {
+		data = append([]byte{}, x...)
+		return nil
+	}); err != nil {
+		if pacherr.IsNotExist(err) {
+			// The object may have been deleted by the garbage collector since
+			// the entry was listed.
+			r.log.WithFields(logrus.Fields{
+				"chunk_id": ent.ChunkID,
+				"gen":      ent.Gen,
+			}).Warnf("skipping replication of missing chunk object")
+			return nil
+		}
+		return errors.EnsureStack(err)
+	}
+	if err := rep.store.Put(ctx, key, data); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The entry may have been deleted since it was listed, in which case
+	// there is nothing to record.
+	if _, err := r.s.db.ExecContext(ctx, `
+	INSERT INTO storage.chunk_replicas (chunk_id, gen, replica)
+	SELECT chunk_id, gen, $3 FROM storage.chunk_objects WHERE chunk_id = $1 AND gen = $2
+	ON CONFLICT DO NOTHING
+	`, ent.ChunkID, ent.Gen, rep.name); err != nil {
+		return errors.EnsureStack(err)
+	}
+	replicatedChunksMetric.WithLabelValues(rep.name).Inc()
+	replicatedBytesMetric.WithLabelValues(rep.name).Add(float64(len(data)))
+	return nil
+}
+
+var _ kv.Store = &failoverStore{}
+
+// failoverStore reads from the replicas when reading from the primary store
+// fails. Writes only go to the primary store.
+type failoverStore struct {
+	kv.Store
+	replicas []*replica
+}
+
+func newFailoverStore(primary kv.Store, replicas []*replica) kv.Store {
+	return &failoverStore{Store: primary, replicas: replicas}
+}
+
+func (fs *failoverStore) Get(ctx context.Context, key []byte, cb kv.ValueCallback) error {
+	var cbErr error
+	wrapped := func(data []byte) error {
+		cbErr = cb(data)
+		return cbErr
+	}
+	err := fs.Store.Get(ctx, key, wrapped)
+	if err == nil || cbErr != nil || ctx.Err() != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, r := range fs.replicas {
+		if rErr := r.store.Get(ctx, key, wrapped); rErr == nil {
+			failoverReadsMetric.WithLabelValues(r.name).Inc()
+			return nil
+		} else if cbErr != nil {
+			return errors.EnsureStack(rErr)
+		}
+	}
+	return errors.EnsureStack(err)
+}
@@ -0,0 +1,122 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/sirupsen/logrus"
+)
+
+func TestReplication(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	replica1, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	replica2, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	oc, s := NewTestStorage(t, db, tracker,
+		WithReplica("replica1", replica1),
+		WithReplica("replica2", replica2),
+		WithReplicaFailover(),
+	)
+
+	const seed = 10
+	data, err := io.ReadAll(io.LimitReader(rand.New(rand.NewSource(seed)), 5e7))
+	require.NoError(t, err)
+	var dataRefs []*DataRef
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	countChunkObjects := func(client obj.Client) int {
+		var count int
+		require.NoError(t, client.Walk(ctx, prefix, func(name string) error {
+			if strings.HasPrefix(name, prefix+"/") {
+				count++
+			}
+			return nil
+		}))
+		return count
+	}
+	count := countChunkObjects(oc)
+	require.True(t, count > 0)
+
+	statuses, err := s.ReplicationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(statuses))
+	for _, status := range statuses {
+		require.Equal(t, int64(0), status.ReplicatedChunks)
+		require.True(t, status.PendingChunks > 0)
+	}
+
+	r := NewReplicator(s, time.Minute, logrus.StandardLogger())
+	require.NoError(t, r.RunOnce(ctx))
+	statuses, err = s.ReplicationStatus(ctx)
+	require.NoError(t, err)
+	for _, status := range statuses {
+		require.True(t, status.ReplicatedChunks > 0)
+		require.Equal(t, int64(0), status.PendingChunks)
+	}
+	require.Equal(t, count, countChunkObjects(replica1))
+	require.Equal(t, count, countChunkObjects(replica2))
+
+	// Reads fail over to the replicas when the primary is missing objects.
+	require.NoError(t, oc.Walk(ctx, prefix, func(name string) error {
+		if strings.HasPrefix(name, prefix+"/") {
+			return oc.Delete(ctx, name)
+		}
+		return nil
+	}))
+	buf := &bytes.Buffer{}
+	require.NoError(t, s.NewReader(ctx, dataRefs).Get(buf))
+	require.Equal(t, data, buf.Bytes())
+}
+
+func TestReplicationMissingObject(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	replica, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	oc, s := NewTestStorage(t, db, tracker, WithReplica("replica", replica))
+	// Page through small batches, so that a skipped entry would be listed
+	// again if replication didn't advance past it.
+	defer func(size int) { replicationBatchSize = size }(replicationBatchSize)
+	replicationBatchSize = 1
+
+	data, err := io.ReadAll(io.LimitReader(rand.New(rand.NewSource(10)), 2e7))
+	require.NoError(t, err)
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, _ []*DataRef) error { return nil })
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	var names []string
+	require.NoError(t, oc.Walk(ctx, prefix, func(name string) error {
+		if strings.HasPrefix(name, prefix+"/") {
+			names = append(names, name)
+		}
+		return nil
+	}))
+	require.True(t, len(names) > 1)
+	require.NoError(t, oc.Delete(ctx, names[0]))
+
+	r := NewReplicator(s, time.Minute, logrus.StandardLogger())
+	require.NoError(t, r.RunOnce(ctx))
+	statuses, err := s.ReplicationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(statuses))
+	require.Equal(t, int64(len(names)-1), statuses[0].ReplicatedChunks)
+	require.Equal(t, int64(1), statuses[0].PendingChunks)
+}
@@ -0,0 +1,298 @@
+package chunk
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+)
+
+const scrubBatchSize = 100
+
+var (
+	scrubChunksMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_scrub",
+		Name:      "chunks_total",
+		Help:      "Number of chunk objects verified by the scrubber",
+	})
+	scrubBytesMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_scrub",
+		Name:      "bytes_total",
+		Help:      "Number of bytes read from object storage by the scrubber",
+	})
+	scrubFindingsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_scrub",
+		Name:      "findings_total",
+		Help:      "Number of missing or corrupt chunk objects found by the scrubber",
+	}, []string{"kind"})
+	scrubRepairsMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_scrub",
+		Name:      "repairs_total",
+		Help:      "Number of chunk objects repaired from a replica by the scrubber",
+	})
+	scrubProgressMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_scrub",
+		Name:      "progress_ratio",
+		Help:      "Estimated fraction of the chunk ID space covered by the current scrubber pass",
+	})
+	scrubLastPassMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_scrub",
+		Name:      "last_pass_completed_timestamp_seconds",
+		Help:      "Time at which the last scrubber pass completed",
+	})
+)
+
+// ScrubOptions configures a scrub of the chunk objects.
+type ScrubOptions struct {
+	// Begin and End limit the scrub to chunks with IDs in [Begin, End).
+	// An empty End is ignored.
+	Begin, End []byte
+	// Repair copies missing or corrupt objects from the replicas.
+	Repair bool
+	// BytesPerSecond limits the rate at which objects are read, 0 means no
+	// limit.
+	BytesPerSecond int64
+}
+
+// ScrubFinding is a missing or corrupt chunk object found by a scrub.
+type ScrubFinding struct {
+	ChunkID ID
+	Gen     uint64
+	Missing bool
+	Err     error
+	// Repaired is true if the object was restored from a replica.
+	Repaired bool
+	// RepairErr is set if the object could not be restored.
+	RepairErr error
+}
+
+// Scrub reads each chunk object and verifies its hash, calling cb with each
+// object that is missing or corrupt. It returns the number of objects checked.
+func (s *Storage) Scrub(ctx context.Context, opts *ScrubOptions, cb func(*ScrubFinding) error) (int, error) {
+	t := newThrottle(opts.BytesPerSecond)
+	first := append([]byte{}, opts.Begin...)
+	var count int
+	for {
+		ents, err := s.scrubEntries(ctx, first, scrubBatchSize)
+		if err != nil {
+			return count, err
+		}
+		for _, ent := range ents {
+			if len(opts.End) > 0 && string(ent.ChunkID) >= string(opts.End) {
+				return count, nil
+			}
+			finding, err := s.scrubOne(ctx, t, ent)
+			if err != nil {
+				return count, err
+			}
+			count++
+			scrubChunksMetric.Inc()
+			scrubProgressMetric.Set(float64(ent.ChunkID[0]) / 256)
+			if finding == nil {
+				continue
+			}
+			if opts.Repair {
+				if err := s.repair(ctx, ent); err != nil {
+					finding.RepairErr = err
+				} else {
+					finding.Repaired = true
+					scrubRepairsMetric.Inc()
+				}
+			}
+			if err := cb(finding); err != nil {
+				return count, err
+			}
+		}
+		if len(ents) < scrubBatchSize {
+			return count, nil
+		}
+		first = keyAfter(ents[len(ents)-1].ChunkID)
+	}
+}
+
+func (s *Storage) scrubEntries(ctx context.Context, first []byte, limit int) ([]Entry, error) {
+	var ents []Entry
+	if err := s.db.SelectContext(ctx, &ents,
+		`SELECT chunk_id, gen, uploaded, tombstone FROM storage.chunk_objects
+		WHERE chunk_id >= $1 AND uploaded = true AND tombstone = false
+		ORDER BY chunk_id, gen
+		LIMIT $2
+	`, first, limit); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return ents, nil
+}
+
+// scrubOne verifies the object for a chunk entry, returning a finding if it
+// is missing or corrupt.
+func (s *Storage) scrubOne(ctx context.Context, t *throttle, ent Entry) (*ScrubFinding, error) {
+	var verifyErr error
+	err := s.rawStore.Get(ctx, chunkKey(ent.ChunkID, ent.Gen), func(data []byte) error {
+		scrubBytesMetric.Add(float64(len(data)))
+		verifyErr = verifyData(ent.ChunkID, data)
+		return t.wait(ctx, int64(len(data)))
+	})
+	if err != nil {
+		if !pacherr.IsNotExist(err) {
+			return nil, errors.EnsureStack(err)
+		}
+		// The object may have been deleted by the garbage collector since the
+		// entry was listed.
+		c := NewClient(s.store, s.db, s.tracker, nil).(*trackedClient)
+		if exists, err := c.entryExists(ctx, ent.ChunkID, ent.Gen); err != nil {
+			return nil, err
+		} else if !exists {
+			return nil, nil
+		}
+		scrubFindingsMetric.WithLabelValues("missing").Inc()
+		return &ScrubFinding{ChunkID: ent.ChunkID, Gen: ent.Gen, Missing: true, Err: newErrMissingObject(ent)}, nil
+	}
+	if verifyErr != nil {
+		scrubFindingsMetric.WithLabelValues("corrupt").Inc()
+		return &ScrubFinding{ChunkID: ent.ChunkID, Gen: ent.Gen, Err: verifyErr}, nil
+	}
+	return nil, nil
+}
+
+// repair copies the object for a chunk entry from the first replica with a
+// valid copy of it.
+func (s *Storage) repair(ctx context.Context, ent Entry) error {
+	if len(s.replicas) == 0 {
+		return errors.New("no replica configured")
+	}
+	key := chunkKey(ent.ChunkID, ent.Gen)
+	var data []byte
+	var errs []error
+	for _, r := range s.replicas {
+		if err := r.store.Get(ctx, key, func(x []byte) error {
+			if err := verifyData(ent.ChunkID, x); err != nil {
+				return errors.Wrap(err, "replica is corrupt")
+			}
+			data = append([]byte{}, x...)
+			return nil
+		}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "replica %v", r.name))
+			continue
+		}
+		break
+	}
+	if data == nil {
+		return errors.Errorf("no valid copy in any replica: %v", errs)
+	}
+	// Delete the bad object first so that it is also evicted from any cache
+	// in front of the object store.
+	if err := s.store.Delete(ctx, key); err != nil && !pacherr.IsNotExist(err) {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(s.store.Put(ctx, key, data))
+}
+
+// throttle limits the rate at which units (bytes, objects) are processed.
+type throttle struct {
+	perSecond int64
+	start     time.Time
+	n         int64
+}
+
+func newThrottle(perSecond int64) *throttle {
+	return &throttle{perSecond: perSecond, start: time.Now()}
+}
+
+// wait records that n units were processed and blocks until processing them
+// is within the rate limit.
+func (t *throttle) wait(ctx context.Context, n int64) error {
+	if t.perSecond <= 0 {
+		return nil
+	}
+	t.n += n
+	expected := time.Duration(float64(t.n) / float64(t.perSecond) * float64(time.Second))
+	delay := expected - time.Since(t.start)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return errors.EnsureStack(ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Scrubber continuously verifies the chunk objects in object storage,
+// repairing them from the replicas if any are configured.
+type Scrubber struct {
+	s              *Storage
+	log            *logrus.Logger
+	period         time.Duration
+	bytesPerSecond int64
+}
+
+// NewScrubber returns a new scrubber operating on s, which starts a pass
+// every period and reads at most bytesPerSecond.
+func NewScrubber(s *Storage, period time.Duration, bytesPerSecond int64, log *logrus.Logger) *Scrubber {
+	return &Scrubber{s: s, log: log, period: period, bytesPerSecond: bytesPerSecond}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (sc *Scrubber) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(sc.period)
+	defer ticker.Stop()
+	for {
+		if err := sc.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			sc.log.Errorf("during chunk scrub: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs 1 pass of the scrubber over all of the chunk objects.
+func (sc *Scrubber) RunOnce(ctx context.Context) error {
+	scrubProgressMetric.Set(0)
+	n, err := sc.s.Scrub(ctx, &ScrubOptions{
+		Repair:         len(sc.s.replicas) > 0,
+		BytesPerSecond: sc.bytesPerSecond,
+	}, func(f *ScrubFinding) error {
+		fields := logrus.Fields{
+			"chunk_id": f.ChunkID,
+			"gen":      f.Gen,
+		}
+		switch {
+		case f.Repaired:
+			sc.log.WithFields(fields).Warnf("repaired chunk object from replica: %v", f.Err)
+		case f.RepairErr != nil:
+			sc.log.WithFields(fields).Errorf("could not repair chunk object: %v: %v", f.Err, f.RepairErr)
+		default:
+			sc.log.WithFields(fields).Errorf("found bad chunk object: %v", f.Err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	scrubProgressMetric.Set(1)
+	scrubLastPassMetric.SetToCurrentTime()
+	sc.log.Infof("chunk scrub verified %d objects", n)
+	return nil
+}
@@ -0,0 +1,64 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestScrub(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	replica, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	oc, s := NewTestStorage(t, db, tracker, WithReplica("replica", replica))
+
+	writeRandom(t, s)
+	var names []string
+	require.NoError(t, oc.Walk(ctx, prefix, func(name string) error {
+		if strings.HasPrefix(name, prefix+"/") {
+			names = append(names, name)
+		}
+		return nil
+	}))
+	require.True(t, len(names) > 1)
+	for _, name := range names {
+		buf := &bytes.Buffer{}
+		require.NoError(t, oc.Get(ctx, name, buf))
+		require.NoError(t, replica.Put(ctx, name, buf))
+	}
+
+	scrub := func(repair bool) []*ScrubFinding {
+		var findings []*ScrubFinding
+		n, err := s.Scrub(ctx, &ScrubOptions{Repair: repair}, func(f *ScrubFinding) error {
+			findings = append(findings, f)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(names), n)
+		return findings
+	}
+	require.Equal(t, 0, len(scrub(false)))
+
+	// Corrupt one object and delete another.
+	require.NoError(t, oc.Put(ctx, names[0], strings.NewReader("bit rot")))
+	require.NoError(t, oc.Delete(ctx, names[1]))
+	findings := scrub(false)
+	require.Equal(t, 2, len(findings))
+	for _, f := range findings {
+		require.False(t, f.Repaired)
+	}
+	findings = scrub(true)
+	require.Equal(t, 2, len(findings))
+	for _, f := range findings {
+		require.True(t, f.Repaired)
+	}
+	require.Equal(t, 0, len(scrub(false)))
+}
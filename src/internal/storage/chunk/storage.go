@@ -24,20 +24,28 @@ const (
 // Storage is the abstraction that manages chunk storage.
 type Storage struct {
 	objClient     obj.Client
+	rawObjClient  obj.Client
 	db            *pachsql.DB
 	tracker       track.Tracker
 	store         kv.Store
+	rawStore      kv.Store
+	replicas      []*replica
+	failover      bool
 	memCache      kv.GetPut
+	diskCache     kv.GetPut
 	deduper       *miscutil.WorkDeduper
 	prefetchLimit int
 
 	createOpts CreateOptions
+
+	replicationStatus replicationStatusCache
 }
 
 // NewStorage creates a new Storage.
 func NewStorage(objC obj.Client, memCache kv.GetPut, db *pachsql.DB, tracker track.Tracker, opts ...StorageOption) *Storage {
 	s := &Storage{
 		objClient:     objC,
+		rawObjClient:  objC,
 		db:            db,
 		tracker:       tracker,
 		memCache:      memCache,
@@ -47,10 +55,17 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *pachsql.DB, tracker tra
 			Compression: CompressionAlgo_GZIP_BEST_SPEED,
 		},
 	}
+	// Scrubbing, replication and the garbage collector's trash access the
+	// object store directly, rather than through the caching and concurrency
+	// limits configured below.
+	s.rawStore = kv.NewFromObjectClient(objC)
 	for _, opt := range opts {
 		opt(s)
 	}
 	s.store = kv.NewFromObjectClient(s.objClient)
+	if s.failover && len(s.replicas) > 0 {
+		s.store = newFailoverStore(s.store, s.replicas)
+	}
 	s.objClient = nil
 	return s
 }
@@ -58,6 +73,9 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *pachsql.DB, tracker tra
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
 	client := NewClient(s.store, s.db, s.tracker, nil)
+	if s.diskCache != nil {
+		client = newCachedClient(client, s.diskCache)
+	}
 	return newReader(ctx, client, s.memCache, s.deduper, s.prefetchLimit, dataRefs, opts...)
 }
 
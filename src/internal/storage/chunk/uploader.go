@@ -27,25 +27,31 @@ type Uploader struct {
 	chunkSem  *semaphore.Weighted
 	noUpload  bool
 	cb        UploadFunc
+	chunking  *ChunkingConfig
 }
 
-func (s *Storage) NewUploader(ctx context.Context, name string, noUpload bool, cb UploadFunc) *Uploader {
+func (s *Storage) NewUploader(ctx context.Context, name string, noUpload bool, cb UploadFunc, opts ...UploaderOption) *Uploader {
 	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL))
-	return &Uploader{
+	u := &Uploader{
 		ctx:       ctx,
 		client:    client,
 		taskChain: NewTaskChain(ctx, semaphore.NewWeighted(taskParallelism)),
 		chunkSem:  semaphore.NewWeighted(chunkParallelism),
 		noUpload:  noUpload,
 		cb:        cb,
+		chunking:  DefaultChunkingConfig(),
 	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
 }
 
 // TODO: Need to think more about the context / error handling with the nested task chains.
 func (u *Uploader) Upload(meta interface{}, r io.Reader) error {
 	taskChain := NewTaskChain(u.ctx, u.chunkSem)
 	var dataRefs []*DataRef
-	if err := ComputeChunks(r, func(chunkBytes []byte) error {
+	if err := u.chunking.ComputeChunks(r, func(chunkBytes []byte) error {
 		return taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
 			dataRef, err := upload(ctx, u.client, chunkBytes, nil, u.noUpload)
 			if err != nil {
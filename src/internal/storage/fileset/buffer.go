@@ -5,6 +5,8 @@ import (
 	"io"
 	"sort"
 	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
 type Buffer struct {
@@ -16,6 +18,7 @@ type Buffer struct {
 type file struct {
 	path     string
 	datum    string
+	attrs    *index.FileAttributes
 	contents []fileContent
 }
 
@@ -49,8 +52,15 @@ func (b *Buffer) add(path, datum string) *file {
 	return datumFiles[datum]
 }
 
-func (b *Buffer) Add(path, datum string) io.Writer {
+func (b *Buffer) Add(path, datum string, opts ...FileOption) io.Writer {
 	f := b.add(path, datum)
+	idxFile := &index.File{}
+	for _, opt := range opts {
+		opt(idxFile)
+	}
+	if idxFile.Attributes != nil {
+		f.attrs = idxFile.Attributes
+	}
 	if len(f.contents) > 0 && f.contents[len(f.contents)-1].copy == nil {
 		return f.contents[len(f.contents)-1].buf
 	}
@@ -92,14 +102,14 @@ func (b *Buffer) Copy(file File, datum string) {
 	f.contents = append(f.contents, fileContent{copy: file})
 }
 
-func (b *Buffer) WalkAdditive(onAdd func(path, datum string, r io.Reader) error, onCopy func(file File, datum string) error) error {
+func (b *Buffer) WalkAdditive(onAdd func(path, datum string, r io.Reader, attrs *index.FileAttributes) error, onCopy func(file File, datum string) error) error {
 	for _, file := range sortFiles(b.additive) {
 		for _, content := range file.contents {
 			if content.copy != nil {
 				if err := onCopy(content.copy, file.datum); err != nil {
 					return err
 				}
-			} else if err := onAdd(file.path, file.datum, bytes.NewReader(content.buf.Bytes())); err != nil {
+			} else if err := onAdd(file.path, file.datum, bytes.NewReader(content.buf.Bytes()), file.attrs); err != nil {
 				return err
 			}
 		}
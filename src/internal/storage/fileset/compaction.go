@@ -22,6 +22,35 @@ func isCompacted(config *CompactionConfig, prims []*Primitive) bool {
 	return config.FixedDelay > int64(len(prims)) || indexOfCompacted(config.LevelFactor, prims) == len(prims)
 }
 
+// CompactionLevel is one of the primitive file sets in a composite file set,
+// which form the levels of a level-based compaction.
+type CompactionLevel struct {
+	ID        ID
+	SizeBytes int64
+}
+
+// CompactionLevels returns the levels of the file sets at ids, oldest first,
+// along with the number of leading levels that are in compacted form under
+// config.
+func (s *Storage) CompactionLevels(ctx context.Context, ids []ID, config *CompactionConfig) ([]CompactionLevel, int, error) {
+	ids, err := s.Flatten(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	prims, err := s.getPrimitives(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	var levels []CompactionLevel
+	for i, prim := range prims {
+		levels = append(levels, CompactionLevel{ID: ids[i], SizeBytes: prim.SizeBytes})
+	}
+	if isCompacted(config, prims) {
+		return levels, len(levels), nil
+	}
+	return levels, indexOfCompacted(config.LevelFactor, prims), nil
+}
+
 // indexOfCompacted returns the last value of i for which the "compacted relationship"
 // is maintained for all layers[:i+1]
 // the "compacted relationship" is defined as leftSize >= (rightSize * factor)
@@ -69,7 +98,11 @@ func (s *Storage) Compact(ctx context.Context, ids []ID, ttl time.Duration, opts
 type CompactCallback func(context.Context, []ID, time.Duration) (*ID, error)
 
 // CompactLevelBased performs a level-based compaction on the passed in filesets.
-func (s *Storage) CompactLevelBased(ctx context.Context, ids []ID, ttl time.Duration, compact CompactCallback) (*ID, error) {
+// The storage's compaction config is used if config is nil.
+func (s *Storage) CompactLevelBased(ctx context.Context, ids []ID, ttl time.Duration, config *CompactionConfig, compact CompactCallback) (*ID, error) {
+	if config == nil {
+		config = s.compactionConfig
+	}
 	ids, err := s.Flatten(ctx, ids)
 	if err != nil {
 		return nil, err
@@ -78,10 +111,10 @@ func (s *Storage) CompactLevelBased(ctx context.Context, ids []ID, ttl time.Dura
 	if err != nil {
 		return nil, err
 	}
-	if isCompacted(s.compactionConfig, prims) {
+	if isCompacted(config, prims) {
 		return s.Compose(ctx, ids, ttl)
 	}
-	i := indexOfCompacted(s.compactionConfig.LevelFactor, prims)
+	i := indexOfCompacted(config.LevelFactor, prims)
 	var id *ID
 	if err := miscutil.LogStep(fmt.Sprintf("compacting %v levels out of %v", len(ids)-i, len(ids)), func() error {
 		id, err = compact(ctx, ids[i:], ttl)
@@ -89,5 +122,5 @@ func (s *Storage) CompactLevelBased(ctx context.Context, ids []ID, ttl time.Dura
 	}); err != nil {
 		return nil, err
 	}
-	return s.CompactLevelBased(ctx, append(ids[:i], *id), ttl, compact)
+	return s.CompactLevelBased(ctx, append(ids[:i], *id), ttl, config, compact)
 }
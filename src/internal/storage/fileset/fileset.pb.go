@@ -159,12 +159,16 @@ func (m *Composite) GetLayers() []string {
 }
 
 type Primitive struct {
-	Deletive             *index.Index `protobuf:"bytes,1,opt,name=deletive,proto3" json:"deletive,omitempty"`
-	Additive             *index.Index `protobuf:"bytes,2,opt,name=additive,proto3" json:"additive,omitempty"`
-	SizeBytes            int64        `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Deletive  *index.Index `protobuf:"bytes,1,opt,name=deletive,proto3" json:"deletive,omitempty"`
+	Additive  *index.Index `protobuf:"bytes,2,opt,name=additive,proto3" json:"additive,omitempty"`
+	SizeBytes int64        `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// path_filter is a bloom filter of the paths in the file set and their
+	// ancestor directories, see index.BloomBuilder. It is empty when the file
+	// set has too many paths for a filter.
+	PathFilter           []byte   `protobuf:"bytes,4,opt,name=path_filter,json=pathFilter,proto3" json:"path_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Primitive) Reset()         { *m = Primitive{} }
@@ -221,6 +225,13 @@ func (m *Primitive) GetSizeBytes() int64 {
 	return 0
 }
 
+func (m *Primitive) GetPathFilter() []byte {
+	if m != nil {
+		return m.PathFilter
+	}
+	return nil
+}
+
 type TestCacheValue struct {
 	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -280,28 +291,29 @@ func init() {
 }
 
 var fileDescriptor_22dc3e2e3017d669 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0x41, 0x4b, 0xf3, 0x40,
-	0x10, 0xfd, 0xb6, 0xe5, 0x6b, 0xbb, 0x5b, 0xf1, 0xb0, 0x07, 0x09, 0x82, 0xa1, 0x44, 0x90, 0xe0,
-	0x21, 0x91, 0x7a, 0xf7, 0xd0, 0x5e, 0x2c, 0x28, 0x48, 0x14, 0x0f, 0x5e, 0xca, 0x36, 0x3b, 0xb6,
-	0x0b, 0x69, 0x37, 0xec, 0x4e, 0x8b, 0x55, 0xf0, 0xf7, 0x79, 0xf4, 0x27, 0x48, 0x7e, 0x89, 0x6c,
-	0xd3, 0xc4, 0x22, 0xf6, 0x32, 0xbb, 0x33, 0xef, 0x3d, 0xe6, 0x3d, 0x86, 0x9d, 0xa9, 0x05, 0x82,
-	0x59, 0x88, 0x2c, 0xb6, 0xa8, 0x8d, 0x98, 0x42, 0xfc, 0xac, 0x32, 0xb0, 0x80, 0xd5, 0x1b, 0xe5,
-	0x46, 0xa3, 0xe6, 0xed, 0x6d, 0x7b, 0x7c, 0xbe, 0x57, 0xa0, 0x16, 0x12, 0x5e, 0xca, 0x5a, 0x8a,
-	0x82, 0x37, 0xd6, 0xb9, 0x05, 0x14, 0x52, 0xa0, 0xe0, 0x7d, 0x46, 0x73, 0xa3, 0xe6, 0x0a, 0xd5,
-	0x0a, 0x3c, 0xd2, 0x23, 0x61, 0xb7, 0xcf, 0xa3, 0x6a, 0xc7, 0x5d, 0x85, 0x5c, 0xff, 0x4b, 0x7e,
-	0x68, 0x4e, 0x93, 0xea, 0x79, 0xae, 0xad, 0x42, 0xf0, 0x1a, 0xbf, 0x34, 0xc3, 0x0a, 0x71, 0x9a,
-	0x9a, 0x36, 0x68, 0xb3, 0xff, 0x2b, 0x91, 0x2d, 0x21, 0x38, 0x65, 0xb4, 0xa6, 0xf0, 0x23, 0xd6,
-	0xca, 0xc4, 0x1a, 0x8c, 0xf5, 0x48, 0xaf, 0x19, 0xd2, 0x64, 0xdb, 0x05, 0xef, 0x8c, 0xd6, 0xbb,
-	0x79, 0xc8, 0x3a, 0x12, 0x32, 0xd8, 0x71, 0x78, 0x10, 0x95, 0x71, 0x46, 0xae, 0x26, 0x35, 0xea,
-	0x98, 0x42, 0xca, 0x32, 0x4b, 0xe3, 0x2f, 0x66, 0x85, 0xf2, 0x13, 0xc6, 0xac, 0x7a, 0x85, 0xf1,
-	0x64, 0x8d, 0x60, 0xbd, 0x66, 0x8f, 0x84, 0xcd, 0x84, 0xba, 0xc9, 0xc0, 0x0d, 0x82, 0x0b, 0x76,
-	0xf8, 0x00, 0x16, 0x87, 0x22, 0x9d, 0xc1, 0xa3, 0xb3, 0xcd, 0x7d, 0xd6, 0x75, 0x09, 0xc7, 0x16,
-	0x70, 0xac, 0xe4, 0xc6, 0x07, 0x4d, 0xa8, 0x1b, 0xdd, 0x03, 0x8e, 0xe4, 0xe0, 0xe6, 0xa3, 0xf0,
-	0xc9, 0x67, 0xe1, 0x93, 0xaf, 0xc2, 0x27, 0x4f, 0x57, 0x53, 0x85, 0xb3, 0xe5, 0x24, 0x4a, 0xf5,
-	0x3c, 0xce, 0x45, 0x3a, 0x5b, 0x4b, 0x30, 0xbb, 0xbf, 0x55, 0x3f, 0xb6, 0x26, 0x8d, 0xf7, 0xdd,
-	0x6c, 0xd2, 0xda, 0x1c, 0xea, 0xf2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xfe, 0xe9, 0x14, 0x07,
-	0x02, 0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcf, 0x4a, 0xf3, 0x40,
+	0x10, 0xff, 0xb6, 0xfd, 0xbe, 0xb6, 0xd9, 0x96, 0xef, 0xb0, 0x07, 0x09, 0x82, 0x31, 0x44, 0x90,
+	0xe0, 0x21, 0x91, 0x7a, 0xf7, 0xd0, 0x82, 0x58, 0x50, 0x90, 0x28, 0x1e, 0xbc, 0x84, 0x6d, 0x76,
+	0xda, 0x2c, 0xa4, 0x4d, 0xd8, 0x9d, 0x16, 0xab, 0x0f, 0xe3, 0xeb, 0x78, 0xf4, 0x11, 0xa4, 0x4f,
+	0x22, 0xdb, 0x34, 0xb1, 0x88, 0xbd, 0x4c, 0x32, 0xbf, 0x3f, 0xcc, 0xfc, 0x76, 0xe8, 0xa9, 0x9c,
+	0x23, 0xa8, 0x39, 0xcf, 0x42, 0x8d, 0xb9, 0xe2, 0x53, 0x08, 0x27, 0x32, 0x03, 0x0d, 0x58, 0x7d,
+	0x83, 0x42, 0xe5, 0x98, 0xb3, 0xf6, 0xb6, 0x3d, 0x3c, 0xdb, 0x6b, 0x90, 0x73, 0x01, 0xcf, 0x65,
+	0x2d, 0x4d, 0xde, 0x2b, 0xed, 0xdc, 0x02, 0x72, 0xc1, 0x91, 0xb3, 0x3e, 0xb5, 0x0a, 0x25, 0x67,
+	0x12, 0xe5, 0x12, 0x6c, 0xe2, 0x12, 0xbf, 0xdb, 0x67, 0x41, 0x35, 0xe3, 0xae, 0x62, 0xae, 0xff,
+	0x44, 0xdf, 0x32, 0xe3, 0x49, 0xf2, 0x59, 0x91, 0x6b, 0x89, 0x60, 0x37, 0x7e, 0x78, 0x86, 0x15,
+	0x63, 0x3c, 0xb5, 0x6c, 0xd0, 0xa6, 0xff, 0x96, 0x3c, 0x5b, 0x80, 0x77, 0x42, 0xad, 0x5a, 0xc2,
+	0x0e, 0x68, 0x2b, 0xe3, 0x2b, 0x50, 0xda, 0x26, 0x6e, 0xd3, 0xb7, 0xa2, 0x6d, 0xe7, 0xbd, 0x11,
+	0x6a, 0xd5, 0xc3, 0x99, 0x4f, 0x3b, 0x02, 0x32, 0xd8, 0x59, 0xb1, 0x17, 0x94, 0x79, 0x46, 0xa6,
+	0x46, 0x35, 0x6b, 0x94, 0x5c, 0x88, 0x32, 0x4c, 0xe3, 0x37, 0x65, 0xc5, 0xb2, 0x23, 0x4a, 0xb5,
+	0x7c, 0x81, 0x78, 0xbc, 0x42, 0xd0, 0x76, 0xd3, 0x25, 0x7e, 0x33, 0xb2, 0x0c, 0x32, 0x30, 0x00,
+	0x3b, 0xa6, 0xdd, 0x82, 0x63, 0x1a, 0x4f, 0x64, 0x86, 0xa0, 0xec, 0xbf, 0x2e, 0xf1, 0x7b, 0x11,
+	0x35, 0xd0, 0xd5, 0x06, 0xf1, 0xce, 0xe9, 0xff, 0x07, 0xd0, 0x38, 0xe4, 0x49, 0x0a, 0x8f, 0x26,
+	0x18, 0x73, 0x68, 0xd7, 0xbc, 0x41, 0xac, 0x01, 0x63, 0x29, 0x36, 0x8b, 0x5a, 0x91, 0x65, 0xa0,
+	0x7b, 0xc0, 0x91, 0x18, 0xdc, 0xbc, 0xaf, 0x1d, 0xf2, 0xb1, 0x76, 0xc8, 0xe7, 0xda, 0x21, 0x4f,
+	0x97, 0x53, 0x89, 0xe9, 0x62, 0x1c, 0x24, 0xf9, 0x2c, 0x2c, 0x78, 0x92, 0xae, 0x04, 0xa8, 0xdd,
+	0xbf, 0x65, 0x3f, 0xd4, 0x2a, 0x09, 0xf7, 0x5d, 0x75, 0xdc, 0xda, 0x9c, 0xf2, 0xe2, 0x6b, 0x00,
+	0x8a, 0x64, 0xcb, 0xc5, 0x29, 0x02, 0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -442,6 +454,13 @@ func (m *Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PathFilter) > 0 {
+		i -= len(m.PathFilter)
+		copy(dAtA[i:], m.PathFilter)
+		i = encodeVarintFileset(dAtA, i, uint64(len(m.PathFilter)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SizeBytes != 0 {
 		i = encodeVarintFileset(dAtA, i, uint64(m.SizeBytes))
 		i--
@@ -593,6 +612,10 @@ func (m *Primitive) Size() (n int) {
 	if m.SizeBytes != 0 {
 		n += 1 + sovFileset(uint64(m.SizeBytes))
 	}
+	l = len(m.PathFilter)
+	if l > 0 {
+		n += 1 + l + sovFileset(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -945,6 +968,40 @@ func (m *Primitive) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathFilter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFileset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFileset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathFilter = append(m.PathFilter[:0], dAtA[iNdEx:postIndex]...)
+			if m.PathFilter == nil {
+				m.PathFilter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFileset(dAtA[iNdEx:])
@@ -8,10 +8,12 @@ import (
 	"math/rand"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -324,3 +326,108 @@ func testStableHash(t *testing.T, data, expected []byte, msg string) {
 	}
 	require.True(t, bytes.Equal(stableHash, getHash()), msg)
 }
+
+func TestAttributes(t *testing.T) {
+	ctx := context.Background()
+	storage := newTestStorage(t)
+	attrs := &index.FileAttributes{
+		Mode:        0755,
+		ContentType: "text/x-shellscript",
+		Xattrs:      map[string]string{"user.origin": "test"},
+	}
+	put := func(p string, appendFile bool, data string, opts ...FileOption) ID {
+		uw, err := storage.NewUnorderedWriter(ctx)
+		require.NoError(t, err)
+		require.NoError(t, uw.Put(p, DefaultFileDatum, appendFile, strings.NewReader(data), opts...))
+		id, err := uw.Close()
+		require.NoError(t, err)
+		return *id
+	}
+	checkAttributes := func(ids []ID, expected map[string]*index.FileAttributes) {
+		fs, err := storage.Open(ctx, ids)
+		require.NoError(t, err)
+		actual := make(map[string]*index.FileAttributes)
+		require.NoError(t, fs.Iterate(ctx, func(f File) error {
+			actual[f.Index().Path] = f.Index().File.Attributes
+			return nil
+		}))
+		require.Equal(t, len(expected), len(actual))
+		for p, attrs := range expected {
+			require.True(t, proto.Equal(attrs, actual[p]), "attributes of %s: expected %v, got %v", p, attrs, actual[p])
+		}
+	}
+	link := &index.FileAttributes{SymlinkTarget: "run.sh"}
+	ids := []ID{
+		put("/run.sh", false, "#!/bin/sh\n", WithAttributes(attrs)),
+		put("/latest", false, "", WithAttributes(link)),
+		put("/plain", false, "data"),
+	}
+	checkAttributes(ids, map[string]*index.FileAttributes{
+		"/run.sh": attrs,
+		"/latest": link,
+		"/plain":  nil,
+	})
+	// Appending without attributes keeps the existing ones.
+	ids = append(ids, put("/run.sh", true, "echo hi\n"))
+	checkAttributes(ids, map[string]*index.FileAttributes{
+		"/run.sh": attrs,
+		"/latest": link,
+		"/plain":  nil,
+	})
+	// The attributes survive compaction.
+	id, err := storage.Compact(ctx, ids, time.Minute)
+	require.NoError(t, err)
+	checkAttributes([]ID{*id}, map[string]*index.FileAttributes{
+		"/run.sh": attrs,
+		"/latest": link,
+		"/plain":  nil,
+	})
+	// Overwriting a file without attributes clears them.
+	ids = append(ids, put("/run.sh", false, "#!/bin/sh\n"))
+	checkAttributes(ids, map[string]*index.FileAttributes{
+		"/run.sh": nil,
+		"/latest": link,
+		"/plain":  nil,
+	})
+}
+
+func TestPathFilter(t *testing.T) {
+	ctx := context.Background()
+	storage := newTestStorage(t)
+	var ids []ID
+	for _, dir := range []string{"a", "b", "c"} {
+		ids = append(ids, writeFileSet(t, storage, []*testFile{
+			{path: "/" + dir + "/file1", datum: DefaultFileDatum},
+			{path: "/" + dir + "/file2", datum: DefaultFileDatum},
+		}))
+	}
+	w := storage.NewWriter(ctx)
+	require.NoError(t, w.Delete("/b/file1", DefaultFileDatum))
+	id, err := w.Close()
+	require.NoError(t, err)
+	ids = append(ids, *id)
+	prim, err := storage.getPrimitive(ctx, ids[0])
+	require.NoError(t, err)
+	require.True(t, index.BloomMayContain(prim.PathFilter, "/a/file1"))
+	require.False(t, index.BloomMayContain(prim.PathFilter, "/b/", "/b/file1"))
+	id, err = storage.Compose(ctx, ids, time.Minute)
+	require.NoError(t, err)
+	for _, test := range []struct {
+		opt      index.Option
+		expected []string
+	}{
+		{index.WithLookup("/a/file1"), []string{"/a/file1"}},
+		{index.WithLookup("/b"), []string{"/b/file2"}},
+		{index.WithPrefix("/c/"), []string{"/c/file1", "/c/file2"}},
+		{index.WithLookup("/d"), nil},
+	} {
+		fs, err := storage.Open(ctx, []ID{*id}, test.opt)
+		require.NoError(t, err)
+		var actual []string
+		require.NoError(t, fs.Iterate(ctx, func(f File) error {
+			actual = append(actual, f.Index().Path)
+			return nil
+		}))
+		require.Equal(t, test.expected, actual)
+	}
+}
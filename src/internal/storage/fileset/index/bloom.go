@@ -0,0 +1,170 @@
+package index
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+const (
+	bloomBitsPerKey = 10
+	bloomHashes     = 7
+	bloomMinBits    = 64
+	// maxBloomKeys bounds the memory used to build a bloom filter and the
+	// size of the filter. File sets with more keys than this don't get a
+	// filter, so they are always read.
+	maxBloomKeys = 1 << 20
+)
+
+// BloomBuilder builds a bloom filter of the paths in a file set. Along with
+// each path, the builder adds the ancestor directories of the path (ending
+// in "/"), so the filter can be checked for both a path and a directory
+// containing paths.
+type BloomBuilder struct {
+	hashes   []uint64
+	last     string
+	overflow bool
+}
+
+// Add adds a path to the filter.
+func (b *BloomBuilder) Add(p string) {
+	if b.overflow {
+		return
+	}
+	b.add(p)
+	// The ancestors shared with the previous path were added along with it.
+	for dir := parentDir(p); dir != "" && !strings.HasPrefix(b.last, dir); dir = parentDir(dir) {
+		b.add(dir)
+	}
+	b.last = p
+}
+
+func (b *BloomBuilder) add(key string) {
+	if len(b.hashes) >= maxBloomKeys {
+		b.overflow = true
+		b.hashes = nil
+		return
+	}
+	b.hashes = append(b.hashes, bloomHash(key))
+}
+
+// Build returns the filter, or nil if too many paths were added.
+func (b *BloomBuilder) Build() []byte {
+	if b.overflow {
+		return nil
+	}
+	sort.Slice(b.hashes, func(i, j int) bool { return b.hashes[i] < b.hashes[j] })
+	var n int
+	for i, h := range b.hashes {
+		if i == 0 || h != b.hashes[i-1] {
+			n++
+		}
+	}
+	bits := n * bloomBitsPerKey
+	if bits < bloomMinBits {
+		bits = bloomMinBits
+	}
+	filter := make([]byte, 1+(bits+7)/8)
+	filter[0] = bloomHashes
+	for _, h := range b.hashes {
+		setBloomBits(filter, h)
+	}
+	return filter
+}
+
+// BloomMayContain returns false if the filter shows that none of the keys
+// were added to it. An empty filter may contain any key.
+func BloomMayContain(filter []byte, keys ...string) bool {
+	if len(filter) < 2 {
+		return true
+	}
+	for _, key := range keys {
+		if hasBloomBits(filter, bloomHash(key)) {
+			return true
+		}
+	}
+	return false
+}
+
+// BloomKeys returns the keys to check a bloom filter for before a read with
+// opts. A file set whose filter contains none of the keys contains none of
+// the paths read. BloomKeys returns nil if the read can't be checked.
+func BloomKeys(opts ...Option) []string {
+	r := &Reader{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.lookup != nil {
+		return []string{*r.lookup, *r.lookup + "/"}
+	}
+	if r.filter == nil {
+		return nil
+	}
+	prefix := r.filter.prefix
+	if r.filter.pathRange != nil {
+		pr := r.filter.pathRange
+		if pr.Upper == "" {
+			return nil
+		}
+		if pr.Lower == pr.Upper {
+			return []string{pr.Lower}
+		}
+		prefix = commonPrefix(pr.Lower, pr.Upper)
+	}
+	// Every path with the prefix is under the last directory in the prefix.
+	dir := prefix[:strings.LastIndex(prefix, "/")+1]
+	if dir == "" {
+		return nil
+	}
+	return []string{dir}
+}
+
+func parentDir(p string) string {
+	p = strings.TrimSuffix(p, "/")
+	i := strings.LastIndex(p, "/")
+	if i < 0 {
+		return ""
+	}
+	return p[:i+1]
+}
+
+func commonPrefix(a, b string) string {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return a[:i]
+}
+
+func bloomHash(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return h.Sum64()
+}
+
+// bloomBits calls f with the bits for a hash, using double hashing to derive
+// the hash functions.
+func bloomBits(filter []byte, h uint64, f func(i int, mask byte) bool) bool {
+	bits := uint64(len(filter)-1) * 8
+	h1, h2 := h&0xffffffff, h>>32|1
+	for i := uint64(0); i < uint64(filter[0]); i++ {
+		bit := (h1 + i*h2) % bits
+		if !f(1+int(bit/8), 1<<(bit%8)) {
+			return false
+		}
+	}
+	return true
+}
+
+func setBloomBits(filter []byte, h uint64) {
+	bloomBits(filter, h, func(i int, mask byte) bool {
+		filter[i] |= mask
+		return true
+	})
+}
+
+func hasBloomBits(filter []byte, h uint64) bool {
+	return bloomBits(filter, h, func(i int, mask byte) bool {
+		return filter[i]&mask != 0
+	})
+}
@@ -6,6 +6,7 @@ package index
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	chunk "github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	io "io"
 	math "math"
@@ -153,6 +154,7 @@ func (m *Range) GetChunkRef() *chunk.DataRef {
 type File struct {
 	Datum                string           `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
 	DataRefs             []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
+	Attributes           *FileAttributes  `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -205,10 +207,99 @@ func (m *File) GetDataRefs() []*chunk.DataRef {
 	return nil
 }
 
+func (m *File) GetAttributes() *FileAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// FileAttributes stores the optional POSIX attributes of a file.
+type FileAttributes struct {
+	Mode                 uint32            `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Mtime                *types.Timestamp  `protobuf:"bytes,2,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	SymlinkTarget        string            `protobuf:"bytes,3,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	ContentType          string            `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Xattrs               map[string]string `protobuf:"bytes,5,rep,name=xattrs,proto3" json:"xattrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FileAttributes) Reset()         { *m = FileAttributes{} }
+func (m *FileAttributes) String() string { return proto.CompactTextString(m) }
+func (*FileAttributes) ProtoMessage()    {}
+func (*FileAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfa1b84c403551af, []int{3}
+}
+func (m *FileAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileAttributes.Merge(m, src)
+}
+func (m *FileAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileAttributes proto.InternalMessageInfo
+
+func (m *FileAttributes) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileAttributes) GetMtime() *types.Timestamp {
+	if m != nil {
+		return m.Mtime
+	}
+	return nil
+}
+
+func (m *FileAttributes) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
+func (m *FileAttributes) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *FileAttributes) GetXattrs() map[string]string {
+	if m != nil {
+		return m.Xattrs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Index)(nil), "index.Index")
 	proto.RegisterType((*Range)(nil), "index.Range")
 	proto.RegisterType((*File)(nil), "index.File")
+	proto.RegisterType((*FileAttributes)(nil), "index.FileAttributes")
+	proto.RegisterMapType((map[string]string)(nil), "index.FileAttributes.XattrsEntry")
 }
 
 func init() {
@@ -216,26 +307,37 @@ func init() {
 }
 
 var fileDescriptor_dfa1b84c403551af = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xc1, 0x4a, 0xf4, 0x30,
-	0x10, 0x26, 0xdb, 0x76, 0xd9, 0x66, 0x7f, 0xfe, 0x43, 0x10, 0x29, 0x0a, 0xb5, 0xf4, 0xb4, 0x28,
-	0x34, 0xb0, 0xbe, 0x81, 0x2c, 0xc2, 0xde, 0x24, 0x47, 0x2f, 0x6b, 0xb6, 0x9d, 0xb4, 0xc1, 0x6e,
-	0x5b, 0x92, 0x54, 0xf4, 0x0d, 0x3d, 0xfa, 0x08, 0xd2, 0x27, 0x91, 0x24, 0x3d, 0x08, 0x8a, 0x97,
-	0x61, 0xbe, 0x99, 0x6f, 0xe6, 0xfb, 0x26, 0xc1, 0xd7, 0xb2, 0x33, 0xa0, 0x3a, 0xde, 0x52, 0x6d,
-	0x7a, 0xc5, 0x6b, 0xa0, 0x42, 0xb6, 0xa0, 0xc1, 0x50, 0xd9, 0x55, 0xf0, 0xea, 0x63, 0x31, 0xa8,
-	0xde, 0xf4, 0x24, 0x72, 0xe0, 0x22, 0xff, 0x31, 0x52, 0x36, 0x63, 0xf7, 0xec, 0xa3, 0xa7, 0xe6,
-	0x4f, 0x38, 0xda, 0x5b, 0x32, 0x21, 0x38, 0x1c, 0xb8, 0x69, 0x12, 0x94, 0xa1, 0x4d, 0xcc, 0x5c,
-	0x4e, 0x72, 0x1c, 0x29, 0xde, 0xd5, 0x90, 0x2c, 0x32, 0xb4, 0x59, 0x6f, 0xff, 0x15, 0x5e, 0x84,
-	0xd9, 0x1a, 0xf3, 0x2d, 0x72, 0x85, 0x43, 0x6b, 0x24, 0x09, 0x1c, 0x65, 0x3d, 0x53, 0xee, 0x65,
-	0x0b, 0xcc, 0x35, 0x72, 0x89, 0x23, 0x37, 0x40, 0xce, 0xf1, 0xb2, 0x17, 0x42, 0x83, 0x71, 0x1a,
-	0x01, 0x9b, 0x11, 0xb9, 0xc4, 0x71, 0xcb, 0xb5, 0x39, 0x38, 0xf9, 0x85, 0x93, 0x5f, 0xd9, 0xc2,
-	0x83, 0xb5, 0x70, 0x83, 0x63, 0x67, 0xf7, 0xa0, 0x40, 0xcc, 0x1a, 0xff, 0x0b, 0x7f, 0xc0, 0x8e,
-	0x1b, 0xce, 0x40, 0xb0, 0x95, 0x83, 0x0c, 0x44, 0xbe, 0xc7, 0xa1, 0x15, 0x26, 0x67, 0x38, 0xaa,
-	0xb8, 0x19, 0x4f, 0xf3, 0x31, 0x1e, 0xd8, 0x55, 0x15, 0x37, 0xdc, 0x6e, 0xd2, 0xc9, 0x22, 0x0b,
-	0x7e, 0x5b, 0x55, 0xf9, 0x44, 0xdf, 0xb1, 0xf7, 0x29, 0x45, 0x1f, 0x53, 0x8a, 0x3e, 0xa7, 0x14,
-	0x3d, 0xee, 0x6a, 0x69, 0x9a, 0xf1, 0x58, 0x94, 0xfd, 0x89, 0x0e, 0xbc, 0x6c, 0xde, 0x2a, 0x50,
-	0xdf, 0xb3, 0x97, 0x2d, 0xd5, 0xaa, 0xa4, 0x7f, 0x7f, 0xd1, 0x71, 0xe9, 0x9e, 0xfc, 0xf6, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0x33, 0x84, 0xa8, 0x77, 0xcb, 0x01, 0x00, 0x00,
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xdd, 0x8a, 0xd3, 0x50,
+	0x10, 0x26, 0x69, 0xb3, 0x6c, 0xa7, 0xbb, 0x45, 0x0e, 0x2a, 0xa1, 0x42, 0xb7, 0x1b, 0x10, 0x16,
+	0x85, 0x44, 0x56, 0x04, 0xd7, 0x3b, 0x65, 0x15, 0xbc, 0x93, 0x43, 0x2f, 0xc4, 0x9b, 0x7a, 0x9a,
+	0x4c, 0xd2, 0xd0, 0xfc, 0x71, 0xce, 0x64, 0xd9, 0xdc, 0xf9, 0x14, 0x3e, 0x93, 0x97, 0x3e, 0x82,
+	0xf4, 0x49, 0xe4, 0xfc, 0xac, 0x54, 0x5c, 0xbc, 0x09, 0x33, 0xdf, 0x7c, 0x67, 0xe6, 0x9b, 0x6f,
+	0x08, 0x3c, 0x2b, 0x1b, 0x42, 0xd9, 0x88, 0x2a, 0x51, 0xd4, 0x4a, 0x51, 0x60, 0x92, 0x97, 0x15,
+	0x2a, 0xa4, 0xa4, 0x6c, 0x32, 0xbc, 0xb5, 0xdf, 0xb8, 0x93, 0x2d, 0xb5, 0x2c, 0x30, 0xc9, 0xfc,
+	0xac, 0x68, 0xdb, 0xa2, 0xc2, 0xc4, 0x80, 0x9b, 0x3e, 0x4f, 0xa8, 0xac, 0x51, 0x91, 0xa8, 0x3b,
+	0xcb, 0x9b, 0x47, 0xff, 0xf4, 0x4c, 0xb7, 0x7d, 0xb3, 0xb3, 0x5f, 0xcb, 0x89, 0xbe, 0x42, 0xf0,
+	0x51, 0x77, 0x63, 0x0c, 0xc6, 0x9d, 0xa0, 0x6d, 0xe8, 0x2d, 0xbd, 0x8b, 0x09, 0x37, 0x31, 0x8b,
+	0x20, 0x90, 0xa2, 0x29, 0x30, 0xf4, 0x97, 0xde, 0xc5, 0xf4, 0xf2, 0x24, 0xb6, 0x2a, 0xb8, 0xc6,
+	0xb8, 0x2d, 0xb1, 0x33, 0x18, 0x6b, 0xa5, 0xe1, 0xc8, 0x50, 0xa6, 0x8e, 0xf2, 0xa1, 0xac, 0x90,
+	0x9b, 0x42, 0x54, 0x42, 0x60, 0x1e, 0xb0, 0xc7, 0x70, 0xd4, 0xe6, 0xb9, 0x42, 0x32, 0x33, 0x46,
+	0xdc, 0x65, 0xec, 0x09, 0x4c, 0x2a, 0xa1, 0x68, 0x6d, 0xc6, 0xfb, 0x66, 0xfc, 0xb1, 0x06, 0x3e,
+	0x69, 0x09, 0xcf, 0x61, 0x62, 0xe4, 0xae, 0x25, 0xe6, 0x6e, 0xc6, 0x2c, 0xb6, 0x0b, 0x5c, 0x0b,
+	0x12, 0x1c, 0x73, 0x7e, 0x6c, 0x52, 0x8e, 0x79, 0xf4, 0xcd, 0x83, 0xb1, 0x9e, 0xcc, 0x1e, 0x42,
+	0x90, 0x09, 0xea, 0x6b, 0xb7, 0x8d, 0x4d, 0x74, 0xaf, 0x4c, 0x90, 0xd0, 0xad, 0x54, 0xe8, 0x2f,
+	0x47, 0xf7, 0xf5, 0xca, 0x6c, 0xa0, 0xd8, 0x2b, 0x00, 0x41, 0x24, 0xcb, 0x4d, 0x4f, 0xa8, 0xdc,
+	0xe4, 0x47, 0x07, 0xdb, 0xbd, 0xfd, 0x53, 0xe4, 0x07, 0xc4, 0xe8, 0xbb, 0x0f, 0xb3, 0xbf, 0xcb,
+	0xda, 0xd9, 0xba, 0xcd, 0xd0, 0x68, 0x39, 0xe5, 0x26, 0x66, 0x2f, 0x20, 0xa8, 0xf5, 0xb9, 0x9c,
+	0xb3, 0xf3, 0xd8, 0xde, 0x32, 0xbe, 0xbb, 0x65, 0xbc, 0xba, 0xbb, 0x25, 0xb7, 0x44, 0xf6, 0x14,
+	0x66, 0x6a, 0xa8, 0xab, 0xb2, 0xd9, 0xad, 0x49, 0xc8, 0x02, 0xc9, 0x68, 0x9a, 0xf0, 0x53, 0x87,
+	0xae, 0x0c, 0xc8, 0xce, 0xe1, 0x24, 0x6d, 0x1b, 0xc2, 0x86, 0xd6, 0x34, 0x74, 0x18, 0x8e, 0x0d,
+	0x69, 0xea, 0xb0, 0xd5, 0xd0, 0x21, 0xbb, 0x82, 0xa3, 0x5b, 0xad, 0x58, 0x85, 0x81, 0xf1, 0xe0,
+	0xfc, 0xde, 0xad, 0xe2, 0xcf, 0x86, 0xf3, 0xbe, 0x21, 0x39, 0x70, 0xf7, 0x60, 0x7e, 0x05, 0xd3,
+	0x03, 0x98, 0x3d, 0x80, 0xd1, 0x0e, 0x07, 0x67, 0xb2, 0x0e, 0xb5, 0xf1, 0x37, 0xa2, 0xea, 0xd1,
+	0xdd, 0xd1, 0x26, 0x6f, 0xfc, 0xd7, 0xde, 0x3b, 0xfe, 0x63, 0xbf, 0xf0, 0x7e, 0xee, 0x17, 0xde,
+	0xaf, 0xfd, 0xc2, 0xfb, 0x72, 0x5d, 0x94, 0xb4, 0xed, 0x37, 0x71, 0xda, 0xd6, 0x49, 0x27, 0xd2,
+	0xed, 0x90, 0xa1, 0x3c, 0x8c, 0x6e, 0x2e, 0x13, 0x25, 0xd3, 0xe4, 0xff, 0x3f, 0xc5, 0xe6, 0xc8,
+	0xd8, 0xf5, 0xf2, 0xf7, 0x00, 0xa5, 0xf6, 0xb7, 0x44, 0x3d, 0x03, 0x00, 0x00,
 }
 
 func (m *Index) Marshal() (dAtA []byte, err error) {
@@ -371,6 +473,18 @@ func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Attributes != nil {
+		{
+			size, err := m.Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DataRefs) > 0 {
 		for iNdEx := len(m.DataRefs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -395,6 +509,83 @@ func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FileAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Xattrs) > 0 {
+		for k := range m.Xattrs {
+			v := m.Xattrs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintIndex(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintIndex(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintIndex(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Mtime != nil {
+		{
+			size, err := m.Mtime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIndex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mode != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIndex(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIndex(v)
 	base := offset
@@ -469,6 +660,45 @@ func (m *File) Size() (n int) {
 			n += 1 + l + sovIndex(uint64(l))
 		}
 	}
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovIndex(uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		l = m.Mtime.Size()
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	if len(m.Xattrs) > 0 {
+		for k, v := range m.Xattrs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovIndex(uint64(len(k))) + 1 + len(v) + sovIndex(uint64(len(v)))
+			n += mapEntrySize + 1 + sovIndex(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -869,6 +1099,339 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = &FileAttributes{}
+			}
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mtime == nil {
+				m.Mtime = &types.Timestamp{}
+			}
+			if err := m.Mtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xattrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Xattrs == nil {
+				m.Xattrs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipIndex(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Xattrs[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndex(dAtA[iNdEx:])
@@ -111,6 +111,16 @@ func Check(t *testing.T, permString string) {
 		actual = actualFiles(t, topIdx, chunks, WithRange(pathRange(expected)))
 		require.Equal(t, expected, actual)
 	})
+	t.Run("LowerBound", func(t *testing.T) {
+		lower := fileNames[len(fileNames)/2]
+		expected := fileNames[len(fileNames)/2:]
+		actual := actualFiles(t, topIdx, chunks, WithLowerBound(lower))
+		require.Equal(t, expected, actual)
+		prefix := string(lower[0])
+		expected = expectedFiles(expected, prefix)
+		actual = actualFiles(t, topIdx, chunks, WithPrefix(prefix), WithLowerBound(lower))
+		require.Equal(t, expected, actual)
+	})
 }
 
 func TestSingleLevel(t *testing.T) {
@@ -120,3 +130,26 @@ func TestSingleLevel(t *testing.T) {
 func TestMultiLevel(t *testing.T) {
 	Check(t, "abcdefg")
 }
+
+func TestBloom(t *testing.T) {
+	var b BloomBuilder
+	for _, p := range []string{"/a/b/c", "/a/b/d", "/a/e/f", "/g"} {
+		b.Add(p)
+	}
+	filter := b.Build()
+	for _, key := range []string{"/", "/a/", "/a/b/", "/a/b/c", "/a/e/", "/g"} {
+		require.True(t, BloomMayContain(filter, key), key)
+	}
+	require.False(t, BloomMayContain(filter, "/x/", "/a/b/c/"))
+	// An empty filter may contain anything.
+	require.True(t, BloomMayContain(nil, "/x/"))
+
+	require.Equal(t, []string{"/a/b", "/a/b/"}, BloomKeys(WithLookup("/a/b")))
+	require.Equal(t, []string{"/a/"}, BloomKeys(WithPrefix("/a/b")))
+	require.Equal(t, []string{"/a/b/"}, BloomKeys(WithPrefix("/a/b/")))
+	require.Equal(t, []string{"/a/b"}, BloomKeys(WithExact("/a/b")))
+	require.Equal(t, []string{"/a/"}, BloomKeys(WithRange(&PathRange{Lower: "/a/b", Upper: "/a/c"})))
+	require.Nil(t, BloomKeys(WithPrefix("")))
+	require.Nil(t, BloomKeys(WithLookup("/a/b"), WithPrefix("a")))
+	require.Nil(t, BloomKeys())
+}
@@ -14,6 +14,7 @@ type PathRange struct {
 func WithRange(pathRange *PathRange) Option {
 	return func(r *Reader) {
 		r.filter = &pathFilter{pathRange: pathRange}
+		r.lookup = nil
 	}
 }
 
@@ -21,6 +22,26 @@ func WithRange(pathRange *PathRange) Option {
 func WithPrefix(prefix string) Option {
 	return func(r *Reader) {
 		r.filter = &pathFilter{prefix: prefix}
+		r.lookup = nil
+	}
+}
+
+// WithLookup sets a prefix filter for the read of the file or directory at
+// path. Unlike with WithPrefix, file sets which contain neither path nor
+// anything under path + "/" may be skipped entirely, so the caller must not
+// depend on the other paths with the prefix.
+func WithLookup(path string) Option {
+	return func(r *Reader) {
+		r.filter = &pathFilter{prefix: path}
+		r.lookup = &path
+	}
+}
+
+// WithLowerBound sets a lower bound for the read, in addition to any range or
+// prefix filter. Paths less than lower are skipped.
+func WithLowerBound(lower string) Option {
+	return func(r *Reader) {
+		r.lower = lower
 	}
 }
 
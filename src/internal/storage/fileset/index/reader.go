@@ -18,6 +18,8 @@ type Reader struct {
 	filter *pathFilter
 	topIdx *Index
 	datum  string
+	lookup *string
+	lower  string
 }
 
 type pathFilter struct {
@@ -99,7 +101,11 @@ func (r *Reader) topLevel() pbutil.Reader {
 // For a range filter, this means the name is >= to the lower bound or the datum (if provided)
 // is >= the lower bound datum at the lower bound path itself
 // For a prefix filter, this means the name is >= to the prefix.
+// The name must also be >= to the lower bound, if one is set.
 func (r *Reader) atStart(name, datum string) bool {
+	if name < r.lower {
+		return false
+	}
 	if r.filter == nil {
 		return true
 	}
@@ -62,12 +62,18 @@ func (mr *MergeReader) iterate(ctx context.Context, cb func(File) error) error {
 			return cb(newFileReader(mr.chunks, fss[0].file.Index()))
 		}
 		var dataRefs []*chunk.DataRef
+		var attrs *index.FileAttributes
 		for _, fs := range fss {
 			idx := fs.file.Index()
 			dataRefs = append(dataRefs, idx.File.DataRefs...)
+			// The most recent attributes win.
+			if idx.File.Attributes != nil {
+				attrs = idx.File.Attributes
+			}
 		}
 		mergeIdx := fss[0].file.Index()
 		mergeIdx.File.DataRefs = dataRefs
+		mergeIdx.File.Attributes = attrs
 		return cb(newMergeFileReader(mr.chunks, mergeIdx))
 
 	})
@@ -6,6 +6,8 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
 // StorageOption configures a storage.
@@ -85,6 +87,14 @@ func WithCompact(maxFanIn int) UnorderedWriterOption {
 	}
 }
 
+// WithChunking sets the configuration used to split the content of the
+// files written to the unordered writer into chunks.
+func WithChunking(config *chunk.ChunkingConfig) UnorderedWriterOption {
+	return func(uw *UnorderedWriter) {
+		uw.chunking = config
+	}
+}
+
 // WriterOption configures a file set writer.
 type WriterOption func(w *Writer)
 
@@ -112,3 +122,21 @@ func StorageOptions(conf *serviceenv.StorageConfiguration) []StorageOption {
 	}
 	return opts
 }
+
+// WithWriterChunking sets the configuration used to split the content of
+// the files written to the writer into chunks.
+func WithWriterChunking(config *chunk.ChunkingConfig) WriterOption {
+	return func(w *Writer) {
+		w.chunking = config
+	}
+}
+
+// FileOption configures a file written to a file set.
+type FileOption func(*index.File)
+
+// WithAttributes sets the attributes of a file written to a file set.
+func WithAttributes(attrs *index.FileAttributes) FileOption {
+	return func(f *index.File) {
+		f.Attributes = attrs
+	}
+}
@@ -58,6 +58,7 @@ type Storage struct {
 	prefetchLimit                                         int
 }
 
+// CompactionConfig configures the level-based compaction of file sets.
 type CompactionConfig struct {
 	FixedDelay, LevelFactor int64
 }
@@ -92,6 +93,12 @@ func (s *Storage) ChunkStorage() *chunk.Storage {
 	return s.chunks
 }
 
+// CompactionConfig returns a copy of the default compaction config.
+func (s *Storage) CompactionConfig() *CompactionConfig {
+	config := *s.compactionConfig
+	return &config
+}
+
 // NewUnorderedWriter creates a new unordered file set writer.
 func (s *Storage) NewUnorderedWriter(ctx context.Context, opts ...UnorderedWriterOption) (*UnorderedWriter, error) {
 	return newUnorderedWriter(ctx, s, s.memThreshold, s.shardCountThreshold/2, opts...)
@@ -118,8 +125,20 @@ func (s *Storage) Open(ctx context.Context, ids []ID, opts ...index.Option) (Fil
 	if err != nil {
 		return nil, err
 	}
+	// Skip the primitives whose path filters show that they contain none of
+	// the paths read.
+	keys := index.BloomKeys(opts...)
 	var fss []FileSet
 	for _, id := range ids {
+		if keys != nil {
+			prim, err := s.getPrimitive(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if !index.BloomMayContain(prim.PathFilter, keys...) {
+				continue
+			}
+		}
 		fss = append(fss, s.newReader(id, opts...))
 	}
 	if len(fss) == 0 {
@@ -243,24 +262,27 @@ func (s *Storage) Concat(ctx context.Context, ids []ID, ttl time.Duration) (*ID,
 		deletiveIndexes = append(deletiveIndexes, prim.Deletive)
 		size += prim.SizeBytes
 	}
-	additive, err := s.concat(ctx, additiveIndexes)
+	var bloom index.BloomBuilder
+	additive, err := s.concat(ctx, additiveIndexes, &bloom)
 	if err != nil {
 		return nil, err
 	}
-	deletive, err := s.concat(ctx, deletiveIndexes)
+	deletive, err := s.concat(ctx, deletiveIndexes, &bloom)
 	if err != nil {
 		return nil, err
 	}
 	return s.newPrimitive(ctx, &Primitive{
-		Additive:  additive,
-		Deletive:  deletive,
-		SizeBytes: size,
+		Additive:   additive,
+		Deletive:   deletive,
+		SizeBytes:  size,
+		PathFilter: bloom.Build(),
 	}, ttl)
 }
 
-func (s *Storage) concat(ctx context.Context, indexes []*index.Index) (*index.Index, error) {
+func (s *Storage) concat(ctx context.Context, indexes []*index.Index, bloom *index.BloomBuilder) (*index.Index, error) {
 	mergeIndex := index.NewWriter(ctx, s.ChunkStorage(), "index-concat")
 	if err := index.Merge(ctx, s.ChunkStorage(), indexes, func(idx *index.Index) error {
+		bloom.Add(idx.Path)
 		return mergeIndex.WriteIndex(idx)
 	}); err != nil {
 		return nil, err
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
@@ -25,6 +26,7 @@ type UnorderedWriter struct {
 	getParentID                func() (*ID, error)
 	validator                  func(string) error
 	maxFanIn                   int
+	chunking                   *chunk.ChunkingConfig
 }
 
 func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold, fileThreshold int64, opts ...UnorderedWriterOption) (*UnorderedWriter, error) {
@@ -49,7 +51,9 @@ func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold, fil
 	return uw, nil
 }
 
-func (uw *UnorderedWriter) Put(p, datum string, appendFile bool, r io.Reader) (retErr error) {
+// Put puts a file in the file set. The attributes of the file are replaced
+// if a FileOption sets them.
+func (uw *UnorderedWriter) Put(p, datum string, appendFile bool, r io.Reader, opts ...FileOption) (retErr error) {
 	if err := uw.validate(p); err != nil {
 		return err
 	}
@@ -59,7 +63,7 @@ func (uw *UnorderedWriter) Put(p, datum string, appendFile bool, r io.Reader) (r
 	if !appendFile {
 		uw.buffer.Delete(p, datum)
 	}
-	w := uw.buffer.Add(p, datum)
+	w := uw.buffer.Add(p, datum, opts...)
 	for {
 		n, err := io.CopyN(w, r, uw.memAvailable)
 		uw.memAvailable -= n
@@ -73,7 +77,7 @@ func (uw *UnorderedWriter) Put(p, datum string, appendFile bool, r io.Reader) (r
 			if err := uw.serialize(); err != nil {
 				return err
 			}
-			w = uw.buffer.Add(p, datum)
+			w = uw.buffer.Add(p, datum, opts...)
 		}
 	}
 	if int64(uw.buffer.Count()) >= uw.fileThreshold {
@@ -96,8 +100,8 @@ func (uw *UnorderedWriter) serialize() error {
 		return nil
 	}
 	return uw.withWriter(func(w *Writer) error {
-		if err := uw.buffer.WalkAdditive(func(path, datum string, r io.Reader) error {
-			return w.Add(path, datum, r)
+		if err := uw.buffer.WalkAdditive(func(path, datum string, r io.Reader, attrs *index.FileAttributes) error {
+			return w.Add(path, datum, r, WithAttributes(attrs))
 		}, func(f File, datum string) error {
 			return w.Copy(f, datum)
 		}); err != nil {
@@ -115,6 +119,9 @@ func (uw *UnorderedWriter) withWriter(cb func(*Writer) error) error {
 	if uw.ttl > 0 {
 		writerOpts = append(writerOpts, WithTTL(uw.ttl))
 	}
+	if uw.chunking != nil {
+		writerOpts = append(writerOpts, WithWriterChunking(uw.chunking))
+	}
 	w := uw.storage.newWriter(uw.ctx, writerOpts...)
 	if err := cb(w); err != nil {
 		return err
@@ -176,6 +183,9 @@ func (uw *UnorderedWriter) Copy(ctx context.Context, fs FileSet, datum string, a
 		datum = DefaultFileDatum
 	}
 	return errors.EnsureStack(fs.Iterate(ctx, func(f File) error {
+		if err := uw.validate(f.Index().Path); err != nil {
+			return err
+		}
 		if !appendFile {
 			uw.buffer.Delete(f.Index().Path, datum)
 		}
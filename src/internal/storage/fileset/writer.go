@@ -32,6 +32,8 @@ type Writer struct {
 	deleteIdx                           *index.Index
 	ttl                                 time.Duration
 	sizeBytes                           int64
+	chunking                            *chunk.ChunkingConfig
+	bloom                               index.BloomBuilder
 }
 
 func newWriter(ctx context.Context, storage *Storage, opts ...WriterOption) *Writer {
@@ -44,12 +46,16 @@ func newWriter(ctx context.Context, storage *Storage, opts ...WriterOption) *Wri
 		opt(w)
 	}
 	w.additive = index.NewWriter(ctx, storage.ChunkStorage(), "additive-index-writer")
+	var uploaderOpts []chunk.UploaderOption
+	if w.chunking != nil {
+		uploaderOpts = append(uploaderOpts, chunk.WithChunkingConfig(w.chunking))
+	}
 	w.uploader = storage.ChunkStorage().NewUploader(ctx, "chunk-uploader", false, func(meta interface{}, dataRefs []*chunk.DataRef) error {
 		idx := meta.(*index.Index)
 		idx.File.DataRefs = dataRefs
 		atomic.AddInt64(&w.sizeBytes, index.SizeBytes(idx))
 		return w.additive.WriteIndex(idx)
-	})
+	}, uploaderOpts...)
 	w.additiveBatched = index.NewWriter(ctx, storage.ChunkStorage(), "additive-batched-index-writer")
 	w.batcher = storage.ChunkStorage().NewBatcher(ctx, "chunk-batcher", w.batchThreshold, chunk.WithEntryCallback(func(meta interface{}, dataRef *chunk.DataRef) error {
 		idx := meta.(*index.Index)
@@ -63,17 +69,22 @@ func newWriter(ctx context.Context, storage *Storage, opts ...WriterOption) *Wri
 	return w
 }
 
-func (w *Writer) Add(path, datum string, r io.Reader) error {
+// Add adds a file to the file set.
+func (w *Writer) Add(path, datum string, r io.Reader, opts ...FileOption) error {
 	idx := &index.Index{
 		Path: path,
 		File: &index.File{
 			Datum: datum,
 		},
 	}
+	for _, opt := range opts {
+		opt(idx.File)
+	}
 	if err := w.checkIndex(w.idx, idx); err != nil {
 		return err
 	}
 	w.idx = idx
+	w.bloom.Add(path)
 	// Handle files less than the batch threshold.
 	buf := &bytes.Buffer{}
 	_, err := io.CopyN(buf, r, int64(w.batchThreshold))
@@ -113,6 +124,7 @@ func (w *Writer) Delete(path, datum string) error {
 		return err
 	}
 	w.deleteIdx = idx
+	w.bloom.Add(path)
 	return w.deletive.WriteIndex(idx)
 }
 
@@ -125,10 +137,12 @@ func (w *Writer) Copy(file File, datum string) error {
 			return err
 		}
 		w.idx = idx
+		w.bloom.Add(idx.Path)
 		copyIdx := &index.Index{
 			Path: idx.Path,
 			File: &index.File{
-				Datum: datum,
+				Datum:      datum,
+				Attributes: idx.File.Attributes,
 			},
 		}
 		return w.uploader.Copy(copyIdx, idx.File.DataRefs)
@@ -138,7 +152,7 @@ func (w *Writer) Copy(file File, datum string) error {
 		r := w.storage.ChunkStorage().NewReader(w.ctx, idx.File.DataRefs)
 		return r.Get(w2)
 	}, func(r io.Reader) error {
-		return w.Add(idx.Path, datum, r)
+		return w.Add(idx.Path, datum, r, WithAttributes(idx.File.Attributes))
 	})
 }
 
@@ -176,8 +190,9 @@ func (w *Writer) Close() (*ID, error) {
 		return nil, err
 	}
 	return w.storage.newPrimitive(w.ctx, &Primitive{
-		Additive:  additiveMergeIdx,
-		Deletive:  deletiveIdx,
-		SizeBytes: w.sizeBytes,
+		Additive:   additiveMergeIdx,
+		Deletive:   deletiveIdx,
+		SizeBytes:  w.sizeBytes,
+		PathFilter: w.bloom.Build(),
 	}, w.ttl)
 }
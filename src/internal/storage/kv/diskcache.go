@@ -0,0 +1,344 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+)
+
+const (
+	diskCacheTmpDir = "tmp"
+	// diskCacheLockFile is locked by a process while it updates the size
+	// file or evicts values.
+	diskCacheLockFile = "lock"
+	// diskCacheSizeFile records the number of bytes in the directory, shared
+	// by all of the processes using it.
+	diskCacheSizeFile = "size"
+	// diskCacheStaleTmp is the age after which a temporary file is assumed to
+	// have been left behind by a crashed writer.
+	diskCacheStaleTmp = time.Hour
+	// diskCacheLowWatermark is the fraction of the maximum size that eviction
+	// brings the cache down to, so that eviction doesn't run on every put.
+	diskCacheLowWatermark = 0.9
+)
+
+var (
+	diskCacheHitMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_disk_cache",
+		Name:      "hits_total",
+		Help:      "Number of chunk gets served from the disk cache",
+	})
+	diskCacheMissMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_disk_cache",
+		Name:      "misses_total",
+		Help:      "Number of chunk gets that were not served from the disk cache",
+	})
+	diskCacheEvictionMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_disk_cache",
+		Name:      "evictions_total",
+		Help:      "Number of chunks evicted from the disk cache",
+	})
+	diskCacheCorruptMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_disk_cache",
+		Name:      "corrupt_total",
+		Help:      "Number of corrupt chunks found and removed from the disk cache",
+	})
+	diskCachePutErrorMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_disk_cache",
+		Name:      "put_errors_total",
+		Help:      "Number of chunks that could not be written to the disk cache",
+	})
+	diskCacheSizeMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_chunk_disk_cache",
+		Name:      "size_bytes",
+		Help:      "Number of bytes in the disk cache as of the last eviction",
+	})
+)
+
+var _ GetPut = &diskCache{}
+
+// diskCache is a size bounded LRU cache of values stored as files in a
+// directory. The directory can be shared by several processes, for example
+// pods on the same node mounting the same hostPath: writes are atomic, and
+// the number of bytes in the directory is tracked in a size file which the
+// processes update under a file lock, evicting the least recently used files
+// when it grows beyond the maximum size.
+type diskCache struct {
+	dir      string
+	maxBytes int64
+
+	// mu serializes the processes' goroutines, since file locks are held by
+	// the process.
+	mu sync.Mutex
+}
+
+// NewDiskCache returns a new cache storing at most maxBytes of values in dir.
+func NewDiskCache(dir string, maxBytes int64) (GetPut, error) {
+	if maxBytes <= 0 {
+		return nil, errors.Errorf("disk cache size must be positive, got %d", maxBytes)
+	}
+	// The cached values are only accessible to the user running the cache,
+	// even if the directory already existed (e.g. a hostPath created by the
+	// kubelet).
+	if err := os.MkdirAll(filepath.Join(dir, diskCacheTmpDir), 0700); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := os.Chmod(dir, 0700); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	dc := &diskCache{dir: dir, maxBytes: maxBytes}
+	if err := dc.withLock(func() error {
+		return dc.evict()
+	}); err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+func (dc *diskCache) Get(ctx context.Context, key []byte, cb ValueCallback) error {
+	p := dc.path(key)
+	data, err := os.ReadFile(p)
+	if err != nil {
+		diskCacheMissMetric.Inc()
+		if os.IsNotExist(err) {
+			return pacherr.NewNotExist("kv.diskCache", hex.EncodeToString(key))
+		}
+		return errors.EnsureStack(err)
+	}
+	value, ok := decodeDiskCacheValue(data)
+	if !ok {
+		// Writes are atomic, so this is corruption on disk rather than a
+		// partial write. Remove the file so that the value is fetched again.
+		diskCacheCorruptMetric.Inc()
+		diskCacheMissMetric.Inc()
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+		return pacherr.NewNotExist("kv.diskCache", hex.EncodeToString(key))
+	}
+	diskCacheHitMetric.Inc()
+	// The modification time records the last use of the value for eviction.
+	// The file may have been evicted by another process in the meantime.
+	now := time.Now()
+	if err := os.Chtimes(p, now, now); err != nil && !os.IsNotExist(err) {
+		return errors.EnsureStack(err)
+	}
+	return cb(value)
+}
+
+func (dc *diskCache) Put(ctx context.Context, key, value []byte) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			diskCachePutErrorMetric.Inc()
+		}
+	}()
+	p := dc.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return errors.EnsureStack(err)
+	}
+	f, err := os.CreateTemp(filepath.Join(dc.dir, diskCacheTmpDir), "put-*")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if retErr != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+	sum := pachhash.Sum(value)
+	if _, err := f.Write(value); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := f.Write(sum[:]); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := f.Sync(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return dc.withLock(func() error {
+		size := int64(len(value) + len(sum))
+		// The value may replace one put by another process.
+		if info, err := os.Stat(p); err == nil {
+			size -= info.Size()
+		} else if !os.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+		// Renaming the file into place is atomic, so readers never observe a
+		// partially written value, even if this process crashes.
+		if err := os.Rename(f.Name(), p); err != nil {
+			return errors.EnsureStack(err)
+		}
+		total, err := dc.readSize()
+		if err != nil {
+			return err
+		}
+		total += size
+		if total > dc.maxBytes {
+			return dc.evict()
+		}
+		return dc.writeSize(total)
+	})
+}
+
+// withLock runs cb while holding the lock on the directory, shared with the
+// other processes using it.
+func (dc *diskCache) withLock(cb func() error) (retErr error) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	f, err := os.OpenFile(filepath.Join(dc.dir, diskCacheLockFile), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := f.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	if err := lockFile(f); err != nil {
+		return err
+	}
+	defer func() {
+		if err := unlockFile(f); retErr == nil {
+			retErr = err
+		}
+	}()
+	return cb()
+}
+
+// readSize returns the number of bytes in the directory as recorded in the
+// size file. The lock must be held.
+func (dc *diskCache) readSize() (int64, error) {
+	data, err := os.ReadFile(filepath.Join(dc.dir, diskCacheSizeFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, errors.EnsureStack(err)
+	}
+	size, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		// The size is recomputed by the next eviction.
+		return dc.maxBytes + 1, nil
+	}
+	return size, nil
+}
+
+// writeSize records the number of bytes in the directory in the size file.
+// The lock must be held.
+func (dc *diskCache) writeSize(size int64) error {
+	diskCacheSizeMetric.Set(float64(size))
+	p := filepath.Join(dc.dir, diskCacheSizeFile)
+	tmp := filepath.Join(dc.dir, diskCacheTmpDir, diskCacheSizeFile)
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(size, 10)), 0600); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(os.Rename(tmp, p))
+}
+
+// evict removes the least recently used files until the cache is below the
+// low watermark, and records the resulting size. It also removes the
+// temporary files left behind by crashed writers. The lock must be held.
+func (dc *diskCache) evict() error {
+	type entry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var entries []entry
+	var total int64
+	tmpDir := filepath.Join(dc.dir, diskCacheTmpDir)
+	if err := filepath.WalkDir(dc.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files may be removed by other processes during the walk.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Dir(p) == dc.dir {
+			// Skip the lock and size files.
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		if filepath.Dir(p) == tmpDir {
+			if time.Since(info.ModTime()) > diskCacheStaleTmp {
+				if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+					return errors.EnsureStack(err)
+				}
+			}
+			return nil
+		}
+		entries = append(entries, entry{path: p, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if total > dc.maxBytes {
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].modTime.Before(entries[j].modTime)
+		})
+		target := int64(float64(dc.maxBytes) * diskCacheLowWatermark)
+		for _, e := range entries {
+			if total <= target {
+				break
+			}
+			if err := os.Remove(e.path); err != nil && !os.IsNotExist(err) {
+				return errors.EnsureStack(err)
+			}
+			total -= e.size
+			diskCacheEvictionMetric.Inc()
+		}
+	}
+	return dc.writeSize(total)
+}
+
+func (dc *diskCache) path(key []byte) string {
+	name := hex.EncodeToString(key)
+	if len(name) < 2 {
+		return filepath.Join(dc.dir, "_", name)
+	}
+	// Spread the files over subdirectories to keep the directories small.
+	return filepath.Join(dc.dir, name[:2], name)
+}
+
+// decodeDiskCacheValue splits the contents of a cache file into the value
+// and its checksum, returning false if the checksum doesn't match.
+func decodeDiskCacheValue(data []byte) ([]byte, bool) {
+	if len(data) < pachhash.OutputSize {
+		return nil, false
+	}
+	value, sum := data[:len(data)-pachhash.OutputSize], data[len(data)-pachhash.OutputSize:]
+	expected := pachhash.Sum(value)
+	return value, bytes.Equal(expected[:], sum)
+}
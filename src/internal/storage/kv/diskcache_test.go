@@ -0,0 +1,123 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func getValue(t *testing.T, c GetPut, key string) ([]byte, error) {
+	var value []byte
+	err := c.Get(context.Background(), []byte(key), func(x []byte) error {
+		value = append([]byte{}, x...)
+		return nil
+	})
+	return value, err
+}
+
+func TestDiskCache(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, err := NewDiskCache(dir, 1000)
+	require.NoError(t, err)
+	_, err = getValue(t, c, "a")
+	require.True(t, pacherr.IsNotExist(err))
+	require.NoError(t, c.Put(ctx, []byte("a"), []byte("value a")))
+	value, err := getValue(t, c, "a")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value a"), value)
+
+	// Another cache on the same directory sees the value.
+	c2, err := NewDiskCache(dir, 1000)
+	require.NoError(t, err)
+	value, err = getValue(t, c2, "a")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value a"), value)
+
+	// Corrupt values are removed.
+	dc := c.(*diskCache)
+	require.NoError(t, os.WriteFile(dc.path([]byte("a")), []byte("bit rot"), 0644))
+	_, err = getValue(t, c, "a")
+	require.True(t, pacherr.IsNotExist(err))
+	_, err = os.Stat(dc.path([]byte("a")))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDiskCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, err := NewDiskCache(dir, 600)
+	require.NoError(t, err)
+	value := bytes.Repeat([]byte{'x'}, 100)
+	keys := []string{"a", "b", "c", "d"}
+	for i, key := range keys {
+		require.NoError(t, c.Put(ctx, []byte(key), value))
+		// Make the modification times distinct, oldest first.
+		mtime := time.Now().Add(time.Duration(i-len(keys)) * time.Minute)
+		require.NoError(t, os.Chtimes(c.(*diskCache).path([]byte(key)), mtime, mtime))
+	}
+	// Reading "a" makes it the most recently used.
+	_, err = getValue(t, c, "a")
+	require.NoError(t, err)
+	require.NoError(t, c.Put(ctx, []byte("e"), value))
+	_, err = getValue(t, c, "b")
+	require.True(t, pacherr.IsNotExist(err))
+	for _, key := range []string{"a", "c", "d", "e"} {
+		_, err := getValue(t, c, key)
+		require.NoError(t, err)
+	}
+
+	// Stale temporary files left behind by crashed writers are removed.
+	tmp := filepath.Join(dir, diskCacheTmpDir, "put-crashed")
+	require.NoError(t, os.WriteFile(tmp, value, 0644))
+	mtime := time.Now().Add(-2 * diskCacheStaleTmp)
+	require.NoError(t, os.Chtimes(tmp, mtime, mtime))
+	_, err = NewDiskCache(dir, 600)
+	require.NoError(t, err)
+	_, err = os.Stat(tmp)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDiskCacheShared(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	// Caches on the same directory enforce the maximum size together.
+	var caches []GetPut
+	for i := 0; i < 2; i++ {
+		c, err := NewDiskCache(dir, 1000)
+		require.NoError(t, err)
+		caches = append(caches, c)
+	}
+	value := bytes.Repeat([]byte{'x'}, 100)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, caches[i%2].Put(ctx, []byte{byte(i)}, value))
+	}
+	var total int64
+	require.NoError(t, filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Dir(p) == dir {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		total += info.Size()
+		return nil
+	}))
+	require.True(t, total <= 1000)
+	size, err := caches[0].(*diskCache).readSize()
+	require.NoError(t, err)
+	require.Equal(t, total, size)
+
+	// The directory is only accessible to its owner.
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0700), info.Mode().Perm())
+}
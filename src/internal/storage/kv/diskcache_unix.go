@@ -0,0 +1,19 @@
+//go:build !windows
+// +build !windows
+
+package kv
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+func lockFile(f *os.File) error {
+	return errors.EnsureStack(syscall.Flock(int(f.Fd()), syscall.LOCK_EX))
+}
+
+func unlockFile(f *os.File) error {
+	return errors.EnsureStack(syscall.Flock(int(f.Fd()), syscall.LOCK_UN))
+}
@@ -33,6 +33,8 @@ func (s *objectAdapter) Put(ctx context.Context, key, value []byte) (retErr erro
 
 func (s *objectAdapter) Get(ctx context.Context, key []byte, cb ValueCallback) (retErr error) {
 	return s.withBuffer(func(buf *bytes.Buffer) error {
+		// Values are read whole, rather than in ranges, so that they are
+		// added to any cache in front of the object store.
 		if err := s.objC.Get(ctx, string(key), buf); err != nil {
 			return errors.EnsureStack(err)
 		}
@@ -27,6 +27,10 @@ func (dm DeleterMux) DeleteTx(tx *pachsql.Tx, id string) error {
 	return errors.EnsureStack(deleter.DeleteTx(tx, id))
 }
 
+// ErrGCPaused is returned by the garbage collectors when garbage collection is
+// paused during a pass.
+var ErrGCPaused = errors.New("garbage collection was paused")
+
 // GarbageCollector periodically runs garbage collection on tracker objects
 type GarbageCollector struct {
 	tracker Tracker
@@ -49,7 +53,7 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	ticker := time.NewTicker(gc.period)
 	defer ticker.Stop()
 	for {
-		if err := gc.RunUntilEmpty(ctx); err != nil {
+		if err := gc.RunUntilEmpty(ctx); err != nil && !errors.Is(err, ErrGCPaused) {
 			logrus.Errorf("gc: %v", err)
 		}
 		select {
@@ -75,9 +79,19 @@ func (gc *GarbageCollector) RunUntilEmpty(ctx context.Context) error {
 }
 
 // RunOnce run's one cycle of garbage collection.
+// It does nothing while garbage collection is paused, and stops with
+// ErrGCPaused if garbage collection is paused during the cycle.
 func (gc *GarbageCollector) RunOnce(ctx context.Context) (int, error) {
+	if paused, err := IsGCPaused(ctx, gc.tracker.DB()); err != nil {
+		return 0, err
+	} else if paused {
+		return 0, nil
+	}
 	var n int
 	err := gc.tracker.IterateDeletable(ctx, func(id string) error {
+		if err := CheckGCPaused(ctx, gc.tracker.DB()); err != nil {
+			return err
+		}
 		if err := gc.deleteObject(ctx, id); err != nil {
 			logrus.Errorf("error deleting object (%s): %v", id, err)
 		} else {
@@ -97,3 +111,88 @@ func (gc *GarbageCollector) deleteObject(ctx context.Context, id string) error {
 		return errors.EnsureStack(gc.deleter.DeleteTx(tx, id))
 	})
 }
+
+// IterateGarbage calls cb with every object that garbage collection would
+// delete if it ran until empty now: the expired objects which are not
+// reachable from an object that hasn't expired.
+func IterateGarbage(ctx context.Context, tr Tracker, cb func(id string) error) (retErr error) {
+	rows, err := tr.DB().QueryxContext(ctx, `
+	WITH RECURSIVE live(int_id) AS (
+		SELECT int_id FROM storage.tracker_objects
+		WHERE expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP
+		UNION
+		SELECT refs.to_id FROM storage.tracker_refs refs
+		JOIN live ON refs.from_id = live.int_id
+	)
+	SELECT str_id FROM storage.tracker_objects objs
+	WHERE NOT EXISTS (SELECT 1 FROM live WHERE live.int_id = objs.int_id)
+	ORDER BY str_id
+	`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(id); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
+// SetupPostgresGCStateV0 sets up the table holding the state shared by the
+// garbage collectors.
+func SetupPostgresGCStateV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, gcStateSchema)
+	return errors.EnsureStack(err)
+}
+
+var gcStateSchema = `
+	CREATE TABLE storage.gc_state (
+		id INT PRIMARY KEY CHECK (id = 0),
+		paused BOOLEAN NOT NULL DEFAULT FALSE,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	INSERT INTO storage.gc_state (id) VALUES (0) ON CONFLICT DO NOTHING;
+`
+
+// SetGCPaused pauses or resumes garbage collection. While it is paused, the
+// garbage collectors don't delete anything.
+func SetGCPaused(ctx context.Context, db *pachsql.DB, paused bool) error {
+	_, err := db.ExecContext(ctx, `
+	UPDATE storage.gc_state SET paused = $1, updated_at = CURRENT_TIMESTAMP WHERE id = 0
+	`, paused)
+	return errors.EnsureStack(err)
+}
+
+// IsGCPaused returns true if garbage collection is paused.
+func IsGCPaused(ctx context.Context, db *pachsql.DB) (bool, error) {
+	var paused bool
+	if err := db.GetContext(ctx, &paused, `SELECT paused FROM storage.gc_state WHERE id = 0`); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	return paused, nil
+}
+
+// CheckGCPaused returns ErrGCPaused if garbage collection is paused. The
+// garbage collectors call it before deleting each object, so that pausing
+// stops a pass in progress.
+func CheckGCPaused(ctx context.Context, db *pachsql.DB) error {
+	paused, err := IsGCPaused(ctx, db)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return ErrGCPaused
+	}
+	return nil
+}
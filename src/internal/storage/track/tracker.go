@@ -223,5 +223,6 @@ func runGC(t *testing.T, tracker Tracker) int {
 func NewTestTracker(t testing.TB, db *pachsql.DB) Tracker {
 	db.MustExec("CREATE SCHEMA IF NOT EXISTS storage")
 	db.MustExec(schema)
+	db.MustExec(gcStateSchema)
 	return NewPostgresTracker(db)
 }
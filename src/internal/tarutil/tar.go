@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 )
@@ -158,6 +159,10 @@ func Equal(file1, file2 File, full ...bool) (bool, error) {
 	return bytes.Equal(buf1.Bytes(), buf2.Bytes()), nil
 }
 
+// Import writes the files in the tar stream r under storageRoot. Entries
+// whose path escapes storageRoot, symlinks whose target is absolute or escapes
+// storageRoot, and entries that would be written through a symlink are
+// rejected.
 func Import(storageRoot string, r io.Reader, cb ...func(*tar.Header) error) error {
 	tr := tar.NewReader(r)
 	for {
@@ -173,18 +178,94 @@ func Import(storageRoot string, r io.Reader, cb ...func(*tar.Header) error) erro
 				return err
 			}
 		}
-		// TODO: Use the tar header metadata.
-		fullPath := path.Join(storageRoot, hdr.Name)
-		if hdr.Typeflag == tar.TypeDir {
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "/"))
+		if escapes(name) {
+			return errors.Errorf("tar entry %q escapes %q", hdr.Name, storageRoot)
+		}
+		if err := checkNoSymlinks(storageRoot, path.Dir(name)); err != nil {
+			return err
+		}
+		fullPath := path.Join(storageRoot, name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
 			if err := os.MkdirAll(fullPath, 0777); err != nil {
 				return errors.EnsureStack(err)
 			}
 			continue
+		case tar.TypeSymlink:
+			if path.IsAbs(hdr.Linkname) || escapes(path.Join(path.Dir(name), hdr.Linkname)) {
+				return errors.Errorf("symlink %q points outside of %q (%q)", hdr.Name, storageRoot, hdr.Linkname)
+			}
+			if err := writeSymlink(fullPath, hdr.Linkname); err != nil {
+				return err
+			}
+			continue
+		}
+		// Replace, rather than write through, an existing symlink.
+		if fi, err := os.Lstat(fullPath); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+			if err := os.Remove(fullPath); err != nil {
+				return errors.EnsureStack(err)
+			}
 		}
 		if err := writeFile(fullPath, tr); err != nil {
 			return err
 		}
+		if err := applyHeader(fullPath, hdr); err != nil {
+			return err
+		}
+	}
+}
+
+// escapes returns true if the clean relative path p refers to a location
+// outside of the directory it is relative to.
+func escapes(p string) bool {
+	return p == ".." || strings.HasPrefix(p, "../")
+}
+
+// checkNoSymlinks returns an error if dir, relative to root, or any of its
+// parents under root is a symlink, as writing under it would write wherever
+// the symlink points.
+func checkNoSymlinks(root, dir string) error {
+	for ; dir != "." && dir != "/"; dir = path.Dir(dir) {
+		fi, err := os.Lstat(path.Join(root, dir))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			return errors.Errorf("cannot write through symlink %q", path.Join(root, dir))
+		}
+	}
+	return nil
+}
+
+func writeSymlink(linkPath, target string) error {
+	if err := os.MkdirAll(path.Dir(linkPath), 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := os.Remove(linkPath); err != nil && !os.IsNotExist(err) {
+		return errors.EnsureStack(err)
 	}
+	return errors.EnsureStack(os.Symlink(target, linkPath))
+}
+
+// applyHeader applies the mode and modification time in hdr to the file at
+// filePath. Headers without a mode or modification time leave the file as is.
+func applyHeader(filePath string, hdr *tar.Header) error {
+	if hdr.Mode != 0 {
+		mode := hdr.FileInfo().Mode() & (os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky)
+		if err := os.Chmod(filePath, mode); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if hdr.ModTime.Unix() > 0 {
+		if err := os.Chtimes(filePath, hdr.ModTime, hdr.ModTime); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
 }
 
 func writeFile(filePath string, r io.Reader) (retErr error) {
@@ -0,0 +1,61 @@
+package tarutil
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func writeTar(t *testing.T, hdrs ...*tar.Header) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, hdr := range hdrs {
+		require.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Size > 0 {
+			_, err := tw.Write(bytes.Repeat([]byte("a"), int(hdr.Size)))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	return buf
+}
+
+func TestImportSymlinks(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, Import(root, writeTar(t,
+		&tar.Header{Name: "dir/file", Typeflag: tar.TypeReg, Size: 1, Mode: 0644},
+		&tar.Header{Name: "dir/link", Typeflag: tar.TypeSymlink, Linkname: "file"},
+		&tar.Header{Name: "uplink", Typeflag: tar.TypeSymlink, Linkname: "dir/../dir/file"},
+	)))
+	target, err := os.Readlink(filepath.Join(root, "dir/link"))
+	require.NoError(t, err)
+	require.Equal(t, "file", target)
+
+	outside := t.TempDir()
+	for _, hdrs := range [][]*tar.Header{
+		// entries and symlink targets can't escape the root
+		{{Name: "../file", Typeflag: tar.TypeReg, Size: 1}},
+		{{Name: "abs", Typeflag: tar.TypeSymlink, Linkname: outside}},
+		{{Name: "dir/rel", Typeflag: tar.TypeSymlink, Linkname: "../../" + filepath.Base(outside)}},
+		// nothing is written through a symlink
+		{{Name: "dir/link/file", Typeflag: tar.TypeReg, Size: 1}},
+	} {
+		require.YesError(t, Import(root, writeTar(t, hdrs...)), hdrs[0].Name)
+	}
+	entries, err := os.ReadDir(outside)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+
+	// An existing symlink is replaced rather than written through.
+	require.NoError(t, Import(root, writeTar(t, &tar.Header{Name: "dir/link", Typeflag: tar.TypeReg, Size: 2, Mode: 0644})))
+	fi, err := os.Lstat(filepath.Join(root, "dir/link"))
+	require.NoError(t, err)
+	require.True(t, fi.Mode().IsRegular())
+	content, err := os.ReadFile(filepath.Join(root, "dir/file"))
+	require.NoError(t, err)
+	require.Equal(t, "a", string(content))
+}
@@ -88,6 +88,10 @@ type getPermissionsFunc func(context.Context, *auth.GetPermissionsRequest) (*aut
 type getPermissionsForPrincipalFunc func(context.Context, *auth.GetPermissionsForPrincipalRequest) (*auth.GetPermissionsResponse, error)
 type whoAmIFunc func(context.Context, *auth.WhoAmIRequest) (*auth.WhoAmIResponse, error)
 type getRolesForPermissionFunc func(context.Context, *auth.GetRolesForPermissionRequest) (*auth.GetRolesForPermissionResponse, error)
+type createRoleFunc func(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error)
+type updateRoleFunc func(context.Context, *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error)
+type deleteRoleFunc func(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error)
+type listRoleFunc func(context.Context, *auth.ListRoleRequest) (*auth.ListRoleResponse, error)
 type getOIDCLoginFunc func(context.Context, *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error)
 type getRobotTokenFunc func(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error)
 type revokeAuthTokenFunc func(context.Context, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error)
@@ -101,6 +105,11 @@ type extractAuthTokensFunc func(context.Context, *auth.ExtractAuthTokensRequest)
 type restoreAuthTokenFunc func(context.Context, *auth.RestoreAuthTokenRequest) (*auth.RestoreAuthTokenResponse, error)
 type deleteExpiredAuthTokensFunc func(context.Context, *auth.DeleteExpiredAuthTokensRequest) (*auth.DeleteExpiredAuthTokensResponse, error)
 type RotateRootTokenFunc func(context.Context, *auth.RotateRootTokenRequest) (*auth.RotateRootTokenResponse, error)
+type listAuditEventsFunc func(context.Context, *auth.ListAuditEventsRequest) (*auth.ListAuditEventsResponse, error)
+type getScopedTokenFunc func(context.Context, *auth.GetScopedTokenRequest) (*auth.GetScopedTokenResponse, error)
+type listAuthTokensFunc func(context.Context, *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error)
+type exportConfigFunc func(context.Context, *auth.ExportConfigRequest) (*auth.ExportConfigResponse, error)
+type importConfigFunc func(context.Context, *auth.ImportConfigRequest) (*auth.ImportConfigResponse, error)
 
 type mockActivateAuth struct{ handler activateAuthFunc }
 type mockDeactivateAuth struct{ handler deactivateAuthFunc }
@@ -117,6 +126,10 @@ type mockGetPermissionsForPrincipal struct {
 }
 type mockWhoAmI struct{ handler whoAmIFunc }
 type mockGetRolesForPermission struct{ handler getRolesForPermissionFunc }
+type mockCreateRole struct{ handler createRoleFunc }
+type mockUpdateRole struct{ handler updateRoleFunc }
+type mockDeleteRole struct{ handler deleteRoleFunc }
+type mockListRole struct{ handler listRoleFunc }
 type mockGetOIDCLogin struct{ handler getOIDCLoginFunc }
 type mockGetRobotToken struct{ handler getRobotTokenFunc }
 type mockRevokeAuthToken struct{ handler revokeAuthTokenFunc }
@@ -130,6 +143,11 @@ type mockExtractAuthTokens struct{ handler extractAuthTokensFunc }
 type mockRestoreAuthToken struct{ handler restoreAuthTokenFunc }
 type mockDeleteExpiredAuthTokens struct{ handler deleteExpiredAuthTokensFunc }
 type mockRotateRootToken struct{ handler RotateRootTokenFunc }
+type mockListAuditEvents struct{ handler listAuditEventsFunc }
+type mockGetScopedToken struct{ handler getScopedTokenFunc }
+type mockListAuthTokens struct{ handler listAuthTokensFunc }
+type mockExportConfig struct{ handler exportConfigFunc }
+type mockImportConfig struct{ handler importConfigFunc }
 
 func (mock *mockActivateAuth) Use(cb activateAuthFunc)                             { mock.handler = cb }
 func (mock *mockDeactivateAuth) Use(cb deactivateAuthFunc)                         { mock.handler = cb }
@@ -141,6 +159,10 @@ func (mock *mockAuthenticate) Use(cb authenticateFunc)
 func (mock *mockAuthorize) Use(cb authorizeFunc)                                   { mock.handler = cb }
 func (mock *mockWhoAmI) Use(cb whoAmIFunc)                                         { mock.handler = cb }
 func (mock *mockGetRolesForPermission) Use(cb getRolesForPermissionFunc)           { mock.handler = cb }
+func (mock *mockCreateRole) Use(cb createRoleFunc)                                 { mock.handler = cb }
+func (mock *mockUpdateRole) Use(cb updateRoleFunc)                                 { mock.handler = cb }
+func (mock *mockDeleteRole) Use(cb deleteRoleFunc)                                 { mock.handler = cb }
+func (mock *mockListRole) Use(cb listRoleFunc)                                     { mock.handler = cb }
 func (mock *mockGetOIDCLogin) Use(cb getOIDCLoginFunc)                             { mock.handler = cb }
 func (mock *mockGetRobotToken) Use(cb getRobotTokenFunc)                           { mock.handler = cb }
 func (mock *mockRevokeAuthToken) Use(cb revokeAuthTokenFunc)                       { mock.handler = cb }
@@ -156,6 +178,11 @@ func (mock *mockExtractAuthTokens) Use(cb extractAuthTokensFunc)
 func (mock *mockRestoreAuthToken) Use(cb restoreAuthTokenFunc)                     { mock.handler = cb }
 func (mock *mockDeleteExpiredAuthTokens) Use(cb deleteExpiredAuthTokensFunc)       { mock.handler = cb }
 func (mock *mockRotateRootToken) Use(cb RotateRootTokenFunc)                       { mock.handler = cb }
+func (mock *mockListAuditEvents) Use(cb listAuditEventsFunc)                       { mock.handler = cb }
+func (mock *mockGetScopedToken) Use(cb getScopedTokenFunc)                         { mock.handler = cb }
+func (mock *mockListAuthTokens) Use(cb listAuthTokensFunc)                         { mock.handler = cb }
+func (mock *mockExportConfig) Use(cb exportConfigFunc)                             { mock.handler = cb }
+func (mock *mockImportConfig) Use(cb importConfigFunc)                             { mock.handler = cb }
 
 type authServerAPI struct {
 	mock *mockAuthServer
@@ -175,6 +202,10 @@ type mockAuthServer struct {
 	GetPermissionsForPrincipal mockGetPermissionsForPrincipal
 	WhoAmI                     mockWhoAmI
 	GetRolesForPermission      mockGetRolesForPermission
+	CreateRole                 mockCreateRole
+	UpdateRole                 mockUpdateRole
+	DeleteRole                 mockDeleteRole
+	ListRole                   mockListRole
 	GetOIDCLogin               mockGetOIDCLogin
 	GetRobotToken              mockGetRobotToken
 	RevokeAuthToken            mockRevokeAuthToken
@@ -188,6 +219,11 @@ type mockAuthServer struct {
 	RestoreAuthToken           mockRestoreAuthToken
 	DeleteExpiredAuthTokens    mockDeleteExpiredAuthTokens
 	RotateRootToken            mockRotateRootToken
+	ListAuditEvents            mockListAuditEvents
+	GetScopedToken             mockGetScopedToken
+	ListAuthTokens             mockListAuthTokens
+	ExportConfig               mockExportConfig
+	ImportConfig               mockImportConfig
 }
 
 func (api *authServerAPI) Activate(ctx context.Context, req *auth.ActivateRequest) (*auth.ActivateResponse, error) {
@@ -262,6 +298,30 @@ func (api *authServerAPI) GetRolesForPermission(ctx context.Context, req *auth.G
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.GetRolesForPermission")
 }
+func (api *authServerAPI) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	if api.mock.CreateRole.handler != nil {
+		return api.mock.CreateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.CreateRole")
+}
+func (api *authServerAPI) UpdateRole(ctx context.Context, req *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error) {
+	if api.mock.UpdateRole.handler != nil {
+		return api.mock.UpdateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.UpdateRole")
+}
+func (api *authServerAPI) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	if api.mock.DeleteRole.handler != nil {
+		return api.mock.DeleteRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.DeleteRole")
+}
+func (api *authServerAPI) ListRole(ctx context.Context, req *auth.ListRoleRequest) (*auth.ListRoleResponse, error) {
+	if api.mock.ListRole.handler != nil {
+		return api.mock.ListRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListRole")
+}
 func (api *authServerAPI) GetOIDCLogin(ctx context.Context, req *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error) {
 	if api.mock.GetOIDCLogin.handler != nil {
 		return api.mock.GetOIDCLogin.handler(ctx, req)
@@ -345,6 +405,37 @@ func (api *authServerAPI) RotateRootToken(ctx context.Context, req *auth.RotateR
 	return nil, errors.Errorf("unhandled pachd mock auth.RotateRootToken")
 }
 
+func (api *authServerAPI) ListAuditEvents(ctx context.Context, req *auth.ListAuditEventsRequest) (*auth.ListAuditEventsResponse, error) {
+	if api.mock.ListAuditEvents.handler != nil {
+		return api.mock.ListAuditEvents.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListAuditEvents")
+}
+func (api *authServerAPI) ExportConfig(ctx context.Context, req *auth.ExportConfigRequest) (*auth.ExportConfigResponse, error) {
+	if api.mock.ExportConfig.handler != nil {
+		return api.mock.ExportConfig.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ExportConfig")
+}
+func (api *authServerAPI) ImportConfig(ctx context.Context, req *auth.ImportConfigRequest) (*auth.ImportConfigResponse, error) {
+	if api.mock.ImportConfig.handler != nil {
+		return api.mock.ImportConfig.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ImportConfig")
+}
+func (api *authServerAPI) GetScopedToken(ctx context.Context, req *auth.GetScopedTokenRequest) (*auth.GetScopedTokenResponse, error) {
+	if api.mock.GetScopedToken.handler != nil {
+		return api.mock.GetScopedToken.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.GetScopedToken")
+}
+func (api *authServerAPI) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error) {
+	if api.mock.ListAuthTokens.handler != nil {
+		return api.mock.ListAuthTokens.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListAuthTokens")
+}
+
 /* Enterprise Server Mocks */
 
 type activateEnterpriseFunc func(context.Context, *enterprise.ActivateRequest) (*enterprise.ActivateResponse, error)
@@ -428,6 +519,7 @@ type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty,
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
 type finishCommitFunc func(context.Context, *pfs.FinishCommitRequest) (*types.Empty, error)
 type inspectCommitFunc func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
+type inspectCommitCompactionFunc func(context.Context, *pfs.InspectCommitCompactionRequest) (*pfs.CommitCompactionInfo, error)
 type listCommitFunc func(*pfs.ListCommitRequest, pfs.API_ListCommitServer) error
 type squashCommitSetFunc func(context.Context, *pfs.SquashCommitSetRequest) (*types.Empty, error)
 type dropCommitSetFunc func(context.Context, *pfs.DropCommitSetRequest) (*types.Empty, error)
@@ -455,6 +547,11 @@ type getFileSetFunc func(context.Context, *pfs.GetFileSetRequest) (*pfs.CreateFi
 type renewFileSetFunc func(context.Context, *pfs.RenewFileSetRequest) (*types.Empty, error)
 type composeFileSetFunc func(context.Context, *pfs.ComposeFileSetRequest) (*pfs.CreateFileSetResponse, error)
 type checkStorageFunc func(context.Context, *pfs.CheckStorageRequest) (*pfs.CheckStorageResponse, error)
+type scrubStorageFunc func(*pfs.ScrubStorageRequest, pfs.API_ScrubStorageServer) error
+type replicationStatusFunc func(context.Context, *pfs.ReplicationStatusRequest) (*pfs.ReplicationStatusResponse, error)
+type garbageCollectStorageFunc func(context.Context, *pfs.GarbageCollectStorageRequest) (*pfs.GarbageCollectStorageResponse, error)
+type setStorageGCPausedFunc func(context.Context, *pfs.SetStorageGCPausedRequest) (*types.Empty, error)
+type restoreStorageTrashFunc func(context.Context, *pfs.RestoreStorageTrashRequest) (*pfs.RestoreStorageTrashResponse, error)
 type putCacheFunc func(context.Context, *pfs.PutCacheRequest) (*types.Empty, error)
 type getCacheFunc func(context.Context, *pfs.GetCacheRequest) (*pfs.GetCacheResponse, error)
 type clearCacheFunc func(context.Context, *pfs.ClearCacheRequest) (*types.Empty, error)
@@ -471,6 +568,7 @@ type mockDeleteRepo struct{ handler deleteRepoFunc }
 type mockStartCommit struct{ handler startCommitFunc }
 type mockFinishCommit struct{ handler finishCommitFunc }
 type mockInspectCommit struct{ handler inspectCommitFunc }
+type mockInspectCommitCompaction struct{ handler inspectCommitCompactionFunc }
 type mockListCommit struct{ handler listCommitFunc }
 type mockSquashCommitSet struct{ handler squashCommitSetFunc }
 type mockDropCommitSet struct{ handler dropCommitSetFunc }
@@ -498,6 +596,11 @@ type mockGetFileSet struct{ handler getFileSetFunc }
 type mockRenewFileSet struct{ handler renewFileSetFunc }
 type mockComposeFileSet struct{ handler composeFileSetFunc }
 type mockCheckStorage struct{ handler checkStorageFunc }
+type mockScrubStorage struct{ handler scrubStorageFunc }
+type mockReplicationStatus struct{ handler replicationStatusFunc }
+type mockGarbageCollectStorage struct{ handler garbageCollectStorageFunc }
+type mockSetStorageGCPaused struct{ handler setStorageGCPausedFunc }
+type mockRestoreStorageTrash struct{ handler restoreStorageTrashFunc }
 type mockPutCache struct{ handler putCacheFunc }
 type mockGetCache struct{ handler getCacheFunc }
 type mockClearCache struct{ handler clearCacheFunc }
@@ -506,97 +609,109 @@ type mockRunLoadTestDefault struct{ handler runLoadTestDefaultFunc }
 type mockListTaskPFS struct{ handler listTaskPFSFunc }
 type mockEgress struct{ handler egressFunc }
 
-func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)       { mock.handler = cb }
-func (mock *mockCreateRepo) Use(cb createRepoFunc)                 { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)               { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)                     { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                 { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)               { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)             { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)           { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)                 { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)       { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)               { mock.handler = cb }
-func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)       { mock.handler = cb }
-func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)           { mock.handler = cb }
-func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)     { mock.handler = cb }
-func (mock *mockListCommitSet) Use(cb listCommitSetFunc)           { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)             { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)           { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)                 { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)             { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)                 { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                       { mock.handler = cb }
-func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                 { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)               { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)                     { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)                     { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)                     { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)                     { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)             { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                             { mock.handler = cb }
-func (mock *mockCreateFileSet) Use(cb createFileSetFunc)           { mock.handler = cb }
-func (mock *mockAddFileSet) Use(cb addFileSetFunc)                 { mock.handler = cb }
-func (mock *mockGetFileSet) Use(cb getFileSetFunc)                 { mock.handler = cb }
-func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)             { mock.handler = cb }
-func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)         { mock.handler = cb }
-func (mock *mockCheckStorage) Use(cb checkStorageFunc)             { mock.handler = cb }
-func (mock *mockPutCache) Use(cb putCacheFunc)                     { mock.handler = cb }
-func (mock *mockGetCache) Use(cb getCacheFunc)                     { mock.handler = cb }
-func (mock *mockClearCache) Use(cb clearCacheFunc)                 { mock.handler = cb }
-func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)               { mock.handler = cb }
-func (mock *mockRunLoadTestDefault) Use(cb runLoadTestDefaultFunc) { mock.handler = cb }
-func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)               { mock.handler = cb }
-func (mock *mockEgress) Use(cb egressFunc)                         { mock.handler = cb }
+func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)                 { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                           { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)                         { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                               { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                           { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)                         { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)                       { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)                     { mock.handler = cb }
+func (mock *mockInspectCommitCompaction) Use(cb inspectCommitCompactionFunc) { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                           { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)                 { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)                         { mock.handler = cb }
+func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)                 { mock.handler = cb }
+func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)                     { mock.handler = cb }
+func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)               { mock.handler = cb }
+func (mock *mockListCommitSet) Use(cb listCommitSetFunc)                     { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)                       { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)                     { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                           { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)                       { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                           { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                                 { mock.handler = cb }
+func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                           { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)                         { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                               { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                               { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                               { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                               { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)                       { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                                       { mock.handler = cb }
+func (mock *mockCreateFileSet) Use(cb createFileSetFunc)                     { mock.handler = cb }
+func (mock *mockAddFileSet) Use(cb addFileSetFunc)                           { mock.handler = cb }
+func (mock *mockGetFileSet) Use(cb getFileSetFunc)                           { mock.handler = cb }
+func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)                       { mock.handler = cb }
+func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)                   { mock.handler = cb }
+func (mock *mockCheckStorage) Use(cb checkStorageFunc)                       { mock.handler = cb }
+func (mock *mockScrubStorage) Use(cb scrubStorageFunc)                       { mock.handler = cb }
+func (mock *mockReplicationStatus) Use(cb replicationStatusFunc)             { mock.handler = cb }
+func (mock *mockGarbageCollectStorage) Use(cb garbageCollectStorageFunc)     { mock.handler = cb }
+func (mock *mockSetStorageGCPaused) Use(cb setStorageGCPausedFunc)           { mock.handler = cb }
+func (mock *mockRestoreStorageTrash) Use(cb restoreStorageTrashFunc)         { mock.handler = cb }
+func (mock *mockPutCache) Use(cb putCacheFunc)                               { mock.handler = cb }
+func (mock *mockGetCache) Use(cb getCacheFunc)                               { mock.handler = cb }
+func (mock *mockClearCache) Use(cb clearCacheFunc)                           { mock.handler = cb }
+func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)                         { mock.handler = cb }
+func (mock *mockRunLoadTestDefault) Use(cb runLoadTestDefaultFunc)           { mock.handler = cb }
+func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)                         { mock.handler = cb }
+func (mock *mockEgress) Use(cb egressFunc)                                   { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
 
 type mockPFSServer struct {
-	api                pfsServerAPI
-	ActivateAuth       mockActivateAuthPFS
-	CreateRepo         mockCreateRepo
-	InspectRepo        mockInspectRepo
-	ListRepo           mockListRepo
-	DeleteRepo         mockDeleteRepo
-	StartCommit        mockStartCommit
-	FinishCommit       mockFinishCommit
-	InspectCommit      mockInspectCommit
-	ListCommit         mockListCommit
-	SubscribeCommit    mockSubscribeCommit
-	ClearCommit        mockClearCommit
-	SquashCommitSet    mockSquashCommitSet
-	DropCommitSet      mockDropCommitSet
-	InspectCommitSet   mockInspectCommitSet
-	ListCommitSet      mockListCommitSet
-	CreateBranch       mockCreateBranch
-	InspectBranch      mockInspectBranch
-	ListBranch         mockListBranch
-	DeleteBranch       mockDeleteBranch
-	ModifyFile         mockModifyFile
-	GetFile            mockGetFile
-	GetFileTAR         mockGetFileTAR
-	InspectFile        mockInspectFile
-	ListFile           mockListFile
-	WalkFile           mockWalkFile
-	GlobFile           mockGlobFile
-	DiffFile           mockDiffFile
-	DeleteAll          mockDeleteAllPFS
-	Fsck               mockFsck
-	CreateFileSet      mockCreateFileSet
-	AddFileSet         mockAddFileSet
-	GetFileSet         mockGetFileSet
-	RenewFileSet       mockRenewFileSet
-	ComposeFileSet     mockComposeFileSet
-	CheckStorage       mockCheckStorage
-	PutCache           mockPutCache
-	GetCache           mockGetCache
-	ClearCache         mockClearCache
-	RunLoadTest        mockRunLoadTest
-	RunLoadTestDefault mockRunLoadTestDefault
-	ListTask           mockListTaskPFS
-	Egress             mockEgress
+	api                     pfsServerAPI
+	ActivateAuth            mockActivateAuthPFS
+	CreateRepo              mockCreateRepo
+	InspectRepo             mockInspectRepo
+	ListRepo                mockListRepo
+	DeleteRepo              mockDeleteRepo
+	StartCommit             mockStartCommit
+	FinishCommit            mockFinishCommit
+	InspectCommit           mockInspectCommit
+	InspectCommitCompaction mockInspectCommitCompaction
+	ListCommit              mockListCommit
+	SubscribeCommit         mockSubscribeCommit
+	ClearCommit             mockClearCommit
+	SquashCommitSet         mockSquashCommitSet
+	DropCommitSet           mockDropCommitSet
+	InspectCommitSet        mockInspectCommitSet
+	ListCommitSet           mockListCommitSet
+	CreateBranch            mockCreateBranch
+	InspectBranch           mockInspectBranch
+	ListBranch              mockListBranch
+	DeleteBranch            mockDeleteBranch
+	ModifyFile              mockModifyFile
+	GetFile                 mockGetFile
+	GetFileTAR              mockGetFileTAR
+	InspectFile             mockInspectFile
+	ListFile                mockListFile
+	WalkFile                mockWalkFile
+	GlobFile                mockGlobFile
+	DiffFile                mockDiffFile
+	DeleteAll               mockDeleteAllPFS
+	Fsck                    mockFsck
+	CreateFileSet           mockCreateFileSet
+	AddFileSet              mockAddFileSet
+	GetFileSet              mockGetFileSet
+	RenewFileSet            mockRenewFileSet
+	ComposeFileSet          mockComposeFileSet
+	CheckStorage            mockCheckStorage
+	ScrubStorage            mockScrubStorage
+	ReplicationStatus       mockReplicationStatus
+	GarbageCollectStorage   mockGarbageCollectStorage
+	SetStorageGCPaused      mockSetStorageGCPaused
+	RestoreStorageTrash     mockRestoreStorageTrash
+	PutCache                mockPutCache
+	GetCache                mockGetCache
+	ClearCache              mockClearCache
+	RunLoadTest             mockRunLoadTest
+	RunLoadTestDefault      mockRunLoadTestDefault
+	ListTask                mockListTaskPFS
+	Egress                  mockEgress
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -647,6 +762,12 @@ func (api *pfsServerAPI) InspectCommit(ctx context.Context, req *pfs.InspectComm
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.InspectCommit")
 }
+func (api *pfsServerAPI) InspectCommitCompaction(ctx context.Context, req *pfs.InspectCommitCompactionRequest) (*pfs.CommitCompactionInfo, error) {
+	if api.mock.InspectCommitCompaction.handler != nil {
+		return api.mock.InspectCommitCompaction.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectCommitCompaction")
+}
 func (api *pfsServerAPI) ListCommit(req *pfs.ListCommitRequest, serv pfs.API_ListCommitServer) error {
 	if api.mock.ListCommit.handler != nil {
 		return api.mock.ListCommit.handler(req, serv)
@@ -809,6 +930,36 @@ func (api *pfsServerAPI) CheckStorage(ctx context.Context, req *pfs.CheckStorage
 	}
 	return nil, errors.Errorf("unhandled pachd mock CheckStorage")
 }
+func (api *pfsServerAPI) ScrubStorage(req *pfs.ScrubStorageRequest, serv pfs.API_ScrubStorageServer) error {
+	if api.mock.ScrubStorage.handler != nil {
+		return api.mock.ScrubStorage.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock ScrubStorage")
+}
+func (api *pfsServerAPI) ReplicationStatus(ctx context.Context, req *pfs.ReplicationStatusRequest) (*pfs.ReplicationStatusResponse, error) {
+	if api.mock.ReplicationStatus.handler != nil {
+		return api.mock.ReplicationStatus.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock ReplicationStatus")
+}
+func (api *pfsServerAPI) GarbageCollectStorage(ctx context.Context, req *pfs.GarbageCollectStorageRequest) (*pfs.GarbageCollectStorageResponse, error) {
+	if api.mock.GarbageCollectStorage.handler != nil {
+		return api.mock.GarbageCollectStorage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock GarbageCollectStorage")
+}
+func (api *pfsServerAPI) SetStorageGCPaused(ctx context.Context, req *pfs.SetStorageGCPausedRequest) (*types.Empty, error) {
+	if api.mock.SetStorageGCPaused.handler != nil {
+		return api.mock.SetStorageGCPaused.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock SetStorageGCPaused")
+}
+func (api *pfsServerAPI) RestoreStorageTrash(ctx context.Context, req *pfs.RestoreStorageTrashRequest) (*pfs.RestoreStorageTrashResponse, error) {
+	if api.mock.RestoreStorageTrash.handler != nil {
+		return api.mock.RestoreStorageTrash.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock RestoreStorageTrash")
+}
 func (api *pfsServerAPI) PutCache(ctx context.Context, req *pfs.PutCacheRequest) (*types.Empty, error) {
 	if api.mock.PutCache.handler != nil {
 		return api.mock.PutCache.handler(ctx, req)
@@ -865,6 +1016,7 @@ type listJobSetFunc func(*pps.ListJobSetRequest, pps.API_ListJobSetServer) error
 type inspectDatumFunc func(context.Context, *pps.InspectDatumRequest) (*pps.DatumInfo, error)
 type listDatumFunc func(*pps.ListDatumRequest, pps.API_ListDatumServer) error
 type restartDatumFunc func(context.Context, *pps.RestartDatumRequest) (*types.Empty, error)
+type explainDatumFunc func(context.Context, *pps.ExplainDatumRequest) (*pps.ExplainDatumResponse, error)
 type createPipelineFunc func(context.Context, *pps.CreatePipelineRequest) (*types.Empty, error)
 type inspectPipelineFunc func(context.Context, *pps.InspectPipelineRequest) (*pps.PipelineInfo, error)
 type listPipelineFunc func(*pps.ListPipelineRequest, pps.API_ListPipelineServer) error
@@ -873,6 +1025,7 @@ type startPipelineFunc func(context.Context, *pps.StartPipelineRequest) (*types.
 type stopPipelineFunc func(context.Context, *pps.StopPipelineRequest) (*types.Empty, error)
 type runPipelineFunc func(context.Context, *pps.RunPipelineRequest) (*types.Empty, error)
 type runCronFunc func(context.Context, *pps.RunCronRequest) (*types.Empty, error)
+type replayDeadLetterFunc func(context.Context, *pps.ReplayDeadLetterRequest) (*types.Empty, error)
 type createSecretFunc func(context.Context, *pps.CreateSecretRequest) (*types.Empty, error)
 type deleteSecretFunc func(context.Context, *pps.DeleteSecretRequest) (*types.Empty, error)
 type inspectSecretFunc func(context.Context, *pps.InspectSecretRequest) (*pps.SecretInfo, error)
@@ -896,6 +1049,7 @@ type mockListJobSet struct{ handler listJobSetFunc }
 type mockInspectDatum struct{ handler inspectDatumFunc }
 type mockListDatum struct{ handler listDatumFunc }
 type mockRestartDatum struct{ handler restartDatumFunc }
+type mockExplainDatum struct{ handler explainDatumFunc }
 type mockCreatePipeline struct{ handler createPipelineFunc }
 type mockInspectPipeline struct{ handler inspectPipelineFunc }
 type mockListPipeline struct{ handler listPipelineFunc }
@@ -904,6 +1058,7 @@ type mockStartPipeline struct{ handler startPipelineFunc }
 type mockStopPipeline struct{ handler stopPipelineFunc }
 type mockRunPipeline struct{ handler runPipelineFunc }
 type mockRunCron struct{ handler runCronFunc }
+type mockReplayDeadLetter struct{ handler replayDeadLetterFunc }
 type mockCreateSecret struct{ handler createSecretFunc }
 type mockDeleteSecret struct{ handler deleteSecretFunc }
 type mockInspectSecret struct{ handler inspectSecretFunc }
@@ -927,6 +1082,7 @@ func (mock *mockListJobSet) Use(cb listJobSetFunc)                       { mock.
 func (mock *mockInspectDatum) Use(cb inspectDatumFunc)                   { mock.handler = cb }
 func (mock *mockListDatum) Use(cb listDatumFunc)                         { mock.handler = cb }
 func (mock *mockRestartDatum) Use(cb restartDatumFunc)                   { mock.handler = cb }
+func (mock *mockExplainDatum) Use(cb explainDatumFunc)                   { mock.handler = cb }
 func (mock *mockCreatePipeline) Use(cb createPipelineFunc)               { mock.handler = cb }
 func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc)             { mock.handler = cb }
 func (mock *mockListPipeline) Use(cb listPipelineFunc)                   { mock.handler = cb }
@@ -935,6 +1091,7 @@ func (mock *mockStartPipeline) Use(cb startPipelineFunc)                 { mock.
 func (mock *mockStopPipeline) Use(cb stopPipelineFunc)                   { mock.handler = cb }
 func (mock *mockRunPipeline) Use(cb runPipelineFunc)                     { mock.handler = cb }
 func (mock *mockRunCron) Use(cb runCronFunc)                             { mock.handler = cb }
+func (mock *mockReplayDeadLetter) Use(cb replayDeadLetterFunc)           { mock.handler = cb }
 func (mock *mockCreateSecret) Use(cb createSecretFunc)                   { mock.handler = cb }
 func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)                   { mock.handler = cb }
 func (mock *mockInspectSecret) Use(cb inspectSecretFunc)                 { mock.handler = cb }
@@ -964,6 +1121,7 @@ type mockPPSServer struct {
 	InspectDatum       mockInspectDatum
 	ListDatum          mockListDatum
 	RestartDatum       mockRestartDatum
+	ExplainDatum       mockExplainDatum
 	CreatePipeline     mockCreatePipeline
 	InspectPipeline    mockInspectPipeline
 	ListPipeline       mockListPipeline
@@ -972,6 +1130,7 @@ type mockPPSServer struct {
 	StopPipeline       mockStopPipeline
 	RunPipeline        mockRunPipeline
 	RunCron            mockRunCron
+	ReplayDeadLetter   mockReplayDeadLetter
 	CreateSecret       mockCreateSecret
 	DeleteSecret       mockDeleteSecret
 	InspectSecret      mockInspectSecret
@@ -1051,6 +1210,12 @@ func (api *ppsServerAPI) RestartDatum(ctx context.Context, req *pps.RestartDatum
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RestartDatum")
 }
+func (api *ppsServerAPI) ExplainDatum(ctx context.Context, req *pps.ExplainDatumRequest) (*pps.ExplainDatumResponse, error) {
+	if api.mock.ExplainDatum.handler != nil {
+		return api.mock.ExplainDatum.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ExplainDatum")
+}
 func (api *ppsServerAPI) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest) (*types.Empty, error) {
 	if api.mock.CreatePipeline.handler != nil {
 		return api.mock.CreatePipeline.handler(ctx, req)
@@ -1099,6 +1264,12 @@ func (api *ppsServerAPI) RunCron(ctx context.Context, req *pps.RunCronRequest) (
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RunCron")
 }
+func (api *ppsServerAPI) ReplayDeadLetter(ctx context.Context, req *pps.ReplayDeadLetterRequest) (*types.Empty, error) {
+	if api.mock.ReplayDeadLetter.handler != nil {
+		return api.mock.ReplayDeadLetter.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ReplayDeadLetter")
+}
 func (api *ppsServerAPI) CreateSecret(ctx context.Context, req *pps.CreateSecretRequest) (*types.Empty, error) {
 	if api.mock.CreateSecret.handler != nil {
 		return api.mock.CreateSecret.handler(ctx, req)
@@ -1174,6 +1345,7 @@ type inspectTransactionFunc func(context.Context, *transaction.InspectTransactio
 type deleteTransactionFunc func(context.Context, *transaction.DeleteTransactionRequest) (*types.Empty, error)
 type listTransactionFunc func(context.Context, *transaction.ListTransactionRequest) (*transaction.TransactionInfos, error)
 type finishTransactionFunc func(context.Context, *transaction.FinishTransactionRequest) (*transaction.TransactionInfo, error)
+type dryRunTransactionFunc func(context.Context, *transaction.DryRunTransactionRequest) (*transaction.DryRunTransactionResponse, error)
 type deleteAllTransactionFunc func(context.Context, *transaction.DeleteAllRequest) (*types.Empty, error)
 
 type mockBatchTransaction struct{ handler batchTransactionFunc }
@@ -1182,6 +1354,7 @@ type mockInspectTransaction struct{ handler inspectTransactionFunc }
 type mockDeleteTransaction struct{ handler deleteTransactionFunc }
 type mockListTransaction struct{ handler listTransactionFunc }
 type mockFinishTransaction struct{ handler finishTransactionFunc }
+type mockDryRunTransaction struct{ handler dryRunTransactionFunc }
 type mockDeleteAllTransaction struct{ handler deleteAllTransactionFunc }
 
 func (mock *mockBatchTransaction) Use(cb batchTransactionFunc)         { mock.handler = cb }
@@ -1190,6 +1363,7 @@ func (mock *mockInspectTransaction) Use(cb inspectTransactionFunc)     { mock.ha
 func (mock *mockDeleteTransaction) Use(cb deleteTransactionFunc)       { mock.handler = cb }
 func (mock *mockListTransaction) Use(cb listTransactionFunc)           { mock.handler = cb }
 func (mock *mockFinishTransaction) Use(cb finishTransactionFunc)       { mock.handler = cb }
+func (mock *mockDryRunTransaction) Use(cb dryRunTransactionFunc)       { mock.handler = cb }
 func (mock *mockDeleteAllTransaction) Use(cb deleteAllTransactionFunc) { mock.handler = cb }
 
 type transactionServerAPI struct {
@@ -1204,6 +1378,7 @@ type mockTransactionServer struct {
 	DeleteTransaction  mockDeleteTransaction
 	ListTransaction    mockListTransaction
 	FinishTransaction  mockFinishTransaction
+	DryRunTransaction  mockDryRunTransaction
 	DeleteAll          mockDeleteAllTransaction
 }
 
@@ -1243,6 +1418,12 @@ func (api *transactionServerAPI) FinishTransaction(ctx context.Context, req *tra
 	}
 	return nil, errors.Errorf("unhandled pachd mock transaction.FinishTransaction")
 }
+func (api *transactionServerAPI) DryRunTransaction(ctx context.Context, req *transaction.DryRunTransactionRequest) (*transaction.DryRunTransactionResponse, error) {
+	if api.mock.DryRunTransaction.handler != nil {
+		return api.mock.DryRunTransaction.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock transaction.DryRunTransaction")
+}
 func (api *transactionServerAPI) DeleteAll(ctx context.Context, req *transaction.DeleteAllRequest) (*types.Empty, error) {
 	if api.mock.DeleteAll.handler != nil {
 		return api.mock.DeleteAll.handler(ctx, req)
@@ -77,6 +77,46 @@ func (mock *mockInspectPipelineInTransaction) Use(cb inspectPipelineInTransactio
 	mock.handler = cb
 }
 
+type deletePipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.DeletePipelineRequest) error
+
+type mockDeletePipelineInTransaction struct {
+	handler deletePipelineInTransactionFunc
+}
+
+func (mock *mockDeletePipelineInTransaction) Use(cb deletePipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type stopPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StopPipelineRequest) error
+
+type mockStopPipelineInTransaction struct {
+	handler stopPipelineInTransactionFunc
+}
+
+func (mock *mockStopPipelineInTransaction) Use(cb stopPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type startPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StartPipelineRequest) error
+
+type mockStartPipelineInTransaction struct {
+	handler startPipelineInTransactionFunc
+}
+
+func (mock *mockStartPipelineInTransaction) Use(cb startPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type createSecretInTransactionFunc func(*txncontext.TransactionContext, *pps.CreateSecretRequest) error
+
+type mockCreateSecretInTransaction struct {
+	handler createSecretInTransactionFunc
+}
+
+func (mock *mockCreateSecretInTransaction) Use(cb createSecretInTransactionFunc) {
+	mock.handler = cb
+}
+
 type ppsTransactionAPI struct {
 	ppsServerAPI
 	mock *MockPPSTransactionServer
@@ -93,6 +133,10 @@ type MockPPSTransactionServer struct {
 	UpdateJobStateInTransaction  mockUpdateJobStateInTransaction
 	CreatePipelineInTransaction  mockCreatePipelineInTransaction
 	InspectPipelineInTransaction mockInspectPipelineInTransaction
+	DeletePipelineInTransaction  mockDeletePipelineInTransaction
+	StopPipelineInTransaction    mockStopPipelineInTransaction
+	StartPipelineInTransaction   mockStartPipelineInTransaction
+	CreateSecretInTransaction    mockCreateSecretInTransaction
 }
 
 type MockPPSPropagater struct{}
@@ -159,6 +203,34 @@ func (api *ppsTransactionAPI) InspectPipelineInTransaction(txnCtx *txncontext.Tr
 	return nil, errors.Errorf("unhandled pachd mock: pps.InspectPipelineInTransaction")
 }
 
+func (api *ppsTransactionAPI) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.DeletePipelineRequest) error {
+	if api.mock.DeletePipelineInTransaction.handler != nil {
+		return api.mock.DeletePipelineInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.DeletePipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StopPipelineRequest) error {
+	if api.mock.StopPipelineInTransaction.handler != nil {
+		return api.mock.StopPipelineInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.StopPipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StartPipelineRequest) error {
+	if api.mock.StartPipelineInTransaction.handler != nil {
+		return api.mock.StartPipelineInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.StartPipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) CreateSecretInTransaction(txnCtx *txncontext.TransactionContext, req *pps.CreateSecretRequest) error {
+	if api.mock.CreateSecretInTransaction.handler != nil {
+		return api.mock.CreateSecretInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.CreateSecretInTransaction")
+}
+
 // NewMockPPSTransactionServer instantiates a MockPPSTransactionServer
 func NewMockPPSTransactionServer() *MockPPSTransactionServer {
 	result := &MockPPSTransactionServer{}
@@ -133,3 +133,12 @@ func DefaultConfigOptions(config *serviceenv.Configuration) {
 	config.StorageCompactionMaxFanIn = 10
 	config.StorageMemoryCacheSize = 20
 }
+
+// WithStorageFaults is a serviceenv config option that injects the faults
+// described by spec (see obj.ParseFaultConfig) into the object storage used by
+// PFS.
+func WithStorageFaults(spec string) serviceenv.ConfigOption {
+	return func(config *serviceenv.Configuration) {
+		config.StorageFaultInjection = spec
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	errorsmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
@@ -111,6 +112,7 @@ func RunLocal() (retErr error) {
 	requireNoncriticalServers := !env.Config().RequireCriticalServersOnly
 
 	// Setup External Pachd GRPC Server.
+	auditInterceptor := audit.NewInterceptor(env.AuthServer)
 	authInterceptor := auth.NewInterceptor(env.AuthServer)
 	externalServer, err := grpcutil.NewServer(
 		context.Background(),
@@ -118,11 +120,13 @@ func RunLocal() (retErr error) {
 		grpc.ChainUnaryInterceptor(
 			errorsmw.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 		),
 		grpc.ChainStreamInterceptor(
 			errorsmw.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 		),
 	)
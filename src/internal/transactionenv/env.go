@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/sirupsen/logrus"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -30,9 +31,12 @@ type PfsWrites interface {
 	StartCommit(*pfs.StartCommitRequest) (*pfs.Commit, error)
 	FinishCommit(*pfs.FinishCommitRequest) error
 	SquashCommitSet(*pfs.SquashCommitSetRequest) error
+	DropCommitSet(*pfs.DropCommitSetRequest) error
 
 	CreateBranch(*pfs.CreateBranchRequest) error
 	DeleteBranch(*pfs.DeleteBranchRequest) error
+
+	AddFileSet(*pfs.AddFileSetRequest) error
 }
 
 // PpsWrites is an interface providing a wrapper for each operation that
@@ -43,6 +47,10 @@ type PpsWrites interface {
 	StopJob(*pps.StopJobRequest) error
 	UpdateJobState(*pps.UpdateJobStateRequest) error
 	CreatePipeline(*pps.CreatePipelineRequest) error
+	DeletePipeline(*pps.DeletePipelineRequest) error
+	StopPipeline(*pps.StopPipelineRequest) error
+	StartPipeline(*pps.StartPipelineRequest) error
+	CreateSecret(*pps.CreateSecretRequest) error
 }
 
 // AuthWrites is an interface providing a wrapper for each operation that
@@ -149,6 +157,11 @@ func (t *directTransaction) SquashCommitSet(original *pfs.SquashCommitSetRequest
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PfsServer().SquashCommitSetInTransaction(t.txnCtx, req))
 }
 
+func (t *directTransaction) DropCommitSet(original *pfs.DropCommitSetRequest) error {
+	req := proto.Clone(original).(*pfs.DropCommitSetRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PfsServer().DropCommitSetInTransaction(t.txnCtx, req))
+}
+
 func (t *directTransaction) CreateBranch(original *pfs.CreateBranchRequest) error {
 	req := proto.Clone(original).(*pfs.CreateBranchRequest)
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PfsServer().CreateBranchInTransaction(t.txnCtx, req))
@@ -159,6 +172,11 @@ func (t *directTransaction) DeleteBranch(original *pfs.DeleteBranchRequest) erro
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PfsServer().DeleteBranchInTransaction(t.txnCtx, req))
 }
 
+func (t *directTransaction) AddFileSet(original *pfs.AddFileSetRequest) error {
+	req := proto.Clone(original).(*pfs.AddFileSetRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PfsServer().AddFileSetInTransaction(t.txnCtx, req))
+}
+
 func (t *directTransaction) StopJob(original *pps.StopJobRequest) error {
 	req := proto.Clone(original).(*pps.StopJobRequest)
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().StopJobInTransaction(t.txnCtx, req))
@@ -180,6 +198,26 @@ func (t *directTransaction) CreatePipeline(original *pps.CreatePipelineRequest)
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().CreatePipelineInTransaction(t.txnCtx, req))
 }
 
+func (t *directTransaction) DeletePipeline(original *pps.DeletePipelineRequest) error {
+	req := proto.Clone(original).(*pps.DeletePipelineRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().DeletePipelineInTransaction(t.txnCtx, req))
+}
+
+func (t *directTransaction) StopPipeline(original *pps.StopPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StopPipelineRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().StopPipelineInTransaction(t.txnCtx, req))
+}
+
+func (t *directTransaction) StartPipeline(original *pps.StartPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StartPipelineRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().StartPipelineInTransaction(t.txnCtx, req))
+}
+
+func (t *directTransaction) CreateSecret(original *pps.CreateSecretRequest) error {
+	req := proto.Clone(original).(*pps.CreateSecretRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().CreateSecretInTransaction(t.txnCtx, req))
+}
+
 func (t *directTransaction) DeleteRoleBinding(original *auth.Resource) error {
 	req := proto.Clone(original).(*auth.Resource)
 	return errors.EnsureStack(t.txnEnv.serviceEnv.AuthServer().DeleteRoleBindingInTransaction(t.txnCtx, req))
@@ -227,6 +265,11 @@ func (t *appendTransaction) SquashCommitSet(req *pfs.SquashCommitSetRequest) err
 	return errors.EnsureStack(err)
 }
 
+func (t *appendTransaction) DropCommitSet(req *pfs.DropCommitSetRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{DropCommitSet: req})
+	return errors.EnsureStack(err)
+}
+
 func (t *appendTransaction) CreateBranch(req *pfs.CreateBranchRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{CreateBranch: req})
 	return errors.EnsureStack(err)
@@ -237,6 +280,11 @@ func (t *appendTransaction) DeleteBranch(req *pfs.DeleteBranchRequest) error {
 	return errors.EnsureStack(err)
 }
 
+func (t *appendTransaction) AddFileSet(req *pfs.AddFileSetRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{AddFileSet: req})
+	return errors.EnsureStack(err)
+}
+
 func (t *appendTransaction) StopJob(req *pps.StopJobRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopJob: req})
 	return errors.EnsureStack(err)
@@ -252,8 +300,32 @@ func (t *appendTransaction) CreatePipeline(req *pps.CreatePipelineRequest) error
 	return errors.EnsureStack(err)
 }
 
-func (t *appendTransaction) ModifyRoleBinding(original *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
-	panic("ModifyRoleBinding not yet implemented in transactions")
+func (t *appendTransaction) DeletePipeline(req *pps.DeletePipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{DeletePipeline: req})
+	return errors.EnsureStack(err)
+}
+
+func (t *appendTransaction) StopPipeline(req *pps.StopPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopPipeline: req})
+	return errors.EnsureStack(err)
+}
+
+func (t *appendTransaction) StartPipeline(req *pps.StartPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StartPipeline: req})
+	return errors.EnsureStack(err)
+}
+
+func (t *appendTransaction) CreateSecret(req *pps.CreateSecretRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{CreateSecret: req})
+	return errors.EnsureStack(err)
+}
+
+func (t *appendTransaction) ModifyRoleBinding(req *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{ModifyRoleBinding: req})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &auth.ModifyRoleBindingResponse{}, nil
 }
 
 func (t *appendTransaction) DeleteRoleBinding(original *auth.Resource) error {
@@ -303,7 +375,7 @@ func (env *TransactionEnv) WithTransaction(ctx context.Context, cb func(Transact
 	}
 }
 
-func (env *TransactionEnv) attemptTx(ctx context.Context, sqlTx *pachsql.Tx, cb func(*txncontext.TransactionContext) error) error {
+func (env *TransactionEnv) attemptTx(ctx context.Context, sqlTx *pachsql.Tx, cb, finished func(*txncontext.TransactionContext) error) error {
 	txnCtx, err := txncontext.New(ctx, sqlTx, env.serviceEnv.AuthServer())
 	if err != nil {
 		return err
@@ -321,7 +393,13 @@ func (env *TransactionEnv) attemptTx(ctx context.Context, sqlTx *pachsql.Tx, cb
 	if err != nil {
 		return err
 	}
-	return txnCtx.Finish()
+	if err := txnCtx.Finish(); err != nil {
+		return err
+	}
+	if finished != nil {
+		return finished(txnCtx)
+	}
+	return nil
 }
 
 func (env *TransactionEnv) waitReady(ctx context.Context) error {
@@ -335,23 +413,55 @@ func (env *TransactionEnv) waitReady(ctx context.Context) error {
 
 // WithWriteContext will call the given callback with a txncontext.TransactionContext
 // which can be used to perform reads and writes on the current cluster state.
+// Any callbacks registered with the context's PostCommit are run once the
+// transaction has been committed, and those registered with OnRollback are
+// run if an attempt at the transaction is rolled back.
 func (env *TransactionEnv) WithWriteContext(ctx context.Context, cb func(*txncontext.TransactionContext) error) error {
 	if err := env.waitReady(ctx); err != nil {
 		return err
 	}
-	return dbutil.WithTx(ctx, env.serviceEnv.GetDBClient(), func(sqlTx *pachsql.Tx) error {
-		return env.attemptTx(ctx, sqlTx, cb)
-	})
+	var attempt *txncontext.TransactionContext
+	if err := dbutil.WithTx(ctx, env.serviceEnv.GetDBClient(), func(sqlTx *pachsql.Tx) error {
+		if attempt != nil {
+			// the previous attempt was rolled back
+			if err := attempt.RunRollback(ctx); err != nil {
+				return err
+			}
+		}
+		return env.attemptTx(ctx, sqlTx, func(txnCtx *txncontext.TransactionContext) error {
+			attempt = txnCtx
+			return cb(txnCtx)
+		}, nil)
+	}); err != nil {
+		if attempt != nil {
+			if rbErr := attempt.RunRollback(ctx); rbErr != nil {
+				logrus.Errorf("error rolling back transaction side effects: %v", rbErr)
+			}
+		}
+		return err
+	}
+	return attempt.RunPostCommit(ctx)
 }
 
 // WithReadContext will call the given callback with a txncontext.TransactionContext
 // which can be used to perform reads of the current cluster state. If the
 // transaction is used to perform any writes, they will be silently discarded.
 func (env *TransactionEnv) WithReadContext(ctx context.Context, cb func(*txncontext.TransactionContext) error) error {
+	return env.WithDryRunContext(ctx, cb, nil)
+}
+
+// WithDryRunContext is like WithReadContext, except that 'finished' is called
+// with the same context once the deferred work of the transaction, such as
+// propagating commits and creating jobs, has been done. All writes are
+// discarded after 'finished' returns.
+func (env *TransactionEnv) WithDryRunContext(ctx context.Context, cb, finished func(*txncontext.TransactionContext) error) error {
 	if err := env.waitReady(ctx); err != nil {
 		return err
 	}
 	return col.NewDryrunSQLTx(ctx, env.serviceEnv.GetDBClient(), func(sqlTx *pachsql.Tx) error {
-		return env.attemptTx(ctx, sqlTx, cb)
+		return env.attemptTx(ctx, sqlTx, func(txnCtx *txncontext.TransactionContext) error {
+			txnCtx.DryRun = true
+			return cb(txnCtx)
+		}, finished)
 	})
 }
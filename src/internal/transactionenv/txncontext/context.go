@@ -18,7 +18,9 @@ import (
 // transaction is started, a context will be created for it containing these
 // objects, which will be threaded through to every API call:
 type TransactionContext struct {
+	ctx      context.Context
 	username string
+	scope    *auth.TokenScope
 	// SqlTx is the ongoing database transaction.
 	SqlTx *pachsql.Tx
 	// CommitSetID is the ID of the CommitSet corresponding to PFS changes in this transaction.
@@ -32,6 +34,15 @@ type TransactionContext struct {
 	// PpsJobStopper stops Jobs in any pipelines that are associated with a removed commitset
 	PpsJobStopper  PpsJobStopper
 	PpsJobFinisher PpsJobFinisher
+	// DryRun is true if the transaction's writes are discarded rather than
+	// committed. Operations with side effects outside of postgres only
+	// validate them in a dry run.
+	DryRun bool
+	// postCommit and rollback hold the callbacks to run once the transaction
+	// has been committed or rolled back. They are shared with any copies made
+	// by AsUser.
+	postCommit *[]func(context.Context) error
+	rollback   *[]func(context.Context) error
 }
 
 type identifier interface {
@@ -40,12 +51,14 @@ type identifier interface {
 
 func New(ctx context.Context, sqlTx *pachsql.Tx, authServer identifier) (*TransactionContext, error) {
 	var username string
+	var scope *auth.TokenScope
 	// check auth once now so that we can refer to it later
 	if authServer != nil {
 		if me, err := authServer.WhoAmI(ctx, &auth.WhoAmIRequest{}); err != nil && !auth.IsErrNotActivated(err) {
 			return nil, errors.EnsureStack(err)
 		} else if err == nil {
 			username = me.Username
+			scope = me.Scope
 		}
 	}
 	var currTime time.Time
@@ -55,18 +68,82 @@ func New(ctx context.Context, sqlTx *pachsql.Tx, authServer identifier) (*Transa
 		return nil, errors.Wrapf(err, "error getting transaction timestamp")
 	}
 	return &TransactionContext{
+		ctx:         ctx,
 		SqlTx:       sqlTx,
 		CommitSetID: uuid.NewWithoutDashes(),
 		Timestamp:   ts,
 		username:    username,
+		scope:       scope,
+		postCommit:  &[]func(context.Context) error{},
+		rollback:    &[]func(context.Context) error{},
 	}, nil
 }
 
+// Context returns the context of the request running the transaction, for
+// calls outside of postgres.
+func (t *TransactionContext) Context() context.Context {
+	return t.ctx
+}
+
 func (t *TransactionContext) WhoAmI() (*auth.WhoAmIResponse, error) {
 	if t.username == "" {
 		return nil, auth.ErrNotActivated
 	}
-	return &auth.WhoAmIResponse{Username: t.username}, nil
+	return &auth.WhoAmIResponse{Username: t.username, Scope: t.scope}, nil
+}
+
+// AsUser returns a copy of the transaction context whose operations are
+// authorized as 'username' rather than the caller. PPS uses this to make
+// changes that the pipeline, and not the caller, is allowed to make. If auth
+// isn't active, the context is returned unchanged.
+func (t *TransactionContext) AsUser(username string) *TransactionContext {
+	if t.username == "" {
+		return t
+	}
+	result := *t
+	result.username = username
+	result.scope = nil
+	return &result
+}
+
+// PostCommit registers 'cb' to be called after the transaction has been
+// committed. It's used for side effects outside of postgres, which can't be
+// rolled back. Callbacks are never run for dry-run transactions, or if the
+// transaction fails.
+func (t *TransactionContext) PostCommit(cb func(context.Context) error) {
+	*t.postCommit = append(*t.postCommit, cb)
+}
+
+// RunPostCommit calls the callbacks registered with PostCommit, in order,
+// stopping at the first error.
+func (t *TransactionContext) RunPostCommit(ctx context.Context) error {
+	for _, cb := range *t.postCommit {
+		if err := cb(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnRollback registers 'cb' to be called if the transaction is rolled back,
+// including when it is retried. It's used to undo side effects outside of
+// postgres which have to happen before the transaction is committed, for
+// example to validate them.
+func (t *TransactionContext) OnRollback(cb func(context.Context) error) {
+	*t.rollback = append(*t.rollback, cb)
+}
+
+// RunRollback calls the callbacks registered with OnRollback, in reverse
+// order, returning the first error.
+func (t *TransactionContext) RunRollback(ctx context.Context) error {
+	var retErr error
+	for i := len(*t.rollback) - 1; i >= 0; i-- {
+		if err := (*t.rollback)[i](ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}
+	*t.rollback = nil
+	return retErr
 }
 
 // PropagateJobs notifies PPS that there are new commits in the transaction's
@@ -0,0 +1,163 @@
+package pfs
+
+import (
+	"archive/tar"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+const (
+	// paxXattrPrefix is the prefix used for extended attributes in PAX records.
+	paxXattrPrefix = "SCHILY.xattr."
+	// ContentTypeXattr is the extended attribute used to store the content
+	// type of a file in tar streams.
+	ContentTypeXattr = "user.mime_type"
+	// UserXattrPrefix is the prefix of the user extended attributes.
+	UserXattrPrefix = "user."
+	// modeMask selects the permission, setuid, setgid and sticky bits.
+	modeMask = 07777
+)
+
+// IsSymlink returns true if the attributes describe a symbolic link.
+func (a *FileAttributes) IsSymlink() bool {
+	return a.GetSymlinkTarget() != ""
+}
+
+// FileMode returns the permission bits of the attributes as an os.FileMode.
+func (a *FileAttributes) FileMode() os.FileMode {
+	mode := a.GetMode()
+	fm := os.FileMode(mode & 0777)
+	if mode&04000 != 0 {
+		fm |= os.ModeSetuid
+	}
+	if mode&02000 != 0 {
+		fm |= os.ModeSetgid
+	}
+	if mode&01000 != 0 {
+		fm |= os.ModeSticky
+	}
+	return fm
+}
+
+// Validate checks that the attributes are well formed.
+func (a *FileAttributes) Validate() error {
+	if a == nil {
+		return nil
+	}
+	if a.Mode&^modeMask != 0 {
+		return errors.Errorf("invalid file mode %o", a.Mode)
+	}
+	if a.Mtime != nil {
+		if _, err := types.TimestampFromProto(a.Mtime); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	for k := range a.Xattrs {
+		if !strings.HasPrefix(k, UserXattrPrefix) {
+			return errors.Errorf("extended attribute %q is not in the %q namespace", k, UserXattrPrefix)
+		}
+	}
+	return nil
+}
+
+// ApplyToTarHeader sets the fields of hdr that correspond to the attributes.
+// A nil set of attributes leaves hdr unchanged.
+func (a *FileAttributes) ApplyToTarHeader(hdr *tar.Header) {
+	if a == nil {
+		return
+	}
+	if a.Mode != 0 {
+		hdr.Mode = int64(a.Mode & modeMask)
+	}
+	if a.Mtime != nil {
+		if mtime, err := types.TimestampFromProto(a.Mtime); err == nil {
+			hdr.ModTime = mtime
+		}
+	}
+	if a.IsSymlink() {
+		hdr.Typeflag = tar.TypeSymlink
+		hdr.Linkname = a.SymlinkTarget
+		hdr.Size = 0
+	}
+	if a.ContentType != "" || len(a.Xattrs) > 0 {
+		if hdr.PAXRecords == nil {
+			hdr.PAXRecords = make(map[string]string)
+		}
+		for k, v := range a.Xattrs {
+			hdr.PAXRecords[paxXattrPrefix+k] = v
+		}
+		if a.ContentType != "" {
+			hdr.PAXRecords[paxXattrPrefix+ContentTypeXattr] = a.ContentType
+		}
+	}
+}
+
+// AttributesFromTarHeader returns the attributes stored in hdr, or nil if
+// hdr does not carry any.
+func AttributesFromTarHeader(hdr *tar.Header) *FileAttributes {
+	attrs := &FileAttributes{
+		Mode: uint32(hdr.Mode & modeMask),
+	}
+	if hdr.ModTime.Unix() > 0 {
+		if mtime, err := types.TimestampProto(hdr.ModTime); err == nil {
+			attrs.Mtime = mtime
+		}
+	}
+	if hdr.Typeflag == tar.TypeSymlink {
+		attrs.SymlinkTarget = hdr.Linkname
+	}
+	for k, v := range hdr.PAXRecords {
+		if !strings.HasPrefix(k, paxXattrPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(k, paxXattrPrefix)
+		switch {
+		case name == ContentTypeXattr:
+			attrs.ContentType = v
+		case strings.HasPrefix(name, UserXattrPrefix):
+			if attrs.Xattrs == nil {
+				attrs.Xattrs = make(map[string]string)
+			}
+			attrs.Xattrs[name] = v
+		}
+	}
+	if attrs.Mode == 0 && attrs.Mtime == nil && attrs.SymlinkTarget == "" && attrs.ContentType == "" && attrs.Xattrs == nil {
+		return nil
+	}
+	return attrs
+}
+
+// AttributesFromFileInfo returns the attributes of the local file at p,
+// described by fi. fi should come from os.Lstat so that symbolic links are
+// not followed.
+func AttributesFromFileInfo(p string, fi os.FileInfo) (*FileAttributes, error) {
+	attrs := &FileAttributes{
+		Mode: uint32(fi.Mode().Perm()),
+	}
+	if fi.Mode()&os.ModeSetuid != 0 {
+		attrs.Mode |= 04000
+	}
+	if fi.Mode()&os.ModeSetgid != 0 {
+		attrs.Mode |= 02000
+	}
+	if fi.Mode()&os.ModeSticky != 0 {
+		attrs.Mode |= 01000
+	}
+	mtime, err := types.TimestampProto(fi.ModTime())
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	attrs.Mtime = mtime
+	if fi.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(p)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		attrs.SymlinkTarget = target
+	}
+	return attrs, nil
+}
@@ -163,6 +163,34 @@ func (Delimiter) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_21a7b2476cbc6216, []int{3}
 }
 
+type ChunkingSpec_Algorithm int32
+
+const (
+	ChunkingSpec_DEFAULT   ChunkingSpec_Algorithm = 0
+	ChunkingSpec_BUZHASH64 ChunkingSpec_Algorithm = 1
+	ChunkingSpec_FASTCDC   ChunkingSpec_Algorithm = 2
+)
+
+var ChunkingSpec_Algorithm_name = map[int32]string{
+	0: "DEFAULT",
+	1: "BUZHASH64",
+	2: "FASTCDC",
+}
+
+var ChunkingSpec_Algorithm_value = map[string]int32{
+	"DEFAULT":   0,
+	"BUZHASH64": 1,
+	"FASTCDC":   2,
+}
+
+func (x ChunkingSpec_Algorithm) String() string {
+	return proto.EnumName(ChunkingSpec_Algorithm_name, int32(x))
+}
+
+func (ChunkingSpec_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{4, 0}
+}
+
 type SQLDatabaseEgress_FileFormat_Type int32
 
 const (
@@ -191,7 +219,7 @@ func (x SQLDatabaseEgress_FileFormat_Type) String() string {
 }
 
 func (SQLDatabaseEgress_FileFormat_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{60, 0, 0}
+	return fileDescriptor_21a7b2476cbc6216, []int{79, 0, 0}
 }
 
 type Repo struct {
@@ -377,6 +405,8 @@ type RepoInfo struct {
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
 	AuthInfo             *RepoAuthInfo     `protobuf:"bytes,6,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
 	Details              *RepoInfo_Details `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	Chunking             *ChunkingSpec     `protobuf:"bytes,8,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	Compaction           *CompactionSpec   `protobuf:"bytes,9,opt,name=compaction,proto3" json:"compaction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -464,6 +494,20 @@ func (m *RepoInfo) GetDetails() *RepoInfo_Details {
 	return nil
 }
 
+func (m *RepoInfo) GetChunking() *ChunkingSpec {
+	if m != nil {
+		return m.Chunking
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetCompaction() *CompactionSpec {
+	if m != nil {
+		return m.Compaction
+	}
+	return nil
+}
+
 // Details are only provided when explicitly requested
 type RepoInfo_Details struct {
 	SizeBytes            int64    `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -512,6 +556,154 @@ func (m *RepoInfo_Details) GetSizeBytes() int64 {
 	return 0
 }
 
+// ChunkingSpec configures how the content of the files in a repo is split
+// into chunks. Unset fields use the defaults of the algorithm.
+type ChunkingSpec struct {
+	Algorithm ChunkingSpec_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=pfs_v2.ChunkingSpec_Algorithm" json:"algorithm,omitempty"`
+	// average_size_bytes must be a power of two.
+	AverageSizeBytes     int64    `protobuf:"varint,2,opt,name=average_size_bytes,json=averageSizeBytes,proto3" json:"average_size_bytes,omitempty"`
+	MinSizeBytes         int64    `protobuf:"varint,3,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	MaxSizeBytes         int64    `protobuf:"varint,4,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChunkingSpec) Reset()         { *m = ChunkingSpec{} }
+func (m *ChunkingSpec) String() string { return proto.CompactTextString(m) }
+func (*ChunkingSpec) ProtoMessage()    {}
+func (*ChunkingSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{4}
+}
+func (m *ChunkingSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkingSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkingSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkingSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkingSpec.Merge(m, src)
+}
+func (m *ChunkingSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkingSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkingSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkingSpec proto.InternalMessageInfo
+
+func (m *ChunkingSpec) GetAlgorithm() ChunkingSpec_Algorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ChunkingSpec_DEFAULT
+}
+
+func (m *ChunkingSpec) GetAverageSizeBytes() int64 {
+	if m != nil {
+		return m.AverageSizeBytes
+	}
+	return 0
+}
+
+func (m *ChunkingSpec) GetMinSizeBytes() int64 {
+	if m != nil {
+		return m.MinSizeBytes
+	}
+	return 0
+}
+
+func (m *ChunkingSpec) GetMaxSizeBytes() int64 {
+	if m != nil {
+		return m.MaxSizeBytes
+	}
+	return 0
+}
+
+// CompactionSpec configures the compaction of the commits in a repo. Unset
+// fields use the cluster defaults.
+type CompactionSpec struct {
+	// level_factor is the factor that the sizes of the levels of a compacted
+	// commit increase by, from the newest level to the oldest.
+	LevelFactor int64 `protobuf:"varint,1,opt,name=level_factor,json=levelFactor,proto3" json:"level_factor,omitempty"`
+	// defer_size_bytes, if set, defers the compaction of the commits that add
+	// more than defer_size_bytes. Such commits finish without being compacted
+	// or validated, and are compacted and validated in the background later,
+	// which sets the commit's error if it is invalid.
+	DeferSizeBytes int64 `protobuf:"varint,2,opt,name=defer_size_bytes,json=deferSizeBytes,proto3" json:"defer_size_bytes,omitempty"`
+	// window, if set, restricts the compaction of deferred commits to a daily
+	// window of the form "HH:MM-HH:MM" in UTC, e.g. "22:00-06:00". The start
+	// and end must differ.
+	Window               string   `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionSpec) Reset()         { *m = CompactionSpec{} }
+func (m *CompactionSpec) String() string { return proto.CompactTextString(m) }
+func (*CompactionSpec) ProtoMessage()    {}
+func (*CompactionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{5}
+}
+func (m *CompactionSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactionSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionSpec.Merge(m, src)
+}
+func (m *CompactionSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionSpec proto.InternalMessageInfo
+
+func (m *CompactionSpec) GetLevelFactor() int64 {
+	if m != nil {
+		return m.LevelFactor
+	}
+	return 0
+}
+
+func (m *CompactionSpec) GetDeferSizeBytes() int64 {
+	if m != nil {
+		return m.DeferSizeBytes
+	}
+	return 0
+}
+
+func (m *CompactionSpec) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
 // RepoAuthInfo includes the caller's access scope for a repo, and is returned
 // by ListRepo and InspectRepo but not persisted in etcd. It's used by the
 // Pachyderm dashboard to render repo access appropriately. To set a user's auth
@@ -533,7 +725,7 @@ func (m *RepoAuthInfo) Reset()         { *m = RepoAuthInfo{} }
 func (m *RepoAuthInfo) String() string { return proto.CompactTextString(m) }
 func (*RepoAuthInfo) ProtoMessage()    {}
 func (*RepoAuthInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{4}
+	return fileDescriptor_21a7b2476cbc6216, []int{6}
 }
 func (m *RepoAuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +784,7 @@ func (m *BranchInfo) Reset()         { *m = BranchInfo{} }
 func (m *BranchInfo) String() string { return proto.CompactTextString(m) }
 func (*BranchInfo) ProtoMessage()    {}
 func (*BranchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{5}
+	return fileDescriptor_21a7b2476cbc6216, []int{7}
 }
 func (m *BranchInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +879,7 @@ func (m *Trigger) Reset()         { *m = Trigger{} }
 func (m *Trigger) String() string { return proto.CompactTextString(m) }
 func (*Trigger) ProtoMessage()    {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{6}
+	return fileDescriptor_21a7b2476cbc6216, []int{8}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,7 +954,7 @@ func (m *CommitOrigin) Reset()         { *m = CommitOrigin{} }
 func (m *CommitOrigin) String() string { return proto.CompactTextString(m) }
 func (*CommitOrigin) ProtoMessage()    {}
 func (*CommitOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{7}
+	return fileDescriptor_21a7b2476cbc6216, []int{9}
 }
 func (m *CommitOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +1004,7 @@ type Commit struct {
 func (m *Commit) Reset()      { *m = Commit{} }
 func (*Commit) ProtoMessage() {}
 func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{8}
+	return fileDescriptor_21a7b2476cbc6216, []int{10}
 }
 func (m *Commit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1071,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{9}
+	return fileDescriptor_21a7b2476cbc6216, []int{11}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,19 +1186,22 @@ func (m *CommitInfo) GetDetails() *CommitInfo_Details {
 
 // Details are only provided when explicitly requested
 type CommitInfo_Details struct {
-	SizeBytes            int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	CompactingTime       *types.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
-	ValidatingTime       *types.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	SizeBytes      int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CompactingTime *types.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
+	ValidatingTime *types.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
+	// compaction_deferred is true if the commit finished without being
+	// compacted, see CompactionSpec.defer_size_bytes.
+	CompactionDeferred   bool     `protobuf:"varint,4,opt,name=compaction_deferred,json=compactionDeferred,proto3" json:"compaction_deferred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitInfo_Details) Reset()         { *m = CommitInfo_Details{} }
 func (m *CommitInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo_Details) ProtoMessage()    {}
 func (*CommitInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{9, 0}
+	return fileDescriptor_21a7b2476cbc6216, []int{11, 0}
 }
 func (m *CommitInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,6 +1251,13 @@ func (m *CommitInfo_Details) GetValidatingTime() *types.Duration {
 	return nil
 }
 
+func (m *CommitInfo_Details) GetCompactionDeferred() bool {
+	if m != nil {
+		return m.CompactionDeferred
+	}
+	return false
+}
+
 type CommitSet struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1067,7 +1269,7 @@ func (m *CommitSet) Reset()         { *m = CommitSet{} }
 func (m *CommitSet) String() string { return proto.CompactTextString(m) }
 func (*CommitSet) ProtoMessage()    {}
 func (*CommitSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{10}
+	return fileDescriptor_21a7b2476cbc6216, []int{12}
 }
 func (m *CommitSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1317,7 @@ func (m *CommitSetInfo) Reset()         { *m = CommitSetInfo{} }
 func (m *CommitSetInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitSetInfo) ProtoMessage()    {}
 func (*CommitSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{11}
+	return fileDescriptor_21a7b2476cbc6216, []int{13}
 }
 func (m *CommitSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,6 +1366,7 @@ type FileInfo struct {
 	Committed            *types.Timestamp `protobuf:"bytes,3,opt,name=committed,proto3" json:"committed,omitempty"`
 	SizeBytes            int64            `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	Hash                 []byte           `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	Attributes           *FileAttributes  `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1173,7 +1376,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{12}
+	return fileDescriptor_21a7b2476cbc6216, []int{14}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,20 +1440,118 @@ func (m *FileInfo) GetHash() []byte {
 	return nil
 }
 
+func (m *FileInfo) GetAttributes() *FileAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// FileAttributes are the optional POSIX attributes of a file. They are
+// preserved as the file moves through PFS, but do not affect its hash.
+type FileAttributes struct {
+	// mode contains the permission bits of the file.
+	Mode  uint32           `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Mtime *types.Timestamp `protobuf:"bytes,2,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	// symlink_target is set if the file is a symbolic link.
+	SymlinkTarget string `protobuf:"bytes,3,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	ContentType   string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// xattrs contains the user extended attributes of the file.
+	Xattrs               map[string]string `protobuf:"bytes,5,rep,name=xattrs,proto3" json:"xattrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FileAttributes) Reset()         { *m = FileAttributes{} }
+func (m *FileAttributes) String() string { return proto.CompactTextString(m) }
+func (*FileAttributes) ProtoMessage()    {}
+func (*FileAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{15}
+}
+func (m *FileAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileAttributes.Merge(m, src)
+}
+func (m *FileAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileAttributes proto.InternalMessageInfo
+
+func (m *FileAttributes) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileAttributes) GetMtime() *types.Timestamp {
+	if m != nil {
+		return m.Mtime
+	}
+	return nil
+}
+
+func (m *FileAttributes) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
+func (m *FileAttributes) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *FileAttributes) GetXattrs() map[string]string {
+	if m != nil {
+		return m.Xattrs
+	}
+	return nil
+}
+
 type CreateRepoRequest struct {
-	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Update               bool     `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Repo        *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Update      bool   `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
+	// chunking, if set, configures the chunking of the repo. Updating a repo
+	// without it keeps the existing configuration, and updating it with an
+	// empty spec resets the repo to the default chunking.
+	Chunking *ChunkingSpec `protobuf:"bytes,4,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	// compaction, if set, configures the compaction of the repo. Updating a
+	// repo without it keeps the existing configuration.
+	Compaction           *CompactionSpec `protobuf:"bytes,5,opt,name=compaction,proto3" json:"compaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CreateRepoRequest) Reset()         { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()    {}
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{13}
+	return fileDescriptor_21a7b2476cbc6216, []int{16}
 }
 func (m *CreateRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,6 +1601,20 @@ func (m *CreateRepoRequest) GetUpdate() bool {
 	return false
 }
 
+func (m *CreateRepoRequest) GetChunking() *ChunkingSpec {
+	if m != nil {
+		return m.Chunking
+	}
+	return nil
+}
+
+func (m *CreateRepoRequest) GetCompaction() *CompactionSpec {
+	if m != nil {
+		return m.Compaction
+	}
+	return nil
+}
+
 type InspectRepoRequest struct {
 	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1311,7 +1626,7 @@ func (m *InspectRepoRequest) Reset()         { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()    {}
 func (*InspectRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{14}
+	return fileDescriptor_21a7b2476cbc6216, []int{17}
 }
 func (m *InspectRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1675,7 @@ func (m *ListRepoRequest) Reset()         { *m = ListRepoRequest{} }
 func (m *ListRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRepoRequest) ProtoMessage()    {}
 func (*ListRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{15}
+	return fileDescriptor_21a7b2476cbc6216, []int{18}
 }
 func (m *ListRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,7 +1723,7 @@ func (m *DeleteRepoRequest) Reset()         { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()    {}
 func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{16}
+	return fileDescriptor_21a7b2476cbc6216, []int{19}
 }
 func (m *DeleteRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1782,7 @@ func (m *StartCommitRequest) Reset()         { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()    {}
 func (*StartCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{17}
+	return fileDescriptor_21a7b2476cbc6216, []int{20}
 }
 func (m *StartCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1533,7 +1848,7 @@ func (m *FinishCommitRequest) Reset()         { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()    {}
 func (*FinishCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{18}
+	return fileDescriptor_21a7b2476cbc6216, []int{21}
 }
 func (m *FinishCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1603,7 +1918,7 @@ func (m *InspectCommitRequest) Reset()         { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()    {}
 func (*InspectCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{19}
+	return fileDescriptor_21a7b2476cbc6216, []int{22}
 }
 func (m *InspectCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1646,31 +1961,25 @@ func (m *InspectCommitRequest) GetWait() CommitState {
 	return CommitState_COMMIT_STATE_UNKNOWN
 }
 
-type ListCommitRequest struct {
-	Repo                 *Repo      `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	From                 *Commit    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *Commit    `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Number               int64      `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
-	Reverse              bool       `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	All                  bool       `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`
-	OriginKind           OriginKind `protobuf:"varint,7,opt,name=origin_kind,json=originKind,proto3,enum=pfs_v2.OriginKind" json:"origin_kind,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type InspectCommitCompactionRequest struct {
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListCommitRequest) Reset()         { *m = ListCommitRequest{} }
-func (m *ListCommitRequest) String() string { return proto.CompactTextString(m) }
-func (*ListCommitRequest) ProtoMessage()    {}
-func (*ListCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{20}
+func (m *InspectCommitCompactionRequest) Reset()         { *m = InspectCommitCompactionRequest{} }
+func (m *InspectCommitCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectCommitCompactionRequest) ProtoMessage()    {}
+func (*InspectCommitCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{23}
 }
-func (m *ListCommitRequest) XXX_Unmarshal(b []byte) error {
+func (m *InspectCommitCompactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InspectCommitCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListCommitRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InspectCommitCompactionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1680,26 +1989,220 @@ func (m *ListCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ListCommitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListCommitRequest.Merge(m, src)
+func (m *InspectCommitCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectCommitCompactionRequest.Merge(m, src)
 }
-func (m *ListCommitRequest) XXX_Size() int {
+func (m *InspectCommitCompactionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListCommitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListCommitRequest.DiscardUnknown(m)
+func (m *InspectCommitCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectCommitCompactionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListCommitRequest proto.InternalMessageInfo
+var xxx_messageInfo_InspectCommitCompactionRequest proto.InternalMessageInfo
 
-func (m *ListCommitRequest) GetRepo() *Repo {
+func (m *InspectCommitCompactionRequest) GetCommit() *Commit {
 	if m != nil {
-		return m.Repo
+		return m.Commit
 	}
 	return nil
 }
 
-func (m *ListCommitRequest) GetFrom() *Commit {
+// CompactionLevel is one of the file sets a commit's files are stored in.
+type CompactionLevel struct {
+	FileSetId string `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	SizeBytes int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// compacted is true if the level is in compacted form relative to the
+	// levels before it.
+	Compacted            bool     `protobuf:"varint,3,opt,name=compacted,proto3" json:"compacted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionLevel) Reset()         { *m = CompactionLevel{} }
+func (m *CompactionLevel) String() string { return proto.CompactTextString(m) }
+func (*CompactionLevel) ProtoMessage()    {}
+func (*CompactionLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{24}
+}
+func (m *CompactionLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactionLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactionLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactionLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionLevel.Merge(m, src)
+}
+func (m *CompactionLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactionLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionLevel proto.InternalMessageInfo
+
+func (m *CompactionLevel) GetFileSetId() string {
+	if m != nil {
+		return m.FileSetId
+	}
+	return ""
+}
+
+func (m *CompactionLevel) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *CompactionLevel) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
+type CommitCompactionInfo struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// spec is the compaction configuration of the commit's repo, with the
+	// cluster defaults filled in.
+	Spec *CompactionSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// levels are the file sets of the commit, oldest first.
+	Levels []*CompactionLevel `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels,omitempty"`
+	// deferred is true if the commit is waiting for a deferred compaction.
+	Deferred             bool     `protobuf:"varint,4,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitCompactionInfo) Reset()         { *m = CommitCompactionInfo{} }
+func (m *CommitCompactionInfo) String() string { return proto.CompactTextString(m) }
+func (*CommitCompactionInfo) ProtoMessage()    {}
+func (*CommitCompactionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{25}
+}
+func (m *CommitCompactionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitCompactionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitCompactionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitCompactionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitCompactionInfo.Merge(m, src)
+}
+func (m *CommitCompactionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitCompactionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitCompactionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitCompactionInfo proto.InternalMessageInfo
+
+func (m *CommitCompactionInfo) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *CommitCompactionInfo) GetSpec() *CompactionSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *CommitCompactionInfo) GetLevels() []*CompactionLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+func (m *CommitCompactionInfo) GetDeferred() bool {
+	if m != nil {
+		return m.Deferred
+	}
+	return false
+}
+
+type ListCommitRequest struct {
+	Repo                 *Repo      `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	From                 *Commit    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *Commit    `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Number               int64      `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Reverse              bool       `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	All                  bool       `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`
+	OriginKind           OriginKind `protobuf:"varint,7,opt,name=origin_kind,json=originKind,proto3,enum=pfs_v2.OriginKind" json:"origin_kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListCommitRequest) Reset()         { *m = ListCommitRequest{} }
+func (m *ListCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommitRequest) ProtoMessage()    {}
+func (*ListCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{26}
+}
+func (m *ListCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommitRequest.Merge(m, src)
+}
+func (m *ListCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCommitRequest proto.InternalMessageInfo
+
+func (m *ListCommitRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetFrom() *Commit {
 	if m != nil {
 		return m.From
 	}
@@ -1753,7 +2256,7 @@ func (m *InspectCommitSetRequest) Reset()         { *m = InspectCommitSetRequest
 func (m *InspectCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitSetRequest) ProtoMessage()    {}
 func (*InspectCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{21}
+	return fileDescriptor_21a7b2476cbc6216, []int{27}
 }
 func (m *InspectCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1806,7 +2309,7 @@ func (m *ListCommitSetRequest) Reset()         { *m = ListCommitSetRequest{} }
 func (m *ListCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitSetRequest) ProtoMessage()    {}
 func (*ListCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{22}
+	return fileDescriptor_21a7b2476cbc6216, []int{28}
 }
 func (m *ListCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1846,7 +2349,7 @@ func (m *SquashCommitSetRequest) Reset()         { *m = SquashCommitSetRequest{}
 func (m *SquashCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*SquashCommitSetRequest) ProtoMessage()    {}
 func (*SquashCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{23}
+	return fileDescriptor_21a7b2476cbc6216, []int{29}
 }
 func (m *SquashCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1893,7 +2396,7 @@ func (m *DropCommitSetRequest) Reset()         { *m = DropCommitSetRequest{} }
 func (m *DropCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*DropCommitSetRequest) ProtoMessage()    {}
 func (*DropCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{24}
+	return fileDescriptor_21a7b2476cbc6216, []int{30}
 }
 func (m *DropCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1947,7 +2450,7 @@ func (m *SubscribeCommitRequest) Reset()         { *m = SubscribeCommitRequest{}
 func (m *SubscribeCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeCommitRequest) ProtoMessage()    {}
 func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{25}
+	return fileDescriptor_21a7b2476cbc6216, []int{31}
 }
 func (m *SubscribeCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2029,7 +2532,7 @@ func (m *ClearCommitRequest) Reset()         { *m = ClearCommitRequest{} }
 func (m *ClearCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ClearCommitRequest) ProtoMessage()    {}
 func (*ClearCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{26}
+	return fileDescriptor_21a7b2476cbc6216, []int{32}
 }
 func (m *ClearCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2080,7 +2583,7 @@ func (m *CreateBranchRequest) Reset()         { *m = CreateBranchRequest{} }
 func (m *CreateBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBranchRequest) ProtoMessage()    {}
 func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{27}
+	return fileDescriptor_21a7b2476cbc6216, []int{33}
 }
 func (m *CreateBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2155,7 +2658,7 @@ func (m *InspectBranchRequest) Reset()         { *m = InspectBranchRequest{} }
 func (m *InspectBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectBranchRequest) ProtoMessage()    {}
 func (*InspectBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{28}
+	return fileDescriptor_21a7b2476cbc6216, []int{34}
 }
 func (m *InspectBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2203,7 +2706,7 @@ func (m *ListBranchRequest) Reset()         { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()    {}
 func (*ListBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{29}
+	return fileDescriptor_21a7b2476cbc6216, []int{35}
 }
 func (m *ListBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2258,7 +2761,7 @@ func (m *DeleteBranchRequest) Reset()         { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()    {}
 func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{30}
+	return fileDescriptor_21a7b2476cbc6216, []int{36}
 }
 func (m *DeleteBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,17 +2810,19 @@ type AddFile struct {
 	// Types that are valid to be assigned to Source:
 	//	*AddFile_Raw
 	//	*AddFile_Url
-	Source               isAddFile_Source `protobuf_oneof:"source"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Source isAddFile_Source `protobuf_oneof:"source"`
+	// attributes, if set, replace the attributes of the file.
+	Attributes           *FileAttributes `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *AddFile) Reset()         { *m = AddFile{} }
 func (m *AddFile) String() string { return proto.CompactTextString(m) }
 func (*AddFile) ProtoMessage()    {}
 func (*AddFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31}
+	return fileDescriptor_21a7b2476cbc6216, []int{37}
 }
 func (m *AddFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2397,6 +2902,13 @@ func (m *AddFile) GetUrl() *AddFile_URLSource {
 	return nil
 }
 
+func (m *AddFile) GetAttributes() *FileAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AddFile) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2417,7 +2929,7 @@ func (m *AddFile_URLSource) Reset()         { *m = AddFile_URLSource{} }
 func (m *AddFile_URLSource) String() string { return proto.CompactTextString(m) }
 func (*AddFile_URLSource) ProtoMessage()    {}
 func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31, 0}
+	return fileDescriptor_21a7b2476cbc6216, []int{37, 0}
 }
 func (m *AddFile_URLSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2472,7 +2984,7 @@ func (m *DeleteFile) Reset()         { *m = DeleteFile{} }
 func (m *DeleteFile) String() string { return proto.CompactTextString(m) }
 func (*DeleteFile) ProtoMessage()    {}
 func (*DeleteFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{32}
+	return fileDescriptor_21a7b2476cbc6216, []int{38}
 }
 func (m *DeleteFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2529,7 +3041,7 @@ func (m *CopyFile) Reset()         { *m = CopyFile{} }
 func (m *CopyFile) String() string { return proto.CompactTextString(m) }
 func (*CopyFile) ProtoMessage()    {}
 func (*CopyFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{33}
+	return fileDescriptor_21a7b2476cbc6216, []int{39}
 }
 func (m *CopyFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2602,7 +3114,7 @@ func (m *ModifyFileRequest) Reset()         { *m = ModifyFileRequest{} }
 func (m *ModifyFileRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyFileRequest) ProtoMessage()    {}
 func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{34}
+	return fileDescriptor_21a7b2476cbc6216, []int{40}
 }
 func (m *ModifyFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2713,7 +3225,7 @@ func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()    {}
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{35}
+	return fileDescriptor_21a7b2476cbc6216, []int{41}
 }
 func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2774,7 +3286,7 @@ func (m *InspectFileRequest) Reset()         { *m = InspectFileRequest{} }
 func (m *InspectFileRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectFileRequest) ProtoMessage()    {}
 func (*InspectFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{36}
+	return fileDescriptor_21a7b2476cbc6216, []int{42}
 }
 func (m *InspectFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2825,7 +3337,7 @@ func (m *ListFileRequest) Reset()         { *m = ListFileRequest{} }
 func (m *ListFileRequest) String() string { return proto.CompactTextString(m) }
 func (*ListFileRequest) ProtoMessage()    {}
 func (*ListFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{37}
+	return fileDescriptor_21a7b2476cbc6216, []int{43}
 }
 func (m *ListFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3384,7 @@ func (m *WalkFileRequest) Reset()         { *m = WalkFileRequest{} }
 func (m *WalkFileRequest) String() string { return proto.CompactTextString(m) }
 func (*WalkFileRequest) ProtoMessage()    {}
 func (*WalkFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{38}
+	return fileDescriptor_21a7b2476cbc6216, []int{44}
 }
 func (m *WalkFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2909,8 +3421,10 @@ func (m *WalkFileRequest) GetFile() *File {
 }
 
 type GlobFileRequest struct {
-	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Commit  *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Pattern string  `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// start_after, if set, skips the files with paths less than or equal to it.
+	StartAfter           string   `protobuf:"bytes,3,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2920,7 +3434,7 @@ func (m *GlobFileRequest) Reset()         { *m = GlobFileRequest{} }
 func (m *GlobFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GlobFileRequest) ProtoMessage()    {}
 func (*GlobFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{39}
+	return fileDescriptor_21a7b2476cbc6216, []int{45}
 }
 func (m *GlobFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2963,6 +3477,13 @@ func (m *GlobFileRequest) GetPattern() string {
 	return ""
 }
 
+func (m *GlobFileRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
 type DiffFileRequest struct {
 	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
 	// OldFile may be left nil in which case the same path in the parent of
@@ -2978,7 +3499,7 @@ func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
 func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
 func (*DiffFileRequest) ProtoMessage()    {}
 func (*DiffFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{40}
+	return fileDescriptor_21a7b2476cbc6216, []int{46}
 }
 func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3561,7 @@ func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
 func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
 func (*DiffFileResponse) ProtoMessage()    {}
 func (*DiffFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{41}
+	return fileDescriptor_21a7b2476cbc6216, []int{47}
 }
 func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3094,7 +3615,7 @@ func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
 func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
 func (*FsckRequest) ProtoMessage()    {}
 func (*FsckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{42}
+	return fileDescriptor_21a7b2476cbc6216, []int{48}
 }
 func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3142,7 +3663,7 @@ func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
 func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
 func (*FsckResponse) ProtoMessage()    {}
 func (*FsckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{43}
+	return fileDescriptor_21a7b2476cbc6216, []int{49}
 }
 func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3196,7 +3717,7 @@ func (m *CreateFileSetResponse) Reset()         { *m = CreateFileSetResponse{} }
 func (m *CreateFileSetResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateFileSetResponse) ProtoMessage()    {}
 func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{44}
+	return fileDescriptor_21a7b2476cbc6216, []int{50}
 }
 func (m *CreateFileSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3243,7 +3764,7 @@ func (m *GetFileSetRequest) Reset()         { *m = GetFileSetRequest{} }
 func (m *GetFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileSetRequest) ProtoMessage()    {}
 func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{45}
+	return fileDescriptor_21a7b2476cbc6216, []int{51}
 }
 func (m *GetFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3291,7 +3812,7 @@ func (m *AddFileSetRequest) Reset()         { *m = AddFileSetRequest{} }
 func (m *AddFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*AddFileSetRequest) ProtoMessage()    {}
 func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{46}
+	return fileDescriptor_21a7b2476cbc6216, []int{52}
 }
 func (m *AddFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3346,7 +3867,7 @@ func (m *RenewFileSetRequest) Reset()         { *m = RenewFileSetRequest{} }
 func (m *RenewFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*RenewFileSetRequest) ProtoMessage()    {}
 func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{47}
+	return fileDescriptor_21a7b2476cbc6216, []int{53}
 }
 func (m *RenewFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3401,7 +3922,7 @@ func (m *ComposeFileSetRequest) Reset()         { *m = ComposeFileSetRequest{} }
 func (m *ComposeFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ComposeFileSetRequest) ProtoMessage()    {}
 func (*ComposeFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{48}
+	return fileDescriptor_21a7b2476cbc6216, []int{54}
 }
 func (m *ComposeFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3457,7 +3978,7 @@ func (m *CheckStorageRequest) Reset()         { *m = CheckStorageRequest{} }
 func (m *CheckStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckStorageRequest) ProtoMessage()    {}
 func (*CheckStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{49}
+	return fileDescriptor_21a7b2476cbc6216, []int{55}
 }
 func (m *CheckStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3518,7 +4039,7 @@ func (m *CheckStorageResponse) Reset()         { *m = CheckStorageResponse{} }
 func (m *CheckStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckStorageResponse) ProtoMessage()    {}
 func (*CheckStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{50}
+	return fileDescriptor_21a7b2476cbc6216, []int{56}
 }
 func (m *CheckStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3554,28 +4075,31 @@ func (m *CheckStorageResponse) GetChunkObjectCount() int64 {
 	return 0
 }
 
-type PutCacheRequest struct {
-	Key                  string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                *types.Any `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	FileSetIds           []string   `protobuf:"bytes,3,rep,name=file_set_ids,json=fileSetIds,proto3" json:"file_set_ids,omitempty"`
-	Tag                  string     `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type ScrubStorageRequest struct {
+	// Repair copies missing or corrupt chunk objects from the replica object
+	// store, if one is configured.
+	Repair     bool   `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+	ChunkBegin []byte `protobuf:"bytes,2,opt,name=chunk_begin,json=chunkBegin,proto3" json:"chunk_begin,omitempty"`
+	ChunkEnd   []byte `protobuf:"bytes,3,opt,name=chunk_end,json=chunkEnd,proto3" json:"chunk_end,omitempty"`
+	// The number of bytes per second read from object storage, 0 means no limit.
+	BytesPerSecond       int64    `protobuf:"varint,4,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutCacheRequest) Reset()         { *m = PutCacheRequest{} }
-func (m *PutCacheRequest) String() string { return proto.CompactTextString(m) }
-func (*PutCacheRequest) ProtoMessage()    {}
-func (*PutCacheRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{51}
+func (m *ScrubStorageRequest) Reset()         { *m = ScrubStorageRequest{} }
+func (m *ScrubStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*ScrubStorageRequest) ProtoMessage()    {}
+func (*ScrubStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{57}
 }
-func (m *PutCacheRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScrubStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScrubStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutCacheRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScrubStorageRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3585,65 +4109,70 @@ func (m *PutCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *PutCacheRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutCacheRequest.Merge(m, src)
+func (m *ScrubStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrubStorageRequest.Merge(m, src)
 }
-func (m *PutCacheRequest) XXX_Size() int {
+func (m *ScrubStorageRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutCacheRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutCacheRequest.DiscardUnknown(m)
+func (m *ScrubStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrubStorageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutCacheRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScrubStorageRequest proto.InternalMessageInfo
 
-func (m *PutCacheRequest) GetKey() string {
+func (m *ScrubStorageRequest) GetRepair() bool {
 	if m != nil {
-		return m.Key
+		return m.Repair
 	}
-	return ""
+	return false
 }
 
-func (m *PutCacheRequest) GetValue() *types.Any {
+func (m *ScrubStorageRequest) GetChunkBegin() []byte {
 	if m != nil {
-		return m.Value
+		return m.ChunkBegin
 	}
 	return nil
 }
 
-func (m *PutCacheRequest) GetFileSetIds() []string {
+func (m *ScrubStorageRequest) GetChunkEnd() []byte {
 	if m != nil {
-		return m.FileSetIds
+		return m.ChunkEnd
 	}
 	return nil
 }
 
-func (m *PutCacheRequest) GetTag() string {
+func (m *ScrubStorageRequest) GetBytesPerSecond() int64 {
 	if m != nil {
-		return m.Tag
+		return m.BytesPerSecond
 	}
-	return ""
+	return 0
 }
 
-type GetCacheRequest struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type ScrubStorageFinding struct {
+	ChunkId []byte `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	// Missing is true if the chunk object doesn't exist, otherwise it is corrupt.
+	Missing              bool     `protobuf:"varint,2,opt,name=missing,proto3" json:"missing,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Repaired             bool     `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	RepairError          string   `protobuf:"bytes,5,opt,name=repair_error,json=repairError,proto3" json:"repair_error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCacheRequest) Reset()         { *m = GetCacheRequest{} }
-func (m *GetCacheRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCacheRequest) ProtoMessage()    {}
-func (*GetCacheRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{52}
+func (m *ScrubStorageFinding) Reset()         { *m = ScrubStorageFinding{} }
+func (m *ScrubStorageFinding) String() string { return proto.CompactTextString(m) }
+func (*ScrubStorageFinding) ProtoMessage()    {}
+func (*ScrubStorageFinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{58}
 }
-func (m *GetCacheRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScrubStorageFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScrubStorageFinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCacheRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScrubStorageFinding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3653,44 +4182,75 @@ func (m *GetCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GetCacheRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCacheRequest.Merge(m, src)
+func (m *ScrubStorageFinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrubStorageFinding.Merge(m, src)
 }
-func (m *GetCacheRequest) XXX_Size() int {
+func (m *ScrubStorageFinding) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCacheRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCacheRequest.DiscardUnknown(m)
+func (m *ScrubStorageFinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrubStorageFinding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCacheRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScrubStorageFinding proto.InternalMessageInfo
 
-func (m *GetCacheRequest) GetKey() string {
+func (m *ScrubStorageFinding) GetChunkId() []byte {
 	if m != nil {
-		return m.Key
+		return m.ChunkId
+	}
+	return nil
+}
+
+func (m *ScrubStorageFinding) GetMissing() bool {
+	if m != nil {
+		return m.Missing
+	}
+	return false
+}
+
+func (m *ScrubStorageFinding) GetError() string {
+	if m != nil {
+		return m.Error
 	}
 	return ""
 }
 
-type GetCacheResponse struct {
-	Value                *types.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *ScrubStorageFinding) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
 }
 
-func (m *GetCacheResponse) Reset()         { *m = GetCacheResponse{} }
-func (m *GetCacheResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCacheResponse) ProtoMessage()    {}
-func (*GetCacheResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{53}
+func (m *ScrubStorageFinding) GetRepairError() string {
+	if m != nil {
+		return m.RepairError
+	}
+	return ""
 }
-func (m *GetCacheResponse) XXX_Unmarshal(b []byte) error {
+
+// ScrubStorageFile is a file in a finished commit with content in the chunk of
+// a finding.
+type ScrubStorageFile struct {
+	ChunkId              []byte   `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	File                 *File    `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScrubStorageFile) Reset()         { *m = ScrubStorageFile{} }
+func (m *ScrubStorageFile) String() string { return proto.CompactTextString(m) }
+func (*ScrubStorageFile) ProtoMessage()    {}
+func (*ScrubStorageFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{59}
+}
+func (m *ScrubStorageFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScrubStorageFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCacheResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScrubStorageFile.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3700,44 +4260,60 @@ func (m *GetCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetCacheResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCacheResponse.Merge(m, src)
+func (m *ScrubStorageFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrubStorageFile.Merge(m, src)
 }
-func (m *GetCacheResponse) XXX_Size() int {
+func (m *ScrubStorageFile) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCacheResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCacheResponse.DiscardUnknown(m)
+func (m *ScrubStorageFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrubStorageFile.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCacheResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScrubStorageFile proto.InternalMessageInfo
 
-func (m *GetCacheResponse) GetValue() *types.Any {
+func (m *ScrubStorageFile) GetChunkId() []byte {
 	if m != nil {
-		return m.Value
+		return m.ChunkId
 	}
 	return nil
 }
 
-type ClearCacheRequest struct {
-	TagPrefix            string   `protobuf:"bytes,1,opt,name=tag_prefix,json=tagPrefix,proto3" json:"tag_prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ScrubStorageFile) GetFile() *File {
+	if m != nil {
+		return m.File
+	}
+	return nil
 }
 
-func (m *ClearCacheRequest) Reset()         { *m = ClearCacheRequest{} }
-func (m *ClearCacheRequest) String() string { return proto.CompactTextString(m) }
-func (*ClearCacheRequest) ProtoMessage()    {}
-func (*ClearCacheRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{54}
+// ScrubStorageResponse is one of the results of a scrub. The findings are
+// sent as the chunk objects are verified, followed by a response with the
+// number of chunk objects verified, and then by the files and unreadable
+// commits found while mapping the findings to files.
+type ScrubStorageResponse struct {
+	ChunkObjectCount int64                `protobuf:"varint,1,opt,name=chunk_object_count,json=chunkObjectCount,proto3" json:"chunk_object_count,omitempty"`
+	Finding          *ScrubStorageFinding `protobuf:"bytes,2,opt,name=finding,proto3" json:"finding,omitempty"`
+	// A commit whose file set could not be read while mapping findings to
+	// files, which happens when a bad chunk holds part of its index.
+	UnreadableCommit     *Commit           `protobuf:"bytes,3,opt,name=unreadable_commit,json=unreadableCommit,proto3" json:"unreadable_commit,omitempty"`
+	File                 *ScrubStorageFile `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *ClearCacheRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ScrubStorageResponse) Reset()         { *m = ScrubStorageResponse{} }
+func (m *ScrubStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*ScrubStorageResponse) ProtoMessage()    {}
+func (*ScrubStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{60}
+}
+func (m *ScrubStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScrubStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearCacheRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScrubStorageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3747,43 +4323,64 @@ func (m *ClearCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ClearCacheRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearCacheRequest.Merge(m, src)
+func (m *ScrubStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScrubStorageResponse.Merge(m, src)
 }
-func (m *ClearCacheRequest) XXX_Size() int {
+func (m *ScrubStorageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearCacheRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearCacheRequest.DiscardUnknown(m)
+func (m *ScrubStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScrubStorageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearCacheRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScrubStorageResponse proto.InternalMessageInfo
 
-func (m *ClearCacheRequest) GetTagPrefix() string {
+func (m *ScrubStorageResponse) GetChunkObjectCount() int64 {
 	if m != nil {
-		return m.TagPrefix
+		return m.ChunkObjectCount
 	}
-	return ""
+	return 0
 }
 
-type ActivateAuthRequest struct {
+func (m *ScrubStorageResponse) GetFinding() *ScrubStorageFinding {
+	if m != nil {
+		return m.Finding
+	}
+	return nil
+}
+
+func (m *ScrubStorageResponse) GetUnreadableCommit() *Commit {
+	if m != nil {
+		return m.UnreadableCommit
+	}
+	return nil
+}
+
+func (m *ScrubStorageResponse) GetFile() *ScrubStorageFile {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+type ReplicationStatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
-func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
-func (*ActivateAuthRequest) ProtoMessage()    {}
-func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{55}
+func (m *ReplicationStatusRequest) Reset()         { *m = ReplicationStatusRequest{} }
+func (m *ReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplicationStatusRequest) ProtoMessage()    {}
+func (*ReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{61}
 }
-func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateAuthRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicationStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3793,36 +4390,43 @@ func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ActivateAuthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateAuthRequest.Merge(m, src)
+func (m *ReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationStatusRequest.Merge(m, src)
 }
-func (m *ActivateAuthRequest) XXX_Size() int {
+func (m *ReplicationStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateAuthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateAuthRequest.DiscardUnknown(m)
+func (m *ReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateAuthRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReplicationStatusRequest proto.InternalMessageInfo
 
-type ActivateAuthResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ReplicaStatus struct {
+	// The URL of the replica object store.
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReplicatedChunks int64  `protobuf:"varint,2,opt,name=replicated_chunks,json=replicatedChunks,proto3" json:"replicated_chunks,omitempty"`
+	PendingChunks    int64  `protobuf:"varint,3,opt,name=pending_chunks,json=pendingChunks,proto3" json:"pending_chunks,omitempty"`
+	PendingBytes     int64  `protobuf:"varint,4,opt,name=pending_bytes,json=pendingBytes,proto3" json:"pending_bytes,omitempty"`
+	// The age of the oldest chunk object not yet copied to the replica.
+	Lag                  *types.Duration `protobuf:"bytes,5,opt,name=lag,proto3" json:"lag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
-func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
-func (*ActivateAuthResponse) ProtoMessage()    {}
-func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{56}
+func (m *ReplicaStatus) Reset()         { *m = ReplicaStatus{} }
+func (m *ReplicaStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicaStatus) ProtoMessage()    {}
+func (*ReplicaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{62}
 }
-func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
+func (m *ReplicaStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateAuthResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicaStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3832,39 +4436,75 @@ func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ActivateAuthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateAuthResponse.Merge(m, src)
+func (m *ReplicaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaStatus.Merge(m, src)
 }
-func (m *ActivateAuthResponse) XXX_Size() int {
+func (m *ReplicaStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateAuthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateAuthResponse.DiscardUnknown(m)
+func (m *ReplicaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateAuthResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReplicaStatus proto.InternalMessageInfo
 
-type RunLoadTestRequest struct {
-	Spec                 string   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	Branch               *Branch  `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Seed                 int64    `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+func (m *ReplicaStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReplicaStatus) GetReplicatedChunks() int64 {
+	if m != nil {
+		return m.ReplicatedChunks
+	}
+	return 0
+}
+
+func (m *ReplicaStatus) GetPendingChunks() int64 {
+	if m != nil {
+		return m.PendingChunks
+	}
+	return 0
+}
+
+func (m *ReplicaStatus) GetPendingBytes() int64 {
+	if m != nil {
+		return m.PendingBytes
+	}
+	return 0
+}
+
+func (m *ReplicaStatus) GetLag() *types.Duration {
+	if m != nil {
+		return m.Lag
+	}
+	return nil
+}
+
+type ReplicationStatusResponse struct {
+	Replicas []*ReplicaStatus `protobuf:"bytes,1,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// Failover is true if reads fall back to the replicas when the primary
+	// object store errors.
+	Failover             bool     `protobuf:"varint,2,opt,name=failover,proto3" json:"failover,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RunLoadTestRequest) Reset()         { *m = RunLoadTestRequest{} }
-func (m *RunLoadTestRequest) String() string { return proto.CompactTextString(m) }
-func (*RunLoadTestRequest) ProtoMessage()    {}
-func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{57}
+func (m *ReplicationStatusResponse) Reset()         { *m = ReplicationStatusResponse{} }
+func (m *ReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplicationStatusResponse) ProtoMessage()    {}
+func (*ReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{63}
 }
-func (m *RunLoadTestRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RunLoadTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RunLoadTestRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicationStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3874,62 +4514,53 @@ func (m *RunLoadTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RunLoadTestRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RunLoadTestRequest.Merge(m, src)
+func (m *ReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationStatusResponse.Merge(m, src)
 }
-func (m *RunLoadTestRequest) XXX_Size() int {
+func (m *ReplicationStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RunLoadTestRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RunLoadTestRequest.DiscardUnknown(m)
+func (m *ReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RunLoadTestRequest proto.InternalMessageInfo
-
-func (m *RunLoadTestRequest) GetSpec() string {
-	if m != nil {
-		return m.Spec
-	}
-	return ""
-}
+var xxx_messageInfo_ReplicationStatusResponse proto.InternalMessageInfo
 
-func (m *RunLoadTestRequest) GetBranch() *Branch {
+func (m *ReplicationStatusResponse) GetReplicas() []*ReplicaStatus {
 	if m != nil {
-		return m.Branch
+		return m.Replicas
 	}
 	return nil
 }
 
-func (m *RunLoadTestRequest) GetSeed() int64 {
+func (m *ReplicationStatusResponse) GetFailover() bool {
 	if m != nil {
-		return m.Seed
+		return m.Failover
 	}
-	return 0
+	return false
 }
 
-type RunLoadTestResponse struct {
-	Spec                 string          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	Branch               *Branch         `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Seed                 int64           `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
-	Error                string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Duration             *types.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type GarbageCollectStorageRequest struct {
+	// DryRun reports what garbage collection would delete without deleting
+	// anything.
+	DryRun               bool     `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RunLoadTestResponse) Reset()         { *m = RunLoadTestResponse{} }
-func (m *RunLoadTestResponse) String() string { return proto.CompactTextString(m) }
-func (*RunLoadTestResponse) ProtoMessage()    {}
-func (*RunLoadTestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{58}
+func (m *GarbageCollectStorageRequest) Reset()         { *m = GarbageCollectStorageRequest{} }
+func (m *GarbageCollectStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*GarbageCollectStorageRequest) ProtoMessage()    {}
+func (*GarbageCollectStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{64}
 }
-func (m *RunLoadTestResponse) XXX_Unmarshal(b []byte) error {
+func (m *GarbageCollectStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RunLoadTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GarbageCollectStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RunLoadTestResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GarbageCollectStorageRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3939,72 +4570,133 @@ func (m *RunLoadTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RunLoadTestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RunLoadTestResponse.Merge(m, src)
+func (m *GarbageCollectStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageCollectStorageRequest.Merge(m, src)
 }
-func (m *RunLoadTestResponse) XXX_Size() int {
+func (m *GarbageCollectStorageRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RunLoadTestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RunLoadTestResponse.DiscardUnknown(m)
+func (m *GarbageCollectStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageCollectStorageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RunLoadTestResponse proto.InternalMessageInfo
+var xxx_messageInfo_GarbageCollectStorageRequest proto.InternalMessageInfo
 
-func (m *RunLoadTestResponse) GetSpec() string {
+func (m *GarbageCollectStorageRequest) GetDryRun() bool {
 	if m != nil {
-		return m.Spec
+		return m.DryRun
 	}
-	return ""
+	return false
 }
 
-func (m *RunLoadTestResponse) GetBranch() *Branch {
-	if m != nil {
-		return m.Branch
-	}
-	return nil
+type GarbageChunk struct {
+	ChunkId   []byte `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	Gen       uint64 `protobuf:"varint,2,opt,name=gen,proto3" json:"gen,omitempty"`
+	SizeBytes int64  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Tombstone is true if the chunk is already marked for deletion, otherwise
+	// it is only referenced by expired objects.
+	Tombstone            bool     `protobuf:"varint,4,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RunLoadTestResponse) GetSeed() int64 {
-	if m != nil {
-		return m.Seed
+func (m *GarbageChunk) Reset()         { *m = GarbageChunk{} }
+func (m *GarbageChunk) String() string { return proto.CompactTextString(m) }
+func (*GarbageChunk) ProtoMessage()    {}
+func (*GarbageChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{65}
+}
+func (m *GarbageChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GarbageChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GarbageChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *GarbageChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageChunk.Merge(m, src)
+}
+func (m *GarbageChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *GarbageChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageChunk.DiscardUnknown(m)
 }
 
-func (m *RunLoadTestResponse) GetError() string {
+var xxx_messageInfo_GarbageChunk proto.InternalMessageInfo
+
+func (m *GarbageChunk) GetChunkId() []byte {
 	if m != nil {
-		return m.Error
+		return m.ChunkId
 	}
-	return ""
+	return nil
 }
 
-func (m *RunLoadTestResponse) GetDuration() *types.Duration {
+func (m *GarbageChunk) GetGen() uint64 {
 	if m != nil {
-		return m.Duration
+		return m.Gen
 	}
-	return nil
+	return 0
 }
 
-type ObjectStorageEgress struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GarbageChunk) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
 }
 
-func (m *ObjectStorageEgress) Reset()         { *m = ObjectStorageEgress{} }
-func (m *ObjectStorageEgress) String() string { return proto.CompactTextString(m) }
-func (*ObjectStorageEgress) ProtoMessage()    {}
-func (*ObjectStorageEgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{59}
+func (m *GarbageChunk) GetTombstone() bool {
+	if m != nil {
+		return m.Tombstone
+	}
+	return false
 }
-func (m *ObjectStorageEgress) XXX_Unmarshal(b []byte) error {
+
+type GarbageCollectStorageResponse struct {
+	// The chunk objects which would be deleted, or were eligible for deletion
+	// when garbage collection started.
+	Chunks     []*GarbageChunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	ChunkCount int64           `protobuf:"varint,2,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	ChunkBytes int64           `protobuf:"varint,3,opt,name=chunk_bytes,json=chunkBytes,proto3" json:"chunk_bytes,omitempty"`
+	// The number of tracker objects (file sets, chunks and temporary objects)
+	// which would be deleted.
+	TrackerObjectCount int64 `protobuf:"varint,4,opt,name=tracker_object_count,json=trackerObjectCount,proto3" json:"tracker_object_count,omitempty"`
+	// The chunk objects in the trash, waiting to be purged.
+	TrashCount int64 `protobuf:"varint,5,opt,name=trash_count,json=trashCount,proto3" json:"trash_count,omitempty"`
+	TrashBytes int64 `protobuf:"varint,6,opt,name=trash_bytes,json=trashBytes,proto3" json:"trash_bytes,omitempty"`
+	Paused     bool  `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The number of chunk objects and tracker objects actually deleted, which
+	// may differ from the counts above if garbage collection was interrupted or
+	// new garbage appeared while it ran.
+	DeletedChunkCount         int64    `protobuf:"varint,8,opt,name=deleted_chunk_count,json=deletedChunkCount,proto3" json:"deleted_chunk_count,omitempty"`
+	DeletedTrackerObjectCount int64    `protobuf:"varint,9,opt,name=deleted_tracker_object_count,json=deletedTrackerObjectCount,proto3" json:"deleted_tracker_object_count,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *GarbageCollectStorageResponse) Reset()         { *m = GarbageCollectStorageResponse{} }
+func (m *GarbageCollectStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*GarbageCollectStorageResponse) ProtoMessage()    {}
+func (*GarbageCollectStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{66}
+}
+func (m *GarbageCollectStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ObjectStorageEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GarbageCollectStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ObjectStorageEgress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GarbageCollectStorageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4014,46 +4706,100 @@ func (m *ObjectStorageEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ObjectStorageEgress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectStorageEgress.Merge(m, src)
+func (m *GarbageCollectStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageCollectStorageResponse.Merge(m, src)
 }
-func (m *ObjectStorageEgress) XXX_Size() int {
+func (m *GarbageCollectStorageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ObjectStorageEgress) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectStorageEgress.DiscardUnknown(m)
+func (m *GarbageCollectStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageCollectStorageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ObjectStorageEgress proto.InternalMessageInfo
+var xxx_messageInfo_GarbageCollectStorageResponse proto.InternalMessageInfo
 
-func (m *ObjectStorageEgress) GetUrl() string {
+func (m *GarbageCollectStorageResponse) GetChunks() []*GarbageChunk {
 	if m != nil {
-		return m.Url
+		return m.Chunks
 	}
-	return ""
+	return nil
 }
 
-type SQLDatabaseEgress struct {
-	Url                  string                        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	FileFormat           *SQLDatabaseEgress_FileFormat `protobuf:"bytes,2,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty"`
-	Secret               *SQLDatabaseEgress_Secret     `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+func (m *GarbageCollectStorageResponse) GetChunkCount() int64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
 }
 
-func (m *SQLDatabaseEgress) Reset()         { *m = SQLDatabaseEgress{} }
-func (m *SQLDatabaseEgress) String() string { return proto.CompactTextString(m) }
-func (*SQLDatabaseEgress) ProtoMessage()    {}
-func (*SQLDatabaseEgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{60}
+func (m *GarbageCollectStorageResponse) GetChunkBytes() int64 {
+	if m != nil {
+		return m.ChunkBytes
+	}
+	return 0
 }
-func (m *SQLDatabaseEgress) XXX_Unmarshal(b []byte) error {
+
+func (m *GarbageCollectStorageResponse) GetTrackerObjectCount() int64 {
+	if m != nil {
+		return m.TrackerObjectCount
+	}
+	return 0
+}
+
+func (m *GarbageCollectStorageResponse) GetTrashCount() int64 {
+	if m != nil {
+		return m.TrashCount
+	}
+	return 0
+}
+
+func (m *GarbageCollectStorageResponse) GetTrashBytes() int64 {
+	if m != nil {
+		return m.TrashBytes
+	}
+	return 0
+}
+
+func (m *GarbageCollectStorageResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GarbageCollectStorageResponse) GetDeletedChunkCount() int64 {
+	if m != nil {
+		return m.DeletedChunkCount
+	}
+	return 0
+}
+
+func (m *GarbageCollectStorageResponse) GetDeletedTrackerObjectCount() int64 {
+	if m != nil {
+		return m.DeletedTrackerObjectCount
+	}
+	return 0
+}
+
+type SetStorageGCPausedRequest struct {
+	Paused               bool     `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetStorageGCPausedRequest) Reset()         { *m = SetStorageGCPausedRequest{} }
+func (m *SetStorageGCPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetStorageGCPausedRequest) ProtoMessage()    {}
+func (*SetStorageGCPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{67}
+}
+func (m *SetStorageGCPausedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SQLDatabaseEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetStorageGCPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SQLDatabaseEgress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetStorageGCPausedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4063,59 +4809,49 @@ func (m *SQLDatabaseEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SQLDatabaseEgress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SQLDatabaseEgress.Merge(m, src)
+func (m *SetStorageGCPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStorageGCPausedRequest.Merge(m, src)
 }
-func (m *SQLDatabaseEgress) XXX_Size() int {
+func (m *SetStorageGCPausedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SQLDatabaseEgress) XXX_DiscardUnknown() {
-	xxx_messageInfo_SQLDatabaseEgress.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SQLDatabaseEgress proto.InternalMessageInfo
-
-func (m *SQLDatabaseEgress) GetUrl() string {
-	if m != nil {
-		return m.Url
-	}
-	return ""
+func (m *SetStorageGCPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStorageGCPausedRequest.DiscardUnknown(m)
 }
 
-func (m *SQLDatabaseEgress) GetFileFormat() *SQLDatabaseEgress_FileFormat {
-	if m != nil {
-		return m.FileFormat
-	}
-	return nil
-}
+var xxx_messageInfo_SetStorageGCPausedRequest proto.InternalMessageInfo
 
-func (m *SQLDatabaseEgress) GetSecret() *SQLDatabaseEgress_Secret {
+func (m *SetStorageGCPausedRequest) GetPaused() bool {
 	if m != nil {
-		return m.Secret
+		return m.Paused
 	}
-	return nil
+	return false
 }
 
-type SQLDatabaseEgress_FileFormat struct {
-	Type                 SQLDatabaseEgress_FileFormat_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pfs_v2.SQLDatabaseEgress_FileFormat_Type" json:"type,omitempty"`
-	Columns              []string                          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+type RestoreStorageTrashRequest struct {
+	// The chunks to restore from the trash, everything in the trash is
+	// restored if this is empty.
+	ChunkIds [][]byte `protobuf:"bytes,1,rep,name=chunk_ids,json=chunkIds,proto3" json:"chunk_ids,omitempty"`
+	// How long the restored chunks are kept without being referenced, before
+	// garbage collection deletes them again.
+	Ttl                  *types.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *SQLDatabaseEgress_FileFormat) Reset()         { *m = SQLDatabaseEgress_FileFormat{} }
-func (m *SQLDatabaseEgress_FileFormat) String() string { return proto.CompactTextString(m) }
-func (*SQLDatabaseEgress_FileFormat) ProtoMessage()    {}
-func (*SQLDatabaseEgress_FileFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{60, 0}
+func (m *RestoreStorageTrashRequest) Reset()         { *m = RestoreStorageTrashRequest{} }
+func (m *RestoreStorageTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreStorageTrashRequest) ProtoMessage()    {}
+func (*RestoreStorageTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{68}
 }
-func (m *SQLDatabaseEgress_FileFormat) XXX_Unmarshal(b []byte) error {
+func (m *RestoreStorageTrashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SQLDatabaseEgress_FileFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RestoreStorageTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SQLDatabaseEgress_FileFormat.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RestoreStorageTrashRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4125,52 +4861,52 @@ func (m *SQLDatabaseEgress_FileFormat) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *SQLDatabaseEgress_FileFormat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SQLDatabaseEgress_FileFormat.Merge(m, src)
+func (m *RestoreStorageTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreStorageTrashRequest.Merge(m, src)
 }
-func (m *SQLDatabaseEgress_FileFormat) XXX_Size() int {
+func (m *RestoreStorageTrashRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SQLDatabaseEgress_FileFormat) XXX_DiscardUnknown() {
-	xxx_messageInfo_SQLDatabaseEgress_FileFormat.DiscardUnknown(m)
+func (m *RestoreStorageTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreStorageTrashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SQLDatabaseEgress_FileFormat proto.InternalMessageInfo
+var xxx_messageInfo_RestoreStorageTrashRequest proto.InternalMessageInfo
 
-func (m *SQLDatabaseEgress_FileFormat) GetType() SQLDatabaseEgress_FileFormat_Type {
+func (m *RestoreStorageTrashRequest) GetChunkIds() [][]byte {
 	if m != nil {
-		return m.Type
+		return m.ChunkIds
 	}
-	return SQLDatabaseEgress_FileFormat_UNKNOWN
+	return nil
 }
 
-func (m *SQLDatabaseEgress_FileFormat) GetColumns() []string {
+func (m *RestoreStorageTrashRequest) GetTtl() *types.Duration {
 	if m != nil {
-		return m.Columns
+		return m.Ttl
 	}
 	return nil
 }
 
-type SQLDatabaseEgress_Secret struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+type RestoreStorageTrashResponse struct {
+	RestoredCount        int64    `protobuf:"varint,1,opt,name=restored_count,json=restoredCount,proto3" json:"restored_count,omitempty"`
+	RestoredBytes        int64    `protobuf:"varint,2,opt,name=restored_bytes,json=restoredBytes,proto3" json:"restored_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SQLDatabaseEgress_Secret) Reset()         { *m = SQLDatabaseEgress_Secret{} }
-func (m *SQLDatabaseEgress_Secret) String() string { return proto.CompactTextString(m) }
-func (*SQLDatabaseEgress_Secret) ProtoMessage()    {}
-func (*SQLDatabaseEgress_Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{60, 1}
+func (m *RestoreStorageTrashResponse) Reset()         { *m = RestoreStorageTrashResponse{} }
+func (m *RestoreStorageTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreStorageTrashResponse) ProtoMessage()    {}
+func (*RestoreStorageTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{69}
 }
-func (m *SQLDatabaseEgress_Secret) XXX_Unmarshal(b []byte) error {
+func (m *RestoreStorageTrashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SQLDatabaseEgress_Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RestoreStorageTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SQLDatabaseEgress_Secret.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RestoreStorageTrashResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4180,55 +4916,54 @@ func (m *SQLDatabaseEgress_Secret) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *SQLDatabaseEgress_Secret) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SQLDatabaseEgress_Secret.Merge(m, src)
+func (m *RestoreStorageTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreStorageTrashResponse.Merge(m, src)
 }
-func (m *SQLDatabaseEgress_Secret) XXX_Size() int {
+func (m *RestoreStorageTrashResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SQLDatabaseEgress_Secret) XXX_DiscardUnknown() {
-	xxx_messageInfo_SQLDatabaseEgress_Secret.DiscardUnknown(m)
+func (m *RestoreStorageTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreStorageTrashResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SQLDatabaseEgress_Secret proto.InternalMessageInfo
+var xxx_messageInfo_RestoreStorageTrashResponse proto.InternalMessageInfo
 
-func (m *SQLDatabaseEgress_Secret) GetName() string {
+func (m *RestoreStorageTrashResponse) GetRestoredCount() int64 {
 	if m != nil {
-		return m.Name
+		return m.RestoredCount
 	}
-	return ""
+	return 0
 }
 
-func (m *SQLDatabaseEgress_Secret) GetKey() string {
+func (m *RestoreStorageTrashResponse) GetRestoredBytes() int64 {
 	if m != nil {
-		return m.Key
+		return m.RestoredBytes
 	}
-	return ""
+	return 0
 }
 
-type EgressRequest struct {
-	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	// Types that are valid to be assigned to Target:
-	//	*EgressRequest_ObjectStorage
-	//	*EgressRequest_SqlDatabase
-	Target               isEgressRequest_Target `protobuf_oneof:"target"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type PutCacheRequest struct {
+	Key                  string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                *types.Any `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	FileSetIds           []string   `protobuf:"bytes,3,rep,name=file_set_ids,json=fileSetIds,proto3" json:"file_set_ids,omitempty"`
+	Tag                  string     `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *EgressRequest) Reset()         { *m = EgressRequest{} }
-func (m *EgressRequest) String() string { return proto.CompactTextString(m) }
-func (*EgressRequest) ProtoMessage()    {}
-func (*EgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{61}
+func (m *PutCacheRequest) Reset()         { *m = PutCacheRequest{} }
+func (m *PutCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*PutCacheRequest) ProtoMessage()    {}
+func (*PutCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{70}
 }
-func (m *EgressRequest) XXX_Unmarshal(b []byte) error {
+func (m *PutCacheRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EgressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutCacheRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4238,92 +4973,112 @@ func (m *EgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EgressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EgressRequest.Merge(m, src)
+func (m *PutCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutCacheRequest.Merge(m, src)
 }
-func (m *EgressRequest) XXX_Size() int {
+func (m *PutCacheRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EgressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EgressRequest.DiscardUnknown(m)
+func (m *PutCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutCacheRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EgressRequest proto.InternalMessageInfo
+var xxx_messageInfo_PutCacheRequest proto.InternalMessageInfo
 
-type isEgressRequest_Target interface {
-	isEgressRequest_Target()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *PutCacheRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-type EgressRequest_ObjectStorage struct {
-	ObjectStorage *ObjectStorageEgress `protobuf:"bytes,2,opt,name=object_storage,json=objectStorage,proto3,oneof" json:"object_storage,omitempty"`
-}
-type EgressRequest_SqlDatabase struct {
-	SqlDatabase *SQLDatabaseEgress `protobuf:"bytes,3,opt,name=sql_database,json=sqlDatabase,proto3,oneof" json:"sql_database,omitempty"`
+func (m *PutCacheRequest) GetValue() *types.Any {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (*EgressRequest_ObjectStorage) isEgressRequest_Target() {}
-func (*EgressRequest_SqlDatabase) isEgressRequest_Target()   {}
-
-func (m *EgressRequest) GetTarget() isEgressRequest_Target {
+func (m *PutCacheRequest) GetFileSetIds() []string {
 	if m != nil {
-		return m.Target
+		return m.FileSetIds
 	}
 	return nil
 }
 
-func (m *EgressRequest) GetCommit() *Commit {
+func (m *PutCacheRequest) GetTag() string {
 	if m != nil {
-		return m.Commit
+		return m.Tag
 	}
-	return nil
+	return ""
 }
 
-func (m *EgressRequest) GetObjectStorage() *ObjectStorageEgress {
-	if x, ok := m.GetTarget().(*EgressRequest_ObjectStorage); ok {
-		return x.ObjectStorage
-	}
-	return nil
+type GetCacheRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EgressRequest) GetSqlDatabase() *SQLDatabaseEgress {
-	if x, ok := m.GetTarget().(*EgressRequest_SqlDatabase); ok {
-		return x.SqlDatabase
+func (m *GetCacheRequest) Reset()         { *m = GetCacheRequest{} }
+func (m *GetCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCacheRequest) ProtoMessage()    {}
+func (*GetCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{71}
+}
+func (m *GetCacheRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCacheRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *GetCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCacheRequest.Merge(m, src)
+}
+func (m *GetCacheRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCacheRequest.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*EgressRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*EgressRequest_ObjectStorage)(nil),
-		(*EgressRequest_SqlDatabase)(nil),
+var xxx_messageInfo_GetCacheRequest proto.InternalMessageInfo
+
+func (m *GetCacheRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
+	return ""
 }
 
-type EgressResponse struct {
-	// Types that are valid to be assigned to Result:
-	//	*EgressResponse_ObjectStorage
-	//	*EgressResponse_SqlDatabase
-	Result               isEgressResponse_Result `protobuf_oneof:"result"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+type GetCacheResponse struct {
+	Value                *types.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *EgressResponse) Reset()         { *m = EgressResponse{} }
-func (m *EgressResponse) String() string { return proto.CompactTextString(m) }
-func (*EgressResponse) ProtoMessage()    {}
-func (*EgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{62}
+func (m *GetCacheResponse) Reset()         { *m = GetCacheResponse{} }
+func (m *GetCacheResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCacheResponse) ProtoMessage()    {}
+func (*GetCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{72}
 }
-func (m *EgressResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetCacheResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EgressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCacheResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4333,82 +5088,90 @@ func (m *EgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EgressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EgressResponse.Merge(m, src)
+func (m *GetCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCacheResponse.Merge(m, src)
 }
-func (m *EgressResponse) XXX_Size() int {
+func (m *GetCacheResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EgressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EgressResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EgressResponse proto.InternalMessageInfo
-
-type isEgressResponse_Result interface {
-	isEgressResponse_Result()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type EgressResponse_ObjectStorage struct {
-	ObjectStorage *EgressResponse_ObjectStorageResult `protobuf:"bytes,1,opt,name=object_storage,json=objectStorage,proto3,oneof" json:"object_storage,omitempty"`
-}
-type EgressResponse_SqlDatabase struct {
-	SqlDatabase *EgressResponse_SQLDatabaseResult `protobuf:"bytes,2,opt,name=sql_database,json=sqlDatabase,proto3,oneof" json:"sql_database,omitempty"`
+func (m *GetCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCacheResponse.DiscardUnknown(m)
 }
 
-func (*EgressResponse_ObjectStorage) isEgressResponse_Result() {}
-func (*EgressResponse_SqlDatabase) isEgressResponse_Result()   {}
+var xxx_messageInfo_GetCacheResponse proto.InternalMessageInfo
 
-func (m *EgressResponse) GetResult() isEgressResponse_Result {
+func (m *GetCacheResponse) GetValue() *types.Any {
 	if m != nil {
-		return m.Result
+		return m.Value
 	}
 	return nil
 }
 
-func (m *EgressResponse) GetObjectStorage() *EgressResponse_ObjectStorageResult {
-	if x, ok := m.GetResult().(*EgressResponse_ObjectStorage); ok {
-		return x.ObjectStorage
-	}
-	return nil
+type ClearCacheRequest struct {
+	TagPrefix            string   `protobuf:"bytes,1,opt,name=tag_prefix,json=tagPrefix,proto3" json:"tag_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EgressResponse) GetSqlDatabase() *EgressResponse_SQLDatabaseResult {
-	if x, ok := m.GetResult().(*EgressResponse_SqlDatabase); ok {
-		return x.SqlDatabase
+func (m *ClearCacheRequest) Reset()         { *m = ClearCacheRequest{} }
+func (m *ClearCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearCacheRequest) ProtoMessage()    {}
+func (*ClearCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{73}
+}
+func (m *ClearCacheRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClearCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClearCacheRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ClearCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearCacheRequest.Merge(m, src)
+}
+func (m *ClearCacheRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClearCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearCacheRequest.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*EgressResponse) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*EgressResponse_ObjectStorage)(nil),
-		(*EgressResponse_SqlDatabase)(nil),
+var xxx_messageInfo_ClearCacheRequest proto.InternalMessageInfo
+
+func (m *ClearCacheRequest) GetTagPrefix() string {
+	if m != nil {
+		return m.TagPrefix
 	}
+	return ""
 }
 
-type EgressResponse_ObjectStorageResult struct {
-	BytesWritten         int64    `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+type ActivateAuthRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EgressResponse_ObjectStorageResult) Reset()         { *m = EgressResponse_ObjectStorageResult{} }
-func (m *EgressResponse_ObjectStorageResult) String() string { return proto.CompactTextString(m) }
-func (*EgressResponse_ObjectStorageResult) ProtoMessage()    {}
-func (*EgressResponse_ObjectStorageResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{62, 0}
+func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
+func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateAuthRequest) ProtoMessage()    {}
+func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{74}
 }
-func (m *EgressResponse_ObjectStorageResult) XXX_Unmarshal(b []byte) error {
+func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EgressResponse_ObjectStorageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EgressResponse_ObjectStorageResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ActivateAuthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4418,44 +5181,36 @@ func (m *EgressResponse_ObjectStorageResult) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EgressResponse_ObjectStorageResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EgressResponse_ObjectStorageResult.Merge(m, src)
+func (m *ActivateAuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateAuthRequest.Merge(m, src)
 }
-func (m *EgressResponse_ObjectStorageResult) XXX_Size() int {
+func (m *ActivateAuthRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EgressResponse_ObjectStorageResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_EgressResponse_ObjectStorageResult.DiscardUnknown(m)
+func (m *ActivateAuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateAuthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EgressResponse_ObjectStorageResult proto.InternalMessageInfo
-
-func (m *EgressResponse_ObjectStorageResult) GetBytesWritten() int64 {
-	if m != nil {
-		return m.BytesWritten
-	}
-	return 0
-}
+var xxx_messageInfo_ActivateAuthRequest proto.InternalMessageInfo
 
-type EgressResponse_SQLDatabaseResult struct {
-	RowsWritten          map[string]int64 `protobuf:"bytes,1,rep,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ActivateAuthResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EgressResponse_SQLDatabaseResult) Reset()         { *m = EgressResponse_SQLDatabaseResult{} }
-func (m *EgressResponse_SQLDatabaseResult) String() string { return proto.CompactTextString(m) }
-func (*EgressResponse_SQLDatabaseResult) ProtoMessage()    {}
-func (*EgressResponse_SQLDatabaseResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{62, 1}
+func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
+func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
+func (*ActivateAuthResponse) ProtoMessage()    {}
+func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{75}
 }
-func (m *EgressResponse_SQLDatabaseResult) XXX_Unmarshal(b []byte) error {
+func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EgressResponse_SQLDatabaseResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EgressResponse_SQLDatabaseResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ActivateAuthResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4465,989 +5220,1195 @@ func (m *EgressResponse_SQLDatabaseResult) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *EgressResponse_SQLDatabaseResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EgressResponse_SQLDatabaseResult.Merge(m, src)
+func (m *ActivateAuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateAuthResponse.Merge(m, src)
 }
-func (m *EgressResponse_SQLDatabaseResult) XXX_Size() int {
+func (m *ActivateAuthResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EgressResponse_SQLDatabaseResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_EgressResponse_SQLDatabaseResult.DiscardUnknown(m)
+func (m *ActivateAuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateAuthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EgressResponse_SQLDatabaseResult proto.InternalMessageInfo
-
-func (m *EgressResponse_SQLDatabaseResult) GetRowsWritten() map[string]int64 {
-	if m != nil {
-		return m.RowsWritten
-	}
-	return nil
-}
+var xxx_messageInfo_ActivateAuthResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("pfs_v2.OriginKind", OriginKind_name, OriginKind_value)
-	proto.RegisterEnum("pfs_v2.FileType", FileType_name, FileType_value)
-	proto.RegisterEnum("pfs_v2.CommitState", CommitState_name, CommitState_value)
-	proto.RegisterEnum("pfs_v2.Delimiter", Delimiter_name, Delimiter_value)
-	proto.RegisterEnum("pfs_v2.SQLDatabaseEgress_FileFormat_Type", SQLDatabaseEgress_FileFormat_Type_name, SQLDatabaseEgress_FileFormat_Type_value)
-	proto.RegisterType((*Repo)(nil), "pfs_v2.Repo")
-	proto.RegisterType((*Branch)(nil), "pfs_v2.Branch")
-	proto.RegisterType((*File)(nil), "pfs_v2.File")
-	proto.RegisterType((*RepoInfo)(nil), "pfs_v2.RepoInfo")
-	proto.RegisterType((*RepoInfo_Details)(nil), "pfs_v2.RepoInfo.Details")
-	proto.RegisterType((*RepoAuthInfo)(nil), "pfs_v2.RepoAuthInfo")
-	proto.RegisterType((*BranchInfo)(nil), "pfs_v2.BranchInfo")
-	proto.RegisterType((*Trigger)(nil), "pfs_v2.Trigger")
-	proto.RegisterType((*CommitOrigin)(nil), "pfs_v2.CommitOrigin")
-	proto.RegisterType((*Commit)(nil), "pfs_v2.Commit")
-	proto.RegisterType((*CommitInfo)(nil), "pfs_v2.CommitInfo")
-	proto.RegisterType((*CommitInfo_Details)(nil), "pfs_v2.CommitInfo.Details")
-	proto.RegisterType((*CommitSet)(nil), "pfs_v2.CommitSet")
-	proto.RegisterType((*CommitSetInfo)(nil), "pfs_v2.CommitSetInfo")
-	proto.RegisterType((*FileInfo)(nil), "pfs_v2.FileInfo")
-	proto.RegisterType((*CreateRepoRequest)(nil), "pfs_v2.CreateRepoRequest")
-	proto.RegisterType((*InspectRepoRequest)(nil), "pfs_v2.InspectRepoRequest")
-	proto.RegisterType((*ListRepoRequest)(nil), "pfs_v2.ListRepoRequest")
-	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs_v2.DeleteRepoRequest")
-	proto.RegisterType((*StartCommitRequest)(nil), "pfs_v2.StartCommitRequest")
-	proto.RegisterType((*FinishCommitRequest)(nil), "pfs_v2.FinishCommitRequest")
-	proto.RegisterType((*InspectCommitRequest)(nil), "pfs_v2.InspectCommitRequest")
-	proto.RegisterType((*ListCommitRequest)(nil), "pfs_v2.ListCommitRequest")
-	proto.RegisterType((*InspectCommitSetRequest)(nil), "pfs_v2.InspectCommitSetRequest")
-	proto.RegisterType((*ListCommitSetRequest)(nil), "pfs_v2.ListCommitSetRequest")
-	proto.RegisterType((*SquashCommitSetRequest)(nil), "pfs_v2.SquashCommitSetRequest")
-	proto.RegisterType((*DropCommitSetRequest)(nil), "pfs_v2.DropCommitSetRequest")
-	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs_v2.SubscribeCommitRequest")
-	proto.RegisterType((*ClearCommitRequest)(nil), "pfs_v2.ClearCommitRequest")
-	proto.RegisterType((*CreateBranchRequest)(nil), "pfs_v2.CreateBranchRequest")
-	proto.RegisterType((*InspectBranchRequest)(nil), "pfs_v2.InspectBranchRequest")
-	proto.RegisterType((*ListBranchRequest)(nil), "pfs_v2.ListBranchRequest")
-	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs_v2.DeleteBranchRequest")
-	proto.RegisterType((*AddFile)(nil), "pfs_v2.AddFile")
-	proto.RegisterType((*AddFile_URLSource)(nil), "pfs_v2.AddFile.URLSource")
-	proto.RegisterType((*DeleteFile)(nil), "pfs_v2.DeleteFile")
-	proto.RegisterType((*CopyFile)(nil), "pfs_v2.CopyFile")
-	proto.RegisterType((*ModifyFileRequest)(nil), "pfs_v2.ModifyFileRequest")
-	proto.RegisterType((*GetFileRequest)(nil), "pfs_v2.GetFileRequest")
-	proto.RegisterType((*InspectFileRequest)(nil), "pfs_v2.InspectFileRequest")
-	proto.RegisterType((*ListFileRequest)(nil), "pfs_v2.ListFileRequest")
-	proto.RegisterType((*WalkFileRequest)(nil), "pfs_v2.WalkFileRequest")
-	proto.RegisterType((*GlobFileRequest)(nil), "pfs_v2.GlobFileRequest")
-	proto.RegisterType((*DiffFileRequest)(nil), "pfs_v2.DiffFileRequest")
-	proto.RegisterType((*DiffFileResponse)(nil), "pfs_v2.DiffFileResponse")
-	proto.RegisterType((*FsckRequest)(nil), "pfs_v2.FsckRequest")
-	proto.RegisterType((*FsckResponse)(nil), "pfs_v2.FsckResponse")
-	proto.RegisterType((*CreateFileSetResponse)(nil), "pfs_v2.CreateFileSetResponse")
-	proto.RegisterType((*GetFileSetRequest)(nil), "pfs_v2.GetFileSetRequest")
-	proto.RegisterType((*AddFileSetRequest)(nil), "pfs_v2.AddFileSetRequest")
-	proto.RegisterType((*RenewFileSetRequest)(nil), "pfs_v2.RenewFileSetRequest")
-	proto.RegisterType((*ComposeFileSetRequest)(nil), "pfs_v2.ComposeFileSetRequest")
-	proto.RegisterType((*CheckStorageRequest)(nil), "pfs_v2.CheckStorageRequest")
-	proto.RegisterType((*CheckStorageResponse)(nil), "pfs_v2.CheckStorageResponse")
-	proto.RegisterType((*PutCacheRequest)(nil), "pfs_v2.PutCacheRequest")
-	proto.RegisterType((*GetCacheRequest)(nil), "pfs_v2.GetCacheRequest")
-	proto.RegisterType((*GetCacheResponse)(nil), "pfs_v2.GetCacheResponse")
-	proto.RegisterType((*ClearCacheRequest)(nil), "pfs_v2.ClearCacheRequest")
-	proto.RegisterType((*ActivateAuthRequest)(nil), "pfs_v2.ActivateAuthRequest")
-	proto.RegisterType((*ActivateAuthResponse)(nil), "pfs_v2.ActivateAuthResponse")
-	proto.RegisterType((*RunLoadTestRequest)(nil), "pfs_v2.RunLoadTestRequest")
-	proto.RegisterType((*RunLoadTestResponse)(nil), "pfs_v2.RunLoadTestResponse")
-	proto.RegisterType((*ObjectStorageEgress)(nil), "pfs_v2.ObjectStorageEgress")
-	proto.RegisterType((*SQLDatabaseEgress)(nil), "pfs_v2.SQLDatabaseEgress")
-	proto.RegisterType((*SQLDatabaseEgress_FileFormat)(nil), "pfs_v2.SQLDatabaseEgress.FileFormat")
-	proto.RegisterType((*SQLDatabaseEgress_Secret)(nil), "pfs_v2.SQLDatabaseEgress.Secret")
-	proto.RegisterType((*EgressRequest)(nil), "pfs_v2.EgressRequest")
-	proto.RegisterType((*EgressResponse)(nil), "pfs_v2.EgressResponse")
-	proto.RegisterType((*EgressResponse_ObjectStorageResult)(nil), "pfs_v2.EgressResponse.ObjectStorageResult")
-	proto.RegisterType((*EgressResponse_SQLDatabaseResult)(nil), "pfs_v2.EgressResponse.SQLDatabaseResult")
-	proto.RegisterMapType((map[string]int64)(nil), "pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry")
+type RunLoadTestRequest struct {
+	Spec                 string   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Branch               *Branch  `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Seed                 int64    `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("pfs/pfs.proto", fileDescriptor_21a7b2476cbc6216) }
-
-var fileDescriptor_21a7b2476cbc6216 = []byte{
-	// 3456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x73, 0xdb, 0xc6,
-	0x15, 0x17, 0x08, 0x8a, 0x1f, 0x8f, 0x94, 0x44, 0xad, 0x64, 0x85, 0xa1, 0x63, 0xd9, 0x83, 0xa4,
-	0xfe, 0x8a, 0x43, 0xb9, 0x72, 0xec, 0x7c, 0xb8, 0x71, 0x86, 0x12, 0x29, 0x8b, 0xb1, 0x2c, 0x39,
-	0xa0, 0xec, 0xb4, 0x49, 0x66, 0x38, 0x10, 0xb1, 0xa4, 0x10, 0x81, 0x00, 0x0c, 0x80, 0x52, 0xd5,
-	0x4e, 0x7b, 0xe9, 0x4c, 0x7b, 0xe8, 0x3f, 0xd0, 0xe9, 0x29, 0x7f, 0x41, 0xa7, 0xed, 0xb1, 0xff,
-	0x40, 0x73, 0xec, 0xb9, 0x87, 0x4e, 0xc7, 0xa7, 0x9e, 0xdb, 0x99, 0x9e, 0x3b, 0xfb, 0x01, 0x60,
-	0x01, 0x7e, 0xca, 0xcd, 0x85, 0xb3, 0xd8, 0x7d, 0xef, 0xed, 0xdb, 0xf7, 0xb5, 0x6f, 0x7f, 0x84,
-	0x05, 0xa7, 0xeb, 0x6d, 0x38, 0x5d, 0xaf, 0xea, 0xb8, 0xb6, 0x6f, 0xa3, 0x8c, 0xd3, 0xf5, 0xda,
-	0xa7, 0x9b, 0x95, 0xcb, 0x3d, 0xdb, 0xee, 0x99, 0x78, 0x83, 0xce, 0x1e, 0x0d, 0xba, 0x1b, 0xb8,
-	0xef, 0xf8, 0xe7, 0x8c, 0xa8, 0x72, 0x35, 0xb9, 0xe8, 0x1b, 0x7d, 0xec, 0xf9, 0x5a, 0xdf, 0xe1,
-	0x04, 0xeb, 0x49, 0x82, 0x33, 0x57, 0x73, 0x1c, 0xec, 0x7a, 0xe3, 0xd6, 0xf5, 0x81, 0xab, 0xf9,
-	0x86, 0x6d, 0xf1, 0xf5, 0x37, 0x93, 0xeb, 0x9a, 0x15, 0xec, 0xbd, 0xda, 0xb3, 0x7b, 0x36, 0x1d,
-	0x6e, 0x90, 0x11, 0x9f, 0x5d, 0xd2, 0x06, 0xfe, 0xf1, 0x06, 0xf9, 0x09, 0x26, 0x7c, 0xcd, 0x3b,
-	0xd9, 0x20, 0x3f, 0x6c, 0x42, 0x79, 0x1f, 0xd2, 0x2a, 0x76, 0x6c, 0x84, 0x20, 0x6d, 0x69, 0x7d,
-	0x5c, 0x96, 0xae, 0x49, 0x37, 0xf3, 0x2a, 0x1d, 0x93, 0x39, 0xff, 0xdc, 0xc1, 0xe5, 0x14, 0x9b,
-	0x23, 0xe3, 0x8f, 0xd3, 0xbf, 0xfb, 0xf6, 0xea, 0x9c, 0x52, 0x87, 0xcc, 0x96, 0xab, 0x59, 0x9d,
-	0x63, 0x74, 0x0d, 0xd2, 0x2e, 0x76, 0x6c, 0xca, 0x57, 0xd8, 0x2c, 0x56, 0x99, 0x9d, 0xaa, 0x44,
-	0xa6, 0x4a, 0x57, 0x42, 0xc9, 0xa9, 0x48, 0x32, 0x97, 0xf2, 0x63, 0x48, 0xef, 0x18, 0x26, 0x46,
-	0xd7, 0x21, 0xd3, 0xb1, 0xfb, 0x7d, 0xc3, 0xe7, 0x52, 0x16, 0x03, 0x29, 0xdb, 0x74, 0x56, 0xe5,
-	0xab, 0x44, 0x92, 0xa3, 0xf9, 0xc7, 0x81, 0x24, 0x32, 0x46, 0xab, 0x30, 0xaf, 0x6b, 0xfe, 0xa0,
-	0x5f, 0x96, 0xe9, 0x24, 0xfb, 0x50, 0xfe, 0x9b, 0x82, 0x1c, 0x51, 0xa1, 0x69, 0x75, 0xed, 0x19,
-	0x54, 0x7c, 0x1f, 0xb2, 0x1d, 0x17, 0x6b, 0x3e, 0xd6, 0xa9, 0xec, 0xc2, 0x66, 0xa5, 0xca, 0x2c,
-	0x5d, 0x0d, 0x2c, 0x5d, 0x3d, 0x0c, 0x5c, 0xa9, 0x06, 0xa4, 0xe8, 0x1e, 0xac, 0x79, 0xc6, 0xcf,
-	0x70, 0xfb, 0xe8, 0xdc, 0xc7, 0x5e, 0x7b, 0x40, 0x1c, 0xd9, 0x3e, 0xb2, 0x07, 0x96, 0x4e, 0x75,
-	0x91, 0xd5, 0x15, 0xb2, 0xba, 0x45, 0x16, 0x9f, 0x93, 0xb5, 0x2d, 0xb2, 0x84, 0xae, 0x41, 0x41,
-	0xc7, 0x5e, 0xc7, 0x35, 0x1c, 0xe2, 0xd7, 0x72, 0x9a, 0x6a, 0x2d, 0x4e, 0xa1, 0xdb, 0x90, 0x3b,
-	0xa2, 0xb6, 0xc5, 0x5e, 0x79, 0xfe, 0x9a, 0x2c, 0xda, 0x83, 0xd9, 0x5c, 0x0d, 0xd7, 0xd1, 0x0f,
-	0x21, 0x4f, 0x9c, 0xdb, 0x36, 0xac, 0xae, 0x5d, 0xce, 0x50, 0xd5, 0x57, 0xc5, 0xf3, 0xd5, 0x06,
-	0xfe, 0x31, 0xb1, 0x81, 0x9a, 0xd3, 0xf8, 0x08, 0x6d, 0x42, 0x56, 0xc7, 0xbe, 0x66, 0x98, 0x5e,
-	0x39, 0x4b, 0x19, 0xca, 0x22, 0x03, 0x21, 0xa9, 0xd6, 0xd9, 0xba, 0x1a, 0x10, 0x56, 0x6e, 0x42,
-	0x96, 0xcf, 0xa1, 0x2b, 0x00, 0xd1, 0xa1, 0xa9, 0x49, 0x65, 0x35, 0x1f, 0x1e, 0x54, 0xf9, 0x0a,
-	0x8a, 0xe2, 0xbe, 0xe8, 0x3e, 0x14, 0x1c, 0xec, 0xf6, 0x0d, 0xcf, 0x33, 0x6c, 0x8b, 0xd0, 0xcb,
-	0x37, 0x17, 0x37, 0x57, 0xaa, 0x54, 0xe9, 0xd3, 0xcd, 0xea, 0xb3, 0x70, 0x4d, 0x15, 0xe9, 0x88,
-	0x57, 0x5d, 0xdb, 0xc4, 0x5e, 0x39, 0x75, 0x4d, 0x26, 0x5e, 0xa5, 0x1f, 0xca, 0xb7, 0x29, 0x00,
-	0x66, 0x02, 0x2a, 0xfb, 0x3a, 0x64, 0x98, 0x21, 0x92, 0x61, 0xc3, 0xcd, 0xc4, 0x57, 0x91, 0x02,
-	0xe9, 0x63, 0xac, 0x05, 0xae, 0x4d, 0x06, 0x17, 0x5d, 0x43, 0x55, 0x00, 0xc7, 0xb5, 0x4f, 0xb1,
-	0xa5, 0x59, 0x1d, 0x5c, 0x96, 0x47, 0x9a, 0x5d, 0xa0, 0x20, 0xf4, 0xde, 0xe0, 0x28, 0xa0, 0x4f,
-	0x8f, 0xa6, 0x8f, 0x28, 0xd0, 0x43, 0x58, 0xd6, 0x0d, 0x17, 0x77, 0xfc, 0xb6, 0xb0, 0xcd, 0x68,
-	0xef, 0x96, 0x18, 0xe1, 0xb3, 0x68, 0xb3, 0x5b, 0x90, 0xf5, 0x5d, 0xa3, 0xd7, 0xc3, 0x2e, 0xf7,
-	0xf1, 0x52, 0xc0, 0x72, 0xc8, 0xa6, 0xd5, 0x60, 0x5d, 0xf9, 0x25, 0x64, 0xf9, 0x1c, 0x5a, 0x8b,
-	0x99, 0x27, 0x1f, 0x9a, 0xa3, 0x04, 0xb2, 0x66, 0x9a, 0xd4, 0x1a, 0x39, 0x95, 0x0c, 0xd1, 0x65,
-	0xc8, 0x77, 0x5c, 0xdb, 0x6a, 0x7b, 0x0e, 0xee, 0xf0, 0x3c, 0xca, 0x91, 0x89, 0x96, 0x83, 0x3b,
-	0x24, 0xe9, 0x88, 0x7b, 0x79, 0xa4, 0xd2, 0x31, 0x2a, 0x43, 0x96, 0xa5, 0x24, 0x89, 0x50, 0x12,
-	0x01, 0xc1, 0xa7, 0xf2, 0x00, 0x8a, 0xcc, 0xae, 0x07, 0xae, 0xd1, 0x33, 0x2c, 0x74, 0x1d, 0xd2,
-	0x27, 0x86, 0xa5, 0x53, 0x15, 0x16, 0x37, 0x51, 0xa0, 0x37, 0x5b, 0x7d, 0x62, 0x58, 0xba, 0x4a,
-	0xd7, 0x95, 0x7d, 0xc8, 0x30, 0xbe, 0x99, 0xbd, 0xba, 0x06, 0x29, 0x83, 0xf9, 0x34, 0xbf, 0x95,
-	0x79, 0xf5, 0x8f, 0xab, 0xa9, 0x66, 0x5d, 0x4d, 0x19, 0x3a, 0x2f, 0x2d, 0xbf, 0xc9, 0x00, 0x30,
-	0x81, 0x41, 0xa8, 0xcc, 0x54, 0x61, 0xee, 0x40, 0xc6, 0xa6, 0xaa, 0xf1, 0x60, 0x59, 0x8d, 0xd3,
-	0x31, 0xb5, 0x55, 0x4e, 0x93, 0xcc, 0x65, 0x79, 0x38, 0x97, 0xef, 0xc1, 0x82, 0xa3, 0xb9, 0xd8,
-	0xf2, 0xdb, 0x7c, 0xfb, 0xf4, 0xc8, 0xed, 0x8b, 0x8c, 0x88, 0x5b, 0xe0, 0x1e, 0x2c, 0x74, 0x8e,
-	0x0d, 0x53, 0x6f, 0x47, 0x36, 0x96, 0x47, 0x31, 0x51, 0x22, 0xf6, 0xe1, 0x91, 0x12, 0xe6, 0xf9,
-	0x9a, 0x4b, 0x4a, 0x58, 0x66, 0x7a, 0x09, 0xe3, 0xa4, 0xe8, 0x43, 0xc8, 0x77, 0x0d, 0xcb, 0xf0,
-	0x8e, 0x0d, 0xab, 0xc7, 0xcb, 0xc1, 0x24, 0xbe, 0x88, 0x18, 0x3d, 0x80, 0x1c, 0xfb, 0xc0, 0x7a,
-	0x39, 0x37, 0x95, 0x31, 0xa4, 0x1d, 0x9d, 0x08, 0xf9, 0x19, 0x13, 0x61, 0x15, 0xe6, 0xb1, 0xeb,
-	0xda, 0x6e, 0x19, 0x58, 0xb1, 0xa7, 0x1f, 0x13, 0xea, 0x70, 0x61, 0x7c, 0x1d, 0x7e, 0x3f, 0x2a,
-	0x83, 0x45, 0xae, 0x7e, 0xcc, 0xbc, 0xa3, 0x0b, 0xe1, 0x1f, 0xa5, 0x59, 0x2b, 0x21, 0xda, 0x82,
-	0xa5, 0x8e, 0xdd, 0x77, 0xb4, 0x8e, 0x6f, 0x58, 0xbd, 0x36, 0xe9, 0x04, 0x78, 0x4c, 0xbd, 0x39,
-	0x64, 0xa7, 0x3a, 0xbf, 0xe5, 0xd5, 0xc5, 0x88, 0x83, 0xd8, 0x8e, 0xc8, 0x38, 0xd5, 0x4c, 0x43,
-	0xd7, 0x22, 0x19, 0xf2, 0x54, 0x19, 0x11, 0x07, 0x91, 0xa1, 0xbc, 0x0d, 0x79, 0x76, 0xa2, 0x16,
-	0xf6, 0x79, 0xd2, 0x48, 0xc9, 0xa4, 0x51, 0x6c, 0x58, 0x08, 0x89, 0x68, 0xc2, 0xdc, 0x05, 0x60,
-	0xd1, 0xd7, 0xf6, 0x70, 0x90, 0x34, 0xcb, 0x71, 0x0b, 0xb5, 0xb0, 0xaf, 0xe6, 0x3b, 0xa1, 0xe8,
-	0x3b, 0x51, 0x4d, 0x48, 0x51, 0x77, 0xa2, 0x61, 0x83, 0x46, 0x75, 0xe2, 0x3b, 0x09, 0x72, 0xe4,
-	0xee, 0x0f, 0x2e, 0xe8, 0xae, 0x61, 0xe2, 0xe4, 0x05, 0x4d, 0xd6, 0x55, 0xba, 0x82, 0xde, 0x23,
-	0x71, 0x6a, 0xe2, 0x76, 0xd8, 0x8e, 0x2c, 0x6e, 0x96, 0x44, 0xb2, 0xc3, 0x73, 0x07, 0x93, 0x20,
-	0x63, 0x23, 0x12, 0xd6, 0x6c, 0x23, 0x92, 0x0e, 0xf2, 0xf4, 0xb0, 0x0e, 0x89, 0x13, 0x4e, 0x4d,
-	0x27, 0x9d, 0x8a, 0x20, 0x7d, 0xac, 0x79, 0xc7, 0xb4, 0xea, 0x15, 0x55, 0x3a, 0x56, 0x6c, 0x58,
-	0xde, 0xa6, 0x1d, 0x01, 0x6d, 0x28, 0xf0, 0xcb, 0x01, 0xf6, 0xfc, 0x19, 0x7a, 0x8e, 0x44, 0xf1,
-	0x48, 0x0d, 0x17, 0x8f, 0x35, 0xc8, 0x0c, 0x1c, 0x5d, 0xf3, 0x99, 0xd3, 0x73, 0x2a, 0xff, 0x52,
-	0x1e, 0x00, 0x6a, 0x5a, 0xa4, 0x56, 0xfb, 0x17, 0xda, 0x51, 0xf9, 0x01, 0x2c, 0xed, 0x19, 0x5e,
-	0x8c, 0x29, 0xe8, 0xf0, 0xa4, 0xa8, 0xc3, 0x53, 0x9e, 0xc0, 0x72, 0x1d, 0x9b, 0xf8, 0xa2, 0xe7,
-	0x59, 0x85, 0xf9, 0xae, 0xed, 0x76, 0x30, 0xbf, 0x58, 0xd8, 0x87, 0xf2, 0x6b, 0x09, 0x50, 0x8b,
-	0x14, 0x1b, 0x5e, 0xb4, 0xb8, 0xb8, 0xeb, 0x90, 0x61, 0x25, 0x6f, 0x5c, 0x3d, 0x66, 0xab, 0x33,
-	0x18, 0x29, 0xba, 0x2e, 0xe4, 0x49, 0xd7, 0x85, 0xf2, 0x5b, 0x09, 0x56, 0x76, 0x68, 0x11, 0x1a,
-	0xd2, 0x64, 0xa6, 0x9b, 0x61, 0xba, 0x26, 0x61, 0x71, 0x92, 0xc5, 0xe2, 0x14, 0x9a, 0x25, 0x2d,
-	0x9a, 0xa5, 0x07, 0xab, 0xdc, 0x85, 0xaf, 0xa7, 0xcd, 0x0d, 0x48, 0x9f, 0x69, 0x86, 0xcf, 0x53,
-	0x61, 0x25, 0x91, 0x98, 0x3e, 0x09, 0x46, 0x4a, 0xa0, 0xfc, 0x5b, 0x82, 0x65, 0xe2, 0xf4, 0xf8,
-	0x36, 0xd3, 0xbd, 0xa9, 0x40, 0xba, 0xeb, 0xda, 0xfd, 0x71, 0x3d, 0x13, 0x59, 0x43, 0xeb, 0x90,
-	0xf2, 0xed, 0xa4, 0xd9, 0x39, 0x45, 0xca, 0xb7, 0x49, 0xfc, 0x5a, 0x83, 0xfe, 0x11, 0x76, 0x79,
-	0x1e, 0xf1, 0x2f, 0xd2, 0x3d, 0xb8, 0xf8, 0x14, 0xbb, 0x1e, 0xa6, 0x79, 0x94, 0x53, 0x83, 0xcf,
-	0xa0, 0x35, 0xc9, 0x44, 0xad, 0xc9, 0x3d, 0x28, 0xb0, 0xcb, 0xb6, 0x4d, 0xdb, 0x88, 0xec, 0xd8,
-	0x36, 0x02, 0xec, 0x70, 0xac, 0xb4, 0xe1, 0x8d, 0x98, 0x75, 0x49, 0xa5, 0xe2, 0x27, 0xbf, 0x78,
-	0x5d, 0x43, 0x82, 0xa9, 0x73, 0xdc, 0xaa, 0x6b, 0xb0, 0x1a, 0x19, 0x35, 0x92, 0xae, 0x7c, 0x06,
-	0x6b, 0xad, 0x97, 0x03, 0x2d, 0x88, 0xb1, 0xff, 0x67, 0x5f, 0x65, 0x17, 0x56, 0xeb, 0xae, 0xed,
-	0x7c, 0x0f, 0x92, 0xfe, 0x25, 0xc1, 0x5a, 0x6b, 0x70, 0x44, 0x22, 0xf5, 0x08, 0x5f, 0x34, 0x10,
-	0xa2, 0x2e, 0x32, 0x15, 0xeb, 0x22, 0x83, 0x00, 0x91, 0x27, 0x04, 0xc8, 0x2d, 0x98, 0xf7, 0x48,
-	0x2c, 0x52, 0xff, 0x8f, 0x09, 0x53, 0x46, 0x11, 0x78, 0x7e, 0x7e, 0xac, 0xe7, 0x33, 0x33, 0x79,
-	0xfe, 0x47, 0x80, 0xb6, 0x4d, 0xac, 0xb9, 0xaf, 0x95, 0x55, 0xca, 0x2b, 0x09, 0x56, 0x58, 0x29,
-	0xe7, 0xc5, 0x83, 0xf3, 0x07, 0x0f, 0x08, 0x69, 0xc2, 0x03, 0xe2, 0x7a, 0xcc, 0x4e, 0xe3, 0xdb,
-	0xd6, 0x8b, 0x3e, 0x34, 0x84, 0xde, 0x3f, 0x3d, 0xb9, 0xf7, 0x47, 0xef, 0xc0, 0xa2, 0x85, 0xcf,
-	0xda, 0x42, 0x74, 0x30, 0x73, 0x16, 0x2d, 0x7c, 0x16, 0x06, 0x86, 0xf2, 0x28, 0x2c, 0x3d, 0xf1,
-	0x43, 0xce, 0xd8, 0x77, 0x2b, 0x07, 0xac, 0xa0, 0xc4, 0x99, 0xa7, 0xc7, 0x91, 0x90, 0xf4, 0xa9,
-	0x58, 0xd2, 0x2b, 0x2d, 0x58, 0x61, 0xf7, 0xcd, 0x6b, 0xe9, 0x33, 0xe6, 0xde, 0xf9, 0xbb, 0x04,
-	0xd9, 0x9a, 0xae, 0x53, 0x78, 0x21, 0x80, 0x0d, 0xa4, 0x51, 0xb0, 0x41, 0x4a, 0x80, 0x0d, 0xd0,
-	0x06, 0xc8, 0xae, 0x76, 0xc6, 0x63, 0xfa, 0xf2, 0x50, 0xc7, 0x40, 0x7b, 0x80, 0x17, 0x9a, 0x39,
-	0xc0, 0xbb, 0x73, 0x2a, 0xa1, 0x44, 0xef, 0x81, 0x3c, 0x70, 0x4d, 0xee, 0x99, 0x37, 0x03, 0x0d,
-	0xf9, 0xc6, 0xd5, 0xe7, 0xea, 0x5e, 0xcb, 0x1e, 0xb8, 0x1d, 0x4a, 0x3e, 0x70, 0xcd, 0xca, 0x43,
-	0xc8, 0x87, 0x73, 0x24, 0xe4, 0x9f, 0xab, 0x7b, 0x5c, 0x2b, 0x32, 0x44, 0x6f, 0x41, 0xde, 0xc5,
-	0x9d, 0x81, 0xeb, 0x19, 0xa7, 0xc1, 0x71, 0xa2, 0x89, 0xad, 0x1c, 0x64, 0x3c, 0xca, 0xa9, 0x3c,
-	0x00, 0x60, 0x16, 0xbb, 0xd8, 0xf1, 0x94, 0x6f, 0x20, 0xb7, 0x6d, 0x3b, 0xe7, 0x94, 0xab, 0x04,
-	0xb2, 0xee, 0xf9, 0xc1, 0xee, 0xba, 0xe7, 0x8f, 0x31, 0xc9, 0x3a, 0xc8, 0x9e, 0xdb, 0xe1, 0x26,
-	0x89, 0xb7, 0x66, 0x64, 0x81, 0xd4, 0x07, 0xcd, 0x71, 0xb0, 0xa5, 0xf3, 0x0b, 0x8e, 0x7f, 0x91,
-	0x5c, 0x5a, 0x7e, 0x6a, 0xeb, 0x46, 0x97, 0x6e, 0x17, 0x38, 0x75, 0x03, 0xc0, 0xc3, 0xe1, 0x63,
-	0x68, 0x64, 0x3e, 0xed, 0xce, 0xa9, 0x79, 0x0f, 0x07, 0x6f, 0xa1, 0x3b, 0x90, 0xd3, 0x74, 0xbd,
-	0x4d, 0xdb, 0xc3, 0x54, 0x3c, 0xfe, 0xb9, 0x95, 0x77, 0xe7, 0xd4, 0xac, 0xc6, 0x3d, 0x7d, 0x9f,
-	0x5c, 0xd2, 0xc4, 0x30, 0x8c, 0x81, 0x29, 0x1d, 0xd6, 0x8c, 0xc8, 0x66, 0xbb, 0x73, 0x2a, 0xe8,
-	0x91, 0x05, 0x37, 0x48, 0xbb, 0xe8, 0x9c, 0x33, 0x26, 0xe6, 0xcb, 0x52, 0xa4, 0x14, 0x33, 0xd8,
-	0xee, 0x9c, 0x9a, 0xeb, 0xf0, 0xf1, 0x56, 0x06, 0xd2, 0x47, 0xb6, 0x7e, 0xae, 0x7c, 0x0d, 0x8b,
-	0x8f, 0xb1, 0x2f, 0x1e, 0x70, 0x7a, 0x2b, 0xcb, 0xdd, 0x9e, 0x8a, 0xdc, 0xbe, 0x06, 0x19, 0xbb,
-	0xdb, 0x25, 0xf9, 0xca, 0x70, 0x23, 0xfe, 0x25, 0xf4, 0x79, 0x17, 0xda, 0x41, 0xf9, 0x88, 0xf5,
-	0x79, 0x17, 0x62, 0xfa, 0x2c, 0x9d, 0x4b, 0x95, 0x64, 0xe5, 0x1e, 0x2c, 0x7d, 0xa1, 0x99, 0x27,
-	0x17, 0xdb, 0xaf, 0x05, 0x4b, 0x8f, 0x4d, 0xfb, 0x48, 0x64, 0x9a, 0xb5, 0x8f, 0x29, 0x43, 0xd6,
-	0xd1, 0x7c, 0x1f, 0xbb, 0x41, 0x47, 0x15, 0x7c, 0x2a, 0xbf, 0x80, 0xa5, 0xba, 0xd1, 0xed, 0x8a,
-	0x42, 0x6f, 0x40, 0x8e, 0xd4, 0xb7, 0xb1, 0xda, 0x64, 0x2d, 0x7c, 0x46, 0xfd, 0x79, 0x03, 0x72,
-	0xb6, 0x19, 0x0b, 0x9a, 0x04, 0xa1, 0x6d, 0xb2, 0x78, 0x29, 0x43, 0xd6, 0x3b, 0xd6, 0x4c, 0xd3,
-	0x3e, 0xe3, 0x2d, 0x76, 0xf0, 0xa9, 0x98, 0x50, 0x8a, 0xb6, 0xf7, 0x1c, 0xdb, 0xf2, 0x30, 0x7a,
-	0x77, 0x68, 0xff, 0xd8, 0x1b, 0x84, 0x3d, 0x70, 0x02, 0x1d, 0xde, 0x1d, 0xd2, 0x61, 0x04, 0x31,
-	0xd7, 0x43, 0xb9, 0x0a, 0x85, 0x1d, 0xaf, 0x73, 0x12, 0x1c, 0xb4, 0x04, 0x72, 0xd7, 0xf8, 0x29,
-	0xdd, 0x23, 0xa7, 0x92, 0xa1, 0xf2, 0x00, 0x8a, 0x8c, 0x80, 0xab, 0x22, 0x50, 0xe4, 0x29, 0x45,
-	0xd4, 0x7d, 0xa6, 0x84, 0xee, 0x53, 0xf9, 0x00, 0x2e, 0xb1, 0x0b, 0x8d, 0x6c, 0x43, 0x9b, 0x08,
-	0x2e, 0x60, 0x1d, 0x0a, 0xf4, 0x41, 0x45, 0xb2, 0x31, 0x78, 0x11, 0xaa, 0xf4, 0x8d, 0x45, 0x5e,
-	0x80, 0xba, 0xf2, 0x10, 0x96, 0x79, 0x64, 0x0b, 0xad, 0xc7, 0xac, 0xf7, 0xe8, 0x57, 0xb0, 0xcc,
-	0x93, 0xf3, 0xe2, 0xcc, 0x49, 0xcd, 0x52, 0x49, 0xcd, 0x5e, 0xc0, 0x8a, 0x8a, 0xb9, 0x95, 0x05,
-	0xf1, 0x53, 0x0e, 0x84, 0xae, 0x42, 0xc1, 0xf7, 0xcd, 0xb6, 0x87, 0x3b, 0xb6, 0xa5, 0x7b, 0x54,
-	0xac, 0xac, 0x82, 0xef, 0x9b, 0x2d, 0x36, 0xa3, 0x7c, 0x09, 0x97, 0xb6, 0xed, 0xbe, 0x63, 0x7b,
-	0x38, 0x21, 0xf9, 0x1a, 0x14, 0x05, 0xc9, 0x0c, 0xc3, 0xcc, 0xab, 0x10, 0x8a, 0xf6, 0xa6, 0xcb,
-	0xfe, 0x39, 0xac, 0x6c, 0x1f, 0xe3, 0xce, 0x49, 0xcb, 0xb7, 0x5d, 0xad, 0x27, 0x64, 0xc9, 0x92,
-	0x8b, 0x35, 0xbd, 0xdd, 0x39, 0x1e, 0x58, 0x27, 0x6d, 0x5d, 0xf3, 0x35, 0xee, 0xf3, 0x05, 0x32,
-	0xbd, 0x4d, 0x66, 0xeb, 0x9a, 0xaf, 0x11, 0xf9, 0x8c, 0xe4, 0x08, 0x07, 0xd0, 0x54, 0x51, 0x05,
-	0x3a, 0xb5, 0x45, 0x66, 0x28, 0x80, 0x47, 0x09, 0x30, 0x07, 0x9f, 0x8b, 0x6a, 0x8e, 0x4e, 0x34,
-	0x2c, 0x5d, 0xa9, 0xc3, 0x6a, 0x7c, 0x73, 0x1e, 0x02, 0x77, 0x00, 0x31, 0x26, 0xfb, 0xe8, 0x1b,
-	0xdc, 0x21, 0x45, 0x79, 0xc0, 0xdf, 0x63, 0xb2, 0x5a, 0xa2, 0x2b, 0x07, 0x74, 0x61, 0x9b, 0xcc,
-	0x2b, 0xbf, 0x92, 0x60, 0xe9, 0xd9, 0xc0, 0xdf, 0xd6, 0x3a, 0xc7, 0x58, 0x88, 0xd3, 0x13, 0x7c,
-	0x1e, 0x44, 0xe1, 0x09, 0x3e, 0x47, 0xb7, 0x61, 0xfe, 0x94, 0xdc, 0x8f, 0x21, 0x7c, 0x96, 0xbc,
-	0x42, 0x6b, 0xd6, 0xb9, 0xca, 0x48, 0x86, 0xec, 0x2a, 0x0f, 0xd9, 0xb5, 0x04, 0xb2, 0xaf, 0xf5,
-	0x38, 0xf2, 0x48, 0x86, 0xca, 0xdb, 0xb0, 0xf4, 0x18, 0x4f, 0x51, 0x42, 0x79, 0x04, 0xa5, 0x88,
-	0x88, 0x1f, 0x36, 0x54, 0x4c, 0x9a, 0xaa, 0x98, 0xb2, 0x09, 0xcb, 0xac, 0x89, 0x14, 0xb7, 0xb9,
-	0x02, 0xe0, 0x6b, 0xbd, 0xb6, 0xe3, 0xe2, 0x28, 0xf1, 0xf2, 0xbe, 0xd6, 0x7b, 0x46, 0x27, 0x94,
-	0x4b, 0xb0, 0x52, 0xeb, 0xf8, 0xc6, 0xa9, 0xe6, 0xe3, 0xda, 0xc0, 0x0f, 0x9a, 0x18, 0xf2, 0x50,
-	0x88, 0x4f, 0x33, 0x75, 0x14, 0x1d, 0x90, 0x3a, 0xb0, 0xf6, 0x6c, 0x4d, 0x3f, 0xc4, 0x9e, 0x2f,
-	0xbc, 0xc6, 0x29, 0x04, 0xcb, 0x6f, 0x72, 0x32, 0x9e, 0xb9, 0xaf, 0x24, 0xbc, 0x18, 0x07, 0x7f,
-	0x3d, 0xd0, 0xb1, 0xf2, 0x67, 0x09, 0x56, 0x62, 0xdb, 0x70, 0x63, 0x7c, 0xcf, 0xfb, 0x44, 0xb5,
-	0x27, 0x2d, 0xbe, 0x7c, 0xef, 0x43, 0x2e, 0xf8, 0xfb, 0x8a, 0x36, 0xa2, 0x13, 0x51, 0xab, 0x90,
-	0x54, 0xb9, 0x01, 0x2b, 0x2c, 0xee, 0x78, 0xbc, 0x36, 0x7a, 0x2e, 0xf6, 0x68, 0x2c, 0x90, 0x4e,
-	0x8b, 0xbb, 0x79, 0xe0, 0x9a, 0xca, 0x7f, 0x52, 0xb0, 0xdc, 0xfa, 0x7c, 0x8f, 0x64, 0xc8, 0x91,
-	0xe6, 0x8d, 0xa5, 0x43, 0x0d, 0x5e, 0x19, 0xba, 0xb6, 0xdb, 0xd7, 0x7c, 0x7e, 0xbc, 0x77, 0x82,
-	0xe3, 0x0d, 0x49, 0xa0, 0xe5, 0x79, 0x87, 0xd2, 0xb2, 0x60, 0x64, 0x63, 0xf4, 0x21, 0x64, 0x3c,
-	0xdc, 0x71, 0xf9, 0x2d, 0x5d, 0xd8, 0xbc, 0x36, 0x5e, 0x42, 0x8b, 0xd2, 0xa9, 0x9c, 0xbe, 0xf2,
-	0x7b, 0x09, 0x20, 0x12, 0x8a, 0x3e, 0x11, 0x30, 0x97, 0xc5, 0xcd, 0x5b, 0xb3, 0x28, 0x52, 0xa5,
-	0xf8, 0x16, 0x65, 0x63, 0xd8, 0xbb, 0x39, 0xe8, 0x5b, 0xc1, 0x9f, 0x23, 0xc1, 0xa7, 0x72, 0x0f,
-	0xd2, 0x14, 0xfd, 0x2a, 0x40, 0xf6, 0xf9, 0xfe, 0x93, 0xfd, 0x83, 0x2f, 0xf6, 0x4b, 0x73, 0x28,
-	0x0b, 0xf2, 0x76, 0xeb, 0x45, 0x49, 0x42, 0x39, 0x48, 0x7f, 0xd6, 0x3a, 0xd8, 0x2f, 0xa5, 0xc8,
-	0xfa, 0xb3, 0x9a, 0xfa, 0xf9, 0xf3, 0xc6, 0x61, 0x49, 0xae, 0x54, 0x21, 0xc3, 0xd4, 0x1d, 0xf9,
-	0x0f, 0x20, 0x4f, 0xae, 0x54, 0x94, 0x5c, 0x7f, 0x95, 0x60, 0x81, 0xe9, 0x77, 0xd1, 0xc2, 0x5e,
-	0x87, 0x45, 0x5e, 0x69, 0x3c, 0xe6, 0x59, 0xee, 0x8a, 0xcb, 0xe1, 0x9b, 0x6e, 0xd8, 0xed, 0xbb,
-	0x73, 0xea, 0x82, 0x2d, 0x4e, 0xa3, 0x47, 0x50, 0xf4, 0x5e, 0x9a, 0xb4, 0x58, 0x12, 0x53, 0x85,
-	0x78, 0xe8, 0x38, 0x2b, 0xee, 0xce, 0xa9, 0x05, 0xef, 0xa5, 0x19, 0x4c, 0x92, 0x2e, 0xda, 0xd7,
-	0xdc, 0x1e, 0xf6, 0x95, 0x3f, 0xc8, 0xb0, 0x18, 0x9c, 0x84, 0x27, 0x46, 0x6b, 0x48, 0x45, 0x76,
-	0xa4, 0xdb, 0x81, 0xf8, 0x38, 0x7d, 0x5c, 0x63, 0x15, 0x7b, 0x03, 0xd3, 0x1f, 0xd6, 0xf8, 0x69,
-	0x42, 0x63, 0x76, 0xea, 0x9b, 0x63, 0x44, 0x0a, 0x07, 0x08, 0x05, 0x8a, 0x07, 0xa8, 0x7c, 0x9c,
-	0xc8, 0x0f, 0x46, 0x85, 0xde, 0x86, 0x05, 0x86, 0x7f, 0x9f, 0xb9, 0x86, 0xef, 0x63, 0x8b, 0x17,
-	0xf2, 0x22, 0x9d, 0xfc, 0x82, 0xcd, 0x55, 0xfe, 0x24, 0xc5, 0x52, 0x86, 0xb3, 0x7e, 0x0d, 0x45,
-	0xd7, 0x3e, 0x13, 0x39, 0xc9, 0xa3, 0xf4, 0xa3, 0x59, 0x15, 0xac, 0xaa, 0xf6, 0x59, 0xb0, 0x43,
-	0xc3, 0xf2, 0xdd, 0x73, 0xb5, 0xe0, 0x46, 0x33, 0x95, 0x47, 0x50, 0x4a, 0x12, 0x8c, 0xb8, 0x38,
-	0x56, 0xc5, 0x8b, 0x43, 0xe6, 0x95, 0xf8, 0xe3, 0xd4, 0x87, 0x12, 0x71, 0x98, 0x4b, 0xf7, 0xb9,
-	0xbd, 0x0f, 0x10, 0x3d, 0xfb, 0xd1, 0x1b, 0xb0, 0x72, 0xa0, 0x36, 0x1f, 0x37, 0xf7, 0xdb, 0x4f,
-	0x9a, 0xfb, 0xf5, 0x76, 0x14, 0xf1, 0x39, 0x48, 0x3f, 0x6f, 0x35, 0x54, 0x16, 0xf2, 0xb5, 0xe7,
-	0x87, 0x07, 0xa5, 0x14, 0x19, 0xed, 0xb4, 0xb6, 0x9f, 0x94, 0x64, 0x94, 0x87, 0xf9, 0xda, 0x5e,
-	0xb3, 0xd6, 0x2a, 0xa5, 0x6f, 0xbf, 0xcb, 0x20, 0x68, 0x9a, 0x33, 0x45, 0xc8, 0xa9, 0x8d, 0x56,
-	0x43, 0x7d, 0xd1, 0xa8, 0x33, 0x11, 0x3b, 0xcd, 0xbd, 0x46, 0x49, 0x22, 0xe9, 0x53, 0x6f, 0xaa,
-	0xa5, 0xd4, 0xed, 0xaf, 0xa1, 0x20, 0xc0, 0x16, 0xa8, 0x0c, 0xab, 0xdb, 0x07, 0x4f, 0x9f, 0x36,
-	0x0f, 0xdb, 0xad, 0xc3, 0xda, 0x61, 0x43, 0xd8, 0xbe, 0x00, 0xd9, 0xd6, 0x61, 0x4d, 0x3d, 0x6c,
-	0xd4, 0x4b, 0x12, 0xd9, 0x4d, 0x6d, 0xd4, 0xea, 0x3f, 0x29, 0xa5, 0xd0, 0x02, 0xe4, 0x77, 0x9a,
-	0xfb, 0xcd, 0xd6, 0x6e, 0x73, 0xff, 0x71, 0x49, 0x26, 0x1b, 0xb2, 0xcf, 0x46, 0xbd, 0x94, 0xbe,
-	0xfd, 0x10, 0xf2, 0x75, 0x6c, 0x1a, 0x7d, 0xc3, 0xc7, 0x2e, 0xd9, 0x7d, 0xff, 0x60, 0xbf, 0xc1,
-	0xf4, 0xa0, 0x39, 0x4b, 0x8f, 0xb2, 0xd7, 0xdc, 0x6f, 0x94, 0x52, 0x44, 0xa3, 0xd6, 0xe7, 0x7b,
-	0x25, 0x39, 0xc8, 0xec, 0xf4, 0xe6, 0x5f, 0xd6, 0x40, 0xae, 0x3d, 0x6b, 0xa2, 0x1a, 0x40, 0x04,
-	0x44, 0xa3, 0x30, 0x25, 0x86, 0xc0, 0xe9, 0xca, 0xda, 0x50, 0x1d, 0x6e, 0xf4, 0x1d, 0xff, 0x5c,
-	0x99, 0x43, 0x9f, 0x40, 0x41, 0x80, 0x96, 0x51, 0xf8, 0x9f, 0xc8, 0x30, 0xde, 0x5c, 0x29, 0x25,
-	0xff, 0x36, 0x56, 0xe6, 0xd0, 0x47, 0x90, 0x0b, 0x10, 0x66, 0xf4, 0x46, 0xb0, 0x9e, 0xc0, 0x9c,
-	0x47, 0x31, 0xde, 0x95, 0x88, 0xf2, 0x11, 0xea, 0x1c, 0x29, 0x3f, 0x84, 0x44, 0x4f, 0x50, 0xfe,
-	0x21, 0x14, 0x04, 0xa8, 0x39, 0x52, 0x7e, 0x18, 0x7f, 0xae, 0x24, 0x6a, 0x94, 0x32, 0x87, 0x1a,
-	0x50, 0x14, 0xe1, 0x61, 0x74, 0x39, 0xea, 0xd6, 0x87, 0x40, 0xe3, 0x09, 0x3a, 0x6c, 0x43, 0x41,
-	0x00, 0xa0, 0x22, 0x1d, 0x86, 0x51, 0xa9, 0x89, 0x42, 0x16, 0x62, 0xf8, 0x25, 0x7a, 0x2b, 0xe1,
-	0x87, 0xb8, 0xa0, 0x11, 0x7f, 0xb4, 0x28, 0x73, 0xe8, 0x53, 0x80, 0x08, 0xa3, 0x8c, 0x0c, 0x3a,
-	0x04, 0x06, 0x8f, 0x66, 0xbf, 0x2b, 0xa1, 0x26, 0x2c, 0x25, 0x50, 0x43, 0xb4, 0x1e, 0x9a, 0x74,
-	0x24, 0x9c, 0x38, 0x56, 0xd4, 0x13, 0x28, 0x25, 0x01, 0x59, 0x74, 0x75, 0xe4, 0x99, 0xa2, 0xbe,
-	0x7b, 0xac, 0xb0, 0x5d, 0x58, 0x88, 0x81, 0xaf, 0x91, 0x75, 0x46, 0x61, 0xb2, 0x95, 0x4b, 0x43,
-	0xd8, 0xa8, 0xa0, 0xd6, 0x52, 0x02, 0xae, 0x15, 0x4e, 0x38, 0x12, 0xc7, 0x9d, 0xe0, 0xb4, 0xc7,
-	0xb0, 0x10, 0xc3, 0x6b, 0x23, 0xb5, 0x46, 0xc1, 0xb8, 0x13, 0x04, 0x35, 0xa0, 0x28, 0x82, 0x90,
-	0x51, 0x24, 0x8e, 0x80, 0x26, 0x67, 0x0a, 0x22, 0x2e, 0x27, 0x19, 0x44, 0x71, 0x41, 0x28, 0xde,
-	0xef, 0xc5, 0x83, 0x88, 0x4b, 0x88, 0x05, 0xd1, 0x0c, 0xec, 0x77, 0x25, 0x72, 0x18, 0x11, 0xdc,
-	0x8b, 0x0e, 0x33, 0x02, 0xf2, 0x9b, 0x78, 0x18, 0x88, 0xc0, 0xa4, 0x48, 0x8f, 0x21, 0x80, 0x69,
-	0xbc, 0x88, 0x9b, 0x12, 0xda, 0x82, 0x2c, 0x7f, 0xd3, 0xa2, 0xb5, 0x40, 0x42, 0x1c, 0xbe, 0xa9,
-	0x4c, 0xc2, 0xfc, 0xf8, 0x79, 0x80, 0xb3, 0x1c, 0xd6, 0xd4, 0xd7, 0x17, 0x13, 0xd5, 0x59, 0xaa,
-	0x4e, 0xb2, 0xce, 0x8a, 0xb2, 0x86, 0x60, 0x83, 0xa8, 0xce, 0x52, 0xde, 0x58, 0x9d, 0x9d, 0xc2,
-	0x78, 0x57, 0x22, 0xac, 0x01, 0xc2, 0x13, 0xb1, 0x26, 0x30, 0x9f, 0xf1, 0xac, 0x01, 0xce, 0x13,
-	0xb1, 0x26, 0x90, 0x9f, 0x31, 0xac, 0x35, 0xc8, 0x05, 0x70, 0x4a, 0xc4, 0x9a, 0xc0, 0x77, 0x2a,
-	0xe5, 0xe1, 0x05, 0xfe, 0x5c, 0x62, 0xc9, 0x5a, 0x14, 0x9f, 0x52, 0x51, 0x24, 0x8d, 0x78, 0x77,
-	0x55, 0xde, 0x1a, 0xbd, 0x18, 0x88, 0x43, 0x9f, 0xd0, 0xfb, 0x16, 0xfb, 0xb8, 0x66, 0x9a, 0x68,
-	0x4c, 0xcc, 0x4c, 0x08, 0xc7, 0xfb, 0x90, 0xde, 0xf1, 0x3a, 0x27, 0x28, 0xfc, 0x47, 0x43, 0x40,
-	0x6f, 0x2a, 0xab, 0xf1, 0x49, 0xe1, 0x08, 0x4f, 0x61, 0x21, 0x86, 0xc6, 0x4c, 0x0a, 0xe4, 0x2b,
-	0xf1, 0xac, 0x4f, 0xe0, 0x37, 0x34, 0x9e, 0x77, 0xc3, 0x58, 0x8c, 0xc9, 0x1a, 0xc2, 0x6d, 0xa6,
-	0xca, 0x22, 0x97, 0x6f, 0x04, 0xd8, 0xa0, 0x24, 0x8e, 0x3d, 0x6b, 0xd5, 0x12, 0x61, 0x99, 0xc8,
-	0x3d, 0x23, 0xc0, 0x9a, 0x09, 0x62, 0x9e, 0xc1, 0x62, 0x1c, 0x85, 0x41, 0x57, 0x84, 0xfa, 0x3d,
-	0x8c, 0xce, 0x4c, 0x3f, 0xdb, 0x13, 0x28, 0x8a, 0xf0, 0x87, 0x50, 0x4e, 0x87, 0x11, 0x99, 0x28,
-	0x6e, 0x46, 0x21, 0x26, 0x34, 0x6e, 0x72, 0x01, 0x08, 0x12, 0xc5, 0x71, 0x02, 0x16, 0x99, 0x70,
-	0xba, 0x4f, 0x21, 0x17, 0x20, 0x13, 0x42, 0x06, 0xc5, 0x01, 0x8d, 0x28, 0x0d, 0x92, 0x20, 0x06,
-	0x73, 0x54, 0x04, 0x4d, 0x08, 0x2d, 0x5e, 0x12, 0xae, 0x98, 0xa0, 0xc3, 0x2e, 0x14, 0x04, 0x4c,
-	0x20, 0x2a, 0x3d, 0xc3, 0x78, 0x44, 0xe5, 0xf2, 0xc8, 0x35, 0xc1, 0xb2, 0x22, 0x88, 0x51, 0xc7,
-	0x5d, 0x8d, 0xbc, 0x26, 0xc6, 0x65, 0xd3, 0x14, 0x61, 0x0f, 0x59, 0x49, 0x3b, 0xd4, 0xbc, 0x13,
-	0x54, 0xae, 0xfa, 0x9a, 0x77, 0xa2, 0x39, 0x46, 0x35, 0x98, 0x0a, 0x34, 0x5a, 0x0e, 0x57, 0xc8,
-	0xac, 0x50, 0x99, 0x32, 0xfc, 0xf9, 0x7f, 0x29, 0xf9, 0x6a, 0x09, 0xcc, 0x31, 0xf2, 0x31, 0xa3,
-	0xcc, 0x6d, 0x7d, 0xf0, 0xdd, 0xab, 0x75, 0xe9, 0x6f, 0xaf, 0xd6, 0xa5, 0x7f, 0xbe, 0x5a, 0x97,
-	0xbe, 0xbc, 0xd5, 0x33, 0xfc, 0xe3, 0xc1, 0x51, 0xb5, 0x63, 0xf7, 0x37, 0x1c, 0xad, 0x73, 0x7c,
-	0xae, 0x63, 0x57, 0x1c, 0x9d, 0x6e, 0x6e, 0x78, 0x6e, 0x67, 0xc3, 0xe9, 0x7a, 0x47, 0x19, 0x7a,
-	0xbe, 0x7b, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xad, 0xbe, 0xb5, 0x29, 0x2c, 0x00, 0x00,
+func (m *RunLoadTestRequest) Reset()         { *m = RunLoadTestRequest{} }
+func (m *RunLoadTestRequest) String() string { return proto.CompactTextString(m) }
+func (*RunLoadTestRequest) ProtoMessage()    {}
+func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{76}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	// CreateRepo creates a new repo.
-	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error)
-	// DeleteRepo deletes a repo.
-	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// ClearCommit removes all data from the commit.
-	ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
-	// ListCommit returns info about all commits.
-	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error)
-	// SubscribeCommit subscribes for new commits on a given branch.
-	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
-	// InspectCommitSet returns the info about a CommitSet.
-	InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error)
-	// ListCommitSet returns info about all CommitSets.
-	ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error)
-	// SquashCommitSet squashes the commits of a CommitSet into their children.
-	SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
-	DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// CreateBranch creates a new branch.
-	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectBranch returns info about a branch.
-	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error)
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// ModifyFile performs modifications on a set of files.
-	ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error)
-	// GetFile returns the contents of a single file
-	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
-	// GetFileTAR returns a TAR stream of the contents matched by the request
-	GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error)
-	// InspectFile returns info about a file.
-	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
-	// ListFile returns info about all files.
-	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error)
-	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
-	// GlobFile returns info about all files.
-	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error)
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error)
-	// ActivateAuth creates a role binding for all existing repos
-	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
-	// DeleteAll deletes everything.
-	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
-	// Fsck does a file system consistency check for pfs.
-	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
-	// FileSet API
-	// CreateFileSet creates a new file set.
-	CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error)
-	// GetFileSet returns a file set with the data from a commit
-	GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error)
-	// AddFileSet associates a file set with a commit
-	AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// RenewFileSet prevents a file set from being deleted for a set amount of time.
-	RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// ComposeFileSet composes a file set from a list of file sets.
-	ComposeFileSet(ctx context.Context, in *ComposeFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error)
-	// CheckStorage runs integrity checks for the storage layer.
-	CheckStorage(ctx context.Context, in *CheckStorageRequest, opts ...grpc.CallOption) (*CheckStorageResponse, error)
-	PutCache(ctx context.Context, in *PutCacheRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error)
-	ClearCache(ctx context.Context, in *ClearCacheRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// RunLoadTest runs a load test.
-	RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
-	// RunLoadTestDefault runs the default load tests.
-	RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
-	// ListTask lists PFS tasks
-	ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error)
-	// Egress writes data from a commit to an external system
-	Egress(ctx context.Context, in *EgressRequest, opts ...grpc.CallOption) (*EgressResponse, error)
+func (m *RunLoadTestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *RunLoadTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunLoadTestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+func (m *RunLoadTestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunLoadTestRequest.Merge(m, src)
 }
-
-func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RunLoadTestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunLoadTestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunLoadTestRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
-	out := new(RepoInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RunLoadTestRequest proto.InternalMessageInfo
+
+func (m *RunLoadTestRequest) GetSpec() string {
+	if m != nil {
+		return m.Spec
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs_v2.API/ListRepo", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListRepoClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RunLoadTestRequest) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
 	}
-	return x, nil
+	return nil
 }
 
-type API_ListRepoClient interface {
-	Recv() (*RepoInfo, error)
-	grpc.ClientStream
+func (m *RunLoadTestRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
 }
 
-type aPIListRepoClient struct {
-	grpc.ClientStream
-}
-
-func (x *aPIListRepoClient) Recv() (*RepoInfo, error) {
-	m := new(RepoInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type RunLoadTestResponse struct {
+	Spec                 string          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Branch               *Branch         `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Seed                 int64           `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Error                string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Duration             *types.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) Reset()         { *m = RunLoadTestResponse{} }
+func (m *RunLoadTestResponse) String() string { return proto.CompactTextString(m) }
+func (*RunLoadTestResponse) ProtoMessage()    {}
+func (*RunLoadTestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{77}
+}
+func (m *RunLoadTestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunLoadTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunLoadTestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RunLoadTestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunLoadTestResponse.Merge(m, src)
+}
+func (m *RunLoadTestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunLoadTestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunLoadTestResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/StartCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RunLoadTestResponse proto.InternalMessageInfo
+
+func (m *RunLoadTestResponse) GetSpec() string {
+	if m != nil {
+		return m.Spec
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/FinishCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/ClearCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
-	out := new(CommitInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs_v2.API/ListCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetDuration() *types.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return x, nil
+	return nil
 }
 
-type API_ListCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+type ObjectStorageEgress struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIListCommitClient struct {
-	grpc.ClientStream
+func (m *ObjectStorageEgress) Reset()         { *m = ObjectStorageEgress{} }
+func (m *ObjectStorageEgress) String() string { return proto.CompactTextString(m) }
+func (*ObjectStorageEgress) ProtoMessage()    {}
+func (*ObjectStorageEgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{78}
 }
-
-func (x *aPIListCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ObjectStorageEgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectStorageEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectStorageEgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *ObjectStorageEgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectStorageEgress.Merge(m, src)
+}
+func (m *ObjectStorageEgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectStorageEgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectStorageEgress.DiscardUnknown(m)
 }
 
-func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs_v2.API/SubscribeCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPISubscribeCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ObjectStorageEgress proto.InternalMessageInfo
+
+func (m *ObjectStorageEgress) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	return x, nil
+	return ""
 }
 
-type API_SubscribeCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+type SQLDatabaseEgress struct {
+	Url                  string                        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	FileFormat           *SQLDatabaseEgress_FileFormat `protobuf:"bytes,2,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty"`
+	Secret               *SQLDatabaseEgress_Secret     `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-type aPISubscribeCommitClient struct {
-	grpc.ClientStream
+func (m *SQLDatabaseEgress) Reset()         { *m = SQLDatabaseEgress{} }
+func (m *SQLDatabaseEgress) String() string { return proto.CompactTextString(m) }
+func (*SQLDatabaseEgress) ProtoMessage()    {}
+func (*SQLDatabaseEgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{79}
 }
-
-func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SQLDatabaseEgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs_v2.API/InspectCommitSet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIInspectCommitSetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *SQLDatabaseEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLDatabaseEgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_InspectCommitSetClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *SQLDatabaseEgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLDatabaseEgress.Merge(m, src)
 }
-
-type aPIInspectCommitSetClient struct {
-	grpc.ClientStream
+func (m *SQLDatabaseEgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLDatabaseEgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLDatabaseEgress.DiscardUnknown(m)
 }
 
-func (x *aPIInspectCommitSetClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_SQLDatabaseEgress proto.InternalMessageInfo
+
+func (m *SQLDatabaseEgress) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs_v2.API/ListCommitSet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListCommitSetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *SQLDatabaseEgress) GetFileFormat() *SQLDatabaseEgress_FileFormat {
+	if m != nil {
+		return m.FileFormat
 	}
-	return x, nil
+	return nil
 }
 
-type API_ListCommitSetClient interface {
-	Recv() (*CommitSetInfo, error)
-	grpc.ClientStream
+func (m *SQLDatabaseEgress) GetSecret() *SQLDatabaseEgress_Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
 }
 
-type aPIListCommitSetClient struct {
-	grpc.ClientStream
+type SQLDatabaseEgress_FileFormat struct {
+	Type                 SQLDatabaseEgress_FileFormat_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pfs_v2.SQLDatabaseEgress_FileFormat_Type" json:"type,omitempty"`
+	Columns              []string                          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (x *aPIListCommitSetClient) Recv() (*CommitSetInfo, error) {
-	m := new(CommitSetInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SQLDatabaseEgress_FileFormat) Reset()         { *m = SQLDatabaseEgress_FileFormat{} }
+func (m *SQLDatabaseEgress_FileFormat) String() string { return proto.CompactTextString(m) }
+func (*SQLDatabaseEgress_FileFormat) ProtoMessage()    {}
+func (*SQLDatabaseEgress_FileFormat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{79, 0}
 }
-
-func (c *aPIClient) SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/SquashCommitSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SQLDatabaseEgress_FileFormat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DropCommitSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SQLDatabaseEgress_FileFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLDatabaseEgress_FileFormat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SQLDatabaseEgress_FileFormat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLDatabaseEgress_FileFormat.Merge(m, src)
+}
+func (m *SQLDatabaseEgress_FileFormat) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLDatabaseEgress_FileFormat) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLDatabaseEgress_FileFormat.DiscardUnknown(m)
 }
 
-func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
-	out := new(BranchInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SQLDatabaseEgress_FileFormat proto.InternalMessageInfo
+
+func (m *SQLDatabaseEgress_FileFormat) GetType() SQLDatabaseEgress_FileFormat_Type {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return SQLDatabaseEgress_FileFormat_UNKNOWN
 }
 
-func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs_v2.API/ListBranch", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListBranchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *SQLDatabaseEgress_FileFormat) GetColumns() []string {
+	if m != nil {
+		return m.Columns
 	}
-	return x, nil
+	return nil
 }
 
-type API_ListBranchClient interface {
-	Recv() (*BranchInfo, error)
-	grpc.ClientStream
+type SQLDatabaseEgress_Secret struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIListBranchClient struct {
-	grpc.ClientStream
+func (m *SQLDatabaseEgress_Secret) Reset()         { *m = SQLDatabaseEgress_Secret{} }
+func (m *SQLDatabaseEgress_Secret) String() string { return proto.CompactTextString(m) }
+func (*SQLDatabaseEgress_Secret) ProtoMessage()    {}
+func (*SQLDatabaseEgress_Secret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{79, 1}
 }
-
-func (x *aPIListBranchClient) Recv() (*BranchInfo, error) {
-	m := new(BranchInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SQLDatabaseEgress_Secret) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLDatabaseEgress_Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLDatabaseEgress_Secret.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *SQLDatabaseEgress_Secret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLDatabaseEgress_Secret.Merge(m, src)
+}
+func (m *SQLDatabaseEgress_Secret) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLDatabaseEgress_Secret) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLDatabaseEgress_Secret.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SQLDatabaseEgress_Secret proto.InternalMessageInfo
+
+func (m *SQLDatabaseEgress_Secret) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs_v2.API/ModifyFile", opts...)
-	if err != nil {
-		return nil, err
+func (m *SQLDatabaseEgress_Secret) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	x := &aPIModifyFileClient{stream}
-	return x, nil
+	return ""
 }
 
-type API_ModifyFileClient interface {
-	Send(*ModifyFileRequest) error
-	CloseAndRecv() (*types.Empty, error)
-	grpc.ClientStream
+type EgressRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//	*EgressRequest_ObjectStorage
+	//	*EgressRequest_SqlDatabase
+	Target               isEgressRequest_Target `protobuf_oneof:"target"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-type aPIModifyFileClient struct {
-	grpc.ClientStream
+func (m *EgressRequest) Reset()         { *m = EgressRequest{} }
+func (m *EgressRequest) String() string { return proto.CompactTextString(m) }
+func (*EgressRequest) ProtoMessage()    {}
+func (*EgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{80}
 }
-
-func (x *aPIModifyFileClient) Send(m *ModifyFileRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *EgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *aPIModifyFileClient) CloseAndRecv() (*types.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(types.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *EgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs_v2.API/GetFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *EgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressRequest.Merge(m, src)
+}
+func (m *EgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressRequest.DiscardUnknown(m)
 }
 
-type API_GetFileClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+var xxx_messageInfo_EgressRequest proto.InternalMessageInfo
+
+type isEgressRequest_Target interface {
+	isEgressRequest_Target()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type aPIGetFileClient struct {
-	grpc.ClientStream
+type EgressRequest_ObjectStorage struct {
+	ObjectStorage *ObjectStorageEgress `protobuf:"bytes,2,opt,name=object_storage,json=objectStorage,proto3,oneof" json:"object_storage,omitempty"`
+}
+type EgressRequest_SqlDatabase struct {
+	SqlDatabase *SQLDatabaseEgress `protobuf:"bytes,3,opt,name=sql_database,json=sqlDatabase,proto3,oneof" json:"sql_database,omitempty"`
 }
 
-func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (*EgressRequest_ObjectStorage) isEgressRequest_Target() {}
+func (*EgressRequest_SqlDatabase) isEgressRequest_Target()   {}
+
+func (m *EgressRequest) GetTarget() isEgressRequest_Target {
+	if m != nil {
+		return m.Target
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs_v2.API/GetFileTAR", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetFileTARClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *EgressRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return x, nil
-}
-
-type API_GetFileTARClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
-}
-
-type aPIGetFileTARClient struct {
-	grpc.ClientStream
+	return nil
 }
 
-func (x *aPIGetFileTARClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *EgressRequest) GetObjectStorage() *ObjectStorageEgress {
+	if x, ok := m.GetTarget().(*EgressRequest_ObjectStorage); ok {
+		return x.ObjectStorage
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
-	out := new(FileInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EgressRequest) GetSqlDatabase() *SQLDatabaseEgress {
+	if x, ok := m.GetTarget().(*EgressRequest_SqlDatabase); ok {
+		return x.SqlDatabase
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs_v2.API/ListFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EgressRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EgressRequest_ObjectStorage)(nil),
+		(*EgressRequest_SqlDatabase)(nil),
 	}
-	return x, nil
 }
 
-type API_ListFileClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+type EgressResponse struct {
+	// Types that are valid to be assigned to Result:
+	//	*EgressResponse_ObjectStorage
+	//	*EgressResponse_SqlDatabase
+	Result               isEgressResponse_Result `protobuf_oneof:"result"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-type aPIListFileClient struct {
-	grpc.ClientStream
+func (m *EgressResponse) Reset()         { *m = EgressResponse{} }
+func (m *EgressResponse) String() string { return proto.CompactTextString(m) }
+func (*EgressResponse) ProtoMessage()    {}
+func (*EgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{81}
 }
-
-func (x *aPIListFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *EgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs_v2.API/WalkFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIWalkFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *EgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
+}
+func (m *EgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressResponse.Merge(m, src)
+}
+func (m *EgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressResponse.DiscardUnknown(m)
 }
 
-type API_WalkFileClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+var xxx_messageInfo_EgressResponse proto.InternalMessageInfo
+
+type isEgressResponse_Result interface {
+	isEgressResponse_Result()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type aPIWalkFileClient struct {
-	grpc.ClientStream
+type EgressResponse_ObjectStorage struct {
+	ObjectStorage *EgressResponse_ObjectStorageResult `protobuf:"bytes,1,opt,name=object_storage,json=objectStorage,proto3,oneof" json:"object_storage,omitempty"`
+}
+type EgressResponse_SqlDatabase struct {
+	SqlDatabase *EgressResponse_SQLDatabaseResult `protobuf:"bytes,2,opt,name=sql_database,json=sqlDatabase,proto3,oneof" json:"sql_database,omitempty"`
 }
 
-func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (*EgressResponse_ObjectStorage) isEgressResponse_Result() {}
+func (*EgressResponse_SqlDatabase) isEgressResponse_Result()   {}
+
+func (m *EgressResponse) GetResult() isEgressResponse_Result {
+	if m != nil {
+		return m.Result
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs_v2.API/GlobFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGlobFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *EgressResponse) GetObjectStorage() *EgressResponse_ObjectStorageResult {
+	if x, ok := m.GetResult().(*EgressResponse_ObjectStorage); ok {
+		return x.ObjectStorage
 	}
-	return x, nil
+	return nil
 }
 
-type API_GlobFileClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+func (m *EgressResponse) GetSqlDatabase() *EgressResponse_SQLDatabaseResult {
+	if x, ok := m.GetResult().(*EgressResponse_SqlDatabase); ok {
+		return x.SqlDatabase
+	}
+	return nil
 }
 
-type aPIGlobFileClient struct {
-	grpc.ClientStream
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EgressResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EgressResponse_ObjectStorage)(nil),
+		(*EgressResponse_SqlDatabase)(nil),
+	}
 }
 
-func (x *aPIGlobFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type EgressResponse_ObjectStorageResult struct {
+	BytesWritten         int64    `protobuf:"varint,1,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs_v2.API/DiffFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIDiffFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *EgressResponse_ObjectStorageResult) Reset()         { *m = EgressResponse_ObjectStorageResult{} }
+func (m *EgressResponse_ObjectStorageResult) String() string { return proto.CompactTextString(m) }
+func (*EgressResponse_ObjectStorageResult) ProtoMessage()    {}
+func (*EgressResponse_ObjectStorageResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{81, 0}
+}
+func (m *EgressResponse_ObjectStorageResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressResponse_ObjectStorageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EgressResponse_ObjectStorageResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_DiffFileClient interface {
-	Recv() (*DiffFileResponse, error)
-	grpc.ClientStream
+func (m *EgressResponse_ObjectStorageResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressResponse_ObjectStorageResult.Merge(m, src)
 }
-
-type aPIDiffFileClient struct {
-	grpc.ClientStream
+func (m *EgressResponse_ObjectStorageResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressResponse_ObjectStorageResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressResponse_ObjectStorageResult.DiscardUnknown(m)
 }
 
-func (x *aPIDiffFileClient) Recv() (*DiffFileResponse, error) {
-	m := new(DiffFileResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_EgressResponse_ObjectStorageResult proto.InternalMessageInfo
+
+func (m *EgressResponse_ObjectStorageResult) GetBytesWritten() int64 {
+	if m != nil {
+		return m.BytesWritten
 	}
-	return m, nil
+	return 0
 }
 
-func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
-	out := new(ActivateAuthResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/ActivateAuth", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EgressResponse_SQLDatabaseResult struct {
+	RowsWritten          map[string]int64 `protobuf:"bytes,1,rep,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+func (m *EgressResponse_SQLDatabaseResult) Reset()         { *m = EgressResponse_SQLDatabaseResult{} }
+func (m *EgressResponse_SQLDatabaseResult) String() string { return proto.CompactTextString(m) }
+func (*EgressResponse_SQLDatabaseResult) ProtoMessage()    {}
+func (*EgressResponse_SQLDatabaseResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{81, 1}
+}
+func (m *EgressResponse_SQLDatabaseResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressResponse_SQLDatabaseResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EgressResponse_SQLDatabaseResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EgressResponse_SQLDatabaseResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressResponse_SQLDatabaseResult.Merge(m, src)
+}
+func (m *EgressResponse_SQLDatabaseResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressResponse_SQLDatabaseResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressResponse_SQLDatabaseResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressResponse_SQLDatabaseResult proto.InternalMessageInfo
+
+func (m *EgressResponse_SQLDatabaseResult) GetRowsWritten() map[string]int64 {
+	if m != nil {
+		return m.RowsWritten
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pfs_v2.OriginKind", OriginKind_name, OriginKind_value)
+	proto.RegisterEnum("pfs_v2.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs_v2.CommitState", CommitState_name, CommitState_value)
+	proto.RegisterEnum("pfs_v2.Delimiter", Delimiter_name, Delimiter_value)
+	proto.RegisterEnum("pfs_v2.ChunkingSpec_Algorithm", ChunkingSpec_Algorithm_name, ChunkingSpec_Algorithm_value)
+	proto.RegisterEnum("pfs_v2.SQLDatabaseEgress_FileFormat_Type", SQLDatabaseEgress_FileFormat_Type_name, SQLDatabaseEgress_FileFormat_Type_value)
+	proto.RegisterType((*Repo)(nil), "pfs_v2.Repo")
+	proto.RegisterType((*Branch)(nil), "pfs_v2.Branch")
+	proto.RegisterType((*File)(nil), "pfs_v2.File")
+	proto.RegisterType((*RepoInfo)(nil), "pfs_v2.RepoInfo")
+	proto.RegisterType((*RepoInfo_Details)(nil), "pfs_v2.RepoInfo.Details")
+	proto.RegisterType((*ChunkingSpec)(nil), "pfs_v2.ChunkingSpec")
+	proto.RegisterType((*CompactionSpec)(nil), "pfs_v2.CompactionSpec")
+	proto.RegisterType((*RepoAuthInfo)(nil), "pfs_v2.RepoAuthInfo")
+	proto.RegisterType((*BranchInfo)(nil), "pfs_v2.BranchInfo")
+	proto.RegisterType((*Trigger)(nil), "pfs_v2.Trigger")
+	proto.RegisterType((*CommitOrigin)(nil), "pfs_v2.CommitOrigin")
+	proto.RegisterType((*Commit)(nil), "pfs_v2.Commit")
+	proto.RegisterType((*CommitInfo)(nil), "pfs_v2.CommitInfo")
+	proto.RegisterType((*CommitInfo_Details)(nil), "pfs_v2.CommitInfo.Details")
+	proto.RegisterType((*CommitSet)(nil), "pfs_v2.CommitSet")
+	proto.RegisterType((*CommitSetInfo)(nil), "pfs_v2.CommitSetInfo")
+	proto.RegisterType((*FileInfo)(nil), "pfs_v2.FileInfo")
+	proto.RegisterType((*FileAttributes)(nil), "pfs_v2.FileAttributes")
+	proto.RegisterMapType((map[string]string)(nil), "pfs_v2.FileAttributes.XattrsEntry")
+	proto.RegisterType((*CreateRepoRequest)(nil), "pfs_v2.CreateRepoRequest")
+	proto.RegisterType((*InspectRepoRequest)(nil), "pfs_v2.InspectRepoRequest")
+	proto.RegisterType((*ListRepoRequest)(nil), "pfs_v2.ListRepoRequest")
+	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs_v2.DeleteRepoRequest")
+	proto.RegisterType((*StartCommitRequest)(nil), "pfs_v2.StartCommitRequest")
+	proto.RegisterType((*FinishCommitRequest)(nil), "pfs_v2.FinishCommitRequest")
+	proto.RegisterType((*InspectCommitRequest)(nil), "pfs_v2.InspectCommitRequest")
+	proto.RegisterType((*InspectCommitCompactionRequest)(nil), "pfs_v2.InspectCommitCompactionRequest")
+	proto.RegisterType((*CompactionLevel)(nil), "pfs_v2.CompactionLevel")
+	proto.RegisterType((*CommitCompactionInfo)(nil), "pfs_v2.CommitCompactionInfo")
+	proto.RegisterType((*ListCommitRequest)(nil), "pfs_v2.ListCommitRequest")
+	proto.RegisterType((*InspectCommitSetRequest)(nil), "pfs_v2.InspectCommitSetRequest")
+	proto.RegisterType((*ListCommitSetRequest)(nil), "pfs_v2.ListCommitSetRequest")
+	proto.RegisterType((*SquashCommitSetRequest)(nil), "pfs_v2.SquashCommitSetRequest")
+	proto.RegisterType((*DropCommitSetRequest)(nil), "pfs_v2.DropCommitSetRequest")
+	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs_v2.SubscribeCommitRequest")
+	proto.RegisterType((*ClearCommitRequest)(nil), "pfs_v2.ClearCommitRequest")
+	proto.RegisterType((*CreateBranchRequest)(nil), "pfs_v2.CreateBranchRequest")
+	proto.RegisterType((*InspectBranchRequest)(nil), "pfs_v2.InspectBranchRequest")
+	proto.RegisterType((*ListBranchRequest)(nil), "pfs_v2.ListBranchRequest")
+	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs_v2.DeleteBranchRequest")
+	proto.RegisterType((*AddFile)(nil), "pfs_v2.AddFile")
+	proto.RegisterType((*AddFile_URLSource)(nil), "pfs_v2.AddFile.URLSource")
+	proto.RegisterType((*DeleteFile)(nil), "pfs_v2.DeleteFile")
+	proto.RegisterType((*CopyFile)(nil), "pfs_v2.CopyFile")
+	proto.RegisterType((*ModifyFileRequest)(nil), "pfs_v2.ModifyFileRequest")
+	proto.RegisterType((*GetFileRequest)(nil), "pfs_v2.GetFileRequest")
+	proto.RegisterType((*InspectFileRequest)(nil), "pfs_v2.InspectFileRequest")
+	proto.RegisterType((*ListFileRequest)(nil), "pfs_v2.ListFileRequest")
+	proto.RegisterType((*WalkFileRequest)(nil), "pfs_v2.WalkFileRequest")
+	proto.RegisterType((*GlobFileRequest)(nil), "pfs_v2.GlobFileRequest")
+	proto.RegisterType((*DiffFileRequest)(nil), "pfs_v2.DiffFileRequest")
+	proto.RegisterType((*DiffFileResponse)(nil), "pfs_v2.DiffFileResponse")
+	proto.RegisterType((*FsckRequest)(nil), "pfs_v2.FsckRequest")
+	proto.RegisterType((*FsckResponse)(nil), "pfs_v2.FsckResponse")
+	proto.RegisterType((*CreateFileSetResponse)(nil), "pfs_v2.CreateFileSetResponse")
+	proto.RegisterType((*GetFileSetRequest)(nil), "pfs_v2.GetFileSetRequest")
+	proto.RegisterType((*AddFileSetRequest)(nil), "pfs_v2.AddFileSetRequest")
+	proto.RegisterType((*RenewFileSetRequest)(nil), "pfs_v2.RenewFileSetRequest")
+	proto.RegisterType((*ComposeFileSetRequest)(nil), "pfs_v2.ComposeFileSetRequest")
+	proto.RegisterType((*CheckStorageRequest)(nil), "pfs_v2.CheckStorageRequest")
+	proto.RegisterType((*CheckStorageResponse)(nil), "pfs_v2.CheckStorageResponse")
+	proto.RegisterType((*ScrubStorageRequest)(nil), "pfs_v2.ScrubStorageRequest")
+	proto.RegisterType((*ScrubStorageFinding)(nil), "pfs_v2.ScrubStorageFinding")
+	proto.RegisterType((*ScrubStorageFile)(nil), "pfs_v2.ScrubStorageFile")
+	proto.RegisterType((*ScrubStorageResponse)(nil), "pfs_v2.ScrubStorageResponse")
+	proto.RegisterType((*ReplicationStatusRequest)(nil), "pfs_v2.ReplicationStatusRequest")
+	proto.RegisterType((*ReplicaStatus)(nil), "pfs_v2.ReplicaStatus")
+	proto.RegisterType((*ReplicationStatusResponse)(nil), "pfs_v2.ReplicationStatusResponse")
+	proto.RegisterType((*GarbageCollectStorageRequest)(nil), "pfs_v2.GarbageCollectStorageRequest")
+	proto.RegisterType((*GarbageChunk)(nil), "pfs_v2.GarbageChunk")
+	proto.RegisterType((*GarbageCollectStorageResponse)(nil), "pfs_v2.GarbageCollectStorageResponse")
+	proto.RegisterType((*SetStorageGCPausedRequest)(nil), "pfs_v2.SetStorageGCPausedRequest")
+	proto.RegisterType((*RestoreStorageTrashRequest)(nil), "pfs_v2.RestoreStorageTrashRequest")
+	proto.RegisterType((*RestoreStorageTrashResponse)(nil), "pfs_v2.RestoreStorageTrashResponse")
+	proto.RegisterType((*PutCacheRequest)(nil), "pfs_v2.PutCacheRequest")
+	proto.RegisterType((*GetCacheRequest)(nil), "pfs_v2.GetCacheRequest")
+	proto.RegisterType((*GetCacheResponse)(nil), "pfs_v2.GetCacheResponse")
+	proto.RegisterType((*ClearCacheRequest)(nil), "pfs_v2.ClearCacheRequest")
+	proto.RegisterType((*ActivateAuthRequest)(nil), "pfs_v2.ActivateAuthRequest")
+	proto.RegisterType((*ActivateAuthResponse)(nil), "pfs_v2.ActivateAuthResponse")
+	proto.RegisterType((*RunLoadTestRequest)(nil), "pfs_v2.RunLoadTestRequest")
+	proto.RegisterType((*RunLoadTestResponse)(nil), "pfs_v2.RunLoadTestResponse")
+	proto.RegisterType((*ObjectStorageEgress)(nil), "pfs_v2.ObjectStorageEgress")
+	proto.RegisterType((*SQLDatabaseEgress)(nil), "pfs_v2.SQLDatabaseEgress")
+	proto.RegisterType((*SQLDatabaseEgress_FileFormat)(nil), "pfs_v2.SQLDatabaseEgress.FileFormat")
+	proto.RegisterType((*SQLDatabaseEgress_Secret)(nil), "pfs_v2.SQLDatabaseEgress.Secret")
+	proto.RegisterType((*EgressRequest)(nil), "pfs_v2.EgressRequest")
+	proto.RegisterType((*EgressResponse)(nil), "pfs_v2.EgressResponse")
+	proto.RegisterType((*EgressResponse_ObjectStorageResult)(nil), "pfs_v2.EgressResponse.ObjectStorageResult")
+	proto.RegisterType((*EgressResponse_SQLDatabaseResult)(nil), "pfs_v2.EgressResponse.SQLDatabaseResult")
+	proto.RegisterMapType((map[string]int64)(nil), "pfs_v2.EgressResponse.SQLDatabaseResult.RowsWrittenEntry")
+}
+
+func init() { proto.RegisterFile("pfs/pfs.proto", fileDescriptor_21a7b2476cbc6216) }
+
+var fileDescriptor_21a7b2476cbc6216 = []byte{
+	// 4578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3b, 0x4d, 0x6f, 0x1b, 0xd7,
+	0x76, 0x22, 0x87, 0xe2, 0xc7, 0x21, 0x25, 0x51, 0x57, 0xb2, 0x42, 0xd3, 0x8e, 0xed, 0x37, 0x49,
+	0x1c, 0xc7, 0xce, 0xa3, 0xfc, 0x64, 0xc7, 0xf9, 0xf0, 0x4b, 0x02, 0x4a, 0xa4, 0x2c, 0xc5, 0xb2,
+	0xe4, 0x0c, 0xa5, 0xe4, 0x35, 0x2f, 0x00, 0x3b, 0xe4, 0x5c, 0x52, 0x13, 0x0d, 0x67, 0x98, 0x99,
+	0xa1, 0x64, 0xb5, 0x68, 0x51, 0xa0, 0x40, 0x37, 0xed, 0xaa, 0x40, 0xd1, 0xa2, 0x9b, 0xbe, 0x5f,
+	0x50, 0xa0, 0xdd, 0xb4, 0xff, 0xa0, 0xed, 0xae, 0xdb, 0x6e, 0x8a, 0x57, 0x03, 0x2d, 0xba, 0x6e,
+	0xb7, 0x5d, 0x14, 0xf7, 0x6b, 0xe6, 0xce, 0x70, 0xf8, 0x21, 0xbf, 0xb7, 0x11, 0xe6, 0xde, 0x7b,
+	0xce, 0xb9, 0xe7, 0x9e, 0x7b, 0xbe, 0xee, 0x39, 0x14, 0x2c, 0x0d, 0x7b, 0xde, 0xe6, 0xb0, 0xe7,
+	0xd5, 0x86, 0xae, 0xe3, 0x3b, 0x28, 0x3b, 0xec, 0x79, 0xed, 0xf3, 0xad, 0xea, 0x8d, 0xbe, 0xe3,
+	0xf4, 0x2d, 0xbc, 0x49, 0x67, 0x3b, 0xa3, 0xde, 0x26, 0x1e, 0x0c, 0xfd, 0x4b, 0x06, 0x54, 0xbd,
+	0x1d, 0x5f, 0xf4, 0xcd, 0x01, 0xf6, 0x7c, 0x7d, 0x30, 0xe4, 0x00, 0xb7, 0xe2, 0x00, 0x17, 0xae,
+	0x3e, 0x1c, 0x62, 0xd7, 0x9b, 0xb4, 0x6e, 0x8c, 0x5c, 0xdd, 0x37, 0x1d, 0x9b, 0xaf, 0x5f, 0x8f,
+	0xaf, 0xeb, 0xb6, 0xd8, 0x7b, 0xbd, 0xef, 0xf4, 0x1d, 0xfa, 0xb9, 0x49, 0xbe, 0xf8, 0xec, 0x8a,
+	0x3e, 0xf2, 0x4f, 0x37, 0xc9, 0x1f, 0x31, 0xe1, 0xeb, 0xde, 0xd9, 0x26, 0xf9, 0xc3, 0x26, 0xd4,
+	0xc7, 0x90, 0xd1, 0xf0, 0xd0, 0x41, 0x08, 0x32, 0xb6, 0x3e, 0xc0, 0x95, 0xd4, 0x9d, 0xd4, 0xbd,
+	0x82, 0x46, 0xbf, 0xc9, 0x9c, 0x7f, 0x39, 0xc4, 0x95, 0x34, 0x9b, 0x23, 0xdf, 0x9f, 0x65, 0xfe,
+	0xea, 0x57, 0xb7, 0x17, 0xd4, 0x06, 0x64, 0xb7, 0x5d, 0xdd, 0xee, 0x9e, 0xa2, 0x3b, 0x90, 0x71,
+	0xf1, 0xd0, 0xa1, 0x78, 0xc5, 0xad, 0x52, 0x8d, 0xc9, 0xa9, 0x46, 0x68, 0x6a, 0x74, 0x25, 0xa0,
+	0x9c, 0x0e, 0x29, 0x73, 0x2a, 0xbf, 0x80, 0xcc, 0xae, 0x69, 0x61, 0x74, 0x17, 0xb2, 0x5d, 0x67,
+	0x30, 0x30, 0x7d, 0x4e, 0x65, 0x59, 0x50, 0xd9, 0xa1, 0xb3, 0x1a, 0x5f, 0x25, 0x94, 0x86, 0xba,
+	0x7f, 0x2a, 0x28, 0x91, 0x6f, 0xb4, 0x0e, 0x8b, 0x86, 0xee, 0x8f, 0x06, 0x15, 0x85, 0x4e, 0xb2,
+	0x81, 0xfa, 0x6b, 0x05, 0xf2, 0x84, 0x85, 0x7d, 0xbb, 0xe7, 0xcc, 0xc1, 0xe2, 0x63, 0xc8, 0x75,
+	0x5d, 0xac, 0xfb, 0xd8, 0xa0, 0xb4, 0x8b, 0x5b, 0xd5, 0x1a, 0x93, 0x74, 0x4d, 0x48, 0xba, 0x76,
+	0x2c, 0xae, 0x52, 0x13, 0xa0, 0xe8, 0x11, 0x6c, 0x78, 0xe6, 0xef, 0xe1, 0x76, 0xe7, 0xd2, 0xc7,
+	0x5e, 0x7b, 0x44, 0x2e, 0xb2, 0xdd, 0x71, 0x46, 0xb6, 0x41, 0x79, 0x51, 0xb4, 0x35, 0xb2, 0xba,
+	0x4d, 0x16, 0x4f, 0xc8, 0xda, 0x36, 0x59, 0x42, 0x77, 0xa0, 0x68, 0x60, 0xaf, 0xeb, 0x9a, 0x43,
+	0x72, 0xaf, 0x95, 0x0c, 0xe5, 0x5a, 0x9e, 0x42, 0xf7, 0x21, 0xdf, 0xa1, 0xb2, 0xc5, 0x5e, 0x65,
+	0xf1, 0x8e, 0x22, 0xcb, 0x83, 0xc9, 0x5c, 0x0b, 0xd6, 0xd1, 0xcf, 0xa0, 0x40, 0x2e, 0xb7, 0x6d,
+	0xda, 0x3d, 0xa7, 0x92, 0xa5, 0xac, 0xaf, 0xcb, 0xe7, 0xab, 0x8f, 0xfc, 0x53, 0x22, 0x03, 0x2d,
+	0xaf, 0xf3, 0x2f, 0xb4, 0x05, 0x39, 0x03, 0xfb, 0xba, 0x69, 0x79, 0x95, 0x1c, 0x45, 0xa8, 0xc8,
+	0x08, 0x04, 0xa4, 0xd6, 0x60, 0xeb, 0x9a, 0x00, 0x44, 0x0f, 0x21, 0xdf, 0x3d, 0x1d, 0xd9, 0x67,
+	0xa6, 0xdd, 0xaf, 0xe4, 0xa3, 0xbb, 0xec, 0xf0, 0xf9, 0xd6, 0x10, 0x77, 0xb5, 0x00, 0x0a, 0x3d,
+	0x01, 0xe8, 0x3a, 0x83, 0xa1, 0xde, 0xa5, 0xa7, 0x2c, 0x50, 0x9c, 0x0d, 0xe9, 0x5a, 0xf9, 0x0a,
+	0xc5, 0x92, 0x20, 0xab, 0xf7, 0x20, 0xc7, 0x77, 0x47, 0x6f, 0x03, 0x84, 0xe2, 0xa5, 0x97, 0xa7,
+	0x68, 0x85, 0x40, 0xa4, 0xea, 0xff, 0xa5, 0xa0, 0x24, 0x6f, 0x8e, 0x7e, 0x0e, 0x05, 0xdd, 0xea,
+	0x3b, 0xae, 0xe9, 0x9f, 0x0e, 0x28, 0xf8, 0xf2, 0xd6, 0xad, 0x24, 0x2e, 0x6b, 0x75, 0x01, 0xa5,
+	0x85, 0x08, 0xe8, 0x43, 0x40, 0xfa, 0x39, 0x76, 0xf5, 0x3e, 0x6e, 0x4b, 0xbb, 0xa6, 0xe9, 0xae,
+	0x65, 0xbe, 0xd2, 0x12, 0x9b, 0xa3, 0x77, 0x61, 0x79, 0x60, 0xda, 0x32, 0x24, 0xbb, 0xf2, 0xd2,
+	0xc0, 0xb4, 0xa3, 0x50, 0xfa, 0x2b, 0x19, 0x2a, 0xc3, 0xa1, 0xf4, 0x57, 0x01, 0x94, 0xfa, 0x18,
+	0x0a, 0x01, 0x47, 0xa8, 0x08, 0xb9, 0x46, 0x73, 0xb7, 0x7e, 0x72, 0x70, 0x5c, 0x5e, 0x40, 0x4b,
+	0x50, 0xd8, 0x3e, 0xf9, 0x6e, 0xaf, 0xde, 0xda, 0x7b, 0xf2, 0xb8, 0x9c, 0x22, 0x6b, 0xbb, 0xf5,
+	0xd6, 0xf1, 0x4e, 0x63, 0xa7, 0x9c, 0x56, 0x47, 0xb0, 0x1c, 0x15, 0x23, 0xfa, 0x09, 0x94, 0x2c,
+	0x7c, 0x8e, 0xad, 0x76, 0x4f, 0xef, 0xfa, 0x8e, 0xcb, 0x25, 0x56, 0xa4, 0x73, 0xbb, 0x74, 0x0a,
+	0xdd, 0x83, 0xb2, 0x81, 0x7b, 0xd8, 0x1d, 0x3f, 0xe2, 0x32, 0x9d, 0x0f, 0x59, 0xdf, 0x80, 0xec,
+	0x85, 0x69, 0x1b, 0xce, 0x05, 0xb7, 0x2b, 0x3e, 0x52, 0x7f, 0x09, 0x25, 0x59, 0xaf, 0xd0, 0x47,
+	0x50, 0x1c, 0x62, 0x77, 0x60, 0x7a, 0x9e, 0xe9, 0xd8, 0xe4, 0x96, 0x94, 0x7b, 0xcb, 0x5b, 0x6b,
+	0x35, 0xaa, 0x94, 0xe7, 0x5b, 0xb5, 0x97, 0xc1, 0x9a, 0x26, 0xc3, 0x11, 0xab, 0x75, 0x1d, 0x8b,
+	0xee, 0xae, 0x10, 0xab, 0xa5, 0x03, 0xf5, 0x57, 0x69, 0x00, 0xa6, 0xe2, 0x94, 0xf6, 0x5d, 0xc8,
+	0x32, 0x45, 0x8f, 0xbb, 0x05, 0x6e, 0x06, 0x7c, 0x15, 0xa9, 0x90, 0x39, 0xc5, 0xba, 0x30, 0xdd,
+	0xb8, 0xf3, 0xa0, 0x6b, 0xa8, 0x06, 0x30, 0x74, 0x9d, 0x73, 0x6c, 0xeb, 0x76, 0x17, 0x57, 0x94,
+	0x44, 0xb3, 0x92, 0x20, 0x08, 0xbc, 0x37, 0xea, 0x08, 0xf8, 0x4c, 0x32, 0x7c, 0x08, 0x81, 0x9e,
+	0xc2, 0xaa, 0x61, 0xba, 0xb8, 0xeb, 0xb7, 0xa5, 0x6d, 0x92, 0xad, 0xb7, 0xcc, 0x00, 0x5f, 0x86,
+	0x9b, 0x7d, 0x00, 0x39, 0xdf, 0x35, 0xfb, 0x7d, 0xec, 0x72, 0x1b, 0x5e, 0x11, 0x28, 0xc7, 0x6c,
+	0x5a, 0x13, 0xeb, 0xea, 0x1f, 0x42, 0x8e, 0xcf, 0x91, 0x2b, 0x92, 0xc4, 0x53, 0x08, 0xc4, 0x51,
+	0x06, 0x45, 0xb7, 0x2c, 0x2a, 0x8d, 0xbc, 0x46, 0x3e, 0xd1, 0x0d, 0x28, 0x74, 0x5d, 0xc7, 0x6e,
+	0x7b, 0x43, 0xdc, 0xe5, 0xf7, 0x99, 0xef, 0xba, 0x5c, 0x6d, 0x10, 0x64, 0x88, 0x36, 0x70, 0x4f,
+	0x44, 0xbf, 0x51, 0x05, 0x72, 0xcc, 0xe5, 0x12, 0x0f, 0x44, 0xd4, 0x43, 0x0c, 0xd5, 0x27, 0x50,
+	0x62, 0x72, 0x3d, 0x72, 0xcd, 0xbe, 0x69, 0xa3, 0xbb, 0x90, 0x39, 0x33, 0x6d, 0x83, 0xdb, 0x1b,
+	0x12, 0x7c, 0xb3, 0xd5, 0xe7, 0xa6, 0x6d, 0x68, 0x74, 0x5d, 0x3d, 0x84, 0x2c, 0xc3, 0x9b, 0xfb,
+	0x56, 0x37, 0x20, 0x6d, 0xb2, 0x3b, 0x2d, 0x6c, 0x67, 0x5f, 0xff, 0xfb, 0xed, 0xf4, 0x7e, 0x43,
+	0x4b, 0x9b, 0x06, 0x0f, 0x1d, 0xff, 0x98, 0x05, 0x60, 0x04, 0x85, 0xaa, 0xcc, 0x15, 0x41, 0x3e,
+	0x84, 0xac, 0x43, 0x59, 0xab, 0xa4, 0x63, 0x6e, 0x4c, 0x3a, 0x94, 0xc6, 0x61, 0xe2, 0xbe, 0x5a,
+	0x19, 0xf7, 0xd5, 0x8f, 0x60, 0x69, 0xa8, 0xbb, 0xd8, 0xf6, 0xdb, 0x7c, 0xfb, 0x4c, 0xe2, 0xf6,
+	0x25, 0x06, 0xc4, 0x46, 0x04, 0xa9, 0x7b, 0x6a, 0x5a, 0x46, 0x3b, 0x94, 0xb1, 0x92, 0x84, 0x44,
+	0x81, 0xd8, 0xc0, 0x23, 0x21, 0xca, 0xf3, 0x75, 0x97, 0x84, 0xa8, 0xec, 0xec, 0x10, 0xc5, 0x41,
+	0xd1, 0x27, 0x50, 0xe8, 0x99, 0xb6, 0xe9, 0x9d, 0x12, 0xcf, 0x9d, 0x9b, 0x89, 0x17, 0x02, 0xa3,
+	0x27, 0x90, 0x67, 0x03, 0x6c, 0x54, 0xf2, 0x33, 0x11, 0x03, 0xd8, 0x64, 0x43, 0x28, 0xcc, 0x69,
+	0x08, 0xeb, 0xb0, 0x88, 0x5d, 0xd7, 0x71, 0x2b, 0xc0, 0x82, 0x39, 0x1d, 0x4c, 0x89, 0xb3, 0xc5,
+	0xc9, 0x71, 0xf6, 0x71, 0x18, 0xe6, 0x4a, 0x9c, 0xfd, 0x88, 0x78, 0x13, 0x03, 0x5d, 0xf5, 0x3f,
+	0x52, 0xf3, 0xc6, 0x1f, 0xb4, 0x0d, 0x2b, 0x22, 0x6e, 0xd9, 0xfd, 0x36, 0xc9, 0xf4, 0xb8, 0x4e,
+	0x5d, 0x1f, 0x93, 0x53, 0x83, 0x67, 0x71, 0xda, 0x72, 0x88, 0x41, 0x64, 0x47, 0x68, 0x9c, 0xeb,
+	0x96, 0x69, 0xe8, 0x21, 0x0d, 0x65, 0x26, 0x8d, 0x10, 0x83, 0xd2, 0xd8, 0x84, 0xb5, 0x30, 0x7e,
+	0xb6, 0xa9, 0x1b, 0x77, 0xb1, 0x41, 0x15, 0x31, 0xaf, 0xa1, 0x70, 0xa9, 0xc1, 0x57, 0xd4, 0x77,
+	0xa0, 0xc0, 0x44, 0xd0, 0xc2, 0x3e, 0xb7, 0xb2, 0x54, 0xdc, 0xca, 0x54, 0x07, 0x96, 0x02, 0x20,
+	0x6a, 0x61, 0x0f, 0x69, 0x40, 0x1f, 0x98, 0x7e, 0xdb, 0xc3, 0xc2, 0xca, 0x56, 0xa3, 0x22, 0x6d,
+	0x61, 0x5f, 0x2b, 0x74, 0x03, 0xd2, 0x1f, 0x86, 0x4e, 0x24, 0x4d, 0xef, 0x1f, 0x8d, 0xdf, 0x40,
+	0xe8, 0x58, 0xfe, 0x28, 0x0d, 0x79, 0x92, 0x0c, 0x8a, 0x8c, 0xad, 0x67, 0x5a, 0x38, 0x9e, 0xb1,
+	0x91, 0x75, 0x8d, 0xae, 0xa0, 0x9f, 0x12, 0xc5, 0xb6, 0x70, 0x3b, 0xc8, 0x4f, 0x97, 0xb7, 0xca,
+	0x32, 0xd8, 0xf1, 0xe5, 0x10, 0x13, 0xad, 0x64, 0x5f, 0xc4, 0x0e, 0xd8, 0x46, 0xc4, 0x7e, 0x94,
+	0xd9, 0x76, 0x10, 0x00, 0xc7, 0xb4, 0x20, 0x13, 0xd7, 0x02, 0x04, 0x99, 0x53, 0xdd, 0x3b, 0xa5,
+	0x6e, 0xb2, 0xa4, 0xd1, 0x6f, 0x92, 0xfb, 0xe8, 0xbe, 0xef, 0x9a, 0x9d, 0x11, 0x41, 0xc9, 0x46,
+	0x73, 0x1f, 0xc2, 0x5c, 0x3d, 0x58, 0xd5, 0x24, 0x48, 0xf5, 0x2f, 0xd3, 0xb0, 0x1c, 0x5d, 0x26,
+	0xe4, 0x07, 0x8e, 0xc1, 0x04, 0xb1, 0xa4, 0xd1, 0x6f, 0xf4, 0x10, 0x16, 0x07, 0x92, 0xba, 0x4d,
+	0x3b, 0x07, 0x03, 0x44, 0xef, 0xc1, 0xb2, 0x77, 0x39, 0xb0, 0x4c, 0xfb, 0xac, 0xed, 0xeb, 0x6e,
+	0x1f, 0xfb, 0xdc, 0x95, 0x2d, 0xf1, 0xd9, 0x63, 0x3a, 0x49, 0x12, 0x88, 0xae, 0x63, 0xfb, 0xc4,
+	0x9b, 0x51, 0xb1, 0xf2, 0xdc, 0x94, 0xcf, 0x51, 0x39, 0x7e, 0x06, 0xd9, 0x57, 0x84, 0x63, 0xe1,
+	0xb3, 0xd4, 0xe4, 0x63, 0xd5, 0x7e, 0x41, 0x81, 0x9a, 0xb6, 0xef, 0x5e, 0x6a, 0x1c, 0xa3, 0xfa,
+	0x29, 0x14, 0xa5, 0x69, 0x12, 0xa6, 0xce, 0xf0, 0x25, 0x8f, 0x5d, 0xe4, 0x93, 0x58, 0xff, 0xb9,
+	0x6e, 0x8d, 0xc4, 0x4b, 0x81, 0x0d, 0x3e, 0x4b, 0x7f, 0x92, 0x52, 0xff, 0x2d, 0x05, 0xab, 0x3b,
+	0x34, 0xeb, 0xa6, 0x49, 0x3b, 0xfe, 0x71, 0x84, 0x3d, 0x7f, 0x8e, 0xbc, 0x3e, 0xe6, 0xc0, 0xd3,
+	0xe3, 0x0e, 0x7c, 0x03, 0xb2, 0xa3, 0xa1, 0xa1, 0xfb, 0xcc, 0xf0, 0xf2, 0x1a, 0x1f, 0x45, 0x32,
+	0xde, 0xcc, 0x1b, 0x64, 0xbc, 0x8b, 0xf3, 0x66, 0xbc, 0xea, 0x13, 0x40, 0xfb, 0x36, 0x89, 0xcc,
+	0xfe, 0x95, 0xce, 0xa6, 0xbe, 0x07, 0x2b, 0x07, 0xa6, 0x17, 0x41, 0x12, 0xef, 0xb5, 0x54, 0xf8,
+	0x5e, 0x53, 0x9f, 0xc3, 0x6a, 0x03, 0x5b, 0xf8, 0xaa, 0x92, 0x5b, 0x87, 0xc5, 0x9e, 0xe3, 0x76,
+	0x31, 0x4f, 0x23, 0xd8, 0x40, 0xfd, 0x93, 0x14, 0xa0, 0x16, 0x09, 0x2d, 0x3c, 0x44, 0x71, 0x72,
+	0x77, 0x21, 0xcb, 0x02, 0xdc, 0xa4, 0xe8, 0xcb, 0x56, 0xe7, 0xb8, 0x8e, 0x30, 0x39, 0x50, 0xa6,
+	0x25, 0x07, 0xea, 0x9f, 0xa6, 0x60, 0x6d, 0x97, 0x86, 0x9c, 0x31, 0x4e, 0xe6, 0xca, 0x03, 0x66,
+	0x73, 0x12, 0x84, 0x22, 0x45, 0x0e, 0x45, 0x81, 0x58, 0x32, 0xb2, 0x58, 0xfa, 0xb0, 0xce, 0xaf,
+	0xf0, 0xcd, 0xb8, 0x79, 0x1f, 0x32, 0x17, 0xba, 0xe9, 0x73, 0x3f, 0xb6, 0x16, 0xf3, 0xaa, 0x3e,
+	0x51, 0x7b, 0x0a, 0xa0, 0xee, 0xc1, 0xad, 0xc8, 0x46, 0xa1, 0x5a, 0x5d, 0x71, 0x4b, 0xd5, 0x86,
+	0x95, 0x10, 0xf9, 0x80, 0x3c, 0x11, 0xd0, 0x2d, 0x28, 0x52, 0x97, 0xea, 0x61, 0xbf, 0x2d, 0x62,
+	0x82, 0x46, 0xbd, 0x2c, 0x89, 0x01, 0x71, 0x4f, 0x98, 0x8e, 0x7b, 0xc2, 0x9b, 0xd4, 0xc5, 0x12,
+	0x8a, 0xdc, 0xc5, 0xe6, 0xb5, 0x70, 0x42, 0xfd, 0x87, 0x14, 0xac, 0xc7, 0x79, 0xbe, 0x52, 0xe6,
+	0x76, 0x1f, 0x32, 0x34, 0x7d, 0x4d, 0x4f, 0x35, 0x2c, 0x0a, 0x83, 0x36, 0x21, 0x4b, 0x5f, 0x3d,
+	0x1e, 0x4f, 0xf4, 0xdf, 0x1a, 0x87, 0xa6, 0x47, 0xd6, 0x38, 0x18, 0xaa, 0x42, 0x3e, 0x16, 0x38,
+	0x83, 0xb1, 0xfa, 0x3f, 0x29, 0x58, 0x25, 0x86, 0x16, 0xbd, 0xda, 0xd9, 0x16, 0xa4, 0x42, 0xa6,
+	0xe7, 0x3a, 0x83, 0x49, 0xaf, 0x12, 0xb2, 0x86, 0x6e, 0x41, 0xda, 0x77, 0x2a, 0x4a, 0x22, 0x44,
+	0xda, 0x77, 0x88, 0x77, 0xb2, 0x47, 0x83, 0x0e, 0x76, 0x79, 0xe0, 0xe1, 0x23, 0x92, 0x9f, 0xbb,
+	0xf8, 0x1c, 0xbb, 0x1e, 0xa6, 0x8e, 0x26, 0xaf, 0x89, 0xa1, 0x48, 0xfe, 0xb3, 0x61, 0xf2, 0xff,
+	0x08, 0x8a, 0x2c, 0x9d, 0x6d, 0xd3, 0x44, 0x3d, 0x37, 0x31, 0x51, 0x07, 0x27, 0xf8, 0x56, 0xdb,
+	0xf0, 0x56, 0x44, 0xd1, 0x5a, 0x38, 0x38, 0xf9, 0xd5, 0x13, 0x01, 0x24, 0xa9, 0x77, 0x9e, 0x6b,
+	0xf2, 0x06, 0xac, 0x87, 0x42, 0x0d, 0xa9, 0xab, 0x5f, 0xc1, 0x46, 0xeb, 0xc7, 0x91, 0xee, 0x9d,
+	0xc6, 0x57, 0xae, 0xbe, 0xaf, 0xba, 0x07, 0xeb, 0x0d, 0xd7, 0x19, 0xfe, 0x16, 0x28, 0xfd, 0x77,
+	0x0a, 0x36, 0x5a, 0xa3, 0x0e, 0xf1, 0x0e, 0x1d, 0x7c, 0x55, 0x45, 0x08, 0xdf, 0x69, 0xe9, 0xc8,
+	0x3b, 0x4d, 0x28, 0x88, 0x32, 0x45, 0x41, 0x3e, 0x80, 0x45, 0x8f, 0xd8, 0x7f, 0x25, 0x33, 0xd9,
+	0x35, 0x30, 0x08, 0x71, 0xf3, 0x8b, 0x13, 0x6f, 0x3e, 0x3b, 0xd7, 0xcd, 0xff, 0x1c, 0xd0, 0x8e,
+	0x85, 0x75, 0xf7, 0x8d, 0x3c, 0x99, 0xfa, 0x3a, 0x05, 0x6b, 0x2c, 0x50, 0x73, 0x87, 0xcd, 0xf1,
+	0xc5, 0x13, 0x3d, 0x35, 0xe5, 0x89, 0x7e, 0x37, 0x22, 0xa7, 0xc9, 0x0f, 0xc3, 0xab, 0x3e, 0xe5,
+	0xa5, 0xd7, 0x75, 0x66, 0xfa, 0xeb, 0x9a, 0x14, 0x6c, 0x6c, 0x7c, 0xd1, 0x96, 0xb4, 0x83, 0x89,
+	0xb3, 0x64, 0xe3, 0x8b, 0x40, 0x31, 0xd4, 0x2f, 0x02, 0x77, 0x1f, 0x3d, 0xe4, 0x9c, 0x2f, 0x5b,
+	0xf5, 0x88, 0x39, 0x94, 0x28, 0xf2, 0x6c, 0x3d, 0x92, 0x8c, 0x3e, 0x1d, 0x31, 0x7a, 0xb5, 0x05,
+	0x6b, 0x2c, 0xc6, 0xbf, 0x11, 0x3f, 0x13, 0x62, 0xfd, 0x9f, 0xa7, 0x21, 0x57, 0x37, 0x0c, 0x5a,
+	0xa0, 0x15, 0x85, 0xd7, 0x54, 0x52, 0xe1, 0x35, 0x2d, 0x15, 0x5e, 0xd1, 0x26, 0x28, 0xae, 0x7e,
+	0xc1, 0x75, 0xfa, 0xc6, 0x58, 0x6a, 0x4a, 0x43, 0xc5, 0x37, 0x24, 0xaf, 0xdb, 0x5b, 0xd0, 0x08,
+	0x24, 0xfa, 0x29, 0x28, 0x23, 0xd7, 0xe2, 0x37, 0x73, 0x5d, 0x70, 0xc8, 0x37, 0xae, 0x9d, 0x68,
+	0x07, 0x2d, 0x67, 0xe4, 0x76, 0x29, 0xf8, 0xc8, 0xb5, 0x62, 0xb9, 0xf5, 0xe2, 0xbc, 0xb9, 0x75,
+	0xf5, 0x29, 0x14, 0x02, 0x5a, 0xc4, 0x54, 0x4e, 0xb4, 0x03, 0x91, 0x7a, 0x9e, 0x68, 0x07, 0x24,
+	0x78, 0xb9, 0xb8, 0x3b, 0x72, 0x3d, 0xf3, 0x5c, 0x88, 0x21, 0x9c, 0xd8, 0xce, 0x43, 0xd6, 0xa3,
+	0x98, 0xea, 0x13, 0x00, 0x26, 0xe9, 0xab, 0x89, 0x45, 0xfd, 0x01, 0xf2, 0x3b, 0xce, 0xf0, 0x92,
+	0x62, 0x95, 0x41, 0x31, 0x3c, 0x5f, 0xec, 0x6e, 0x78, 0xfe, 0x04, 0x51, 0xde, 0x02, 0xc5, 0x73,
+	0xbb, 0x15, 0x25, 0xaa, 0x10, 0x84, 0x84, 0x46, 0x16, 0x88, 0x5f, 0x21, 0xcd, 0x03, 0x5b, 0x84,
+	0x2c, 0x3e, 0x22, 0x36, 0xb8, 0xfa, 0xc2, 0x31, 0xcc, 0x1e, 0xdd, 0x4e, 0x28, 0xc3, 0x26, 0x00,
+	0x09, 0xec, 0xd3, 0xac, 0x78, 0x6f, 0x41, 0x2b, 0x78, 0x58, 0x54, 0x29, 0x3e, 0x84, 0xbc, 0x6e,
+	0x18, 0x6d, 0xfa, 0x0e, 0x4b, 0x47, 0xed, 0x86, 0xdf, 0xce, 0xde, 0x82, 0x96, 0xd3, 0xd9, 0x27,
+	0xa9, 0x03, 0x1a, 0x54, 0x30, 0x0c, 0x81, 0x31, 0x1d, 0xf8, 0x9a, 0x50, 0x66, 0x7b, 0x0b, 0x1a,
+	0x18, 0xc1, 0x08, 0x6d, 0x92, 0xa4, 0x61, 0x78, 0xc9, 0x90, 0x98, 0x0e, 0x94, 0x43, 0xa6, 0x98,
+	0xc0, 0xf6, 0x16, 0xb4, 0x7c, 0x97, 0x7f, 0x6f, 0x67, 0x21, 0xd3, 0x71, 0x8c, 0x4b, 0xf5, 0x7b,
+	0x58, 0x7e, 0x86, 0x7d, 0xf9, 0x80, 0xb3, 0xdf, 0x8c, 0xfc, 0xda, 0xd3, 0xe1, 0xb5, 0x6f, 0x40,
+	0xd6, 0xe9, 0xf5, 0x3c, 0xfe, 0x20, 0x52, 0x34, 0x3e, 0x92, 0x72, 0xf2, 0x2b, 0xed, 0xa0, 0x7e,
+	0xca, 0x72, 0xf2, 0x2b, 0x21, 0x7d, 0x95, 0xc9, 0xa7, 0xcb, 0x8a, 0xfa, 0x08, 0x56, 0xbe, 0xd5,
+	0xad, 0xb3, 0xab, 0xed, 0xe7, 0xc3, 0xca, 0x33, 0xcb, 0xe9, 0xc8, 0x48, 0xf3, 0xe6, 0x53, 0x15,
+	0xc8, 0x0d, 0x75, 0xdf, 0xc7, 0xae, 0xc8, 0x7e, 0xc5, 0x10, 0xdd, 0x86, 0x22, 0x2d, 0x1f, 0xb5,
+	0xf5, 0x9e, 0x8f, 0x45, 0xfe, 0x0b, 0x74, 0xaa, 0x4e, 0x66, 0xd4, 0x3f, 0x80, 0x95, 0x86, 0xd9,
+	0xeb, 0xc9, 0xbb, 0xbe, 0x0f, 0x79, 0xe2, 0x38, 0x27, 0xb2, 0x9b, 0xb3, 0xf1, 0x05, 0xf9, 0x20,
+	0x80, 0x8e, 0x15, 0xd1, 0xaa, 0x18, 0xa0, 0x63, 0x31, 0x85, 0xaa, 0x40, 0xce, 0x3b, 0xd5, 0x2d,
+	0x8b, 0x57, 0xa0, 0xf3, 0x9a, 0x18, 0xaa, 0x16, 0x94, 0xc3, 0xed, 0xbd, 0xa1, 0x63, 0x7b, 0x18,
+	0x3d, 0x18, 0xdb, 0x3f, 0x52, 0x0d, 0x60, 0xa5, 0x06, 0xc1, 0xc3, 0x83, 0x31, 0x1e, 0x12, 0x80,
+	0x39, 0x1f, 0xea, 0x6d, 0x28, 0xee, 0x7a, 0xdd, 0x33, 0x71, 0xd0, 0x32, 0x28, 0x3d, 0xf3, 0x15,
+	0xdd, 0x23, 0xaf, 0x91, 0x4f, 0x52, 0x11, 0x65, 0x00, 0x9c, 0x15, 0x09, 0xa2, 0x40, 0x21, 0xc2,
+	0xa7, 0x44, 0x5a, 0x7a, 0x4a, 0xa8, 0x1f, 0xc3, 0x35, 0x16, 0x29, 0x77, 0x59, 0x86, 0x1d, 0x10,
+	0x98, 0x91, 0x87, 0xab, 0x4f, 0x61, 0x95, 0xab, 0xbe, 0x94, 0xd3, 0xcc, 0x1b, 0xa0, 0x7f, 0x09,
+	0xab, 0xdc, 0x7a, 0xaf, 0x8e, 0x1c, 0xe7, 0x2c, 0x1d, 0xe7, 0xec, 0x1b, 0x58, 0xd3, 0x30, 0x97,
+	0xb2, 0x44, 0x7e, 0xd6, 0xc3, 0xe2, 0x36, 0x14, 0x7d, 0xdf, 0x6a, 0x7b, 0xb8, 0xeb, 0xd8, 0x86,
+	0x78, 0x59, 0x80, 0xef, 0x5b, 0x2d, 0x36, 0xa3, 0x7e, 0x07, 0xd7, 0x48, 0xe6, 0xee, 0x78, 0x38,
+	0x46, 0xf9, 0x0e, 0x94, 0x24, 0xca, 0xac, 0xfd, 0x50, 0xd0, 0x20, 0x20, 0xed, 0xcd, 0xa6, 0xfd,
+	0xfb, 0xb0, 0xb6, 0x73, 0x8a, 0xbb, 0x67, 0x2d, 0xdf, 0x21, 0x2d, 0x9e, 0x50, 0x24, 0x2b, 0x2e,
+	0xd6, 0x8d, 0x36, 0x7d, 0xde, 0xb7, 0x0d, 0xdd, 0xd7, 0xf9, 0x9d, 0x2f, 0x91, 0x69, 0x5a, 0x02,
+	0x68, 0xe8, 0xbe, 0x4e, 0xe8, 0x33, 0x90, 0x0e, 0x16, 0x55, 0xe5, 0x92, 0x06, 0x74, 0x6a, 0x9b,
+	0xcc, 0xd0, 0xda, 0x3b, 0x05, 0xc0, 0xbc, 0x2f, 0x58, 0xe2, 0x35, 0x83, 0xa6, 0x6d, 0xa8, 0x0d,
+	0x58, 0x8f, 0x6e, 0xce, 0x55, 0xe0, 0x43, 0x40, 0x0c, 0xc9, 0xe9, 0xfc, 0x40, 0x4a, 0xa9, 0x5d,
+	0x67, 0xc4, 0x1f, 0xd7, 0x8a, 0x56, 0xa6, 0x2b, 0x47, 0x74, 0x61, 0x87, 0xcc, 0xab, 0x7f, 0x91,
+	0x82, 0xb5, 0x56, 0xd7, 0x1d, 0x75, 0x62, 0x67, 0xd8, 0x80, 0xac, 0x8b, 0x87, 0xba, 0xe9, 0x72,
+	0xd6, 0xf9, 0xe8, 0x37, 0xe3, 0x99, 0xf4, 0x90, 0x58, 0x21, 0x96, 0x94, 0x61, 0x99, 0x5c, 0xf9,
+	0xeb, 0x64, 0x99, 0xce, 0xbf, 0xc4, 0x2e, 0x93, 0xad, 0xfa, 0x37, 0x31, 0xbe, 0x76, 0x4d, 0xdb,
+	0x20, 0x95, 0x92, 0xeb, 0xbc, 0xb6, 0x22, 0x94, 0xa1, 0xa4, 0xe5, 0xe8, 0x78, 0xdf, 0x20, 0x56,
+	0x4f, 0x7b, 0x44, 0x76, 0x5f, 0xe4, 0x38, 0x7c, 0x38, 0xe1, 0x3d, 0x5e, 0x85, 0x3c, 0x3b, 0x54,
+	0xf8, 0x70, 0x13, 0x63, 0x52, 0xce, 0x62, 0xdf, 0x6d, 0x86, 0xb8, 0xc8, 0x1e, 0xf9, 0x6c, 0xae,
+	0x49, 0x6d, 0xf0, 0x08, 0xca, 0x51, 0x06, 0x2d, 0x3c, 0x8d, 0x3b, 0xe1, 0x90, 0xd3, 0x13, 0x1d,
+	0xf2, 0x7f, 0xa5, 0x60, 0x3d, 0x7a, 0x15, 0x6f, 0x72, 0xa3, 0xe8, 0x23, 0xc8, 0xf5, 0x98, 0xb0,
+	0xf8, 0x5e, 0x37, 0xc4, 0x5e, 0x09, 0xf2, 0xd4, 0x04, 0x2c, 0xa9, 0xbd, 0x8f, 0x6c, 0xa2, 0x9f,
+	0x7a, 0xc7, 0xc2, 0x22, 0xd4, 0x27, 0x3f, 0x2f, 0xca, 0x21, 0x60, 0x10, 0xef, 0x33, 0x52, 0x14,
+	0xae, 0x24, 0x6f, 0x18, 0x1c, 0xb4, 0x0a, 0x15, 0x0d, 0x0f, 0x2d, 0xb3, 0x4b, 0x8b, 0xd2, 0xe4,
+	0x21, 0x32, 0xf2, 0xc4, 0x1b, 0xee, 0x5f, 0x52, 0xb0, 0xc4, 0x17, 0xd9, 0x42, 0xe2, 0x8f, 0x0b,
+	0x1e, 0xc0, 0xaa, 0xcb, 0x29, 0x60, 0x6e, 0x67, 0x41, 0xbf, 0x35, 0x5c, 0xa0, 0x96, 0xe6, 0x91,
+	0x0a, 0x26, 0xc9, 0x6d, 0x48, 0x95, 0x9c, 0x43, 0xb2, 0x80, 0xbd, 0xc4, 0x67, 0x39, 0xd8, 0x3b,
+	0x20, 0x26, 0xa2, 0xfd, 0x56, 0x3e, 0xc9, 0x0a, 0x15, 0x0f, 0x40, 0xb1, 0xf4, 0x7e, 0x65, 0x71,
+	0x56, 0xa1, 0x9d, 0x40, 0xa9, 0x3f, 0xc0, 0xf5, 0x84, 0x73, 0xf2, 0x4b, 0xfd, 0x19, 0xd5, 0x3e,
+	0xb2, 0xc8, 0x5c, 0x4f, 0x71, 0xeb, 0x9a, 0x94, 0xb7, 0x87, 0xe7, 0xd7, 0x02, 0x30, 0xa2, 0xb0,
+	0x3d, 0xdd, 0xb4, 0x9c, 0x73, 0xec, 0x72, 0x0d, 0x0f, 0xc6, 0xea, 0xc7, 0x70, 0xf3, 0x99, 0xee,
+	0x76, 0xf4, 0x3e, 0xde, 0x71, 0x2c, 0x0b, 0x77, 0xfd, 0x98, 0x3d, 0xbf, 0x05, 0x39, 0xc3, 0xbd,
+	0x6c, 0xbb, 0x23, 0x5b, 0x18, 0xb4, 0xe1, 0x5e, 0x6a, 0x23, 0x5b, 0x7d, 0x05, 0x25, 0x81, 0x48,
+	0xe4, 0x30, 0x4d, 0x85, 0xcb, 0xa0, 0xf4, 0x31, 0xb3, 0xf9, 0x8c, 0x46, 0x3e, 0x63, 0x65, 0x1d,
+	0x25, 0xa1, 0xac, 0xe3, 0x3b, 0x83, 0x8e, 0xe7, 0x3b, 0xb6, 0xa8, 0x7a, 0x85, 0x13, 0xea, 0x9f,
+	0x29, 0xf0, 0xf6, 0x04, 0x9e, 0x03, 0xc5, 0xcf, 0xf2, 0x1b, 0x63, 0x12, 0x0a, 0xca, 0xa8, 0x32,
+	0xc7, 0x1a, 0x87, 0x09, 0x5d, 0x13, 0xb3, 0x0f, 0xee, 0xae, 0xe9, 0x14, 0xb3, 0x8c, 0xd0, 0x77,
+	0x49, 0xec, 0x72, 0xdf, 0x45, 0xf9, 0x7d, 0x08, 0xeb, 0xbe, 0xab, 0x77, 0xcf, 0xb0, 0x1b, 0x35,
+	0x35, 0xa6, 0x09, 0x88, 0xaf, 0xc9, 0xc6, 0x46, 0x42, 0x84, 0xab, 0x7b, 0xa7, 0x1c, 0x90, 0x35,
+	0x3c, 0x81, 0x4e, 0xc5, 0x00, 0xd8, 0x9e, 0x59, 0x09, 0x20, 0x68, 0x96, 0x0f, 0xf5, 0x91, 0x87,
+	0x59, 0x75, 0x25, 0xaf, 0xf1, 0x11, 0xaa, 0xc1, 0x1a, 0xcb, 0x75, 0x45, 0x1c, 0x61, 0x3b, 0xe4,
+	0x29, 0x81, 0x55, 0xbe, 0xb4, 0x13, 0x1e, 0xee, 0x4b, 0xb8, 0x29, 0xe0, 0x13, 0xcf, 0x50, 0xa0,
+	0x88, 0xd7, 0x39, 0xcc, 0xf1, 0xd8, 0x51, 0xd4, 0x47, 0x70, 0xbd, 0x85, 0xc5, 0x15, 0x3c, 0xdb,
+	0x79, 0x49, 0xd9, 0x90, 0xc2, 0x01, 0xe7, 0x32, 0x25, 0x73, 0xa9, 0xf6, 0xa0, 0xaa, 0x61, 0xcf,
+	0x77, 0x5c, 0xcc, 0x11, 0x8f, 0xc9, 0xc9, 0x04, 0x56, 0x10, 0x0b, 0x44, 0x7c, 0x15, 0xb1, 0x80,
+	0x44, 0xd7, 0x07, 0xa0, 0xf8, 0xbe, 0x35, 0xbb, 0xef, 0x45, 0xa0, 0xd4, 0x33, 0xb8, 0x91, 0xb8,
+	0x0f, 0x57, 0x94, 0xf7, 0x60, 0xd9, 0x65, 0xcb, 0x46, 0xc4, 0x3b, 0x2e, 0x89, 0x59, 0x26, 0x23,
+	0x19, 0x4c, 0xae, 0x44, 0x06, 0x60, 0xec, 0x47, 0x15, 0x7f, 0x9c, 0x82, 0x95, 0x97, 0x23, 0x7f,
+	0x47, 0xef, 0x9e, 0x62, 0x29, 0x77, 0x8b, 0x75, 0x1c, 0xee, 0xcb, 0x1d, 0x07, 0xa2, 0x9b, 0xf1,
+	0x13, 0xd4, 0xed, 0x4b, 0xde, 0x87, 0x18, 0xcb, 0x35, 0x94, 0xb1, 0x5c, 0xa3, 0x0c, 0x8a, 0xaf,
+	0xf7, 0x79, 0xdb, 0x84, 0x7c, 0xaa, 0xef, 0xc0, 0xca, 0x33, 0x3c, 0x83, 0x09, 0xf5, 0x0b, 0x28,
+	0x87, 0x40, 0x5c, 0x18, 0x01, 0x63, 0xa9, 0x99, 0x8c, 0xa9, 0x5b, 0xb0, 0xca, 0x2a, 0x36, 0xf2,
+	0x36, 0x6f, 0x03, 0xf8, 0x7a, 0xbf, 0x3d, 0x74, 0x71, 0x98, 0x8c, 0x16, 0x7c, 0xbd, 0xff, 0x92,
+	0x4e, 0xa8, 0xd7, 0x60, 0xad, 0xde, 0xf5, 0xcd, 0x73, 0xdd, 0xc7, 0xe4, 0xa7, 0x1c, 0xc2, 0x73,
+	0x6f, 0xc0, 0x7a, 0x74, 0x9a, 0xb1, 0xa3, 0x1a, 0x80, 0xb4, 0x91, 0x7d, 0xe0, 0xe8, 0xc6, 0x31,
+	0xf6, 0x7c, 0xa9, 0xdd, 0x40, 0x4b, 0xb2, 0xdc, 0xab, 0x93, 0xef, 0xb9, 0x8b, 0x38, 0x04, 0x17,
+	0x63, 0xf1, 0x4b, 0x29, 0xfa, 0xad, 0xfe, 0x7d, 0x0a, 0xd6, 0x22, 0xdb, 0x70, 0x61, 0xfc, 0x96,
+	0xf7, 0x09, 0x53, 0x89, 0x8c, 0x9c, 0x4a, 0x7c, 0x04, 0x79, 0xf1, 0x6b, 0xbb, 0xd9, 0xb1, 0x21,
+	0x00, 0x55, 0xdf, 0x87, 0x35, 0x66, 0x81, 0x5c, 0xa9, 0x9b, 0x7d, 0x17, 0x7b, 0x54, 0x17, 0x48,
+	0x59, 0x83, 0x5f, 0xf3, 0xc8, 0xb5, 0xd4, 0xff, 0x4d, 0xc3, 0x6a, 0xeb, 0xeb, 0x03, 0x92, 0x35,
+	0x76, 0x74, 0x6f, 0x22, 0x1c, 0x6a, 0xf2, 0x6c, 0xb9, 0xe7, 0xb8, 0x03, 0xdd, 0xe7, 0xc7, 0x7b,
+	0x37, 0x08, 0xc7, 0x71, 0x0a, 0x34, 0xfb, 0xd8, 0xa5, 0xb0, 0x4c, 0x19, 0xd9, 0x37, 0xfa, 0x04,
+	0xb2, 0x1e, 0xee, 0xba, 0x58, 0x24, 0x00, 0x77, 0x26, 0x53, 0x68, 0x51, 0x38, 0x8d, 0xc3, 0x57,
+	0xff, 0x3a, 0x05, 0x10, 0x12, 0x45, 0x9f, 0x4b, 0x4d, 0xa5, 0xe5, 0xad, 0x0f, 0xe6, 0x61, 0xa4,
+	0x46, 0xbb, 0xaf, 0x14, 0x8d, 0xfd, 0x94, 0xc4, 0x1a, 0x0d, 0x6c, 0xf1, 0x5b, 0x1f, 0x31, 0x54,
+	0x1f, 0x41, 0x86, 0xc0, 0x91, 0x9f, 0x35, 0x9d, 0x1c, 0x3e, 0x3f, 0x3c, 0xfa, 0xf6, 0xb0, 0xbc,
+	0x80, 0x72, 0xa0, 0xec, 0xb4, 0xbe, 0x29, 0xa7, 0x50, 0x1e, 0x32, 0x5f, 0xb5, 0x8e, 0x0e, 0xcb,
+	0x69, 0xb2, 0xfe, 0xb2, 0xae, 0x7d, 0x7d, 0xd2, 0x3c, 0x2e, 0x2b, 0xd5, 0x1a, 0x64, 0x19, 0xbb,
+	0x89, 0x39, 0x05, 0x37, 0xae, 0x74, 0x68, 0x5c, 0xff, 0x94, 0x82, 0x25, 0xc6, 0xdf, 0x55, 0x1f,
+	0x3b, 0x0d, 0x58, 0xe6, 0xce, 0xd7, 0x63, 0x37, 0x1b, 0x4f, 0xc5, 0x12, 0xae, 0x7d, 0x6f, 0x41,
+	0x5b, 0x72, 0xe4, 0x69, 0xf4, 0x05, 0x94, 0xbc, 0x1f, 0xad, 0xb6, 0xc1, 0x45, 0x15, 0xb4, 0xf7,
+	0x27, 0x49, 0x71, 0x6f, 0x41, 0x2b, 0x7a, 0x3f, 0x5a, 0x62, 0x92, 0x94, 0x9e, 0x58, 0xcb, 0x56,
+	0xfd, 0x5b, 0x05, 0x96, 0xc5, 0x49, 0xb8, 0x61, 0xb4, 0xc6, 0x58, 0x64, 0x47, 0xba, 0x2f, 0xc8,
+	0x47, 0xe1, 0xa3, 0x1c, 0x6b, 0xd8, 0x1b, 0x59, 0xfe, 0x38, 0xc7, 0x2f, 0x62, 0x1c, 0xb3, 0x53,
+	0xdf, 0x9b, 0x40, 0x52, 0x3a, 0x40, 0x40, 0x50, 0x3e, 0x40, 0xf5, 0xb3, 0x98, 0x7d, 0x30, 0x28,
+	0x92, 0xa9, 0xb1, 0x57, 0xc4, 0x85, 0x6b, 0xfa, 0x3e, 0xb6, 0xb9, 0xb3, 0x2f, 0xd1, 0xc9, 0x6f,
+	0xd9, 0x5c, 0xf5, 0xef, 0x52, 0x11, 0x93, 0xe1, 0xa8, 0xdf, 0x43, 0xc9, 0x75, 0x2e, 0x64, 0x4c,
+	0x92, 0x57, 0x7c, 0x3a, 0x2f, 0x83, 0x35, 0xcd, 0xb9, 0x10, 0x3b, 0xb0, 0x06, 0x75, 0xd1, 0x0d,
+	0x67, 0xaa, 0x5f, 0x40, 0x39, 0x0e, 0x30, 0xab, 0x55, 0xad, 0x48, 0xad, 0x6a, 0x72, 0x61, 0x2e,
+	0xdd, 0xe7, 0xfe, 0x21, 0x40, 0x58, 0x63, 0x47, 0x6f, 0xc1, 0xda, 0x91, 0xb6, 0xff, 0x6c, 0xff,
+	0xb0, 0xfd, 0x7c, 0xff, 0xb0, 0xd1, 0x0e, 0x35, 0x3e, 0x0f, 0x99, 0x93, 0x56, 0x53, 0x63, 0x2a,
+	0x5f, 0x3f, 0x39, 0x3e, 0x2a, 0xa7, 0xc9, 0xd7, 0x6e, 0x6b, 0xe7, 0x79, 0x59, 0x41, 0x05, 0x58,
+	0xac, 0x1f, 0xec, 0xd7, 0x5b, 0xe5, 0xcc, 0xfd, 0x07, 0xec, 0x07, 0x12, 0xd4, 0x66, 0x4a, 0x90,
+	0xd7, 0x9a, 0xad, 0xa6, 0xf6, 0x4d, 0xb3, 0xc1, 0x48, 0xec, 0xee, 0x1f, 0x34, 0xcb, 0x29, 0x62,
+	0x3e, 0x8d, 0x7d, 0xad, 0x9c, 0xbe, 0xff, 0x3d, 0x14, 0xa5, 0x1e, 0x01, 0xaa, 0xc0, 0xfa, 0xce,
+	0xd1, 0x8b, 0x17, 0xfb, 0xc7, 0xed, 0xd6, 0x71, 0xfd, 0xb8, 0x29, 0x6d, 0x5f, 0x84, 0x5c, 0xeb,
+	0xb8, 0xae, 0x1d, 0x37, 0x1b, 0xe5, 0x14, 0xd9, 0x4d, 0x6b, 0xd6, 0x1b, 0xbf, 0x53, 0x4e, 0x93,
+	0xdf, 0x1e, 0xee, 0xee, 0x1f, 0xee, 0xb7, 0xf6, 0xf6, 0x0f, 0x9f, 0x95, 0x15, 0xb2, 0x21, 0x1b,
+	0x36, 0x1b, 0xe5, 0xcc, 0xfd, 0xa7, 0x50, 0x68, 0x60, 0xcb, 0x1c, 0x98, 0x3e, 0x76, 0xc9, 0xee,
+	0x87, 0x47, 0x87, 0xcd, 0xf2, 0x42, 0x60, 0xb3, 0xf4, 0x28, 0x07, 0xfb, 0x87, 0xcd, 0x72, 0x9a,
+	0x70, 0xd4, 0xfa, 0xfa, 0xa0, 0xac, 0x08, 0xcb, 0xce, 0x6c, 0xfd, 0x67, 0x15, 0x94, 0xfa, 0xcb,
+	0x7d, 0x54, 0x07, 0x08, 0x7b, 0xfa, 0x28, 0x30, 0x89, 0xb1, 0x3e, 0x7f, 0x75, 0x63, 0xcc, 0x0f,
+	0x37, 0xc9, 0x8f, 0xae, 0xd5, 0x05, 0xf4, 0x39, 0x14, 0xa5, 0xde, 0x39, 0x0a, 0x7e, 0xe2, 0x33,
+	0xde, 0x50, 0xaf, 0x96, 0xe3, 0xbf, 0x72, 0x55, 0x17, 0xd0, 0xa7, 0x90, 0x17, 0x2d, 0x74, 0x14,
+	0xf4, 0x08, 0x63, 0x4d, 0xf5, 0x24, 0xc4, 0x87, 0x29, 0xc2, 0x7c, 0xd8, 0x56, 0x0f, 0x99, 0x1f,
+	0x6b, 0xb5, 0x4f, 0x61, 0xfe, 0x29, 0x14, 0xa5, 0x5e, 0x7a, 0xc8, 0xfc, 0x78, 0x83, 0xbd, 0x1a,
+	0xf3, 0x51, 0xea, 0x02, 0x6a, 0x42, 0x49, 0xee, 0x7f, 0xa3, 0x1b, 0xe1, 0x63, 0x74, 0xac, 0x2b,
+	0x3e, 0x85, 0x87, 0x1d, 0x28, 0x4a, 0xdd, 0x9e, 0x90, 0x87, 0xf1, 0x16, 0xd0, 0x54, 0x22, 0x4b,
+	0x91, 0x66, 0x21, 0xba, 0x19, 0xbb, 0x87, 0x28, 0xa1, 0x84, 0x9f, 0x01, 0xa9, 0x0b, 0x48, 0x8f,
+	0x75, 0x1c, 0xc3, 0x56, 0x2d, 0xba, 0x9b, 0x48, 0x6e, 0xac, 0xf7, 0x5d, 0xbd, 0x19, 0x25, 0x1c,
+	0x6d, 0x34, 0xab, 0x0b, 0xe8, 0x4b, 0x80, 0xb0, 0xe7, 0x18, 0xde, 0xd9, 0x58, 0x73, 0x37, 0x99,
+	0xc3, 0x87, 0x29, 0xb4, 0x0f, 0x2b, 0xb1, 0x2e, 0x20, 0x0a, 0x7e, 0x61, 0x9c, 0xdc, 0x1e, 0x9c,
+	0x48, 0xea, 0x39, 0x94, 0xe3, 0x0d, 0x56, 0x74, 0x3b, 0xf1, 0x9c, 0x2d, 0x3c, 0x93, 0xd8, 0x1e,
+	0x2c, 0x45, 0x9a, 0xa9, 0xe1, 0x05, 0x24, 0xf5, 0x58, 0xab, 0xd7, 0xc6, 0x7a, 0x9d, 0x12, 0x5b,
+	0x2b, 0xb1, 0xf6, 0xab, 0x74, 0xc2, 0xc4, 0xbe, 0xec, 0x14, 0xbd, 0x78, 0x06, 0x4b, 0x91, 0xfe,
+	0x6b, 0xc8, 0x56, 0x52, 0x5b, 0x76, 0x0a, 0xa1, 0x26, 0x94, 0xe4, 0xa6, 0x62, 0xa8, 0xec, 0x09,
+	0xad, 0xc6, 0xb9, 0xf4, 0x94, 0xd3, 0x89, 0xeb, 0x69, 0x94, 0x10, 0x8a, 0xa6, 0x94, 0x51, 0x25,
+	0xe2, 0x14, 0x22, 0x4a, 0x34, 0x07, 0xfa, 0xc3, 0x14, 0x39, 0x8c, 0xdc, 0xac, 0x0b, 0x0f, 0x93,
+	0xd0, 0xc2, 0x9b, 0x7a, 0x18, 0x08, 0x9b, 0x3c, 0x21, 0x1f, 0x63, 0x8d, 0x9f, 0xc9, 0x24, 0xee,
+	0xa5, 0xd0, 0x36, 0xe4, 0x78, 0x29, 0x19, 0x05, 0x4d, 0xb4, 0x68, 0x5b, 0xa5, 0x3a, 0xad, 0x87,
+	0xc7, 0xcf, 0x03, 0x1c, 0xe5, 0xb8, 0xae, 0xbd, 0x39, 0x99, 0xd0, 0x95, 0x53, 0x76, 0xe2, 0xae,
+	0x5c, 0xa6, 0x35, 0x56, 0xad, 0x0f, 0x5d, 0x39, 0xc5, 0x8d, 0xb8, 0xf2, 0x19, 0x88, 0x0f, 0x53,
+	0x04, 0x55, 0x74, 0x5e, 0x42, 0xd4, 0x58, 0x2f, 0x66, 0x32, 0xaa, 0xe8, 0xbf, 0x84, 0xa8, 0xb1,
+	0x8e, 0xcc, 0x04, 0xd4, 0x3a, 0xe4, 0x45, 0x17, 0x23, 0x44, 0x8d, 0xb5, 0x55, 0xaa, 0x95, 0xf1,
+	0x05, 0xfe, 0x22, 0x63, 0xc6, 0x5a, 0x92, 0x5f, 0x6b, 0xa1, 0x26, 0x25, 0x3c, 0xed, 0xaa, 0x37,
+	0x93, 0x17, 0x05, 0x39, 0xf4, 0x39, 0x0d, 0xe9, 0xd8, 0xc7, 0x75, 0xcb, 0x42, 0x13, 0x74, 0x66,
+	0x8a, 0x3a, 0x7e, 0x04, 0x19, 0xd2, 0x05, 0x41, 0xc1, 0x2f, 0x14, 0xa4, 0xa6, 0x49, 0x75, 0x3d,
+	0x3a, 0x29, 0x1d, 0xe1, 0x05, 0x2c, 0x45, 0x9a, 0x20, 0xd3, 0x14, 0xf9, 0xed, 0xa8, 0xd5, 0xc7,
+	0xda, 0x26, 0x54, 0x9f, 0xf7, 0x02, 0x5d, 0x8c, 0xd0, 0x1a, 0x6b, 0x97, 0xcc, 0xa4, 0x45, 0xe2,
+	0x7b, 0xd8, 0x27, 0x41, 0xf1, 0xbe, 0xf4, 0xbc, 0x5e, 0x4b, 0xee, 0x86, 0x84, 0xd7, 0x93, 0xd0,
+	0x23, 0x99, 0x42, 0xe6, 0x25, 0x2c, 0x47, 0x9b, 0x1f, 0xe8, 0x6d, 0xf9, 0xe7, 0x4c, 0x63, 0x4d,
+	0x91, 0xd9, 0x67, 0x7b, 0x0e, 0x25, 0xb9, 0xeb, 0x20, 0xb9, 0xd3, 0xf1, 0x46, 0x48, 0xf5, 0x66,
+	0xf2, 0x62, 0x40, 0xec, 0x05, 0x94, 0xe4, 0x12, 0x31, 0x4a, 0xac, 0x54, 0x8f, 0x11, 0x4b, 0xaa,
+	0x91, 0x53, 0x85, 0xf8, 0x0e, 0x56, 0xc7, 0xea, 0xad, 0xe8, 0x4e, 0xac, 0xaa, 0x3a, 0x56, 0x72,
+	0xae, 0xfe, 0x64, 0x0a, 0x44, 0xc0, 0x6a, 0x0f, 0xae, 0x25, 0xd6, 0x2a, 0xd1, 0xbb, 0xf1, 0x9a,
+	0x64, 0x52, 0xf9, 0xb5, 0xfa, 0xde, 0x0c, 0xa8, 0x60, 0x9f, 0xaf, 0x01, 0x8d, 0x57, 0xe1, 0x50,
+	0xc0, 0xe2, 0xc4, 0x0a, 0xdd, 0x14, 0x25, 0xf8, 0x5d, 0xd2, 0x59, 0x1b, 0xab, 0x9d, 0x21, 0x35,
+	0x3c, 0xf6, 0xa4, 0x02, 0x5e, 0xf5, 0x9d, 0xa9, 0x30, 0x92, 0xfd, 0xe7, 0x45, 0xbd, 0x2c, 0xf4,
+	0x47, 0xb1, 0x0a, 0xda, 0x14, 0x06, 0xbf, 0x84, 0xfc, 0x33, 0x1c, 0x47, 0x8f, 0xd5, 0xbe, 0xaa,
+	0x95, 0xf1, 0x05, 0xd9, 0xe0, 0xc2, 0x2a, 0x96, 0xf4, 0x1a, 0x88, 0x57, 0xb6, 0xa6, 0xf0, 0xb0,
+	0x07, 0x45, 0xa9, 0x7c, 0x14, 0x86, 0x90, 0xf1, 0xd2, 0x55, 0xf5, 0x46, 0xe2, 0x9a, 0x64, 0x21,
+	0x72, 0xbd, 0xab, 0x81, 0x7b, 0x3a, 0x79, 0x78, 0x4e, 0xf2, 0x8a, 0x33, 0x88, 0x3d, 0x65, 0xa1,
+	0xe9, 0x58, 0xf7, 0xce, 0x50, 0xa5, 0x46, 0xfe, 0xf5, 0x52, 0x1f, 0x9a, 0x35, 0x31, 0x25, 0x38,
+	0x5a, 0x0d, 0x56, 0xc8, 0xac, 0x14, 0x61, 0xb2, 0xbc, 0x52, 0x74, 0x2d, 0xfe, 0xc0, 0x15, 0xe2,
+	0x48, 0x7c, 0xf7, 0xaa, 0x0b, 0xdb, 0x1f, 0xff, 0xf3, 0xeb, 0x5b, 0xa9, 0x7f, 0x7d, 0x7d, 0x2b,
+	0xf5, 0xeb, 0xd7, 0xb7, 0x52, 0xdf, 0x7d, 0xd0, 0x37, 0xfd, 0xd3, 0x51, 0xa7, 0xd6, 0x75, 0x06,
+	0x9b, 0x43, 0xbd, 0x7b, 0x7a, 0x69, 0x60, 0x57, 0xfe, 0x3a, 0xdf, 0xda, 0xf4, 0xdc, 0x2e, 0xf9,
+	0x8f, 0xd7, 0x4e, 0x96, 0x9e, 0xef, 0xd1, 0xff, 0x0f, 0x00, 0xd5, 0x89, 0xc5, 0xfa, 0x03, 0x3b,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	// CreateRepo creates a new repo.
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error)
+	// DeleteRepo deletes a repo.
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ClearCommit removes all data from the commit.
+	ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
+	// InspectCommitCompaction returns the level structure of a commit's file sets.
+	InspectCommitCompaction(ctx context.Context, in *InspectCommitCompactionRequest, opts ...grpc.CallOption) (*CommitCompactionInfo, error)
+	// ListCommit returns info about all commits.
+	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error)
+	// SubscribeCommit subscribes for new commits on a given branch.
+	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
+	// InspectCommitSet returns the info about a CommitSet.
+	InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error)
+	// ListCommitSet returns info about all CommitSets.
+	ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error)
+	// SquashCommitSet squashes the commits of a CommitSet into their children.
+	SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
+	DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// CreateBranch creates a new branch.
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectBranch returns info about a branch.
+	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error)
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ModifyFile performs modifications on a set of files.
+	ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error)
+	// GetFile returns the contents of a single file
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
+	// GetFileTAR returns a TAR stream of the contents matched by the request
+	GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error)
+	// InspectFile returns info about a file.
+	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// ListFile returns info about all files.
+	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error)
+	// WalkFile walks over all the files under a directory, including children of children.
+	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
+	// GlobFile returns info about all files.
+	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error)
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error)
+	// ActivateAuth creates a role binding for all existing repos
+	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
+	// DeleteAll deletes everything.
+	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+	// Fsck does a file system consistency check for pfs.
+	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
+	// FileSet API
+	// CreateFileSet creates a new file set.
+	CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error)
+	// GetFileSet returns a file set with the data from a commit
+	GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error)
+	// AddFileSet associates a file set with a commit
+	AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RenewFileSet prevents a file set from being deleted for a set amount of time.
+	RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ComposeFileSet composes a file set from a list of file sets.
+	ComposeFileSet(ctx context.Context, in *ComposeFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error)
+	// CheckStorage runs integrity checks for the storage layer.
+	CheckStorage(ctx context.Context, in *CheckStorageRequest, opts ...grpc.CallOption) (*CheckStorageResponse, error)
+	// ScrubStorage verifies the hash of every chunk object, optionally repairing
+	// bad objects from a replica, and reports the files affected by bad objects.
+	ScrubStorage(ctx context.Context, in *ScrubStorageRequest, opts ...grpc.CallOption) (API_ScrubStorageClient, error)
+	// ReplicationStatus returns the status of the replication of chunk objects
+	// to the replica object stores.
+	ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error)
+	// GarbageCollectStorage runs a pass of garbage collection, or reports what
+	// it would delete.
+	GarbageCollectStorage(ctx context.Context, in *GarbageCollectStorageRequest, opts ...grpc.CallOption) (*GarbageCollectStorageResponse, error)
+	// SetStorageGCPaused pauses or resumes garbage collection.
+	SetStorageGCPaused(ctx context.Context, in *SetStorageGCPausedRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RestoreStorageTrash moves chunk objects deleted by garbage collection out
+	// of the trash, and tracks them again.
+	RestoreStorageTrash(ctx context.Context, in *RestoreStorageTrashRequest, opts ...grpc.CallOption) (*RestoreStorageTrashResponse, error)
+	PutCache(ctx context.Context, in *PutCacheRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error)
+	ClearCache(ctx context.Context, in *ClearCacheRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RunLoadTest runs a load test.
+	RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
+	// RunLoadTestDefault runs the default load tests.
+	RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
+	// ListTask lists PFS tasks
+	ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error)
+	// Egress writes data from a commit to an external system
+	Egress(ctx context.Context, in *EgressRequest, opts ...grpc.CallOption) (*EgressResponse, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteAll", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[13], "/pfs_v2.API/Fsck", opts...)
+func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
+	out := new(RepoInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIFsckClient{stream}
+	return out, nil
+}
+
+func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs_v2.API/ListRepo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListRepoClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -5457,153 +6418,247 @@ func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.Call
 	return x, nil
 }
 
-type API_FsckClient interface {
-	Recv() (*FsckResponse, error)
+type API_ListRepoClient interface {
+	Recv() (*RepoInfo, error)
 	grpc.ClientStream
 }
 
-type aPIFsckClient struct {
+type aPIListRepoClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
-	m := new(FsckResponse)
+func (x *aPIListRepoClient) Recv() (*RepoInfo, error) {
+	m := new(RepoInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[14], "/pfs_v2.API/CreateFileSet", opts...)
+func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPICreateFileSetClient{stream}
-	return x, nil
+	return out, nil
 }
 
-type API_CreateFileSetClient interface {
-	Send(*ModifyFileRequest) error
-	CloseAndRecv() (*CreateFileSetResponse, error)
-	grpc.ClientStream
+func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/StartCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type aPICreateFileSetClient struct {
-	grpc.ClientStream
+func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/FinishCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPICreateFileSetClient) Send(m *ModifyFileRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (c *aPIClient) ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/ClearCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPICreateFileSetClient) CloseAndRecv() (*CreateFileSetResponse, error) {
+func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
+	out := new(CommitInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectCommitCompaction(ctx context.Context, in *InspectCommitCompactionRequest, opts ...grpc.CallOption) (*CommitCompactionInfo, error) {
+	out := new(CommitCompactionInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectCommitCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs_v2.API/ListCommit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
 	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	m := new(CreateFileSetResponse)
+	return x, nil
+}
+
+type API_ListCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListCommitClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error) {
-	out := new(CreateFileSetResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/GetFileSet", in, out, opts...)
+func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs_v2.API/SubscribeCommit", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &aPISubscribeCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *aPIClient) AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/AddFileSet", in, out, opts...)
-	if err != nil {
+type API_SubscribeCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
+}
+
+type aPISubscribeCommitClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *aPIClient) RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/RenewFileSet", in, out, opts...)
+func (c *aPIClient) InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs_v2.API/InspectCommitSet", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &aPIInspectCommitSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *aPIClient) ComposeFileSet(ctx context.Context, in *ComposeFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error) {
-	out := new(CreateFileSetResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/ComposeFileSet", in, out, opts...)
-	if err != nil {
+type API_InspectCommitSetClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
+}
+
+type aPIInspectCommitSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIInspectCommitSetClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *aPIClient) CheckStorage(ctx context.Context, in *CheckStorageRequest, opts ...grpc.CallOption) (*CheckStorageResponse, error) {
-	out := new(CheckStorageResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/CheckStorage", in, out, opts...)
+func (c *aPIClient) ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs_v2.API/ListCommitSet", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &aPIListCommitSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *aPIClient) PutCache(ctx context.Context, in *PutCacheRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/PutCache", in, out, opts...)
-	if err != nil {
+type API_ListCommitSetClient interface {
+	Recv() (*CommitSetInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListCommitSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListCommitSetClient) Recv() (*CommitSetInfo, error) {
+	m := new(CommitSetInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *aPIClient) GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error) {
-	out := new(GetCacheResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/GetCache", in, out, opts...)
+func (c *aPIClient) SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/SquashCommitSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) ClearCache(ctx context.Context, in *ClearCacheRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+func (c *aPIClient) DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/ClearCache", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DropCommitSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
-	out := new(RunLoadTestResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTest", in, out, opts...)
+func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateBranch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
-	out := new(RunLoadTestResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTestDefault", in, out, opts...)
+func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
+	out := new(BranchInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectBranch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[15], "/pfs_v2.API/ListTask", opts...)
+func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs_v2.API/ListBranch", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIListTaskClient{stream}
+	x := &aPIListBranchClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -5613,2518 +6668,1965 @@ func (c *aPIClient) ListTask(ctx context.Context, in *task.ListTaskRequest, opts
 	return x, nil
 }
 
-type API_ListTaskClient interface {
-	Recv() (*task.TaskInfo, error)
+type API_ListBranchClient interface {
+	Recv() (*BranchInfo, error)
 	grpc.ClientStream
 }
 
-type aPIListTaskClient struct {
+type aPIListBranchClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIListTaskClient) Recv() (*task.TaskInfo, error) {
-	m := new(task.TaskInfo)
+func (x *aPIListBranchClient) Recv() (*BranchInfo, error) {
+	m := new(BranchInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) Egress(ctx context.Context, in *EgressRequest, opts ...grpc.CallOption) (*EgressResponse, error) {
-	out := new(EgressResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/Egress", in, out, opts...)
+func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteBranch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	// CreateRepo creates a new repo.
-	CreateRepo(context.Context, *CreateRepoRequest) (*types.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(*ListRepoRequest, API_ListRepoServer) error
-	// DeleteRepo deletes a repo.
-	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(context.Context, *FinishCommitRequest) (*types.Empty, error)
-	// ClearCommit removes all data from the commit.
-	ClearCommit(context.Context, *ClearCommitRequest) (*types.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
-	// ListCommit returns info about all commits.
-	ListCommit(*ListCommitRequest, API_ListCommitServer) error
-	// SubscribeCommit subscribes for new commits on a given branch.
-	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
-	// InspectCommitSet returns the info about a CommitSet.
-	InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error
-	// ListCommitSet returns info about all CommitSets.
-	ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error
-	// SquashCommitSet squashes the commits of a CommitSet into their children.
-	SquashCommitSet(context.Context, *SquashCommitSetRequest) (*types.Empty, error)
-	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
-	DropCommitSet(context.Context, *DropCommitSetRequest) (*types.Empty, error)
-	// CreateBranch creates a new branch.
-	CreateBranch(context.Context, *CreateBranchRequest) (*types.Empty, error)
-	// InspectBranch returns info about a branch.
-	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(*ListBranchRequest, API_ListBranchServer) error
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(context.Context, *DeleteBranchRequest) (*types.Empty, error)
-	// ModifyFile performs modifications on a set of files.
-	ModifyFile(API_ModifyFileServer) error
-	// GetFile returns the contents of a single file
-	GetFile(*GetFileRequest, API_GetFileServer) error
-	// GetFileTAR returns a TAR stream of the contents matched by the request
-	GetFileTAR(*GetFileRequest, API_GetFileTARServer) error
-	// InspectFile returns info about a file.
-	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
-	// ListFile returns info about all files.
-	ListFile(*ListFileRequest, API_ListFileServer) error
-	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(*WalkFileRequest, API_WalkFileServer) error
-	// GlobFile returns info about all files.
-	GlobFile(*GlobFileRequest, API_GlobFileServer) error
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(*DiffFileRequest, API_DiffFileServer) error
-	// ActivateAuth creates a role binding for all existing repos
-	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
-	// DeleteAll deletes everything.
-	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
-	// Fsck does a file system consistency check for pfs.
-	Fsck(*FsckRequest, API_FsckServer) error
-	// FileSet API
-	// CreateFileSet creates a new file set.
-	CreateFileSet(API_CreateFileSetServer) error
-	// GetFileSet returns a file set with the data from a commit
-	GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error)
-	// AddFileSet associates a file set with a commit
-	AddFileSet(context.Context, *AddFileSetRequest) (*types.Empty, error)
-	// RenewFileSet prevents a file set from being deleted for a set amount of time.
-	RenewFileSet(context.Context, *RenewFileSetRequest) (*types.Empty, error)
-	// ComposeFileSet composes a file set from a list of file sets.
-	ComposeFileSet(context.Context, *ComposeFileSetRequest) (*CreateFileSetResponse, error)
-	// CheckStorage runs integrity checks for the storage layer.
-	CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error)
-	PutCache(context.Context, *PutCacheRequest) (*types.Empty, error)
-	GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error)
-	ClearCache(context.Context, *ClearCacheRequest) (*types.Empty, error)
-	// RunLoadTest runs a load test.
-	RunLoadTest(context.Context, *RunLoadTestRequest) (*RunLoadTestResponse, error)
-	// RunLoadTestDefault runs the default load tests.
-	RunLoadTestDefault(context.Context, *types.Empty) (*RunLoadTestResponse, error)
-	// ListTask lists PFS tasks
-	ListTask(*task.ListTaskRequest, API_ListTaskServer) error
-	// Egress writes data from a commit to an external system
-	Egress(context.Context, *EgressRequest) (*EgressResponse, error)
+func (c *aPIClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs_v2.API/ModifyFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIModifyFileClient{stream}
+	return x, nil
 }
 
-// UnimplementedAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+type API_ModifyFileClient interface {
+	Send(*ModifyFileRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
 }
 
-func (*UnimplementedAPIServer) CreateRepo(ctx context.Context, req *CreateRepoRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
+type aPIModifyFileClient struct {
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) InspectRepo(ctx context.Context, req *InspectRepoRequest) (*RepoInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectRepo not implemented")
+
+func (x *aPIModifyFileClient) Send(m *ModifyFileRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (*UnimplementedAPIServer) ListRepo(req *ListRepoRequest, srv API_ListRepoServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListRepo not implemented")
+
+func (x *aPIModifyFileClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedAPIServer) DeleteRepo(ctx context.Context, req *DeleteRepoRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
+
+func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs_v2.API/GetFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGetFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (*UnimplementedAPIServer) StartCommit(ctx context.Context, req *StartCommitRequest) (*Commit, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
+
+type API_GetFileClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) FinishCommit(ctx context.Context, req *FinishCommitRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinishCommit not implemented")
+
+type aPIGetFileClient struct {
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) ClearCommit(ctx context.Context, req *ClearCommitRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearCommit not implemented")
+
+func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedAPIServer) InspectCommit(ctx context.Context, req *InspectCommitRequest) (*CommitInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectCommit not implemented")
+
+func (c *aPIClient) GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs_v2.API/GetFileTAR", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGetFileTARClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (*UnimplementedAPIServer) ListCommit(req *ListCommitRequest, srv API_ListCommitServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListCommit not implemented")
+
+type API_GetFileTARClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) SubscribeCommit(req *SubscribeCommitRequest, srv API_SubscribeCommitServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeCommit not implemented")
+
+type aPIGetFileTARClient struct {
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) InspectCommitSet(req *InspectCommitSetRequest, srv API_InspectCommitSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method InspectCommitSet not implemented")
+
+func (x *aPIGetFileTARClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedAPIServer) ListCommitSet(req *ListCommitSetRequest, srv API_ListCommitSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListCommitSet not implemented")
+
+func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAPIServer) SquashCommitSet(ctx context.Context, req *SquashCommitSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SquashCommitSet not implemented")
+
+func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs_v2.API/ListFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (*UnimplementedAPIServer) DropCommitSet(ctx context.Context, req *DropCommitSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropCommitSet not implemented")
+
+type API_ListFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) CreateBranch(ctx context.Context, req *CreateBranchRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+
+type aPIListFileClient struct {
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) InspectBranch(ctx context.Context, req *InspectBranchRequest) (*BranchInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectBranch not implemented")
+
+func (x *aPIListFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedAPIServer) ListBranch(req *ListBranchRequest, srv API_ListBranchServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListBranch not implemented")
+
+func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs_v2.API/WalkFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIWalkFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (*UnimplementedAPIServer) DeleteBranch(ctx context.Context, req *DeleteBranchRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
+
+type API_WalkFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) ModifyFile(srv API_ModifyFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ModifyFile not implemented")
+
+type aPIWalkFileClient struct {
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) GetFile(req *GetFileRequest, srv API_GetFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+
+func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedAPIServer) GetFileTAR(req *GetFileRequest, srv API_GetFileTARServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetFileTAR not implemented")
+
+func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs_v2.API/GlobFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGlobFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (*UnimplementedAPIServer) InspectFile(ctx context.Context, req *InspectFileRequest) (*FileInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectFile not implemented")
-}
-func (*UnimplementedAPIServer) ListFile(req *ListFileRequest, srv API_ListFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListFile not implemented")
-}
-func (*UnimplementedAPIServer) WalkFile(req *WalkFileRequest, srv API_WalkFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method WalkFile not implemented")
-}
-func (*UnimplementedAPIServer) GlobFile(req *GlobFileRequest, srv API_GlobFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GlobFile not implemented")
-}
-func (*UnimplementedAPIServer) DiffFile(req *DiffFileRequest, srv API_DiffFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method DiffFile not implemented")
-}
-func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
-}
-func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
-}
-func (*UnimplementedAPIServer) Fsck(req *FsckRequest, srv API_FsckServer) error {
-	return status.Errorf(codes.Unimplemented, "method Fsck not implemented")
-}
-func (*UnimplementedAPIServer) CreateFileSet(srv API_CreateFileSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method CreateFileSet not implemented")
-}
-func (*UnimplementedAPIServer) GetFileSet(ctx context.Context, req *GetFileSetRequest) (*CreateFileSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFileSet not implemented")
-}
-func (*UnimplementedAPIServer) AddFileSet(ctx context.Context, req *AddFileSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFileSet not implemented")
-}
-func (*UnimplementedAPIServer) RenewFileSet(ctx context.Context, req *RenewFileSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewFileSet not implemented")
-}
-func (*UnimplementedAPIServer) ComposeFileSet(ctx context.Context, req *ComposeFileSetRequest) (*CreateFileSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ComposeFileSet not implemented")
-}
-func (*UnimplementedAPIServer) CheckStorage(ctx context.Context, req *CheckStorageRequest) (*CheckStorageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckStorage not implemented")
-}
-func (*UnimplementedAPIServer) PutCache(ctx context.Context, req *PutCacheRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutCache not implemented")
-}
-func (*UnimplementedAPIServer) GetCache(ctx context.Context, req *GetCacheRequest) (*GetCacheResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCache not implemented")
-}
-func (*UnimplementedAPIServer) ClearCache(ctx context.Context, req *ClearCacheRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearCache not implemented")
-}
-func (*UnimplementedAPIServer) RunLoadTest(ctx context.Context, req *RunLoadTestRequest) (*RunLoadTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTest not implemented")
-}
-func (*UnimplementedAPIServer) RunLoadTestDefault(ctx context.Context, req *types.Empty) (*RunLoadTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTestDefault not implemented")
-}
-func (*UnimplementedAPIServer) ListTask(req *task.ListTaskRequest, srv API_ListTaskServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListTask not implemented")
-}
-func (*UnimplementedAPIServer) Egress(ctx context.Context, req *EgressRequest) (*EgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
+
+type API_GlobFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+type aPIGlobFileClient struct {
+	grpc.ClientStream
 }
 
-func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRepoRequest)
-	if err := dec(in); err != nil {
+func (x *aPIGlobFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/CreateRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectRepoRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs_v2.API/DiffFile", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+	x := &aPIDiffFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _API_ListRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRepoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListRepo(m, &aPIListRepoServer{stream})
-}
-
-type API_ListRepoServer interface {
-	Send(*RepoInfo) error
-	grpc.ServerStream
+	return x, nil
 }
 
-type aPIListRepoServer struct {
-	grpc.ServerStream
+type API_DiffFileClient interface {
+	Recv() (*DiffFileResponse, error)
+	grpc.ClientStream
 }
 
-func (x *aPIListRepoServer) Send(m *RepoInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIDiffFileClient struct {
+	grpc.ClientStream
 }
 
-func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRepoRequest)
-	if err := dec(in); err != nil {
+func (x *aPIDiffFileClient) Recv() (*DiffFileResponse, error) {
+	m := new(DiffFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DeleteRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartCommitRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
+	out := new(ActivateAuthResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/ActivateAuth", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).StartCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/StartCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FinishCommitRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).FinishCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/FinishCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_ClearCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearCommitRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[13], "/pfs_v2.API/Fsck", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ClearCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/ClearCommit",
+	x := &aPIFsckClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ClearCommit(ctx, req.(*ClearCommitRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectCommitRequest)
-	if err := dec(in); err != nil {
+type API_FsckClient interface {
+	Recv() (*FsckResponse, error)
+	grpc.ClientStream
+}
+
+type aPIFsckClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
+	m := new(FsckResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_ListCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[14], "/pfs_v2.API/CreateFileSet", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListCommit(m, &aPIListCommitServer{stream})
+	x := &aPICreateFileSetClient{stream}
+	return x, nil
 }
 
-type API_ListCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+type API_CreateFileSetClient interface {
+	Send(*ModifyFileRequest) error
+	CloseAndRecv() (*CreateFileSetResponse, error)
+	grpc.ClientStream
 }
 
-type aPIListCommitServer struct {
-	grpc.ServerStream
+type aPICreateFileSetClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPIListCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (x *aPICreateFileSetClient) Send(m *ModifyFileRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPICreateFileSetClient) CloseAndRecv() (*CreateFileSetResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
-}
-
-type API_SubscribeCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
-}
-
-type aPISubscribeCommitServer struct {
-	grpc.ServerStream
+	m := new(CreateFileSetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error) {
+	out := new(CreateFileSetResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/GetFileSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_InspectCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InspectCommitSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/AddFileSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).InspectCommitSet(m, &aPIInspectCommitSetServer{stream})
+	return out, nil
 }
 
-type API_InspectCommitSetServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+func (c *aPIClient) RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RenewFileSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type aPIInspectCommitSetServer struct {
-	grpc.ServerStream
+func (c *aPIClient) ComposeFileSet(ctx context.Context, in *ComposeFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error) {
+	out := new(CreateFileSetResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/ComposeFileSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPIInspectCommitSetServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) CheckStorage(ctx context.Context, in *CheckStorageRequest, opts ...grpc.CallOption) (*CheckStorageResponse, error) {
+	out := new(CheckStorageResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/CheckStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_ListCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListCommitSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) ScrubStorage(ctx context.Context, in *ScrubStorageRequest, opts ...grpc.CallOption) (API_ScrubStorageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[15], "/pfs_v2.API/ScrubStorage", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListCommitSet(m, &aPIListCommitSetServer{stream})
+	x := &aPIScrubStorageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type API_ListCommitSetServer interface {
-	Send(*CommitSetInfo) error
-	grpc.ServerStream
+type API_ScrubStorageClient interface {
+	Recv() (*ScrubStorageResponse, error)
+	grpc.ClientStream
 }
 
-type aPIListCommitSetServer struct {
-	grpc.ServerStream
+type aPIScrubStorageClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPIListCommitSetServer) Send(m *CommitSetInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (x *aPIScrubStorageClient) Recv() (*ScrubStorageResponse, error) {
+	m := new(ScrubStorageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func _API_SquashCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SquashCommitSetRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error) {
+	out := new(ReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/ReplicationStatus", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).SquashCommitSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/SquashCommitSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SquashCommitSet(ctx, req.(*SquashCommitSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_DropCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropCommitSetRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) GarbageCollectStorage(ctx context.Context, in *GarbageCollectStorageRequest, opts ...grpc.CallOption) (*GarbageCollectStorageResponse, error) {
+	out := new(GarbageCollectStorageResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/GarbageCollectStorage", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DropCommitSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DropCommitSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DropCommitSet(ctx, req.(*DropCommitSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) SetStorageGCPaused(ctx context.Context, in *SetStorageGCPausedRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/SetStorageGCPaused", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/CreateBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) RestoreStorageTrash(ctx context.Context, in *RestoreStorageTrashRequest, opts ...grpc.CallOption) (*RestoreStorageTrashResponse, error) {
+	out := new(RestoreStorageTrashResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RestoreStorageTrash", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_ListBranch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListBranchRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) PutCache(ctx context.Context, in *PutCacheRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/PutCache", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListBranch(m, &aPIListBranchServer{stream})
+	return out, nil
 }
 
-type API_ListBranchServer interface {
-	Send(*BranchInfo) error
-	grpc.ServerStream
+func (c *aPIClient) GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error) {
+	out := new(GetCacheResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/GetCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type aPIListBranchServer struct {
-	grpc.ServerStream
+func (c *aPIClient) ClearCache(ctx context.Context, in *ClearCacheRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/ClearCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPIListBranchServer) Send(m *BranchInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
+	out := new(RunLoadTestResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
+	out := new(RunLoadTestResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTestDefault", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteBranch(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[16], "/pfs_v2.API/ListTask", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DeleteBranch",
+	x := &aPIListTaskClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _API_ModifyFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).ModifyFile(&aPIModifyFileServer{stream})
-}
-
-type API_ModifyFileServer interface {
-	SendAndClose(*types.Empty) error
-	Recv() (*ModifyFileRequest, error)
-	grpc.ServerStream
+	return x, nil
 }
 
-type aPIModifyFileServer struct {
-	grpc.ServerStream
+type API_ListTaskClient interface {
+	Recv() (*task.TaskInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIModifyFileServer) SendAndClose(m *types.Empty) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListTaskClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPIModifyFileServer) Recv() (*ModifyFileRequest, error) {
-	m := new(ModifyFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *aPIListTaskClient) Recv() (*task.TaskInfo, error) {
+	m := new(task.TaskInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
+func (c *aPIClient) Egress(ctx context.Context, in *EgressRequest, opts ...grpc.CallOption) (*EgressResponse, error) {
+	out := new(EgressResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/Egress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type API_GetFileServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+// APIServer is the server API for API service.
+type APIServer interface {
+	// CreateRepo creates a new repo.
+	CreateRepo(context.Context, *CreateRepoRequest) (*types.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(*ListRepoRequest, API_ListRepoServer) error
+	// DeleteRepo deletes a repo.
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(context.Context, *FinishCommitRequest) (*types.Empty, error)
+	// ClearCommit removes all data from the commit.
+	ClearCommit(context.Context, *ClearCommitRequest) (*types.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
+	// InspectCommitCompaction returns the level structure of a commit's file sets.
+	InspectCommitCompaction(context.Context, *InspectCommitCompactionRequest) (*CommitCompactionInfo, error)
+	// ListCommit returns info about all commits.
+	ListCommit(*ListCommitRequest, API_ListCommitServer) error
+	// SubscribeCommit subscribes for new commits on a given branch.
+	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
+	// InspectCommitSet returns the info about a CommitSet.
+	InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error
+	// ListCommitSet returns info about all CommitSets.
+	ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error
+	// SquashCommitSet squashes the commits of a CommitSet into their children.
+	SquashCommitSet(context.Context, *SquashCommitSetRequest) (*types.Empty, error)
+	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
+	DropCommitSet(context.Context, *DropCommitSetRequest) (*types.Empty, error)
+	// CreateBranch creates a new branch.
+	CreateBranch(context.Context, *CreateBranchRequest) (*types.Empty, error)
+	// InspectBranch returns info about a branch.
+	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(*ListBranchRequest, API_ListBranchServer) error
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*types.Empty, error)
+	// ModifyFile performs modifications on a set of files.
+	ModifyFile(API_ModifyFileServer) error
+	// GetFile returns the contents of a single file
+	GetFile(*GetFileRequest, API_GetFileServer) error
+	// GetFileTAR returns a TAR stream of the contents matched by the request
+	GetFileTAR(*GetFileRequest, API_GetFileTARServer) error
+	// InspectFile returns info about a file.
+	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
+	// ListFile returns info about all files.
+	ListFile(*ListFileRequest, API_ListFileServer) error
+	// WalkFile walks over all the files under a directory, including children of children.
+	WalkFile(*WalkFileRequest, API_WalkFileServer) error
+	// GlobFile returns info about all files.
+	GlobFile(*GlobFileRequest, API_GlobFileServer) error
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(*DiffFileRequest, API_DiffFileServer) error
+	// ActivateAuth creates a role binding for all existing repos
+	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
+	// DeleteAll deletes everything.
+	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	// Fsck does a file system consistency check for pfs.
+	Fsck(*FsckRequest, API_FsckServer) error
+	// FileSet API
+	// CreateFileSet creates a new file set.
+	CreateFileSet(API_CreateFileSetServer) error
+	// GetFileSet returns a file set with the data from a commit
+	GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error)
+	// AddFileSet associates a file set with a commit
+	AddFileSet(context.Context, *AddFileSetRequest) (*types.Empty, error)
+	// RenewFileSet prevents a file set from being deleted for a set amount of time.
+	RenewFileSet(context.Context, *RenewFileSetRequest) (*types.Empty, error)
+	// ComposeFileSet composes a file set from a list of file sets.
+	ComposeFileSet(context.Context, *ComposeFileSetRequest) (*CreateFileSetResponse, error)
+	// CheckStorage runs integrity checks for the storage layer.
+	CheckStorage(context.Context, *CheckStorageRequest) (*CheckStorageResponse, error)
+	// ScrubStorage verifies the hash of every chunk object, optionally repairing
+	// bad objects from a replica, and reports the files affected by bad objects.
+	ScrubStorage(*ScrubStorageRequest, API_ScrubStorageServer) error
+	// ReplicationStatus returns the status of the replication of chunk objects
+	// to the replica object stores.
+	ReplicationStatus(context.Context, *ReplicationStatusRequest) (*ReplicationStatusResponse, error)
+	// GarbageCollectStorage runs a pass of garbage collection, or reports what
+	// it would delete.
+	GarbageCollectStorage(context.Context, *GarbageCollectStorageRequest) (*GarbageCollectStorageResponse, error)
+	// SetStorageGCPaused pauses or resumes garbage collection.
+	SetStorageGCPaused(context.Context, *SetStorageGCPausedRequest) (*types.Empty, error)
+	// RestoreStorageTrash moves chunk objects deleted by garbage collection out
+	// of the trash, and tracks them again.
+	RestoreStorageTrash(context.Context, *RestoreStorageTrashRequest) (*RestoreStorageTrashResponse, error)
+	PutCache(context.Context, *PutCacheRequest) (*types.Empty, error)
+	GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error)
+	ClearCache(context.Context, *ClearCacheRequest) (*types.Empty, error)
+	// RunLoadTest runs a load test.
+	RunLoadTest(context.Context, *RunLoadTestRequest) (*RunLoadTestResponse, error)
+	// RunLoadTestDefault runs the default load tests.
+	RunLoadTestDefault(context.Context, *types.Empty) (*RunLoadTestResponse, error)
+	// ListTask lists PFS tasks
+	ListTask(*task.ListTaskRequest, API_ListTaskServer) error
+	// Egress writes data from a commit to an external system
+	Egress(context.Context, *EgressRequest) (*EgressResponse, error)
 }
 
-type aPIGetFileServer struct {
-	grpc.ServerStream
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
 }
 
-func (x *aPIGetFileServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) CreateRepo(ctx context.Context, req *CreateRepoRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
 }
-
-func _API_GetFileTAR_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).GetFileTAR(m, &aPIGetFileTARServer{stream})
+func (*UnimplementedAPIServer) InspectRepo(ctx context.Context, req *InspectRepoRequest) (*RepoInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectRepo not implemented")
 }
-
-type API_GetFileTARServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+func (*UnimplementedAPIServer) ListRepo(req *ListRepoRequest, srv API_ListRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRepo not implemented")
 }
-
-type aPIGetFileTARServer struct {
-	grpc.ServerStream
+func (*UnimplementedAPIServer) DeleteRepo(ctx context.Context, req *DeleteRepoRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
 }
-
-func (x *aPIGetFileTARServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) StartCommit(ctx context.Context, req *StartCommitRequest) (*Commit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
 }
-
-func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedAPIServer) FinishCommit(ctx context.Context, req *FinishCommitRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishCommit not implemented")
 }
-
-func _API_ListFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListFile(m, &aPIListFileServer{stream})
+func (*UnimplementedAPIServer) ClearCommit(ctx context.Context, req *ClearCommitRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCommit not implemented")
 }
-
-type API_ListFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (*UnimplementedAPIServer) InspectCommit(ctx context.Context, req *InspectCommitRequest) (*CommitInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectCommit not implemented")
 }
-
-type aPIListFileServer struct {
-	grpc.ServerStream
+func (*UnimplementedAPIServer) InspectCommitCompaction(ctx context.Context, req *InspectCommitCompactionRequest) (*CommitCompactionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectCommitCompaction not implemented")
 }
-
-func (x *aPIListFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) ListCommit(req *ListCommitRequest, srv API_ListCommitServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCommit not implemented")
 }
-
-func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WalkFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
+func (*UnimplementedAPIServer) SubscribeCommit(req *SubscribeCommitRequest, srv API_SubscribeCommitServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeCommit not implemented")
 }
-
-type API_WalkFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (*UnimplementedAPIServer) InspectCommitSet(req *InspectCommitSetRequest, srv API_InspectCommitSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method InspectCommitSet not implemented")
 }
-
-type aPIWalkFileServer struct {
-	grpc.ServerStream
+func (*UnimplementedAPIServer) ListCommitSet(req *ListCommitSetRequest, srv API_ListCommitSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCommitSet not implemented")
 }
-
-func (x *aPIWalkFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) SquashCommitSet(ctx context.Context, req *SquashCommitSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SquashCommitSet not implemented")
 }
-
-func _API_GlobFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GlobFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).GlobFile(m, &aPIGlobFileServer{stream})
+func (*UnimplementedAPIServer) DropCommitSet(ctx context.Context, req *DropCommitSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropCommitSet not implemented")
 }
-
-type API_GlobFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (*UnimplementedAPIServer) CreateBranch(ctx context.Context, req *CreateBranchRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
 }
-
-type aPIGlobFileServer struct {
-	grpc.ServerStream
+func (*UnimplementedAPIServer) InspectBranch(ctx context.Context, req *InspectBranchRequest) (*BranchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectBranch not implemented")
 }
-
-func (x *aPIGlobFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) ListBranch(req *ListBranchRequest, srv API_ListBranchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBranch not implemented")
 }
-
-func _API_DiffFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DiffFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).DiffFile(m, &aPIDiffFileServer{stream})
+func (*UnimplementedAPIServer) DeleteBranch(ctx context.Context, req *DeleteBranchRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
 }
-
-type API_DiffFileServer interface {
-	Send(*DiffFileResponse) error
-	grpc.ServerStream
+func (*UnimplementedAPIServer) ModifyFile(srv API_ModifyFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ModifyFile not implemented")
 }
-
-type aPIDiffFileServer struct {
-	grpc.ServerStream
+func (*UnimplementedAPIServer) GetFile(req *GetFileRequest, srv API_GetFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFile not implemented")
 }
-
-func (x *aPIDiffFileServer) Send(m *DiffFileResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) GetFileTAR(req *GetFileRequest, srv API_GetFileTARServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFileTAR not implemented")
 }
-
-func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateAuthRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ActivateAuth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/ActivateAuth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedAPIServer) InspectFile(ctx context.Context, req *InspectFileRequest) (*FileInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectFile not implemented")
 }
-
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DeleteAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedAPIServer) ListFile(req *ListFileRequest, srv API_ListFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFile not implemented")
 }
-
-func _API_Fsck_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FsckRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).Fsck(m, &aPIFsckServer{stream})
+func (*UnimplementedAPIServer) WalkFile(req *WalkFileRequest, srv API_WalkFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method WalkFile not implemented")
 }
-
-type API_FsckServer interface {
-	Send(*FsckResponse) error
-	grpc.ServerStream
+func (*UnimplementedAPIServer) GlobFile(req *GlobFileRequest, srv API_GlobFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GlobFile not implemented")
 }
-
-type aPIFsckServer struct {
-	grpc.ServerStream
+func (*UnimplementedAPIServer) DiffFile(req *DiffFileRequest, srv API_DiffFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DiffFile not implemented")
 }
-
-func (x *aPIFsckServer) Send(m *FsckResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
 }
-
-func _API_CreateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).CreateFileSet(&aPICreateFileSetServer{stream})
+func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
 }
-
-type API_CreateFileSetServer interface {
-	SendAndClose(*CreateFileSetResponse) error
-	Recv() (*ModifyFileRequest, error)
-	grpc.ServerStream
+func (*UnimplementedAPIServer) Fsck(req *FsckRequest, srv API_FsckServer) error {
+	return status.Errorf(codes.Unimplemented, "method Fsck not implemented")
 }
-
-type aPICreateFileSetServer struct {
-	grpc.ServerStream
+func (*UnimplementedAPIServer) CreateFileSet(srv API_CreateFileSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateFileSet not implemented")
 }
-
-func (x *aPICreateFileSetServer) SendAndClose(m *CreateFileSetResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAPIServer) GetFileSet(ctx context.Context, req *GetFileSetRequest) (*CreateFileSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileSet not implemented")
+}
+func (*UnimplementedAPIServer) AddFileSet(ctx context.Context, req *AddFileSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFileSet not implemented")
+}
+func (*UnimplementedAPIServer) RenewFileSet(ctx context.Context, req *RenewFileSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewFileSet not implemented")
+}
+func (*UnimplementedAPIServer) ComposeFileSet(ctx context.Context, req *ComposeFileSetRequest) (*CreateFileSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComposeFileSet not implemented")
+}
+func (*UnimplementedAPIServer) CheckStorage(ctx context.Context, req *CheckStorageRequest) (*CheckStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckStorage not implemented")
+}
+func (*UnimplementedAPIServer) ScrubStorage(req *ScrubStorageRequest, srv API_ScrubStorageServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScrubStorage not implemented")
+}
+func (*UnimplementedAPIServer) ReplicationStatus(ctx context.Context, req *ReplicationStatusRequest) (*ReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicationStatus not implemented")
+}
+func (*UnimplementedAPIServer) GarbageCollectStorage(ctx context.Context, req *GarbageCollectStorageRequest) (*GarbageCollectStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GarbageCollectStorage not implemented")
+}
+func (*UnimplementedAPIServer) SetStorageGCPaused(ctx context.Context, req *SetStorageGCPausedRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStorageGCPaused not implemented")
+}
+func (*UnimplementedAPIServer) RestoreStorageTrash(ctx context.Context, req *RestoreStorageTrashRequest) (*RestoreStorageTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStorageTrash not implemented")
+}
+func (*UnimplementedAPIServer) PutCache(ctx context.Context, req *PutCacheRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutCache not implemented")
+}
+func (*UnimplementedAPIServer) GetCache(ctx context.Context, req *GetCacheRequest) (*GetCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCache not implemented")
+}
+func (*UnimplementedAPIServer) ClearCache(ctx context.Context, req *ClearCacheRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCache not implemented")
+}
+func (*UnimplementedAPIServer) RunLoadTest(ctx context.Context, req *RunLoadTestRequest) (*RunLoadTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTest not implemented")
+}
+func (*UnimplementedAPIServer) RunLoadTestDefault(ctx context.Context, req *types.Empty) (*RunLoadTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTestDefault not implemented")
+}
+func (*UnimplementedAPIServer) ListTask(req *task.ListTaskRequest, srv API_ListTaskServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTask not implemented")
+}
+func (*UnimplementedAPIServer) Egress(ctx context.Context, req *EgressRequest) (*EgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Egress not implemented")
 }
 
-func (x *aPICreateFileSetServer) Recv() (*ModifyFileRequest, error) {
-	m := new(ModifyFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
 }
 
-func _API_GetFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileSetRequest)
+func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).GetFileSet(ctx, in)
+		return srv.(APIServer).CreateRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/GetFileSet",
+		FullMethod: "/pfs_v2.API/CreateRepo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetFileSet(ctx, req.(*GetFileSetRequest))
+		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_AddFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFileSetRequest)
+func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRepoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).AddFileSet(ctx, in)
+		return srv.(APIServer).InspectRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/AddFileSet",
+		FullMethod: "/pfs_v2.API/InspectRepo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).AddFileSet(ctx, req.(*AddFileSetRequest))
+		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_RenewFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewFileSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).RenewFileSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/RenewFileSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RenewFileSet(ctx, req.(*RenewFileSetRequest))
+func _API_ListRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(APIServer).ListRepo(m, &aPIListRepoServer{stream})
 }
 
-func _API_ComposeFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ComposeFileSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ComposeFileSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/ComposeFileSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ComposeFileSet(ctx, req.(*ComposeFileSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type API_ListRepoServer interface {
+	Send(*RepoInfo) error
+	grpc.ServerStream
 }
 
-func _API_CheckStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckStorageRequest)
+type aPIListRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListRepoServer) Send(m *RepoInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).CheckStorage(ctx, in)
+		return srv.(APIServer).DeleteRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/CheckStorage",
+		FullMethod: "/pfs_v2.API/DeleteRepo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CheckStorage(ctx, req.(*CheckStorageRequest))
+		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_PutCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutCacheRequest)
+func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).PutCache(ctx, in)
+		return srv.(APIServer).StartCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/PutCache",
+		FullMethod: "/pfs_v2.API/StartCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).PutCache(ctx, req.(*PutCacheRequest))
+		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCacheRequest)
+func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).GetCache(ctx, in)
+		return srv.(APIServer).FinishCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/GetCache",
+		FullMethod: "/pfs_v2.API/FinishCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetCache(ctx, req.(*GetCacheRequest))
+		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ClearCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearCacheRequest)
+func _API_ClearCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ClearCache(ctx, in)
+		return srv.(APIServer).ClearCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/ClearCache",
+		FullMethod: "/pfs_v2.API/ClearCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ClearCache(ctx, req.(*ClearCacheRequest))
+		return srv.(APIServer).ClearCommit(ctx, req.(*ClearCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_RunLoadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RunLoadTestRequest)
+func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).RunLoadTest(ctx, in)
+		return srv.(APIServer).InspectCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/RunLoadTest",
+		FullMethod: "/pfs_v2.API/InspectCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunLoadTest(ctx, req.(*RunLoadTestRequest))
+		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_RunLoadTestDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func _API_InspectCommitCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCommitCompactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).RunLoadTestDefault(ctx, in)
+		return srv.(APIServer).InspectCommitCompaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/RunLoadTestDefault",
+		FullMethod: "/pfs_v2.API/InspectCommitCompaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunLoadTestDefault(ctx, req.(*types.Empty))
+		return srv.(APIServer).InspectCommitCompaction(ctx, req.(*InspectCommitCompactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListTask_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(task.ListTaskRequest)
+func _API_ListCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).ListTask(m, &aPIListTaskServer{stream})
+	return srv.(APIServer).ListCommit(m, &aPIListCommitServer{stream})
 }
 
-type API_ListTaskServer interface {
-	Send(*task.TaskInfo) error
+type API_ListCommitServer interface {
+	Send(*CommitInfo) error
 	grpc.ServerStream
 }
 
-type aPIListTaskServer struct {
+type aPIListCommitServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIListTaskServer) Send(m *task.TaskInfo) error {
+func (x *aPIListCommitServer) Send(m *CommitInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _API_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EgressRequest)
+func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
+}
+
+type API_SubscribeCommitServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPISubscribeCommitServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_InspectCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InspectCommitSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).InspectCommitSet(m, &aPIInspectCommitSetServer{stream})
+}
+
+type API_InspectCommitSetServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPIInspectCommitSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIInspectCommitSetServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ListCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListCommitSet(m, &aPIListCommitSetServer{stream})
+}
+
+type API_ListCommitSetServer interface {
+	Send(*CommitSetInfo) error
+	grpc.ServerStream
+}
+
+type aPIListCommitSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListCommitSetServer) Send(m *CommitSetInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SquashCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquashCommitSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).Egress(ctx, in)
+		return srv.(APIServer).SquashCommitSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/Egress",
+		FullMethod: "/pfs_v2.API/SquashCommitSet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Egress(ctx, req.(*EgressRequest))
+		return srv.(APIServer).SquashCommitSet(ctx, req.(*SquashCommitSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pfs_v2.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRepo",
-			Handler:    _API_CreateRepo_Handler,
-		},
-		{
-			MethodName: "InspectRepo",
-			Handler:    _API_InspectRepo_Handler,
-		},
-		{
-			MethodName: "DeleteRepo",
-			Handler:    _API_DeleteRepo_Handler,
-		},
-		{
-			MethodName: "StartCommit",
-			Handler:    _API_StartCommit_Handler,
-		},
-		{
-			MethodName: "FinishCommit",
-			Handler:    _API_FinishCommit_Handler,
-		},
-		{
-			MethodName: "ClearCommit",
-			Handler:    _API_ClearCommit_Handler,
-		},
-		{
-			MethodName: "InspectCommit",
-			Handler:    _API_InspectCommit_Handler,
-		},
-		{
-			MethodName: "SquashCommitSet",
-			Handler:    _API_SquashCommitSet_Handler,
-		},
-		{
-			MethodName: "DropCommitSet",
-			Handler:    _API_DropCommitSet_Handler,
-		},
-		{
-			MethodName: "CreateBranch",
-			Handler:    _API_CreateBranch_Handler,
-		},
-		{
-			MethodName: "InspectBranch",
-			Handler:    _API_InspectBranch_Handler,
-		},
-		{
-			MethodName: "DeleteBranch",
-			Handler:    _API_DeleteBranch_Handler,
-		},
-		{
-			MethodName: "InspectFile",
-			Handler:    _API_InspectFile_Handler,
-		},
-		{
-			MethodName: "ActivateAuth",
-			Handler:    _API_ActivateAuth_Handler,
-		},
-		{
-			MethodName: "DeleteAll",
-			Handler:    _API_DeleteAll_Handler,
-		},
-		{
-			MethodName: "GetFileSet",
-			Handler:    _API_GetFileSet_Handler,
-		},
-		{
-			MethodName: "AddFileSet",
-			Handler:    _API_AddFileSet_Handler,
-		},
-		{
-			MethodName: "RenewFileSet",
-			Handler:    _API_RenewFileSet_Handler,
-		},
-		{
-			MethodName: "ComposeFileSet",
-			Handler:    _API_ComposeFileSet_Handler,
-		},
-		{
-			MethodName: "CheckStorage",
-			Handler:    _API_CheckStorage_Handler,
-		},
-		{
-			MethodName: "PutCache",
-			Handler:    _API_PutCache_Handler,
-		},
-		{
-			MethodName: "GetCache",
-			Handler:    _API_GetCache_Handler,
-		},
-		{
-			MethodName: "ClearCache",
-			Handler:    _API_ClearCache_Handler,
-		},
-		{
-			MethodName: "RunLoadTest",
-			Handler:    _API_RunLoadTest_Handler,
-		},
-		{
-			MethodName: "RunLoadTestDefault",
-			Handler:    _API_RunLoadTestDefault_Handler,
-		},
-		{
-			MethodName: "Egress",
-			Handler:    _API_Egress_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ListRepo",
-			Handler:       _API_ListRepo_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListCommit",
-			Handler:       _API_ListCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "SubscribeCommit",
-			Handler:       _API_SubscribeCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "InspectCommitSet",
-			Handler:       _API_InspectCommitSet_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListCommitSet",
-			Handler:       _API_ListCommitSet_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListBranch",
-			Handler:       _API_ListBranch_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ModifyFile",
-			Handler:       _API_ModifyFile_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "GetFile",
-			Handler:       _API_GetFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetFileTAR",
-			Handler:       _API_GetFileTAR_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListFile",
-			Handler:       _API_ListFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WalkFile",
-			Handler:       _API_WalkFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GlobFile",
-			Handler:       _API_GlobFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "DiffFile",
-			Handler:       _API_DiffFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Fsck",
-			Handler:       _API_Fsck_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "CreateFileSet",
-			Handler:       _API_CreateFileSet_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ListTask",
-			Handler:       _API_ListTask_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pfs/pfs.proto",
-}
-
-func (m *Repo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_DropCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCommitSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Repo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).DropCommitSet(ctx, in)
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DropCommitSet",
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DropCommitSet(ctx, req.(*DropCommitSetRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Branch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Branch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).CreateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/CreateBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Branch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).InspectBranch(ctx, in)
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectBranch",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *File) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListBranch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBranchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListBranch(m, &aPIListBranchServer{stream})
 }
 
-func (m *File) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListBranchServer interface {
+	Send(*BranchInfo) error
+	grpc.ServerStream
 }
 
-func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIListBranchServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListBranchServer) Send(m *BranchInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).DeleteBranch(ctx, in)
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DeleteBranch",
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_ModifyFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).ModifyFile(&aPIModifyFileServer{stream})
+}
+
+type API_ModifyFileServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*ModifyFileRequest, error)
+	grpc.ServerStream
+}
+
+type aPIModifyFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIModifyFileServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIModifyFileServer) Recv() (*ModifyFileRequest, error) {
+	m := new(ModifyFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
 }
 
-func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type API_GetFileServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
+}
+
+type aPIGetFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetFileServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GetFileTAR_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.AuthInfo != nil {
-		{
-			size, err := m.AuthInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Branches) > 0 {
-		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+	return srv.(APIServer).GetFileTAR(m, &aPIGetFileTARServer{stream})
+}
+
+type API_GetFileTARServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
+}
+
+type aPIGetFileTARServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetFileTARServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytesUpperBound != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(APIServer).InspectFile(ctx, in)
 	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectFile",
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo_Details) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListFile(m, &aPIListFileServer{stream})
 }
 
-func (m *RepoInfo_Details) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *RepoInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIListFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WalkFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-		i--
-		dAtA[i] = 0x8
+	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
+}
+
+type API_WalkFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type aPIWalkFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIWalkFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GlobFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GlobFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(APIServer).GlobFile(m, &aPIGlobFileServer{stream})
 }
 
-func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type API_GlobFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type aPIGlobFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGlobFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DiffFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiffFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).DiffFile(m, &aPIDiffFileServer{stream})
 }
 
-func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_DiffFileServer interface {
+	Send(*DiffFileResponse) error
+	grpc.ServerStream
 }
 
-func (m *RepoAuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIDiffFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIDiffFileServer) Send(m *DiffFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Roles) > 0 {
-		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Roles[iNdEx])
-			copy(dAtA[i:], m.Roles[iNdEx])
-			i = encodeVarintPfs(dAtA, i, uint64(len(m.Roles[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(APIServer).ActivateAuth(ctx, in)
 	}
-	if len(m.Permissions) > 0 {
-		dAtA8 := make([]byte, len(m.Permissions)*10)
-		var j7 int
-		for _, num := range m.Permissions {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintPfs(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/ActivateAuth",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DeleteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_Fsck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FsckRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Fsck(m, &aPIFsckServer{stream})
 }
 
-func (m *BranchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Trigger != nil {
-		{
-			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.DirectProvenance) > 0 {
-		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Subvenance) > 0 {
-		for iNdEx := len(m.Subvenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Provenance) > 0 {
-		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type API_FsckServer interface {
+	Send(*FsckResponse) error
+	grpc.ServerStream
 }
 
-func (m *Trigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIFsckServer struct {
+	grpc.ServerStream
 }
 
-func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *aPIFsckServer) Send(m *FsckResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Commits != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commits))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Size_) > 0 {
-		i -= len(m.Size_)
-		copy(dAtA[i:], m.Size_)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Size_)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CronSpec) > 0 {
-		i -= len(m.CronSpec)
-		copy(dAtA[i:], m.CronSpec)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.CronSpec)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func _API_CreateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).CreateFileSet(&aPICreateFileSetServer{stream})
 }
 
-func (m *CommitOrigin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type API_CreateFileSetServer interface {
+	SendAndClose(*CreateFileSetResponse) error
+	Recv() (*ModifyFileRequest, error)
+	grpc.ServerStream
 }
 
-func (m *CommitOrigin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type aPICreateFileSetServer struct {
+	grpc.ServerStream
 }
 
-func (m *CommitOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Kind != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Kind))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (x *aPICreateFileSetServer) SendAndClose(m *CreateFileSetResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *Commit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *aPICreateFileSetServer) Recv() (*ModifyFileRequest, error) {
+	m := new(ModifyFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_GetFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).GetFileSet(ctx, in)
 	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/GetFileSet",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetFileSet(ctx, req.(*GetFileSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_AddFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFileSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).AddFileSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/AddFileSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddFileSet(ctx, req.(*AddFileSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+func _API_RenewFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewFileSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytesUpperBound != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
-		i--
-		dAtA[i] = 0x58
+	if interceptor == nil {
+		return srv.(APIServer).RenewFileSet(ctx, in)
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x52
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RenewFileSet",
 	}
-	if len(m.DirectProvenance) > 0 {
-		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenewFileSet(ctx, req.(*RenewFileSetRequest))
 	}
-	if m.Finished != nil {
-		{
-			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ComposeFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComposeFileSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Finishing != nil {
-		{
-			size, err := m.Finishing.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(APIServer).ComposeFileSet(ctx, in)
 	}
-	if m.Started != nil {
-		{
-			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/ComposeFileSet",
 	}
-	if len(m.ChildCommits) > 0 {
-		for iNdEx := len(m.ChildCommits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ChildCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ComposeFileSet(ctx, req.(*ComposeFileSetRequest))
 	}
-	if m.ParentCommit != nil {
-		{
-			size, err := m.ParentCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CheckStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).CheckStorage(ctx, in)
 	}
-	if m.Origin != nil {
-		{
-			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/CheckStorage",
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CheckStorage(ctx, req.(*CheckStorageRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo_Details) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ScrubStorage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScrubStorageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ScrubStorage(m, &aPIScrubStorageServer{stream})
 }
 
-func (m *CommitInfo_Details) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ScrubStorageServer interface {
+	Send(*ScrubStorageResponse) error
+	grpc.ServerStream
 }
 
-func (m *CommitInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIScrubStorageServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIScrubStorageServer) Send(m *ScrubStorageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ValidatingTime != nil {
-		{
-			size, err := m.ValidatingTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).ReplicationStatus(ctx, in)
 	}
-	if m.CompactingTime != nil {
-		{
-			size, err := m.CompactingTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/ReplicationStatus",
 	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ReplicationStatus(ctx, req.(*ReplicationStatusRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_GarbageCollectStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GarbageCollectStorageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommitSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).GarbageCollectStorage(ctx, in)
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/GarbageCollectStorage",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GarbageCollectStorage(ctx, req.(*GarbageCollectStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitSetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_SetStorageGCPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageGCPausedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommitSetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CommitSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).SetStorageGCPaused(ctx, in)
 	}
-	if len(m.Commits) > 0 {
-		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/SetStorageGCPaused",
 	}
-	if m.CommitSet != nil {
-		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetStorageGCPaused(ctx, req.(*SetStorageGCPausedRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FileInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RestoreStorageTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreStorageTrashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).RestoreStorageTrash(ctx, in)
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RestoreStorageTrash",
 	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RestoreStorageTrash(ctx, req.(*RestoreStorageTrashRequest))
 	}
-	if m.Committed != nil {
-		{
-			size, err := m.Committed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PutCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FileType != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(APIServer).PutCache(ctx, in)
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/PutCache",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PutCache(ctx, req.(*PutCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).GetCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/GetCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetCache(ctx, req.(*GetCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_ClearCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Update {
-		i--
-		if m.Update {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(APIServer).ClearCache(ctx, in)
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/ClearCache",
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ClearCache(ctx, req.(*ClearCacheRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RunLoadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunLoadTestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).RunLoadTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RunLoadTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunLoadTest(ctx, req.(*RunLoadTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InspectRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RunLoadTestDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).RunLoadTestDefault(ctx, in)
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RunLoadTestDefault",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunLoadTestDefault(ctx, req.(*types.Empty))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _API_ListTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(task.ListTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(APIServer).ListTask(m, &aPIListTaskServer{stream})
 }
 
-func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type API_ListTaskServer interface {
+	Send(*task.TaskInfo) error
+	grpc.ServerStream
 }
 
-func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type aPIListTaskServer struct {
+	grpc.ServerStream
 }
 
-func (m *StartCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Parent != nil {
-		{
-			size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (x *aPIListTaskServer) Send(m *task.TaskInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_Egress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EgressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FinishCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).Egress(ctx, in)
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/Egress",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Egress(ctx, req.(*EgressRequest))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pfs_v2.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRepo",
+			Handler:    _API_CreateRepo_Handler,
+		},
+		{
+			MethodName: "InspectRepo",
+			Handler:    _API_InspectRepo_Handler,
+		},
+		{
+			MethodName: "DeleteRepo",
+			Handler:    _API_DeleteRepo_Handler,
+		},
+		{
+			MethodName: "StartCommit",
+			Handler:    _API_StartCommit_Handler,
+		},
+		{
+			MethodName: "FinishCommit",
+			Handler:    _API_FinishCommit_Handler,
+		},
+		{
+			MethodName: "ClearCommit",
+			Handler:    _API_ClearCommit_Handler,
+		},
+		{
+			MethodName: "InspectCommit",
+			Handler:    _API_InspectCommit_Handler,
+		},
+		{
+			MethodName: "InspectCommitCompaction",
+			Handler:    _API_InspectCommitCompaction_Handler,
+		},
+		{
+			MethodName: "SquashCommitSet",
+			Handler:    _API_SquashCommitSet_Handler,
+		},
+		{
+			MethodName: "DropCommitSet",
+			Handler:    _API_DropCommitSet_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _API_CreateBranch_Handler,
+		},
+		{
+			MethodName: "InspectBranch",
+			Handler:    _API_InspectBranch_Handler,
+		},
+		{
+			MethodName: "DeleteBranch",
+			Handler:    _API_DeleteBranch_Handler,
+		},
+		{
+			MethodName: "InspectFile",
+			Handler:    _API_InspectFile_Handler,
+		},
+		{
+			MethodName: "ActivateAuth",
+			Handler:    _API_ActivateAuth_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _API_DeleteAll_Handler,
+		},
+		{
+			MethodName: "GetFileSet",
+			Handler:    _API_GetFileSet_Handler,
+		},
+		{
+			MethodName: "AddFileSet",
+			Handler:    _API_AddFileSet_Handler,
+		},
+		{
+			MethodName: "RenewFileSet",
+			Handler:    _API_RenewFileSet_Handler,
+		},
+		{
+			MethodName: "ComposeFileSet",
+			Handler:    _API_ComposeFileSet_Handler,
+		},
+		{
+			MethodName: "CheckStorage",
+			Handler:    _API_CheckStorage_Handler,
+		},
+		{
+			MethodName: "ReplicationStatus",
+			Handler:    _API_ReplicationStatus_Handler,
+		},
+		{
+			MethodName: "GarbageCollectStorage",
+			Handler:    _API_GarbageCollectStorage_Handler,
+		},
+		{
+			MethodName: "SetStorageGCPaused",
+			Handler:    _API_SetStorageGCPaused_Handler,
+		},
+		{
+			MethodName: "RestoreStorageTrash",
+			Handler:    _API_RestoreStorageTrash_Handler,
+		},
+		{
+			MethodName: "PutCache",
+			Handler:    _API_PutCache_Handler,
+		},
+		{
+			MethodName: "GetCache",
+			Handler:    _API_GetCache_Handler,
+		},
+		{
+			MethodName: "ClearCache",
+			Handler:    _API_ClearCache_Handler,
+		},
+		{
+			MethodName: "RunLoadTest",
+			Handler:    _API_RunLoadTest_Handler,
+		},
+		{
+			MethodName: "RunLoadTestDefault",
+			Handler:    _API_RunLoadTestDefault_Handler,
+		},
+		{
+			MethodName: "Egress",
+			Handler:    _API_Egress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListRepo",
+			Handler:       _API_ListRepo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCommit",
+			Handler:       _API_ListCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCommit",
+			Handler:       _API_SubscribeCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InspectCommitSet",
+			Handler:       _API_InspectCommitSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCommitSet",
+			Handler:       _API_ListCommitSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBranch",
+			Handler:       _API_ListBranch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ModifyFile",
+			Handler:       _API_ModifyFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetFile",
+			Handler:       _API_GetFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFileTAR",
+			Handler:       _API_GetFileTAR_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFile",
+			Handler:       _API_ListFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WalkFile",
+			Handler:       _API_WalkFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GlobFile",
+			Handler:       _API_GlobFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DiffFile",
+			Handler:       _API_DiffFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Fsck",
+			Handler:       _API_Fsck_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CreateFileSet",
+			Handler:       _API_CreateFileSet_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ScrubStorage",
+			Handler:       _API_ScrubStorage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTask",
+			Handler:       _API_ListTask_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pfs/pfs.proto",
+}
+
+func (m *Repo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Repo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8133,27 +8635,24 @@ func (m *InspectCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Wait != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Wait))
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *Branch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8163,12 +8662,12 @@ func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Branch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Branch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8177,39 +8676,16 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.OriginKind != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Number != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
-	if m.To != nil {
+	if m.Repo != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8217,23 +8693,52 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *File) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *File) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Repo != nil {
+	if m.Commit != nil {
 		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8246,7 +8751,7 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectCommitSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8256,12 +8761,12 @@ func (m *InspectCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8270,19 +8775,95 @@ func (m *InspectCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Wait {
+	if m.Compaction != nil {
+		{
+			size, err := m.Compaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x4a
+	}
+	if m.Chunking != nil {
+		{
+			size, err := m.Chunking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x42
 	}
-	if m.CommitSet != nil {
+	if m.Details != nil {
 		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AuthInfo != nil {
+		{
+			size, err := m.AuthInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SizeBytesUpperBound != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8295,7 +8876,7 @@ func (m *InspectCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ListCommitSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoInfo_Details) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8305,12 +8886,12 @@ func (m *ListCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoInfo_Details) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8319,10 +8900,15 @@ func (m *ListCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SquashCommitSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *ChunkingSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8332,12 +8918,12 @@ func (m *SquashCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SquashCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChunkingSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SquashCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChunkingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8346,22 +8932,30 @@ func (m *SquashCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitSet != nil {
-		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if m.MaxSizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxSizeBytes))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x20
+	}
+	if m.MinSizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.MinSizeBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AverageSizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.AverageSizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Algorithm != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DropCommitSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *CompactionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8371,12 +8965,12 @@ func (m *DropCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactionSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8385,22 +8979,27 @@ func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitSet != nil {
-		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Window)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if m.DeferSizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.DeferSizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LevelFactor != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.LevelFactor))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8410,12 +9009,12 @@ func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscribeCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoAuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8424,61 +9023,37 @@ func (m *SubscribeCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.OriginKind != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.State != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Permissions) > 0 {
+		dAtA10 := make([]byte, len(m.Permissions)*10)
+		var j9 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
 			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintPfs(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClearCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8488,12 +9063,12 @@ func (m *ClearCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClearCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BranchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8502,9 +9077,9 @@ func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Commit != nil {
+	if m.Trigger != nil {
 		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8512,56 +9087,35 @@ func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x32
 	}
-	if m.NewCommitSet {
-		i--
-		if m.NewCommitSet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.DirectProvenance) > 0 {
+		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if m.Trigger != nil {
-		{
-			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Subvenance) > 0 {
+		for iNdEx := len(m.Subvenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x22
 	}
 	if len(m.Provenance) > 0 {
 		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
@@ -8577,9 +9131,9 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if m.Branch != nil {
+	if m.Head != nil {
 		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8589,9 +9143,9 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Head != nil {
+	if m.Branch != nil {
 		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8604,7 +9158,7 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8614,12 +9168,12 @@ func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8628,22 +9182,46 @@ func (m *InspectBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
+	if m.Commits != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Size_) > 0 {
+		i -= len(m.Size_)
+		copy(dAtA[i:], m.Size_)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Size_)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CronSpec) > 0 {
+		i -= len(m.CronSpec)
+		copy(dAtA[i:], m.CronSpec)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.CronSpec)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommitOrigin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8653,12 +9231,12 @@ func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitOrigin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8667,32 +9245,15 @@ func (m *ListBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if m.Kind != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Kind))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *Commit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8702,12 +9263,12 @@ func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8716,15 +9277,12 @@ func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.Branch != nil {
 		{
@@ -8741,7 +9299,7 @@ func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AddFile) Marshal() (dAtA []byte, err error) {
+func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8751,12 +9309,12 @@ func (m *AddFile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8765,42 +9323,47 @@ func (m *AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Source != nil {
+	if m.Details != nil {
 		{
-			size := m.Source.Size()
-			i -= size
-			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+	if m.SizeBytesUpperBound != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x58
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddFile_Raw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddFile_Raw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Raw != nil {
+	if len(m.DirectProvenance) > 0 {
+		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Finished != nil {
 		{
-			size, err := m.Raw.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8808,20 +9371,49 @@ func (m *AddFile_Raw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-func (m *AddFile_Url) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddFile_Url) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Url != nil {
+	if m.Finishing != nil {
 		{
-			size, err := m.Url.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Finishing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChildCommits) > 0 {
+		for iNdEx := len(m.ChildCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChildCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ParentCommit != nil {
+		{
+			size, err := m.ParentCommit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8831,9 +9423,41 @@ func (m *AddFile_Url) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Origin != nil {
+		{
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
-func (m *AddFile_URLSource) Marshal() (dAtA []byte, err error) {
+
+func (m *CommitInfo_Details) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8843,12 +9467,12 @@ func (m *AddFile_URLSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddFile_URLSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitInfo_Details) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddFile_URLSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8857,27 +9481,49 @@ func (m *AddFile_URLSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Recursive {
+	if m.CompactionDeferred {
 		i--
-		if m.Recursive {
+		if m.CompactionDeferred {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
+	if m.ValidatingTime != nil {
+		{
+			size, err := m.ValidatingTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if m.CompactingTime != nil {
+		{
+			size, err := m.CompactingTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteFile) Marshal() (dAtA []byte, err error) {
+func (m *CommitSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8887,12 +9533,12 @@ func (m *DeleteFile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8901,24 +9547,17 @@ func (m *DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CopyFile) Marshal() (dAtA []byte, err error) {
+func (m *CommitSetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8928,12 +9567,12 @@ func (m *CopyFile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CopyFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitSetInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8942,19 +9581,23 @@ func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Append {
-		i--
-		if m.Append {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if m.Src != nil {
+	if m.CommitSet != nil {
 		{
-			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8962,26 +9605,12 @@ func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Dst) > 0 {
-		i -= len(m.Dst)
-		copy(dAtA[i:], m.Dst)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Dst)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModifyFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *FileInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8991,12 +9620,12 @@ func (m *ModifyFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModifyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModifyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9005,28 +9634,33 @@ func (m *ModifyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Body != nil {
+	if m.Attributes != nil {
 		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ModifyFileRequest_SetCommit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModifyFileRequest_SetCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SetCommit != nil {
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Committed != nil {
 		{
-			size, err := m.SetCommit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Committed.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9034,41 +9668,16 @@ func (m *ModifyFileRequest_SetCommit) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ModifyFileRequest_AddFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModifyFileRequest_AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.AddFile != nil {
-		{
-			size, err := m.AddFile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if m.FileType != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ModifyFileRequest_DeleteFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModifyFileRequest_DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DeleteFile != nil {
+	if m.File != nil {
 		{
-			size, err := m.DeleteFile.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9076,32 +9685,12 @@ func (m *ModifyFileRequest_DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ModifyFileRequest_CopyFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ModifyFileRequest_CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CopyFile != nil {
-		{
-			size, err := m.CopyFile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
-}
-func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *FileAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9111,12 +9700,12 @@ func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FileAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9125,21 +9714,42 @@ func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Offset))
+	if len(m.Xattrs) > 0 {
+		for k := range m.Xattrs {
+			v := m.Xattrs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ContentType)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.File != nil {
+	if m.Mtime != nil {
 		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Mtime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9147,12 +9757,17 @@ func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Mode != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9162,12 +9777,12 @@ func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9176,9 +9791,9 @@ func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.File != nil {
+	if m.Compaction != nil {
 		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Compaction.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9186,38 +9801,40 @@ func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Chunking != nil {
+		{
+			size, err := m.Chunking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Update {
+		i--
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.File != nil {
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
 		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9230,7 +9847,7 @@ func (m *ListFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9240,12 +9857,12 @@ func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WalkFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9254,9 +9871,9 @@ func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.File != nil {
+	if m.Repo != nil {
 		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9269,7 +9886,7 @@ func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9279,12 +9896,12 @@ func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GlobFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9293,29 +9910,17 @@ func (m *GlobFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Pattern) > 0 {
-		i -= len(m.Pattern)
-		copy(dAtA[i:], m.Pattern)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9325,12 +9930,12 @@ func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9339,31 +9944,19 @@ func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Shallow {
+	if m.Force {
 		i--
-		if m.Shallow {
+		if m.Force {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.OldFile != nil {
-		{
-			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.NewFile != nil {
+	if m.Repo != nil {
 		{
-			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9376,7 +9969,7 @@ func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9386,12 +9979,12 @@ func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9400,9 +9993,9 @@ func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.OldFile != nil {
+	if m.Branch != nil {
 		{
-			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9410,11 +10003,18 @@ func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if m.NewFile != nil {
+	if m.Parent != nil {
 		{
-			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9427,7 +10027,7 @@ func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FsckRequest) Marshal() (dAtA []byte, err error) {
+func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9437,12 +10037,12 @@ func (m *FsckRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FsckRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FsckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FinishCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9451,61 +10051,46 @@ func (m *FsckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Fix {
+	if m.Force {
 		i--
-		if m.Fix {
+		if m.Force {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FsckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *FsckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FsckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x20
 	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Fix) > 0 {
-		i -= len(m.Fix)
-		copy(dAtA[i:], m.Fix)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Fix)))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9515,12 +10100,12 @@ func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateFileSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9529,39 +10114,10 @@ func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FileSetId) > 0 {
-		i -= len(m.FileSetId)
-		copy(dAtA[i:], m.FileSetId)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+	if m.Wait != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Wait))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GetFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x10
 	}
 	if m.Commit != nil {
 		{
@@ -9578,7 +10134,7 @@ func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectCommitCompactionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9588,12 +10144,12 @@ func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectCommitCompactionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectCommitCompactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9602,13 +10158,6 @@ func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FileSetId) > 0 {
-		i -= len(m.FileSetId)
-		copy(dAtA[i:], m.FileSetId)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.Commit != nil {
 		{
 			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
@@ -9624,7 +10173,7 @@ func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *CompactionLevel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9634,12 +10183,12 @@ func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RenewFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactionLevel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactionLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9648,8 +10197,18 @@ func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TtlSeconds != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
+	if m.Compacted {
+		i--
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -9663,7 +10222,7 @@ func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ComposeFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommitCompactionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9673,12 +10232,12 @@ func (m *ComposeFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ComposeFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitCompactionInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ComposeFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitCompactionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9687,24 +10246,58 @@ func (m *ComposeFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TtlSeconds != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
+	if m.Deferred {
 		i--
-		dAtA[i] = 0x10
+		if m.Deferred {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.FileSetIds) > 0 {
-		for iNdEx := len(m.FileSetIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FileSetIds[iNdEx])
-			copy(dAtA[i:], m.FileSetIds[iNdEx])
-			i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetIds[iNdEx])))
+	if len(m.Levels) > 0 {
+		for iNdEx := len(m.Levels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Levels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CheckStorageRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9714,12 +10307,12 @@ func (m *CheckStorageRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CheckStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9728,34 +10321,76 @@ func (m *CheckStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ChunkEnd) > 0 {
-		i -= len(m.ChunkEnd)
-		copy(dAtA[i:], m.ChunkEnd)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkEnd)))
+	if m.OriginKind != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x38
 	}
-	if len(m.ChunkBegin) > 0 {
-		i -= len(m.ChunkBegin)
-		copy(dAtA[i:], m.ChunkBegin)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkBegin)))
+	if m.All {
 		i--
-		dAtA[i] = 0x12
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.ReadChunkData {
+	if m.Reverse {
 		i--
-		if m.ReadChunkData {
+		if m.Reverse {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x28
+	}
+	if m.Number != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CheckStorageResponse) Marshal() (dAtA []byte, err error) {
+func (m *InspectCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9765,12 +10400,12 @@ func (m *CheckStorageResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CheckStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9779,57 +10414,19 @@ func (m *CheckStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChunkObjectCount != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkObjectCount))
+	if m.Wait {
 		i--
-		dAtA[i] = 0x8
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PutCacheRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PutCacheRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PutCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FileSetIds) > 0 {
-		for iNdEx := len(m.FileSetIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FileSetIds[iNdEx])
-			copy(dAtA[i:], m.FileSetIds[iNdEx])
-			i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Value != nil {
+	if m.CommitSet != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9837,19 +10434,12 @@ func (m *PutCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Key)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCacheRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9859,12 +10449,12 @@ func (m *GetCacheRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9873,17 +10463,10 @@ func (m *GetCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCacheResponse) Marshal() (dAtA []byte, err error) {
+func (m *SquashCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9893,12 +10476,12 @@ func (m *GetCacheResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCacheResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SquashCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCacheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SquashCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9907,9 +10490,9 @@ func (m *GetCacheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value != nil {
+	if m.CommitSet != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9922,7 +10505,7 @@ func (m *GetCacheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ClearCacheRequest) Marshal() (dAtA []byte, err error) {
+func (m *DropCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9932,12 +10515,12 @@ func (m *ClearCacheRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClearCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DropCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9946,17 +10529,22 @@ func (m *ClearCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TagPrefix) > 0 {
-		i -= len(m.TagPrefix)
-		copy(dAtA[i:], m.TagPrefix)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.TagPrefix)))
+	if m.CommitSet != nil {
+		{
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9966,12 +10554,12 @@ func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubscribeCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9980,37 +10568,61 @@ func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.OriginKind != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
+		i--
+		dAtA[i] = 0x30
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.State != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RunLoadTestRequest) Marshal() (dAtA []byte, err error) {
+func (m *ClearCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10020,12 +10632,12 @@ func (m *RunLoadTestRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RunLoadTestRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClearCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10034,14 +10646,9 @@ func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Seed != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Branch != nil {
+	if m.Commit != nil {
 		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10049,19 +10656,12 @@ func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Spec) > 0 {
-		i -= len(m.Spec)
-		copy(dAtA[i:], m.Spec)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RunLoadTestResponse) Marshal() (dAtA []byte, err error) {
+func (m *CreateBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10071,12 +10671,12 @@ func (m *RunLoadTestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RunLoadTestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10085,9 +10685,19 @@ func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Duration != nil {
+	if m.NewCommitSet {
+		i--
+		if m.NewCommitSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Trigger != nil {
 		{
-			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10095,19 +10705,21 @@ func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
-		i--
 		dAtA[i] = 0x22
 	}
-	if m.Seed != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Provenance) > 0 {
+		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.Branch != nil {
 		{
@@ -10121,17 +10733,22 @@ func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Spec) > 0 {
-		i -= len(m.Spec)
-		copy(dAtA[i:], m.Spec)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectStorageEgress) Marshal() (dAtA []byte, err error) {
+func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10141,12 +10758,12 @@ func (m *ObjectStorageEgress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectStorageEgress) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectStorageEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10155,17 +10772,22 @@ func (m *ObjectStorageEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SQLDatabaseEgress) Marshal() (dAtA []byte, err error) {
+func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10175,12 +10797,12 @@ func (m *SQLDatabaseEgress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SQLDatabaseEgress) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SQLDatabaseEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10189,21 +10811,19 @@ func (m *SQLDatabaseEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Secret != nil {
-		{
-			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
 	}
-	if m.FileFormat != nil {
+	if m.Repo != nil {
 		{
-			size, err := m.FileFormat.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10211,19 +10831,12 @@ func (m *SQLDatabaseEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SQLDatabaseEgress_FileFormat) Marshal() (dAtA []byte, err error) {
+func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10233,12 +10846,12 @@ func (m *SQLDatabaseEgress_FileFormat) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SQLDatabaseEgress_FileFormat) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SQLDatabaseEgress_FileFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10247,65 +10860,32 @@ func (m *SQLDatabaseEgress_FileFormat) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Columns) > 0 {
-		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Columns[iNdEx])
-			copy(dAtA[i:], m.Columns[iNdEx])
-			i = encodeVarintPfs(dAtA, i, uint64(len(m.Columns[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Type != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Type))
+	if m.Force {
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SQLDatabaseEgress_Secret) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SQLDatabaseEgress_Secret) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SQLDatabaseEgress_Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Key)))
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EgressRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddFile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10315,12 +10895,12 @@ func (m *EgressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EgressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddFile) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10329,40 +10909,54 @@ func (m *EgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Target != nil {
+	if m.Attributes != nil {
 		{
-			size := m.Target.Size()
-			i -= size
-			if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Commit != nil {
+	if m.Source != nil {
 		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Source.Size()
+			i -= size
+			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+	}
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EgressRequest_ObjectStorage) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddFile_Raw) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EgressRequest_ObjectStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddFile_Raw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ObjectStorage != nil {
+	if m.Raw != nil {
 		{
-			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Raw.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10370,20 +10964,20 @@ func (m *EgressRequest_ObjectStorage) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *EgressRequest_SqlDatabase) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddFile_Url) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EgressRequest_SqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddFile_Url) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SqlDatabase != nil {
+	if m.Url != nil {
 		{
-			size, err := m.SqlDatabase.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Url.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10391,11 +10985,11 @@ func (m *EgressRequest_SqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-func (m *EgressResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddFile_URLSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10405,12 +10999,12 @@ func (m *EgressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EgressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddFile_URLSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddFile_URLSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10419,61 +11013,27 @@ func (m *EgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Result != nil {
-		{
-			size := m.Result.Size()
-			i -= size
-			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if m.Recursive {
+		i--
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EgressResponse_ObjectStorage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EgressResponse_ObjectStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ObjectStorage != nil {
-		{
-			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *EgressResponse_SqlDatabase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *EgressResponse_SqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SqlDatabase != nil {
-		{
-			size, err := m.SqlDatabase.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func (m *EgressResponse_ObjectStorageResult) Marshal() (dAtA []byte, err error) {
+func (m *DeleteFile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10483,12 +11043,12 @@ func (m *EgressResponse_ObjectStorageResult) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EgressResponse_ObjectStorageResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteFile) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EgressResponse_ObjectStorageResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10497,15 +11057,24 @@ func (m *EgressResponse_ObjectStorageResult) MarshalToSizedBuffer(dAtA []byte) (
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.BytesWritten != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.BytesWritten))
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EgressResponse_SQLDatabaseResult) Marshal() (dAtA []byte, err error) {
+func (m *CopyFile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10515,12 +11084,12 @@ func (m *EgressResponse_SQLDatabaseResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EgressResponse_SQLDatabaseResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *CopyFile) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EgressResponse_SQLDatabaseResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10529,1658 +11098,6706 @@ func (m *EgressResponse_SQLDatabaseResult) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RowsWritten) > 0 {
-		for k := range m.RowsWritten {
-			v := m.RowsWritten[k]
-			baseI := i
-			i = encodeVarintPfs(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if m.Append {
+		i--
+		if m.Append {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Src != nil {
+		{
+			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dst) > 0 {
+		i -= len(m.Dst)
+		copy(dAtA[i:], m.Dst)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Dst)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPfs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ModifyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Repo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ModifyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return len(dAtA) - i, nil
+}
+
+func (m *ModifyFileRequest_SetCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest_SetCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetCommit != nil {
+		{
+			size, err := m.SetCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *ModifyFileRequest_AddFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Branch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModifyFileRequest_AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddFile != nil {
+		{
+			size, err := m.AddFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *ModifyFileRequest_DeleteFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest_DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeleteFile != nil {
+		{
+			size, err := m.DeleteFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *ModifyFileRequest_CopyFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest_CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CopyFile != nil {
+		{
+			size, err := m.CopyFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return len(dAtA) - i, nil
+}
+func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *File) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RepoInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.SizeBytesUpperBound != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Branches) > 0 {
-		for _, e := range m.Branches {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.AuthInfo != nil {
-		l = m.AuthInfo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RepoInfo_Details) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RepoAuthInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WalkFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Permissions) > 0 {
-		l = 0
-		for _, e := range m.Permissions {
-			l += sovPfs(uint64(e))
-		}
-		n += 1 + sovPfs(uint64(l)) + l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Roles) > 0 {
-		for _, s := range m.Roles {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BranchInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if len(m.StartAfter) > 0 {
+		i -= len(m.StartAfter)
+		copy(dAtA[i:], m.StartAfter)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.StartAfter)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Subvenance) > 0 {
-		for _, e := range m.Subvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DirectProvenance) > 0 {
-		for _, e := range m.DirectProvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Trigger != nil {
-		l = m.Trigger.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Trigger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.All {
-		n += 2
-	}
-	l = len(m.CronSpec)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Size_)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Shallow {
+		i--
+		if m.Shallow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Commits != 0 {
-		n += 1 + sovPfs(uint64(m.Commits))
+	if m.OldFile != nil {
+		{
+			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.NewFile != nil {
+		{
+			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitOrigin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Kind != 0 {
-		n += 1 + sovPfs(uint64(m.Kind))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.ParentCommit != nil {
-		l = m.ParentCommit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ChildCommits) > 0 {
-		for _, e := range m.ChildCommits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.OldFile != nil {
+		{
+			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Finishing != nil {
-		l = m.Finishing.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.DirectProvenance) > 0 {
-		for _, e := range m.DirectProvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.NewFile != nil {
+		{
+			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.SizeBytesUpperBound != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitInfo_Details) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if m.CompactingTime != nil {
-		l = m.CompactingTime.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.ValidatingTime != nil {
-		l = m.ValidatingTime.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *FsckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *FsckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FsckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Fix {
+		i--
+		if m.Fix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FileInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FsckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FsckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FsckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.FileType != 0 {
-		n += 1 + sovPfs(uint64(m.FileType))
-	}
-	if m.Committed != nil {
-		l = m.Committed.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Fix) > 0 {
+		i -= len(m.Fix)
+		copy(dAtA[i:], m.Fix)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Fix)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFileSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Update {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.FileSetId) > 0 {
+		i -= len(m.FileSetId)
+		copy(dAtA[i:], m.FileSetId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.FileSetId) > 0 {
+		i -= len(m.FileSetId)
+		copy(dAtA[i:], m.FileSetId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Force {
-		n += 2
+	if m.TtlSeconds != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.FileSetId) > 0 {
+		i -= len(m.FileSetId)
+		copy(dAtA[i:], m.FileSetId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StartCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ComposeFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ComposeFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComposeFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.TtlSeconds != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.FileSetIds) > 0 {
+		for iNdEx := len(m.FileSetIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileSetIds[iNdEx])
+			copy(dAtA[i:], m.FileSetIds[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FinishCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.ChunkEnd) > 0 {
+		i -= len(m.ChunkEnd)
+		copy(dAtA[i:], m.ChunkEnd)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkEnd)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Force {
-		n += 2
+	if len(m.ChunkBegin) > 0 {
+		i -= len(m.ChunkBegin)
+		copy(dAtA[i:], m.ChunkBegin)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkBegin)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ReadChunkData {
+		i--
+		if m.ReadChunkData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Wait != 0 {
-		n += 1 + sovPfs(uint64(m.Wait))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ChunkObjectCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkObjectCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScrubStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScrubStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScrubStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Number != 0 {
-		n += 1 + sovPfs(uint64(m.Number))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Reverse {
-		n += 2
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.All {
-		n += 2
+	if len(m.ChunkEnd) > 0 {
+		i -= len(m.ChunkEnd)
+		copy(dAtA[i:], m.ChunkEnd)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkEnd)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.OriginKind != 0 {
-		n += 1 + sovPfs(uint64(m.OriginKind))
+	if len(m.ChunkBegin) > 0 {
+		i -= len(m.ChunkBegin)
+		copy(dAtA[i:], m.ChunkBegin)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkBegin)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Repair {
+		i--
+		if m.Repair {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Wait {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ScrubStorageFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScrubStorageFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScrubStorageFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *SquashCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RepairError) > 0 {
+		i -= len(m.RepairError)
+		copy(dAtA[i:], m.RepairError)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RepairError)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repaired {
+		i--
+		if m.Repaired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Missing {
+		i--
+		if m.Missing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChunkId) > 0 {
+		i -= len(m.ChunkId)
+		copy(dAtA[i:], m.ChunkId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DropCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScrubStorageFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScrubStorageFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScrubStorageFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChunkId) > 0 {
+		i -= len(m.ChunkId)
+		copy(dAtA[i:], m.ChunkId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubscribeCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScrubStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScrubStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScrubStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.State != 0 {
-		n += 1 + sovPfs(uint64(m.State))
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.All {
-		n += 2
+	if m.UnreadableCommit != nil {
+		{
+			size, err := m.UnreadableCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.OriginKind != 0 {
-		n += 1 + sovPfs(uint64(m.OriginKind))
+	if m.Finding != nil {
+		{
+			size, err := m.Finding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ChunkObjectCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkObjectCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClearCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Lag != nil {
+		{
+			size, err := m.Lag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Trigger != nil {
-		l = m.Trigger.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.PendingBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.PendingBytes))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.NewCommitSet {
-		n += 2
+	if m.PendingChunks != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.PendingChunks))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ReplicatedChunks != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ReplicatedChunks))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Failover {
+		i--
+		if m.Failover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GarbageCollectStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GarbageCollectStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Reverse {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Force {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GarbageChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GarbageChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GarbageChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Tombstone {
+		i--
+		if m.Tombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Source != nil {
-		n += m.Source.Size()
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Gen != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Gen))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ChunkId) > 0 {
+		i -= len(m.ChunkId)
+		copy(dAtA[i:], m.ChunkId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddFile_Raw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Raw != nil {
-		l = m.Raw.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *GarbageCollectStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *AddFile_Url) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Url != nil {
-		l = m.Url.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
+
+func (m *GarbageCollectStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *AddFile_URLSource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GarbageCollectStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Recursive {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *DeleteFile) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.DeletedTrackerObjectCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.DeletedTrackerObjectCount))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.DeletedChunkCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.DeletedChunkCount))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *CopyFile) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TrashBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TrashBytes))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.Dst)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.TrashCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TrashCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.TrackerObjectCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TrackerObjectCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Src != nil {
-		l = m.Src.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.ChunkBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkBytes))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Append {
-		n += 2
+	if m.ChunkCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ModifyFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetStorageGCPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStorageGCPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStorageGCPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ModifyFileRequest_SetCommit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SetCommit != nil {
-		l = m.SetCommit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *RestoreStorageTrashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ModifyFileRequest_AddFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RestoreStorageTrashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreStorageTrashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AddFile != nil {
-		l = m.AddFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *ModifyFileRequest_DeleteFile) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.DeleteFile != nil {
-		l = m.DeleteFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.ChunkIds) > 0 {
+		for iNdEx := len(m.ChunkIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChunkIds[iNdEx])
+			copy(dAtA[i:], m.ChunkIds[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.ChunkIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ModifyFileRequest_CopyFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CopyFile != nil {
-		l = m.CopyFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+
+func (m *RestoreStorageTrashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *GetFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RestoreStorageTrashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreStorageTrashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		n += 1 + sovPfs(uint64(m.Offset))
+	if m.RestoredBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.RestoredBytes))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.RestoredCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.RestoredCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PutCacheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *PutCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WalkFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PutCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *GlobFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.FileSetIds) > 0 {
+		for iNdEx := len(m.FileSetIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileSetIds[iNdEx])
+			copy(dAtA[i:], m.FileSetIds[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DiffFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.OldFile != nil {
-		l = m.OldFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Shallow {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetCacheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DiffFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.OldFile != nil {
-		l = m.OldFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FsckRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetCacheResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCacheResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCacheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Fix {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FsckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fix)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ClearCacheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateFileSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FileSetId)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ClearCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFileSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClearCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.TagPrefix) > 0 {
+		i -= len(m.TagPrefix)
+		copy(dAtA[i:], m.TagPrefix)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.TagPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddFileSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.FileSetId)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RenewFileSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FileSetId)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.TtlSeconds != 0 {
-		n += 1 + sovPfs(uint64(m.TtlSeconds))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ComposeFileSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FileSetIds) > 0 {
-		for _, s := range m.FileSetIds {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if m.TtlSeconds != 0 {
-		n += 1 + sovPfs(uint64(m.TtlSeconds))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CheckStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RunLoadTestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RunLoadTestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReadChunkData {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ChunkBegin)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Seed != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ChunkEnd)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CheckStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChunkObjectCount != 0 {
-		n += 1 + sovPfs(uint64(m.ChunkObjectCount))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RunLoadTestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutCacheRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RunLoadTestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.FileSetIds) > 0 {
-		for _, s := range m.FileSetIds {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Duration != nil {
+		{
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Seed != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCacheRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ObjectStorageEgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectStorageEgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectStorageEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCacheResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SQLDatabaseEgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLDatabaseEgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLDatabaseEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ClearCacheRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.TagPrefix)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.FileFormat != nil {
+		{
+			size, err := m.FileFormat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActivateAuthRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SQLDatabaseEgress_FileFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *SQLDatabaseEgress_FileFormat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunLoadTestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SQLDatabaseEgress_FileFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Seed != 0 {
-		n += 1 + sovPfs(uint64(m.Seed))
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RunLoadTestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SQLDatabaseEgress_Secret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLDatabaseEgress_Secret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLDatabaseEgress_Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Seed != 0 {
-		n += 1 + sovPfs(uint64(m.Seed))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Duration != nil {
-		l = m.Duration.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ObjectStorageEgress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Target != nil {
+		{
+			size := m.Target.Size()
+			i -= size
+			if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SQLDatabaseEgress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EgressRequest_ObjectStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressRequest_ObjectStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ObjectStorage != nil {
+		{
+			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EgressRequest_SqlDatabase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressRequest_SqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SqlDatabase != nil {
+		{
+			size, err := m.SqlDatabase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FileFormat != nil {
-		l = m.FileFormat.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Result != nil {
+		{
+			size := m.Result.Size()
+			i -= size
+			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.Secret != nil {
-		l = m.Secret.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EgressResponse_ObjectStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressResponse_ObjectStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ObjectStorage != nil {
+		{
+			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EgressResponse_SqlDatabase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressResponse_SqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SqlDatabase != nil {
+		{
+			size, err := m.SqlDatabase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EgressResponse_ObjectStorageResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressResponse_ObjectStorageResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressResponse_ObjectStorageResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.BytesWritten != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.BytesWritten))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SQLDatabaseEgress_FileFormat) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EgressResponse_SQLDatabaseResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressResponse_SQLDatabaseResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressResponse_SQLDatabaseResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPfs(uint64(m.Type))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Columns) > 0 {
-		for _, s := range m.Columns {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.RowsWritten) > 0 {
+		for k := range m.RowsWritten {
+			v := m.RowsWritten[k]
+			baseI := i
+			i = encodeVarintPfs(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPfs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Repo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Branch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *File) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytesUpperBound != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.AuthInfo != nil {
+		l = m.AuthInfo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Chunking != nil {
+		l = m.Chunking.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Compaction != nil {
+		l = m.Compaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoInfo_Details) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChunkingSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		n += 1 + sovPfs(uint64(m.Algorithm))
+	}
+	if m.AverageSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.AverageSizeBytes))
+	}
+	if m.MinSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MaxSizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactionSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LevelFactor != 0 {
+		n += 1 + sovPfs(uint64(m.LevelFactor))
+	}
+	if m.DeferSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.DeferSizeBytes))
+	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAuthInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovPfs(uint64(e))
+		}
+		n += 1 + sovPfs(uint64(l)) + l
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BranchInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.Subvenance) > 0 {
+		for _, e := range m.Subvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.DirectProvenance) > 0 {
+		for _, e := range m.DirectProvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	l = len(m.CronSpec)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Size_)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commits != 0 {
+		n += 1 + sovPfs(uint64(m.Commits))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovPfs(uint64(m.Kind))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ParentCommit != nil {
+		l = m.ParentCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.ChildCommits) > 0 {
+		for _, e := range m.ChildCommits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finishing != nil {
+		l = m.Finishing.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.DirectProvenance) > 0 {
+		for _, e := range m.DirectProvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytesUpperBound != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitInfo_Details) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.CompactingTime != nil {
+		l = m.CompactingTime.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ValidatingTime != nil {
+		l = m.ValidatingTime.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.CompactionDeferred {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileType != 0 {
+		n += 1 + sovPfs(uint64(m.FileType))
+	}
+	if m.Committed != nil {
+		l = m.Committed.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	if m.Mtime != nil {
+		l = m.Mtime.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Xattrs) > 0 {
+		for k, v := range m.Xattrs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.Chunking != nil {
+		l = m.Chunking.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Compaction != nil {
+		l = m.Compaction.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinishCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Wait != 0 {
+		n += 1 + sovPfs(uint64(m.Wait))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectCommitCompactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactionLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileSetId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.Compacted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitCompactionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Levels) > 0 {
+		for _, e := range m.Levels {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Deferred {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.All {
+		n += 2
+	}
+	if m.OriginKind != 0 {
+		n += 1 + sovPfs(uint64(m.OriginKind))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Wait {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SquashCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DropCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscribeCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPfs(uint64(m.State))
+	}
+	if m.All {
+		n += 2
+	}
+	if m.OriginKind != 0 {
+		n += 1 + sovPfs(uint64(m.OriginKind))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClearCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.NewCommitSet {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Source != nil {
+		n += m.Source.Size()
+	}
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddFile_Raw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Raw != nil {
+		l = m.Raw.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *AddFile_Url) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Url != nil {
+		l = m.Url.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *AddFile_URLSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dst)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Append {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyFileRequest_SetCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetCommit != nil {
+		l = m.SetCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *ModifyFileRequest_AddFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddFile != nil {
+		l = m.AddFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *ModifyFileRequest_DeleteFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeleteFile != nil {
+		l = m.DeleteFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *ModifyFileRequest_CopyFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CopyFile != nil {
+		l = m.CopyFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *GetFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPfs(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WalkFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.StartAfter)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Shallow {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FsckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fix {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FsckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateFileSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileSetId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFileSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddFileSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.FileSetId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenewFileSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileSetId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.TtlSeconds != 0 {
+		n += 1 + sovPfs(uint64(m.TtlSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ComposeFileSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FileSetIds) > 0 {
+		for _, s := range m.FileSetIds {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.TtlSeconds != 0 {
+		n += 1 + sovPfs(uint64(m.TtlSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadChunkData {
+		n += 2
+	}
+	l = len(m.ChunkBegin)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ChunkEnd)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChunkObjectCount != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkObjectCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScrubStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repair {
+		n += 2
+	}
+	l = len(m.ChunkBegin)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ChunkEnd)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovPfs(uint64(m.BytesPerSecond))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScrubStorageFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChunkId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Missing {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Repaired {
+		n += 2
+	}
+	l = len(m.RepairError)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScrubStorageFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChunkId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScrubStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChunkObjectCount != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkObjectCount))
+	}
+	if m.Finding != nil {
+		l = m.Finding.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.UnreadableCommit != nil {
+		l = m.UnreadableCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ReplicatedChunks != 0 {
+		n += 1 + sovPfs(uint64(m.ReplicatedChunks))
+	}
+	if m.PendingChunks != 0 {
+		n += 1 + sovPfs(uint64(m.PendingChunks))
+	}
+	if m.PendingBytes != 0 {
+		n += 1 + sovPfs(uint64(m.PendingBytes))
+	}
+	if m.Lag != nil {
+		l = m.Lag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Failover {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageCollectStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChunkId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Gen != 0 {
+		n += 1 + sovPfs(uint64(m.Gen))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.Tombstone {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageCollectStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkCount))
+	}
+	if m.ChunkBytes != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkBytes))
+	}
+	if m.TrackerObjectCount != 0 {
+		n += 1 + sovPfs(uint64(m.TrackerObjectCount))
+	}
+	if m.TrashCount != 0 {
+		n += 1 + sovPfs(uint64(m.TrashCount))
+	}
+	if m.TrashBytes != 0 {
+		n += 1 + sovPfs(uint64(m.TrashBytes))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.DeletedChunkCount != 0 {
+		n += 1 + sovPfs(uint64(m.DeletedChunkCount))
+	}
+	if m.DeletedTrackerObjectCount != 0 {
+		n += 1 + sovPfs(uint64(m.DeletedTrackerObjectCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStorageGCPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreStorageTrashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChunkIds) > 0 {
+		for _, b := range m.ChunkIds {
+			l = len(b)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Ttl != nil {
+		l = m.Ttl.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreStorageTrashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RestoredCount != 0 {
+		n += 1 + sovPfs(uint64(m.RestoredCount))
+	}
+	if m.RestoredBytes != 0 {
+		n += 1 + sovPfs(uint64(m.RestoredBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutCacheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.FileSetIds) > 0 {
+		for _, s := range m.FileSetIds {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCacheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCacheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClearCacheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TagPrefix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RunLoadTestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovPfs(uint64(m.Seed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RunLoadTestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovPfs(uint64(m.Seed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ObjectStorageEgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SQLDatabaseEgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileFormat != nil {
+		l = m.FileFormat.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SQLDatabaseEgress_FileFormat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPfs(uint64(m.Type))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SQLDatabaseEgress_Secret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Target != nil {
+		n += m.Target.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EgressRequest_ObjectStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectStorage != nil {
+		l = m.ObjectStorage.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *EgressRequest_SqlDatabase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SqlDatabase != nil {
+		l = m.SqlDatabase.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *EgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		n += m.Result.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EgressResponse_ObjectStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectStorage != nil {
+		l = m.ObjectStorage.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *EgressResponse_SqlDatabase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SqlDatabase != nil {
+		l = m.SqlDatabase.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *EgressResponse_ObjectStorageResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesWritten != 0 {
+		n += 1 + sovPfs(uint64(m.BytesWritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EgressResponse_SQLDatabaseResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RowsWritten) > 0 {
+		for k, v := range m.RowsWritten {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + sovPfs(uint64(v))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPfs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPfs(x uint64) (n int) {
+	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Repo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Branch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *File) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
+			}
+			m.SizeBytesUpperBound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &Branch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &RepoAuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &RepoInfo_Details{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chunking == nil {
+				m.Chunking = &ChunkingSpec{}
+			}
+			if err := m.Chunking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Compaction == nil {
+				m.Compaction = &CompactionSpec{}
+			}
+			if err := m.Compaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkingSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= ChunkingSpec_Algorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageSizeBytes", wireType)
+			}
+			m.AverageSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSizeBytes", wireType)
+			}
+			m.MinSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
+			}
+			m.MaxSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LevelFactor", wireType)
+			}
+			m.LevelFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LevelFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferSizeBytes", wireType)
+			}
+			m.DeferSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v auth.Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= auth.Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPfs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPfs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]auth.Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v auth.Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= auth.Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subvenance = append(m.Subvenance, &Branch{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
+			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Size_ = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= OriginKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &CommitOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildCommits = append(m.ChildCommits, &Commit{})
+			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finishing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finishing == nil {
+				m.Finishing = &types.Timestamp{}
+			}
+			if err := m.Finishing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
+			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
+			}
+			m.SizeBytesUpperBound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &CommitInfo_Details{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *SQLDatabaseEgress_Secret) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *EgressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Target != nil {
-		n += m.Target.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *EgressRequest_ObjectStorage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectStorage != nil {
-		l = m.ObjectStorage.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
-}
-func (m *EgressRequest_SqlDatabase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SqlDatabase != nil {
-		l = m.SqlDatabase.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
-}
-func (m *EgressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		n += m.Result.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *EgressResponse_ObjectStorage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectStorage != nil {
-		l = m.ObjectStorage.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
-}
-func (m *EgressResponse_SqlDatabase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SqlDatabase != nil {
-		l = m.SqlDatabase.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
-}
-func (m *EgressResponse_ObjectStorageResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BytesWritten != 0 {
-		n += 1 + sovPfs(uint64(m.BytesWritten))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *EgressResponse_SQLDatabaseResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RowsWritten) > 0 {
-		for k, v := range m.RowsWritten {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + sovPfs(uint64(v))
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPfs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPfs(x uint64) (n int) {
-	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Repo) Unmarshal(dAtA []byte) error {
+func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12203,17 +17820,36 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactingTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12223,29 +17859,69 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactingTime == nil {
+				m.CompactingTime = &types.Duration{}
+			}
+			if err := m.CompactingTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ValidatingTime == nil {
+				m.ValidatingTime = &types.Duration{}
+			}
+			if err := m.ValidatingTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionDeferred", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12255,24 +17931,12 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.CompactionDeferred = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12295,7 +17959,7 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Branch) Unmarshal(dAtA []byte) error {
+func (m *CommitSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12318,51 +17982,15 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12390,7 +18018,7 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12414,7 +18042,7 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *File) Unmarshal(dAtA []byte) error {
+func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12437,15 +18065,15 @@ func (m *File) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitSetInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12472,18 +18100,18 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12493,55 +18121,25 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Commits = append(m.Commits, &CommitInfo{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Datum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12565,7 +18163,7 @@ func (m *File) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12588,15 +18186,15 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12623,54 +18221,18 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
 			}
-			m.SizeBytesUpperBound = 0
+			m.FileType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12680,16 +18242,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
+				m.FileType |= FileType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12699,29 +18261,33 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Committed == nil {
+				m.Committed = &types.Timestamp{}
+			}
+			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var msglen int
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12731,31 +18297,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branches = append(m.Branches, &Branch{})
-			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12765,31 +18316,29 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &RepoAuthInfo{}
-			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12816,10 +18365,10 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &RepoInfo_Details{}
+			if m.Attributes == nil {
+				m.Attributes = &FileAttributes{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12845,7 +18394,7 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
+func (m *FileAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12868,17 +18417,17 @@ func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.SizeBytes = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12888,65 +18437,148 @@ func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				m.Mode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mtime == nil {
+				m.Mtime = &types.Timestamp{}
+			}
+			if err := m.Mtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xattrs", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v auth.Permission
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Xattrs == nil {
+				m.Xattrs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowPfs
@@ -12956,44 +18588,43 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= auth.Permission(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.Permissions = append(m.Permissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPfs
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
 					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPfs
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPfs
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]auth.Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v auth.Permission
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPfs
@@ -13003,47 +18634,40 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= auth.Permission(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Permissions = append(m.Permissions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			m.Xattrs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13067,7 +18691,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13090,15 +18714,15 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13125,18 +18749,18 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13146,33 +18770,29 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13182,29 +18802,15 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Update = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunking", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13231,48 +18837,16 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subvenance = append(m.Subvenance, &Branch{})
-			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Chunking == nil {
+				m.Chunking = &ChunkingSpec{}
 			}
-			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
-			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Chunking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13299,10 +18873,10 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &Trigger{}
+			if m.Compaction == nil {
+				m.Compaction = &CompactionSpec{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Compaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13328,7 +18902,7 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Trigger) Unmarshal(dAtA []byte) error {
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13351,69 +18925,17 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13423,75 +18945,28 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CronSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Size_ = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
-			}
-			m.Commits = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Commits |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13514,7 +18989,7 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13537,17 +19012,17 @@ func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitOrigin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Kind = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13557,11 +19032,24 @@ func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= OriginKind(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13584,7 +19072,7 @@ func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13607,15 +19095,15 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13642,18 +19130,18 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13663,24 +19151,12 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13703,7 +19179,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13726,15 +19202,15 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13761,50 +19237,14 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Origin == nil {
-				m.Origin = &CommitOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -13836,9 +19276,9 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13865,122 +19305,67 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChildCommits = append(m.ChildCommits, &Commit{})
-			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finishing", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finishing == nil {
-				m.Finishing = &types.Timestamp{}
-			}
-			if err := m.Finishing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14007,18 +19392,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14028,27 +19413,25 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
-			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -14080,30 +19463,11 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
-			}
-			m.SizeBytesUpperBound = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14113,28 +19477,12 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &CommitInfo_Details{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14157,7 +19505,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14180,17 +19528,17 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14200,16 +19548,33 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactingTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			var msglen int
+			m.Wait = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14219,31 +19584,65 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Wait |= CommitState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompactingTime == nil {
-				m.CompactingTime = &types.Duration{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectCommitCompactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.CompactingTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectCommitCompactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectCommitCompactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14270,10 +19669,10 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidatingTime == nil {
-				m.ValidatingTime = &types.Duration{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.ValidatingTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14299,7 +19698,7 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitSet) Unmarshal(dAtA []byte) error {
+func (m *CompactionLevel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14322,15 +19721,15 @@ func (m *CommitSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactionLevel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactionLevel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14358,8 +19757,47 @@ func (m *CommitSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.FileSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compacted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14382,7 +19820,7 @@ func (m *CommitSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
+func (m *CommitCompactionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14401,19 +19839,55 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitSetInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitCompactionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitCompactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14440,16 +19914,16 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
+			if m.Spec == nil {
+				m.Spec = &CompactionSpec{}
 			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14476,11 +19950,31 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &CommitInfo{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Levels = append(m.Levels, &CompactionLevel{})
+			if err := m.Levels[len(m.Levels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deferred = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14503,7 +19997,7 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14526,15 +20020,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14561,18 +20055,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			m.FileType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14582,14 +20076,31 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileType |= FileType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14616,18 +20127,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Committed == nil {
-				m.Committed = &types.Timestamp{}
+			if m.To == nil {
+				m.To = &Commit{}
 			}
-			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.SizeBytes = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14637,16 +20148,126 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
+			}
+			m.OriginKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginKind |= OriginKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectCommitSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14656,26 +20277,99 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
+			}
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCommitSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14698,7 +20392,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14721,15 +20415,15 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SquashCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SquashCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14756,65 +20450,13 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14837,7 +20479,7 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14860,15 +20502,15 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14895,10 +20537,10 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14924,7 +20566,7 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14947,15 +20589,51 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14967,24 +20645,118 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= CommitState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
+			m.All = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.OriginKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginKind |= OriginKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15007,7 +20779,7 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15030,15 +20802,15 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15065,33 +20837,13 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15114,7 +20866,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15137,15 +20889,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15172,46 +20924,14 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Head == nil {
+				m.Head = &Commit{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
@@ -15247,60 +20967,9 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15327,18 +20996,16 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15348,29 +21015,33 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCommitSet", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15380,29 +21051,68 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
+			m.NewCommitSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15412,12 +21122,28 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15440,7 +21166,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15463,15 +21189,15 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15498,18 +21224,18 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
 			}
-			m.Wait = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15519,11 +21245,12 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Wait |= CommitState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Reverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15546,7 +21273,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15569,15 +21296,15 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15604,18 +21331,18 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15625,33 +21352,68 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15661,33 +21423,29 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &Commit{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			m.Number = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15697,56 +21455,29 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.Reverse = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.All = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
+			m.Datum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
 			}
-			m.OriginKind = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15756,65 +21487,30 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OriginKind |= OriginKind(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &types.BytesValue{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitSetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Source = &AddFile_Raw{v}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15841,18 +21537,17 @@ func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
-			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AddFile_URLSource{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Source = &AddFile_Url{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15862,63 +21557,28 @@ func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Attributes == nil {
+				m.Attributes = &FileAttributes{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitSetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15941,7 +21601,7 @@ func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *AddFile_URLSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15964,17 +21624,17 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SquashCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: URLSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SquashCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: URLSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15984,28 +21644,44 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Recursive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16028,7 +21704,7 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16051,17 +21727,17 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16071,27 +21747,55 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Datum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16115,7 +21819,7 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CopyFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16138,17 +21842,17 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16158,31 +21862,27 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dst = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16210,11 +21910,11 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Datum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16241,57 +21941,18 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
+			if m.Src == nil {
+				m.Src = &File{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= CommitState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Append", wireType)
 			}
-			m.OriginKind = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16301,11 +21962,12 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OriginKind |= OriginKind(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Append = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16328,7 +21990,7 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16351,15 +22013,15 @@ func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16386,12 +22048,116 @@ func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			v := &Commit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Body = &ModifyFileRequest_SetCommit{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AddFile{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &ModifyFileRequest_AddFile{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeleteFile{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &ModifyFileRequest_DeleteFile{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CopyFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CopyFile{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Body = &ModifyFileRequest_CopyFile{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16415,7 +22181,7 @@ func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16438,15 +22204,15 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16473,18 +22239,18 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16494,33 +22260,29 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var msglen int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16530,29 +22292,65 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16569,43 +22367,23 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Trigger == nil {
-				m.Trigger = &Trigger{}
-			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCommitSet", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.NewCommitSet = bool(v != 0)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16628,7 +22406,7 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16651,15 +22429,15 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16686,10 +22464,10 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16715,7 +22493,7 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16738,15 +22516,15 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WalkFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WalkFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16773,33 +22551,13 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16822,7 +22580,7 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16845,15 +22603,15 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16880,18 +22638,18 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16901,12 +22659,56 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16929,7 +22731,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddFile) Unmarshal(dAtA []byte) error {
+func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16952,17 +22754,17 @@ func (m *AddFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16972,29 +22774,33 @@ func (m *AddFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.NewFile == nil {
+				m.NewFile = &File{}
+			}
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17004,27 +22810,102 @@ func (m *AddFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Datum = string(dAtA[iNdEx:postIndex])
+			if m.OldFile == nil {
+				m.OldFile = &File{}
+			}
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shallow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17051,15 +22932,16 @@ func (m *AddFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.BytesValue{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewFile == nil {
+				m.NewFile = &FileInfo{}
+			}
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Source = &AddFile_Raw{v}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17086,11 +22968,12 @@ func (m *AddFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AddFile_URLSource{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OldFile == nil {
+				m.OldFile = &FileInfo{}
+			}
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Source = &AddFile_Url{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17114,7 +22997,7 @@ func (m *AddFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddFile_URLSource) Unmarshal(dAtA []byte) error {
+func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17137,47 +23020,15 @@ func (m *AddFile_URLSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: URLSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: FsckRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: URLSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FsckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -17194,7 +23045,7 @@ func (m *AddFile_URLSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Recursive = bool(v != 0)
+			m.Fix = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17217,7 +23068,7 @@ func (m *AddFile_URLSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteFile) Unmarshal(dAtA []byte) error {
+func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17240,15 +23091,15 @@ func (m *DeleteFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: FsckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FsckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17276,11 +23127,11 @@ func (m *DeleteFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Fix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17308,7 +23159,7 @@ func (m *DeleteFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Datum = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17332,7 +23183,7 @@ func (m *DeleteFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyFile) Unmarshal(dAtA []byte) error {
+func (m *CreateFileSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17355,15 +23206,15 @@ func (m *CopyFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateFileSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateFileSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17391,13 +23242,64 @@ func (m *CopyFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dst = string(dAtA[iNdEx:postIndex])
+			m.FileSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFileSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFileSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17407,27 +23309,82 @@ func (m *CopyFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Datum = string(dAtA[iNdEx:postIndex])
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddFileSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddFileSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17454,18 +23411,18 @@ func (m *CopyFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Src == nil {
-				m.Src = &File{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Append", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSetId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17475,12 +23432,24 @@ func (m *CopyFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Append = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17503,7 +23472,7 @@ func (m *CopyFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
+func (m *RenewFileSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17526,17 +23495,17 @@ func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenewFileSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenewFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17546,32 +23515,29 @@ func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Commit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &ModifyFileRequest_SetCommit{v}
+			m.FileSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddFile", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
 			}
-			var msglen int
+			m.TtlSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17581,32 +23547,67 @@ func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TtlSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AddFile{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComposeFileSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			m.Body = &ModifyFileRequest_AddFile{v}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComposeFileSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComposeFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17616,32 +23617,29 @@ func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DeleteFile{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &ModifyFileRequest_DeleteFile{v}
+			m.FileSetIds = append(m.FileSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CopyFile", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
 			}
-			var msglen int
+			m.TtlSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17651,27 +23649,11 @@ func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TtlSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CopyFile{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &ModifyFileRequest_CopyFile{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17694,7 +23676,7 @@ func (m *ModifyFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17717,17 +23699,17 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadChunkData", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17737,33 +23719,17 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ReadChunkData = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkBegin", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17773,29 +23739,31 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ChunkBegin = append(m.ChunkBegin[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkBegin == nil {
+				m.ChunkBegin = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkEnd", wireType)
 			}
-			m.Offset = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17805,11 +23773,26 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkEnd = append(m.ChunkEnd[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkEnd == nil {
+				m.ChunkEnd = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17832,7 +23815,7 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17855,17 +23838,17 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkObjectCount", wireType)
 			}
-			var msglen int
+			m.ChunkObjectCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17875,28 +23858,11 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChunkObjectCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17919,7 +23885,7 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+func (m *ScrubStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17942,17 +23908,71 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScrubStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScrubStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repair", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repair = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkBegin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkBegin = append(m.ChunkBegin[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkBegin == nil {
+				m.ChunkBegin = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkEnd", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17962,28 +23982,45 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChunkEnd = append(m.ChunkEnd[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkEnd == nil {
+				m.ChunkEnd = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18006,7 +24043,7 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
+func (m *ScrubStorageFinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18029,17 +24066,17 @@ func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WalkFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScrubStorageFinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WalkFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScrubStorageFinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18049,84 +24086,51 @@ func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChunkId = append(m.ChunkId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkId == nil {
+				m.ChunkId = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Missing = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18136,31 +24140,47 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaired", wireType)
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.Repaired = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepairError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18188,7 +24208,7 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			m.RepairError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18212,7 +24232,7 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
+func (m *ScrubStorageFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18235,17 +24255,17 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScrubStorageFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScrubStorageFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18255,31 +24275,29 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFile == nil {
-				m.NewFile = &File{}
-			}
-			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChunkId = append(m.ChunkId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkId == nil {
+				m.ChunkId = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18306,33 +24324,13 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldFile == nil {
-				m.OldFile = &File{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Shallow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18355,7 +24353,7 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+func (m *ScrubStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18365,28 +24363,83 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowPfs
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScrubStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScrubStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkObjectCount", wireType)
+			}
+			m.ChunkObjectCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkObjectCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Finding == nil {
+				m.Finding = &ScrubStorageFinding{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Finding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnreadableCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18413,16 +24466,16 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFile == nil {
-				m.NewFile = &FileInfo{}
+			if m.UnreadableCommit == nil {
+				m.UnreadableCommit = &Commit{}
 			}
-			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnreadableCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18449,10 +24502,10 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldFile == nil {
-				m.OldFile = &FileInfo{}
+			if m.File == nil {
+				m.File = &ScrubStorageFile{}
 			}
-			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18478,7 +24531,7 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FsckRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18501,32 +24554,12 @@ func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FsckRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FsckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Fix = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18549,7 +24582,7 @@ func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FsckResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicaStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18572,15 +24605,15 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FsckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicaStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FsckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18608,13 +24641,70 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fix = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatedChunks", wireType)
+			}
+			m.ReplicatedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicatedChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChunks", wireType)
+			}
+			m.PendingChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBytes", wireType)
+			}
+			m.PendingBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18624,23 +24714,27 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if m.Lag == nil {
+				m.Lag = &types.Duration{}
+			}
+			if err := m.Lag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18664,7 +24758,7 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateFileSetResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18687,17 +24781,17 @@ func (m *CreateFileSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateFileSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateFileSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18707,24 +24801,46 @@ func (m *CreateFileSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileSetId = string(dAtA[iNdEx:postIndex])
+			m.Replicas = append(m.Replicas, &ReplicaStatus{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failover = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18747,7 +24863,7 @@ func (m *CreateFileSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileSetRequest) Unmarshal(dAtA []byte) error {
+func (m *GarbageCollectStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18770,17 +24886,17 @@ func (m *GetFileSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GarbageCollectStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GarbageCollectStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18790,28 +24906,12 @@ func (m *GetFileSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18834,7 +24934,7 @@ func (m *GetFileSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddFileSetRequest) Unmarshal(dAtA []byte) error {
+func (m *GarbageChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18857,17 +24957,17 @@ func (m *AddFileSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddFileSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GarbageChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GarbageChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18877,33 +24977,69 @@ func (m *AddFileSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChunkId = append(m.ChunkId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChunkId == nil {
+				m.ChunkId = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSetId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gen", wireType)
+			}
+			m.Gen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18913,24 +25049,12 @@ func (m *AddFileSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Tombstone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18953,7 +25077,7 @@ func (m *AddFileSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RenewFileSetRequest) Unmarshal(dAtA []byte) error {
+func (m *GarbageCollectStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18976,17 +25100,17 @@ func (m *RenewFileSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RenewFileSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GarbageCollectStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenewFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GarbageCollectStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18996,29 +25120,31 @@ func (m *RenewFileSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileSetId = string(dAtA[iNdEx:postIndex])
+			m.Chunks = append(m.Chunks, &GarbageChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
 			}
-			m.TtlSeconds = 0
+			m.ChunkCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19028,7 +25154,141 @@ func (m *RenewFileSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TtlSeconds |= int64(b&0x7F) << shift
+				m.ChunkCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkBytes", wireType)
+			}
+			m.ChunkBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackerObjectCount", wireType)
+			}
+			m.TrackerObjectCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackerObjectCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrashCount", wireType)
+			}
+			m.TrashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrashCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrashBytes", wireType)
+			}
+			m.TrashBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrashBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedChunkCount", wireType)
+			}
+			m.DeletedChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedChunkCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedTrackerObjectCount", wireType)
+			}
+			m.DeletedTrackerObjectCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedTrackerObjectCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19055,7 +25315,7 @@ func (m *RenewFileSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComposeFileSetRequest) Unmarshal(dAtA []byte) error {
+func (m *SetStorageGCPausedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19078,49 +25338,17 @@ func (m *ComposeFileSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComposeFileSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetStorageGCPausedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComposeFileSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetStorageGCPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSetIds", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileSetIds = append(m.FileSetIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			m.TtlSeconds = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19130,11 +25358,12 @@ func (m *ComposeFileSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TtlSeconds |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -19157,7 +25386,7 @@ func (m *ComposeFileSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreStorageTrashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19180,35 +25409,15 @@ func (m *CheckStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreStorageTrashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreStorageTrashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadChunkData", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ReadChunkData = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkBegin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIds", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -19235,16 +25444,14 @@ func (m *CheckStorageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChunkBegin = append(m.ChunkBegin[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChunkBegin == nil {
-				m.ChunkBegin = []byte{}
-			}
+			m.ChunkIds = append(m.ChunkIds, make([]byte, postIndex-iNdEx))
+			copy(m.ChunkIds[len(m.ChunkIds)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19254,24 +25461,26 @@ func (m *CheckStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChunkEnd = append(m.ChunkEnd[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChunkEnd == nil {
-				m.ChunkEnd = []byte{}
+			if m.Ttl == nil {
+				m.Ttl = &types.Duration{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -19296,7 +25505,7 @@ func (m *CheckStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreStorageTrashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19319,17 +25528,17 @@ func (m *CheckStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreStorageTrashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreStorageTrashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkObjectCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredCount", wireType)
 			}
-			m.ChunkObjectCount = 0
+			m.RestoredCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19339,7 +25548,26 @@ func (m *CheckStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChunkObjectCount |= int64(b&0x7F) << shift
+				m.RestoredCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredBytes", wireType)
+			}
+			m.RestoredBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestoredBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
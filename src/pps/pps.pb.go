@@ -77,6 +77,38 @@ func (JobState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{0}
 }
 
+// CronCatchUp is the policy for ticks that a cron input missed.
+type CronCatchUp int32
+
+const (
+	// CRON_BACKFILL makes every missed tick (up to the input's backfill_limit).
+	CronCatchUp_CRON_BACKFILL CronCatchUp = 0
+	// CRON_SKIP drops missed ticks and resumes at the next scheduled tick.
+	CronCatchUp_CRON_SKIP CronCatchUp = 1
+	// CRON_LATEST makes only the most recent missed tick.
+	CronCatchUp_CRON_LATEST CronCatchUp = 2
+)
+
+var CronCatchUp_name = map[int32]string{
+	0: "CRON_BACKFILL",
+	1: "CRON_SKIP",
+	2: "CRON_LATEST",
+}
+
+var CronCatchUp_value = map[string]int32{
+	"CRON_BACKFILL": 0,
+	"CRON_SKIP":     1,
+	"CRON_LATEST":   2,
+}
+
+func (x CronCatchUp) String() string {
+	return proto.EnumName(CronCatchUp_name, int32(x))
+}
+
+func (CronCatchUp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{1}
+}
+
 type DatumState int32
 
 const (
@@ -111,7 +143,7 @@ func (x DatumState) String() string {
 }
 
 func (DatumState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{1}
+	return fileDescriptor_beade573c128ccc7, []int{2}
 }
 
 type WorkerState int32
@@ -142,7 +174,7 @@ func (x WorkerState) String() string {
 }
 
 func (WorkerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{2}
+	return fileDescriptor_beade573c128ccc7, []int{3}
 }
 
 type PipelineState int32
@@ -201,7 +233,7 @@ func (x PipelineState) String() string {
 }
 
 func (PipelineState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{3}
+	return fileDescriptor_beade573c128ccc7, []int{4}
 }
 
 // The pipeline type is stored here so that we can internally know the type of
@@ -234,7 +266,7 @@ func (x PipelineInfo_PipelineType) String() string {
 }
 
 func (PipelineInfo_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 0}
+	return fileDescriptor_beade573c128ccc7, []int{28, 0}
 }
 
 type SecretMount struct {
@@ -986,11 +1018,20 @@ type CronInput struct {
 	Spec   string `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
 	// Overwrite, if true, will expose a single datum that gets overwritten each
 	// tick. If false, it will create a new datum for each tick.
-	Overwrite            bool             `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	Start                *types.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Overwrite bool             `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Start     *types.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	// Timezone is the IANA time zone (e.g. "America/New_York") in which spec is
+	// evaluated. If empty, spec is evaluated in UTC.
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// CatchUp determines what happens to ticks that were missed while the
+	// pipeline wasn't running.
+	CatchUp CronCatchUp `protobuf:"varint,8,opt,name=catch_up,json=catchUp,proto3,enum=pps_v2.CronCatchUp" json:"catch_up,omitempty"`
+	// BackfillLimit, if nonzero, is the maximum number of missed ticks that are
+	// made when catch_up is CRON_BACKFILL. Only the most recent ticks are made.
+	BackfillLimit        int64    `protobuf:"varint,9,opt,name=backfill_limit,json=backfillLimit,proto3" json:"backfill_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CronInput) Reset()         { *m = CronInput{} }
@@ -1068,23 +1109,154 @@ func (m *CronInput) GetStart() *types.Timestamp {
 	return nil
 }
 
+func (m *CronInput) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *CronInput) GetCatchUp() CronCatchUp {
+	if m != nil {
+		return m.CatchUp
+	}
+	return CronCatchUp_CRON_BACKFILL
+}
+
+func (m *CronInput) GetBackfillLimit() int64 {
+	if m != nil {
+		return m.BackfillLimit
+	}
+	return 0
+}
+
+// ObjectInput watches a prefix in an external object store and commits new or
+// changed objects into a repo, which is then processed like a PFS input.
+type ObjectInput struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// URL is the bucket prefix to watch, e.g. "s3://bucket/path/to/prefix".
+	URL string `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
+	// Spec is a cron spec describing how often the prefix is checked for changes.
+	Spec string `protobuf:"bytes,5,opt,name=spec,proto3" json:"spec,omitempty"`
+	Glob string `protobuf:"bytes,6,opt,name=glob,proto3" json:"glob,omitempty"`
+	Lazy bool   `protobuf:"varint,7,opt,name=lazy,proto3" json:"lazy,omitempty"`
+	// Delete, if true, will delete files from the repo when the corresponding
+	// objects are deleted from the bucket.
+	Delete               bool     `protobuf:"varint,8,opt,name=delete,proto3" json:"delete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectInput) Reset()         { *m = ObjectInput{} }
+func (m *ObjectInput) String() string { return proto.CompactTextString(m) }
+func (*ObjectInput) ProtoMessage()    {}
+func (*ObjectInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{10}
+}
+func (m *ObjectInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectInput.Merge(m, src)
+}
+func (m *ObjectInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectInput proto.InternalMessageInfo
+
+func (m *ObjectInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ObjectInput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *ObjectInput) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *ObjectInput) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *ObjectInput) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *ObjectInput) GetGlob() string {
+	if m != nil {
+		return m.Glob
+	}
+	return ""
+}
+
+func (m *ObjectInput) GetLazy() bool {
+	if m != nil {
+		return m.Lazy
+	}
+	return false
+}
+
+func (m *ObjectInput) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
 type Input struct {
-	Pfs                  *PFSInput  `protobuf:"bytes,1,opt,name=pfs,proto3" json:"pfs,omitempty"`
-	Join                 []*Input   `protobuf:"bytes,2,rep,name=join,proto3" json:"join,omitempty"`
-	Group                []*Input   `protobuf:"bytes,3,rep,name=group,proto3" json:"group,omitempty"`
-	Cross                []*Input   `protobuf:"bytes,4,rep,name=cross,proto3" json:"cross,omitempty"`
-	Union                []*Input   `protobuf:"bytes,5,rep,name=union,proto3" json:"union,omitempty"`
-	Cron                 *CronInput `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Pfs                  *PFSInput    `protobuf:"bytes,1,opt,name=pfs,proto3" json:"pfs,omitempty"`
+	Join                 []*Input     `protobuf:"bytes,2,rep,name=join,proto3" json:"join,omitempty"`
+	Group                []*Input     `protobuf:"bytes,3,rep,name=group,proto3" json:"group,omitempty"`
+	Cross                []*Input     `protobuf:"bytes,4,rep,name=cross,proto3" json:"cross,omitempty"`
+	Union                []*Input     `protobuf:"bytes,5,rep,name=union,proto3" json:"union,omitempty"`
+	Cron                 *CronInput   `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
+	Object               *ObjectInput `protobuf:"bytes,7,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{10}
+	return fileDescriptor_beade573c128ccc7, []int{11}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1155,6 +1327,13 @@ func (m *Input) GetCron() *CronInput {
 	return nil
 }
 
+func (m *Input) GetObject() *ObjectInput {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
 type JobInput struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Commit               *pfs.Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -1169,7 +1348,7 @@ func (m *JobInput) Reset()         { *m = JobInput{} }
 func (m *JobInput) String() string { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()    {}
 func (*JobInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{11}
+	return fileDescriptor_beade573c128ccc7, []int{12}
 }
 func (m *JobInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1241,7 +1420,7 @@ func (m *ParallelismSpec) Reset()         { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()    {}
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{12}
+	return fileDescriptor_beade573c128ccc7, []int{13}
 }
 func (m *ParallelismSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1291,7 +1470,7 @@ func (m *InputFile) Reset()         { *m = InputFile{} }
 func (m *InputFile) String() string { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()    {}
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{13}
+	return fileDescriptor_beade573c128ccc7, []int{14}
 }
 func (m *InputFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1347,7 +1526,7 @@ func (m *Datum) Reset()         { *m = Datum{} }
 func (m *Datum) String() string { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()    {}
 func (*Datum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{14}
+	return fileDescriptor_beade573c128ccc7, []int{15}
 }
 func (m *Datum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1405,7 +1584,7 @@ func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
 func (m *DatumInfo) String() string { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()    {}
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{15}
+	return fileDescriptor_beade573c128ccc7, []int{16}
 }
 func (m *DatumInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +1663,7 @@ func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{16}
+	return fileDescriptor_beade573c128ccc7, []int{17}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1549,21 +1728,28 @@ func (m *Aggregate) GetNinetyFifthPercentile() float64 {
 }
 
 type ProcessStats struct {
-	DownloadTime         *types.Duration `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
-	ProcessTime          *types.Duration `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
-	UploadTime           *types.Duration `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
-	DownloadBytes        int64           `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
-	UploadBytes          int64           `protobuf:"varint,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	DownloadTime  *types.Duration `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
+	ProcessTime   *types.Duration `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
+	UploadTime    *types.Duration `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
+	DownloadBytes int64           `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+	UploadBytes   int64           `protobuf:"varint,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
+	// cpu_time is the user and system CPU time consumed by the user code.
+	CpuTime *types.Duration `protobuf:"bytes,6,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	// peak_memory_bytes is the peak resident set size of the user code.
+	PeakMemoryBytes int64 `protobuf:"varint,7,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3" json:"peak_memory_bytes,omitempty"`
+	// scratch_bytes is the peak disk usage of the datum's inputs and outputs,
+	// sampled periodically while the user code runs.
+	ScratchBytes         int64    `protobuf:"varint,8,opt,name=scratch_bytes,json=scratchBytes,proto3" json:"scratch_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
 func (m *ProcessStats) String() string { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()    {}
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{17}
+	return fileDescriptor_beade573c128ccc7, []int{18}
 }
 func (m *ProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1627,12 +1813,36 @@ func (m *ProcessStats) GetUploadBytes() int64 {
 	return 0
 }
 
+func (m *ProcessStats) GetCpuTime() *types.Duration {
+	if m != nil {
+		return m.CpuTime
+	}
+	return nil
+}
+
+func (m *ProcessStats) GetPeakMemoryBytes() int64 {
+	if m != nil {
+		return m.PeakMemoryBytes
+	}
+	return 0
+}
+
+func (m *ProcessStats) GetScratchBytes() int64 {
+	if m != nil {
+		return m.ScratchBytes
+	}
+	return 0
+}
+
 type AggregateProcessStats struct {
 	DownloadTime         *Aggregate `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
 	ProcessTime          *Aggregate `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
 	UploadTime           *Aggregate `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
 	DownloadBytes        *Aggregate `protobuf:"bytes,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
 	UploadBytes          *Aggregate `protobuf:"bytes,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
+	CpuTime              *Aggregate `protobuf:"bytes,6,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	PeakMemoryBytes      *Aggregate `protobuf:"bytes,7,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3" json:"peak_memory_bytes,omitempty"`
+	ScratchBytes         *Aggregate `protobuf:"bytes,8,opt,name=scratch_bytes,json=scratchBytes,proto3" json:"scratch_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1642,7 +1852,7 @@ func (m *AggregateProcessStats) Reset()         { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()    {}
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{18}
+	return fileDescriptor_beade573c128ccc7, []int{19}
 }
 func (m *AggregateProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1706,6 +1916,27 @@ func (m *AggregateProcessStats) GetUploadBytes() *Aggregate {
 	return nil
 }
 
+func (m *AggregateProcessStats) GetCpuTime() *Aggregate {
+	if m != nil {
+		return m.CpuTime
+	}
+	return nil
+}
+
+func (m *AggregateProcessStats) GetPeakMemoryBytes() *Aggregate {
+	if m != nil {
+		return m.PeakMemoryBytes
+	}
+	return nil
+}
+
+func (m *AggregateProcessStats) GetScratchBytes() *Aggregate {
+	if m != nil {
+		return m.ScratchBytes
+	}
+	return nil
+}
+
 type WorkerStatus struct {
 	WorkerID             string       `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
 	JobID                string       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -1719,7 +1950,7 @@ func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()    {}
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{19}
+	return fileDescriptor_beade573c128ccc7, []int{20}
 }
 func (m *WorkerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1782,7 +2013,7 @@ func (m *DatumStatus) Reset()         { *m = DatumStatus{} }
 func (m *DatumStatus) String() string { return proto.CompactTextString(m) }
 func (*DatumStatus) ProtoMessage()    {}
 func (*DatumStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{20}
+	return fileDescriptor_beade573c128ccc7, []int{21}
 }
 func (m *DatumStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +2079,7 @@ func (m *ResourceSpec) Reset()         { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()    {}
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{21}
+	return fileDescriptor_beade573c128ccc7, []int{22}
 }
 func (m *ResourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1919,7 +2150,7 @@ func (m *GPUSpec) Reset()         { *m = GPUSpec{} }
 func (m *GPUSpec) String() string { return proto.CompactTextString(m) }
 func (*GPUSpec) ProtoMessage()    {}
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{22}
+	return fileDescriptor_beade573c128ccc7, []int{23}
 }
 func (m *GPUSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1974,7 +2205,7 @@ func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
 func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
 func (*JobSetInfo) ProtoMessage()    {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{23}
+	return fileDescriptor_beade573c128ccc7, []int{24}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,23 +2265,26 @@ type JobInfo struct {
 	DataFailed    int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
 	DataRecovered int64 `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
 	// Download/process/upload time and download/upload bytes
-	Stats                *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
-	State                JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
-	Created              *types.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
-	Started              *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
-	Finished             *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
-	Details              *JobInfo_Details `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Stats *ProcessStats `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	// The distribution of the process stats of the datums processed by the job,
+	// set when the job's datums have all been processed
+	DatumStats           *AggregateProcessStats `protobuf:"bytes,17,opt,name=datum_stats,json=datumStats,proto3" json:"datum_stats,omitempty"`
+	State                JobState               `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
+	Reason               string                 `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	Created              *types.Timestamp       `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
+	Started              *types.Timestamp       `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
+	Finished             *types.Timestamp       `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
+	Details              *JobInfo_Details       `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *JobInfo) Reset()         { *m = JobInfo{} }
 func (m *JobInfo) String() string { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()    {}
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24}
+	return fileDescriptor_beade573c128ccc7, []int{25}
 }
 func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2149,6 +2383,13 @@ func (m *JobInfo) GetStats() *ProcessStats {
 	return nil
 }
 
+func (m *JobInfo) GetDatumStats() *AggregateProcessStats {
+	if m != nil {
+		return m.DatumStats
+	}
+	return nil
+}
+
 func (m *JobInfo) GetState() JobState {
 	if m != nil {
 		return m.State
@@ -2210,6 +2451,7 @@ type JobInfo_Details struct {
 	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,16,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
 	PodSpec               string           `protobuf:"bytes,17,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
 	PodPatch              string           `protobuf:"bytes,18,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	DeadLetter            bool             `protobuf:"varint,19,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
 	XXX_unrecognized      []byte           `json:"-"`
 	XXX_sizecache         int32            `json:"-"`
@@ -2219,7 +2461,7 @@ func (m *JobInfo_Details) Reset()         { *m = JobInfo_Details{} }
 func (m *JobInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*JobInfo_Details) ProtoMessage()    {}
 func (*JobInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24, 0}
+	return fileDescriptor_beade573c128ccc7, []int{25, 0}
 }
 func (m *JobInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2374,6 +2616,13 @@ func (m *JobInfo_Details) GetPodPatch() string {
 	return ""
 }
 
+func (m *JobInfo_Details) GetDeadLetter() bool {
+	if m != nil {
+		return m.DeadLetter
+	}
+	return false
+}
+
 type Worker struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State                WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.WorkerState" json:"state,omitempty"`
@@ -2386,7 +2635,7 @@ func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{25}
+	return fileDescriptor_beade573c128ccc7, []int{26}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2440,7 +2689,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{26}
+	return fileDescriptor_beade573c128ccc7, []int{27}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2757,7 @@ func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()    {}
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27}
+	return fileDescriptor_beade573c128ccc7, []int{28}
 }
 func (m *PipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2649,6 +2898,7 @@ type PipelineInfo_Details struct {
 	UnclaimedTasks        int64            `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
 	WorkerRc              string           `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
 	Autoscaling           bool             `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	DeadLetter            bool             `protobuf:"varint,34,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
 	XXX_unrecognized      []byte           `json:"-"`
 	XXX_sizecache         int32            `json:"-"`
@@ -2658,7 +2908,7 @@ func (m *PipelineInfo_Details) Reset()         { *m = PipelineInfo_Details{} }
 func (m *PipelineInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo_Details) ProtoMessage()    {}
 func (*PipelineInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 0}
+	return fileDescriptor_beade573c128ccc7, []int{28, 0}
 }
 func (m *PipelineInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2904,6 +3154,13 @@ func (m *PipelineInfo_Details) GetAutoscaling() bool {
 	return false
 }
 
+func (m *PipelineInfo_Details) GetDeadLetter() bool {
+	if m != nil {
+		return m.DeadLetter
+	}
+	return false
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -2915,7 +3172,7 @@ func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()    {}
 func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{28}
+	return fileDescriptor_beade573c128ccc7, []int{29}
 }
 func (m *PipelineInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2962,7 +3219,7 @@ func (m *JobSet) Reset()         { *m = JobSet{} }
 func (m *JobSet) String() string { return proto.CompactTextString(m) }
 func (*JobSet) ProtoMessage()    {}
 func (*JobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{29}
+	return fileDescriptor_beade573c128ccc7, []int{30}
 }
 func (m *JobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3011,7 +3268,7 @@ func (m *InspectJobSetRequest) Reset()         { *m = InspectJobSetRequest{} }
 func (m *InspectJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobSetRequest) ProtoMessage()    {}
 func (*InspectJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{30}
+	return fileDescriptor_beade573c128ccc7, []int{31}
 }
 func (m *InspectJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3072,7 +3329,7 @@ func (m *ListJobSetRequest) Reset()         { *m = ListJobSetRequest{} }
 func (m *ListJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobSetRequest) ProtoMessage()    {}
 func (*ListJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{31}
+	return fileDescriptor_beade573c128ccc7, []int{32}
 }
 func (m *ListJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3122,7 +3379,7 @@ func (m *InspectJobRequest) Reset()         { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()    {}
 func (*InspectJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{32}
+	return fileDescriptor_beade573c128ccc7, []int{33}
 }
 func (m *InspectJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3200,7 +3457,7 @@ func (m *ListJobRequest) Reset()         { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()    {}
 func (*ListJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{33}
+	return fileDescriptor_beade573c128ccc7, []int{34}
 }
 func (m *ListJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3277,7 +3534,7 @@ func (m *SubscribeJobRequest) Reset()         { *m = SubscribeJobRequest{} }
 func (m *SubscribeJobRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeJobRequest) ProtoMessage()    {}
 func (*SubscribeJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{34}
+	return fileDescriptor_beade573c128ccc7, []int{35}
 }
 func (m *SubscribeJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3331,7 +3588,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{35}
+	return fileDescriptor_beade573c128ccc7, []int{36}
 }
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3379,7 +3636,7 @@ func (m *StopJobRequest) Reset()         { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()    {}
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{36}
+	return fileDescriptor_beade573c128ccc7, []int{37}
 }
 func (m *StopJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3442,7 +3699,7 @@ func (m *UpdateJobStateRequest) Reset()         { *m = UpdateJobStateRequest{} }
 func (m *UpdateJobStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobStateRequest) ProtoMessage()    {}
 func (*UpdateJobStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{37}
+	return fileDescriptor_beade573c128ccc7, []int{38}
 }
 func (m *UpdateJobStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3577,7 +3834,7 @@ func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()    {}
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{38}
+	return fileDescriptor_beade573c128ccc7, []int{39}
 }
 func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3695,7 +3952,7 @@ func (m *LogMessage) Reset()         { *m = LogMessage{} }
 func (m *LogMessage) String() string { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()    {}
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{39}
+	return fileDescriptor_beade573c128ccc7, []int{40}
 }
 func (m *LogMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3799,7 +4056,7 @@ func (m *RestartDatumRequest) Reset()         { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()    {}
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{40}
+	return fileDescriptor_beade573c128ccc7, []int{41}
 }
 func (m *RestartDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3853,7 +4110,7 @@ func (m *InspectDatumRequest) Reset()         { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()    {}
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{41}
+	return fileDescriptor_beade573c128ccc7, []int{42}
 }
 func (m *InspectDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3889,25 +4146,207 @@ func (m *InspectDatumRequest) GetDatum() *Datum {
 	return nil
 }
 
-type ListDatumRequest struct {
-	// Job and Input are two different ways to specify the datums you want.
-	// Only one can be set.
-	// Job is the job to list datums from.
-	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	// Input is the input to list datums from.
-	// The datums listed are the ones that would be run if a pipeline was created
-	// with the provided input.
-	Input                *Input   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+type ExplainDatumRequest struct {
+	Datum                *Datum   `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
-func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
-func (*ListDatumRequest) ProtoMessage()    {}
+func (m *ExplainDatumRequest) Reset()         { *m = ExplainDatumRequest{} }
+func (m *ExplainDatumRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainDatumRequest) ProtoMessage()    {}
+func (*ExplainDatumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{43}
+}
+func (m *ExplainDatumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainDatumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainDatumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainDatumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainDatumRequest.Merge(m, src)
+}
+func (m *ExplainDatumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainDatumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainDatumRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainDatumRequest proto.InternalMessageInfo
+
+func (m *ExplainDatumRequest) GetDatum() *Datum {
+	if m != nil {
+		return m.Datum
+	}
+	return nil
+}
+
+// ExplainDatumResponse explains why a datum was processed or skipped by a job.
+type ExplainDatumResponse struct {
+	Datum *Datum     `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
+	State DatumState `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.DatumState" json:"state,omitempty"`
+	// data are the datum's input files, including their hashes.
+	Data []*pfs.FileInfo `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// hash is computed from the datum ID, the input file hashes and the
+	// pipeline salt. A datum is only skipped if its parent has the same hash.
+	Hash string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Salt string `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+	// parent_job is the job the datum was compared against, which is the most
+	// recent successful ancestor of the job. It is unset if there is none.
+	ParentJob *Job `protobuf:"bytes,6,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
+	// parent_match is true if a datum with the same ID exists in parent_job.
+	ParentMatch bool       `protobuf:"varint,7,opt,name=parent_match,json=parentMatch,proto3" json:"parent_match,omitempty"`
+	ParentHash  string     `protobuf:"bytes,8,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	ParentState DatumState `protobuf:"varint,9,opt,name=parent_state,json=parentState,proto3,enum=pps_v2.DatumState" json:"parent_state,omitempty"`
+	ParentSalt  string     `protobuf:"bytes,10,opt,name=parent_salt,json=parentSalt,proto3" json:"parent_salt,omitempty"`
+	// reason is a human readable explanation of the decision.
+	Reason               string   `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainDatumResponse) Reset()         { *m = ExplainDatumResponse{} }
+func (m *ExplainDatumResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainDatumResponse) ProtoMessage()    {}
+func (*ExplainDatumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{44}
+}
+func (m *ExplainDatumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainDatumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainDatumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainDatumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainDatumResponse.Merge(m, src)
+}
+func (m *ExplainDatumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainDatumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainDatumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainDatumResponse proto.InternalMessageInfo
+
+func (m *ExplainDatumResponse) GetDatum() *Datum {
+	if m != nil {
+		return m.Datum
+	}
+	return nil
+}
+
+func (m *ExplainDatumResponse) GetState() DatumState {
+	if m != nil {
+		return m.State
+	}
+	return DatumState_UNKNOWN
+}
+
+func (m *ExplainDatumResponse) GetData() []*pfs.FileInfo {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExplainDatumResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ExplainDatumResponse) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *ExplainDatumResponse) GetParentJob() *Job {
+	if m != nil {
+		return m.ParentJob
+	}
+	return nil
+}
+
+func (m *ExplainDatumResponse) GetParentMatch() bool {
+	if m != nil {
+		return m.ParentMatch
+	}
+	return false
+}
+
+func (m *ExplainDatumResponse) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *ExplainDatumResponse) GetParentState() DatumState {
+	if m != nil {
+		return m.ParentState
+	}
+	return DatumState_UNKNOWN
+}
+
+func (m *ExplainDatumResponse) GetParentSalt() string {
+	if m != nil {
+		return m.ParentSalt
+	}
+	return ""
+}
+
+func (m *ExplainDatumResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ListDatumRequest struct {
+	// Job and Input are two different ways to specify the datums you want.
+	// Only one can be set.
+	// Job is the job to list datums from.
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Input is the input to list datums from.
+	// The datums listed are the ones that would be run if a pipeline was created
+	// with the provided input.
+	Input                *Input   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
+func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatumRequest) ProtoMessage()    {}
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{42}
+	return fileDescriptor_beade573c128ccc7, []int{45}
 }
 func (m *ListDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3972,7 +4411,7 @@ func (m *DatumSetSpec) Reset()         { *m = DatumSetSpec{} }
 func (m *DatumSetSpec) String() string { return proto.CompactTextString(m) }
 func (*DatumSetSpec) ProtoMessage()    {}
 func (*DatumSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{43}
+	return fileDescriptor_beade573c128ccc7, []int{46}
 }
 func (m *DatumSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4034,7 +4473,7 @@ func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
 func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
 func (*SchedulingSpec) ProtoMessage()    {}
 func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{44}
+	return fileDescriptor_beade573c128ccc7, []int{47}
 }
 func (m *SchedulingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4101,31 +4540,36 @@ type CreatePipelineRequest struct {
 	Description           string        `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	Service              *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec         *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumTries           int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	Autoscaling          bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	Service        *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec   *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout   *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumTries     int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec  string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	Autoscaling    bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	// dead_letter, if set, quarantines datums that fail after exhausting
+	// datum_tries instead of failing the job. Each quarantined datum is recorded
+	// on the "deadletter" branch of the pipeline's meta repo and is skipped by
+	// subsequent jobs until it is replayed with ReplayDeadLetter.
+	DeadLetter           bool     `protobuf:"varint,31,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{45}
+	return fileDescriptor_beade573c128ccc7, []int{48}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4350,6 +4794,13 @@ func (m *CreatePipelineRequest) GetAutoscaling() bool {
 	return false
 }
 
+func (m *CreatePipelineRequest) GetDeadLetter() bool {
+	if m != nil {
+		return m.DeadLetter
+	}
+	return false
+}
+
 type InspectPipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	// When true, return PipelineInfos with the details field, which requires
@@ -4364,7 +4815,7 @@ func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{}
 func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()    {}
 func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{46}
+	return fileDescriptor_beade573c128ccc7, []int{49}
 }
 func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4432,7 +4883,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{47}
+	return fileDescriptor_beade573c128ccc7, []int{50}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4503,7 +4954,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{48}
+	return fileDescriptor_beade573c128ccc7, []int{51}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4571,7 +5022,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{49}
+	return fileDescriptor_beade573c128ccc7, []int{52}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4618,7 +5069,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{50}
+	return fileDescriptor_beade573c128ccc7, []int{53}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4667,7 +5118,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{51}
+	return fileDescriptor_beade573c128ccc7, []int{54}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4718,17 +5169,21 @@ func (m *RunPipelineRequest) GetJobID() string {
 }
 
 type RunCronRequest struct {
-	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// At, if set, is the time of the tick to make, which allows a specific
+	// (e.g. historical) tick to be materialized. If unset, the tick is made at
+	// the current time.
+	At                   *types.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{52}
+	return fileDescriptor_beade573c128ccc7, []int{55}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4764,6 +5219,70 @@ func (m *RunCronRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *RunCronRequest) GetAt() *types.Timestamp {
+	if m != nil {
+		return m.At
+	}
+	return nil
+}
+
+type ReplayDeadLetterRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// datums are the IDs of the quarantined datums to replay. If empty, every
+	// quarantined datum is replayed.
+	Datums               []string `protobuf:"bytes,2,rep,name=datums,proto3" json:"datums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayDeadLetterRequest) Reset()         { *m = ReplayDeadLetterRequest{} }
+func (m *ReplayDeadLetterRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayDeadLetterRequest) ProtoMessage()    {}
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{56}
+}
+func (m *ReplayDeadLetterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayDeadLetterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayDeadLetterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayDeadLetterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayDeadLetterRequest.Merge(m, src)
+}
+func (m *ReplayDeadLetterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayDeadLetterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayDeadLetterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayDeadLetterRequest proto.InternalMessageInfo
+
+func (m *ReplayDeadLetterRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *ReplayDeadLetterRequest) GetDatums() []string {
+	if m != nil {
+		return m.Datums
+	}
+	return nil
+}
+
 type CreateSecretRequest struct {
 	File                 []byte   `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4775,7 +5294,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{53}
+	return fileDescriptor_beade573c128ccc7, []int{57}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4822,7 +5341,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{54}
+	return fileDescriptor_beade573c128ccc7, []int{58}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4859,17 +5378,21 @@ func (m *DeleteSecretRequest) GetSecret() *Secret {
 }
 
 type InspectSecretRequest struct {
-	Secret               *Secret  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// If set, the secret is inspected on behalf of this pipeline, which
+	// requires PIPELINE_READ_SECRETS on the pipeline rather than SECRET_INSPECT
+	// on the cluster. The pipeline must use the secret.
+	Pipeline             *Pipeline `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{55}
+	return fileDescriptor_beade573c128ccc7, []int{59}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4905,6 +5428,13 @@ func (m *InspectSecretRequest) GetSecret() *Secret {
 	return nil
 }
 
+func (m *InspectSecretRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
 type Secret struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4916,7 +5446,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{56}
+	return fileDescriptor_beade573c128ccc7, []int{60}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4965,7 +5495,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{57}
+	return fileDescriptor_beade573c128ccc7, []int{61}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5026,7 +5556,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{58}
+	return fileDescriptor_beade573c128ccc7, []int{62}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5072,7 +5602,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{59}
+	return fileDescriptor_beade573c128ccc7, []int{63}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5111,7 +5641,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{60}
+	return fileDescriptor_beade573c128ccc7, []int{64}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5152,7 +5682,7 @@ func (m *RenderTemplateRequest) Reset()         { *m = RenderTemplateRequest{} }
 func (m *RenderTemplateRequest) String() string { return proto.CompactTextString(m) }
 func (*RenderTemplateRequest) ProtoMessage()    {}
 func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{61}
+	return fileDescriptor_beade573c128ccc7, []int{65}
 }
 func (m *RenderTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5207,7 +5737,7 @@ func (m *RenderTemplateResponse) Reset()         { *m = RenderTemplateResponse{}
 func (m *RenderTemplateResponse) String() string { return proto.CompactTextString(m) }
 func (*RenderTemplateResponse) ProtoMessage()    {}
 func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{62}
+	return fileDescriptor_beade573c128ccc7, []int{66}
 }
 func (m *RenderTemplateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5252,6 +5782,7 @@ func (m *RenderTemplateResponse) GetSpecs() []*CreatePipelineRequest {
 
 func init() {
 	proto.RegisterEnum("pps_v2.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("pps_v2.CronCatchUp", CronCatchUp_name, CronCatchUp_value)
 	proto.RegisterEnum("pps_v2.DatumState", DatumState_name, DatumState_value)
 	proto.RegisterEnum("pps_v2.WorkerState", WorkerState_name, WorkerState_value)
 	proto.RegisterEnum("pps_v2.PipelineState", PipelineState_name, PipelineState_value)
@@ -5269,6 +5800,7 @@ func init() {
 	proto.RegisterType((*Spout)(nil), "pps_v2.Spout")
 	proto.RegisterType((*PFSInput)(nil), "pps_v2.PFSInput")
 	proto.RegisterType((*CronInput)(nil), "pps_v2.CronInput")
+	proto.RegisterType((*ObjectInput)(nil), "pps_v2.ObjectInput")
 	proto.RegisterType((*Input)(nil), "pps_v2.Input")
 	proto.RegisterType((*JobInput)(nil), "pps_v2.JobInput")
 	proto.RegisterType((*ParallelismSpec)(nil), "pps_v2.ParallelismSpec")
@@ -5303,6 +5835,8 @@ func init() {
 	proto.RegisterType((*LogMessage)(nil), "pps_v2.LogMessage")
 	proto.RegisterType((*RestartDatumRequest)(nil), "pps_v2.RestartDatumRequest")
 	proto.RegisterType((*InspectDatumRequest)(nil), "pps_v2.InspectDatumRequest")
+	proto.RegisterType((*ExplainDatumRequest)(nil), "pps_v2.ExplainDatumRequest")
+	proto.RegisterType((*ExplainDatumResponse)(nil), "pps_v2.ExplainDatumResponse")
 	proto.RegisterType((*ListDatumRequest)(nil), "pps_v2.ListDatumRequest")
 	proto.RegisterType((*DatumSetSpec)(nil), "pps_v2.DatumSetSpec")
 	proto.RegisterType((*SchedulingSpec)(nil), "pps_v2.SchedulingSpec")
@@ -5315,6 +5849,7 @@ func init() {
 	proto.RegisterType((*StopPipelineRequest)(nil), "pps_v2.StopPipelineRequest")
 	proto.RegisterType((*RunPipelineRequest)(nil), "pps_v2.RunPipelineRequest")
 	proto.RegisterType((*RunCronRequest)(nil), "pps_v2.RunCronRequest")
+	proto.RegisterType((*ReplayDeadLetterRequest)(nil), "pps_v2.ReplayDeadLetterRequest")
 	proto.RegisterType((*CreateSecretRequest)(nil), "pps_v2.CreateSecretRequest")
 	proto.RegisterType((*DeleteSecretRequest)(nil), "pps_v2.DeleteSecretRequest")
 	proto.RegisterType((*InspectSecretRequest)(nil), "pps_v2.InspectSecretRequest")
@@ -5331,307 +5866,335 @@ func init() {
 func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
 var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x4b, 0x73, 0x1c, 0x47,
-	0x72, 0xe6, 0xbc, 0x67, 0x72, 0x1e, 0x18, 0x14, 0x1e, 0x1c, 0x0e, 0xdf, 0x2d, 0x2f, 0x45, 0x72,
-	0x25, 0x40, 0x02, 0xb5, 0xdc, 0x15, 0xb5, 0xa2, 0x16, 0x8f, 0x21, 0x05, 0x12, 0x02, 0xa1, 0x9e,
-	0x81, 0x14, 0xda, 0xb0, 0xa3, 0xb7, 0x67, 0xba, 0x66, 0xd0, 0x44, 0x4f, 0x77, 0xab, 0xab, 0x1b,
-	0x34, 0x74, 0xb1, 0x8f, 0x0e, 0x87, 0x4f, 0x96, 0x0f, 0x3e, 0xfa, 0xe2, 0xc3, 0x3a, 0xc2, 0x61,
-	0xff, 0x03, 0xdb, 0x11, 0x3e, 0xd8, 0xb7, 0x3d, 0xd9, 0x07, 0x47, 0x28, 0x1c, 0x0c, 0x5f, 0xf7,
-	0x0f, 0xf8, 0xb4, 0x51, 0xaf, 0x7e, 0xcc, 0x34, 0x06, 0x2f, 0x5d, 0x88, 0xaa, 0xcc, 0xac, 0xac,
-	0xaa, 0xac, 0xaa, 0xcc, 0x2f, 0x73, 0x9a, 0x50, 0x77, 0x5d, 0xb2, 0xea, 0xba, 0x64, 0xc5, 0xf5,
-	0x1c, 0xdf, 0x41, 0x45, 0xd7, 0x25, 0xda, 0xd1, 0x5a, 0xfb, 0xfa, 0xc8, 0x71, 0x46, 0x16, 0x5e,
-	0x65, 0xd4, 0x7e, 0x30, 0x5c, 0xc5, 0x63, 0xd7, 0x3f, 0xe6, 0x42, 0xed, 0xdb, 0x93, 0x4c, 0xdf,
-	0x1c, 0x63, 0xe2, 0xeb, 0x63, 0x57, 0x08, 0xdc, 0x9a, 0x14, 0x30, 0x02, 0x4f, 0xf7, 0x4d, 0xc7,
-	0x16, 0xfc, 0xc5, 0x91, 0x33, 0x72, 0x58, 0x73, 0x95, 0xb6, 0x04, 0xb5, 0xee, 0x0e, 0xc9, 0xaa,
-	0x3b, 0x14, 0x4b, 0x69, 0xcf, 0xf9, 0x3a, 0x39, 0x5c, 0xa5, 0xff, 0x70, 0x82, 0x72, 0x08, 0xd5,
-	0x2e, 0x1e, 0x78, 0xd8, 0xff, 0xc2, 0x09, 0x6c, 0x1f, 0x21, 0xc8, 0xdb, 0xfa, 0x18, 0xb7, 0x32,
-	0x77, 0x32, 0xf7, 0x2b, 0x2a, 0x6b, 0xa3, 0x26, 0xe4, 0x0e, 0xf1, 0x71, 0x2b, 0xcb, 0x48, 0xb4,
-	0x89, 0x6e, 0x02, 0x8c, 0xa9, 0xb8, 0xe6, 0xea, 0xfe, 0x41, 0x2b, 0xc7, 0x18, 0x15, 0x46, 0xd9,
-	0xd3, 0xfd, 0x03, 0x74, 0x15, 0x4a, 0xd8, 0x3e, 0xd2, 0x8e, 0x74, 0xaf, 0x95, 0x67, 0xbc, 0x22,
-	0xb6, 0x8f, 0xbe, 0xd2, 0x3d, 0xe5, 0x7f, 0x72, 0x50, 0xe9, 0x79, 0xba, 0x4d, 0x86, 0x8e, 0x37,
-	0x46, 0x8b, 0x50, 0x30, 0xc7, 0xfa, 0x48, 0x4e, 0xc6, 0x3b, 0x74, 0xb6, 0xc1, 0xd8, 0x68, 0x65,
-	0xef, 0xe4, 0xe8, 0x6c, 0x83, 0xb1, 0xc1, 0xd4, 0x79, 0x9e, 0x46, 0xa9, 0x39, 0x46, 0x2d, 0x62,
-	0xcf, 0xdb, 0x1c, 0x1b, 0xe8, 0x3d, 0xc8, 0x61, 0xfb, 0xa8, 0x95, 0xbf, 0x93, 0xbb, 0x5f, 0x5d,
-	0x6b, 0xaf, 0x70, 0x2b, 0xaf, 0x84, 0x13, 0xac, 0x74, 0xec, 0xa3, 0x8e, 0xed, 0x7b, 0xc7, 0x2a,
-	0x15, 0x43, 0xef, 0x43, 0x89, 0xb0, 0x9d, 0x92, 0x56, 0x81, 0x8d, 0x58, 0x90, 0x23, 0x62, 0x06,
-	0x50, 0xa5, 0x0c, 0x7a, 0x0f, 0x10, 0x5b, 0x90, 0xe6, 0x06, 0x96, 0xa5, 0xc9, 0x91, 0x45, 0xb6,
-	0x80, 0x26, 0xe3, 0xec, 0x05, 0x96, 0xd5, 0x15, 0xd2, 0x8b, 0x50, 0x20, 0xbe, 0x61, 0xda, 0xad,
-	0x12, 0x13, 0xe0, 0x1d, 0x74, 0x1d, 0x2a, 0x74, 0xe5, 0x9c, 0x53, 0x66, 0x9c, 0x32, 0xf6, 0xbc,
-	0x2e, 0x63, 0xbe, 0x07, 0x48, 0x1f, 0x0c, 0xb0, 0xeb, 0x6b, 0x1e, 0xf6, 0x03, 0xcf, 0xd6, 0x06,
-	0x8e, 0x81, 0x5b, 0x95, 0x3b, 0xb9, 0xfb, 0x39, 0xb5, 0xc9, 0x39, 0x2a, 0x63, 0x6c, 0x3a, 0x06,
-	0xa6, 0x13, 0x18, 0xb8, 0x1f, 0x8c, 0x5a, 0x70, 0x27, 0x73, 0xbf, 0xac, 0xf2, 0x0e, 0x3d, 0xae,
-	0x80, 0x60, 0xaf, 0x55, 0xe5, 0xc7, 0x45, 0xdb, 0xe8, 0x36, 0x54, 0xdf, 0x38, 0xde, 0xa1, 0x69,
-	0x8f, 0x34, 0xc3, 0xf4, 0x5a, 0x35, 0xc6, 0x02, 0x41, 0xda, 0x32, 0x3d, 0x74, 0x0b, 0xc0, 0x70,
-	0x06, 0x87, 0xd8, 0x1b, 0x9a, 0x16, 0x6e, 0xd5, 0x39, 0x3f, 0xa2, 0xb4, 0x1f, 0x43, 0x59, 0x5a,
-	0x4e, 0x9e, 0x7d, 0x26, 0x3a, 0xfb, 0x45, 0x28, 0x1c, 0xe9, 0x56, 0x80, 0xc5, 0x7d, 0xe0, 0x9d,
-	0x27, 0xd9, 0x5f, 0x64, 0x94, 0x07, 0x50, 0xe8, 0x3d, 0x7b, 0xe1, 0xf4, 0xd1, 0x1d, 0x28, 0xfa,
-	0x43, 0xed, 0xb5, 0xd3, 0xe7, 0xe3, 0x36, 0x2a, 0x6f, 0x7f, 0xb8, 0xcd, 0x59, 0x6a, 0xc1, 0x1f,
-	0xbe, 0x70, 0xfa, 0xca, 0x3f, 0x64, 0xa0, 0xd8, 0x19, 0x79, 0x98, 0x10, 0x3a, 0xc3, 0xbe, 0xba,
-	0x23, 0x67, 0xd8, 0x57, 0x77, 0xd0, 0x16, 0x34, 0x9c, 0xfe, 0x6b, 0x3c, 0xf0, 0x35, 0xe2, 0x3b,
-	0x1e, 0xbd, 0x20, 0x74, 0xaa, 0xea, 0xda, 0xf5, 0x15, 0x77, 0xc8, 0xce, 0xeb, 0x15, 0xe3, 0x76,
-	0x39, 0x93, 0xab, 0xf9, 0xfc, 0x8a, 0x5a, 0x77, 0xe2, 0x64, 0xf4, 0x14, 0x6a, 0xe4, 0x5b, 0x4b,
-	0x33, 0x74, 0x5f, 0xef, 0xeb, 0x04, 0xb3, 0x5b, 0x5a, 0x5d, 0xbb, 0x26, 0x75, 0x74, 0xbf, 0xdc,
-	0xd9, 0x12, 0xac, 0x50, 0x43, 0x95, 0x7c, 0x6b, 0x49, 0xe2, 0x46, 0x19, 0x8a, 0xbe, 0xee, 0x8d,
-	0xb0, 0xaf, 0x7c, 0x09, 0x39, 0xba, 0xab, 0xf7, 0xa0, 0xec, 0x9a, 0x2e, 0xb6, 0x4c, 0x9b, 0xdf,
-	0xd8, 0xea, 0x5a, 0x53, 0x5e, 0xa0, 0x3d, 0x41, 0x57, 0x43, 0x09, 0xb4, 0x0c, 0x59, 0xd3, 0xe0,
-	0x36, 0xda, 0x28, 0xbe, 0xfd, 0xe1, 0x76, 0x76, 0x7b, 0x4b, 0xcd, 0x9a, 0xc6, 0x93, 0xfc, 0xdf,
-	0xfe, 0xdd, 0xed, 0x2b, 0xca, 0x9f, 0x67, 0xa1, 0xfc, 0x05, 0xf6, 0x75, 0xba, 0x3a, 0xb4, 0x09,
-	0x55, 0xdd, 0xb6, 0x1d, 0x9f, 0x3d, 0x66, 0xd2, 0xca, 0xb0, 0xcb, 0x79, 0x57, 0xea, 0x96, 0x62,
-	0x2b, 0xeb, 0x91, 0x0c, 0xbf, 0xd5, 0xf1, 0x51, 0xe8, 0x23, 0x28, 0x5a, 0x7a, 0x1f, 0x5b, 0x84,
-	0xbd, 0x9c, 0xea, 0xda, 0x8d, 0xa9, 0xf1, 0x3b, 0x8c, 0xcd, 0x87, 0x0a, 0xd9, 0xf6, 0x53, 0x68,
-	0x4e, 0xaa, 0x3d, 0xcf, 0x91, 0xb7, 0x3f, 0x86, 0x6a, 0x4c, 0xed, 0xb9, 0x6e, 0xcb, 0x9f, 0x41,
-	0xa9, 0x8b, 0xbd, 0x23, 0x73, 0x80, 0xd1, 0x3b, 0x50, 0x37, 0x6d, 0x1f, 0x7b, 0xb6, 0x6e, 0x69,
-	0xae, 0xe3, 0xf9, 0x4c, 0x41, 0x41, 0xad, 0x49, 0xe2, 0x9e, 0xe3, 0xf9, 0x54, 0x08, 0xff, 0x69,
-	0x5c, 0x28, 0xcb, 0x85, 0x24, 0x91, 0x09, 0x51, 0xab, 0xbb, 0xdc, 0x21, 0x09, 0xab, 0xef, 0xa9,
-	0x59, 0xd3, 0xa5, 0xef, 0xc4, 0x3f, 0x76, 0xb1, 0x70, 0x47, 0xac, 0xad, 0xac, 0x41, 0xa1, 0xeb,
-	0x3a, 0x81, 0x8f, 0x1e, 0x50, 0xc7, 0xc0, 0x56, 0x22, 0xce, 0x75, 0x2e, 0x72, 0x0c, 0x8c, 0xac,
-	0x4a, 0xbe, 0xf2, 0x5f, 0x59, 0x28, 0xef, 0x3d, 0xeb, 0x6e, 0xdb, 0x6e, 0x90, 0xee, 0x2b, 0x11,
-	0xe4, 0x3d, 0xec, 0x3a, 0x62, 0xbb, 0xac, 0x4d, 0xbd, 0x00, 0xfd, 0xab, 0xb1, 0x15, 0xf0, 0xe7,
-	0x56, 0xa6, 0x84, 0xde, 0xb1, 0x4b, 0xef, 0x49, 0xb1, 0xef, 0xe9, 0xf6, 0x40, 0xba, 0x51, 0xd1,
-	0xa3, 0xf4, 0x81, 0x33, 0x1e, 0x9b, 0xbe, 0x74, 0xa1, 0xbc, 0x47, 0x27, 0x18, 0x59, 0x4e, 0xbf,
-	0x55, 0xe0, 0x13, 0xd0, 0x36, 0x75, 0x90, 0xaf, 0x1d, 0xd3, 0xd6, 0x1c, 0xbb, 0x55, 0xe4, 0xc2,
-	0xb4, 0xfb, 0xca, 0xa6, 0x7e, 0xda, 0x09, 0x7c, 0xec, 0x69, 0xb4, 0xdf, 0x2a, 0x31, 0xcf, 0x51,
-	0x61, 0x94, 0x17, 0x8e, 0x69, 0xa3, 0x6b, 0x50, 0x1e, 0x79, 0x4e, 0xe0, 0x6a, 0xfd, 0xe3, 0x56,
-	0x99, 0x0d, 0x2c, 0xb1, 0xfe, 0xc6, 0x31, 0x9d, 0xc6, 0xd2, 0xbf, 0x3b, 0x6e, 0x55, 0xd8, 0x18,
-	0xd6, 0xa6, 0x8e, 0x85, 0x05, 0x2c, 0x8d, 0x7a, 0x09, 0x22, 0x1c, 0x11, 0x30, 0xd2, 0x33, 0x4a,
-	0x41, 0x0d, 0xc8, 0x92, 0x47, 0xcc, 0x17, 0x95, 0xd5, 0x2c, 0x79, 0x44, 0x0d, 0xeb, 0x7b, 0xe6,
-	0x68, 0x84, 0xb9, 0x17, 0x62, 0x86, 0x1d, 0x0a, 0x1f, 0xcd, 0xc8, 0xaa, 0xe4, 0x2b, 0xff, 0x94,
-	0x81, 0xca, 0xa6, 0xe7, 0xd8, 0xe7, 0xb3, 0x6c, 0x64, 0xa4, 0xdc, 0xa4, 0x91, 0x88, 0x8b, 0x07,
-	0xf2, 0xb8, 0x69, 0x1b, 0xdd, 0x80, 0x8a, 0x73, 0x84, 0xbd, 0x37, 0x9e, 0xe9, 0x63, 0x66, 0x3d,
-	0x6a, 0x0a, 0x49, 0x40, 0x1f, 0x50, 0xff, 0xad, 0x7b, 0x3e, 0x33, 0x20, 0x0d, 0x26, 0x3c, 0xd8,
-	0xae, 0xc8, 0x60, 0xbb, 0xd2, 0x93, 0xd1, 0x58, 0xe5, 0x82, 0xca, 0xff, 0x65, 0xa0, 0xc0, 0x57,
-	0xab, 0x40, 0xce, 0x1d, 0x92, 0x29, 0x9f, 0x20, 0xae, 0x89, 0x4a, 0x99, 0xe8, 0x2e, 0xe4, 0xd9,
-	0x19, 0xf0, 0xc7, 0x59, 0x97, 0x42, 0x5c, 0x82, 0xb1, 0xd0, 0x3b, 0x50, 0x60, 0xd6, 0x67, 0x41,
-	0x6e, 0x4a, 0x86, 0xf3, 0xa8, 0xd0, 0xc0, 0x73, 0x08, 0x11, 0x41, 0x6f, 0x52, 0x88, 0xf1, 0xa8,
-	0x50, 0x60, 0x9b, 0x8e, 0x2d, 0xe2, 0xdc, 0xa4, 0x10, 0xe3, 0xa1, 0x9f, 0x40, 0x7e, 0xe0, 0x89,
-	0x1b, 0x53, 0x5d, 0x9b, 0x97, 0x32, 0xe1, 0x21, 0xa8, 0x8c, 0xad, 0xd8, 0x50, 0x7e, 0xe1, 0xf4,
-	0x4f, 0x3e, 0x96, 0x7b, 0xe1, 0x11, 0x70, 0x27, 0xdd, 0x90, 0x47, 0xbc, 0xc9, 0xa8, 0x53, 0xf7,
-	0x36, 0x17, 0xbb, 0xb7, 0xf2, 0x92, 0xe5, 0xa3, 0x4b, 0xa6, 0xbc, 0x0f, 0x73, 0x7b, 0xba, 0xa7,
-	0x5b, 0x16, 0xb6, 0x4c, 0x32, 0xee, 0xd2, 0x93, 0x6b, 0x43, 0x79, 0xe0, 0xd8, 0xc4, 0xd7, 0x6d,
-	0xee, 0x19, 0xf2, 0x6a, 0xd8, 0x57, 0x1e, 0x41, 0x85, 0xad, 0x8d, 0x5e, 0x40, 0xaa, 0x8f, 0x01,
-	0x12, 0xb1, 0x3e, 0xda, 0xa6, 0xb4, 0x03, 0x9d, 0x1c, 0xb0, 0xd5, 0xd5, 0x54, 0xd6, 0x56, 0x9e,
-	0x42, 0x61, 0x4b, 0xf7, 0x83, 0x31, 0xba, 0x09, 0x39, 0x19, 0xa5, 0xaa, 0x6b, 0x55, 0x69, 0x02,
-	0x1a, 0xa7, 0x28, 0xfd, 0x24, 0x1f, 0xae, 0xfc, 0x77, 0x06, 0x2a, 0x4c, 0xc1, 0xb6, 0x3d, 0x74,
-	0xa8, 0xb5, 0x0d, 0xda, 0x11, 0x6a, 0x42, 0x6b, 0x33, 0x09, 0x95, 0xf3, 0xd0, 0x7d, 0x76, 0xbf,
-	0x7c, 0xee, 0x07, 0x1b, 0x6b, 0x28, 0x21, 0xd4, 0xa5, 0x1c, 0x95, 0x0b, 0xa0, 0x87, 0x5c, 0x92,
-	0x88, 0x80, 0xb5, 0x18, 0xde, 0x27, 0xcf, 0x19, 0x60, 0x42, 0xa8, 0x2c, 0xe1, 0xb2, 0x04, 0x3d,
-	0x80, 0x0a, 0xb5, 0x36, 0xd7, 0x9c, 0x67, 0xf2, 0x35, 0x69, 0x7f, 0x6a, 0x11, 0xb5, 0xec, 0x0e,
-	0xd9, 0x08, 0x8c, 0xfe, 0x08, 0xf2, 0x34, 0x0a, 0x88, 0x2b, 0xd1, 0x8c, 0x4b, 0xd1, 0x5d, 0xa8,
-	0x8c, 0xab, 0xfc, 0x73, 0x06, 0x2a, 0xeb, 0xa3, 0x91, 0x87, 0x47, 0x74, 0xcc, 0x22, 0x14, 0x06,
-	0x14, 0x14, 0xb1, 0x9d, 0xe5, 0x54, 0xde, 0xa1, 0x16, 0x1d, 0x63, 0xdd, 0x66, 0x3b, 0xc9, 0xa8,
-	0xac, 0x4d, 0x1f, 0x22, 0xf1, 0x0d, 0x03, 0x1f, 0xb1, 0x55, 0x67, 0x54, 0xd1, 0x43, 0x0f, 0xa0,
-	0x39, 0x34, 0x87, 0xfe, 0x81, 0xe6, 0x62, 0x6f, 0x80, 0x6d, 0x9f, 0x02, 0x8e, 0x3c, 0x93, 0x98,
-	0x63, 0xf4, 0xbd, 0x90, 0x8c, 0x1e, 0xc3, 0x55, 0xdb, 0xb4, 0x31, 0x73, 0x2f, 0x13, 0x23, 0x0a,
-	0x6c, 0xc4, 0x12, 0x67, 0x3f, 0x4b, 0x8e, 0x53, 0xfe, 0x3a, 0x0b, 0xb5, 0xb8, 0x6d, 0xd0, 0x53,
-	0xa8, 0x1b, 0xce, 0x1b, 0xdb, 0x72, 0x74, 0x43, 0xa3, 0x18, 0x5a, 0x9c, 0xcb, 0xb5, 0xa9, 0x27,
-	0xbd, 0x25, 0xf0, 0xb3, 0x5a, 0x93, 0xf2, 0xf4, 0x91, 0xa3, 0x5f, 0x42, 0xcd, 0xe5, 0xfa, 0xf8,
-	0xf0, 0xec, 0x69, 0xc3, 0xab, 0x42, 0x9c, 0x8d, 0x7e, 0x02, 0xd5, 0xc0, 0x8d, 0xe6, 0xce, 0x9d,
-	0x36, 0x18, 0xb8, 0x34, 0x1b, 0xfb, 0x13, 0x68, 0x84, 0x2b, 0xef, 0x1f, 0xfb, 0x98, 0x30, 0x5b,
-	0xe5, 0xd4, 0x70, 0x3f, 0x1b, 0x94, 0x88, 0xee, 0x42, 0x4d, 0x4c, 0xc1, 0x85, 0x0a, 0x4c, 0x48,
-	0x4c, 0xcb, 0x44, 0x94, 0xdf, 0x66, 0x61, 0x29, 0x3c, 0xc7, 0x84, 0x75, 0x1e, 0xa7, 0x5b, 0x27,
-	0x7c, 0xff, 0xe1, 0xa8, 0x09, 0xab, 0x7c, 0x94, 0x6a, 0x95, 0x94, 0x61, 0x09, 0x6b, 0xac, 0xa5,
-	0x59, 0x23, 0x65, 0x50, 0xdc, 0x0a, 0xbf, 0x48, 0xb5, 0x42, 0xea, 0xb0, 0x09, 0xc3, 0x7c, 0x94,
-	0x62, 0x98, 0xf4, 0x35, 0xc6, 0x6d, 0xf5, 0x7d, 0x06, 0x6a, 0x5f, 0x3b, 0xde, 0x21, 0xf6, 0xa8,
-	0x85, 0x02, 0xf6, 0xaa, 0xde, 0xb0, 0xbe, 0x66, 0x1a, 0x02, 0xc1, 0xd6, 0xde, 0xfe, 0x70, 0xbb,
-	0xcc, 0x85, 0xb6, 0xb7, 0xd4, 0x32, 0x67, 0x6f, 0x1b, 0x14, 0xe9, 0xbe, 0x76, 0xfa, 0x5a, 0xe8,
-	0x25, 0x18, 0xd2, 0xa5, 0xfe, 0x72, 0x4b, 0x2d, 0xbc, 0x76, 0xfa, 0xdb, 0x06, 0x7a, 0x0c, 0x35,
-	0xe6, 0x01, 0xd8, 0x23, 0x0d, 0xe4, 0xab, 0x5e, 0x98, 0x7a, 0xff, 0x01, 0x51, 0xab, 0x46, 0xd4,
-	0x51, 0x5e, 0x43, 0x35, 0xc6, 0x43, 0x1f, 0x41, 0x89, 0x85, 0x1d, 0x6c, 0x88, 0x03, 0x9b, 0x15,
-	0xa1, 0xa4, 0x28, 0xf5, 0xf1, 0xec, 0xd1, 0xf3, 0xa8, 0x33, 0x9f, 0x88, 0x03, 0xcc, 0x3f, 0xf0,
-	0x57, 0xef, 0x40, 0x4d, 0xc5, 0xc4, 0x09, 0xbc, 0x01, 0x66, 0x0e, 0x97, 0xa6, 0x60, 0x6e, 0xc0,
-	0x26, 0xca, 0xaa, 0xb4, 0x49, 0xdf, 0xf7, 0x18, 0x8f, 0x1d, 0x4f, 0x66, 0x81, 0xa2, 0x87, 0xee,
-	0x42, 0x6e, 0xe4, 0x06, 0x62, 0x53, 0x21, 0x6c, 0x7a, 0xbe, 0xb7, 0x4f, 0xf5, 0xa8, 0x94, 0x47,
-	0xdd, 0x85, 0x61, 0x92, 0x43, 0x19, 0x8b, 0x69, 0x5b, 0xf9, 0x19, 0x94, 0x84, 0x4c, 0x88, 0xcc,
-	0x32, 0x11, 0x32, 0xa3, 0xb3, 0xd9, 0xc1, 0xb8, 0x8f, 0x3d, 0x36, 0x5b, 0x4e, 0x15, 0x3d, 0xe5,
-	0xd7, 0x00, 0x2f, 0x9c, 0x7e, 0x17, 0xfb, 0xcc, 0xef, 0xbe, 0x4b, 0x51, 0x4f, 0x5f, 0x23, 0xd8,
-	0x17, 0x26, 0x69, 0xc4, 0x1c, 0x78, 0x17, 0xfb, 0x14, 0x05, 0xd1, 0xbf, 0xe8, 0x1d, 0x1a, 0x7b,
-	0xfb, 0x12, 0x18, 0xcf, 0xc5, 0xa4, 0xb8, 0xe7, 0xa3, 0x4c, 0xe5, 0xef, 0x6b, 0x50, 0x12, 0x94,
-	0xd3, 0xc2, 0xc2, 0x03, 0x68, 0x4a, 0x98, 0xaf, 0x1d, 0x61, 0x8f, 0xd0, 0x48, 0x9b, 0x65, 0x71,
-	0x69, 0x4e, 0xd2, 0xbf, 0xe2, 0x64, 0xf4, 0x08, 0xea, 0x4e, 0xe0, 0xbb, 0x81, 0xaf, 0xc5, 0x70,
-	0xca, 0x74, 0x90, 0xac, 0x71, 0x21, 0xde, 0x43, 0x2d, 0x28, 0x79, 0x98, 0xa3, 0x91, 0x3c, 0x53,
-	0x2b, 0xbb, 0xcc, 0x41, 0xe8, 0xbe, 0xae, 0x89, 0x27, 0x86, 0x0d, 0xf1, 0xf6, 0xeb, 0x94, 0xba,
-	0x27, 0x89, 0xd4, 0x41, 0x30, 0x31, 0x72, 0x68, 0xba, 0x2e, 0x36, 0x58, 0x88, 0xcf, 0xb1, 0xeb,
-	0xa5, 0x77, 0x39, 0x89, 0x22, 0x43, 0x26, 0xe2, 0x3b, 0xbe, 0x6e, 0x31, 0x64, 0x98, 0x53, 0x2b,
-	0x94, 0xd2, 0xa3, 0x04, 0x0a, 0xf5, 0x18, 0x7b, 0xa8, 0x9b, 0x16, 0x36, 0x18, 0x38, 0xcc, 0xa9,
-	0x6c, 0xc4, 0x33, 0x46, 0x09, 0x57, 0xe2, 0xe1, 0x01, 0x05, 0x51, 0xd8, 0x60, 0x48, 0x51, 0xac,
-	0x44, 0x95, 0xc4, 0x28, 0x98, 0xc1, 0xe9, 0xc1, 0xec, 0x9e, 0x0c, 0x91, 0x55, 0x16, 0x22, 0x9b,
-	0xf1, 0xd3, 0x8c, 0x07, 0xc8, 0x65, 0x28, 0x7a, 0x58, 0x27, 0x8e, 0x2d, 0x52, 0x5b, 0xd1, 0xa3,
-	0x4f, 0x64, 0xe0, 0x61, 0x9d, 0x3e, 0x91, 0xfa, 0xe9, 0x4f, 0x44, 0x88, 0xc6, 0x1f, 0x56, 0xe3,
-	0xec, 0x0f, 0xeb, 0x31, 0x94, 0x87, 0xa6, 0x6d, 0x92, 0x03, 0x6c, 0xb4, 0xe6, 0x4e, 0x1d, 0x16,
-	0xca, 0xa2, 0x0f, 0xa1, 0x64, 0x60, 0x5f, 0x37, 0x2d, 0xd2, 0x6a, 0xb2, 0x61, 0x57, 0x27, 0x6e,
-	0xe3, 0xca, 0x16, 0x67, 0xab, 0x52, 0xae, 0xfd, 0x57, 0x25, 0x28, 0x09, 0x22, 0x5a, 0x85, 0x8a,
-	0x2f, 0xab, 0x1b, 0x93, 0x8e, 0x3b, 0x2c, 0x7b, 0xa8, 0x91, 0x0c, 0xda, 0x80, 0xa6, 0x1b, 0xa1,
-	0x29, 0x8d, 0x81, 0xe2, 0x6c, 0x72, 0xe2, 0x09, 0xb4, 0xa5, 0xce, 0xb9, 0x13, 0xf0, 0xeb, 0x1e,
-	0x14, 0x31, 0xcb, 0x90, 0xa3, 0xcb, 0xcb, 0x47, 0xf2, 0xbc, 0x59, 0x15, 0xdc, 0x78, 0x1a, 0x95,
-	0x9f, 0x9d, 0x46, 0x51, 0xc8, 0x44, 0x68, 0xea, 0x25, 0x3c, 0x74, 0x08, 0x99, 0x58, 0x3e, 0xa6,
-	0x72, 0x1e, 0xfa, 0x18, 0xea, 0xc2, 0x0d, 0x0b, 0xd7, 0x59, 0x64, 0xef, 0x37, 0xbc, 0x43, 0x71,
-	0x9f, 0xad, 0xd6, 0xde, 0xc4, 0x3d, 0xf8, 0x3a, 0xcc, 0x7b, 0xc2, 0xa1, 0x69, 0x1e, 0xfe, 0x36,
-	0xc0, 0xc4, 0x27, 0xec, 0x92, 0xc7, 0x86, 0xc7, 0x3d, 0x9e, 0xda, 0x94, 0xe2, 0xaa, 0x90, 0x46,
-	0x9f, 0xc2, 0x5c, 0xa8, 0xc2, 0x32, 0xc7, 0xa6, 0x4f, 0xd8, 0x2b, 0x38, 0x49, 0x41, 0x43, 0x0a,
-	0xef, 0x30, 0x59, 0xb4, 0x03, 0x57, 0x89, 0x69, 0xe0, 0x81, 0xee, 0x69, 0x93, 0x6a, 0x2a, 0x33,
-	0xd4, 0x2c, 0x89, 0x41, 0x6a, 0x52, 0xdb, 0x3b, 0x50, 0x30, 0xa9, 0xcf, 0x16, 0xcf, 0x68, 0x12,
-	0xd0, 0x9b, 0x12, 0x9d, 0x13, 0xdd, 0xf2, 0x65, 0x2d, 0x88, 0xb6, 0xd1, 0x13, 0xf6, 0x4c, 0x69,
-	0xf4, 0xc1, 0x3e, 0x3f, 0xfd, 0x5a, 0x72, 0x76, 0x1e, 0x63, 0xb0, 0xcf, 0x66, 0xe7, 0x91, 0x4a,
-	0xf4, 0x18, 0x8e, 0x62, 0x63, 0x69, 0xe8, 0xa6, 0x87, 0x55, 0x3f, 0x1d, 0x47, 0x51, 0xf9, 0x1e,
-	0x17, 0xa7, 0x48, 0x88, 0xfa, 0x67, 0x39, 0xba, 0x71, 0x2a, 0x12, 0x7a, 0xed, 0xf4, 0xe5, 0x58,
-	0xee, 0x7f, 0xe8, 0xdc, 0x9e, 0x89, 0x09, 0x7b, 0x62, 0xdc, 0xff, 0x04, 0xe3, 0x1e, 0xa5, 0xa0,
-	0xcf, 0x60, 0x8e, 0x0c, 0x0e, 0xb0, 0x11, 0x58, 0xa6, 0x3d, 0xe2, 0x3b, 0xe3, 0x0f, 0x6a, 0x39,
-	0xbc, 0x4b, 0x21, 0x9b, 0x1f, 0x10, 0x49, 0xf4, 0x69, 0xee, 0xeb, 0x3a, 0x06, 0x1f, 0x39, 0xcf,
-	0x73, 0x5f, 0xd7, 0x31, 0x18, 0xeb, 0x3a, 0x54, 0x28, 0xcb, 0xd5, 0xfd, 0xc1, 0x41, 0x0b, 0xf1,
-	0x7c, 0xdd, 0x75, 0x8c, 0x3d, 0xda, 0x57, 0x9e, 0x43, 0x91, 0x5f, 0xbc, 0xd4, 0x6c, 0xe8, 0x41,
-	0x12, 0xe6, 0x2f, 0x4c, 0xdf, 0x55, 0xe9, 0xc6, 0x94, 0x5b, 0x50, 0x96, 0x65, 0xa3, 0x34, 0x55,
-	0xca, 0xbf, 0xce, 0x41, 0x4d, 0x0a, 0xb0, 0xa8, 0x74, 0xbe, 0xfa, 0x53, 0x0b, 0x4a, 0xc9, 0xd8,
-	0x24, 0xbb, 0x68, 0x15, 0xaa, 0x74, 0xd7, 0xb3, 0x23, 0x12, 0x50, 0x91, 0x28, 0x1e, 0x11, 0xdf,
-	0x61, 0x91, 0x84, 0x67, 0x6a, 0xb2, 0x8b, 0x7e, 0x2a, 0xb7, 0x5b, 0x60, 0xdb, 0x5d, 0x9a, 0x5c,
-	0xcf, 0x09, 0x7e, 0xbb, 0x98, 0xf0, 0xdb, 0x8f, 0xa1, 0x61, 0xe9, 0xc4, 0xd7, 0x58, 0x30, 0x67,
-	0xda, 0xca, 0x27, 0x04, 0x80, 0x1a, 0x95, 0x93, 0x3d, 0x74, 0x07, 0xaa, 0x31, 0x57, 0xc5, 0x9e,
-	0x55, 0x5e, 0x8d, 0x93, 0xd0, 0xcf, 0x04, 0xb6, 0x00, 0xa6, 0xef, 0xee, 0xe4, 0xea, 0x98, 0xbf,
-	0x95, 0x9d, 0xde, 0xb1, 0x8b, 0x05, 0xfc, 0xb8, 0x09, 0xa0, 0x07, 0xfe, 0x81, 0xe6, 0x3b, 0x87,
-	0xd8, 0x16, 0xcf, 0xa9, 0x42, 0x29, 0x3d, 0x4a, 0x40, 0x8f, 0x23, 0x1f, 0xce, 0x1f, 0xd3, 0x8d,
-	0x54, 0xc5, 0x53, 0x8e, 0xfc, 0xf7, 0x70, 0x09, 0x47, 0xbe, 0x1a, 0x96, 0x54, 0xb3, 0x49, 0x17,
-	0xc0, 0xca, 0xaa, 0xd3, 0x15, 0xd6, 0x54, 0xcf, 0x9f, 0xbb, 0xb0, 0xe7, 0xcf, 0xcf, 0xf4, 0xfc,
-	0x1f, 0x03, 0x88, 0x70, 0xaa, 0xe9, 0xd2, 0xa7, 0xcf, 0x8a, 0x87, 0x15, 0x21, 0xbd, 0xee, 0x53,
-	0xa8, 0xe2, 0x61, 0x9a, 0xca, 0x69, 0xd8, 0xf3, 0x1c, 0x4f, 0x5c, 0x8d, 0x2a, 0xa7, 0x75, 0x28,
-	0x09, 0xfd, 0x14, 0xe6, 0xb9, 0x73, 0x27, 0xd2, 0x97, 0x63, 0x43, 0x20, 0x96, 0xa6, 0x60, 0xa8,
-	0x92, 0x1e, 0x17, 0xd6, 0x8f, 0x74, 0xd3, 0xd2, 0xfb, 0x16, 0x16, 0xf0, 0x45, 0x0a, 0xaf, 0x4b,
-	0x3a, 0x7a, 0x27, 0x44, 0x67, 0xa2, 0x04, 0x57, 0x61, 0xb3, 0x0b, 0x34, 0xb6, 0xc1, 0x0b, 0x71,
-	0xa9, 0xb1, 0x04, 0x2e, 0x1b, 0x4b, 0xaa, 0x3f, 0x4e, 0x2c, 0xa9, 0x5d, 0x22, 0x96, 0xd4, 0x67,
-	0xc4, 0x92, 0x3b, 0x50, 0x35, 0x30, 0x19, 0x78, 0xa6, 0x4b, 0x5d, 0x33, 0xf3, 0xdd, 0x15, 0x35,
-	0x4e, 0x0a, 0xa3, 0x4d, 0x33, 0x16, 0x6d, 0xa2, 0x17, 0x3e, 0x9f, 0x78, 0xe1, 0x31, 0x64, 0xb0,
-	0x70, 0x56, 0x64, 0xb0, 0x38, 0x03, 0x19, 0x4c, 0x47, 0xb5, 0xa5, 0x8b, 0x47, 0xb5, 0xe5, 0x4b,
-	0x45, 0xb5, 0xab, 0x97, 0x88, 0x6a, 0xad, 0xb3, 0x44, 0xb5, 0x6b, 0x17, 0x8e, 0x6a, 0xed, 0x19,
-	0x51, 0xed, 0x7a, 0x32, 0xaa, 0xa1, 0x25, 0x28, 0x92, 0x47, 0x1a, 0xdd, 0xd0, 0x0d, 0xfe, 0xf3,
-	0x12, 0x79, 0xf4, 0x2a, 0xf0, 0x69, 0xc8, 0x19, 0x8b, 0x9f, 0x0f, 0x5a, 0x37, 0x93, 0x21, 0x47,
-	0xfe, 0xac, 0xa0, 0x86, 0x12, 0x34, 0x27, 0xf0, 0xb0, 0x2c, 0x12, 0xb0, 0x25, 0xdc, 0x62, 0xd3,
-	0xd4, 0x43, 0x2a, 0x5b, 0xc8, 0xbb, 0x30, 0x17, 0xd8, 0x03, 0x4b, 0x37, 0xc7, 0xd8, 0xd0, 0x7c,
-	0x9d, 0x1c, 0x92, 0xd6, 0x6d, 0x66, 0x89, 0x46, 0x48, 0xee, 0x51, 0x2a, 0x5d, 0xb1, 0x00, 0x80,
-	0xde, 0xa0, 0x75, 0x87, 0xaf, 0x98, 0x13, 0xd4, 0x01, 0xbd, 0xa1, 0x7a, 0xe0, 0x3b, 0x64, 0xa0,
-	0xd3, 0xcd, 0xb7, 0xee, 0xb2, 0x65, 0xc7, 0x49, 0xca, 0x77, 0x51, 0xfc, 0x64, 0x95, 0xf6, 0x6b,
-	0xb0, 0xb4, 0xb7, 0xbd, 0xd7, 0xd9, 0xd9, 0xde, 0xed, 0x69, 0xbd, 0x6f, 0xf6, 0x3a, 0xda, 0xfe,
-	0xee, 0xcb, 0xdd, 0x57, 0x5f, 0xef, 0x36, 0xaf, 0xa0, 0xeb, 0x70, 0x55, 0xb0, 0x3a, 0x9c, 0xd5,
-	0x53, 0xd7, 0x77, 0xbb, 0xcf, 0x5e, 0xa9, 0x5f, 0x34, 0x33, 0xe8, 0x2a, 0x2c, 0x24, 0x99, 0xdd,
-	0xbd, 0x57, 0xfb, 0xbd, 0x66, 0x36, 0xa6, 0x50, 0x32, 0x3a, 0xea, 0x57, 0xdb, 0x9b, 0x9d, 0x66,
-	0xee, 0x45, 0xbe, 0x5c, 0x6a, 0x96, 0x95, 0x17, 0x50, 0x8f, 0x87, 0x04, 0xea, 0x28, 0xeb, 0x61,
-	0xe6, 0x68, 0xda, 0x43, 0x47, 0xfc, 0xd6, 0xb3, 0x98, 0x16, 0x40, 0xd4, 0x9a, 0x1b, 0xeb, 0x29,
-	0x77, 0xa0, 0xc8, 0xd3, 0x5a, 0x51, 0x95, 0xcc, 0x4c, 0x55, 0x25, 0xc7, 0xb0, 0xb8, 0x6d, 0x53,
-	0xb3, 0xfb, 0x22, 0xff, 0xe5, 0xee, 0xe7, 0xec, 0x79, 0x32, 0x82, 0xfc, 0x1b, 0x5d, 0x14, 0x72,
-	0xcb, 0x2a, 0x6b, 0xd3, 0xd8, 0x2f, 0x83, 0x5d, 0x8e, 0xc7, 0x7e, 0xd1, 0x55, 0xde, 0x87, 0xf9,
-	0x1d, 0x93, 0x4c, 0xcc, 0x15, 0x13, 0xcf, 0x24, 0xc5, 0x7f, 0x03, 0xf3, 0xd1, 0xea, 0xa4, 0xf8,
-	0x29, 0x89, 0xf6, 0xf9, 0x16, 0xf4, 0x6f, 0x19, 0x68, 0x88, 0x15, 0x49, 0xfd, 0xe7, 0x83, 0x4c,
-	0x1f, 0x42, 0x8d, 0x79, 0x3f, 0x2d, 0x2c, 0x68, 0xe7, 0x52, 0x90, 0x51, 0x95, 0xc9, 0x44, 0xd0,
-	0xe8, 0xc0, 0x24, 0xbe, 0xe3, 0x1d, 0x8b, 0x52, 0x9d, 0xec, 0xc6, 0xd7, 0x59, 0x48, 0xac, 0x13,
-	0xb5, 0xa1, 0xfc, 0xfa, 0xdb, 0x67, 0xa6, 0xe5, 0x63, 0x19, 0xee, 0xc2, 0xbe, 0xf2, 0x27, 0xb0,
-	0xd0, 0x0d, 0xfa, 0xd4, 0xcb, 0xf6, 0xf1, 0x85, 0xf7, 0x11, 0x9b, 0x3a, 0x9b, 0x34, 0xd1, 0x87,
-	0xd0, 0xdc, 0xc2, 0x16, 0xf6, 0xf1, 0x99, 0xcf, 0x40, 0x79, 0x0e, 0x8d, 0xae, 0xef, 0xb8, 0x67,
-	0x3f, 0xb4, 0x28, 0x08, 0xe4, 0xe2, 0x41, 0x40, 0xf9, 0x7d, 0x16, 0x96, 0xf6, 0x5d, 0x43, 0x67,
-	0x93, 0x73, 0x3c, 0x77, 0x36, 0x85, 0xf7, 0x92, 0x98, 0xfa, 0x0c, 0x75, 0x81, 0xc4, 0xc4, 0xf1,
-	0x72, 0x4a, 0xe1, 0xb4, 0x72, 0x4a, 0xf1, 0x2c, 0xe5, 0x94, 0xd2, 0x74, 0x39, 0xe5, 0xc7, 0xaa,
-	0x97, 0x24, 0xcb, 0x32, 0x30, 0x59, 0x96, 0x09, 0xcb, 0x29, 0xd5, 0x53, 0xcb, 0x29, 0xca, 0xbf,
-	0x67, 0xa1, 0xf1, 0x1c, 0xfb, 0x3b, 0xce, 0x88, 0x5c, 0xec, 0x1a, 0x89, 0x63, 0xc9, 0x9e, 0x70,
-	0x2c, 0xd2, 0x2a, 0x43, 0x76, 0x73, 0x89, 0xf8, 0x34, 0x83, 0x99, 0x81, 0x5f, 0x66, 0x12, 0xfd,
-	0x32, 0x92, 0x9f, 0xf1, 0xcb, 0xc8, 0x32, 0x14, 0xc7, 0x3a, 0xa1, 0x8f, 0x81, 0xbf, 0x13, 0xd1,
-	0xa3, 0xf4, 0xa1, 0x63, 0x59, 0xce, 0x1b, 0x76, 0x28, 0x65, 0x55, 0xf4, 0x58, 0xc1, 0x50, 0x37,
-	0x65, 0xcd, 0x8a, 0xb5, 0xd1, 0x7d, 0x68, 0x06, 0x04, 0x6b, 0x96, 0x73, 0x68, 0x6a, 0x7d, 0x7d,
-	0x70, 0x88, 0x6d, 0x7e, 0x06, 0x65, 0xb5, 0x11, 0x10, 0xbc, 0xe3, 0x1c, 0x9a, 0x1b, 0x9c, 0x8a,
-	0x56, 0xa1, 0x40, 0x4c, 0x7b, 0x80, 0x45, 0x16, 0x3e, 0x23, 0x70, 0x73, 0x39, 0xe5, 0x5f, 0xb2,
-	0x00, 0x3b, 0xce, 0xe8, 0x0b, 0x4c, 0x88, 0x3e, 0x62, 0x90, 0x31, 0xf4, 0xe0, 0xb1, 0x94, 0x2d,
-	0xf4, 0xd5, 0xbb, 0x34, 0x0b, 0x3c, 0xbd, 0x2a, 0x9c, 0x28, 0x31, 0xe7, 0x66, 0x96, 0x98, 0xef,
-	0x41, 0x99, 0x83, 0x06, 0x93, 0xa7, 0x5f, 0x95, 0x8d, 0xea, 0xdb, 0x1f, 0x6e, 0x97, 0xf8, 0xef,
-	0x4f, 0x5b, 0x6a, 0x89, 0x31, 0xb7, 0x8d, 0x13, 0xed, 0x28, 0x6b, 0xc0, 0xc5, 0x99, 0x35, 0xe0,
-	0xf0, 0x4b, 0x12, 0xfe, 0x23, 0x31, 0xff, 0x92, 0xe4, 0x21, 0x64, 0xc3, 0xb2, 0xc7, 0x2c, 0x3c,
-	0x9f, 0xf5, 0x09, 0x7d, 0x65, 0x63, 0x6e, 0x23, 0x81, 0xa2, 0x65, 0x57, 0xf9, 0x1a, 0x16, 0x54,
-	0xfe, 0xe0, 0xf8, 0xb9, 0x9f, 0xed, 0xd5, 0x4f, 0x5e, 0xaf, 0xec, 0xd4, 0xf5, 0x52, 0x9e, 0xc0,
-	0x82, 0x08, 0x29, 0x09, 0xc5, 0x67, 0xf9, 0x3d, 0x4e, 0xf9, 0x0a, 0x9a, 0x34, 0x56, 0x9c, 0x67,
-	0x45, 0x21, 0x70, 0xce, 0x9e, 0x0c, 0x9c, 0x15, 0x03, 0x6a, 0x71, 0xf0, 0x19, 0x2b, 0x65, 0x67,
-	0xe2, 0xa5, 0x6c, 0xfa, 0xd0, 0x89, 0xf9, 0x1d, 0x16, 0x3f, 0x54, 0xf0, 0x32, 0x77, 0x85, 0x52,
-	0xf8, 0x2f, 0x19, 0x37, 0x01, 0x5c, 0xec, 0x69, 0xfc, 0x12, 0xb0, 0x0b, 0x92, 0x53, 0x2b, 0x2e,
-	0xf6, 0xf8, 0xfd, 0x50, 0x7e, 0x97, 0x81, 0x46, 0x12, 0x09, 0xa2, 0x2f, 0xa0, 0x6e, 0x3b, 0x06,
-	0xd6, 0x08, 0xb6, 0xf0, 0xc0, 0x77, 0x3c, 0x01, 0x2d, 0xee, 0xa7, 0x03, 0xc7, 0x95, 0x5d, 0xc7,
-	0xc0, 0x5d, 0x21, 0xca, 0x3f, 0x09, 0xa9, 0xd9, 0x31, 0x12, 0x5a, 0x81, 0x05, 0xd7, 0x33, 0x1d,
-	0xcf, 0xf4, 0x8f, 0xb5, 0x81, 0xa5, 0x13, 0xc2, 0x6f, 0x3b, 0xaf, 0xfe, 0xcf, 0x4b, 0xd6, 0x26,
-	0xe5, 0xd0, 0x2b, 0xdf, 0xfe, 0x0c, 0xe6, 0xa7, 0x54, 0x9e, 0xeb, 0x73, 0x90, 0xff, 0xaf, 0xc0,
-	0xd2, 0x26, 0x4b, 0x0b, 0x43, 0x57, 0x74, 0x21, 0xaf, 0x75, 0xee, 0x44, 0x39, 0x91, 0x8a, 0xe7,
-	0x2e, 0x58, 0x53, 0xcd, 0x5f, 0x38, 0xb3, 0x2e, 0xcc, 0xcc, 0xac, 0x97, 0xa1, 0x18, 0xb0, 0x98,
-	0x29, 0x9d, 0x20, 0xef, 0x4d, 0x67, 0xae, 0xa5, 0x94, 0xcc, 0x35, 0x02, 0xf5, 0xe5, 0x38, 0xa8,
-	0x4f, 0x4d, 0x68, 0x2b, 0x97, 0x4d, 0x68, 0xe1, 0xc7, 0x49, 0x68, 0xab, 0x97, 0x48, 0x68, 0x6b,
-	0x67, 0x4f, 0x68, 0xeb, 0xd3, 0x09, 0xed, 0x0d, 0xf6, 0x95, 0x0e, 0x0f, 0xa4, 0xac, 0xe0, 0x58,
-	0x56, 0x23, 0x42, 0x3c, 0x85, 0x9d, 0x3f, 0x6b, 0x0a, 0x8b, 0xce, 0x95, 0xc2, 0x2e, 0x5c, 0x3c,
-	0x85, 0x5d, 0xbc, 0x54, 0x0a, 0xbb, 0x74, 0x9e, 0x14, 0x56, 0xa6, 0xfd, 0xcb, 0xb1, 0xb4, 0x7f,
-	0x22, 0xad, 0xbd, 0x7a, 0x96, 0xb4, 0xb6, 0x75, 0xe1, 0xb4, 0xf6, 0xda, 0x8c, 0xb4, 0xb6, 0x3d,
-	0x91, 0xd6, 0x4e, 0x94, 0x3a, 0xaf, 0x9f, 0x5a, 0xea, 0x8c, 0x27, 0xbc, 0x37, 0x2e, 0x90, 0xf0,
-	0xde, 0x4c, 0x4b, 0x78, 0x27, 0x52, 0xd5, 0x5b, 0xd3, 0xa9, 0xea, 0x6f, 0x60, 0x59, 0x44, 0xb2,
-	0xcb, 0x39, 0xbf, 0x93, 0x91, 0xff, 0xf7, 0x19, 0x58, 0xa0, 0x01, 0xef, 0xd2, 0xfa, 0x65, 0xba,
-	0x93, 0x3d, 0x31, 0xdd, 0xc9, 0x9d, 0x9c, 0xee, 0xe4, 0x27, 0xd2, 0x9d, 0xbf, 0xcc, 0xc0, 0x12,
-	0x4f, 0x48, 0x2e, 0xb7, 0xae, 0x26, 0xe4, 0x74, 0xcb, 0x12, 0x7b, 0xa6, 0x4d, 0x1a, 0x68, 0x86,
-	0x8e, 0x37, 0xc0, 0x62, 0x35, 0xbc, 0x43, 0x2f, 0xcb, 0x21, 0xc6, 0xae, 0xc6, 0x3e, 0x24, 0xe3,
-	0xb5, 0xec, 0x32, 0x25, 0xa8, 0xd8, 0x75, 0x94, 0x2d, 0x58, 0xec, 0x52, 0x94, 0x72, 0xa9, 0xa5,
-	0x28, 0x9b, 0xb0, 0x40, 0xf3, 0xa5, 0xcb, 0x29, 0xf9, 0x9b, 0x0c, 0x20, 0x35, 0xb0, 0x2f, 0x67,
-	0x94, 0x15, 0x00, 0xd7, 0x73, 0x8e, 0xb0, 0xad, 0x53, 0xbc, 0x9b, 0x9e, 0xcc, 0xc6, 0x24, 0x62,
-	0xa8, 0x35, 0x97, 0x8e, 0x5a, 0x95, 0xa7, 0xd0, 0x50, 0x03, 0x7b, 0xd3, 0x73, 0xec, 0x8b, 0x6d,
-	0xeb, 0x01, 0x2c, 0xf0, 0x10, 0xcf, 0xbf, 0x9a, 0x96, 0x4a, 0x10, 0xe4, 0xd9, 0x97, 0xc8, 0x19,
-	0xfe, 0x89, 0x16, 0x6d, 0x2b, 0x9f, 0xc2, 0x02, 0xbf, 0x18, 0x49, 0xd1, 0x7b, 0x50, 0xe4, 0x5f,
-	0x62, 0x4f, 0x96, 0x32, 0x84, 0x98, 0xe0, 0x2a, 0x4f, 0xc3, 0x5a, 0xc8, 0xc5, 0xc6, 0xdf, 0x80,
-	0x22, 0xa7, 0xa4, 0xfe, 0x34, 0xf3, 0x7d, 0x06, 0x80, 0xb3, 0xd9, 0x0f, 0x33, 0x67, 0x54, 0x1a,
-	0x7e, 0xea, 0x90, 0x8d, 0x7d, 0xea, 0xb0, 0x0d, 0x88, 0x15, 0xc3, 0x4d, 0xc7, 0xd6, 0xc2, 0x0f,
-	0xfe, 0x05, 0x0c, 0x99, 0x05, 0xb9, 0xe7, 0xe5, 0xa8, 0x90, 0xa4, 0x6c, 0xc8, 0x2f, 0xf9, 0x79,
-	0xad, 0xe9, 0x11, 0x54, 0xf9, 0xbc, 0xf1, 0x4a, 0x13, 0x4a, 0x2e, 0x8d, 0xd5, 0x99, 0x80, 0x84,
-	0x6d, 0x65, 0x09, 0x16, 0xd6, 0x07, 0xbe, 0x79, 0xa4, 0xfb, 0x78, 0x3d, 0xf0, 0x0f, 0x84, 0xd9,
-	0x94, 0x65, 0x58, 0x4c, 0x92, 0x89, 0xeb, 0xd8, 0x04, 0x2b, 0xbf, 0xcd, 0xc0, 0x92, 0x8a, 0x6d,
-	0x03, 0x7b, 0x3d, 0x3c, 0x76, 0xad, 0x58, 0x4e, 0xdf, 0x86, 0xb2, 0x2f, 0x48, 0xc2, 0x74, 0x61,
-	0x1f, 0x7d, 0x02, 0x79, 0xdd, 0x1b, 0xc9, 0xef, 0x31, 0xde, 0x8d, 0x62, 0x7d, 0x8a, 0xa2, 0x95,
-	0x75, 0x6f, 0x24, 0xbe, 0x59, 0x66, 0x83, 0xda, 0x3f, 0x87, 0x4a, 0x48, 0x3a, 0x17, 0xc0, 0xd4,
-	0x61, 0x79, 0x72, 0x06, 0xbe, 0x0b, 0x7a, 0x2e, 0xaf, 0x89, 0x63, 0xcb, 0x23, 0xa6, 0x6d, 0xf4,
-	0x88, 0x06, 0x71, 0x3c, 0x90, 0x8b, 0xbc, 0x19, 0x7d, 0x1e, 0x99, 0x02, 0x51, 0x55, 0x2e, 0xfb,
-	0xf0, 0x1f, 0x33, 0xec, 0x63, 0x49, 0xfe, 0xf3, 0xd4, 0x12, 0xcc, 0xbf, 0x78, 0xb5, 0xa1, 0x75,
-	0x7b, 0xeb, 0xbd, 0x78, 0xa9, 0x71, 0x0e, 0xaa, 0x94, 0xbc, 0xa9, 0x76, 0xd6, 0x7b, 0x9d, 0xad,
-	0x66, 0x06, 0x35, 0xa1, 0x26, 0xe4, 0xd4, 0xde, 0xf6, 0xee, 0xf3, 0x66, 0x56, 0x8a, 0xa8, 0xfb,
-	0xbb, 0xbb, 0x94, 0x90, 0x93, 0x84, 0x67, 0xeb, 0xdb, 0x3b, 0xfb, 0x6a, 0xa7, 0x99, 0x97, 0x84,
-	0xee, 0xfe, 0xe6, 0x66, 0xa7, 0xdb, 0x6d, 0x16, 0x50, 0x03, 0x80, 0x12, 0x5e, 0x6e, 0xef, 0xec,
-	0x74, 0xb6, 0x9a, 0x45, 0x34, 0x0f, 0x75, 0xda, 0xef, 0x3c, 0x57, 0x3b, 0xdd, 0x2e, 0x55, 0x52,
-	0x92, 0xa4, 0x67, 0xdb, 0xbb, 0xdb, 0xdd, 0xcf, 0x29, 0xa9, 0xfc, 0xf0, 0x8f, 0x01, 0xa2, 0xef,
-	0x0f, 0x51, 0x15, 0x4a, 0xd1, 0x32, 0x01, 0x8a, 0x74, 0x3a, 0xb6, 0xc2, 0x2a, 0x94, 0xe4, 0x4c,
-	0x59, 0xd6, 0x79, 0xb9, 0xbd, 0xb7, 0xd7, 0xd9, 0x6a, 0xe6, 0x50, 0x0d, 0xca, 0xe1, 0xba, 0xf3,
-	0xa8, 0x0e, 0x15, 0xb5, 0xb3, 0xf9, 0xea, 0xab, 0x8e, 0xda, 0xd9, 0x6a, 0x16, 0x1e, 0x7e, 0x03,
-	0xd5, 0xd8, 0xcf, 0x9e, 0xa8, 0x05, 0x8b, 0x5f, 0xbf, 0x52, 0x5f, 0x76, 0xd4, 0x34, 0x93, 0xec,
-	0xbd, 0xda, 0x0a, 0xf7, 0x9b, 0x91, 0x84, 0x68, 0xd2, 0x06, 0x00, 0x25, 0x88, 0x15, 0xe5, 0x1e,
-	0xfe, 0x67, 0x26, 0xaa, 0xac, 0x72, 0xed, 0x6d, 0x58, 0x0e, 0x6b, 0xb1, 0x93, 0xfa, 0x97, 0x60,
-	0x3e, 0xce, 0xe3, 0xcb, 0xcd, 0xa0, 0x45, 0x68, 0x86, 0x64, 0x39, 0x77, 0x36, 0x51, 0xed, 0x55,
-	0x3b, 0xa1, 0x78, 0x2e, 0x21, 0x1e, 0x9d, 0xc4, 0x02, 0xcc, 0x85, 0xd4, 0xbd, 0xf5, 0xfd, 0x2e,
-	0xdd, 0x79, 0x42, 0xb4, 0xdb, 0x5b, 0xdf, 0xdd, 0xda, 0xf8, 0xa6, 0x59, 0x4c, 0x2c, 0x63, 0x53,
-	0x5d, 0xe7, 0x87, 0x50, 0x5a, 0xfb, 0x8b, 0x26, 0xe4, 0xd6, 0xf7, 0xb6, 0xd1, 0x13, 0x80, 0xa8,
-	0x40, 0x8a, 0xae, 0x45, 0x28, 0x76, 0xa2, 0x68, 0xda, 0x9e, 0xfc, 0x80, 0x49, 0xb9, 0x82, 0x36,
-	0xa0, 0x9e, 0x28, 0xfd, 0xa2, 0x1b, 0xd3, 0xc3, 0xa3, 0x2a, 0x6d, 0x8a, 0x86, 0x0f, 0x32, 0xe8,
-	0x31, 0x94, 0x44, 0xf5, 0x14, 0x85, 0xb0, 0x2c, 0x59, 0x4e, 0x4d, 0x1f, 0xf7, 0x19, 0x40, 0x54,
-	0x07, 0x8e, 0xd6, 0x3d, 0x55, 0x1b, 0x6e, 0xa3, 0x64, 0xd9, 0x39, 0x54, 0xf0, 0x2b, 0xa8, 0xc5,
-	0x6b, 0x9e, 0xe8, 0x7a, 0xe8, 0xa3, 0xa6, 0x2b, 0xa1, 0x27, 0x2d, 0xa1, 0x12, 0x96, 0x35, 0x51,
-	0x2b, 0x44, 0xd0, 0x13, 0x95, 0xce, 0xf6, 0xf2, 0x94, 0x3f, 0xed, 0x8c, 0x5d, 0xff, 0x58, 0xb9,
-	0x82, 0x3e, 0x81, 0x92, 0x28, 0x72, 0x46, 0x7b, 0x4f, 0x56, 0x3d, 0x67, 0x0c, 0xfe, 0x15, 0xd4,
-	0xe2, 0x65, 0x88, 0x68, 0xfd, 0x29, 0xc5, 0x89, 0xf6, 0x7c, 0x02, 0xdf, 0x8b, 0xe3, 0xfb, 0x25,
-	0x54, 0xc2, 0x62, 0x44, 0xb4, 0xfe, 0xc9, 0xfa, 0x44, 0xea, 0xd8, 0x0f, 0x32, 0xa8, 0xc3, 0xbe,
-	0xde, 0x0b, 0xeb, 0x2b, 0xd1, 0xfc, 0x29, 0x55, 0x97, 0x19, 0xdb, 0xd8, 0x86, 0x46, 0xd2, 0xb9,
-	0xa1, 0xd9, 0x4e, 0x6f, 0xa6, 0xaa, 0xb9, 0x09, 0x38, 0x8b, 0x6e, 0x4d, 0x18, 0x65, 0x52, 0x59,
-	0xea, 0x4f, 0x20, 0xca, 0x15, 0xba, 0xb9, 0x38, 0x6c, 0x8d, 0x36, 0x97, 0x02, 0x66, 0x4f, 0x52,
-	0xf2, 0x41, 0x86, 0x6e, 0x2e, 0x89, 0x33, 0xa3, 0xcd, 0xa5, 0xe2, 0xcf, 0x19, 0x9b, 0x7b, 0x0e,
-	0xf5, 0x04, 0x4c, 0x8c, 0xde, 0x5a, 0x1a, 0x7a, 0x9c, 0xa1, 0xa8, 0x03, 0xb5, 0x38, 0x52, 0x8c,
-	0xdd, 0xfb, 0x69, 0xfc, 0x38, 0x43, 0xcd, 0x26, 0x54, 0x63, 0x50, 0x11, 0x85, 0xff, 0x0d, 0x6e,
-	0x1a, 0x3f, 0xce, 0x7e, 0x00, 0x02, 0xd9, 0x45, 0x0f, 0x20, 0x09, 0xf5, 0x66, 0x6f, 0x24, 0x0e,
-	0xeb, 0xa2, 0x8d, 0xa4, 0x80, 0xbd, 0xd9, 0x6a, 0xe2, 0x90, 0x2f, 0x52, 0x93, 0x02, 0x04, 0x67,
-	0x6e, 0x85, 0xf9, 0x23, 0xa1, 0xe4, 0x04, 0xb9, 0xf6, 0xc2, 0x34, 0x10, 0x22, 0xcc, 0x98, 0xf5,
-	0x04, 0x6e, 0x9c, 0x72, 0xa4, 0xc9, 0x55, 0xa4, 0xc0, 0x29, 0xe5, 0x0a, 0xfa, 0x54, 0xba, 0xa3,
-	0x75, 0xcb, 0x3a, 0x71, 0x01, 0x27, 0x6f, 0xe0, 0x63, 0x28, 0x89, 0xba, 0x7d, 0x74, 0x16, 0xc9,
-	0x42, 0x7e, 0x34, 0x6f, 0x54, 0x99, 0x66, 0xd7, 0xfc, 0x25, 0xd4, 0xe2, 0x38, 0x2d, 0x32, 0x61,
-	0x0a, 0xa8, 0x6b, 0xdf, 0x48, 0x67, 0x0a, 0x68, 0xc7, 0x1c, 0x42, 0xf2, 0xf7, 0x9a, 0xe8, 0xcd,
-	0xa4, 0xfe, 0x8e, 0x33, 0x63, 0x4b, 0x9f, 0xb3, 0x3b, 0xba, 0xe3, 0xe8, 0x46, 0x8f, 0x81, 0x43,
-	0x99, 0x85, 0xc4, 0x88, 0x52, 0xc9, 0xf5, 0x54, 0x5e, 0xb8, 0xa8, 0x97, 0x2c, 0x31, 0x92, 0x8c,
-	0x2d, 0x3c, 0xd4, 0x03, 0xeb, 0xe4, 0x53, 0x3e, 0x45, 0xd9, 0x97, 0xd0, 0x48, 0x42, 0xc2, 0x68,
-	0x87, 0xa9, 0x60, 0xb4, 0x7d, 0xeb, 0x24, 0x76, 0xa8, 0xf2, 0x13, 0x28, 0xd3, 0xdb, 0xd7, 0xd3,
-	0xc9, 0x21, 0x6a, 0xad, 0xf8, 0x3a, 0x39, 0xd4, 0x5d, 0x73, 0x45, 0x92, 0x22, 0x4f, 0x2e, 0x39,
-	0x94, 0x2a, 0xbd, 0xd4, 0xc6, 0xcf, 0xff, 0xe3, 0xed, 0xad, 0xcc, 0xef, 0xde, 0xde, 0xca, 0xfc,
-	0xef, 0xdb, 0x5b, 0x99, 0x5f, 0x3f, 0x18, 0x99, 0xfe, 0x41, 0xd0, 0x5f, 0x19, 0x38, 0xe3, 0x55,
-	0x57, 0x1f, 0x1c, 0x1c, 0x1b, 0xd8, 0x8b, 0xb7, 0x8e, 0xd6, 0x56, 0x89, 0x37, 0x58, 0x75, 0x5d,
-	0xd2, 0x2f, 0xb2, 0x7d, 0x3f, 0xfa, 0x43, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xdf, 0x09, 0x9f,
-	0x78, 0x3c, 0x00, 0x00,
+	// 5236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7c, 0x4b, 0x6f, 0x1c, 0xc7,
+	0x76, 0xb0, 0xe6, 0x3d, 0x73, 0xe6, 0xc1, 0x61, 0xf1, 0xa1, 0x16, 0xf5, 0x6e, 0x7d, 0xd7, 0x96,
+	0x64, 0x9b, 0xb4, 0x29, 0x5b, 0xf7, 0x5a, 0xbe, 0x7e, 0xf0, 0x31, 0x92, 0x29, 0x51, 0x14, 0xdd,
+	0x43, 0xd9, 0xf0, 0xc5, 0x17, 0xf4, 0xed, 0x99, 0x2e, 0x92, 0x2d, 0xf6, 0x74, 0xb7, 0xbb, 0x7b,
+	0x28, 0xd3, 0x9b, 0x64, 0x95, 0x45, 0x16, 0x01, 0x02, 0x67, 0x91, 0x20, 0x9b, 0x20, 0xc8, 0x26,
+	0x01, 0x2e, 0x10, 0x20, 0x3f, 0x20, 0x08, 0x90, 0x45, 0xb2, 0x09, 0xee, 0x2a, 0x59, 0x04, 0x30,
+	0x12, 0xed, 0xf3, 0x1f, 0x82, 0x53, 0x8f, 0x7e, 0xcc, 0x34, 0x87, 0x2f, 0x6f, 0xc4, 0xaa, 0x73,
+	0x4e, 0x9d, 0x3a, 0x55, 0xa7, 0xea, 0xbc, 0xaa, 0x47, 0xd0, 0xf4, 0xbc, 0x60, 0xc9, 0xf3, 0x82,
+	0x45, 0xcf, 0x77, 0x43, 0x97, 0x94, 0x3d, 0x2f, 0xd0, 0x0f, 0x97, 0x17, 0xae, 0xee, 0xb9, 0xee,
+	0x9e, 0x4d, 0x97, 0x18, 0xb4, 0x37, 0xdc, 0x5d, 0xa2, 0x03, 0x2f, 0x3c, 0xe2, 0x44, 0x0b, 0x37,
+	0x47, 0x91, 0xa1, 0x35, 0xa0, 0x41, 0x68, 0x0c, 0x3c, 0x41, 0x70, 0x63, 0x94, 0xc0, 0x1c, 0xfa,
+	0x46, 0x68, 0xb9, 0x8e, 0xc0, 0xcf, 0xee, 0xb9, 0x7b, 0x2e, 0x6b, 0x2e, 0x61, 0x4b, 0x40, 0x9b,
+	0xde, 0x6e, 0xb0, 0xe4, 0xed, 0x0a, 0x51, 0x16, 0xa6, 0x42, 0x23, 0x38, 0x58, 0xc2, 0x7f, 0x38,
+	0x40, 0x3d, 0x80, 0x7a, 0x97, 0xf6, 0x7d, 0x1a, 0x3e, 0x77, 0x87, 0x4e, 0x48, 0x08, 0x14, 0x1d,
+	0x63, 0x40, 0x95, 0xdc, 0xad, 0xdc, 0xdd, 0x9a, 0xc6, 0xda, 0xa4, 0x0d, 0x85, 0x03, 0x7a, 0xa4,
+	0xe4, 0x19, 0x08, 0x9b, 0xe4, 0x3a, 0xc0, 0x00, 0xc9, 0x75, 0xcf, 0x08, 0xf7, 0x95, 0x02, 0x43,
+	0xd4, 0x18, 0x64, 0xdb, 0x08, 0xf7, 0xc9, 0x65, 0xa8, 0x50, 0xe7, 0x50, 0x3f, 0x34, 0x7c, 0xa5,
+	0xc8, 0x70, 0x65, 0xea, 0x1c, 0x7e, 0x6d, 0xf8, 0xea, 0x7f, 0x15, 0xa0, 0xb6, 0xe3, 0x1b, 0x4e,
+	0xb0, 0xeb, 0xfa, 0x03, 0x32, 0x0b, 0x25, 0x6b, 0x60, 0xec, 0xc9, 0xc9, 0x78, 0x07, 0x67, 0xeb,
+	0x0f, 0x4c, 0x25, 0x7f, 0xab, 0x80, 0xb3, 0xf5, 0x07, 0x26, 0x63, 0xe7, 0xfb, 0x3a, 0x42, 0x0b,
+	0x0c, 0x5a, 0xa6, 0xbe, 0xbf, 0x36, 0x30, 0xc9, 0xbb, 0x50, 0xa0, 0xce, 0xa1, 0x52, 0xbc, 0x55,
+	0xb8, 0x5b, 0x5f, 0x5e, 0x58, 0xe4, 0xbb, 0xbc, 0x18, 0x4d, 0xb0, 0xd8, 0x71, 0x0e, 0x3b, 0x4e,
+	0xe8, 0x1f, 0x69, 0x48, 0x46, 0xde, 0x83, 0x4a, 0xc0, 0x56, 0x1a, 0x28, 0x25, 0x36, 0x62, 0x46,
+	0x8e, 0x48, 0x6c, 0x80, 0x26, 0x69, 0xc8, 0xbb, 0x40, 0x98, 0x40, 0xba, 0x37, 0xb4, 0x6d, 0x5d,
+	0x8e, 0x2c, 0x33, 0x01, 0xda, 0x0c, 0xb3, 0x3d, 0xb4, 0xed, 0xae, 0xa0, 0x9e, 0x85, 0x52, 0x10,
+	0x9a, 0x96, 0xa3, 0x54, 0x18, 0x01, 0xef, 0x90, 0xab, 0x50, 0x43, 0xc9, 0x39, 0xa6, 0xca, 0x30,
+	0x55, 0xea, 0xfb, 0x5d, 0x86, 0x7c, 0x17, 0x88, 0xd1, 0xef, 0x53, 0x2f, 0xd4, 0x7d, 0x1a, 0x0e,
+	0x7d, 0x47, 0xef, 0xbb, 0x26, 0x55, 0x6a, 0xb7, 0x0a, 0x77, 0x0b, 0x5a, 0x9b, 0x63, 0x34, 0x86,
+	0x58, 0x73, 0x4d, 0x8a, 0x13, 0x98, 0xb4, 0x37, 0xdc, 0x53, 0xe0, 0x56, 0xee, 0x6e, 0x55, 0xe3,
+	0x1d, 0x54, 0xd7, 0x30, 0xa0, 0xbe, 0x52, 0xe7, 0xea, 0xc2, 0x36, 0xb9, 0x09, 0xf5, 0xd7, 0xae,
+	0x7f, 0x60, 0x39, 0x7b, 0xba, 0x69, 0xf9, 0x4a, 0x83, 0xa1, 0x40, 0x80, 0xd6, 0x2d, 0x9f, 0xdc,
+	0x00, 0x30, 0xdd, 0xfe, 0x01, 0xf5, 0x77, 0x2d, 0x9b, 0x2a, 0x4d, 0x8e, 0x8f, 0x21, 0x0b, 0x0f,
+	0xa1, 0x2a, 0x77, 0x4e, 0xea, 0x3e, 0x17, 0xeb, 0x7e, 0x16, 0x4a, 0x87, 0x86, 0x3d, 0xa4, 0xe2,
+	0x3c, 0xf0, 0xce, 0xa3, 0xfc, 0xaf, 0x72, 0xea, 0x3d, 0x28, 0xed, 0x3c, 0x7e, 0xea, 0xf6, 0xc8,
+	0x2d, 0x28, 0x87, 0xbb, 0xfa, 0x2b, 0xb7, 0xc7, 0xc7, 0xad, 0xd6, 0xde, 0xfc, 0x74, 0x93, 0xa3,
+	0xb4, 0x52, 0xb8, 0xfb, 0xd4, 0xed, 0xa9, 0x7f, 0x9f, 0x83, 0x72, 0x67, 0xcf, 0xa7, 0x41, 0x80,
+	0x33, 0xbc, 0xd4, 0x36, 0xe5, 0x0c, 0x2f, 0xb5, 0x4d, 0xb2, 0x0e, 0x2d, 0xb7, 0xf7, 0x8a, 0xf6,
+	0x43, 0x3d, 0x08, 0x5d, 0xdf, 0xd8, 0xe3, 0x53, 0xd5, 0x97, 0xaf, 0x2e, 0x7a, 0xbb, 0x4c, 0x5f,
+	0x2f, 0x18, 0xb6, 0xcb, 0x91, 0x9c, 0xcd, 0x97, 0x97, 0xb4, 0xa6, 0x9b, 0x04, 0x93, 0xcf, 0xa0,
+	0x11, 0x7c, 0x67, 0xeb, 0xa6, 0x11, 0x1a, 0x3d, 0x23, 0xa0, 0xec, 0x94, 0xd6, 0x97, 0xaf, 0x48,
+	0x1e, 0xdd, 0xaf, 0x36, 0xd7, 0x05, 0x2a, 0xe2, 0x50, 0x0f, 0xbe, 0xb3, 0x25, 0x70, 0xb5, 0x0a,
+	0xe5, 0xd0, 0xf0, 0xf7, 0x68, 0xa8, 0x7e, 0x05, 0x05, 0x5c, 0xd5, 0xbb, 0x50, 0xf5, 0x2c, 0x8f,
+	0xda, 0x96, 0xc3, 0x4f, 0x6c, 0x7d, 0xb9, 0x2d, 0x0f, 0xd0, 0xb6, 0x80, 0x6b, 0x11, 0x05, 0x99,
+	0x87, 0xbc, 0x65, 0xf2, 0x3d, 0x5a, 0x2d, 0xbf, 0xf9, 0xe9, 0x66, 0x7e, 0x63, 0x5d, 0xcb, 0x5b,
+	0xe6, 0xa3, 0xe2, 0x5f, 0xfc, 0xf5, 0xcd, 0x4b, 0xea, 0x1f, 0xe5, 0xa1, 0xfa, 0x9c, 0x86, 0x06,
+	0x4a, 0x47, 0xd6, 0xa0, 0x6e, 0x38, 0x8e, 0x1b, 0xb2, 0xcb, 0x1c, 0x28, 0x39, 0x76, 0x38, 0x6f,
+	0x4b, 0xde, 0x92, 0x6c, 0x71, 0x25, 0xa6, 0xe1, 0xa7, 0x3a, 0x39, 0x8a, 0x7c, 0x08, 0x65, 0xdb,
+	0xe8, 0x51, 0x3b, 0x60, 0x37, 0xa7, 0xbe, 0x7c, 0x6d, 0x6c, 0xfc, 0x26, 0x43, 0xf3, 0xa1, 0x82,
+	0x76, 0xe1, 0x33, 0x68, 0x8f, 0xb2, 0x3d, 0x8b, 0xca, 0x17, 0x3e, 0x86, 0x7a, 0x82, 0xed, 0x99,
+	0x4e, 0xcb, 0x1f, 0x42, 0xa5, 0x4b, 0xfd, 0x43, 0xab, 0x4f, 0xc9, 0x1d, 0x68, 0x5a, 0x4e, 0x48,
+	0x7d, 0xc7, 0xb0, 0x75, 0xcf, 0xf5, 0x43, 0xc6, 0xa0, 0xa4, 0x35, 0x24, 0x70, 0xdb, 0xf5, 0x43,
+	0x24, 0xa2, 0xdf, 0x27, 0x89, 0xf2, 0x9c, 0x88, 0x7e, 0x9f, 0x20, 0xc2, 0x5d, 0xf7, 0x94, 0x42,
+	0x62, 0xd7, 0xb7, 0xb5, 0xbc, 0xe5, 0xe1, 0x3d, 0x09, 0x8f, 0x3c, 0x2a, 0xcc, 0x11, 0x6b, 0xab,
+	0xcb, 0x50, 0xea, 0x7a, 0xee, 0x30, 0x24, 0xf7, 0xd0, 0x30, 0x30, 0x49, 0x84, 0x5e, 0xa7, 0x62,
+	0xc3, 0xc0, 0xc0, 0x9a, 0xc4, 0xab, 0xff, 0x91, 0x87, 0xea, 0xf6, 0xe3, 0xee, 0x86, 0xe3, 0x0d,
+	0xb3, 0x6d, 0x25, 0x81, 0xa2, 0x4f, 0x3d, 0x57, 0x2c, 0x97, 0xb5, 0xd1, 0x0a, 0xe0, 0x5f, 0x9d,
+	0x49, 0xc0, 0xaf, 0x5b, 0x15, 0x01, 0x3b, 0x47, 0x1e, 0x9e, 0x93, 0x72, 0xcf, 0x37, 0x9c, 0xbe,
+	0x34, 0xa3, 0xa2, 0x87, 0xf0, 0xbe, 0x3b, 0x18, 0x58, 0xa1, 0x34, 0xa1, 0xbc, 0x87, 0x13, 0xec,
+	0xd9, 0x6e, 0x4f, 0x29, 0xf1, 0x09, 0xb0, 0x8d, 0x06, 0xf2, 0x95, 0x6b, 0x39, 0xba, 0xeb, 0x28,
+	0x65, 0x4e, 0x8c, 0xdd, 0x17, 0x0e, 0xda, 0x69, 0x77, 0x18, 0x52, 0x5f, 0xc7, 0xbe, 0x52, 0x61,
+	0x96, 0xa3, 0xc6, 0x20, 0x4f, 0x5d, 0xcb, 0x21, 0x57, 0xa0, 0xba, 0xe7, 0xbb, 0x43, 0x4f, 0xef,
+	0x1d, 0x29, 0x55, 0x36, 0xb0, 0xc2, 0xfa, 0xab, 0x47, 0x38, 0x8d, 0x6d, 0xfc, 0x70, 0xa4, 0xd4,
+	0xd8, 0x18, 0xd6, 0x46, 0xc3, 0xc2, 0x1c, 0x96, 0x8e, 0x56, 0x22, 0x10, 0x86, 0x08, 0x18, 0xe8,
+	0x31, 0x42, 0x48, 0x0b, 0xf2, 0xc1, 0x03, 0x66, 0x8b, 0xaa, 0x5a, 0x3e, 0x78, 0x80, 0x1b, 0x1b,
+	0xfa, 0xd6, 0xde, 0x1e, 0xe5, 0x56, 0x88, 0x6d, 0xec, 0xae, 0xb0, 0xd1, 0x0c, 0xac, 0x49, 0xbc,
+	0xfa, 0x37, 0x79, 0xa8, 0xad, 0xf9, 0xae, 0x73, 0xb6, 0x9d, 0x8d, 0x37, 0xa9, 0x30, 0xba, 0x49,
+	0x81, 0x47, 0xfb, 0x52, 0xdd, 0xd8, 0x26, 0xd7, 0xa0, 0xe6, 0x1e, 0x52, 0xff, 0xb5, 0x6f, 0x85,
+	0x54, 0x29, 0x89, 0xad, 0x90, 0x00, 0xf2, 0x3e, 0xda, 0x6f, 0xc3, 0x0f, 0xd9, 0x06, 0xa2, 0x33,
+	0xe1, 0xce, 0x76, 0x51, 0x3a, 0xdb, 0xc5, 0x1d, 0xe9, 0x8d, 0x35, 0x4e, 0x48, 0x16, 0xa0, 0x8a,
+	0x1e, 0xfa, 0x07, 0xd7, 0xa1, 0x6c, 0x67, 0x6b, 0x5a, 0xd4, 0x27, 0x8b, 0x50, 0xed, 0x1b, 0x61,
+	0x7f, 0x5f, 0x1f, 0x7a, 0x6c, 0x63, 0x5b, 0xb1, 0xaf, 0xc1, 0x45, 0xae, 0x21, 0xee, 0xa5, 0xa7,
+	0x55, 0xfa, 0xbc, 0x41, 0x7e, 0x01, 0xad, 0x9e, 0xd1, 0x3f, 0xd8, 0xb5, 0x6c, 0x5b, 0xb7, 0x2d,
+	0x5c, 0x0f, 0xee, 0x7b, 0x41, 0x6b, 0x4a, 0xe8, 0x26, 0x02, 0xd5, 0x7f, 0xcc, 0x41, 0x9d, 0xdb,
+	0xbe, 0x9f, 0x67, 0x9b, 0x84, 0xe9, 0x2d, 0xc6, 0xa6, 0x57, 0x6e, 0x5c, 0x29, 0xb1, 0x71, 0xf2,
+	0xc4, 0x95, 0x13, 0x27, 0x4e, 0x1e, 0x8f, 0x4a, 0xe2, 0x78, 0xcc, 0x43, 0xd9, 0xa4, 0x36, 0x0d,
+	0x29, 0x5b, 0x72, 0x55, 0x13, 0x3d, 0xf5, 0xcf, 0xf2, 0x50, 0xe2, 0xf2, 0xaa, 0x50, 0xf0, 0x76,
+	0x83, 0x31, 0xe3, 0x29, 0xee, 0x93, 0x86, 0x48, 0x72, 0x1b, 0x8a, 0xec, 0xb0, 0x72, 0x2b, 0xd6,
+	0x94, 0x44, 0x9c, 0x82, 0xa1, 0xc8, 0x1d, 0x28, 0xb1, 0x63, 0xaa, 0x14, 0xb2, 0x68, 0x38, 0x0e,
+	0x89, 0xfa, 0xbe, 0x1b, 0x04, 0x4a, 0x31, 0x93, 0x88, 0xe1, 0x90, 0x68, 0xe8, 0x58, 0xae, 0xa3,
+	0x94, 0x32, 0x89, 0x18, 0x8e, 0xfc, 0x02, 0x8a, 0x7d, 0x5f, 0x5c, 0xad, 0xfa, 0xf2, 0x74, 0x52,
+	0x91, 0x42, 0x2a, 0x44, 0x93, 0x77, 0xa0, 0xcc, 0x1d, 0x10, 0xdb, 0x94, 0x44, 0x74, 0x91, 0xd0,
+	0x98, 0x26, 0x48, 0x54, 0x07, 0xaa, 0x4f, 0xdd, 0xde, 0xf1, 0x5a, 0x7c, 0x2b, 0xd2, 0x18, 0x77,
+	0x7d, 0x2d, 0x79, 0x71, 0xd6, 0x18, 0x74, 0xcc, 0x1a, 0x14, 0x32, 0x74, 0x53, 0x8c, 0x75, 0xa3,
+	0xbe, 0x07, 0x53, 0xdb, 0x86, 0x6f, 0xd8, 0x36, 0xb5, 0xad, 0x60, 0xd0, 0x45, 0xb5, 0x2e, 0x40,
+	0xb5, 0xef, 0x3a, 0x41, 0x68, 0x38, 0xdc, 0xde, 0x16, 0xb5, 0xa8, 0xaf, 0x3e, 0x80, 0x1a, 0x93,
+	0x0d, 0xaf, 0x35, 0xf2, 0x63, 0x61, 0x9e, 0x90, 0x0f, 0xdb, 0x08, 0xdb, 0x37, 0x82, 0x7d, 0x26,
+	0x5d, 0x43, 0x63, 0x6d, 0xf5, 0x33, 0x28, 0xad, 0x1b, 0xe1, 0x70, 0x40, 0xae, 0x43, 0x41, 0xfa,
+	0xfe, 0xfa, 0x72, 0x5d, 0x6e, 0x03, 0x7a, 0x7f, 0x84, 0x1f, 0xe7, 0x19, 0xd5, 0xff, 0xcc, 0x41,
+	0x8d, 0x31, 0xd8, 0x70, 0x76, 0x5d, 0x54, 0x8d, 0x89, 0x1d, 0xc1, 0x26, 0x52, 0x0d, 0xa3, 0xd0,
+	0x38, 0x8e, 0xdc, 0x65, 0xb7, 0x36, 0xe4, 0xde, 0xa5, 0xb5, 0x4c, 0x52, 0x44, 0x5d, 0xc4, 0x68,
+	0x9c, 0x80, 0xdc, 0xe7, 0x94, 0x81, 0x08, 0x03, 0x66, 0xa3, 0xc3, 0xe7, 0xbb, 0x7d, 0x1a, 0x04,
+	0x48, 0x1b, 0x70, 0xda, 0x80, 0xdc, 0x83, 0x1a, 0xee, 0x36, 0xe7, 0x5c, 0x64, 0xf4, 0x0d, 0xb9,
+	0xff, 0xb8, 0x23, 0x5a, 0xd5, 0xdb, 0x65, 0x23, 0x28, 0xf9, 0x7f, 0x50, 0x44, 0xdf, 0x2a, 0xce,
+	0x4f, 0x3b, 0x49, 0x85, 0xab, 0xd0, 0x18, 0x56, 0xfd, 0x87, 0x1c, 0xd4, 0x56, 0xf6, 0xf6, 0x7c,
+	0xba, 0x87, 0x63, 0x66, 0xa1, 0xd4, 0xc7, 0x50, 0x93, 0xad, 0xac, 0xa0, 0xf1, 0x0e, 0xee, 0xe8,
+	0x80, 0x1a, 0x0e, 0x5b, 0x49, 0x4e, 0x63, 0x6d, 0xbc, 0x51, 0x41, 0x68, 0x9a, 0xf4, 0x90, 0x49,
+	0x9d, 0xd3, 0x44, 0x8f, 0xdc, 0x83, 0xf6, 0xae, 0xb5, 0x1b, 0xee, 0xeb, 0x1e, 0xf5, 0xfb, 0xd4,
+	0x09, 0x2d, 0x9b, 0xcb, 0x99, 0xd3, 0xa6, 0x18, 0x7c, 0x3b, 0x02, 0x93, 0x87, 0x70, 0xd9, 0xb1,
+	0x1c, 0xca, 0x8c, 0xf6, 0xc8, 0x88, 0x12, 0x1b, 0x31, 0xc7, 0xd1, 0x8f, 0xd3, 0xe3, 0xd4, 0xbf,
+	0x2c, 0x40, 0x23, 0xb9, 0x37, 0xe4, 0x33, 0x68, 0x9a, 0xee, 0x6b, 0xc7, 0x76, 0x0d, 0x53, 0x47,
+	0x3b, 0x27, 0xf4, 0x72, 0x65, 0xcc, 0x50, 0xae, 0x8b, 0xac, 0x44, 0x6b, 0x48, 0x7a, 0x34, 0x9d,
+	0xe4, 0xd7, 0xd0, 0xf0, 0x38, 0x3f, 0x3e, 0x3c, 0x7f, 0xd2, 0xf0, 0xba, 0x20, 0x67, 0xa3, 0x1f,
+	0x41, 0x7d, 0xe8, 0xc5, 0x73, 0x17, 0x4e, 0x1a, 0x0c, 0x9c, 0x9a, 0x8d, 0xfd, 0x05, 0xb4, 0x22,
+	0xc9, 0x7b, 0x47, 0x21, 0x0d, 0xd8, 0x5e, 0x15, 0xb4, 0x68, 0x3d, 0xab, 0x08, 0x24, 0xb7, 0xa1,
+	0x31, 0xf4, 0x12, 0x44, 0x25, 0x46, 0x24, 0xa6, 0xe5, 0x24, 0x1f, 0x42, 0xb5, 0xef, 0x0d, 0xb9,
+	0x08, 0xe5, 0x93, 0x44, 0xa8, 0xf4, 0xbd, 0x21, 0x9b, 0xff, 0x3e, 0x4c, 0x7b, 0xd4, 0x38, 0xd0,
+	0x07, 0x74, 0xe0, 0xfa, 0x47, 0x82, 0x7b, 0x85, 0x71, 0x9f, 0x42, 0xc4, 0x73, 0x06, 0xe7, 0x33,
+	0xdc, 0x81, 0x66, 0xd0, 0xf7, 0x99, 0xeb, 0xe0, 0x74, 0x55, 0x46, 0xd7, 0x10, 0x40, 0x46, 0xa4,
+	0xfe, 0x7b, 0x01, 0xe6, 0xa2, 0xe3, 0x94, 0x52, 0xd2, 0xc3, 0x6c, 0x25, 0x45, 0x36, 0x2b, 0x1a,
+	0x35, 0xa2, 0x9c, 0x0f, 0x33, 0x95, 0x93, 0x31, 0x2c, 0xa5, 0x94, 0xe5, 0x2c, 0xa5, 0x64, 0x0c,
+	0x4a, 0x2a, 0xe3, 0x57, 0x99, 0xca, 0xc8, 0x1c, 0x36, 0xa2, 0x9f, 0x0f, 0x33, 0xf4, 0x93, 0x2d,
+	0x63, 0x52, 0x65, 0xef, 0x8e, 0xa9, 0x2c, 0x63, 0x44, 0xa4, 0xaa, 0x4f, 0x8f, 0x53, 0x55, 0xe6,
+	0xb0, 0x31, 0xed, 0x3d, 0xcc, 0xd2, 0x5e, 0xf6, 0xf6, 0xa7, 0x14, 0xfa, 0x63, 0x0e, 0x1a, 0xdf,
+	0xb8, 0xfe, 0x01, 0xf5, 0x51, 0x8d, 0x43, 0x66, 0x81, 0x5e, 0xb3, 0xbe, 0x6e, 0x99, 0x22, 0x87,
+	0x6a, 0xbc, 0xf9, 0xe9, 0x66, 0x95, 0x13, 0x6d, 0xac, 0x6b, 0x55, 0x8e, 0xde, 0x30, 0x31, 0xd7,
+	0x7a, 0xe5, 0xf6, 0xf4, 0xc8, 0xa2, 0xb2, 0x5c, 0x0b, 0x7d, 0xcb, 0xba, 0x56, 0x7a, 0xe5, 0xf6,
+	0x36, 0x4c, 0xf2, 0x10, 0x1a, 0xcc, 0x5a, 0x32, 0x83, 0x36, 0x94, 0x16, 0x70, 0x66, 0xcc, 0x56,
+	0x0e, 0x03, 0xad, 0x6e, 0xc6, 0x1d, 0xf5, 0x15, 0xd4, 0x13, 0x38, 0xf2, 0x21, 0x54, 0x58, 0xe0,
+	0x43, 0x4d, 0x25, 0x77, 0x62, 0x8c, 0x24, 0x49, 0xd1, 0x79, 0x32, 0x03, 0xc9, 0xdd, 0xf9, 0x74,
+	0xca, 0xc1, 0x32, 0x5b, 0xca, 0x2d, 0xa4, 0x0b, 0x0d, 0x8d, 0x06, 0xee, 0xd0, 0xef, 0x53, 0xe6,
+	0x9c, 0xb0, 0x08, 0xe0, 0x0d, 0xd9, 0x44, 0x79, 0x0d, 0x9b, 0x68, 0x0b, 0xb9, 0x56, 0x44, 0x64,
+	0x23, 0x7a, 0xe4, 0x36, 0x14, 0xf6, 0xbc, 0xa1, 0x52, 0x48, 0x07, 0xee, 0x4f, 0xb6, 0x5f, 0x22,
+	0x1f, 0x0d, 0x71, 0x68, 0x5a, 0x4d, 0x2b, 0x38, 0x90, 0xd1, 0x20, 0xb6, 0xd5, 0x8f, 0xa0, 0x22,
+	0x68, 0xa2, 0xdc, 0x20, 0x17, 0xe7, 0x06, 0x38, 0x9b, 0x33, 0x1c, 0xf4, 0xa8, 0xcf, 0x66, 0x2b,
+	0x68, 0xa2, 0xa7, 0xfe, 0x06, 0xe0, 0xa9, 0xdb, 0xeb, 0xd2, 0x90, 0xf9, 0xa8, 0xb7, 0x31, 0xee,
+	0xee, 0xe9, 0x01, 0x0d, 0xc5, 0x96, 0xb4, 0x12, 0xce, 0xae, 0x4b, 0x43, 0x8c, 0xc3, 0xf1, 0x2f,
+	0xb9, 0x83, 0x41, 0x4d, 0x4f, 0xa6, 0x66, 0x53, 0x09, 0x2a, 0xee, 0x25, 0x10, 0xa9, 0xfe, 0x71,
+	0x13, 0x2a, 0x02, 0x72, 0x92, 0x0b, 0xbd, 0x07, 0x6d, 0x99, 0x68, 0xea, 0x87, 0xd4, 0x0f, 0x30,
+	0x84, 0xc9, 0x33, 0x1f, 0x3e, 0x25, 0xe1, 0x5f, 0x73, 0x30, 0x79, 0x00, 0x4d, 0x77, 0x18, 0x7a,
+	0xc3, 0x50, 0x4f, 0x84, 0x80, 0xe3, 0x01, 0x45, 0x83, 0x13, 0xf1, 0x1e, 0x51, 0xa0, 0xe2, 0x53,
+	0x1e, 0x0f, 0x17, 0x19, 0x5b, 0xd9, 0x65, 0xc6, 0xd4, 0x08, 0x0d, 0x5d, 0xd8, 0x01, 0x6a, 0x0a,
+	0x3b, 0xd9, 0x44, 0xe8, 0xb6, 0x04, 0xa2, 0x31, 0x65, 0x64, 0xc1, 0x81, 0xe5, 0x79, 0xd4, 0x64,
+	0x57, 0xaf, 0xc0, 0x8e, 0x97, 0xd1, 0xe5, 0x20, 0xcc, 0x4d, 0x18, 0x49, 0xe8, 0x86, 0x86, 0x2d,
+	0xec, 0x61, 0x0d, 0x21, 0x3b, 0x08, 0xc0, 0x64, 0x83, 0xa1, 0x77, 0x0d, 0xcb, 0xa6, 0xa6, 0xb0,
+	0x83, 0x6c, 0xc4, 0x63, 0x06, 0x89, 0x24, 0xf1, 0x69, 0x1f, 0xc3, 0x78, 0x6a, 0xca, 0x98, 0x19,
+	0xa1, 0x9a, 0x04, 0xc6, 0x8e, 0x1f, 0x4e, 0x76, 0xfc, 0x9f, 0x41, 0x3d, 0xbe, 0x29, 0x81, 0x32,
+	0xcd, 0x46, 0x5c, 0x1f, 0xbb, 0xbd, 0xa9, 0xa1, 0x10, 0x5d, 0x99, 0x80, 0xbc, 0x25, 0xc3, 0x91,
+	0x3a, 0x0b, 0x47, 0xda, 0xc9, 0xd3, 0x90, 0x0c, 0x46, 0xe6, 0xa1, 0xec, 0x53, 0x23, 0x70, 0x1d,
+	0x51, 0x9c, 0x11, 0x3d, 0xbc, 0x62, 0x7d, 0x9f, 0x1a, 0x78, 0xc5, 0x9a, 0x27, 0x5f, 0x31, 0x41,
+	0x9a, 0xbc, 0x98, 0xad, 0xd3, 0x5f, 0xcc, 0x87, 0x50, 0xdd, 0xb5, 0x1c, 0x2b, 0xd8, 0xa7, 0xa6,
+	0x32, 0x75, 0xe2, 0xb0, 0x88, 0x96, 0x7c, 0x00, 0x15, 0x93, 0x86, 0x86, 0x65, 0x07, 0x4a, 0x9b,
+	0x0d, 0xbb, 0x3c, 0x72, 0x9a, 0x17, 0xd7, 0x39, 0x5a, 0x93, 0x74, 0x0b, 0xbf, 0xab, 0x40, 0x45,
+	0x00, 0xc9, 0x12, 0xd4, 0x42, 0x59, 0x9f, 0x1b, 0xf5, 0x4e, 0x51, 0xe1, 0x4e, 0x8b, 0x69, 0xc8,
+	0x2a, 0xb4, 0xbd, 0x38, 0x72, 0xd5, 0x59, 0x76, 0x92, 0x4f, 0x4f, 0x3c, 0x12, 0xd9, 0x6a, 0x53,
+	0x5e, 0x1a, 0x80, 0xd1, 0x34, 0x65, 0x35, 0x9e, 0xf8, 0xf0, 0xf3, 0x91, 0xbc, 0xf2, 0xa3, 0x09,
+	0x6c, 0xb2, 0x10, 0x50, 0x9c, 0x5c, 0x08, 0xc0, 0xf0, 0x34, 0xc0, 0xe2, 0x81, 0x52, 0x4a, 0x87,
+	0xa7, 0xac, 0xa2, 0xa0, 0x71, 0x1c, 0xf9, 0x18, 0x9a, 0xc2, 0x8c, 0x0b, 0xd3, 0x5b, 0xbe, 0x55,
+	0x48, 0x9e, 0xc1, 0xa4, 0xcd, 0xd7, 0x1a, 0xaf, 0x13, 0x3d, 0xb2, 0x02, 0xd3, 0xbe, 0x30, 0x88,
+	0xba, 0x4f, 0xbf, 0x1b, 0xd2, 0x20, 0x94, 0x9e, 0x28, 0x1a, 0x9e, 0xb4, 0x98, 0x5a, 0x5b, 0x92,
+	0x6b, 0x82, 0x9a, 0x7c, 0x0a, 0x53, 0x11, 0x0b, 0x96, 0x54, 0x4a, 0x7f, 0x94, 0xcd, 0xa0, 0x25,
+	0x89, 0x59, 0xae, 0x19, 0x90, 0x4d, 0xb8, 0x1c, 0x58, 0x26, 0xed, 0x1b, 0xbe, 0x3e, 0xca, 0xa6,
+	0x36, 0x81, 0xcd, 0x9c, 0x18, 0xa4, 0xa5, 0xb9, 0xdd, 0x81, 0x92, 0x85, 0x36, 0x5f, 0x81, 0xf4,
+	0x7e, 0x89, 0x4c, 0xcb, 0x92, 0x99, 0x50, 0x60, 0xd8, 0xa1, 0xac, 0x66, 0x62, 0x9b, 0x3c, 0x82,
+	0x96, 0xb8, 0x93, 0x34, 0xe4, 0xda, 0x6f, 0xa4, 0x67, 0xe7, 0x3e, 0x8a, 0x86, 0x6c, 0xf6, 0x86,
+	0x99, 0xe8, 0xb1, 0x98, 0x95, 0x8d, 0x45, 0xf7, 0x8f, 0xca, 0x6a, 0x9e, 0x1c, 0xb3, 0x22, 0xfd,
+	0x0e, 0x27, 0xc7, 0xa8, 0x13, 0xed, 0xbb, 0x1c, 0xdd, 0x3a, 0x69, 0x34, 0xbc, 0x72, 0x7b, 0x72,
+	0xec, 0x4d, 0x69, 0x4b, 0x42, 0xdf, 0xa2, 0x81, 0x32, 0x15, 0xd9, 0xaf, 0xe1, 0x60, 0x07, 0x21,
+	0xe4, 0x73, 0x98, 0x0a, 0xfa, 0xfb, 0xd4, 0x1c, 0xda, 0x58, 0xa9, 0x65, 0x2b, 0xe3, 0x17, 0x6a,
+	0x3e, 0x3a, 0x4b, 0x11, 0x9a, 0x2b, 0x28, 0x48, 0xf5, 0xb1, 0x7a, 0xe3, 0xb9, 0x26, 0x1f, 0x39,
+	0xcd, 0xab, 0x37, 0x9e, 0x6b, 0x32, 0xd4, 0x55, 0xa8, 0x21, 0xca, 0xc3, 0x10, 0x43, 0x21, 0x0c,
+	0x87, 0xb4, 0xdb, 0xd8, 0x67, 0x92, 0x51, 0xc3, 0xd4, 0x6d, 0x1a, 0x86, 0xd4, 0x57, 0x66, 0x78,
+	0x19, 0x07, 0x41, 0x9b, 0x0c, 0xa2, 0x3e, 0x81, 0x32, 0x3f, 0x99, 0x99, 0xa9, 0xe9, 0xbd, 0x74,
+	0xce, 0x35, 0x33, 0x7e, 0x98, 0xa5, 0x9d, 0x53, 0x6f, 0x40, 0x55, 0x56, 0x46, 0xb3, 0x58, 0xa9,
+	0xff, 0x33, 0x05, 0x0d, 0x49, 0xc0, 0xdc, 0xde, 0xd9, 0x4a, 0xac, 0x0a, 0x54, 0xd2, 0xce, 0x4f,
+	0x76, 0xc9, 0x12, 0xd4, 0x71, 0x5b, 0x26, 0xbb, 0x3c, 0x40, 0x92, 0xd8, 0xe1, 0x05, 0xa1, 0xcb,
+	0x5c, 0x15, 0x4f, 0x9b, 0x65, 0x97, 0xbc, 0x23, 0x97, 0x5b, 0x62, 0xcb, 0x9d, 0x1b, 0x95, 0xe7,
+	0x18, 0xc3, 0x5e, 0x4e, 0x19, 0xf6, 0x87, 0xd0, 0xb2, 0x8d, 0x20, 0xd4, 0x59, 0xb4, 0xc0, 0xb8,
+	0x55, 0x8f, 0xf1, 0x10, 0x0d, 0xa4, 0x93, 0x3d, 0x72, 0x0b, 0xea, 0x09, 0x5b, 0xc6, 0xee, 0x5d,
+	0x51, 0x4b, 0x82, 0xc8, 0x47, 0x22, 0x78, 0x01, 0xc6, 0xef, 0xf6, 0xa8, 0x74, 0xcc, 0x20, 0xcb,
+	0x0e, 0xd6, 0x1b, 0x45, 0x7c, 0x73, 0x1d, 0xc0, 0x18, 0x86, 0xfb, 0x7a, 0xe8, 0x1e, 0x50, 0x47,
+	0xdc, 0xb7, 0x1a, 0x42, 0x76, 0x10, 0x40, 0x1e, 0xc6, 0x46, 0x9e, 0xdf, 0xb6, 0x6b, 0x99, 0x8c,
+	0xc7, 0x2c, 0xfd, 0x9f, 0xd6, 0x2f, 0x60, 0xe9, 0x97, 0xa2, 0x57, 0x83, 0x7c, 0xda, 0x46, 0xb0,
+	0x97, 0x83, 0xf1, 0x47, 0x84, 0x4c, 0xd7, 0x50, 0x38, 0xb7, 0x6b, 0x28, 0x4e, 0x74, 0x0d, 0x1f,
+	0x03, 0x08, 0x7f, 0xab, 0x1b, 0xd2, 0xe8, 0x4f, 0x72, 0x98, 0x35, 0x41, 0xbd, 0x12, 0x62, 0x2c,
+	0xe4, 0x53, 0xcc, 0xab, 0x75, 0xea, 0xfb, 0xae, 0x2f, 0x8e, 0x46, 0x9d, 0xc3, 0x3a, 0x08, 0x22,
+	0xef, 0xc0, 0x34, 0xb7, 0xfe, 0x81, 0x34, 0xf6, 0xd4, 0x14, 0x21, 0x51, 0x5b, 0x20, 0x34, 0x09,
+	0x4f, 0x12, 0x1b, 0x87, 0x86, 0x65, 0x1b, 0x3d, 0x9b, 0x2a, 0xd5, 0x14, 0xf1, 0x8a, 0x84, 0x63,
+	0x42, 0x29, 0xc2, 0x3f, 0x51, 0x65, 0xae, 0xb1, 0xd9, 0x45, 0xb8, 0xb7, 0xca, 0x60, 0xd9, 0xce,
+	0x06, 0x2e, 0xea, 0x6c, 0xea, 0x3f, 0x8f, 0xb3, 0x69, 0x5c, 0xc0, 0xd9, 0x34, 0x27, 0x38, 0x9b,
+	0x5b, 0x68, 0x06, 0x83, 0xbe, 0x6f, 0x79, 0x68, 0xbb, 0x99, 0x71, 0xaf, 0x69, 0x49, 0x50, 0xe4,
+	0x8e, 0xda, 0x09, 0x77, 0x14, 0xdf, 0xf0, 0xe9, 0xd4, 0x0d, 0x4f, 0x84, 0x0e, 0x33, 0xa7, 0x0d,
+	0x1d, 0x66, 0x27, 0x84, 0x0e, 0xe3, 0x6e, 0x6f, 0xee, 0xfc, 0x6e, 0x6f, 0xfe, 0x42, 0x6e, 0xef,
+	0xf2, 0x05, 0xdc, 0x9e, 0x72, 0x1a, 0xb7, 0x77, 0xe5, 0xdc, 0x6e, 0x6f, 0x61, 0x82, 0xdb, 0xbb,
+	0x3a, 0xe2, 0xf6, 0xe6, 0xa0, 0x1c, 0x3c, 0xd0, 0x71, 0x41, 0xd7, 0xf8, 0x0b, 0x6a, 0xf0, 0xe0,
+	0xc5, 0x30, 0x44, 0x97, 0x33, 0x10, 0x2f, 0x64, 0xca, 0xf5, 0xb4, 0xcb, 0x91, 0x2f, 0x67, 0x5a,
+	0x44, 0x81, 0x49, 0x87, 0x4f, 0x65, 0xa9, 0x84, 0x89, 0x70, 0x83, 0x4d, 0xd3, 0x8c, 0xa0, 0x4c,
+	0x90, 0xb7, 0x61, 0x6a, 0xe8, 0xf4, 0x6d, 0xc3, 0x1a, 0x50, 0x53, 0xc7, 0xc7, 0xf6, 0x40, 0xb9,
+	0xc9, 0x76, 0xa2, 0x15, 0x81, 0x77, 0x10, 0x8a, 0x12, 0x8b, 0x08, 0xd1, 0xef, 0x2b, 0xb7, 0xb8,
+	0xc4, 0x1c, 0xa0, 0xf5, 0xf1, 0x84, 0x1a, 0xc3, 0xd0, 0x0d, 0xfa, 0x06, 0x2e, 0x5e, 0xb9, 0xcd,
+	0xc4, 0x4e, 0x82, 0x46, 0x5d, 0xb9, 0x3a, 0xe6, 0xca, 0x7f, 0x80, 0x46, 0xd2, 0xfa, 0x93, 0x2b,
+	0x30, 0xb7, 0xbd, 0xb1, 0xdd, 0xd9, 0xdc, 0xd8, 0xda, 0xd1, 0x77, 0xbe, 0xdd, 0xee, 0xe8, 0x2f,
+	0xb7, 0x9e, 0x6d, 0xbd, 0xf8, 0x66, 0xab, 0x7d, 0x89, 0x5c, 0x85, 0xcb, 0x02, 0xd5, 0xe1, 0xa8,
+	0x1d, 0x6d, 0x65, 0xab, 0xfb, 0xf8, 0x85, 0xf6, 0xbc, 0x9d, 0x23, 0x97, 0x61, 0x26, 0x8d, 0xec,
+	0x6e, 0xbf, 0x78, 0xb9, 0xd3, 0xce, 0x27, 0x18, 0x4a, 0x44, 0x47, 0xfb, 0x7a, 0x63, 0xad, 0xd3,
+	0x2e, 0x3c, 0x2d, 0x56, 0x2b, 0xed, 0xaa, 0xfa, 0x14, 0x9a, 0x49, 0x9f, 0x81, 0x96, 0xb4, 0x19,
+	0xe5, 0xae, 0x96, 0xb3, 0xeb, 0x8a, 0xf7, 0xce, 0xd9, 0x2c, 0x0f, 0xa3, 0x35, 0xbc, 0x44, 0x4f,
+	0xbd, 0x05, 0x65, 0x9e, 0x58, 0x8b, 0x1a, 0x72, 0x6e, 0xac, 0x86, 0x3c, 0x80, 0xd9, 0x0d, 0x07,
+	0xf5, 0x12, 0x72, 0x42, 0x61, 0x9f, 0x4e, 0x9f, 0xa9, 0x13, 0x28, 0xbe, 0x36, 0x44, 0xd9, 0xbd,
+	0xaa, 0xb1, 0x36, 0x06, 0x07, 0xd2, 0x1b, 0x16, 0x78, 0x70, 0x20, 0xba, 0xea, 0x7b, 0x30, 0xbd,
+	0x69, 0x05, 0x23, 0x73, 0x25, 0xc8, 0x73, 0x69, 0xf2, 0xdf, 0xc2, 0x74, 0x2c, 0x9d, 0x24, 0x3f,
+	0x21, 0xd5, 0x3f, 0x9b, 0x40, 0xff, 0x9c, 0x83, 0x96, 0x90, 0x48, 0xf2, 0x3f, 0x5b, 0x4c, 0xf5,
+	0x01, 0x34, 0x98, 0x79, 0xd4, 0xa3, 0xe7, 0x87, 0x42, 0x46, 0xe8, 0x54, 0x67, 0x34, 0x71, 0xec,
+	0xb4, 0x6f, 0x05, 0x21, 0x96, 0x66, 0x78, 0x61, 0x55, 0x76, 0x93, 0x72, 0x96, 0x52, 0x72, 0xe2,
+	0xe3, 0xc3, 0xab, 0xef, 0x1e, 0x5b, 0x76, 0x48, 0xa5, 0x3f, 0x8c, 0xfa, 0xea, 0x1f, 0xc0, 0x4c,
+	0x77, 0xd8, 0x43, 0x33, 0xdc, 0xa3, 0xe7, 0x5e, 0x47, 0x62, 0xea, 0x7c, 0x7a, 0x8b, 0x3e, 0x80,
+	0xf6, 0x3a, 0x7b, 0x98, 0x3a, 0xb5, 0x0e, 0xd4, 0x27, 0xd0, 0xea, 0x86, 0xae, 0x77, 0x7a, 0xa5,
+	0xc5, 0x5e, 0xa2, 0x90, 0xf4, 0x12, 0xea, 0xff, 0xe6, 0x61, 0xee, 0xa5, 0x67, 0x1a, 0x21, 0x95,
+	0x21, 0xde, 0x29, 0x19, 0xbe, 0x95, 0x0e, 0xba, 0x4f, 0x51, 0x59, 0x48, 0x4d, 0x9c, 0x2c, 0xe8,
+	0x94, 0x4e, 0x2a, 0xe8, 0x94, 0x4f, 0x53, 0xd0, 0xa9, 0x8c, 0x17, 0x74, 0x7e, 0xae, 0x8a, 0x4d,
+	0xba, 0x30, 0x04, 0xa3, 0x85, 0xa1, 0xa8, 0xa0, 0x53, 0x3f, 0xb1, 0xa0, 0xa3, 0xfe, 0x4b, 0x1e,
+	0x5a, 0x4f, 0x68, 0xb8, 0xe9, 0xee, 0x05, 0xe7, 0x3b, 0x46, 0x42, 0x2d, 0xf9, 0x63, 0xd4, 0x22,
+	0x77, 0x65, 0x97, 0x9d, 0xdc, 0x40, 0x7c, 0x9e, 0xc4, 0xb6, 0x81, 0x1f, 0xe6, 0x20, 0x7e, 0xc7,
+	0x2a, 0x4e, 0x78, 0xc7, 0xc2, 0xe2, 0xa6, 0x11, 0xe0, 0x65, 0xe0, 0xf7, 0x44, 0xf4, 0x10, 0xbe,
+	0xeb, 0xda, 0xb6, 0xfb, 0x9a, 0x29, 0xa5, 0xaa, 0x89, 0x1e, 0x2b, 0x59, 0x1a, 0x96, 0xac, 0x9a,
+	0xb1, 0x36, 0xb9, 0x0b, 0xed, 0x61, 0x40, 0x75, 0xdb, 0x3d, 0xb0, 0x74, 0x7c, 0x36, 0xa6, 0x8e,
+	0x29, 0x1e, 0x62, 0x5b, 0xc3, 0x80, 0x6e, 0xba, 0x07, 0xd6, 0x2a, 0x87, 0x92, 0x25, 0x28, 0x05,
+	0x96, 0xd3, 0xa7, 0x4a, 0xed, 0x24, 0xcf, 0xce, 0xe9, 0xd4, 0x7f, 0xca, 0x03, 0x6c, 0xba, 0x7b,
+	0xcf, 0x69, 0x10, 0xe0, 0x97, 0x35, 0x77, 0x12, 0x16, 0x3c, 0x91, 0xd3, 0x45, 0xb6, 0x7a, 0x0b,
+	0xd3, 0xc4, 0x93, 0xeb, 0xd2, 0xa9, 0x22, 0x77, 0x61, 0x62, 0x91, 0xfb, 0x2d, 0xa8, 0xf2, 0xa8,
+	0xc2, 0xe2, 0xf9, 0x59, 0x6d, 0xb5, 0xfe, 0xe6, 0xa7, 0x9b, 0x15, 0xfe, 0x5a, 0xb8, 0xae, 0x55,
+	0x18, 0x72, 0xc3, 0x3c, 0x76, 0x1f, 0x65, 0x15, 0xba, 0x3c, 0xb1, 0x0a, 0x1d, 0x7d, 0x4d, 0x25,
+	0x5e, 0xb5, 0xb1, 0x4d, 0xee, 0x43, 0x3e, 0x2a, 0x9c, 0x4c, 0x0a, 0xf8, 0xf3, 0x61, 0x80, 0xb7,
+	0x6c, 0xc0, 0xf7, 0x48, 0x84, 0xd9, 0xb2, 0xab, 0x7e, 0x03, 0x33, 0x1a, 0xbf, 0x70, 0x5c, 0xef,
+	0xa7, 0xbb, 0xf5, 0xa3, 0xc7, 0x2b, 0x3f, 0x76, 0xbc, 0xd4, 0x47, 0x30, 0x23, 0x5c, 0x4a, 0x8a,
+	0xf1, 0x69, 0x5e, 0x4f, 0x71, 0x6c, 0xe7, 0x7b, 0xcf, 0x36, 0x2c, 0xe7, 0xec, 0x63, 0x7f, 0x2c,
+	0xc0, 0x6c, 0x7a, 0x70, 0xe0, 0xb9, 0x4e, 0x40, 0x7f, 0xee, 0x77, 0x5b, 0xf9, 0xc0, 0x5a, 0x98,
+	0xf4, 0xc0, 0x1a, 0x3d, 0x47, 0x8b, 0x0a, 0x3f, 0xb6, 0xa3, 0xe8, 0xbd, 0x94, 0x88, 0xde, 0xef,
+	0x03, 0x78, 0x86, 0x8f, 0xa9, 0xd8, 0x2b, 0xf1, 0x41, 0xc3, 0xc8, 0xb6, 0xd7, 0x38, 0xfa, 0x29,
+	0xdf, 0x7c, 0x41, 0x3b, 0x60, 0xf1, 0x24, 0x3f, 0x14, 0x75, 0x0e, 0x7b, 0x2e, 0x2b, 0x29, 0x82,
+	0x84, 0xcd, 0xce, 0x3f, 0xa1, 0x11, 0x33, 0x7c, 0x89, 0x32, 0x7c, 0x14, 0xf1, 0xe0, 0xcb, 0xad,
+	0x1d, 0xbb, 0x5c, 0xc1, 0x88, 0x75, 0x12, 0x7c, 0xd9, 0x0a, 0x20, 0xc9, 0xb7, 0x9b, 0xce, 0x42,
+	0xea, 0x29, 0xff, 0xf2, 0x35, 0xb4, 0xd1, 0xfb, 0x9f, 0xe5, 0x8c, 0x45, 0xb9, 0x52, 0xfe, 0xf8,
+	0x5c, 0x49, 0x35, 0xa1, 0x91, 0xcc, 0x37, 0x12, 0xcf, 0x23, 0xb9, 0xe4, 0xf3, 0x08, 0x9a, 0xee,
+	0xc0, 0xfa, 0x81, 0x8a, 0xd7, 0x2f, 0xfe, 0x74, 0x52, 0x43, 0x08, 0x7f, 0x1f, 0xbb, 0x0e, 0xe0,
+	0x51, 0x5f, 0xe7, 0xd7, 0x9a, 0x5d, 0xf9, 0x82, 0x56, 0xf3, 0xa8, 0xcf, 0x6f, 0xbc, 0xfa, 0xfb,
+	0x1c, 0xb4, 0xd2, 0xc1, 0x3f, 0x79, 0x0e, 0x4d, 0xc7, 0x35, 0xa9, 0x1e, 0x50, 0x9b, 0xf6, 0x43,
+	0xd7, 0x17, 0xc1, 0xe2, 0xdd, 0xec, 0x5c, 0x61, 0x71, 0xcb, 0x35, 0x69, 0x57, 0x90, 0xf2, 0x0f,
+	0xdd, 0x1a, 0x4e, 0x02, 0x44, 0x16, 0x61, 0xc6, 0xf3, 0x2d, 0xd7, 0xb7, 0xc2, 0x23, 0xbd, 0x6f,
+	0x1b, 0x41, 0xc0, 0xed, 0x17, 0x7f, 0x51, 0x9a, 0x96, 0xa8, 0x35, 0xc4, 0xa0, 0x11, 0x5b, 0xf8,
+	0x1c, 0xa6, 0xc7, 0x58, 0x9e, 0xe9, 0x23, 0xb7, 0xbf, 0x02, 0x98, 0x5b, 0x63, 0x95, 0x80, 0xc8,
+	0xb9, 0x9c, 0xcb, 0x0f, 0x9d, 0xb9, 0x36, 0x92, 0xaa, 0xbe, 0x14, 0xce, 0x59, 0x67, 0x2f, 0x9e,
+	0xbb, 0x98, 0x52, 0x9a, 0x58, 0x4c, 0x99, 0x87, 0xf2, 0x90, 0x45, 0x41, 0xd2, 0xad, 0xf1, 0xde,
+	0x78, 0xb1, 0xa2, 0x92, 0x51, 0xac, 0x88, 0xf3, 0xb8, 0x6a, 0x32, 0x8f, 0xcb, 0xac, 0x61, 0xd4,
+	0x2e, 0x5a, 0xc3, 0x80, 0x9f, 0xa7, 0x86, 0x51, 0xbf, 0x40, 0x0d, 0xa3, 0x71, 0xfa, 0x1a, 0x46,
+	0x73, 0xbc, 0x86, 0x71, 0x8d, 0x7d, 0x7b, 0xc8, 0x43, 0x23, 0x56, 0x84, 0xae, 0x6a, 0x31, 0x20,
+	0x59, 0xb5, 0x98, 0x3e, 0x6d, 0xd5, 0x82, 0x9c, 0xa9, 0x6a, 0x31, 0x73, 0xfe, 0xaa, 0xc5, 0xec,
+	0x85, 0xaa, 0x16, 0x73, 0x67, 0xa9, 0x5a, 0x48, 0x5f, 0x31, 0x9f, 0xf0, 0x15, 0x23, 0x95, 0x8c,
+	0xcb, 0xa7, 0xa9, 0x64, 0x28, 0xe7, 0xae, 0x64, 0x5c, 0x99, 0x50, 0xc9, 0x58, 0x18, 0xa9, 0x64,
+	0x8c, 0x54, 0xb7, 0xaf, 0x9e, 0x58, 0xdd, 0x4e, 0xd6, 0x38, 0xae, 0x9d, 0xa3, 0xc6, 0x71, 0x3d,
+	0xab, 0xc6, 0x31, 0x52, 0x9d, 0xb8, 0x71, 0x62, 0x75, 0xe2, 0xe6, 0x58, 0x75, 0xe2, 0xb7, 0x30,
+	0x2f, 0x82, 0x97, 0x8b, 0x59, 0xc7, 0xe3, 0x93, 0xbd, 0x1f, 0x73, 0x30, 0x83, 0x1e, 0xf1, 0xc2,
+	0xfc, 0x65, 0x86, 0x9b, 0x3f, 0x36, 0xc3, 0x2d, 0x1c, 0x9f, 0xe1, 0x16, 0x47, 0x32, 0xdc, 0x3f,
+	0xc9, 0xc1, 0x1c, 0xcf, 0x41, 0x2f, 0x26, 0x57, 0x1b, 0x0a, 0x86, 0x6d, 0x8b, 0x35, 0x63, 0x13,
+	0x3d, 0xd1, 0xae, 0xeb, 0xf7, 0xa9, 0x90, 0x86, 0x77, 0xf0, 0x34, 0x1d, 0x50, 0xea, 0xe9, 0xec,
+	0xc3, 0x50, 0xfe, 0xbe, 0x51, 0x45, 0x80, 0x46, 0x3d, 0x57, 0x5d, 0x87, 0xd9, 0x2e, 0x06, 0xa6,
+	0x17, 0x12, 0x45, 0x5d, 0x83, 0x19, 0x4c, 0x91, 0x2f, 0xc6, 0xe4, 0xcf, 0x73, 0x40, 0xb4, 0xa1,
+	0x73, 0xb1, 0x4d, 0x59, 0x04, 0xf0, 0x7c, 0xf7, 0x90, 0x3a, 0x06, 0xa6, 0x38, 0xd9, 0xf5, 0x8b,
+	0x04, 0x45, 0x22, 0x51, 0x29, 0x64, 0x27, 0x2a, 0xea, 0x2b, 0x68, 0x69, 0x43, 0x07, 0xbf, 0xf7,
+	0x3c, 0x9f, 0x44, 0xf7, 0x21, 0x6f, 0xc8, 0xf8, 0x6a, 0x62, 0x0a, 0x61, 0x84, 0xaa, 0x0e, 0x97,
+	0x35, 0xea, 0xd9, 0xc6, 0xd1, 0x7a, 0x74, 0x4d, 0xce, 0x37, 0x29, 0x7e, 0x8d, 0x8b, 0xb6, 0x4a,
+	0x66, 0x0d, 0xa2, 0xa7, 0xde, 0x83, 0x19, 0x1e, 0x90, 0xf0, 0x5f, 0xae, 0x48, 0xe6, 0x04, 0x8a,
+	0xec, 0xd7, 0x20, 0x39, 0xfe, 0x41, 0x27, 0xb6, 0xd5, 0x4f, 0x61, 0x86, 0x9f, 0xd2, 0x34, 0xe9,
+	0x5b, 0x50, 0xe6, 0xbf, 0x86, 0x19, 0x2d, 0xa5, 0x09, 0x32, 0x81, 0x55, 0xed, 0xa8, 0x16, 0x77,
+	0xae, 0xf1, 0xa9, 0xf5, 0xe6, 0x4f, 0x3c, 0x3b, 0xd7, 0xa0, 0xcc, 0xc7, 0x67, 0xbe, 0x34, 0xfe,
+	0x98, 0x03, 0xe0, 0x68, 0xf6, 0xce, 0x78, 0x5a, 0x11, 0xe4, 0xa7, 0x41, 0xf9, 0xc4, 0xa7, 0x41,
+	0x1b, 0x40, 0xd8, 0xdb, 0x8e, 0xe5, 0x3a, 0x7a, 0xf4, 0x13, 0x2d, 0xa5, 0x70, 0xa2, 0x76, 0xa7,
+	0xe5, 0xa8, 0x08, 0xa4, 0xae, 0x42, 0x3d, 0x16, 0x2a, 0x20, 0x0f, 0xa0, 0xce, 0xe7, 0x4d, 0xd6,
+	0x45, 0x49, 0x5a, 0x34, 0xa4, 0xd4, 0x20, 0x88, 0xda, 0xea, 0x1c, 0xcc, 0xac, 0xf4, 0x43, 0xeb,
+	0xd0, 0x08, 0xe9, 0xca, 0x30, 0xdc, 0x17, 0x9b, 0xac, 0xce, 0xc3, 0x6c, 0x1a, 0xcc, 0xd3, 0x33,
+	0xf5, 0xef, 0x72, 0x30, 0xa7, 0x51, 0xc7, 0xa4, 0xfe, 0x0e, 0x1d, 0x78, 0x76, 0xa2, 0x02, 0x85,
+	0xdf, 0xb3, 0x0b, 0x90, 0xd8, 0xba, 0xa8, 0x4f, 0x3e, 0x81, 0xa2, 0xe1, 0xef, 0xc9, 0xef, 0x97,
+	0xde, 0x8e, 0xe3, 0x98, 0x0c, 0x46, 0x8b, 0x2b, 0xfe, 0x9e, 0xf8, 0x95, 0x09, 0x1b, 0xb4, 0xf0,
+	0x4b, 0xa8, 0x45, 0xa0, 0x33, 0x05, 0xcf, 0x06, 0xcc, 0x8f, 0xce, 0x20, 0x92, 0x4c, 0x02, 0xc5,
+	0x57, 0x98, 0xfd, 0x08, 0x15, 0x63, 0x9b, 0x3c, 0xc0, 0x00, 0x85, 0xf6, 0xa5, 0x90, 0xd7, 0xe3,
+	0xef, 0xb4, 0x33, 0xc2, 0x6f, 0x8d, 0xd3, 0xde, 0xff, 0x5d, 0x8e, 0x7d, 0x88, 0xcd, 0xd3, 0xae,
+	0x39, 0x98, 0x7e, 0xfa, 0x62, 0x55, 0xef, 0xee, 0xac, 0xec, 0x24, 0x0b, 0xe3, 0x53, 0x50, 0x47,
+	0xf0, 0x9a, 0xd6, 0x59, 0xd9, 0xe9, 0xac, 0xb7, 0x73, 0xa4, 0x0d, 0x0d, 0x41, 0xa7, 0xed, 0x6c,
+	0x6c, 0x3d, 0x69, 0xe7, 0x25, 0x89, 0xf6, 0x72, 0x6b, 0x0b, 0x01, 0x05, 0x09, 0x78, 0xbc, 0xb2,
+	0xb1, 0xf9, 0x52, 0xeb, 0xb4, 0x8b, 0x12, 0xd0, 0x7d, 0xb9, 0xb6, 0xd6, 0xe9, 0x76, 0xdb, 0x25,
+	0xd2, 0x02, 0x40, 0xc0, 0xb3, 0x8d, 0xcd, 0xcd, 0xce, 0x7a, 0xbb, 0x4c, 0xa6, 0xa1, 0x89, 0xfd,
+	0xce, 0x13, 0xad, 0xd3, 0xed, 0x22, 0x93, 0x8a, 0x04, 0x3d, 0xde, 0xd8, 0xda, 0xe8, 0x7e, 0x89,
+	0xa0, 0xea, 0xfd, 0x2f, 0xa0, 0x9e, 0xf8, 0x01, 0x01, 0x52, 0xac, 0x69, 0x2f, 0xb6, 0xf4, 0xd5,
+	0x95, 0xb5, 0x67, 0x8f, 0x37, 0x36, 0x37, 0xdb, 0x97, 0x48, 0x13, 0x6a, 0x0c, 0xd4, 0x7d, 0xb6,
+	0xb1, 0xdd, 0xce, 0xe1, 0xbc, 0xac, 0xbb, 0xb9, 0xb2, 0xd3, 0xe9, 0xee, 0xb4, 0xf3, 0xf7, 0xff,
+	0x3f, 0x40, 0x9c, 0x76, 0x92, 0x3a, 0x54, 0xe2, 0x85, 0x02, 0x94, 0x51, 0x60, 0xb6, 0xc6, 0x3a,
+	0x54, 0xa4, 0xac, 0x79, 0xd6, 0x79, 0xb6, 0xb1, 0xbd, 0xdd, 0x59, 0x6f, 0x17, 0x48, 0x03, 0xaa,
+	0xd1, 0xca, 0x8b, 0x38, 0x9d, 0xd6, 0x59, 0x7b, 0xf1, 0x75, 0x47, 0xeb, 0xac, 0xb7, 0x4b, 0xf7,
+	0xbf, 0x85, 0x7a, 0xe2, 0x3b, 0x00, 0xa2, 0xc0, 0xec, 0x37, 0x2f, 0xb4, 0x67, 0x1d, 0x2d, 0x6b,
+	0x53, 0xb7, 0x5f, 0xac, 0x47, 0x3b, 0x96, 0x93, 0x80, 0x78, 0xd2, 0x16, 0x00, 0x02, 0x84, 0x44,
+	0x85, 0xfb, 0xff, 0x96, 0x8b, 0x5f, 0x12, 0x38, 0xf7, 0x05, 0x98, 0x8f, 0xde, 0x1e, 0x46, 0xf9,
+	0xcf, 0xc1, 0x74, 0x12, 0xc7, 0xc5, 0xcd, 0x91, 0x59, 0x68, 0x47, 0x60, 0x39, 0x77, 0x3e, 0xf5,
+	0xba, 0xa1, 0x75, 0x22, 0xf2, 0x42, 0x8a, 0x3c, 0xd6, 0xe5, 0x0c, 0x4c, 0x45, 0xd0, 0xed, 0x95,
+	0x97, 0x5d, 0x5c, 0x79, 0x8a, 0xb4, 0xbb, 0xb3, 0xb2, 0xb5, 0xbe, 0xfa, 0x6d, 0xbb, 0x9c, 0x12,
+	0x63, 0x4d, 0x5b, 0xe1, 0x6a, 0xac, 0x2c, 0xff, 0xed, 0x34, 0x14, 0x56, 0xb6, 0x37, 0xc8, 0x23,
+	0x80, 0xf8, 0x41, 0x80, 0x5c, 0x89, 0x63, 0xfc, 0x91, 0x47, 0x82, 0x85, 0xd1, 0x4f, 0x06, 0xd5,
+	0x4b, 0x64, 0x15, 0x9a, 0xa9, 0xa7, 0x0e, 0x72, 0x6d, 0x7c, 0x78, 0xfc, 0x2a, 0x91, 0xc1, 0xe1,
+	0xfd, 0x1c, 0xbe, 0xf3, 0x8b, 0xd7, 0x02, 0x12, 0x05, 0xad, 0xe9, 0xe7, 0x83, 0xec, 0x71, 0x9f,
+	0x03, 0xc4, 0xef, 0x1e, 0xb1, 0xdc, 0x63, 0x6f, 0x21, 0x0b, 0x24, 0xfd, 0xcc, 0x12, 0x31, 0xf8,
+	0x02, 0x1a, 0xc9, 0x1a, 0x3f, 0xb9, 0x1a, 0x59, 0xb9, 0xf1, 0xca, 0xff, 0x71, 0x22, 0xd4, 0xa2,
+	0x32, 0x3e, 0x51, 0xa2, 0xfc, 0x62, 0xa4, 0xb2, 0xbf, 0x30, 0x3f, 0x66, 0x91, 0x3b, 0xf8, 0x7b,
+	0x25, 0xf5, 0x12, 0xf9, 0x04, 0x2a, 0xa2, 0xa8, 0x1f, 0xaf, 0x3d, 0x5d, 0xe5, 0x9f, 0x30, 0xf8,
+	0x0b, 0x68, 0x24, 0xcb, 0x6e, 0xb1, 0xfc, 0x19, 0xc5, 0xb8, 0x85, 0xe9, 0x54, 0xf6, 0x23, 0xd4,
+	0xf7, 0x6b, 0xa8, 0x45, 0xa5, 0x9a, 0x58, 0xfe, 0xd1, 0xea, 0x4d, 0xe6, 0xd8, 0xf7, 0x73, 0xa4,
+	0xc3, 0xbe, 0x97, 0x8d, 0xea, 0x89, 0xf1, 0xfc, 0x19, 0x55, 0xc6, 0x09, 0xcb, 0x78, 0x06, 0x8d,
+	0x64, 0x11, 0x2f, 0x66, 0x93, 0x51, 0x17, 0x5c, 0xb8, 0x96, 0x8d, 0x14, 0x8e, 0xe5, 0x12, 0xd9,
+	0x80, 0x56, 0xda, 0xd6, 0x92, 0xc9, 0x36, 0x78, 0x82, 0x5c, 0x1b, 0x30, 0x35, 0x92, 0x18, 0x90,
+	0x1b, 0x23, 0x3b, 0x3c, 0xca, 0x2c, 0xf3, 0xfd, 0x50, 0xbd, 0x84, 0x3b, 0x95, 0x4c, 0x00, 0xe2,
+	0x25, 0x66, 0xa4, 0x05, 0xc7, 0x31, 0x79, 0x3f, 0x87, 0x8b, 0x4b, 0x47, 0xec, 0xf1, 0xe2, 0x32,
+	0x23, 0xf9, 0x09, 0x8b, 0x7b, 0x02, 0xcd, 0x54, 0xc0, 0x1d, 0x5f, 0xdc, 0xac, 0x38, 0x7c, 0x02,
+	0xa3, 0x0e, 0x34, 0x92, 0x31, 0x77, 0xe2, 0x12, 0x8d, 0x47, 0xe2, 0x13, 0xd8, 0xac, 0x41, 0x3d,
+	0x11, 0x74, 0x93, 0xe8, 0x77, 0xd4, 0xe3, 0x91, 0xf8, 0xe4, 0xdb, 0x24, 0x62, 0xe4, 0xf8, 0x36,
+	0xa5, 0x83, 0xe6, 0x09, 0x83, 0x9f, 0x43, 0x7b, 0x34, 0xe8, 0x25, 0x37, 0xe3, 0x13, 0x9d, 0x19,
+	0x0e, 0x4f, 0xde, 0x97, 0x64, 0x88, 0x1b, 0xef, 0x4b, 0x46, 0xe0, 0x3b, 0x99, 0x4d, 0x32, 0xfc,
+	0x8d, 0xd9, 0x64, 0x04, 0xc5, 0x13, 0x77, 0x86, 0xd9, 0x4a, 0xc1, 0xe4, 0x18, 0xba, 0x85, 0x99,
+	0xf1, 0x30, 0x2f, 0x60, 0xba, 0x69, 0xa6, 0x62, 0xe8, 0x31, 0x23, 0x9f, 0x96, 0x22, 0x23, 0x58,
+	0x54, 0x2f, 0x91, 0x4f, 0xa5, 0xa9, 0x5c, 0xb1, 0xed, 0x63, 0x05, 0x38, 0x7e, 0x01, 0x1f, 0x43,
+	0x45, 0xbc, 0xa1, 0xc5, 0xaa, 0x4d, 0x3f, 0xaa, 0xc5, 0xf3, 0xc6, 0xaf, 0x44, 0xec, 0xd6, 0x3c,
+	0x83, 0x46, 0x32, 0x0a, 0x8d, 0xb7, 0x30, 0x23, 0x64, 0x5d, 0xb8, 0x96, 0x8d, 0x4c, 0xda, 0x97,
+	0xf4, 0xdb, 0x69, 0x7c, 0x05, 0x33, 0xdf, 0x54, 0x27, 0x2c, 0xe9, 0x4b, 0x76, 0xe4, 0x37, 0xf1,
+	0x47, 0x29, 0x2c, 0xf4, 0x95, 0xe9, 0x61, 0x02, 0x28, 0x99, 0x5c, 0xcd, 0xc4, 0x45, 0x42, 0x3d,
+	0x03, 0x92, 0x40, 0xac, 0xd3, 0x5d, 0x63, 0x68, 0x1f, 0xaf, 0xe5, 0x13, 0x98, 0x7d, 0x05, 0xad,
+	0x74, 0xc0, 0x1b, 0xaf, 0x30, 0x33, 0xd4, 0x5e, 0xb8, 0x71, 0x1c, 0x3a, 0x62, 0xf9, 0x09, 0x54,
+	0xf1, 0xf4, 0xe1, 0xd7, 0x26, 0x44, 0x59, 0xc4, 0x4f, 0x51, 0x0c, 0xcf, 0x5a, 0x94, 0xa0, 0xd8,
+	0xcb, 0x48, 0x0c, 0x42, 0xa5, 0xd1, 0x5b, 0xfd, 0xe5, 0xbf, 0xbe, 0xb9, 0x91, 0xfb, 0xfd, 0x9b,
+	0x1b, 0xb9, 0xff, 0x7e, 0x73, 0x23, 0xf7, 0x9b, 0x7b, 0x7b, 0x56, 0xb8, 0x3f, 0xec, 0x2d, 0xf6,
+	0xdd, 0xc1, 0x92, 0x67, 0xf4, 0xf7, 0x8f, 0x4c, 0xea, 0x27, 0x5b, 0x87, 0xcb, 0x4b, 0x81, 0xdf,
+	0xc7, 0xff, 0xf6, 0xa2, 0x57, 0x66, 0xeb, 0x7e, 0xf0, 0x7f, 0x03, 0x00, 0x5e, 0x62, 0xe1, 0xad,
+	0x08, 0x43, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5658,6 +6221,8 @@ type APIClient interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error)
 	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ExplainDatum explains why a datum was processed or skipped by a job.
+	ExplainDatum(ctx context.Context, in *ExplainDatumRequest, opts ...grpc.CallOption) (*ExplainDatumResponse, error)
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (API_ListPipelineClient, error)
@@ -5666,6 +6231,9 @@ type APIClient interface {
 	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	RunPipeline(ctx context.Context, in *RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ReplayDeadLetter releases quarantined datums from a pipeline's dead-letter
+	// branch and starts a new job to reprocess them.
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	ListSecret(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SecretInfos, error)
@@ -5901,6 +6469,15 @@ func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) ExplainDatum(ctx context.Context, in *ExplainDatumRequest, opts ...grpc.CallOption) (*ExplainDatumResponse, error) {
+	out := new(ExplainDatumResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/ExplainDatum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/pps_v2.API/CreatePipeline", in, out, opts...)
@@ -5996,6 +6573,15 @@ func (c *aPIClient) RunCron(ctx context.Context, in *RunCronRequest, opts ...grp
 	return out, nil
 }
 
+func (c *aPIClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/ReplayDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/pps_v2.API/CreateSecret", in, out, opts...)
@@ -6164,6 +6750,8 @@ type APIServer interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(*ListDatumRequest, API_ListDatumServer) error
 	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
+	// ExplainDatum explains why a datum was processed or skipped by a job.
+	ExplainDatum(context.Context, *ExplainDatumRequest) (*ExplainDatumResponse, error)
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(*ListPipelineRequest, API_ListPipelineServer) error
@@ -6172,6 +6760,9 @@ type APIServer interface {
 	StopPipeline(context.Context, *StopPipelineRequest) (*types.Empty, error)
 	RunPipeline(context.Context, *RunPipelineRequest) (*types.Empty, error)
 	RunCron(context.Context, *RunCronRequest) (*types.Empty, error)
+	// ReplayDeadLetter releases quarantined datums from a pipeline's dead-letter
+	// branch and starts a new job to reprocess them.
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*types.Empty, error)
 	CreateSecret(context.Context, *CreateSecretRequest) (*types.Empty, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*types.Empty, error)
 	ListSecret(context.Context, *types.Empty) (*SecretInfos, error)
@@ -6228,6 +6819,9 @@ func (*UnimplementedAPIServer) ListDatum(req *ListDatumRequest, srv API_ListDatu
 func (*UnimplementedAPIServer) RestartDatum(ctx context.Context, req *RestartDatumRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartDatum not implemented")
 }
+func (*UnimplementedAPIServer) ExplainDatum(ctx context.Context, req *ExplainDatumRequest) (*ExplainDatumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainDatum not implemented")
+}
 func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
 }
@@ -6252,6 +6846,9 @@ func (*UnimplementedAPIServer) RunPipeline(ctx context.Context, req *RunPipeline
 func (*UnimplementedAPIServer) RunCron(ctx context.Context, req *RunCronRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunCron not implemented")
 }
+func (*UnimplementedAPIServer) ReplayDeadLetter(ctx context.Context, req *ReplayDeadLetterRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetter not implemented")
+}
 func (*UnimplementedAPIServer) CreateSecret(ctx context.Context, req *CreateSecretRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
 }
@@ -6488,6 +7085,24 @@ func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ExplainDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainDatumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ExplainDatum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/ExplainDatum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExplainDatum(ctx, req.(*ExplainDatumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePipelineRequest)
 	if err := dec(in); err != nil {
@@ -6635,6 +7250,24 @@ func _API_RunCron_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/ReplayDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSecretRequest)
 	if err := dec(in); err != nil {
@@ -6881,6 +7514,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestartDatum",
 			Handler:    _API_RestartDatum_Handler,
 		},
+		{
+			MethodName: "ExplainDatum",
+			Handler:    _API_ExplainDatum_Handler,
+		},
 		{
 			MethodName: "CreatePipeline",
 			Handler:    _API_CreatePipeline_Handler,
@@ -6909,6 +7546,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunCron",
 			Handler:    _API_RunCron_Handler,
 		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _API_ReplayDeadLetter_Handler,
+		},
 		{
 			MethodName: "CreateSecret",
 			Handler:    _API_CreateSecret_Handler,
@@ -7691,6 +8332,23 @@ func (m *CronInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BackfillLimit != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.BackfillLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CatchUp != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.CatchUp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Timezone) > 0 {
+		i -= len(m.Timezone)
+		copy(dAtA[i:], m.Timezone)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Timezone)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Start != nil {
 		{
 			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
@@ -7744,6 +8402,95 @@ func (m *CronInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ObjectInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Lazy {
+		i--
+		if m.Lazy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Glob) > 0 {
+		i -= len(m.Glob)
+		copy(dAtA[i:], m.Glob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7768,6 +8515,18 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Object != nil {
+		{
+			size, err := m.Object.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Cron != nil {
 		{
 			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
@@ -8195,6 +8954,28 @@ func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ScratchBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.ScratchBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeakMemoryBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PeakMemoryBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CpuTime != nil {
+		{
+			size, err := m.CpuTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.UploadBytes != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
 		i--
@@ -8268,6 +9049,42 @@ func (m *AggregateProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ScratchBytes != nil {
+		{
+			size, err := m.ScratchBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PeakMemoryBytes != nil {
+		{
+			size, err := m.PeakMemoryBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CpuTime != nil {
+		{
+			size, err := m.CpuTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.UploadBytes != nil {
 		{
 			size, err := m.UploadBytes.MarshalToSizedBuffer(dAtA[:i])
@@ -8612,6 +9429,20 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DatumStats != nil {
+		{
+			size, err := m.DatumStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.Details != nil {
 		{
 			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
@@ -8772,6 +9603,18 @@ func (m *JobInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DeadLetter {
+		i--
+		if m.DeadLetter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if len(m.PodPatch) > 0 {
 		i -= len(m.PodPatch)
 		copy(dAtA[i:], m.PodPatch)
@@ -9186,6 +10029,18 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DeadLetter {
+		i--
+		if m.DeadLetter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
 	if m.Autoscaling {
 		i--
 		if m.Autoscaling {
@@ -10364,7 +11219,7 @@ func (m *InspectDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *ExplainDatumRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10374,12 +11229,12 @@ func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExplainDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExplainDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10388,21 +11243,9 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Job != nil {
+	if m.Datum != nil {
 		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10415,7 +11258,7 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
+func (m *ExplainDatumResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10425,12 +11268,12 @@ func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DatumSetSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExplainDatumResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExplainDatumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10439,30 +11282,201 @@ func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PerWorker != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.PerWorker))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x5a
 	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	if len(m.ParentSalt) > 0 {
+		i -= len(m.ParentSalt)
+		copy(dAtA[i:], m.ParentSalt)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ParentSalt)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x52
 	}
-	if m.Number != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+	if m.ParentState != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.ParentState))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x48
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ParentMatch {
+		i--
+		if m.ParentMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ParentJob != nil {
+		{
+			size, err := m.ParentJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Datum != nil {
+		{
+			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumSetSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PerWorker != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PerWorker))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -10534,6 +11548,18 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DeadLetter {
+		i--
+		if m.DeadLetter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
 	if m.Autoscaling {
 		i--
 		if m.Autoscaling {
@@ -11197,6 +12223,66 @@ func (m *RunCronRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.At != nil {
+		{
+			size, err := m.At.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayDeadLetterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayDeadLetterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayDeadLetterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Datums) > 0 {
+		for iNdEx := len(m.Datums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Datums[iNdEx])
+			copy(dAtA[i:], m.Datums[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Datums[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Pipeline != nil {
 		{
 			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
@@ -11309,6 +12395,18 @@ func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Secret != nil {
 		{
 			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
@@ -11971,28 +13069,80 @@ func (m *CronInput) Size() (n int) {
 		l = m.Start.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.CatchUp != 0 {
+		n += 1 + sovPps(uint64(m.CatchUp))
+	}
+	if m.BackfillLimit != 0 {
+		n += 1 + sovPps(uint64(m.BackfillLimit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Input) Size() (n int) {
+func (m *ObjectInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pfs != nil {
-		l = m.Pfs.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Join) > 0 {
-		for _, e := range m.Join {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	if m.Delete {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pfs != nil {
+		l = m.Pfs.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Join) > 0 {
+		for _, e := range m.Join {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	if len(m.Group) > 0 {
 		for _, e := range m.Group {
 			l = e.Size()
@@ -12015,6 +13165,10 @@ func (m *Input) Size() (n int) {
 		l = m.Cron.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12187,6 +13341,16 @@ func (m *ProcessStats) Size() (n int) {
 	if m.UploadBytes != 0 {
 		n += 1 + sovPps(uint64(m.UploadBytes))
 	}
+	if m.CpuTime != nil {
+		l = m.CpuTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PeakMemoryBytes != 0 {
+		n += 1 + sovPps(uint64(m.PeakMemoryBytes))
+	}
+	if m.ScratchBytes != 0 {
+		n += 1 + sovPps(uint64(m.ScratchBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12219,6 +13383,18 @@ func (m *AggregateProcessStats) Size() (n int) {
 		l = m.UploadBytes.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.CpuTime != nil {
+		l = m.CpuTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PeakMemoryBytes != nil {
+		l = m.PeakMemoryBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ScratchBytes != nil {
+		l = m.ScratchBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12401,6 +13577,10 @@ func (m *JobInfo) Size() (n int) {
 		l = m.Details.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DatumStats != nil {
+		l = m.DatumStats.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12486,6 +13666,9 @@ func (m *JobInfo_Details) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DeadLetter {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12701,6 +13884,9 @@ func (m *PipelineInfo_Details) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
+	if m.DeadLetter {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13064,6 +14250,77 @@ func (m *InspectDatumRequest) Size() (n int) {
 	return n
 }
 
+func (m *ExplainDatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExplainDatumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParentJob != nil {
+		l = m.ParentJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParentMatch {
+		n += 2
+	}
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParentState != 0 {
+		n += 1 + sovPps(uint64(m.ParentState))
+	}
+	l = len(m.ParentSalt)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ListDatumRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13242,6 +14499,9 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
+	if m.DeadLetter {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13386,6 +14646,32 @@ func (m *RunCronRequest) Size() (n int) {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.At != nil {
+		l = m.At.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayDeadLetterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Datums) > 0 {
+		for _, s := range m.Datums {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13434,6 +14720,10 @@ func (m *InspectSecretRequest) Size() (n int) {
 		l = m.Secret.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -15885,6 +17175,76 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUp", wireType)
+			}
+			m.CatchUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUp |= CronCatchUp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackfillLimit", wireType)
+			}
+			m.BackfillLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackfillLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15907,7 +17267,7 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *ObjectInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15930,17 +17290,17 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15950,33 +17310,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pfs == nil {
-				m.Pfs = &PFSInput{}
-			}
-			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15986,31 +17342,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Join = append(m.Join, &Input{})
-			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16020,31 +17374,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = append(m.Group, &Input{})
-			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16054,31 +17406,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16088,31 +17438,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16122,28 +17470,64 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Lazy = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16166,7 +17550,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInput) Unmarshal(dAtA []byte) error {
+func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16189,17 +17573,17 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16209,27 +17593,31 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Pfs == nil {
+				m.Pfs = &PFSInput{}
+			}
+			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16256,18 +17644,16 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16277,29 +17663,31 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.Group = append(m.Group, &Input{})
+			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16309,68 +17697,31 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
 			}
-			m.Constant = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16380,67 +17731,31 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16450,29 +17765,33 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16482,24 +17801,26 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.Object == nil {
+				m.Object = &ObjectInput{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -16524,7 +17845,7 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Datum) Unmarshal(dAtA []byte) error {
+func (m *JobInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16547,15 +17868,47 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16582,16 +17935,16 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16619,8 +17972,28 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16643,7 +18016,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16666,17 +18039,17 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
 			}
-			var msglen int
+			m.Constant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16686,52 +18059,67 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16741,33 +18129,29 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16777,66 +18161,30 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -16855,7 +18203,7 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
+func (m *Datum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16878,17 +18226,17 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16898,55 +18246,60 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16969,7 +18322,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16992,15 +18345,15 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17027,16 +18380,35 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &types.Duration{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17063,16 +18435,16 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &types.Duration{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17099,18 +18471,18 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &types.Duration{}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.DownloadBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17120,30 +18492,26 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownloadBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.UploadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadBytes |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17166,7 +18534,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17189,17 +18557,17 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17209,31 +18577,109 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17260,16 +18706,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &types.Duration{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17296,16 +18742,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &types.Duration{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17332,17 +18778,55 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
+			if m.UploadTime == nil {
+				m.UploadTime = &types.Duration{}
 			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuTime", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -17368,13 +18852,51 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
+			if m.CpuTime == nil {
+				m.CpuTime = &types.Duration{}
 			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CpuTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakMemoryBytes", wireType)
+			}
+			m.PeakMemoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeakMemoryBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScratchBytes", wireType)
+			}
+			m.ScratchBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScratchBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17397,7 +18919,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17420,17 +18942,17 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17440,29 +18962,33 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17472,27 +18998,31 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17519,67 +19049,88 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumStatus == nil {
-				m.DatumStatus = &DatumStatus{}
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
 			}
-			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
 			}
-			if iNdEx >= l {
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17606,16 +19157,16 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.CpuTime == nil {
+				m.CpuTime = &Aggregate{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CpuTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakMemoryBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17642,8 +19193,46 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PeakMemoryBytes == nil {
+				m.PeakMemoryBytes = &Aggregate{}
+			}
+			if err := m.PeakMemoryBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScratchBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScratchBytes == nil {
+				m.ScratchBytes = &Aggregate{}
+			}
+			if err := m.ScratchBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17669,7 +19258,7 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17692,26 +19281,15 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17739,13 +19317,13 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17755,33 +19333,29 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpu == nil {
-				m.Gpu = &GPUSpec{}
-			}
-			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17791,23 +19365,27 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Disk = string(dAtA[iNdEx:postIndex])
+			if m.DatumStatus == nil {
+				m.DatumStatus = &DatumStatus{}
+			}
+			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17831,7 +19409,7 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17854,17 +19432,17 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17874,29 +19452,33 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Number = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17906,11 +19488,26 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17933,7 +19530,7 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17956,17 +19553,28 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17976,12 +19584,265 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gpu == nil {
+				m.Gpu = &GPUSpec{}
+			}
+			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
 			postIndex := iNdEx + msglen
@@ -18519,8 +20380,44 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumStats == nil {
+				m.DatumStats = &AggregateProcessStats{}
+			}
+			if err := m.DatumStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -19187,6 +21084,26 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			}
 			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLetter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20741,6 +22658,26 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Autoscaling = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLetter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22814,21 +24751,455 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExplainDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExplainDatumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainDatumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ParentMatch = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentState", wireType)
+			}
+			m.ParentState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentState |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentSalt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22838,27 +25209,55 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			m.ParentSalt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24255,6 +26654,26 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Autoscaling = bool(v != 0)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLetter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25081,6 +27500,161 @@ func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.At == nil {
+				m.At = &types.Timestamp{}
+			}
+			if err := m.At.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayDeadLetterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayDeadLetterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayDeadLetterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datums = append(m.Datums, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25340,6 +27914,42 @@ func (m *InspectSecretRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
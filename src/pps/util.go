@@ -135,6 +135,15 @@ func InputBranches(input *Input) []*pfs.Branch {
 				Name: "master",
 			})
 		}
+		if input.Object != nil {
+			result = append(result, &pfs.Branch{
+				Repo: &pfs.Repo{
+					Name: input.Object.Repo,
+					Type: pfs.UserRepoType,
+				},
+				Name: "master",
+			})
+		}
 		return nil
 	})
 	return result
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
@@ -15,8 +16,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/config"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	txncmds "github.com/pachyderm/pachyderm/v2/src/server/transaction/cmds"
 	"github.com/pkg/browser"
 
 	"github.com/spf13/cobra"
@@ -403,6 +406,9 @@ func WhoamiCmd() *cobra.Command {
 			if resp.Expiration != nil {
 				fmt.Printf("session expires: %v\n", resp.Expiration.Format(time.RFC822))
 			}
+			if resp.Scope != nil {
+				fmt.Printf("token scope: %s\n", formatTokenScope(resp.Scope))
+			}
 			return nil
 		}),
 	}
@@ -410,12 +416,73 @@ func WhoamiCmd() *cobra.Command {
 	return cmdutil.CreateAlias(whoami, "auth whoami")
 }
 
+// tokenScopeFlags holds the flags that restrict the scope of a new token
+type tokenScopeFlags struct {
+	permissions, roles, resources []string
+}
+
+func (f *tokenScopeFlags) addTo(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringSliceVar(&f.permissions, "permission", nil, "Restrict the token to these permissions.")
+	cmd.PersistentFlags().StringSliceVar(&f.roles, "role", nil, "Restrict the token to the permissions of these roles.")
+	cmd.PersistentFlags().StringSliceVar(&f.resources, "resource", nil, "Restrict the token to these resources, e.g. 'repo:images'.")
+}
+
+// scope returns the scope given by the flags, or nil if none were set
+func (f *tokenScopeFlags) scope() (*auth.TokenScope, error) {
+	if len(f.permissions) == 0 && len(f.roles) == 0 && len(f.resources) == 0 {
+		return nil, nil
+	}
+	scope := &auth.TokenScope{Roles: f.roles}
+	for _, p := range f.permissions {
+		permission, ok := auth.Permission_value[strings.ToUpper(p)]
+		if !ok {
+			return nil, errors.Errorf("unknown permission %q", p)
+		}
+		scope.Permissions = append(scope.Permissions, auth.Permission(permission))
+	}
+	for _, r := range f.resources {
+		resource, err := parseResource(r)
+		if err != nil {
+			return nil, err
+		}
+		scope.Resources = append(scope.Resources, resource)
+	}
+	return scope, nil
+}
+
+func formatTokenScope(scope *auth.TokenScope) string {
+	if scope == nil {
+		return "unrestricted"
+	}
+	var parts []string
+	for _, p := range scope.Permissions {
+		parts = append(parts, p.String())
+	}
+	parts = append(parts, scope.Roles...)
+	if len(parts) == 0 {
+		parts = append(parts, "all permissions")
+	}
+	var resources []string
+	for _, r := range scope.Resources {
+		resource := strings.ToLower(r.Type.String())
+		if r.Name != "" {
+			resource += ":" + r.Name
+		}
+		resources = append(resources, resource)
+	}
+	if len(resources) == 0 {
+		resources = append(resources, "all resources")
+	}
+	return strings.Join(parts, ",") + " on " + strings.Join(resources, ",")
+}
+
 // GetRobotTokenCmd returns a cobra command that lets a user get a pachyderm
 // token on behalf of themselves or another user
 func GetRobotTokenCmd() *cobra.Command {
 	var enterprise bool
 	var quiet bool
 	var ttl string
+	var scopeFlags tokenScopeFlags
 	getAuthToken := &cobra.Command{
 		Use:   "{{alias}} [username]",
 		Short: "Get an auth token for a robot user with the specified name.",
@@ -430,6 +497,9 @@ func GetRobotTokenCmd() *cobra.Command {
 			req := &auth.GetRobotTokenRequest{
 				Robot: args[0],
 			}
+			if req.Scope, err = scopeFlags.scope(); err != nil {
+				return err
+			}
 			if ttl != "" {
 				d, err := time.ParseDuration(ttl)
 				if err != nil {
@@ -456,9 +526,119 @@ func GetRobotTokenCmd() *cobra.Command {
 		"resulting auth token will have the given lifetime. If not set, the token does not expire."+
 		" This flag should be a golang duration (e.g. \"30s\" or \"1h2m3s\").")
 	getAuthToken.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Get a robot token for the enterprise context")
+	scopeFlags.addTo(getAuthToken)
 	return cmdutil.CreateAlias(getAuthToken, "auth get-robot-token")
 }
 
+// GetScopedTokenCmd returns a cobra command that creates a token for the
+// current user that is restricted to some permissions and resources
+func GetScopedTokenCmd() *cobra.Command {
+	var quiet bool
+	var ttl string
+	var scopeFlags tokenScopeFlags
+	getScopedToken := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Get an auth token for the current user that is restricted to some permissions and resources.",
+		Long: "Get an auth token for the current user that is restricted to some permissions and resources. " +
+			"The token only permits operations that the current user is also allowed to perform, and it can't outlive the current user's token.",
+		Example: `
+# get a token that can only read the repo 'images' for the next 24 hours
+$ {{alias}} --role repoReader --resource repo:images --ttl 24h`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			scope, err := scopeFlags.scope()
+			if err != nil {
+				return err
+			}
+			if scope == nil {
+				return errors.New("at least one of --permission, --role or --resource must be set")
+			}
+			req := &auth.GetScopedTokenRequest{Scope: scope}
+			if ttl != "" {
+				d, err := time.ParseDuration(ttl)
+				if err != nil {
+					return errors.Wrapf(err, "could not parse duration %q", ttl)
+				}
+				req.TTL = int64(d.Seconds())
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetScopedToken(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if quiet {
+				fmt.Println(resp.Token)
+			} else {
+				fmt.Printf("Token: %s\n", resp.Token)
+			}
+			return nil
+		}),
+	}
+	getScopedToken.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "if "+
+		"set, only print the resulting token (if successful). This is useful for "+
+		"scripting, as the output can be piped to use-auth-token")
+	getScopedToken.PersistentFlags().StringVar(&ttl, "ttl", "", "if set, the "+
+		"resulting auth token will have the given lifetime. If not set, the token expires with the current user's token."+
+		" This flag should be a golang duration (e.g. \"30s\" or \"1h2m3s\").")
+	scopeFlags.addTo(getScopedToken)
+	return cmdutil.CreateAlias(getScopedToken, "auth get-scoped-token")
+}
+
+// ListTokenCmd returns a cobra command that lists the tokens issued to a user
+func ListTokenCmd() *cobra.Command {
+	var subject string
+	listToken := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "List the auth tokens issued to a user, and their scopes.",
+		Long: "List the auth tokens issued to a user, and their scopes. Tokens are identified by their hash, " +
+			"which can be passed to 'pachctl auth revoke token'. By default the current user's tokens are listed.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListAuthTokens(c.Ctx(), &auth.ListAuthTokensRequest{Subject: subject})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, "HASH\tSUBJECT\tEXPIRATION\tSCOPE\n")
+			for _, t := range resp.Tokens {
+				expiration := "never"
+				if t.Expiration != nil {
+					expiration = t.Expiration.Local().Format(time.RFC3339)
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", t.HashedToken, t.Subject, expiration, formatTokenScope(t.Scope))
+			}
+			return writer.Flush()
+		}),
+	}
+	listToken.Flags().StringVar(&subject, "subject", "", "List the tokens of this subject, e.g. 'robot:ci'. Listing another user's tokens requires the clusterAdmin role.")
+	return cmdutil.CreateAlias(listToken, "auth list token")
+}
+
+// RevokeTokenCmd returns a cobra command that revokes a single token
+func RevokeTokenCmd() *cobra.Command {
+	revokeToken := &cobra.Command{
+		Use:   "{{alias}} <hash>",
+		Short: "Revoke an auth token, identified by the hash shown by 'pachctl auth list token'.",
+		Long:  "Revoke an auth token, identified by the hash shown by 'pachctl auth list token'.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.RevokeAuthToken(c.Ctx(), &auth.RevokeAuthTokenRequest{HashedToken: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(revokeToken, "auth revoke token")
+}
+
 func GetGroupsCmd() *cobra.Command {
 	var enterprise bool
 	getGroups := &cobra.Command{
@@ -569,8 +749,9 @@ func SetRepoRoleBindingCmd() *cobra.Command {
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer c.Close()
-			err = c.ModifyRepoRoleBinding(repo, subject, roles)
-			return grpcutil.ScrubGRPC(err)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return grpcutil.ScrubGRPC(c.ModifyRepoRoleBinding(repo, subject, roles))
+			})
 		}),
 	}
 	return cmdutil.CreateAlias(setScope, "auth set repo")
@@ -594,12 +775,231 @@ func GetRepoRoleBindingCmd() *cobra.Command {
 				return grpcutil.ScrubGRPC(err)
 			}
 			printRoleBinding(resp)
+			for pattern := range resp.Branches {
+				fmt.Printf("branch %v: see 'pachctl auth get branch %v'\n", pattern, auth.BranchResourceName(repo, pattern))
+			}
+			for prefix := range resp.Paths {
+				fmt.Printf("path %v: see 'pachctl auth get path %v'\n", prefix, auth.PathResourceName(repo, prefix))
+			}
+			if resp.Pipeline != nil {
+				fmt.Printf("pipeline: see 'pachctl auth get pipeline %v'\n", repo)
+			}
 			return nil
 		}),
 	}
 	return cmdutil.CreateAlias(get, "auth get repo")
 }
 
+// SetBranchRoleBindingCmd returns a cobra command that sets the roles for a user on the branches of a repo
+func SetBranchRoleBindingCmd() *cobra.Command {
+	setScope := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> [role1,role2 | none ] <subject>",
+		Short: "Set the roles that 'subject' has on the branches of 'repo' matching 'branch'",
+		Long: "Set the roles that 'subject' has on the branches of 'repo' matching 'branch', which may be " +
+			"a branch name or a glob pattern such as 'dev/*'. These roles are granted in addition to the " +
+			"subject's roles on the repo.",
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			var roles []string
+			if args[1] == "none" {
+				roles = []string{}
+			} else {
+				roles = strings.Split(args[1], ",")
+			}
+
+			repo, pattern, err := auth.ParseBranchResourceName(args[0])
+			if err != nil {
+				return err
+			}
+			subject := args[2]
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return grpcutil.ScrubGRPC(c.ModifyBranchRoleBinding(repo, pattern, subject, roles))
+			})
+		}),
+	}
+	return cmdutil.CreateAlias(setScope, "auth set branch")
+}
+
+// GetBranchRoleBindingCmd returns a cobra command that gets the role bindings for the branches of a repo
+func GetBranchRoleBindingCmd() *cobra.Command {
+	get := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Get the role bindings for the branches of 'repo' matching 'branch'",
+		Long:  "Get the role bindings for the branches of 'repo' matching 'branch'",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			repo, pattern, err := auth.ParseBranchResourceName(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetBranchRoleBinding(repo, pattern)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printRoleBinding(resp)
+			return nil
+		}),
+	}
+	return cmdutil.CreateAlias(get, "auth get branch")
+}
+
+// SetPathRoleBindingCmd returns a cobra command that sets the roles for a user on the files under a path in a repo
+func SetPathRoleBindingCmd() *cobra.Command {
+	setScope := &cobra.Command{
+		Use:   "{{alias}} <repo>:<path> [role1,role2 | none ] <subject>",
+		Short: "Set the roles that 'subject' has on the files under 'path' in 'repo'",
+		Long: "Set the roles that 'subject' has on the files under 'path' in 'repo'. Once a path has a " +
+			"role binding, the files under it are restricted: only cluster roles and the roles bound on " +
+			"the path grant access to them.",
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			var roles []string
+			if args[1] == "none" {
+				roles = []string{}
+			} else {
+				roles = strings.Split(args[1], ",")
+			}
+
+			repo, prefix, err := auth.ParsePathResourceName(args[0])
+			if err != nil {
+				return err
+			}
+			subject := args[2]
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return grpcutil.ScrubGRPC(c.ModifyPathRoleBinding(repo, prefix, subject, roles))
+			})
+		}),
+	}
+	return cmdutil.CreateAlias(setScope, "auth set path")
+}
+
+// GetPathRoleBindingCmd returns a cobra command that gets the role bindings for the files under a path in a repo
+func GetPathRoleBindingCmd() *cobra.Command {
+	get := &cobra.Command{
+		Use:   "{{alias}} <repo>:<path>",
+		Short: "Get the role bindings for the files under 'path' in 'repo'",
+		Long:  "Get the role bindings for the files under 'path' in 'repo'",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			repo, prefix, err := auth.ParsePathResourceName(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetPathRoleBinding(repo, prefix)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printRoleBinding(resp)
+			return nil
+		}),
+	}
+	return cmdutil.CreateAlias(get, "auth get path")
+}
+
+// CheckPipelineCmd returns a cobra command that checks the permissions a user has on a pipeline
+func CheckPipelineCmd() *cobra.Command {
+	check := &cobra.Command{
+		Use:   "{{alias}} <pipeline> [<user>]",
+		Short: "Check the permissions a user has on 'pipeline'",
+		Long:  "Check the permissions a user has on 'pipeline'",
+		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
+			resource := &auth.Resource{Type: auth.ResourceType_PIPELINE, Name: args[0]}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			var perms *auth.GetPermissionsResponse
+			if len(args) == 2 {
+				perms, err = c.GetPermissionsForPrincipal(c.Ctx(), &auth.GetPermissionsForPrincipalRequest{
+					Resource:  resource,
+					Principal: args[1],
+				})
+			} else {
+				perms, err = c.GetPermissions(c.Ctx(), &auth.GetPermissionsRequest{
+					Resource: resource,
+				})
+			}
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Printf("Roles: %v\nPermissions: %v\n", perms.Roles, perms.Permissions)
+			return nil
+		}),
+	}
+	return cmdutil.CreateAlias(check, "auth check pipeline")
+}
+
+// SetPipelineRoleBindingCmd returns a cobra command that sets the roles for a user on a pipeline
+func SetPipelineRoleBindingCmd() *cobra.Command {
+	setScope := &cobra.Command{
+		Use:   "{{alias}} <pipeline> [role1,role2 | none ] <subject>",
+		Short: "Set the roles that 'subject' has on 'pipeline'",
+		Long: "Set the roles that 'subject' has on 'pipeline', such as pipelineOperator. These roles are " +
+			"granted in addition to the subject's roles on the pipeline's output repo, and don't give access " +
+			"to the pipeline's data.",
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			var roles []string
+			if args[1] == "none" {
+				roles = []string{}
+			} else {
+				roles = strings.Split(args[1], ",")
+			}
+
+			subject, pipeline := args[2], args[0]
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return grpcutil.ScrubGRPC(c.ModifyPipelineRoleBinding(pipeline, subject, roles))
+			})
+		}),
+	}
+	return cmdutil.CreateAlias(setScope, "auth set pipeline")
+}
+
+// GetPipelineRoleBindingCmd returns a cobra command that gets the role bindings for a pipeline
+func GetPipelineRoleBindingCmd() *cobra.Command {
+	get := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Get the role bindings for 'pipeline'",
+		Long:  "Get the role bindings for 'pipeline'",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetPipelineRoleBinding(args[0])
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printRoleBinding(resp)
+			return nil
+		}),
+	}
+	return cmdutil.CreateAlias(get, "auth get pipeline")
+}
+
 // SetClusterRoleBindingCmd returns a cobra command that sets the roles for a user on a resource
 func SetClusterRoleBindingCmd() *cobra.Command {
 	setScope := &cobra.Command{
@@ -771,6 +1171,242 @@ func RolesForPermissionCmd() *cobra.Command {
 	return cmdutil.CreateAlias(rotateRootToken, "auth roles-for-permission")
 }
 
+// parseRole builds a role from the names of its permissions and resource
+// types, as given on the command line.
+func parseRole(name string, permissions, resourceTypes []string) (*auth.Role, error) {
+	role := &auth.Role{Name: name}
+	for _, p := range permissions {
+		permission, ok := auth.Permission_value[strings.ToUpper(p)]
+		if !ok {
+			return nil, errors.Errorf("unknown permission %q", p)
+		}
+		role.Permissions = append(role.Permissions, auth.Permission(permission))
+	}
+	for _, rt := range resourceTypes {
+		resourceType, ok := auth.ResourceType_value[strings.ToUpper(rt)]
+		if !ok {
+			return nil, errors.Errorf("unknown resource type %q", rt)
+		}
+		role.ResourceTypes = append(role.ResourceTypes, auth.ResourceType(resourceType))
+	}
+	return role, nil
+}
+
+// CreateRoleCmd returns a cobra command that creates a custom role
+func CreateRoleCmd() *cobra.Command {
+	var permissions, resourceTypes []string
+	create := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Create a custom role",
+		Long:  "Create a custom role that grants the given permissions. The role can then be bound to subjects like a built-in role.",
+		Example: `
+# Create a role that can read a repo and create branches in it, but not write files
+$ {{alias}} branchCreator --permission REPO_READ,REPO_LIST_BRANCH,REPO_CREATE_BRANCH`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			role, err := parseRole(args[0], permissions, resourceTypes)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.CreateRole(c.Ctx(), &auth.CreateRoleRequest{Role: role})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	create.Flags().StringSliceVarP(&permissions, "permission", "p", nil, "The permissions granted by the role.")
+	create.Flags().StringSliceVar(&resourceTypes, "resource-type", nil, "The types of resources (CLUSTER, REPO) that the role can be bound on. Defaults to every type that all of the role's permissions can be granted on.")
+	return cmdutil.CreateAlias(create, "auth create role")
+}
+
+// UpdateRoleCmd returns a cobra command that replaces the permissions of a custom role
+func UpdateRoleCmd() *cobra.Command {
+	var permissions, resourceTypes []string
+	update := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Update a custom role",
+		Long:  "Replace the permissions and resource types of a custom role.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			role, err := parseRole(args[0], permissions, resourceTypes)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.UpdateRole(c.Ctx(), &auth.UpdateRoleRequest{Role: role})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	update.Flags().StringSliceVarP(&permissions, "permission", "p", nil, "The permissions granted by the role.")
+	update.Flags().StringSliceVar(&resourceTypes, "resource-type", nil, "The types of resources (CLUSTER, REPO) that the role can be bound on. Defaults to every type that all of the role's permissions can be granted on.")
+	return cmdutil.CreateAlias(update, "auth update role")
+}
+
+// DeleteRoleCmd returns a cobra command that deletes a custom role
+func DeleteRoleCmd() *cobra.Command {
+	deleteRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Delete a custom role",
+		Long:  "Delete a custom role. A role cannot be deleted while it is still bound to a subject.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.DeleteRole(c.Ctx(), &auth.DeleteRoleRequest{Name: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteRole, "auth delete role")
+}
+
+// ListRoleCmd returns a cobra command that lists the built-in and custom roles
+func ListRoleCmd() *cobra.Command {
+	list := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "List the built-in and custom roles",
+		Long:  "List the built-in and custom roles",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListRole(c.Ctx(), &auth.ListRoleRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			for _, r := range resp.Roles {
+				kind := "built-in"
+				if r.Custom {
+					kind = "custom"
+				}
+				permissions := make([]string, len(r.Permissions))
+				for i, p := range r.Permissions {
+					permissions[i] = p.String()
+				}
+				fmt.Printf("%s (%s): %s\n", r.Name, kind, strings.Join(permissions, ", "))
+			}
+			return nil
+		}),
+	}
+	return cmdutil.CreateAlias(list, "auth list role")
+}
+
+// parseAuditTime parses a --since or --until flag, which is either a duration
+// before now or an RFC 3339 timestamp.
+func parseAuditTime(value string) (*types.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		d, durationErr := time.ParseDuration(value)
+		if durationErr != nil {
+			return nil, errors.Errorf("could not parse %q as a duration or an RFC 3339 timestamp", value)
+		}
+		t = time.Now().Add(-d)
+	}
+	ts, err := types.TimestampProto(t)
+	return ts, errors.EnsureStack(err)
+}
+
+// parseResource parses a --resource flag of the form type[:name], such as
+// 'repo:images' or 'cluster'.
+func parseResource(value string) (*auth.Resource, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parts := strings.SplitN(value, ":", 2)
+	t, ok := auth.ResourceType_value[strings.ToUpper(parts[0])]
+	if !ok {
+		return nil, errors.Errorf("unknown resource type %q", parts[0])
+	}
+	resource := &auth.Resource{Type: auth.ResourceType(t)}
+	if len(parts) == 2 {
+		resource.Name = parts[1]
+	}
+	return resource, nil
+}
+
+// AuditCmd returns a cobra command that lists the events in the audit log
+func AuditCmd() *cobra.Command {
+	var since, until, principal, resource string
+	var denied bool
+	var limit int64
+	audit := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "List the API calls recorded in the audit log",
+		Long: "List the API calls recorded in the audit log, most recent first. " +
+			"--since and --until accept either a duration before now, such as '24h', or an RFC 3339 timestamp.",
+		Example: `
+# list the calls made in the last hour
+$ {{alias}} --since 1h
+
+# list the calls to the 'images' repo that were denied
+$ {{alias}} --resource repo:images --denied`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			req := &auth.ListAuditEventsRequest{
+				Principal:  principal,
+				DeniedOnly: denied,
+				Limit:      limit,
+			}
+			var err error
+			if req.Since, err = parseAuditTime(since); err != nil {
+				return err
+			}
+			if req.Until, err = parseAuditTime(until); err != nil {
+				return err
+			}
+			if req.Resource, err = parseResource(resource); err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListAuditEvents(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, "TIME\tPRINCIPAL\tMETHOD\tRESOURCE\tDECISION\tERROR\n")
+			for _, e := range resp.Events {
+				t, err := types.TimestampFromProto(e.Time)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				var r string
+				if e.Resource != nil {
+					r = strings.ToLower(e.Resource.Type.String())
+					if e.Resource.Name != "" {
+						r += ":" + e.Resource.Name
+					}
+				}
+				decision := "allowed"
+				if !e.Allowed {
+					decision = "denied"
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", t.Local().Format(time.RFC3339), e.Principal, e.Method, r, decision, e.Error)
+			}
+			return writer.Flush()
+		}),
+	}
+	audit.Flags().StringVar(&since, "since", "", "Only list calls made after this time.")
+	audit.Flags().StringVar(&until, "until", "", "Only list calls made before this time.")
+	audit.Flags().StringVar(&principal, "principal", "", "Only list calls made by this principal, e.g. 'user:alice@example.com'.")
+	audit.Flags().StringVar(&resource, "resource", "", "Only list calls on this resource, e.g. 'repo:images'.")
+	audit.Flags().BoolVar(&denied, "denied", false, "Only list calls that were denied.")
+	audit.Flags().Int64Var(&limit, "limit", 0, "The maximum number of calls to list (default 1000).")
+	return cmdutil.CreateAlias(audit, "auth audit")
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds() []*cobra.Command {
@@ -796,28 +1432,76 @@ func Cmds() []*cobra.Command {
 		Long:  "Check whether a subject has a permission on a resource",
 	}
 
+	create := &cobra.Command{
+		Short: "Create a custom role",
+		Long:  "Create a custom role",
+	}
+
+	update := &cobra.Command{
+		Short: "Update a custom role",
+		Long:  "Update a custom role",
+	}
+
+	del := &cobra.Command{
+		Short: "Delete a custom role",
+		Long:  "Delete a custom role",
+	}
+
+	list := &cobra.Command{
+		Short: "List roles or tokens",
+		Long:  "List roles or tokens",
+	}
+
+	revoke := &cobra.Command{
+		Short: "Revoke a token",
+		Long:  "Revoke a token",
+	}
+
 	commands = append(commands, cmdutil.CreateAlias(auth, "auth"))
 	commands = append(commands, cmdutil.CreateAlias(get, "auth get"))
 	commands = append(commands, cmdutil.CreateAlias(set, "auth set"))
 	commands = append(commands, cmdutil.CreateAlias(check, "auth check"))
+	commands = append(commands, cmdutil.CreateAlias(create, "auth create"))
+	commands = append(commands, cmdutil.CreateAlias(update, "auth update"))
+	commands = append(commands, cmdutil.CreateAlias(del, "auth delete"))
+	commands = append(commands, cmdutil.CreateAlias(list, "auth list"))
+	commands = append(commands, cmdutil.CreateAlias(revoke, "auth revoke"))
 	commands = append(commands, ActivateCmd())
 	commands = append(commands, DeactivateCmd())
 	commands = append(commands, LoginCmd())
 	commands = append(commands, LogoutCmd())
 	commands = append(commands, WhoamiCmd())
 	commands = append(commands, GetRobotTokenCmd())
+	commands = append(commands, GetScopedTokenCmd())
+	commands = append(commands, ListTokenCmd())
+	commands = append(commands, RevokeTokenCmd())
 	commands = append(commands, UseAuthTokenCmd())
 	commands = append(commands, GetConfigCmd())
 	commands = append(commands, SetConfigCmd())
+	commands = append(commands, ExportConfigCmd())
+	commands = append(commands, ImportConfigCmd())
+	commands = append(commands, GenerateConfigKeyCmd())
 	commands = append(commands, CheckRepoCmd())
 	commands = append(commands, GetGroupsCmd())
 	commands = append(commands, GetRepoRoleBindingCmd())
 	commands = append(commands, SetRepoRoleBindingCmd())
+	commands = append(commands, GetBranchRoleBindingCmd())
+	commands = append(commands, SetBranchRoleBindingCmd())
+	commands = append(commands, GetPathRoleBindingCmd())
+	commands = append(commands, SetPathRoleBindingCmd())
+	commands = append(commands, CheckPipelineCmd())
+	commands = append(commands, GetPipelineRoleBindingCmd())
+	commands = append(commands, SetPipelineRoleBindingCmd())
 	commands = append(commands, GetClusterRoleBindingCmd())
 	commands = append(commands, SetClusterRoleBindingCmd())
 	commands = append(commands, GetEnterpriseRoleBindingCmd())
 	commands = append(commands, SetEnterpriseRoleBindingCmd())
 	commands = append(commands, RotateRootToken())
 	commands = append(commands, RolesForPermissionCmd())
+	commands = append(commands, CreateRoleCmd())
+	commands = append(commands, UpdateRoleCmd())
+	commands = append(commands, DeleteRoleCmd())
+	commands = append(commands, ListRoleCmd())
+	commands = append(commands, AuditCmd())
 	return commands
 }
@@ -2,20 +2,44 @@ package cmds
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/nacl/box"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 )
 
+// readConfigFile reads a config from 'file', or from stdin if it's "-"
+func readConfigFile(file string) ([]byte, error) {
+	switch file {
+	case "-":
+		rawConfigBytes, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read config from stdin")
+		}
+		return rawConfigBytes, nil
+	case "":
+		return nil, errors.New("must set input file (use \"-\" to read from stdin)")
+	default:
+		rawConfigBytes, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read config from %q", file)
+		}
+		return rawConfigBytes, nil
+	}
+}
+
 // GetConfigCmd returns a cobra command that lets the caller see the configured
 // auth backends in Pachyderm
 func GetConfigCmd() *cobra.Command {
@@ -82,21 +106,9 @@ func SetConfigCmd() *cobra.Command {
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer c.Close()
-			var rawConfigBytes []byte
-			if file == "-" {
-				var err error
-				rawConfigBytes, err = ioutil.ReadAll(os.Stdin)
-				if err != nil {
-					return errors.Wrapf(err, "could not read config from stdin")
-				}
-			} else if file != "" {
-				var err error
-				rawConfigBytes, err = ioutil.ReadFile(file)
-				if err != nil {
-					return errors.Wrapf(err, "could not read config from %q", file)
-				}
-			} else {
-				return errors.New("must set input file (use \"-\" to read from stdin)")
+			rawConfigBytes, err := readConfigFile(file)
+			if err != nil {
+				return err
 			}
 
 			// parse config
@@ -116,3 +128,132 @@ func SetConfigCmd() *cobra.Command {
 		"as the new config")
 	return cmdutil.CreateAlias(setConfig, "auth set-config")
 }
+
+// ExportConfigCmd returns a cobra command that exports the cluster's auth and
+// identity configuration
+func ExportConfigCmd() *cobra.Command {
+	var encryptionKey, format string
+	exportConfig := &cobra.Command{
+		Short: "Export the cluster's auth and identity configuration",
+		Long: "Export the cluster's auth and identity configuration, including its role bindings, groups, custom roles, " +
+			"IDP connectors and OIDC clients, so that it can be reviewed, or imported into another cluster with " +
+			"'pachctl auth import-config'. Role bindings of pipelines and internal users aren't exported. " +
+			"Secrets are omitted, unless --encryption-key is set, in which case they're exported encrypted to it.",
+		Example: `
+# export the configuration, without secrets
+$ {{alias}} > auth-config.yaml
+
+# export the configuration, with its secrets encrypted
+$ pachctl auth generate-config-key --private-key-file config.key
+$ {{alias}} --encryption-key <public key> > auth-config.yaml`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := newClient(false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ExportConfig(c.Ctx(), &auth.ExportConfigRequest{EncryptionKey: encryptionKey})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			e, err := serde.GetEncoder(strings.ToLower(format), os.Stdout, serde.WithIndent(2), serde.WithOrigName(true))
+			if err != nil {
+				return err
+			}
+			return errors.EnsureStack(e.EncodeProto(resp.Config))
+		}),
+	}
+	exportConfig.Flags().StringVar(&encryptionKey, "encryption-key", "", "A base64-encoded public key, as printed by 'pachctl auth generate-config-key', to encrypt secrets to.")
+	exportConfig.Flags().StringVarP(&format, "output-format", "o", "yaml", "output format (\"json\" or \"yaml\")")
+	return cmdutil.CreateAlias(exportConfig, "auth export-config")
+}
+
+// ImportConfigCmd returns a cobra command that makes the cluster's auth and
+// identity configuration match an exported one
+func ImportConfigCmd() *cobra.Command {
+	var file, keyFile string
+	var dryRun bool
+	importConfig := &cobra.Command{
+		Short: "Import an auth and identity configuration into the cluster",
+		Long: "Import an auth and identity configuration, as exported by 'pachctl auth export-config', into the cluster. " +
+			"The cluster's configuration is changed to match it: items missing from it are deleted, except for the " +
+			"role bindings of repos that it doesn't mention. Role bindings on repos that don't exist in the cluster " +
+			"are skipped. Secrets that were omitted from the export keep their current values. The auth changes are " +
+			"made in a single transaction, and identity changes are undone if it fails.",
+		Example: `
+# list the changes that importing a configuration would make
+$ {{alias}} -f auth-config.yaml --dry-run
+
+# import a configuration with encrypted secrets
+$ {{alias}} -f auth-config.yaml --decryption-key-file config.key`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			rawConfigBytes, err := readConfigFile(file)
+			if err != nil {
+				return err
+			}
+			var config auth.ClusterAuthConfig
+			if err := serde.Decode(rawConfigBytes, &config); err != nil {
+				return errors.Wrapf(err, "could not parse config")
+			}
+			req := &auth.ImportConfigRequest{Config: &config, DryRun: dryRun}
+			if keyFile != "" {
+				key, err := ioutil.ReadFile(keyFile)
+				if err != nil {
+					return errors.Wrapf(err, "could not read decryption key from %q", keyFile)
+				}
+				req.DecryptionKey = strings.TrimSpace(string(key))
+			}
+			c, err := newClient(false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ImportConfig(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if len(resp.Changes) == 0 {
+				fmt.Println("No changes")
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, "OP\tKIND\tNAME\tREASON\n")
+			for _, change := range resp.Changes {
+				op := strings.ToLower(strings.TrimPrefix(change.Op.String(), "CONFIG_"))
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", op, change.Kind, change.Name, change.Reason)
+			}
+			return writer.Flush()
+		}),
+	}
+	importConfig.Flags().StringVarP(&file, "file", "f", "-", "The file to read the configuration from, or '-' for stdin.")
+	importConfig.Flags().StringVar(&keyFile, "decryption-key-file", "", "A file containing the private key that the configuration's secrets were encrypted to.")
+	importConfig.Flags().BoolVar(&dryRun, "dry-run", false, "List the changes that would be made, without making them.")
+	return cmdutil.CreateAlias(importConfig, "auth import-config")
+}
+
+// GenerateConfigKeyCmd returns a cobra command that generates a key pair to
+// encrypt the secrets in exported configurations with
+func GenerateConfigKeyCmd() *cobra.Command {
+	var keyFile string
+	generateKey := &cobra.Command{
+		Short: "Generate a key pair to encrypt the secrets in exported auth configurations",
+		Long: "Generate a key pair to encrypt the secrets in exported auth configurations with. " +
+			"The private key is written to --private-key-file, and the public key is printed, " +
+			"to be passed to 'pachctl auth export-config --encryption-key'.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if keyFile == "" {
+				return errors.New("--private-key-file must be set")
+			}
+			public, private, err := box.GenerateKey(rand.Reader)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(private[:])+"\n"), 0600); err != nil {
+				return errors.Wrapf(err, "could not write private key to %q", keyFile)
+			}
+			fmt.Println(base64.StdEncoding.EncodeToString(public[:]))
+			return nil
+		}),
+	}
+	generateKey.Flags().StringVar(&keyFile, "private-key-file", "", "The file to write the private key to.")
+	return cmdutil.CreateAlias(generateKey, "auth generate-config-key")
+}
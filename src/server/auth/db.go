@@ -22,3 +22,35 @@ ON auth.auth_tokens (subject);
 `)
 	return errors.EnsureStack(err)
 }
+
+// CreateAuditEventsTable sets up the postgres table which stores the audit log
+// of API calls
+func CreateAuditEventsTable(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS auth.audit_events (
+	id BIGSERIAL PRIMARY KEY,
+	time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	principal VARCHAR(4096) NOT NULL,
+	method VARCHAR(4096) NOT NULL,
+	resource_type VARCHAR(64) NOT NULL,
+	resource_name VARCHAR(4096) NOT NULL,
+	allowed BOOLEAN NOT NULL,
+	request TEXT NOT NULL,
+	error TEXT NOT NULL
+);
+
+CREATE INDEX audit_events_time_index
+ON auth.audit_events (time);
+
+CREATE INDEX audit_events_principal_index
+ON auth.audit_events (principal, time);
+`)
+	return errors.EnsureStack(err)
+}
+
+// AddAuthTokenScopeColumn adds the column that stores the scope of restricted
+// tokens. Tokens with a NULL scope are unrestricted.
+func AddAuthTokenScopeColumn(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `ALTER TABLE auth.auth_tokens ADD COLUMN IF NOT EXISTS scope BYTEA`)
+	return errors.EnsureStack(err)
+}
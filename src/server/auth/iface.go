@@ -18,6 +18,15 @@ type APIServer interface {
 	CheckClusterIsAuthorized(ctx context.Context, p ...auth_client.Permission) error
 	CheckClusterIsAuthorizedInTransaction(*txncontext.TransactionContext, ...auth_client.Permission) error
 	CheckRepoIsAuthorizedInTransaction(*txncontext.TransactionContext, *pfs_client.Repo, ...auth_client.Permission) error
+	CheckBranchIsAuthorized(context.Context, *pfs_client.Branch, ...auth_client.Permission) error
+	CheckBranchIsAuthorizedInTransaction(*txncontext.TransactionContext, *pfs_client.Branch, ...auth_client.Permission) error
+	CheckPipelineIsAuthorized(context.Context, string, ...auth_client.Permission) error
+	CheckPipelineIsAuthorizedInTransaction(*txncontext.TransactionContext, string, ...auth_client.Permission) error
+
+	// AuthorizedPathFilter returns a predicate on the files of a repo that are
+	// accessible under its path bindings, or nil if no paths are restricted.
+	AuthorizedPathFilter(context.Context, *pfs_client.Repo, ...auth_client.Permission) (func(string) bool, error)
+	AuthorizedPathFilterInTransaction(*txncontext.TransactionContext, *pfs_client.Repo, ...auth_client.Permission) (func(string) bool, error)
 
 	AuthorizeInTransaction(*txncontext.TransactionContext, *auth_client.AuthorizeRequest) (*auth_client.AuthorizeResponse, error)
 	ModifyRoleBindingInTransaction(*txncontext.TransactionContext, *auth_client.ModifyRoleBindingRequest) (*auth_client.ModifyRoleBindingResponse, error)
@@ -39,4 +48,8 @@ type APIServer interface {
 	RevokeAuthTokenInTransaction(*txncontext.TransactionContext, *auth_client.RevokeAuthTokenRequest) (*auth_client.RevokeAuthTokenResponse, error)
 
 	GetPermissionsInTransaction(*txncontext.TransactionContext, *auth_client.GetPermissionsRequest) (*auth_client.GetPermissionsResponse, error)
+
+	// RecordAuditEvent is an internal API used by the audit interceptor to
+	// queue an event for the audit log. It blocks only if the queue is full.
+	RecordAuditEvent(*auth_client.AuditEvent)
 }
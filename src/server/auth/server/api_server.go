@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	enterpriseclient "github.com/pachyderm/pachyderm/v2/src/enterprise"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
@@ -63,10 +65,15 @@ type apiServer struct {
 	members col.PostgresCollection
 	// groups is a collection of group -> usernames mappings.
 	groups col.PostgresCollection
+	// customRoles is a collection of role name -> custom role definitions.
+	customRoles col.PostgresCollection
 	// collection containing the auth config (under the key configKey)
 	authConfig col.PostgresCollection
 	// oidcStates  contains the set of OIDC nonces for requests that are in progress
 	oidcStates col.EtcdCollection
+	// auditEvents buffers audit events until they're written to postgres. It's
+	// nil if the audit log is disabled.
+	auditEvents chan *auth.AuditEvent
 
 	// public addresses the fact that pachd in full mode initializes two auth
 	// servers: one that exposes a public API, possibly over TLS, and one that
@@ -98,6 +105,7 @@ func NewAuthServer(env Env, public, requireNoncriticalServers, watchesEnabled bo
 		roleBindings:   roleBindingsCollection(env.DB, env.Listener),
 		members:        membersCollection(env.DB, env.Listener),
 		groups:         groupsCollection(env.DB, env.Listener),
+		customRoles:    rolesCollection(env.DB, env.Listener),
 		oidcStates:     oidcStates,
 		public:         public,
 		watchesEnabled: watchesEnabled,
@@ -121,6 +129,12 @@ func NewAuthServer(env Env, public, requireNoncriticalServers, watchesEnabled bo
 
 	s.deleteExpiredTokensRoutine()
 
+	if env.Config.AuditLogRetentionDays > 0 {
+		s.auditEvents = make(chan *auth.AuditEvent, auditBufferSize)
+		s.writeAuditEventsRoutine()
+		s.deleteExpiredAuditEventsRoutine()
+	}
+
 	return s, nil
 }
 
@@ -293,7 +307,7 @@ func (a *apiServer) Activate(ctx context.Context, req *auth.ActivateRequest) (re
 		}); err != nil {
 			return errors.EnsureStack(err)
 		}
-		return a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(pachToken), auth.RootUser)
+		return a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(pachToken), auth.RootUser, nil)
 	}); err != nil {
 		return nil, err
 	}
@@ -327,7 +341,7 @@ func (a *apiServer) RotateRootToken(ctx context.Context, req *auth.RotateRootTok
 		if rootToken == "" {
 			rootToken = uuid.NewWithoutDashes()
 		}
-		if err := a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(rootToken), auth.RootUser); err != nil {
+		if err := a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(rootToken), auth.RootUser, nil); err != nil {
 			return err
 		}
 		return nil
@@ -448,7 +462,7 @@ func resourceKey(r *auth.Resource) string {
 }
 
 func (a *apiServer) evaluateRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, principal string, resource *auth.Resource, permissions map[auth.Permission]bool) (*authorizeRequest, error) {
-	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction)
+	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction, a.getRoleInTransaction)
 
 	// Special-case making spec repos world-readable, because the alternative breaks reading pipelines.
 	// TOOD: 2.0 - should we make this a user-configurable cluster binding instead of hard-coding it?
@@ -490,18 +504,64 @@ func (a *apiServer) evaluateRoleBindingInTransaction(txnCtx *txncontext.Transact
 		return request, nil
 	}
 
+	// Branch, path and pipeline bindings are stored in the role binding for
+	// their repo
+	bindingResource, scope, err := scopedResource(resource)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the role bindings for the resource to check
 	var roleBinding auth.RoleBinding
-	if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(resourceKey(resource), &roleBinding); err != nil {
+	if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(resourceKey(bindingResource), &roleBinding); err != nil {
 		if col.IsErrNotFound(err) {
 			return nil, &auth.ErrNoRoleBinding{
-				Resource: *resource,
+				Resource: *bindingResource,
 			}
 		}
-		return nil, errors.Wrapf(err, "error getting role bindings for %s \"%s\"", resource.Type, resource.Name)
+		return nil, errors.Wrapf(err, "error getting role bindings for %s \"%s\"", bindingResource.Type, bindingResource.Name)
 	}
-	if err := request.evaluateRoleBinding(txnCtx, &roleBinding); err != nil {
-		return nil, err
+
+	switch resource.Type {
+	case auth.ResourceType_BRANCH:
+		// Branch bindings grant permissions in addition to the repo binding
+		if err := request.evaluateRoleBinding(txnCtx, &roleBinding); err != nil {
+			return nil, err
+		}
+		for _, b := range branchBindings(&roleBinding, scope) {
+			if request.isSatisfied() {
+				break
+			}
+			if err := request.evaluateRoleBinding(txnCtx, b); err != nil {
+				return nil, err
+			}
+		}
+	case auth.ResourceType_PIPELINE:
+		// Pipeline bindings grant permissions in addition to the binding for
+		// the pipeline's output repo
+		if err := request.evaluateRoleBinding(txnCtx, &roleBinding); err != nil {
+			return nil, err
+		}
+		if roleBinding.Pipeline != nil && !request.isSatisfied() {
+			if err := request.evaluateRoleBinding(txnCtx, roleBinding.Pipeline); err != nil {
+				return nil, err
+			}
+		}
+	case auth.ResourceType_PATH:
+		// Path bindings replace the repo binding for the files they cover
+		bindings := pathBindings(&roleBinding, scope)
+		if len(bindings) == 0 {
+			bindings = []*auth.RoleBinding{&roleBinding}
+		}
+		for _, b := range bindings {
+			if err := request.evaluateRoleBinding(txnCtx, b); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		if err := request.evaluateRoleBinding(txnCtx, &roleBinding); err != nil {
+			return nil, err
+		}
 	}
 	return request, nil
 }
@@ -526,6 +586,9 @@ func (a *apiServer) AuthorizeInTransaction(
 	if err != nil {
 		return nil, err
 	}
+	if err := a.restrictToTokenScope(txnCtx, me.Scope, req.Resource, request); err != nil {
+		return nil, err
+	}
 
 	return &auth.AuthorizeResponse{
 		Principal:  me.Username,
@@ -580,7 +643,14 @@ func (a *apiServer) GetPermissions(ctx context.Context, req *auth.GetPermissions
 		return nil, err
 	}
 
-	return a.GetPermissionsForPrincipal(ctx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Subject, Resource: req.Resource})
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		resp, err = a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Subject, Resource: req.Resource}, callerInfo.Scope)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (a *apiServer) GetPermissionsInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsRequest) (*auth.GetPermissionsResponse, error) {
@@ -590,10 +660,12 @@ func (a *apiServer) GetPermissionsInTransaction(txnCtx *txncontext.TransactionCo
 		return nil, err
 	}
 
-	return a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Username, Resource: req.Resource})
+	return a.getPermissionsForPrincipalInTransaction(txnCtx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Username, Resource: req.Resource}, callerInfo.Scope)
 }
 
-func (a *apiServer) getPermissionsForPrincipalInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsForPrincipalRequest) (*auth.GetPermissionsResponse, error) {
+// getPermissionsForPrincipalInTransaction returns the permissions that
+// 'req.Principal' has on 'req.Resource', restricted to 'scope' if it's set.
+func (a *apiServer) getPermissionsForPrincipalInTransaction(txnCtx *txncontext.TransactionContext, req *auth.GetPermissionsForPrincipalRequest, scope *auth.TokenScope) (*auth.GetPermissionsResponse, error) {
 	permissions := make(map[auth.Permission]bool)
 	for p := range auth.Permission_name {
 		permissions[auth.Permission(p)] = true
@@ -603,6 +675,9 @@ func (a *apiServer) getPermissionsForPrincipalInTransaction(txnCtx *txncontext.T
 	if err != nil {
 		return nil, err
 	}
+	if err := a.restrictToTokenScope(txnCtx, scope, req.Resource, request); err != nil {
+		return nil, err
+	}
 
 	return &auth.GetPermissionsResponse{
 		Roles:       request.rolesForResourceType(req.Resource.Type),
@@ -625,6 +700,7 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 	return &auth.WhoAmIResponse{
 		Username:   callerInfo.Subject,
 		Expiration: callerInfo.Expiration,
+		Scope:      callerInfo.Scope,
 	}, nil
 }
 
@@ -649,24 +725,28 @@ func (a *apiServer) DeleteRoleBindingInTransaction(txnCtx *txncontext.Transactio
 	return nil
 }
 
-// rolesFromRoleSlice converts a slice of strings into *auth.Roles,
-// validating that each role name is valid.
-func rolesFromRoleSlice(rs []string) (*auth.Roles, error) {
-	if len(rs) == 0 {
-		return nil, nil
-	}
-
+// rolesFromRoleSliceInTransaction converts a slice of strings into *auth.Roles,
+// validating that each role name is a built-in or custom role.
+func (a *apiServer) rolesFromRoleSliceInTransaction(txnCtx *txncontext.TransactionContext, rs []string) (*auth.Roles, error) {
 	for _, r := range rs {
-		if _, err := getRole(r); err != nil {
+		if _, err := a.getRoleInTransaction(txnCtx, r); err != nil {
 			return nil, err
 		}
 	}
+	return rolesFromRoleSlice(rs), nil
+}
+
+// rolesFromRoleSlice converts a slice of strings into *auth.Roles.
+func rolesFromRoleSlice(rs []string) *auth.Roles {
+	if len(rs) == 0 {
+		return nil
+	}
 
 	roles := &auth.Roles{Roles: make(map[string]bool)}
 	for _, r := range rs {
 		roles.Roles[r] = true
 	}
-	return roles, nil
+	return roles
 }
 
 // CreateRoleBindingInTransaction is an internal-only API to create a role binding for a new resource.
@@ -683,7 +763,7 @@ func (a *apiServer) CreateRoleBindingInTransaction(txnCtx *txncontext.Transactio
 			return err
 		}
 
-		roles, err := rolesFromRoleSlice(roleSlice)
+		roles, err := a.rolesFromRoleSliceInTransaction(txnCtx, roleSlice)
 		if err != nil {
 			return err
 		}
@@ -783,6 +863,23 @@ func (a *apiServer) ModifyRoleBindingInTransaction(
 		if err := a.CheckRepoIsAuthorizedInTransaction(txnCtx, &pfs.Repo{Type: pfs.UserRepoType, Name: req.Resource.Name}, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
 			return nil, err
 		}
+	case auth.ResourceType_BRANCH, auth.ResourceType_PATH, auth.ResourceType_PIPELINE:
+		repo, _, err := scopedResource(req.Resource)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.CheckRepoIsAuthorizedInTransaction(txnCtx, &pfs.Repo{Type: pfs.UserRepoType, Name: repo.Name}, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+			return nil, err
+		}
+		for _, r := range req.Roles {
+			role, err := a.getRoleInTransaction(txnCtx, r)
+			if err != nil {
+				return nil, err
+			}
+			if !roleAppliesToResource(role.role, req.Resource.Type) {
+				return nil, errors.Errorf("role %q cannot be granted on resources of type %v", r, req.Resource.Type)
+			}
+		}
 	default:
 		return nil, errors.Errorf("unknown resource type %v", req.Resource.Type)
 	}
@@ -795,31 +892,46 @@ func (a *apiServer) ModifyRoleBindingInTransaction(
 }
 
 func (a *apiServer) setUserRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, principal string, roleSlice []string) error {
-	roles, err := rolesFromRoleSlice(roleSlice)
+	roles, err := a.rolesFromRoleSliceInTransaction(txnCtx, roleSlice)
 	if err != nil {
 		return err
 	}
 
-	key := resourceKey(resource)
+	bindingResource, scope, err := scopedResource(resource)
+	if err != nil {
+		return err
+	}
+
+	key := resourceKey(bindingResource)
 	roleBindings := a.roleBindings.ReadWrite(txnCtx.SqlTx)
 	var bindings auth.RoleBinding
 	if err := roleBindings.Get(key, &bindings); err != nil {
 		if col.IsErrNotFound(err) {
 			return &auth.ErrNoRoleBinding{
-				Resource: *resource,
+				Resource: *bindingResource,
 			}
 		}
 		return errors.EnsureStack(err)
 	}
 
-	if bindings.Entries == nil {
-		bindings.Entries = make(map[string]*auth.Roles)
+	// Branch, path and pipeline bindings are nested in the repo binding
+	binding := &bindings
+	if bindingResource != resource {
+		binding = nestedBinding(&bindings, resource.Type, scope, true)
+	}
+
+	if binding.Entries == nil {
+		binding.Entries = make(map[string]*auth.Roles)
 	}
 
 	if len(roleSlice) == 0 {
-		delete(bindings.Entries, principal)
+		delete(binding.Entries, principal)
 	} else {
-		bindings.Entries[principal] = roles
+		binding.Entries[principal] = roles
+	}
+
+	if binding != &bindings && len(binding.Entries) == 0 {
+		deleteNestedBinding(&bindings, resource.Type, scope)
 	}
 	return errors.EnsureStack(roleBindings.Put(key, &bindings))
 }
@@ -836,11 +948,12 @@ func (a *apiServer) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleB
 	}
 
 	// If the request is not in a transaction, block until the cache is updated
-	if req.Resource.Type == auth.ResourceType_CLUSTER {
-		expected, err := rolesFromRoleSlice(req.Roles)
-		if err != nil {
-			return nil, err
-		}
+	activeTxn, err := client.GetTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if activeTxn == nil && req.Resource.Type == auth.ResourceType_CLUSTER {
+		expected := rolesFromRoleSlice(req.Roles)
 		if err := backoff.Retry(func() error {
 			bindings, ok := a.clusterRoleBindingCache.Load().(*auth.RoleBinding)
 			if !ok {
@@ -868,11 +981,24 @@ func (a *apiServer) GetRoleBindingInTransaction(
 		return nil, err
 	}
 
+	bindingResource, scope, err := scopedResource(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+
 	var roleBindings auth.RoleBinding
-	if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(resourceKey(req.Resource), &roleBindings); err != nil && !col.IsErrNotFound(err) {
+	if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(resourceKey(bindingResource), &roleBindings); err != nil && !col.IsErrNotFound(err) {
 		return nil, errors.EnsureStack(err)
 	}
 
+	if bindingResource != req.Resource {
+		if b := nestedBinding(&roleBindings, req.Resource.Type, scope, false); b != nil {
+			roleBindings = *b
+		} else {
+			roleBindings = auth.RoleBinding{}
+		}
+	}
+
 	if roleBindings.Entries == nil {
 		roleBindings.Entries = make(map[string]*auth.Roles)
 	}
@@ -905,13 +1031,7 @@ func (a *apiServer) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRe
 	subject = auth.RobotPrefix + subject
 
 	// generate new token, and write to postgres
-	var token string
-	var err error
-	if req.TTL > 0 {
-		token, err = a.generateAndInsertAuthToken(ctx, subject, req.TTL)
-	} else {
-		token, err = a.generateAndInsertAuthTokenNoTTL(ctx, subject)
-	}
+	token, err := a.generateAndInsertScopedAuthToken(ctx, subject, req.TTL, req.Scope)
 	if err != nil {
 		return nil, err
 	}
@@ -920,6 +1040,59 @@ func (a *apiServer) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRe
 	}, nil
 }
 
+// GetScopedToken implements the protobuf auth.GetScopedToken RPC
+func (a *apiServer) GetScopedToken(ctx context.Context, req *auth.GetScopedTokenRequest) (resp *auth.GetScopedTokenResponse, retErr error) {
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if callerInfo.Scope != nil {
+		return nil, errors.New("scoped tokens cannot be used to create other tokens")
+	}
+	if req.Scope == nil {
+		return nil, errors.New("the scope of the token must be set")
+	}
+
+	// the new token can't outlive the caller's token
+	ttl := req.TTL
+	if callerInfo.Expiration != nil {
+		remaining := int64(time.Until(*callerInfo.Expiration).Seconds())
+		if remaining <= 0 {
+			return nil, auth.ErrExpiredToken
+		}
+		if ttl <= 0 || ttl > remaining {
+			ttl = remaining
+		}
+	}
+	token, err := a.generateAndInsertScopedAuthToken(ctx, callerInfo.Subject, ttl, req.Scope)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetScopedTokenResponse{Token: token}, nil
+}
+
+// ListAuthTokens implements the protobuf auth.ListAuthTokens RPC
+func (a *apiServer) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (resp *auth.ListAuthTokensResponse, retErr error) {
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subject := req.Subject
+	if subject == "" {
+		subject = callerInfo.Subject
+	}
+	if subject != callerInfo.Subject {
+		if err := a.CheckClusterIsAuthorized(ctx, auth.Permission_CLUSTER_AUTH_EXTRACT_TOKENS); err != nil {
+			return nil, err
+		}
+	}
+	tokens, err := a.listAuthTokensForSubject(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListAuthTokensResponse{Tokens: tokens}, nil
+}
+
 // GetPipelineAuthTokenInTransaction is an internal API used to create a pipeline token for a given pipeline.
 // Not an RPC.
 func (a *apiServer) GetPipelineAuthTokenInTransaction(txnCtx *txncontext.TransactionContext, pipeline string) (string, error) {
@@ -928,7 +1101,7 @@ func (a *apiServer) GetPipelineAuthTokenInTransaction(txnCtx *txncontext.Transac
 	}
 
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthTokenNoTTLInTransaction(txnCtx, auth.HashToken(token), auth.PipelinePrefix+pipeline); err != nil {
+	if err := a.insertAuthTokenNoTTLInTransaction(txnCtx, auth.HashToken(token), auth.PipelinePrefix+pipeline, nil); err != nil {
 		return "", errors.Wrapf(err, "error storing token")
 	} else {
 		return token, nil
@@ -961,7 +1134,41 @@ func (a *apiServer) RevokeAuthTokenInTransaction(txnCtx *txncontext.TransactionC
 		return nil, err
 	}
 
-	if err := a.deleteAuthToken(txnCtx.SqlTx, auth.HashToken(req.Token)); err != nil {
+	tokenHash := req.HashedToken
+	if req.Token != "" {
+		tokenHash = auth.HashToken(req.Token)
+	} else if tokenHash == "" {
+		return nil, errors.New("either the token or its hash must be set")
+	}
+	// A scoped token can only revoke itself
+	if me, err := txnCtx.WhoAmI(); err == nil && me.Scope != nil {
+		token, err := auth.GetAuthToken(txnCtx.Context())
+		if err != nil || auth.HashToken(token) != tokenHash {
+			return nil, errors.New("a scoped token can only revoke itself")
+		}
+	}
+	if req.Token == "" {
+		// Revoking a token by its hash requires it to belong to the caller,
+		// or permission to revoke other users' tokens
+		var subject string
+		if err := txnCtx.SqlTx.Get(&subject, `SELECT subject FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, errors.Errorf("no token with hash %q", tokenHash)
+			}
+			return nil, errors.Wrapf(err, "error querying token")
+		}
+		me, err := txnCtx.WhoAmI()
+		if err != nil {
+			return nil, err
+		}
+		if subject != me.Username {
+			if err := a.CheckClusterIsAuthorizedInTransaction(txnCtx, auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := a.deleteAuthToken(txnCtx.SqlTx, tokenHash); err != nil {
 		return nil, err
 	}
 	return &auth.RevokeAuthTokenResponse{}, nil
@@ -1184,7 +1391,163 @@ func (a *apiServer) GetUsers(ctx context.Context, req *auth.GetUsersRequest) (re
 
 // GetRolesForPermission implements the protobuf auth.GetRolesForPermission RPC
 func (a *apiServer) GetRolesForPermission(ctx context.Context, req *auth.GetRolesForPermissionRequest) (resp *auth.GetRolesForPermissionResponse, retErr error) {
-	return &auth.GetRolesForPermissionResponse{Roles: rolesForPermission(req.Permission)}, nil
+	result := rolesForPermission(req.Permission)
+	customRoles, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range customRoles {
+		if newInternalRole(r).permissionsIndex[req.Permission] {
+			result = append(result, r)
+		}
+	}
+	return &auth.GetRolesForPermissionResponse{Roles: result}, nil
+}
+
+// CreateRole implements the protobuf auth.CreateRole RPC
+func (a *apiServer) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (resp *auth.CreateRoleResponse, retErr error) {
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	if req.Role == nil {
+		return nil, errors.Errorf("role must be set")
+	}
+	role := proto.Clone(req.Role).(*auth.Role)
+	role.Custom = true
+	if len(role.ResourceTypes) == 0 {
+		role.ResourceTypes = defaultResourceTypes(role.Permissions)
+	}
+	if err := validateCustomRole(role); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *pachsql.Tx) error {
+		if err := a.customRoles.ReadWrite(sqlTx).Create(role.Name, role); err != nil {
+			if col.IsErrExists(err) {
+				return errors.Errorf("role %q already exists", role.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.CreateRoleResponse{}, nil
+}
+
+// UpdateRole implements the protobuf auth.UpdateRole RPC
+func (a *apiServer) UpdateRole(ctx context.Context, req *auth.UpdateRoleRequest) (resp *auth.UpdateRoleResponse, retErr error) {
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	if req.Role == nil {
+		return nil, errors.Errorf("role must be set")
+	}
+	update := proto.Clone(req.Role).(*auth.Role)
+	if len(update.ResourceTypes) == 0 {
+		update.ResourceTypes = defaultResourceTypes(update.Permissions)
+	}
+	if err := validateCustomRole(update); err != nil {
+		return nil, err
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *pachsql.Tx) error {
+		var role auth.Role
+		if err := a.customRoles.ReadWrite(sqlTx).Update(update.Name, &role, func() error {
+			role.Permissions = update.Permissions
+			role.ResourceTypes = update.ResourceTypes
+			return nil
+		}); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("role %q not found", update.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.UpdateRoleResponse{}, nil
+}
+
+// DeleteRole implements the protobuf auth.DeleteRole RPC
+func (a *apiServer) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (resp *auth.DeleteRoleResponse, retErr error) {
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	if _, ok := roles[req.Name]; ok {
+		return nil, errors.Errorf("cannot delete built-in role %q", req.Name)
+	}
+
+	// Refuse to delete a role that is still bound, as authorization fails on
+	// bindings that refer to unknown roles. The check and the delete share a
+	// (serializable) transaction, so a binding to the role can't be created
+	// between them.
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *pachsql.Tx) error {
+		if err := a.customRoles.ReadWrite(sqlTx).Delete(req.Name); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("role %q not found", req.Name)
+			}
+			return errors.EnsureStack(err)
+		}
+		var binding auth.RoleBinding
+		if err := a.roleBindings.ReadWrite(sqlTx).List(&binding, col.DefaultOptions(), func(key string) error {
+			if principal, scope, ok := roleBound(&binding, req.Name); ok {
+				return errors.Errorf("role %q is still bound to %q on %q%s", req.Name, principal, key, scope)
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		var rows []*tokenRow
+		if err := sqlTx.SelectContext(ctx, &rows,
+			`SELECT token_hash, subject, expiration, scope
+			FROM auth.auth_tokens
+			WHERE scope IS NOT NULL AND (expiration IS NULL OR expiration > NOW())`); err != nil {
+			return errors.Wrapf(err, "error querying tokens")
+		}
+		for _, row := range rows {
+			scope, err := unmarshalTokenScope(row.Scope)
+			if err != nil {
+				return err
+			}
+			for _, r := range scope.Roles {
+				if r == req.Name {
+					return errors.Errorf("role %q is still in the scope of a token issued to %q", req.Name, row.Subject)
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.DeleteRoleResponse{}, nil
+}
+
+// ListRole implements the protobuf auth.ListRole RPC
+func (a *apiServer) ListRole(ctx context.Context, req *auth.ListRoleRequest) (resp *auth.ListRoleResponse, retErr error) {
+	result := make([]*auth.Role, 0, len(roles))
+	for _, r := range roles {
+		result = append(result, r.role)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	customRoles, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListRoleResponse{Roles: append(result, customRoles...)}, nil
+}
+
+// listCustomRoles returns every custom role, ordered by name.
+func (a *apiServer) listCustomRoles(ctx context.Context) ([]*auth.Role, error) {
+	var result []*auth.Role
+	role := &auth.Role{}
+	if err := a.customRoles.ReadOnly(ctx).List(role, col.DefaultOptions(), func(string) error {
+		result = append(result, proto.Clone(role).(*auth.Role))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
 }
 
 func setToList(set map[string]bool) []string {
@@ -1206,9 +1569,28 @@ func (a *apiServer) getAuthenticatedUser(ctx context.Context) (*auth.TokenInfo,
 
 	// try to lookup pre-computed subject
 	if subject := internalauth.GetWhoAmI(ctx); subject != "" {
-		return &auth.TokenInfo{
+		tokenInfo := &auth.TokenInfo{
 			Subject: subject,
-		}, nil
+		}
+		// the scope of the caller's token still applies to the calls made on
+		// its behalf, and is usually cached along with the subject
+		if scope, ok := internalauth.GetWhoAmIScope(ctx); ok {
+			tokenInfo.Scope = scope
+			return tokenInfo, nil
+		}
+		if token, err := auth.GetAuthToken(ctx); err == nil {
+			info, err := a.lookupAuthTokenInfo(ctx, auth.HashToken(token))
+			if err != nil {
+				if col.IsErrNotFound(err) {
+					return nil, auth.ErrBadToken
+				}
+				return nil, err
+			}
+			if info.Subject == subject {
+				tokenInfo = info
+			}
+		}
+		return tokenInfo, nil
 	}
 
 	// otherwise, we need a token
@@ -1314,21 +1696,25 @@ func (a *apiServer) SetConfiguration(ctx context.Context, req *auth.SetConfigura
 		return nil, err
 	}
 
-	// block until the watcher observes the write
-	if err := backoff.Retry(func() error {
+	if err := a.waitForConfig(configToStore); err != nil {
+		return nil, err
+	}
+	return &auth.SetConfigurationResponse{}, nil
+}
+
+// waitForConfig blocks until the config watcher observes that the auth config
+// has been set to 'config'
+func (a *apiServer) waitForConfig(config *auth.OIDCConfig) error {
+	return backoff.Retry(func() error {
 		record, ok := a.configCache.Load().(*auth.OIDCConfig)
 		if !ok {
 			return errors.Errorf("could not retrieve auth config from cache")
 		}
-		if !proto.Equal(record, configToStore) {
+		if !proto.Equal(record, config) {
 			return errors.Errorf("config in cache was not updated")
 		}
 		return nil
-	}, backoff.RetryEvery(time.Second)); err != nil {
-		return nil, err
-	}
-
-	return &auth.SetConfigurationResponse{}, nil
+	}, backoff.RetryEvery(time.Second))
 }
 
 func (a *apiServer) ExtractAuthTokens(ctx context.Context, req *auth.ExtractAuthTokensRequest) (resp *auth.ExtractAuthTokensResponse, retErr error) {
@@ -1354,9 +1740,9 @@ func (a *apiServer) RestoreAuthToken(ctx context.Context, req *auth.RestoreAuthT
 
 	if err := func() error {
 		if ttl > 0 {
-			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl)
+			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl, req.Token.Scope)
 		} else {
-			return a.insertAuthTokenNoTTL(ctx, req.Token.HashedToken, req.Token.Subject)
+			return a.insertAuthTokenNoTTL(ctx, req.Token.HashedToken, req.Token.Subject, req.Token.Scope)
 		}
 	}(); err != nil {
 		return nil, errors.Wrapf(err, "error restoring auth token")
@@ -1396,48 +1782,92 @@ func (a *apiServer) deleteExpiredTokensRoutine() {
 
 // we interpret an expiration value of NULL as "lives forever".
 func (a *apiServer) lookupAuthTokenInfo(ctx context.Context, tokenHash string) (*auth.TokenInfo, error) {
-	var tokenInfo auth.TokenInfo
-	err := a.env.DB.GetContext(ctx, &tokenInfo, `SELECT subject, expiration FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
+	var row tokenRow
+	err := a.env.DB.GetContext(ctx, &row, `SELECT subject, expiration, scope FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
 	if err != nil {
-		return nil, col.ErrNotFound{Type: "auth_tokens", Key: tokenHash}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, col.ErrNotFound{Type: "auth_tokens", Key: tokenHash}
+		}
+		return nil, errors.Wrapf(err, "error querying token")
 	}
-	return &tokenInfo, nil
+	return row.toProto()
 }
 
 // we will sometimes have expiration values set in the passed, since we only remove those values in the deleteExpiredTokensRoutine() goroutine
 func (a *apiServer) listRobotTokens(ctx context.Context) ([]*auth.TokenInfo, error) {
-	robotTokens := make([]*auth.TokenInfo, 0)
-	if err := a.env.DB.SelectContext(ctx, &robotTokens,
-		`SELECT token_hash, subject, expiration
+	var rows []*tokenRow
+	if err := a.env.DB.SelectContext(ctx, &rows,
+		`SELECT token_hash, subject, expiration, scope
 		FROM auth.auth_tokens 
 		WHERE subject LIKE $1 || '%'`, auth.RobotPrefix); err != nil {
 		return nil, errors.Wrapf(err, "error querying token")
 	}
-	return robotTokens, nil
+	return tokenRowsToProto(rows)
+}
+
+// listAuthTokensForSubject returns the unexpired tokens issued to 'subject'
+func (a *apiServer) listAuthTokensForSubject(ctx context.Context, subject string) ([]*auth.TokenInfo, error) {
+	var rows []*tokenRow
+	if err := a.env.DB.SelectContext(ctx, &rows,
+		`SELECT token_hash, subject, expiration, scope
+		FROM auth.auth_tokens
+		WHERE subject = $1 AND (expiration IS NULL OR expiration > NOW())
+		ORDER BY created_at`, subject); err != nil {
+		return nil, errors.Wrapf(err, "error querying tokens")
+	}
+	return tokenRowsToProto(rows)
+}
+
+func tokenRowsToProto(rows []*tokenRow) ([]*auth.TokenInfo, error) {
+	tokens := make([]*auth.TokenInfo, 0, len(rows))
+	for _, row := range rows {
+		token, err := row.toProto()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
 }
 
 func (a *apiServer) generateAndInsertAuthToken(ctx context.Context, subject string, ttlSeconds int64) (string, error) {
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds); err != nil {
+	if err := a.insertAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds, nil); err != nil {
 		return "", err
 	}
 	return token, nil
 }
 
-func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject string) (string, error) {
+// generateAndInsertScopedAuthToken generates a token restricted to 'scope',
+// which may be nil. If 'ttlSeconds' isn't positive, the token doesn't expire.
+func (a *apiServer) generateAndInsertScopedAuthToken(ctx context.Context, subject string, ttlSeconds int64, scope *auth.TokenScope) (string, error) {
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.validateTokenScopeInTransaction(txnCtx, scope)
+	}); err != nil {
+		return "", err
+	}
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthTokenNoTTL(ctx, auth.HashToken(token), subject); err != nil {
+	var err error
+	if ttlSeconds > 0 {
+		err = a.insertAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds, scope)
+	} else {
+		err = a.insertAuthTokenNoTTL(ctx, auth.HashToken(token), subject, scope)
+	}
+	if err != nil {
 		return "", err
 	}
-
 	return token, nil
 }
 
 // generates a token, and stores it's hash and supporting data in postgres
-func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64) error {
+func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64, scope *auth.TokenScope) error {
+	scopeData, err := marshalTokenScope(scope)
+	if err != nil {
+		return err
+	}
 	if _, err := a.env.DB.ExecContext(ctx,
-		`INSERT INTO auth.auth_tokens (token_hash, subject, expiration) 
-		VALUES ($1, $2, NOW() + $3 * interval '1 sec')`, tokenHash, subject, ttlSeconds); err != nil {
+		`INSERT INTO auth.auth_tokens (token_hash, subject, expiration, scope) 
+		VALUES ($1, $2, NOW() + $3 * interval '1 sec', $4)`, tokenHash, subject, ttlSeconds, scopeData); err != nil {
 		if dbutil.IsUniqueViolation(err) {
 			return errors.New("cannot overwrite existing token with same hash")
 		}
@@ -1447,17 +1877,21 @@ func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subje
 }
 
 // TODO(acohen4): replace this function with what's implemented in postgres-integration once it lands
-func (a *apiServer) insertAuthTokenNoTTL(ctx context.Context, tokenHash string, subject string) error {
+func (a *apiServer) insertAuthTokenNoTTL(ctx context.Context, tokenHash string, subject string, scope *auth.TokenScope) error {
 	return a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		err := a.insertAuthTokenNoTTLInTransaction(txnCtx, tokenHash, subject)
+		err := a.insertAuthTokenNoTTLInTransaction(txnCtx, tokenHash, subject, scope)
 		return err
 	})
 }
 
-func (a *apiServer) insertAuthTokenNoTTLInTransaction(txnCtx *txncontext.TransactionContext, tokenHash string, subject string) error {
+func (a *apiServer) insertAuthTokenNoTTLInTransaction(txnCtx *txncontext.TransactionContext, tokenHash string, subject string, scope *auth.TokenScope) error {
+	scopeData, err := marshalTokenScope(scope)
+	if err != nil {
+		return err
+	}
 	if _, err := txnCtx.SqlTx.Exec(
-		`INSERT INTO auth.auth_tokens (token_hash, subject) 
-		VALUES ($1, $2)`, tokenHash, subject); err != nil {
+		`INSERT INTO auth.auth_tokens (token_hash, subject, scope) 
+		VALUES ($1, $2, $3)`, tokenHash, subject, scopeData); err != nil {
 		if dbutil.IsUniqueViolation(err) {
 			return errors.New("cannot overwrite existing token with same hash")
 		}
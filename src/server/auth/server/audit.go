@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+const (
+	// auditBufferSize is the number of audit events that can be waiting to be
+	// written before callers have to write their events themselves
+	auditBufferSize = 4096
+	// auditBatchSize is the maximum number of audit events written at once
+	auditBatchSize = 256
+	// defaultAuditEventsLimit is the number of events returned by
+	// ListAuditEvents if the request doesn't set a limit
+	defaultAuditEventsLimit = 1000
+)
+
+var auditEventsDroppedMetric = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "pachyderm",
+	Subsystem: "auth_audit",
+	Name:      "events_dropped_total",
+	Help:      "Number of audit events that couldn't be written to the audit log",
+})
+
+// auditEventRow is the representation of an AuditEvent in postgres
+type auditEventRow struct {
+	ID           int64     `db:"id"`
+	Time         time.Time `db:"time"`
+	Principal    string    `db:"principal"`
+	Method       string    `db:"method"`
+	ResourceType string    `db:"resource_type"`
+	ResourceName string    `db:"resource_name"`
+	Allowed      bool      `db:"allowed"`
+	Request      string    `db:"request"`
+	Error        string    `db:"error"`
+}
+
+func newAuditEventRow(event *auth.AuditEvent) (*auditEventRow, error) {
+	t := time.Now()
+	if event.Time != nil {
+		var err error
+		if t, err = types.TimestampFromProto(event.Time); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	row := &auditEventRow{
+		Time:      t.UTC(),
+		Principal: event.Principal,
+		Method:    event.Method,
+		Allowed:   event.Allowed,
+		Request:   event.Request,
+		Error:     event.Error,
+	}
+	if event.Resource != nil {
+		row.ResourceType = event.Resource.Type.String()
+		row.ResourceName = event.Resource.Name
+	}
+	return row, nil
+}
+
+func (r *auditEventRow) toProto() (*auth.AuditEvent, error) {
+	t, err := types.TimestampProto(r.Time)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	event := &auth.AuditEvent{
+		Id:        r.ID,
+		Time:      t,
+		Principal: r.Principal,
+		Method:    r.Method,
+		Allowed:   r.Allowed,
+		Request:   r.Request,
+		Error:     r.Error,
+	}
+	if r.ResourceType != "" {
+		event.Resource = &auth.Resource{
+			Type: auth.ResourceType(auth.ResourceType_value[r.ResourceType]),
+			Name: r.ResourceName,
+		}
+	}
+	return event, nil
+}
+
+// RecordAuditEvent queues 'event' to be written to the audit log. If the
+// audit log can't keep up, the caller writes the event itself, so that a
+// burst of calls slows down rather than going unrecorded.
+func (a *apiServer) RecordAuditEvent(event *auth.AuditEvent) {
+	if a.auditEvents == nil {
+		return // the audit log is disabled
+	}
+	select {
+	case a.auditEvents <- event:
+	default:
+		if err := a.insertAuditEvents(a.env.BackgroundContext, []*auth.AuditEvent{event}); err != nil {
+			auditEventsDroppedMetric.Inc()
+			logrus.Errorf("error writing audit event for %q by %q: %v", event.Method, event.Principal, err)
+		}
+	}
+}
+
+// writeAuditEventsRoutine writes queued audit events to postgres in batches
+func (a *apiServer) writeAuditEventsRoutine() {
+	go func(ctx context.Context) {
+		for {
+			var batch []*auth.AuditEvent
+			select {
+			case event := <-a.auditEvents:
+				batch = append(batch, event)
+			case <-ctx.Done():
+				return
+			}
+		drain:
+			for len(batch) < auditBatchSize {
+				select {
+				case event := <-a.auditEvents:
+					batch = append(batch, event)
+				default:
+					break drain
+				}
+			}
+			if err := a.insertAuditEvents(ctx, batch); err != nil {
+				auditEventsDroppedMetric.Add(float64(len(batch)))
+				logrus.Errorf("error writing %d audit events: %v", len(batch), err)
+			}
+		}
+	}(a.env.BackgroundContext)
+}
+
+func (a *apiServer) insertAuditEvents(ctx context.Context, events []*auth.AuditEvent) error {
+	rows := make([]*auditEventRow, 0, len(events))
+	for _, event := range events {
+		row, err := newAuditEventRow(event)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	_, err := a.env.DB.NamedExecContext(ctx, `
+	INSERT INTO auth.audit_events (time, principal, method, resource_type, resource_name, allowed, request, error)
+	VALUES (:time, :principal, :method, :resource_type, :resource_name, :allowed, :request, :error)`, rows)
+	return errors.Wrapf(err, "error inserting audit events")
+}
+
+// deleteExpiredAuditEventsRoutine removes events older than the audit log's
+// retention period
+func (a *apiServer) deleteExpiredAuditEventsRoutine() {
+	go func(ctx context.Context) {
+		for {
+			cutoff := time.Now().UTC().AddDate(0, 0, -a.env.Config.AuditLogRetentionDays)
+			if _, err := a.env.DB.ExecContext(ctx, `DELETE FROM auth.audit_events WHERE time < $1`, cutoff); err != nil {
+				logrus.Errorf("error deleting expired audit events: %v", err)
+			}
+			select {
+			case <-time.After(time.Duration(cleanupIntervalHours) * time.Hour):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}(a.env.BackgroundContext)
+}
+
+// ListAuditEvents implements the protobuf auth.ListAuditEvents RPC
+func (a *apiServer) ListAuditEvents(ctx context.Context, req *auth.ListAuditEventsRequest) (resp *auth.ListAuditEventsResponse, retErr error) {
+	var conds []string
+	var args []interface{}
+	where := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if req.Since != nil {
+		since, err := types.TimestampFromProto(req.Since)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		where("time >= $%d", since.UTC())
+	}
+	if req.Until != nil {
+		until, err := types.TimestampFromProto(req.Until)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		where("time < $%d", until.UTC())
+	}
+	if req.Principal != "" {
+		where("principal = $%d", req.Principal)
+	}
+	if req.Resource != nil {
+		where("resource_type = $%d", req.Resource.Type.String())
+		if req.Resource.Name != "" {
+			where("resource_name = $%d", req.Resource.Name)
+		}
+	}
+	if req.DeniedOnly {
+		conds = append(conds, "NOT allowed")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultAuditEventsLimit
+	}
+
+	query := `SELECT id, time, principal, method, resource_type, resource_name, allowed, request, error FROM auth.audit_events`
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY time DESC, id DESC LIMIT $%d", len(args))
+
+	var rows []*auditEventRow
+	if err := a.env.DB.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "error querying audit events")
+	}
+	resp = &auth.ListAuditEventsResponse{}
+	for _, row := range rows {
+		event, err := row.toProto()
+		if err != nil {
+			return nil, err
+		}
+		resp.Events = append(resp.Events, event)
+	}
+	return resp, nil
+}
@@ -11,6 +11,7 @@ const (
 	roleBindingsCollectionName = "role_bindings"
 	membersCollectionName      = "members"
 	groupsCollectionName       = "groups"
+	rolesCollectionName        = "roles"
 )
 
 var authConfigIndexes = []*col.Index{}
@@ -61,6 +62,18 @@ func groupsCollection(db *pachsql.DB, listener col.PostgresListener) col.Postgre
 	)
 }
 
+var rolesIndexes = []*col.Index{}
+
+func rolesCollection(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		rolesCollectionName,
+		db,
+		listener,
+		&auth.Role{},
+		rolesIndexes,
+	)
+}
+
 // CollectionsV0 returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -74,3 +87,10 @@ func CollectionsV0() []col.PostgresCollection {
 		col.NewPostgresCollection(groupsCollectionName, nil, nil, nil, groupsIndexes),
 	}
 }
+
+// RolesCollectionV0 returns the custom roles collection for
+// postgres-initialization purposes. This collection is not usable for
+// querying.
+func RolesCollectionV0() col.PostgresCollection {
+	return col.NewPostgresCollection(rolesCollectionName, nil, nil, nil, rolesIndexes)
+}
@@ -10,6 +10,8 @@ import (
 
 type groupLookupFn func(txnCtx *txncontext.TransactionContext, subject string) ([]string, error)
 
+type roleLookupFn func(txnCtx *txncontext.TransactionContext, name string) (*internalRole, error)
+
 // authorizeRequest is a helper struct used to evaluate an incoming Authorize request.
 // It's initialized with the subject and set of permissions required for an Operation,
 // and it looks at role bindings to figure out which permissions are satisfied.
@@ -22,15 +24,17 @@ type authorizeRequest struct {
 	roleMap              map[string]*auth.Role
 	satisfiedPermissions []auth.Permission
 	groupsForSubject     groupLookupFn
+	getRole              roleLookupFn
 	groups               []string
 }
 
-func newAuthorizeRequest(subject string, permissions map[auth.Permission]bool, groupsForSubject groupLookupFn) *authorizeRequest {
+func newAuthorizeRequest(subject string, permissions map[auth.Permission]bool, groupsForSubject groupLookupFn, getRole roleLookupFn) *authorizeRequest {
 	return &authorizeRequest{
 		subject:              subject,
 		roleMap:              make(map[string]*auth.Role),
 		permissions:          permissions,
 		groupsForSubject:     groupsForSubject,
+		getRole:              getRole,
 		satisfiedPermissions: make([]auth.Permission, 0),
 	}
 }
@@ -63,13 +67,27 @@ func (r *authorizeRequest) missing() []auth.Permission {
 	return missing
 }
 
+// restrict moves the satisfied permissions for which 'allowed' returns false
+// back to the set of missing permissions
+func (r *authorizeRequest) restrict(allowed func(auth.Permission) bool) {
+	satisfied := make([]auth.Permission, 0, len(r.satisfiedPermissions))
+	for _, p := range r.satisfiedPermissions {
+		if allowed(p) {
+			satisfied = append(satisfied, p)
+		} else {
+			r.permissions[p] = true
+		}
+	}
+	r.satisfiedPermissions = satisfied
+}
+
 // evaluateRoleBinding removes permissions that are satisfied by the role binding from the
 // set of desired permissions. A subject derives permissions from:
 // - role bindings that refer to them by name
 // - role bindings that refer to allClusterUsers
 // - role bindings that refer to any group the subject belongs to
 func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionContext, binding *auth.RoleBinding) error {
-	if err := r.evaluateRoleBindingForSubject(r.subject, binding); err != nil {
+	if err := r.evaluateRoleBindingForSubject(txnCtx, r.subject, binding); err != nil {
 		return err
 	}
 
@@ -77,7 +95,7 @@ func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionCon
 		return nil
 	}
 
-	if err := r.evaluateRoleBindingForSubject(auth.AllClusterUsersSubject, binding); err != nil {
+	if err := r.evaluateRoleBindingForSubject(txnCtx, auth.AllClusterUsersSubject, binding); err != nil {
 		return err
 	}
 
@@ -97,7 +115,7 @@ func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionCon
 	}
 
 	for _, g := range r.groups {
-		if err := r.evaluateRoleBindingForSubject(g, binding); err != nil {
+		if err := r.evaluateRoleBindingForSubject(txnCtx, g, binding); err != nil {
 			return err
 		}
 	}
@@ -105,7 +123,7 @@ func (r *authorizeRequest) evaluateRoleBinding(txnCtx *txncontext.TransactionCon
 	return nil
 }
 
-func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding *auth.RoleBinding) error {
+func (r *authorizeRequest) evaluateRoleBindingForSubject(txnCtx *txncontext.TransactionContext, subject string, binding *auth.RoleBinding) error {
 	if binding.Entries == nil {
 		return nil
 	}
@@ -117,7 +135,7 @@ func (r *authorizeRequest) evaluateRoleBindingForSubject(subject string, binding
 				continue
 			}
 
-			roleDefinition, err := getRole(role)
+			roleDefinition, err := r.getRole(txnCtx, role)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,956 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	logrus "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/identity"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	identity_server "github.com/pachyderm/pachyderm/v2/src/server/identity"
+)
+
+// encryptedSecretPrefix marks secrets in an exported config that are
+// encrypted, as opposed to secrets that have been set by hand
+const encryptedSecretPrefix = "encrypted:"
+
+// errDryRun is returned from the import transaction to roll it back in dry
+// run mode
+var errDryRun = errors.New("dry run")
+
+// configKeyPair is the key pair that secrets in an exported config are
+// encrypted to
+type configKeyPair struct {
+	public, private *[32]byte
+}
+
+func decodeConfigKey(s string) (*[32]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if len(b) != 32 {
+		return nil, errors.Errorf("key must be 32 bytes long, not %d", len(b))
+	}
+	var key [32]byte
+	copy(key[:], b)
+	return &key, nil
+}
+
+// parseDecryptionKey parses a base64-encoded curve25519 private key, and
+// derives the public key that goes with it
+func parseDecryptionKey(s string) (*configKeyPair, error) {
+	private, err := decodeConfigKey(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid decryption key")
+	}
+	public, err := curve25519.X25519(private[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	keys := &configKeyPair{private: private, public: &[32]byte{}}
+	copy(keys.public[:], public)
+	return keys, nil
+}
+
+// exportSecret returns the value that the secret 's' is exported as. Secrets
+// are encrypted to 'key' if it's set, and omitted otherwise.
+func exportSecret(s string, key *[32]byte) (string, error) {
+	if s == "" || key == nil {
+		return "", nil
+	}
+	sealed, err := box.SealAnonymous(nil, []byte(s), key, rand.Reader)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return encryptedSecretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// importSecret returns the value of the secret 's' from an imported config.
+// Secrets that were omitted from the export keep their current value,
+// 'current', and encrypted secrets are decrypted with 'keys'.
+func importSecret(s, current string, keys *configKeyPair) (string, error) {
+	if s == "" {
+		return current, nil
+	}
+	if !strings.HasPrefix(s, encryptedSecretPrefix) {
+		return s, nil
+	}
+	if keys == nil {
+		return "", errors.Errorf("the config contains encrypted secrets, but no decryption key was given")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, encryptedSecretPrefix))
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid encrypted secret")
+	}
+	plain, ok := box.OpenAnonymous(nil, sealed, keys.public, keys.private)
+	if !ok {
+		return "", errors.Errorf("could not decrypt secret, it was encrypted to a different key")
+	}
+	return string(plain), nil
+}
+
+// isSecretField reports whether a field of an IDP connector's JSON config
+// holds a secret, e.g. "clientSecret" or "bindPW"
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	return strings.Contains(name, "secret") || strings.Contains(name, "password") || name == "bindpw"
+}
+
+// mapConnectorSecrets replaces each secret in an IDP connector's JSON config
+// with the result of 'f', which is passed the secret's path in the config
+func mapConnectorSecrets(config string, f func(path, secret string) (string, error)) (string, error) {
+	if config == "" || config == "null" {
+		return config, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(config), &value); err != nil {
+		return "", errors.Wrapf(err, "invalid connector config")
+	}
+	var walk func(path string, v interface{}) (interface{}, error)
+	walk = func(path string, v interface{}) (interface{}, error) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				childPath := strings.TrimPrefix(path+"."+k, ".")
+				if s, ok := child.(string); ok && isSecretField(k) {
+					secret, err := f(childPath, s)
+					if err != nil {
+						return nil, err
+					}
+					v[k] = secret
+					continue
+				}
+				mapped, err := walk(childPath, child)
+				if err != nil {
+					return nil, err
+				}
+				v[k] = mapped
+			}
+		case []interface{}:
+			for i, child := range v {
+				mapped, err := walk(fmt.Sprintf("%s[%d]", path, i), child)
+				if err != nil {
+					return nil, err
+				}
+				v[i] = mapped
+			}
+		}
+		return v, nil
+	}
+	value, err := walk("", value)
+	if err != nil {
+		return "", err
+	}
+	result, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return string(result), nil
+}
+
+// connectorSecrets returns the secrets in an IDP connector's JSON config,
+// keyed by their path in it
+func connectorSecrets(config string) (map[string]string, error) {
+	secrets := make(map[string]string)
+	_, err := mapConnectorSecrets(config, func(path, secret string) (string, error) {
+		secrets[path] = secret
+		return secret, nil
+	})
+	return secrets, err
+}
+
+// jsonEqual reports whether two JSON documents have the same contents,
+// regardless of formatting
+func jsonEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var av, bv interface{}
+	if json.Unmarshal([]byte(a), &av) != nil || json.Unmarshal([]byte(b), &bv) != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// mapSecrets replaces each secret in 'config' with the result of 'f'
+func mapSecrets(config *auth.ClusterAuthConfig, f func(string) (string, error)) error {
+	var err error
+	if config.AuthConfig != nil {
+		if config.AuthConfig.ClientSecret, err = f(config.AuthConfig.ClientSecret); err != nil {
+			return err
+		}
+	}
+	for _, c := range config.IdpConnectors {
+		if c.JsonConfig, err = mapConnectorSecrets(c.JsonConfig, func(_, secret string) (string, error) {
+			return f(secret)
+		}); err != nil {
+			return errors.Wrapf(err, "connector %q", c.Id)
+		}
+	}
+	for _, c := range config.OidcClients {
+		if c.Secret, err = f(c.Secret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isManagedPrincipal reports whether role bindings for 'principal' are managed
+// by pachd, rather than by users. They're left out of exported configs, and
+// left as they are by imports.
+func isManagedPrincipal(principal string) bool {
+	return strings.HasPrefix(principal, auth.PipelinePrefix) ||
+		strings.HasPrefix(principal, auth.PachPrefix) ||
+		strings.HasPrefix(principal, auth.InternalPrefix)
+}
+
+// filterBinding returns a copy of 'binding', including its branch, path and
+// pipeline bindings, with only the entries for principals that 'keep'
+// returns true for. Nested bindings that end up empty are dropped.
+func filterBinding(binding *auth.RoleBinding, keep func(string) bool) *auth.RoleBinding {
+	result := &auth.RoleBinding{Entries: make(map[string]*auth.Roles)}
+	if binding == nil {
+		return result
+	}
+	for principal, roles := range binding.Entries {
+		if keep(principal) {
+			result.Entries[principal] = proto.Clone(roles).(*auth.Roles)
+		}
+	}
+	nested := func(b *auth.RoleBinding) *auth.RoleBinding {
+		filtered := filterBinding(b, keep)
+		if len(filtered.Entries) == 0 && len(filtered.Branches) == 0 && len(filtered.Paths) == 0 && filtered.Pipeline == nil {
+			return nil
+		}
+		return filtered
+	}
+	for pattern, b := range binding.Branches {
+		if filtered := nested(b); filtered != nil {
+			if result.Branches == nil {
+				result.Branches = make(map[string]*auth.RoleBinding)
+			}
+			result.Branches[pattern] = filtered
+		}
+	}
+	for prefix, b := range binding.Paths {
+		if filtered := nested(b); filtered != nil {
+			if result.Paths == nil {
+				result.Paths = make(map[string]*auth.RoleBinding)
+			}
+			result.Paths[prefix] = filtered
+		}
+	}
+	if binding.Pipeline != nil {
+		result.Pipeline = nested(binding.Pipeline)
+	}
+	return result
+}
+
+// mergeBinding adds the entries in 'src', including its branch, path and
+// pipeline bindings, to 'dst'
+func mergeBinding(dst, src *auth.RoleBinding) {
+	if dst.Entries == nil {
+		dst.Entries = make(map[string]*auth.Roles)
+	}
+	for principal, roles := range src.Entries {
+		dst.Entries[principal] = roles
+	}
+	mergeNested := func(dst map[string]*auth.RoleBinding, src map[string]*auth.RoleBinding) map[string]*auth.RoleBinding {
+		for k, b := range src {
+			if dst == nil {
+				dst = make(map[string]*auth.RoleBinding)
+			}
+			if dst[k] == nil {
+				dst[k] = &auth.RoleBinding{}
+			}
+			mergeBinding(dst[k], b)
+		}
+		return dst
+	}
+	dst.Branches = mergeNested(dst.Branches, src.Branches)
+	dst.Paths = mergeNested(dst.Paths, src.Paths)
+	if src.Pipeline != nil {
+		if dst.Pipeline == nil {
+			dst.Pipeline = &auth.RoleBinding{}
+		}
+		mergeBinding(dst.Pipeline, src.Pipeline)
+	}
+}
+
+// resourceFromKey is the inverse of resourceKey
+func resourceFromKey(key string) (*auth.Resource, error) {
+	parts := strings.SplitN(key, ":", 2)
+	rt, ok := auth.ResourceType_value[parts[0]]
+	if len(parts) != 2 || !ok {
+		return nil, errors.Errorf("invalid role binding key %q", key)
+	}
+	return &auth.Resource{Type: auth.ResourceType(rt), Name: parts[1]}, nil
+}
+
+func resourceString(r *auth.Resource) string {
+	if r.Type == auth.ResourceType_CLUSTER {
+		return r.Type.String()
+	}
+	return fmt.Sprintf("%v %s", r.Type, r.Name)
+}
+
+// identityServer returns the identity server running in this pachd, or nil if
+// there isn't one
+func (a *apiServer) identityServer() identity.APIServer {
+	if a.env.GetIdentityServer == nil {
+		return nil
+	}
+	return a.env.GetIdentityServer()
+}
+
+// readClusterAuthConfig returns the cluster's current auth and identity
+// config, including its secrets and the role bindings of managed principals
+func (a *apiServer) readClusterAuthConfig(ctx context.Context) (*auth.ClusterAuthConfig, error) {
+	config := &auth.ClusterAuthConfig{}
+
+	var authConfig auth.OIDCConfig
+	if err := a.authConfig.ReadOnly(ctx).Get(configKey, &authConfig); err != nil && !col.IsErrNotFound(err) {
+		return nil, errors.EnsureStack(err)
+	}
+	config.AuthConfig = &authConfig
+
+	roles, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config.Roles = roles
+
+	var users auth.Users
+	if err := a.groups.ReadOnly(ctx).List(&users, col.DefaultOptions(), func(group string) error {
+		if len(users.Usernames) == 0 {
+			return nil
+		}
+		usernames := setToList(users.Usernames)
+		sort.Strings(usernames)
+		config.Groups = append(config.Groups, &auth.GroupMembers{Group: group, Usernames: usernames})
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	sort.Slice(config.Groups, func(i, j int) bool { return config.Groups[i].Group < config.Groups[j].Group })
+
+	var binding auth.RoleBinding
+	if err := a.roleBindings.ReadOnly(ctx).List(&binding, col.DefaultOptions(), func(key string) error {
+		resource, err := resourceFromKey(key)
+		if err != nil {
+			return err
+		}
+		config.RoleBindings = append(config.RoleBindings, &auth.ResourceRoleBinding{
+			Resource: resource,
+			Binding:  filterBinding(&binding, func(string) bool { return true }),
+		})
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	sort.Slice(config.RoleBindings, func(i, j int) bool {
+		return resourceKey(config.RoleBindings[i].Resource) < resourceKey(config.RoleBindings[j].Resource)
+	})
+
+	idServer := a.identityServer()
+	if idServer == nil {
+		return config, nil
+	}
+	serverConfig, err := idServer.GetIdentityServerConfig(ctx, &identity.GetIdentityServerConfigRequest{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	config.IdentityServerConfig = serverConfig.Config
+	if config.IdentityServerConfig == nil {
+		config.IdentityServerConfig = &identity.IdentityServerConfig{}
+	}
+	connectors, err := idServer.ListIDPConnectors(ctx, &identity.ListIDPConnectorsRequest{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	config.IdpConnectors = connectors.Connectors
+	sort.Slice(config.IdpConnectors, func(i, j int) bool { return config.IdpConnectors[i].Id < config.IdpConnectors[j].Id })
+	clients, err := idServer.ListOIDCClients(ctx, &identity.ListOIDCClientsRequest{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	config.OidcClients = clients.Clients
+	sort.Slice(config.OidcClients, func(i, j int) bool { return config.OidcClients[i].Id < config.OidcClients[j].Id })
+	return config, nil
+}
+
+// ExportConfig implements the protobuf auth.ExportConfig RPC
+func (a *apiServer) ExportConfig(ctx context.Context, req *auth.ExportConfigRequest) (resp *auth.ExportConfigResponse, retErr error) {
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	var key *[32]byte
+	if req.EncryptionKey != "" {
+		var err error
+		if key, err = decodeConfigKey(req.EncryptionKey); err != nil {
+			return nil, errors.Wrapf(err, "invalid encryption key")
+		}
+	}
+	config, err := a.readClusterAuthConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rb := range config.RoleBindings {
+		rb.Binding = filterBinding(rb.Binding, func(principal string) bool { return !isManagedPrincipal(principal) })
+	}
+	// connector versions are specific to each cluster, so they'd make exports
+	// of identical configs differ
+	for _, c := range config.IdpConnectors {
+		c.ConfigVersion = 0
+	}
+	if err := mapSecrets(config, func(secret string) (string, error) {
+		return exportSecret(secret, key)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.ExportConfigResponse{Config: config}, nil
+}
+
+// configChange is a change to one item of the cluster's configuration. Auth
+// changes are applied in a single transaction, whereas identity changes are
+// applied one at a time, and undone if a later change fails.
+type configChange struct {
+	*auth.ConfigChange
+	apply         func(txnCtx *txncontext.TransactionContext) error
+	applyIdentity func(ctx context.Context) (undo func(context.Context) error, err error)
+}
+
+func newConfigChange(kind, name string, op auth.ConfigChangeOp) configChange {
+	return configChange{ConfigChange: &auth.ConfigChange{Kind: kind, Name: name, Op: op}}
+}
+
+// ImportConfig implements the protobuf auth.ImportConfig RPC
+func (a *apiServer) ImportConfig(ctx context.Context, req *auth.ImportConfigRequest) (resp *auth.ImportConfigResponse, retErr error) {
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	if req.Config == nil {
+		return nil, errors.Errorf("config must be set")
+	}
+	var keys *configKeyPair
+	if req.DecryptionKey != "" {
+		var err error
+		if keys, err = parseDecryptionKey(req.DecryptionKey); err != nil {
+			return nil, err
+		}
+	}
+	live, err := a.readClusterAuthConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	authChanges, err := a.diffAuthConfig(ctx, live, req.Config, keys)
+	if err != nil {
+		return nil, err
+	}
+	identityChanges, err := a.diffIdentityConfig(live, req.Config, keys)
+	if err != nil {
+		return nil, err
+	}
+	resp = &auth.ImportConfigResponse{}
+	for _, c := range append(identityChanges, authChanges...) {
+		resp.Changes = append(resp.Changes, c.ConfigChange)
+	}
+	// the auth changes are made, and rolled back, to validate them before
+	// anything is changed
+	if err := a.applyAuthChanges(ctx, authChanges, true); err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	// identity changes are made next, as they can be undone if the auth
+	// transaction fails, but not the other way round
+	var undos []func(context.Context) error
+	rollback := func(err error) error {
+		// the changes are undone even if the request has been cancelled
+		for i := len(undos) - 1; i >= 0; i-- {
+			if undoErr := undos[i](a.env.BackgroundContext); undoErr != nil {
+				logrus.Errorf("could not roll back identity config change: %v", undoErr)
+			}
+		}
+		return err
+	}
+	for _, c := range identityChanges {
+		if c.applyIdentity == nil {
+			continue
+		}
+		undo, err := c.applyIdentity(ctx)
+		if err != nil {
+			return nil, rollback(errors.Wrapf(err, "error applying change to %s %q", c.Kind, c.Name))
+		}
+		undos = append(undos, undo)
+	}
+	if err := a.applyAuthChanges(ctx, authChanges, false); err != nil {
+		return nil, rollback(err)
+	}
+	return resp, nil
+}
+
+// applyAuthChanges applies 'changes' in a single transaction, which is rolled
+// back if 'dryRun' is set. If the auth config is changed, it blocks until the
+// new config is in effect.
+func (a *apiServer) applyAuthChanges(ctx context.Context, changes []configChange, dryRun bool) error {
+	var newConfig *auth.OIDCConfig
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		for _, c := range changes {
+			if c.apply == nil {
+				continue
+			}
+			if err := c.apply(txnCtx); err != nil {
+				return errors.Wrapf(err, "error applying change to %s %q", c.Kind, c.Name)
+			}
+		}
+		if dryRun {
+			return errDryRun
+		}
+		var config auth.OIDCConfig
+		if err := a.authConfig.ReadWrite(txnCtx.SqlTx).Get(configKey, &config); err != nil && !col.IsErrNotFound(err) {
+			return errors.EnsureStack(err)
+		}
+		newConfig = &config
+		return nil
+	}); err != nil && !errors.Is(err, errDryRun) {
+		return err
+	}
+	if dryRun || !a.watchesEnabled {
+		return nil
+	}
+	for _, c := range changes {
+		if c.Kind == "auth_config" && c.Op == auth.ConfigChangeOp_CONFIG_UPDATE {
+			return a.waitForConfig(newConfig)
+		}
+	}
+	return nil
+}
+
+// diffAuthConfig returns the changes needed to make the cluster's auth config
+// match 'config'. Custom roles are created and updated before they're bound,
+// and deleted after they're unbound.
+func (a *apiServer) diffAuthConfig(ctx context.Context, live, config *auth.ClusterAuthConfig, keys *configKeyPair) ([]configChange, error) {
+	var changes, roleDeletes []configChange
+
+	liveRoles := make(map[string]*auth.Role)
+	for _, r := range live.Roles {
+		liveRoles[r.Name] = r
+	}
+	wantRoles := make(map[string]bool)
+	for _, r := range config.Roles {
+		role := proto.Clone(r).(*auth.Role)
+		role.Custom = true
+		if len(role.ResourceTypes) == 0 {
+			role.ResourceTypes = defaultResourceTypes(role.Permissions)
+		}
+		if err := validateCustomRole(role); err != nil {
+			return nil, err
+		}
+		wantRoles[role.Name] = true
+		op := auth.ConfigChangeOp_CONFIG_CREATE
+		if cur, ok := liveRoles[role.Name]; ok {
+			if proto.Equal(cur, role) {
+				continue
+			}
+			op = auth.ConfigChangeOp_CONFIG_UPDATE
+		}
+		c := newConfigChange("role", role.Name, op)
+		c.apply = func(txnCtx *txncontext.TransactionContext) error {
+			return errors.EnsureStack(a.customRoles.ReadWrite(txnCtx.SqlTx).Put(role.Name, role))
+		}
+		changes = append(changes, c)
+	}
+	for _, r := range live.Roles {
+		if wantRoles[r.Name] {
+			continue
+		}
+		name := r.Name
+		c := newConfigChange("role", name, auth.ConfigChangeOp_CONFIG_DELETE)
+		c.apply = func(txnCtx *txncontext.TransactionContext) error {
+			return errors.EnsureStack(a.customRoles.ReadWrite(txnCtx.SqlTx).Delete(name))
+		}
+		roleDeletes = append(roleDeletes, c)
+	}
+
+	liveGroups := make(map[string]map[string]bool)
+	for _, g := range live.Groups {
+		liveGroups[g.Group] = addToSet(nil, g.Usernames...)
+	}
+	wantGroups := make(map[string]bool)
+	for _, g := range config.Groups {
+		// groups without members don't exist
+		if len(g.Usernames) == 0 {
+			continue
+		}
+		if err := a.checkCanonicalSubjects(g.Usernames); err != nil {
+			return nil, errors.Wrapf(err, "group %q", g.Group)
+		}
+		wantGroups[g.Group] = true
+		want := addToSet(nil, g.Usernames...)
+		op := auth.ConfigChangeOp_CONFIG_CREATE
+		if cur, ok := liveGroups[g.Group]; ok {
+			if reflect.DeepEqual(cur, want) {
+				continue
+			}
+			op = auth.ConfigChangeOp_CONFIG_UPDATE
+		}
+		changes = append(changes, a.groupChange(g.Group, op, liveGroups[g.Group], want))
+	}
+	for _, g := range live.Groups {
+		if !wantGroups[g.Group] {
+			changes = append(changes, a.groupChange(g.Group, auth.ConfigChangeOp_CONFIG_DELETE, liveGroups[g.Group], nil))
+		}
+	}
+
+	liveBindings := make(map[string]*auth.RoleBinding)
+	for _, rb := range live.RoleBindings {
+		liveBindings[resourceKey(rb.Resource)] = rb.Binding
+	}
+	wantBindings := make(map[string]bool)
+	for _, rb := range config.RoleBindings {
+		if rb.Resource == nil {
+			return nil, errors.Errorf("role binding has no resource")
+		}
+		resource := rb.Resource
+		key := resourceKey(resource)
+		wantBindings[key] = true
+		cur, ok := liveBindings[key]
+		if !ok && resource.Type != auth.ResourceType_CLUSTER {
+			c := newConfigChange("role_binding", resourceString(resource), auth.ConfigChangeOp_CONFIG_SKIP)
+			c.Reason = fmt.Sprintf("%v %q doesn't exist", resource.Type, resource.Name)
+			changes = append(changes, c)
+			continue
+		}
+		// bindings for managed principals are kept as they are
+		want := filterBinding(rb.Binding, func(principal string) bool { return !isManagedPrincipal(principal) })
+		mergeBinding(want, filterBinding(cur, isManagedPrincipal))
+		if proto.Equal(want, filterBinding(cur, func(string) bool { return true })) {
+			continue
+		}
+		c := newConfigChange("role_binding", resourceString(resource), auth.ConfigChangeOp_CONFIG_UPDATE)
+		c.apply = func(txnCtx *txncontext.TransactionContext) error {
+			if err := a.validateBindingInTransaction(txnCtx, want, resource.Type); err != nil {
+				return err
+			}
+			return errors.EnsureStack(a.roleBindings.ReadWrite(txnCtx.SqlTx).Put(key, want))
+		}
+		changes = append(changes, c)
+	}
+	for _, rb := range live.RoleBindings {
+		key := resourceKey(rb.Resource)
+		if wantBindings[key] {
+			continue
+		}
+		// the resource keeps its binding, as resources are expected to have
+		// one, but only with the entries for managed principals
+		want := filterBinding(rb.Binding, isManagedPrincipal)
+		if proto.Equal(want, filterBinding(rb.Binding, func(string) bool { return true })) {
+			continue
+		}
+		c := newConfigChange("role_binding", resourceString(rb.Resource), auth.ConfigChangeOp_CONFIG_DELETE)
+		c.apply = func(txnCtx *txncontext.TransactionContext) error {
+			return errors.EnsureStack(a.roleBindings.ReadWrite(txnCtx.SqlTx).Put(key, want))
+		}
+		changes = append(changes, c)
+	}
+	changes = append(changes, roleDeletes...)
+
+	if config.AuthConfig != nil {
+		want := proto.Clone(config.AuthConfig).(*auth.OIDCConfig)
+		var err error
+		if want.ClientSecret, err = importSecret(want.ClientSecret, live.AuthConfig.ClientSecret, keys); err != nil {
+			return nil, errors.Wrapf(err, "auth config")
+		}
+		if !proto.Equal(want, live.AuthConfig) {
+			if !a.watchesEnabled {
+				return nil, errors.New("watches are not enabled, unable to set config")
+			}
+			if err := validateOIDCConfig(ctx, want); err != nil {
+				return nil, err
+			}
+			c := newConfigChange("auth_config", configKey, auth.ConfigChangeOp_CONFIG_UPDATE)
+			c.apply = func(txnCtx *txncontext.TransactionContext) error {
+				return errors.EnsureStack(a.authConfig.ReadWrite(txnCtx.SqlTx).Put(configKey, want))
+			}
+			changes = append(changes, c)
+		}
+	}
+	return changes, nil
+}
+
+// groupChange returns the change that sets the members of 'group' from 'cur'
+// to 'want', in both the groups and the members collections
+func (a *apiServer) groupChange(group string, op auth.ConfigChangeOp, cur, want map[string]bool) configChange {
+	c := newConfigChange("group", group, op)
+	c.apply = func(txnCtx *txncontext.TransactionContext) error {
+		members := a.members.ReadWrite(txnCtx.SqlTx)
+		var groupsProto auth.Groups
+		for username := range cur {
+			if want[username] {
+				continue
+			}
+			if err := members.Upsert(username, &groupsProto, func() error {
+				groupsProto.Groups = removeFromSet(groupsProto.Groups, group)
+				return nil
+			}); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		for username := range want {
+			if err := members.Upsert(username, &groupsProto, func() error {
+				groupsProto.Groups = addToSet(groupsProto.Groups, group)
+				return nil
+			}); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		groups := a.groups.ReadWrite(txnCtx.SqlTx)
+		if len(want) == 0 {
+			return errors.EnsureStack(groups.Delete(group))
+		}
+		return errors.EnsureStack(groups.Put(group, &auth.Users{Usernames: want}))
+	}
+	return c
+}
+
+// validateBindingInTransaction checks that the principals in 'binding', and
+// its nested bindings, are canonical, and that their roles exist and apply to
+// the bound resources
+func (a *apiServer) validateBindingInTransaction(txnCtx *txncontext.TransactionContext, binding *auth.RoleBinding, rt auth.ResourceType) error {
+	for principal, roles := range binding.Entries {
+		if isManagedPrincipal(principal) {
+			continue
+		}
+		if err := a.checkCanonicalSubject(principal); err != nil {
+			return err
+		}
+		for r := range roles.Roles {
+			role, err := a.getRoleInTransaction(txnCtx, r)
+			if err != nil {
+				return err
+			}
+			if rt != auth.ResourceType_CLUSTER && rt != auth.ResourceType_REPO && !roleAppliesToResource(role.role, rt) {
+				return errors.Errorf("role %q cannot be granted on resources of type %v", r, rt)
+			}
+		}
+	}
+	for _, b := range binding.Branches {
+		if err := a.validateBindingInTransaction(txnCtx, b, auth.ResourceType_BRANCH); err != nil {
+			return err
+		}
+	}
+	for _, b := range binding.Paths {
+		if err := a.validateBindingInTransaction(txnCtx, b, auth.ResourceType_PATH); err != nil {
+			return err
+		}
+	}
+	if binding.Pipeline != nil {
+		return a.validateBindingInTransaction(txnCtx, binding.Pipeline, auth.ResourceType_PIPELINE)
+	}
+	return nil
+}
+
+// diffIdentityConfig returns the changes needed to make the cluster's
+// identity config match 'config'. The identity config is left alone if it
+// isn't included in 'config'.
+func (a *apiServer) diffIdentityConfig(live, config *auth.ClusterAuthConfig, keys *configKeyPair) ([]configChange, error) {
+	if config.IdentityServerConfig == nil {
+		return nil, nil
+	}
+	idServer := a.identityServer()
+	if idServer == nil {
+		return nil, errors.New("this pachd has no identity server, so its identity config can't be imported")
+	}
+	var changes []configChange
+
+	if want := config.IdentityServerConfig; !proto.Equal(want, live.IdentityServerConfig) {
+		prev := live.IdentityServerConfig
+		c := newConfigChange("identity_server_config", "", auth.ConfigChangeOp_CONFIG_UPDATE)
+		c.applyIdentity = func(ctx context.Context) (func(context.Context) error, error) {
+			if _, err := idServer.SetIdentityServerConfig(ctx, &identity.SetIdentityServerConfigRequest{Config: want}); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return func(ctx context.Context) error {
+				_, err := idServer.SetIdentityServerConfig(ctx, &identity.SetIdentityServerConfigRequest{Config: prev})
+				return errors.EnsureStack(err)
+			}, nil
+		}
+		changes = append(changes, c)
+	}
+
+	liveConnectors := make(map[string]*identity.IDPConnector)
+	for _, c := range live.IdpConnectors {
+		liveConnectors[c.Id] = c
+	}
+	wantConnectors := make(map[string]bool)
+	for _, conn := range config.IdpConnectors {
+		want := proto.Clone(conn).(*identity.IDPConnector)
+		wantConnectors[want.Id] = true
+		cur, ok := liveConnectors[want.Id]
+		liveSecrets := make(map[string]string)
+		if ok {
+			var err error
+			if liveSecrets, err = connectorSecrets(cur.JsonConfig); err != nil {
+				return nil, errors.Wrapf(err, "connector %q", cur.Id)
+			}
+		}
+		var err error
+		if want.JsonConfig, err = mapConnectorSecrets(want.JsonConfig, func(path, secret string) (string, error) {
+			return importSecret(secret, liveSecrets[path], keys)
+		}); err != nil {
+			return nil, errors.Wrapf(err, "connector %q", want.Id)
+		}
+		if err := identity_server.ValidateIDPConnector(want, logrus.WithField("source", "config-import")); err != nil {
+			return nil, errors.Wrapf(err, "connector %q", want.Id)
+		}
+		if !ok {
+			c := newConfigChange("idp_connector", want.Id, auth.ConfigChangeOp_CONFIG_CREATE)
+			c.applyIdentity = func(ctx context.Context) (func(context.Context) error, error) {
+				if _, err := idServer.CreateIDPConnector(ctx, &identity.CreateIDPConnectorRequest{Connector: want}); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+				return func(ctx context.Context) error {
+					_, err := idServer.DeleteIDPConnector(ctx, &identity.DeleteIDPConnectorRequest{Id: want.Id})
+					return errors.EnsureStack(err)
+				}, nil
+			}
+			changes = append(changes, c)
+			continue
+		}
+		if want.Name == cur.Name && want.Type == cur.Type && jsonEqual(want.JsonConfig, cur.JsonConfig) {
+			continue
+		}
+		c := newConfigChange("idp_connector", want.Id, auth.ConfigChangeOp_CONFIG_UPDATE)
+		c.applyIdentity = func(ctx context.Context) (func(context.Context) error, error) {
+			want.ConfigVersion = cur.ConfigVersion + 1
+			if _, err := idServer.UpdateIDPConnector(ctx, &identity.UpdateIDPConnectorRequest{Connector: want}); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return func(ctx context.Context) error {
+				prev := proto.Clone(cur).(*identity.IDPConnector)
+				prev.ConfigVersion = want.ConfigVersion + 1
+				_, err := idServer.UpdateIDPConnector(ctx, &identity.UpdateIDPConnectorRequest{Connector: prev})
+				return errors.EnsureStack(err)
+			}, nil
+		}
+		changes = append(changes, c)
+	}
+	for _, cur := range live.IdpConnectors {
+		if wantConnectors[cur.Id] {
+			continue
+		}
+		cur := cur
+		c := newConfigChange("idp_connector", cur.Id, auth.ConfigChangeOp_CONFIG_DELETE)
+		c.applyIdentity = func(ctx context.Context) (func(context.Context) error, error) {
+			if _, err := idServer.DeleteIDPConnector(ctx, &identity.DeleteIDPConnectorRequest{Id: cur.Id}); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return func(ctx context.Context) error {
+				_, err := idServer.CreateIDPConnector(ctx, &identity.CreateIDPConnectorRequest{Connector: cur})
+				return errors.EnsureStack(err)
+			}, nil
+		}
+		changes = append(changes, c)
+	}
+
+	liveClients := make(map[string]*identity.OIDCClient)
+	for _, c := range live.OidcClients {
+		liveClients[c.Id] = c
+	}
+	wantClients := make(map[string]bool)
+	for _, client := range config.OidcClients {
+		want := proto.Clone(client).(*identity.OIDCClient)
+		wantClients[want.Id] = true
+		cur, ok := liveClients[want.Id]
+		var curSecret string
+		if ok {
+			curSecret = cur.Secret
+		}
+		var err error
+		if want.Secret, err = importSecret(want.Secret, curSecret, keys); err != nil {
+			return nil, errors.Wrapf(err, "OIDC client %q", want.Id)
+		}
+		if err := identity_server.ValidateOIDCClient(want); err != nil {
+			return nil, errors.Wrapf(err, "OIDC client %q", want.Id)
+		}
+		switch {
+		case !ok:
+			c := newConfigChange("oidc_client", want.Id, auth.ConfigChangeOp_CONFIG_CREATE)
+			c.applyIdentity = a.replaceOIDCClient(idServer, nil, want)
+			changes = append(changes, c)
+		case proto.Equal(want, cur):
+		case want.Secret != cur.Secret:
+			// the secret of an existing client can't be updated, so it has
+			// to be recreated
+			c := newConfigChange("oidc_client", want.Id, auth.ConfigChangeOp_CONFIG_UPDATE)
+			c.applyIdentity = a.replaceOIDCClient(idServer, cur, want)
+			changes = append(changes, c)
+		default:
+			cur := cur
+			c := newConfigChange("oidc_client", want.Id, auth.ConfigChangeOp_CONFIG_UPDATE)
+			c.applyIdentity = func(ctx context.Context) (func(context.Context) error, error) {
+				if _, err := idServer.UpdateOIDCClient(ctx, &identity.UpdateOIDCClientRequest{Client: want}); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+				return func(ctx context.Context) error {
+					_, err := idServer.UpdateOIDCClient(ctx, &identity.UpdateOIDCClientRequest{Client: cur})
+					return errors.EnsureStack(err)
+				}, nil
+			}
+			changes = append(changes, c)
+		}
+	}
+	for _, cur := range live.OidcClients {
+		if wantClients[cur.Id] {
+			continue
+		}
+		c := newConfigChange("oidc_client", cur.Id, auth.ConfigChangeOp_CONFIG_DELETE)
+		c.applyIdentity = a.replaceOIDCClient(idServer, cur, nil)
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
+
+// replaceOIDCClient returns a function that deletes the OIDC client 'cur', if
+// it's set, and creates 'want', if it's set, along with a function undoing
+// that
+func (a *apiServer) replaceOIDCClient(idServer identity.APIServer, cur, want *identity.OIDCClient) func(context.Context) (func(context.Context) error, error) {
+	replace := func(ctx context.Context, from, to *identity.OIDCClient) error {
+		if from != nil {
+			if _, err := idServer.DeleteOIDCClient(ctx, &identity.DeleteOIDCClientRequest{Id: from.Id}); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if to != nil {
+			if _, err := idServer.CreateOIDCClient(ctx, &identity.CreateOIDCClientRequest{Client: to}); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	}
+	return func(ctx context.Context) (func(context.Context) error, error) {
+		if err := replace(ctx, cur, want); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return replace(ctx, want, cur)
+		}, nil
+	}
+}
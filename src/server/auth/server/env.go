@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/pachyderm/pachyderm/v2/src/identity"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
@@ -20,6 +21,7 @@ type Env struct {
 
 	// circular dependency
 	GetEnterpriseServer func() enterprise.APIServer
+	GetIdentityServer   func() identity.APIServer
 
 	BackgroundContext context.Context
 	Logger            *logrus.Logger
@@ -34,6 +36,7 @@ func EnvFromServiceEnv(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv
 		TxnEnv:     txnEnv,
 
 		GetEnterpriseServer: senv.EnterpriseServer,
+		GetIdentityServer:   senv.IdentityServer,
 
 		BackgroundContext: senv.Context(),
 		Logger:            senv.Logger(),
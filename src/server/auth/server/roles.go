@@ -1,8 +1,12 @@
 package server
 
 import (
+	"strings"
+
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 )
 
 type internalRole struct {
@@ -12,26 +16,38 @@ type internalRole struct {
 
 var roles = make(map[string]*internalRole)
 
-func registerRole(r *auth.Role) *auth.Role {
+func newInternalRole(r *auth.Role) *internalRole {
 	permissionsIndex := make(map[auth.Permission]bool)
 
 	for _, permission := range r.Permissions {
 		permissionsIndex[permission] = true
 	}
 
-	roles[r.Name] = &internalRole{
+	return &internalRole{
 		role:             r,
 		permissionsIndex: permissionsIndex,
 	}
+}
+
+func registerRole(r *auth.Role) *auth.Role {
+	roles[r.Name] = newInternalRole(r)
 	return r
 }
 
-func getRole(name string) (*internalRole, error) {
-	r, ok := roles[name]
-	if !ok {
-		return nil, errors.Errorf("unknown role %q", name)
+// getRoleInTransaction returns the built-in role or custom role with the given
+// name.
+func (a *apiServer) getRoleInTransaction(txnCtx *txncontext.TransactionContext, name string) (*internalRole, error) {
+	if r, ok := roles[name]; ok {
+		return r, nil
 	}
-	return r, nil
+	var role auth.Role
+	if err := a.customRoles.ReadWrite(txnCtx.SqlTx).Get(name, &role); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("unknown role %q", name)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return newInternalRole(&role), nil
 }
 
 func init() {
@@ -39,7 +55,7 @@ func init() {
 	// and create pipelines that read from a repo.
 	repoReaderRole := registerRole(&auth.Role{
 		Name:          auth.RepoReaderRole,
-		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO, auth.ResourceType_BRANCH, auth.ResourceType_PATH},
 		Permissions: []auth.Permission{
 			auth.Permission_REPO_READ,
 			auth.Permission_REPO_INSPECT_COMMIT,
@@ -50,6 +66,7 @@ func init() {
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
 			auth.Permission_PIPELINE_LIST_JOB,
+			auth.Permission_PIPELINE_READ_LOGS,
 		},
 	})
 
@@ -58,13 +75,16 @@ func init() {
 	// plus all the permissions of repoReader.
 	repoWriterRole := registerRole(&auth.Role{
 		Name:          auth.RepoWriterRole,
-		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO, auth.ResourceType_BRANCH, auth.ResourceType_PATH},
 		Permissions: combinePermissions(repoReaderRole.Permissions, []auth.Permission{
 			auth.Permission_REPO_WRITE,
 			auth.Permission_REPO_DELETE_COMMIT,
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
+			auth.Permission_PIPELINE_UPDATE_SPEC,
+			auth.Permission_PIPELINE_START_STOP,
+			auth.Permission_PIPELINE_RUN_DATUM,
 		}),
 	})
 
@@ -76,9 +96,23 @@ func init() {
 		Permissions: combinePermissions(repoWriterRole.Permissions, []auth.Permission{
 			auth.Permission_REPO_MODIFY_BINDINGS,
 			auth.Permission_REPO_DELETE,
+			auth.Permission_PIPELINE_READ_SECRETS,
 		}),
 	})
 
+	// pipelineOperator has the ability to start, stop and rerun a
+	// pipeline and read its logs, without access to the data in its repos.
+	registerRole(&auth.Role{
+		Name:          auth.PipelineOperatorRole,
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_PIPELINE},
+		Permissions: []auth.Permission{
+			auth.Permission_PIPELINE_LIST_JOB,
+			auth.Permission_PIPELINE_START_STOP,
+			auth.Permission_PIPELINE_RUN_DATUM,
+			auth.Permission_PIPELINE_READ_LOGS,
+		},
+	})
+
 	// oidcAppAdmin has the ability to create, update and
 	// delete OIDC apps.
 	oidcAppAdminRole := registerRole(&auth.Role{
@@ -171,6 +205,15 @@ func init() {
 		},
 	})
 
+	// auditLogReader has the ability to read the audit log of API calls
+	auditLogReaderRole := registerRole(&auth.Role{
+		Name:          auth.AuditLogReaderRole,
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER},
+		Permissions: []auth.Permission{
+			auth.Permission_CLUSTER_AUTH_LIST_AUDIT_EVENTS,
+		},
+	})
+
 	// clusterAdmin is a catch-all role that has every permission
 	registerRole(&auth.Role{
 		Name:          auth.ClusterAdminRole,
@@ -185,6 +228,7 @@ func init() {
 			licenseAdminRole.Permissions,
 			secretAdminRole.Permissions,
 			pachdLogReaderRole.Permissions,
+			auditLogReaderRole.Permissions,
 			[]auth.Permission{
 				auth.Permission_CLUSTER_MODIFY_BINDINGS,
 				auth.Permission_CLUSTER_GET_BINDINGS,
@@ -198,6 +242,9 @@ func init() {
 				auth.Permission_CLUSTER_AUTH_EXTRACT_TOKENS,
 				auth.Permission_CLUSTER_AUTH_RESTORE_TOKEN,
 				auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN,
+				auth.Permission_CLUSTER_AUTH_MODIFY_ROLES,
+				auth.Permission_CLUSTER_AUTH_EXPORT_CONFIG,
+				auth.Permission_CLUSTER_AUTH_IMPORT_CONFIG,
 				auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS,
 				auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL,
 				auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS,
@@ -207,6 +254,9 @@ func init() {
 				auth.Permission_CLUSTER_ENTERPRISE_DEACTIVATE,
 				auth.Permission_CLUSTER_DELETE_ALL,
 				auth.Permission_CLUSTER_ENTERPRISE_PAUSE,
+				auth.Permission_CLUSTER_STORAGE_GC,
+				auth.Permission_CLUSTER_STORAGE_INSPECT,
+				auth.Permission_CLUSTER_STORAGE_SCRUB,
 			}),
 	})
 }
@@ -219,8 +269,82 @@ func combinePermissions(permissions ...[]auth.Permission) []auth.Permission {
 	return output
 }
 
-func roleAppliesToResource(r *auth.Role, rt auth.ResourceType) bool {
-	for _, t := range r.ResourceTypes {
+// permissionResourceTypes returns the types of resources on which a role
+// granting the permission may be bound.
+func permissionResourceTypes(permission auth.Permission) []auth.ResourceType {
+	name := permission.String()
+	switch {
+	case strings.HasPrefix(name, "REPO_"):
+		return []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO, auth.ResourceType_BRANCH, auth.ResourceType_PATH}
+	case strings.HasPrefix(name, "PIPELINE_"):
+		return []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO, auth.ResourceType_PIPELINE}
+	case strings.HasPrefix(name, "CLUSTER_"), strings.HasPrefix(name, "SECRET_"):
+		return []auth.ResourceType{auth.ResourceType_CLUSTER}
+	default:
+		return nil
+	}
+}
+
+// defaultResourceTypes returns the resource types that all of the given
+// permissions can be granted on.
+func defaultResourceTypes(permissions []auth.Permission) []auth.ResourceType {
+	var result []auth.ResourceType
+	for _, rt := range []auth.ResourceType{
+		auth.ResourceType_CLUSTER,
+		auth.ResourceType_REPO,
+		auth.ResourceType_BRANCH,
+		auth.ResourceType_PATH,
+		auth.ResourceType_PIPELINE,
+	} {
+		applies := true
+		for _, p := range permissions {
+			if !resourceTypesContain(permissionResourceTypes(p), rt) {
+				applies = false
+				break
+			}
+		}
+		if applies {
+			result = append(result, rt)
+		}
+	}
+	return result
+}
+
+// validateCustomRole checks that a custom role has a name that doesn't clash
+// with a built-in role, and that each of its permissions can be granted on
+// each of the resource types it applies to.
+func validateCustomRole(r *auth.Role) error {
+	if r == nil {
+		return errors.Errorf("role must be set")
+	}
+	if r.Name == "" {
+		return errors.Errorf("role must have a name")
+	}
+	if _, ok := roles[r.Name]; ok {
+		return errors.Errorf("%q is a built-in role", r.Name)
+	}
+	if len(r.Permissions) == 0 {
+		return errors.Errorf("role %q must have at least one permission", r.Name)
+	}
+	if len(r.ResourceTypes) == 0 {
+		return errors.Errorf("role %q must apply to at least one resource type", r.Name)
+	}
+	for _, p := range r.Permissions {
+		types := permissionResourceTypes(p)
+		if types == nil {
+			return errors.Errorf("unknown permission %v", p)
+		}
+		for _, rt := range r.ResourceTypes {
+			if !resourceTypesContain(types, rt) {
+				return errors.Errorf("permission %v cannot be granted on resources of type %v", p, rt)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceTypesContain(types []auth.ResourceType, rt auth.ResourceType) bool {
+	for _, t := range types {
 		if t == rt {
 			return true
 		}
@@ -228,6 +352,10 @@ func roleAppliesToResource(r *auth.Role, rt auth.ResourceType) bool {
 	return false
 }
 
+func roleAppliesToResource(r *auth.Role, rt auth.ResourceType) bool {
+	return resourceTypesContain(r.ResourceTypes, rt)
+}
+
 func rolesForPermission(permission auth.Permission) []*auth.Role {
 	resp := make([]*auth.Role, 0)
 	for _, r := range roles {
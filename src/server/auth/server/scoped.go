@@ -0,0 +1,157 @@
+package server
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	globlib "github.com/pachyderm/ohmyglob"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// scopedResource splits a BRANCH, PATH or PIPELINE resource into the repo
+// whose role binding stores it and the branch pattern or path prefix it
+// covers. Other resources are returned unchanged with an empty scope.
+func scopedResource(r *auth.Resource) (*auth.Resource, string, error) {
+	switch r.Type {
+	case auth.ResourceType_BRANCH:
+		repo, pattern, err := auth.ParseBranchResourceName(r.Name)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := globlib.Compile(pattern, '/'); err != nil {
+			return nil, "", errors.Wrapf(err, "invalid branch pattern %q", pattern)
+		}
+		return &auth.Resource{Type: auth.ResourceType_REPO, Name: repo}, pattern, nil
+	case auth.ResourceType_PATH:
+		repo, prefix, err := auth.ParsePathResourceName(r.Name)
+		if err != nil {
+			return nil, "", err
+		}
+		return &auth.Resource{Type: auth.ResourceType_REPO, Name: repo}, prefix, nil
+	case auth.ResourceType_PIPELINE:
+		// A pipeline's binding is stored with its output repo
+		return &auth.Resource{Type: auth.ResourceType_REPO, Name: r.Name}, "", nil
+	default:
+		return r, "", nil
+	}
+}
+
+// nestedBinding returns the binding nested in 'binding' for the resource of
+// type 'rt' with the given scope. If it doesn't exist, an empty binding is
+// added to 'binding' when 'create' is set, and nil is returned otherwise.
+func nestedBinding(binding *auth.RoleBinding, rt auth.ResourceType, scope string, create bool) *auth.RoleBinding {
+	if rt == auth.ResourceType_PIPELINE {
+		if binding.Pipeline == nil && create {
+			binding.Pipeline = &auth.RoleBinding{}
+		}
+		return binding.Pipeline
+	}
+	var bindings map[string]*auth.RoleBinding
+	switch rt {
+	case auth.ResourceType_BRANCH:
+		if binding.Branches == nil && create {
+			binding.Branches = make(map[string]*auth.RoleBinding)
+		}
+		bindings = binding.Branches
+	case auth.ResourceType_PATH:
+		if binding.Paths == nil && create {
+			binding.Paths = make(map[string]*auth.RoleBinding)
+		}
+		bindings = binding.Paths
+	default:
+		return nil
+	}
+	if bindings[scope] == nil && create {
+		bindings[scope] = &auth.RoleBinding{}
+	}
+	return bindings[scope]
+}
+
+// deleteNestedBinding removes the binding nested in 'binding' for the
+// resource of type 'rt' with the given scope.
+func deleteNestedBinding(binding *auth.RoleBinding, rt auth.ResourceType, scope string) {
+	switch rt {
+	case auth.ResourceType_BRANCH:
+		delete(binding.Branches, scope)
+	case auth.ResourceType_PATH:
+		delete(binding.Paths, scope)
+	case auth.ResourceType_PIPELINE:
+		binding.Pipeline = nil
+	}
+}
+
+// roleBound returns a principal that 'role' is bound to in 'binding' or the
+// bindings nested in it, and a description of the nested binding, if any.
+func roleBound(binding *auth.RoleBinding, role string) (principal, scope string, ok bool) {
+	for principal, entry := range binding.Entries {
+		if entry.Roles[role] {
+			return principal, "", true
+		}
+	}
+	for pattern, b := range binding.Branches {
+		if principal, scope, ok := roleBound(b, role); ok {
+			return principal, " branch " + strconv.Quote(pattern) + scope, true
+		}
+	}
+	for prefix, b := range binding.Paths {
+		if principal, scope, ok := roleBound(b, role); ok {
+			return principal, " path " + strconv.Quote(prefix) + scope, true
+		}
+	}
+	if binding.Pipeline != nil {
+		if principal, scope, ok := roleBound(binding.Pipeline, role); ok {
+			return principal, " pipeline" + scope, true
+		}
+	}
+	return "", "", false
+}
+
+// branchBindings returns the branch bindings in 'binding' whose pattern
+// matches 'branch'.
+func branchBindings(binding *auth.RoleBinding, branch string) []*auth.RoleBinding {
+	var result []*auth.RoleBinding
+	for pattern, b := range binding.Branches {
+		if pattern == branch {
+			result = append(result, b)
+			continue
+		}
+		g, err := globlib.Compile(pattern, '/')
+		if err != nil {
+			continue
+		}
+		if g.Match(branch) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// pathBindings returns the path bindings in 'binding' whose prefix covers
+// the file at 'p'.
+func pathBindings(binding *auth.RoleBinding, p string) []*auth.RoleBinding {
+	var result []*auth.RoleBinding
+	p = cleanPath(p)
+	for prefix, b := range binding.Paths {
+		if pathHasPrefix(p, prefix) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+func pathHasPrefix(p, prefix string) bool {
+	return p == prefix || strings.HasPrefix(p, prefix+"/")
+}
+
+// cleanPath converts a file path to the form used for path prefixes in role
+// bindings, with a leading slash and without a trailing one.
+func cleanPath(p string) string {
+	p = path.Clean(p)
+	if p == "." {
+		return "/"
+	}
+	return "/" + strings.Trim(p, "/")
+}
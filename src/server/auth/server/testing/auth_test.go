@@ -1154,6 +1154,11 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_PIPELINE_LIST_JOB,
+			auth.Permission_PIPELINE_READ_LOGS,
+			auth.Permission_PIPELINE_UPDATE_SPEC,
+			auth.Permission_PIPELINE_START_STOP,
+			auth.Permission_PIPELINE_RUN_DATUM,
+			auth.Permission_PIPELINE_READ_SECRETS,
 		},
 		repoWriter: []auth.Permission{
 			auth.Permission_REPO_READ,
@@ -1170,6 +1175,10 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_PIPELINE_LIST_JOB,
+			auth.Permission_PIPELINE_READ_LOGS,
+			auth.Permission_PIPELINE_UPDATE_SPEC,
+			auth.Permission_PIPELINE_START_STOP,
+			auth.Permission_PIPELINE_RUN_DATUM,
 		},
 		repoReader: []auth.Permission{
 			auth.Permission_REPO_READ,
@@ -1181,6 +1190,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_PIPELINE_LIST_JOB,
+			auth.Permission_PIPELINE_READ_LOGS,
 		},
 	}
 	for _, info := range repoInfos {
@@ -2902,6 +2912,399 @@ func TestRolesForPermission(t *testing.T) {
 	require.Equal(t, []string{"clusterAdmin", "repoOwner", "repoReader", "repoWriter"}, names)
 }
 
+// TestCustomRoles tests that custom roles can be created, bound like built-in
+// roles, and only deleted once they are no longer bound.
+func TestCustomRoles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient, adminClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, auth.RootUser)
+
+	role := &auth.Role{
+		Name:          tu.UniqueString("branchCreator"),
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
+		Permissions: []auth.Permission{
+			auth.Permission_REPO_READ,
+			auth.Permission_REPO_LIST_BRANCH,
+			auth.Permission_REPO_CREATE_BRANCH,
+		},
+	}
+
+	// Only admins can create roles
+	_, err := aliceClient.CreateRole(aliceClient.Ctx(), &auth.CreateRoleRequest{Role: role})
+	require.YesError(t, err)
+
+	// Cluster permissions can't be granted on repos, and built-in roles can't
+	// be redefined
+	_, err = adminClient.CreateRole(adminClient.Ctx(), &auth.CreateRoleRequest{Role: &auth.Role{
+		Name:          tu.UniqueString("debugger"),
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_REPO},
+		Permissions:   []auth.Permission{auth.Permission_CLUSTER_DEBUG_DUMP},
+	}})
+	require.YesError(t, err)
+	_, err = adminClient.CreateRole(adminClient.Ctx(), &auth.CreateRoleRequest{Role: &auth.Role{
+		Name:          auth.RepoReaderRole,
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_REPO},
+		Permissions:   []auth.Permission{auth.Permission_REPO_READ},
+	}})
+	require.YesError(t, err)
+
+	_, err = adminClient.CreateRole(adminClient.Ctx(), &auth.CreateRoleRequest{Role: role})
+	require.NoError(t, err)
+
+	resp, err := aliceClient.GetRolesForPermission(aliceClient.Ctx(), &auth.GetRolesForPermissionRequest{Permission: auth.Permission_REPO_CREATE_BRANCH})
+	require.NoError(t, err)
+	names := make([]string, len(resp.Roles))
+	for i, r := range resp.Roles {
+		names[i] = r.Name
+	}
+	require.OneOfEquals(t, role.Name, names)
+
+	// alice can create branches in the repo, but not write to it
+	repo := tu.UniqueString("TestCustomRoles")
+	require.NoError(t, adminClient.CreateRepo(repo))
+	require.NoError(t, adminClient.PutFile(client.NewCommit(repo, "master", ""), "/file", strings.NewReader("1")))
+	require.NoError(t, adminClient.ModifyRepoRoleBinding(repo, alice, []string{role.Name}))
+	require.NoError(t, aliceClient.CreateBranch(repo, "dev", "master", "", nil))
+	require.YesError(t, aliceClient.PutFile(client.NewCommit(repo, "dev", ""), "/file", strings.NewReader("2")))
+
+	// The role can't be deleted while it's bound
+	_, err = adminClient.DeleteRole(adminClient.Ctx(), &auth.DeleteRoleRequest{Name: role.Name})
+	require.YesError(t, err)
+	require.NoError(t, adminClient.ModifyRepoRoleBinding(repo, alice, []string{}))
+
+	// ...including on a branch, or in the scope of a token
+	require.NoError(t, adminClient.ModifyBranchRoleBinding(repo, "dev/*", alice, []string{role.Name}))
+	_, err = adminClient.DeleteRole(adminClient.Ctx(), &auth.DeleteRoleRequest{Name: role.Name})
+	require.YesError(t, err)
+	require.NoError(t, adminClient.ModifyBranchRoleBinding(repo, "dev/*", alice, []string{}))
+	scoped, err := adminClient.GetScopedToken(adminClient.Ctx(), &auth.GetScopedTokenRequest{
+		Scope: &auth.TokenScope{
+			Roles:     []string{role.Name},
+			Resources: []*auth.Resource{{Type: auth.ResourceType_REPO, Name: repo}},
+		},
+		TTL: 3600,
+	})
+	require.NoError(t, err)
+	_, err = adminClient.DeleteRole(adminClient.Ctx(), &auth.DeleteRoleRequest{Name: role.Name})
+	require.YesError(t, err)
+	_, err = adminClient.RevokeAuthToken(adminClient.Ctx(), &auth.RevokeAuthTokenRequest{Token: scoped.Token})
+	require.NoError(t, err)
+
+	_, err = adminClient.DeleteRole(adminClient.Ctx(), &auth.DeleteRoleRequest{Name: role.Name})
+	require.NoError(t, err)
+	require.YesError(t, adminClient.ModifyRepoRoleBinding(repo, alice, []string{role.Name}))
+}
+
+func TestBranchAndPathRoleBindings(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, bob)
+
+	repo := tu.UniqueString("TestBranchAndPathRoleBindings")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	master := client.NewCommit(repo, "master", "")
+	require.NoError(t, aliceClient.PutFile(master, "/public/file", strings.NewReader("1")))
+	require.NoError(t, aliceClient.PutFile(master, "/PII/file", strings.NewReader("2")))
+
+	// bob can only write to dev/* branches
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoReaderRole}))
+	require.NoError(t, aliceClient.ModifyBranchRoleBinding(repo, "dev/*", bob, []string{auth.RepoWriterRole}))
+	require.YesError(t, bobClient.PutFile(master, "/public/file", strings.NewReader("3")))
+	require.YesError(t, bobClient.CreateBranch(repo, "staging", "master", "", nil))
+	require.NoError(t, bobClient.CreateBranch(repo, "dev/bob", "master", "", nil))
+	require.NoError(t, bobClient.PutFile(client.NewCommit(repo, "dev/bob", ""), "/public/file", strings.NewReader("3")))
+
+	binding, err := aliceClient.GetBranchRoleBinding(repo, "dev/*")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(binding.Entries))
+	require.True(t, binding.Entries[bob].Roles[auth.RepoWriterRole])
+
+	// Restricting /PII hides it from bob, who only has repo roles
+	require.NoError(t, aliceClient.ModifyPathRoleBinding(repo, "/PII", alice, []string{auth.RepoWriterRole}))
+	files, err := bobClient.ListFileAll(master, "/")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	require.Equal(t, "/public/", files[0].File.Path)
+	require.YesError(t, bobClient.GetFile(master, "/PII/file", &bytes.Buffer{}))
+	require.YesError(t, bobClient.PutFile(client.NewCommit(repo, "dev/bob", ""), "/PII/other", strings.NewReader("4")))
+
+	var buf bytes.Buffer
+	require.NoError(t, aliceClient.GetFile(master, "/PII/file", &buf))
+	require.Equal(t, "2", buf.String())
+
+	// Removing the last path binding lifts the restriction
+	require.NoError(t, aliceClient.ModifyPathRoleBinding(repo, "/PII", alice, []string{}))
+	files, err = bobClient.ListFileAll(master, "/")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(files))
+}
+
+// TestFileSetAuthorization tests that filesets can only be read from and added
+// to commits by callers with access to the whole repo.
+func TestFileSetAuthorization(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, bob)
+
+	repo := tu.UniqueString("TestFileSetAuthorization")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	master := client.NewCommit(repo, "master", "")
+	require.NoError(t, aliceClient.PutFile(master, "/public/file", strings.NewReader("1")))
+	require.NoError(t, aliceClient.PutFile(master, "/PII/file", strings.NewReader("2")))
+	resp, err := bobClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+		return mf.PutFile("/public/other", strings.NewReader("3"))
+	})
+	require.NoError(t, err)
+
+	// bob can't read or write the repo
+	_, err = bobClient.GetFileSet(repo, "master", "")
+	require.YesError(t, err)
+	commit, err := aliceClient.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.YesError(t, bobClient.AddFileSet(repo, "master", commit.ID, resp.FileSetId))
+
+	// bob can read and write the repo, but not /PII
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoWriterRole}))
+	require.NoError(t, aliceClient.ModifyPathRoleBinding(repo, "/PII", alice, []string{auth.RepoWriterRole}))
+	_, err = bobClient.GetFileSet(repo, "master", "")
+	require.YesError(t, err)
+	require.YesError(t, bobClient.AddFileSet(repo, "master", commit.ID, resp.FileSetId))
+
+	// bob can read and write the whole repo
+	require.NoError(t, aliceClient.ModifyPathRoleBinding(repo, "/PII", alice, []string{}))
+	_, err = bobClient.GetFileSet(repo, "master", "")
+	require.NoError(t, err)
+	require.NoError(t, bobClient.AddFileSet(repo, "master", commit.ID, resp.FileSetId))
+}
+
+// TestPipelineOperatorRole tests that a pipelineOperator bound on a pipeline
+// can stop and start it, but can't read or write its data or update it
+func TestPipelineOperatorRole(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, bob)
+
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	pipeline := tu.UniqueString("alice-pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: DefaultUserImage
+		[]string{"bash"},
+		[]string{"cp /pfs/*/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/*"),
+		"", // default output branch: master
+		false,
+	))
+
+	// bob has no access to the pipeline
+	err := bobClient.StopPipeline(pipeline)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// only an owner of the output repo can bind roles on the pipeline
+	require.YesError(t, bobClient.ModifyPipelineRoleBinding(pipeline, bob, []string{auth.PipelineOperatorRole}))
+	require.YesError(t, aliceClient.ModifyPipelineRoleBinding(pipeline, bob, []string{auth.RepoWriterRole}))
+	require.NoError(t, aliceClient.ModifyPipelineRoleBinding(pipeline, bob, []string{auth.PipelineOperatorRole}))
+	binding, err := aliceClient.GetPipelineRoleBinding(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, buildBindings(bob, auth.PipelineOperatorRole), binding)
+
+	// bob can now stop and start the pipeline without access to its repos
+	require.NoError(t, bobClient.StopPipeline(pipeline))
+	require.NoError(t, bobClient.StartPipeline(pipeline))
+	require.YesError(t, bobClient.PutFile(client.NewCommit(pipeline, "master", ""), "/file", strings.NewReader("1")))
+	require.YesError(t, bobClient.GetFile(client.NewCommit(repo, "master", ""), "/file", &bytes.Buffer{}))
+	// logs can contain input data, so reading them requires access to the input
+	iter := bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
+	for iter.Next() {
+	}
+	require.YesError(t, iter.Err())
+	require.Matches(t, "not authorized", iter.Err().Error())
+	_, err = bobClient.PpsAPIClient.CreatePipeline(bobClient.Ctx(), &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(pipeline),
+		Transform: &pps.Transform{Cmd: []string{"bash"}, Stdin: []string{"true"}},
+		Input:     client.NewPFSInput(repo, "/*"),
+		Update:    true,
+	})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// bob's permissions on the pipeline come from the pipeline binding
+	permissions, err := bobClient.GetPermissions(bobClient.Ctx(), &auth.GetPermissionsRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_PIPELINE, Name: pipeline},
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []auth.Permission{
+		auth.Permission_PIPELINE_LIST_JOB,
+		auth.Permission_PIPELINE_START_STOP,
+		auth.Permission_PIPELINE_RUN_DATUM,
+		auth.Permission_PIPELINE_READ_LOGS,
+	}, permissions.Permissions)
+
+	// removing the binding revokes bob's access
+	require.NoError(t, aliceClient.ModifyPipelineRoleBinding(pipeline, bob, []string{}))
+	require.YesError(t, bobClient.StopPipeline(pipeline))
+}
+
+func TestAuditLog(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, bob)
+	rootClient := tu.AuthenticateClient(t, c, auth.RootUser)
+
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.YesError(t, bobClient.PutFile(client.NewCommit(repo, "master", ""), "/file", strings.NewReader("1")))
+
+	// only auditLogReaders can list the audit log
+	_, err := bobClient.ListAuditEvents(bobClient.Ctx(), &auth.ListAuditEventsRequest{})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	require.NoError(t, rootClient.ModifyClusterRoleBinding(bob, []string{auth.AuditLogReaderRole}))
+
+	// events are written asynchronously
+	resource := &auth.Resource{Type: auth.ResourceType_REPO, Name: repo}
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		resp, err := bobClient.ListAuditEvents(bobClient.Ctx(), &auth.ListAuditEventsRequest{Resource: resource})
+		if err != nil {
+			return err
+		}
+		var created, denied bool
+		for _, e := range resp.Events {
+			if e.Principal == alice && e.Method == "/pfs_v2.API/CreateRepo" && e.Allowed {
+				created = true
+			}
+			if e.Principal == bob && e.Method == "/pfs_v2.API/ModifyFile" && !e.Allowed {
+				denied = true
+			}
+		}
+		if !created || !denied {
+			return errors.Errorf("missing audit events for %s: %v", repo, resp.Events)
+		}
+		return nil
+	})
+
+	// filters
+	resp, err := bobClient.ListAuditEvents(bobClient.Ctx(), &auth.ListAuditEventsRequest{
+		Resource:   resource,
+		DeniedOnly: true,
+	})
+	require.NoError(t, err)
+	require.True(t, len(resp.Events) > 0)
+	for _, e := range resp.Events {
+		require.False(t, e.Allowed)
+		require.Equal(t, bob, e.Principal)
+	}
+	resp, err = bobClient.ListAuditEvents(bobClient.Ctx(), &auth.ListAuditEventsRequest{
+		Principal: alice,
+		Limit:     1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Events))
+}
+
+func TestScopedToken(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient := tu.AuthenticateClient(t, c, alice)
+
+	images, labels := tu.UniqueString("images"), tu.UniqueString("labels")
+	require.NoError(t, aliceClient.CreateRepo(images))
+	require.NoError(t, aliceClient.CreateRepo(labels))
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(images, "master", ""), "/file", strings.NewReader("1")))
+
+	// get a token that can only read 'images'
+	resp, err := aliceClient.GetScopedToken(aliceClient.Ctx(), &auth.GetScopedTokenRequest{
+		Scope: &auth.TokenScope{
+			Roles:     []string{auth.RepoReaderRole},
+			Resources: []*auth.Resource{{Type: auth.ResourceType_REPO, Name: images}},
+		},
+		TTL: 3600,
+	})
+	require.NoError(t, err)
+	scopedClient := tu.UnauthenticatedPachClient(t, c)
+	scopedClient.SetAuthToken(resp.Token)
+
+	who, err := scopedClient.WhoAmI(scopedClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, alice, who.Username)
+	require.NotNil(t, who.Scope)
+	require.NotNil(t, who.Expiration)
+
+	// the scoped token can read 'images', but not write it or touch 'labels'
+	var buf bytes.Buffer
+	require.NoError(t, scopedClient.GetFile(client.NewCommit(images, "master", ""), "/file", &buf))
+	require.Equal(t, "1", buf.String())
+	err = scopedClient.PutFile(client.NewCommit(images, "master", ""), "/file", strings.NewReader("2"))
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	_, err = scopedClient.InspectRepo(labels)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	require.YesError(t, scopedClient.CreateRepo(tu.UniqueString("new")))
+
+	// scoped tokens can't create other tokens
+	_, err = scopedClient.GetScopedToken(scopedClient.Ctx(), &auth.GetScopedTokenRequest{
+		Scope: &auth.TokenScope{Roles: []string{auth.RepoOwnerRole}},
+	})
+	require.YesError(t, err)
+
+	// scoped tokens can't revoke other tokens, even their subject's
+	_, err = scopedClient.RevokeAuthToken(scopedClient.Ctx(), &auth.RevokeAuthTokenRequest{Token: aliceClient.AuthToken()})
+	require.YesError(t, err)
+	_, err = aliceClient.WhoAmI(aliceClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+
+	// the scoped token is listed with its scope, and can be revoked
+	tokens, err := aliceClient.ListAuthTokens(aliceClient.Ctx(), &auth.ListAuthTokensRequest{})
+	require.NoError(t, err)
+	var hash string
+	for _, token := range tokens.Tokens {
+		if token.Scope != nil {
+			hash = token.HashedToken
+			require.Equal(t, []string{auth.RepoReaderRole}, token.Scope.Roles)
+		}
+	}
+	require.Equal(t, auth.HashToken(resp.Token), hash)
+	_, err = aliceClient.RevokeAuthToken(aliceClient.Ctx(), &auth.RevokeAuthTokenRequest{HashedToken: hash})
+	require.NoError(t, err)
+	_, err = scopedClient.WhoAmI(scopedClient.Ctx(), &auth.WhoAmIRequest{})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrBadToken(err))
+}
+
 // TODO: This test mirrors TestLoad in src/server/pfs/server/testing/load_test.go.
 // Need to restructure testing such that we have the implementation of this
 // test in one place while still being able to test auth enabled and disabled clusters.
@@ -1,9 +1,13 @@
 package server
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"golang.org/x/crypto/nacl/box"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
@@ -274,3 +278,141 @@ func TestSetGetNilConfig(t *testing.T) {
 	conf = proto.Clone(&authserver.DefaultOIDCConfig).(*auth.OIDCConfig)
 	require.Equal(t, true, proto.Equal(conf, configResp.Configuration))
 }
+
+// TestExportImportConfig exports the cluster's auth and identity config,
+// changes the cluster, and checks that importing the export undoes the changes
+func TestExportImportConfig(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	tu.ConfigureOIDCProvider(t, c)
+	adminClient := tu.AuthenticateClient(t, c, auth.RootUser)
+	alice, group := robot(tu.UniqueString("alice")), "group:"+tu.UniqueString("readers")
+
+	repo := tu.UniqueString("TestExportImportConfig")
+	require.NoError(t, adminClient.CreateRepo(repo))
+	require.NoError(t, adminClient.ModifyRepoRoleBinding(repo, alice, []string{auth.RepoWriterRole}))
+	_, err := adminClient.ModifyMembers(adminClient.Ctx(), &auth.ModifyMembersRequest{Group: group, Add: []string{alice}})
+	require.NoError(t, err)
+	_, err = adminClient.CreateOIDCClient(adminClient.Ctx(), &identity.CreateOIDCClientRequest{Client: &identity.OIDCClient{
+		Id:           "exporttest",
+		Name:         "exporttest",
+		Secret:       "clientsecret",
+		RedirectUris: []string{"http://localhost:1657/test"},
+	}})
+	require.NoError(t, err)
+
+	public, private, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	resp, err := adminClient.ExportConfig(adminClient.Ctx(), &auth.ExportConfigRequest{
+		EncryptionKey: base64.StdEncoding.EncodeToString(public[:]),
+	})
+	require.NoError(t, err)
+	config := resp.Config
+
+	// managed principals aren't exported, and secrets are encrypted
+	for _, rb := range config.RoleBindings {
+		for principal := range rb.Binding.Entries {
+			require.False(t, strings.HasPrefix(principal, auth.PachPrefix))
+		}
+	}
+	var exported *identity.OIDCClient
+	for _, client := range config.OidcClients {
+		if client.Id == "exporttest" {
+			exported = client
+		}
+	}
+	require.NotNil(t, exported)
+	require.True(t, strings.HasPrefix(exported.Secret, "encrypted:"))
+
+	// importing the export into the unchanged cluster is a no-op
+	importResp, err := adminClient.ImportConfig(adminClient.Ctx(), &auth.ImportConfigRequest{
+		Config:        config,
+		DecryptionKey: base64.StdEncoding.EncodeToString(private[:]),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(importResp.Changes))
+
+	// change the cluster
+	require.NoError(t, adminClient.ModifyRepoRoleBinding(repo, alice, []string{}))
+	_, err = adminClient.ModifyMembers(adminClient.Ctx(), &auth.ModifyMembersRequest{Group: group, Remove: []string{alice}})
+	require.NoError(t, err)
+	_, err = adminClient.DeleteOIDCClient(adminClient.Ctx(), &identity.DeleteOIDCClientRequest{Id: "exporttest"})
+	require.NoError(t, err)
+
+	// a dry run lists the changes without making them, and bindings on repos
+	// that don't exist are skipped
+	missing := &auth.Resource{Type: auth.ResourceType_REPO, Name: tu.UniqueString("missing")}
+	config.RoleBindings = append(config.RoleBindings, &auth.ResourceRoleBinding{
+		Resource: missing,
+		Binding:  &auth.RoleBinding{Entries: map[string]*auth.Roles{alice: {Roles: map[string]bool{auth.RepoReaderRole: true}}}},
+	})
+	importResp, err = adminClient.ImportConfig(adminClient.Ctx(), &auth.ImportConfigRequest{
+		Config:        config,
+		DecryptionKey: base64.StdEncoding.EncodeToString(private[:]),
+		DryRun:        true,
+	})
+	require.NoError(t, err)
+	ops := make(map[string]auth.ConfigChangeOp)
+	for _, change := range importResp.Changes {
+		ops[change.Kind+"/"+change.Name] = change.Op
+	}
+	require.Equal(t, auth.ConfigChangeOp_CONFIG_UPDATE, ops["role_binding/REPO "+repo])
+	require.Equal(t, auth.ConfigChangeOp_CONFIG_SKIP, ops["role_binding/REPO "+missing.Name])
+	require.Equal(t, auth.ConfigChangeOp_CONFIG_CREATE, ops["group/"+group])
+	require.Equal(t, auth.ConfigChangeOp_CONFIG_CREATE, ops["oidc_client/exporttest"])
+	require.Nil(t, getRepoRoleBinding(t, adminClient, repo).Entries[alice])
+
+	// the real import restores the exported config, including secrets
+	_, err = adminClient.ImportConfig(adminClient.Ctx(), &auth.ImportConfigRequest{
+		Config:        config,
+		DecryptionKey: base64.StdEncoding.EncodeToString(private[:]),
+	})
+	require.NoError(t, err)
+	require.True(t, getRepoRoleBinding(t, adminClient, repo).Entries[alice].Roles[auth.RepoWriterRole])
+	groups, err := adminClient.GetGroupsForPrincipal(adminClient.Ctx(), &auth.GetGroupsForPrincipalRequest{Principal: alice})
+	require.NoError(t, err)
+	require.OneOfEquals(t, group, groups.Groups)
+	clientResp, err := adminClient.GetOIDCClient(adminClient.Ctx(), &identity.GetOIDCClientRequest{Id: "exporttest"})
+	require.NoError(t, err)
+	require.Equal(t, "clientsecret", clientResp.Client.Secret)
+
+	// bindings that aren't in the config are removed, except for those of
+	// managed principals
+	bob := robot(tu.UniqueString("bob"))
+	other := tu.UniqueString("TestExportImportConfigOther")
+	require.NoError(t, adminClient.CreateRepo(other))
+	require.NoError(t, adminClient.ModifyRepoRoleBinding(other, bob, []string{auth.RepoReaderRole}))
+	importResp, err = adminClient.ImportConfig(adminClient.Ctx(), &auth.ImportConfigRequest{
+		Config:        config,
+		DecryptionKey: base64.StdEncoding.EncodeToString(private[:]),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(importResp.Changes))
+	require.Equal(t, auth.ConfigChangeOp_CONFIG_DELETE, importResp.Changes[0].Op)
+	require.Nil(t, getRepoRoleBinding(t, adminClient, other).Entries[bob])
+
+	// invalid identity objects fail the dry run
+	invalid := proto.Clone(config).(*auth.ClusterAuthConfig)
+	invalid.IdpConnectors = append(invalid.IdpConnectors, &identity.IDPConnector{
+		Id:         "invalid",
+		Name:       "invalid",
+		Type:       "nonexistent",
+		JsonConfig: "{}",
+	})
+	_, err = adminClient.ImportConfig(adminClient.Ctx(), &auth.ImportConfigRequest{
+		Config:        invalid,
+		DecryptionKey: base64.StdEncoding.EncodeToString(private[:]),
+		DryRun:        true,
+	})
+	require.YesError(t, err)
+	require.Matches(t, "unknown connector type", err.Error())
+
+	// encrypted secrets can't be imported without the key
+	_, err = adminClient.DeleteOIDCClient(adminClient.Ctx(), &identity.DeleteOIDCClientRequest{Id: "exporttest"})
+	require.NoError(t, err)
+	_, err = adminClient.ImportConfig(adminClient.Ctx(), &auth.ImportConfigRequest{Config: config})
+	require.YesError(t, err)
+}
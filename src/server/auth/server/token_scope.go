@@ -0,0 +1,142 @@
+package server
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+)
+
+// tokenRow is the representation of a token in postgres. Unlike TokenInfo, it
+// stores the token's scope as a serialized proto.
+type tokenRow struct {
+	HashedToken string     `db:"token_hash"`
+	Subject     string     `db:"subject"`
+	Expiration  *time.Time `db:"expiration"`
+	Scope       []byte     `db:"scope"`
+}
+
+func (r *tokenRow) toProto() (*auth.TokenInfo, error) {
+	scope, err := unmarshalTokenScope(r.Scope)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.TokenInfo{
+		HashedToken: r.HashedToken,
+		Subject:     r.Subject,
+		Expiration:  r.Expiration,
+		Scope:       scope,
+	}, nil
+}
+
+// marshalTokenScope serializes 'scope' to be stored with a token. A nil scope
+// is stored as NULL.
+func marshalTokenScope(scope *auth.TokenScope) ([]byte, error) {
+	if scope == nil {
+		return nil, nil
+	}
+	data, err := proto.Marshal(scope)
+	return data, errors.EnsureStack(err)
+}
+
+func unmarshalTokenScope(data []byte) (*auth.TokenScope, error) {
+	if data == nil {
+		return nil, nil
+	}
+	scope := &auth.TokenScope{}
+	if err := proto.Unmarshal(data, scope); err != nil {
+		return nil, errors.Wrapf(err, "error reading token scope")
+	}
+	return scope, nil
+}
+
+// validateTokenScopeInTransaction checks that the roles and resources in
+// 'scope' exist and are well-formed.
+func (a *apiServer) validateTokenScopeInTransaction(txnCtx *txncontext.TransactionContext, scope *auth.TokenScope) error {
+	if scope == nil {
+		return nil
+	}
+	for _, name := range scope.Roles {
+		if _, err := a.getRoleInTransaction(txnCtx, name); err != nil {
+			return err
+		}
+	}
+	for _, r := range scope.Resources {
+		if r == nil {
+			return errors.Errorf("token scope resources must be set")
+		}
+		if r.Type != auth.ResourceType_CLUSTER && r.Name == "" {
+			return errors.Errorf("token scope resource of type %v must have a name", r.Type)
+		}
+		if _, _, err := scopedResource(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restrictToTokenScope removes the permissions satisfied by 'request' that
+// 'scope' doesn't grant on 'resource'. A nil scope doesn't restrict anything.
+func (a *apiServer) restrictToTokenScope(txnCtx *txncontext.TransactionContext, scope *auth.TokenScope, resource *auth.Resource, request *authorizeRequest) error {
+	if scope == nil {
+		return nil
+	}
+	if !tokenScopeCovers(scope, resource) {
+		request.restrict(func(auth.Permission) bool { return false })
+		return nil
+	}
+	if len(scope.Permissions) == 0 && len(scope.Roles) == 0 {
+		return nil
+	}
+	allowed := make(map[auth.Permission]bool)
+	for _, p := range scope.Permissions {
+		allowed[p] = true
+	}
+	for _, name := range scope.Roles {
+		r, err := a.getRoleInTransaction(txnCtx, name)
+		if err != nil {
+			return err
+		}
+		for _, p := range r.role.Permissions {
+			allowed[p] = true
+		}
+	}
+	request.restrict(func(p auth.Permission) bool { return allowed[p] })
+	return nil
+}
+
+// tokenScopeCovers returns true if 'resource' is one of the resources in
+// 'scope', or is a branch or path in one of its repos.
+func tokenScopeCovers(scope *auth.TokenScope, resource *auth.Resource) bool {
+	if len(scope.Resources) == 0 {
+		return true
+	}
+	rt, name := resource.Type, resource.Name
+	switch rt {
+	case auth.ResourceType_SPEC_REPO:
+		rt = auth.ResourceType_REPO
+	case auth.ResourceType_BRANCH, auth.ResourceType_PATH:
+		repo, _, err := scopedResource(resource)
+		if err != nil {
+			return false
+		}
+		for _, r := range scope.Resources {
+			if r.Type == resource.Type && r.Name == resource.Name {
+				return true
+			}
+			if r.Type == auth.ResourceType_REPO && r.Name == repo.Name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, r := range scope.Resources {
+		if r.Type == rt && (rt == auth.ResourceType_CLUSTER || r.Name == name) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
@@ -95,3 +97,138 @@ func (a *apiServer) CheckClusterIsAuthorized(ctx context.Context, p ...auth.Perm
 	}
 	return nil
 }
+
+// CheckBranchIsAuthorizedInTransaction is identical to CheckBranchIsAuthorized except that
+// it performs reads consistent with the latest state of the STM transaction.
+func (a *apiServer) CheckBranchIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, b *pfs.Branch, p ...auth.Permission) error {
+	if b.Name == "" || b.Repo.Type != pfs.UserRepoType {
+		return a.CheckRepoIsAuthorizedInTransaction(txnCtx, b.Repo, p...)
+	}
+	me, err := txnCtx.WhoAmI()
+	if auth.IsErrNotActivated(err) {
+		return nil
+	}
+
+	resource := auth.Resource{Type: auth.ResourceType_BRANCH, Name: auth.BranchResourceName(b.Repo.Name, b.Name)}
+	req := &auth.AuthorizeRequest{Resource: &resource, Permissions: p}
+	resp, err := a.AuthorizeInTransaction(txnCtx, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Authorized {
+		return &auth.ErrNotAuthorized{Subject: me.Username, Resource: resource, Required: p}
+	}
+	return nil
+}
+
+// CheckBranchIsAuthorized returns an error if the current user doesn't have
+// the permissions in `p` on the branch `b`, either through the role binding
+// for its repo or through a branch binding matching it. If the branch has no
+// name, only the repo binding is checked.
+func (a *apiServer) CheckBranchIsAuthorized(ctx context.Context, b *pfs.Branch, p ...auth.Permission) error {
+	return a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.CheckBranchIsAuthorizedInTransaction(txnCtx, b, p...)
+	})
+}
+
+// CheckPipelineIsAuthorizedInTransaction is identical to CheckPipelineIsAuthorized except that
+// it performs reads consistent with the latest state of the STM transaction.
+func (a *apiServer) CheckPipelineIsAuthorizedInTransaction(txnCtx *txncontext.TransactionContext, pipeline string, p ...auth.Permission) error {
+	me, err := txnCtx.WhoAmI()
+	if auth.IsErrNotActivated(err) {
+		return nil
+	}
+
+	resource := auth.Resource{Type: auth.ResourceType_PIPELINE, Name: pipeline}
+	req := &auth.AuthorizeRequest{Resource: &resource, Permissions: p}
+	resp, err := a.AuthorizeInTransaction(txnCtx, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Authorized {
+		return &auth.ErrNotAuthorized{Subject: me.Username, Resource: resource, Required: p}
+	}
+	return nil
+}
+
+// CheckPipelineIsAuthorized returns an error if the current user doesn't have
+// the permissions in `p` on the pipeline, either through the role binding for
+// its output repo or through the pipeline's own role binding.
+func (a *apiServer) CheckPipelineIsAuthorized(ctx context.Context, pipeline string, p ...auth.Permission) error {
+	return a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.CheckPipelineIsAuthorizedInTransaction(txnCtx, pipeline, p...)
+	})
+}
+
+// AuthorizedPathFilter returns a function reporting whether the current user
+// has the permissions in `p` on a file in the repo `r`. Files that aren't
+// covered by a path binding are always accepted, so the caller must check the
+// repo or branch separately. A nil filter is returned if no path in the repo
+// is restricted.
+func (a *apiServer) AuthorizedPathFilter(ctx context.Context, r *pfs.Repo, p ...auth.Permission) (func(string) bool, error) {
+	var filter func(string) bool
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		filter, err = a.AuthorizedPathFilterInTransaction(txnCtx, r, p...)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// AuthorizedPathFilterInTransaction is identical to AuthorizedPathFilter
+// except that it performs reads consistent with the latest state of the STM
+// transaction.
+func (a *apiServer) AuthorizedPathFilterInTransaction(txnCtx *txncontext.TransactionContext, r *pfs.Repo, p ...auth.Permission) (func(string) bool, error) {
+	if r.Type != pfs.UserRepoType {
+		return nil, nil
+	}
+	me, err := txnCtx.WhoAmI()
+	if auth.IsErrNotActivated(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var binding auth.RoleBinding
+	if err := a.roleBindings.ReadWrite(txnCtx.SqlTx).Get(resourceKey(&auth.Resource{Type: auth.ResourceType_REPO, Name: r.Name}), &binding); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if len(binding.Paths) == 0 {
+		return nil, nil
+	}
+
+	allowed := make(map[string]bool)
+	for prefix := range binding.Paths {
+		permissions := make(map[auth.Permission]bool)
+		for _, permission := range p {
+			permissions[permission] = true
+		}
+		resource := &auth.Resource{Type: auth.ResourceType_PATH, Name: auth.PathResourceName(r.Name, prefix)}
+		request, err := a.evaluateRoleBindingInTransaction(txnCtx, me.Username, resource, permissions)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.restrictToTokenScope(txnCtx, me.Scope, resource, request); err != nil {
+			return nil, err
+		}
+		allowed[prefix] = request.isSatisfied()
+	}
+	return func(file string) bool {
+		file = cleanPath(file)
+		covered := false
+		for prefix, ok := range allowed {
+			if pathHasPrefix(file, prefix) {
+				if ok {
+					return true
+				}
+				covered = true
+			}
+		}
+		return !covered
+	}, nil
+}
@@ -115,6 +115,26 @@ func (a *InactiveAPIServer) GetRolesForPermission(context.Context, *auth.GetRole
 	return nil, auth.ErrNotActivated
 }
 
+// CreateRole implements the CreateRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) CreateRole(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// UpdateRole implements the UpdateRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) UpdateRole(context.Context, *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DeleteRole implements the DeleteRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DeleteRole(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListRole implements the ListRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListRole(context.Context, *auth.ListRoleRequest) (*auth.ListRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // GetRobotToken implements the GetRobotToken RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetRobotToken(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -200,6 +220,34 @@ func (a *InactiveAPIServer) RotateRootToken(context.Context, *auth.RotateRootTok
 	return nil, auth.ErrNotActivated
 }
 
+// ListAuditEvents implements the ListAuditEvents RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListAuditEvents(context.Context, *auth.ListAuditEventsRequest) (*auth.ListAuditEventsResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// GetScopedToken implements the GetScopedToken RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetScopedToken(context.Context, *auth.GetScopedTokenRequest) (*auth.GetScopedTokenResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListAuthTokens implements the ListAuthTokens RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListAuthTokens(context.Context, *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ExportConfig implements the ExportConfig RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ExportConfig(context.Context, *auth.ExportConfigRequest) (*auth.ExportConfigResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ImportConfig implements the ImportConfig RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ImportConfig(context.Context, *auth.ImportConfigRequest) (*auth.ImportConfigResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// RecordAuditEvent does nothing when auth is not activated
+func (a *InactiveAPIServer) RecordAuditEvent(*auth.AuditEvent) {}
+
 // CheckRepoIsAuthorized returns nil when auth is not activated
 func (a *InactiveAPIServer) CheckRepoIsAuthorized(context.Context, *pfs.Repo, ...auth.Permission) error {
 	return nil
@@ -219,3 +267,33 @@ func (a *InactiveAPIServer) CheckClusterIsAuthorizedInTransaction(*txncontext.Tr
 func (a *InactiveAPIServer) CheckRepoIsAuthorizedInTransaction(*txncontext.TransactionContext, *pfs.Repo, ...auth.Permission) error {
 	return nil
 }
+
+// CheckBranchIsAuthorized returns nil when auth is not activated
+func (a *InactiveAPIServer) CheckBranchIsAuthorized(context.Context, *pfs.Branch, ...auth.Permission) error {
+	return nil
+}
+
+// CheckBranchIsAuthorizedInTransaction returns nil when auth is not activated
+func (a *InactiveAPIServer) CheckBranchIsAuthorizedInTransaction(*txncontext.TransactionContext, *pfs.Branch, ...auth.Permission) error {
+	return nil
+}
+
+// CheckPipelineIsAuthorized returns nil when auth is not activated
+func (a *InactiveAPIServer) CheckPipelineIsAuthorized(context.Context, string, ...auth.Permission) error {
+	return nil
+}
+
+// CheckPipelineIsAuthorizedInTransaction returns nil when auth is not activated
+func (a *InactiveAPIServer) CheckPipelineIsAuthorizedInTransaction(*txncontext.TransactionContext, string, ...auth.Permission) error {
+	return nil
+}
+
+// AuthorizedPathFilter returns a nil filter when auth is not activated
+func (a *InactiveAPIServer) AuthorizedPathFilter(context.Context, *pfs.Repo, ...auth.Permission) (func(string) bool, error) {
+	return nil, nil
+}
+
+// AuthorizedPathFilterInTransaction returns a nil filter when auth is not activated
+func (a *InactiveAPIServer) AuthorizedPathFilterInTransaction(*txncontext.TransactionContext, *pfs.Repo, ...auth.Permission) (func(string) bool, error) {
+	return nil, nil
+}
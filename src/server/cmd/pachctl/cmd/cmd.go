@@ -822,6 +822,18 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(runDocs, "run"))
 
+	explainDocs := &cobra.Command{
+		Short: "Explain a decision Pachyderm made.",
+		Long:  "Explain a decision Pachyderm made.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(explainDocs, "explain"))
+
+	replayDocs := &cobra.Command{
+		Short: "Reprocess data that Pachyderm set aside.",
+		Long:  "Reprocess data that Pachyderm set aside.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(replayDocs, "replay"))
+
 	editDocs := &cobra.Command{
 		Short: "Edit the value of an existing Pachyderm resource.",
 		Long:  "Edit the value of an existing Pachyderm resource.",
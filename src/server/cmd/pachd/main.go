@@ -25,6 +25,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	logutil "github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	auditmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/audit"
 	authmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	errorsmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/errors"
 	loggingmw "github.com/pachyderm/pachyderm/v2/src/internal/middleware/logging"
@@ -154,6 +155,7 @@ func doEnterpriseMode(config interface{}) (retErr error) {
 	}
 
 	// Setup External Pachd GRPC Server.
+	auditInterceptor := auditmw.NewInterceptor(env.AuthServer)
 	authInterceptor := authmw.NewInterceptor(env.AuthServer)
 	loggingInterceptor := loggingmw.NewLoggingInterceptor(env.Logger())
 	externalServer, err := grpcutil.NewServer(
@@ -170,6 +172,7 @@ func doEnterpriseMode(config interface{}) (retErr error) {
 			errorsmw.UnaryServerInterceptor,
 			version_middleware.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 			loggingInterceptor.UnaryServerInterceptor,
 		),
@@ -177,6 +180,7 @@ func doEnterpriseMode(config interface{}) (retErr error) {
 			errorsmw.StreamServerInterceptor,
 			version_middleware.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 			loggingInterceptor.StreamServerInterceptor,
 		),
@@ -259,6 +263,7 @@ func doEnterpriseMode(config interface{}) (retErr error) {
 				identity_server.EnvFromServiceEnv(env),
 				true,
 			)
+			env.SetIdentityServer(idAPIServer)
 			identityclient.RegisterAPIServer(externalServer.Server, idAPIServer)
 			return nil
 		}); err != nil {
@@ -281,11 +286,13 @@ func doEnterpriseMode(config interface{}) (retErr error) {
 		grpc.ChainUnaryInterceptor(
 			errorsmw.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 			loggingInterceptor.UnaryServerInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			errorsmw.StreamServerInterceptor,
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 			loggingInterceptor.StreamServerInterceptor,
 		),
@@ -368,6 +375,7 @@ func doEnterpriseMode(config interface{}) (retErr error) {
 				identity_server.EnvFromServiceEnv(env),
 				false,
 			)
+			env.SetIdentityServer(idAPIServer)
 			identityclient.RegisterAPIServer(internalServer.Server, idAPIServer)
 			return nil
 		}); err != nil {
@@ -427,6 +435,7 @@ func doSidecarMode(config interface{}) (retErr error) {
 	if env.Config().EtcdPrefix == "" {
 		env.Config().EtcdPrefix = col.DefaultPrefix
 	}
+	auditInterceptor := auditmw.NewInterceptor(env.AuthServer)
 	authInterceptor := authmw.NewInterceptor(env.AuthServer)
 	loggingInterceptor := loggingmw.NewLoggingInterceptor(env.Logger())
 	server, err := grpcutil.NewServer(
@@ -435,12 +444,14 @@ func doSidecarMode(config interface{}) (retErr error) {
 		grpc.ChainUnaryInterceptor(
 			errorsmw.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 			loggingInterceptor.UnaryServerInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			errorsmw.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 			loggingInterceptor.StreamServerInterceptor,
 		),
@@ -605,6 +616,7 @@ func doFullMode(config interface{}) (retErr error) {
 	requireNoncriticalServers := !env.Config().RequireCriticalServersOnly
 
 	// Setup External Pachd GRPC Server.
+	auditInterceptor := auditmw.NewInterceptor(env.AuthServer)
 	authInterceptor := authmw.NewInterceptor(env.AuthServer)
 	loggingInterceptor := loggingmw.NewLoggingInterceptor(env.Logger())
 	externalServer, err := grpcutil.NewServer(
@@ -621,6 +633,7 @@ func doFullMode(config interface{}) (retErr error) {
 			errorsmw.UnaryServerInterceptor,
 			version_middleware.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 			loggingInterceptor.UnaryServerInterceptor,
 		),
@@ -628,6 +641,7 @@ func doFullMode(config interface{}) (retErr error) {
 			errorsmw.StreamServerInterceptor,
 			version_middleware.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 			loggingInterceptor.StreamServerInterceptor,
 		),
@@ -643,6 +657,7 @@ func doFullMode(config interface{}) (retErr error) {
 				identity_server.EnvFromServiceEnv(env),
 				true,
 			)
+			env.SetIdentityServer(idAPIServer)
 			identityclient.RegisterAPIServer(externalServer.Server, idAPIServer)
 			return nil
 		}); err != nil {
@@ -787,11 +802,13 @@ func doFullMode(config interface{}) (retErr error) {
 		grpc.ChainUnaryInterceptor(
 			errorsmw.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 			loggingInterceptor.UnaryServerInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			errorsmw.StreamServerInterceptor,
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 			loggingInterceptor.StreamServerInterceptor,
 		),
@@ -836,6 +853,7 @@ func doFullMode(config interface{}) (retErr error) {
 				identity_server.EnvFromServiceEnv(env),
 				false,
 			)
+			env.SetIdentityServer(idAPIServer)
 			identityclient.RegisterAPIServer(internalServer.Server, idAPIServer)
 			return nil
 		}); err != nil {
@@ -1036,6 +1054,7 @@ func doPausedMode(config interface{}) (retErr error) {
 	requireNoncriticalServers := !env.Config().RequireCriticalServersOnly
 
 	// Setup External Pachd GRPC Server.
+	auditInterceptor := auditmw.NewInterceptor(env.AuthServer)
 	authInterceptor := authmw.NewInterceptor(env.AuthServer)
 	loggingInterceptor := loggingmw.NewLoggingInterceptor(env.Logger())
 	externalServer, err := grpcutil.NewServer(
@@ -1052,6 +1071,7 @@ func doPausedMode(config interface{}) (retErr error) {
 			errorsmw.UnaryServerInterceptor,
 			version_middleware.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 			loggingInterceptor.UnaryServerInterceptor,
 		),
@@ -1059,6 +1079,7 @@ func doPausedMode(config interface{}) (retErr error) {
 			errorsmw.StreamServerInterceptor,
 			version_middleware.StreamServerInterceptor,
 			tracing.StreamServerInterceptor(),
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 			loggingInterceptor.StreamServerInterceptor,
 		),
@@ -1119,6 +1140,7 @@ func doPausedMode(config interface{}) (retErr error) {
 				identity_server.EnvFromServiceEnv(env),
 				true,
 			)
+			env.SetIdentityServer(idAPIServer)
 			identityclient.RegisterAPIServer(externalServer.Server, idAPIServer)
 			return nil
 		}); err != nil {
@@ -1163,11 +1185,13 @@ func doPausedMode(config interface{}) (retErr error) {
 		grpc.ChainUnaryInterceptor(
 			errorsmw.UnaryServerInterceptor,
 			tracing.UnaryServerInterceptor(),
+			auditInterceptor.InterceptUnary,
 			authInterceptor.InterceptUnary,
 			loggingInterceptor.UnaryServerInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			errorsmw.StreamServerInterceptor,
+			auditInterceptor.InterceptStream,
 			authInterceptor.InterceptStream,
 			loggingInterceptor.StreamServerInterceptor,
 		),
@@ -1248,6 +1272,7 @@ func doPausedMode(config interface{}) (retErr error) {
 				identity_server.EnvFromServiceEnv(env),
 				false,
 			)
+			env.SetIdentityServer(idAPIServer)
 			identityclient.RegisterAPIServer(internalServer.Server, idAPIServer)
 			return nil
 		}); err != nil {
@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"strconv"
 
 	dex_api "github.com/dexidp/dex/api/v2"
@@ -12,6 +11,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	identity_server "github.com/pachyderm/pachyderm/v2/src/server/identity"
 )
 
 // dexAPI wraps an api.DexServer and extends it with CRUD operations
@@ -33,12 +33,8 @@ func newDexAPI(sp dex_storage.Storage) *dexAPI {
 }
 
 func (a *dexAPI) createClient(ctx context.Context, in *identity.CreateOIDCClientRequest) (*identity.OIDCClient, error) {
-	if in.Client.Name == "" {
-		return nil, errors.New("no client name specified")
-	}
-
-	if in.Client.Id == "" {
-		return nil, errors.New("no client id specified")
+	if err := identity_server.ValidateOIDCClient(in.Client); err != nil {
+		return nil, err
 	}
 
 	req := &dex_api.CreateClientReq{
@@ -97,19 +93,7 @@ func (a *dexAPI) deleteClient(ctx context.Context, id string) error {
 }
 
 func (a *dexAPI) createConnector(req *identity.CreateIDPConnectorRequest) error {
-	if req.Connector.Id == "" {
-		return errors.New("no id specified")
-	}
-
-	if req.Connector.Type == "" {
-		return errors.New("no type specified")
-	}
-
-	if req.Connector.Name == "" {
-		return errors.New("no name specified")
-	}
-
-	if err := a.validateConnector(req.Connector.Id, req.Connector.Type, []byte(req.Connector.JsonConfig)); err != nil {
+	if err := identity_server.ValidateIDPConnector(req.Connector, a.logger); err != nil {
 		return err
 	}
 
@@ -164,7 +148,7 @@ func (a *dexAPI) updateConnector(in *identity.UpdateIDPConnectorRequest) error {
 			c.Type = in.Connector.Type
 		}
 
-		if err := a.validateConnector(c.ID, c.Type, c.Config); err != nil {
+		if err := identity_server.ValidateConnectorConfig(c.ID, c.Type, c.Config, a.logger); err != nil {
 			return dex_storage.Connector{}, err
 		}
 
@@ -214,24 +198,6 @@ func (a *dexAPI) getClient(id string) (*identity.OIDCClient, error) {
 	return storageClientToPach(client), nil
 }
 
-func (a *dexAPI) validateConnector(id, connType string, jsonConfig []byte) error {
-	typeConf, ok := dex_server.ConnectorsConfig[connType]
-	if !ok {
-		return errors.Errorf("unknown connector type %q", connType)
-	}
-
-	conf := typeConf()
-	if err := json.Unmarshal(jsonConfig, conf); err != nil {
-		return errors.Errorf("unable to deserialize JSON: %v", err)
-	}
-
-	if _, err := conf.Open(id, a.logger); err != nil {
-		return errors.Errorf("unable to open connector: %v", err)
-	}
-
-	return nil
-}
-
 func storageClientToPach(c dex_storage.Client) *identity.OIDCClient {
 	return &identity.OIDCClient{
 		Id:           c.ID,
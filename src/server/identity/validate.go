@@ -0,0 +1,63 @@
+package identity
+
+import (
+	"encoding/json"
+
+	dex_server "github.com/dexidp/dex/server"
+	logrus "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/identity"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// ValidateOIDCClient checks that 'client' has the fields it needs to be
+// created
+func ValidateOIDCClient(client *identity.OIDCClient) error {
+	if client.Name == "" {
+		return errors.New("no client name specified")
+	}
+
+	if client.Id == "" {
+		return errors.New("no client id specified")
+	}
+
+	return nil
+}
+
+// ValidateIDPConnector checks that 'conn' has the fields it needs to be
+// created, and that its config can be used to open a connector of its type
+func ValidateIDPConnector(conn *identity.IDPConnector, logger *logrus.Entry) error {
+	if conn.Id == "" {
+		return errors.New("no id specified")
+	}
+
+	if conn.Type == "" {
+		return errors.New("no type specified")
+	}
+
+	if conn.Name == "" {
+		return errors.New("no name specified")
+	}
+
+	return ValidateConnectorConfig(conn.Id, conn.Type, []byte(conn.JsonConfig), logger)
+}
+
+// ValidateConnectorConfig checks that 'jsonConfig' can be used to open a
+// connector of type 'connType'
+func ValidateConnectorConfig(id, connType string, jsonConfig []byte, logger *logrus.Entry) error {
+	typeConf, ok := dex_server.ConnectorsConfig[connType]
+	if !ok {
+		return errors.Errorf("unknown connector type %q", connType)
+	}
+
+	conf := typeConf()
+	if err := json.Unmarshal(jsonConfig, conf); err != nil {
+		return errors.Errorf("unable to deserialize JSON: %v", err)
+	}
+
+	if _, err := conf.Open(id, logger); err != nil {
+		return errors.Errorf("unable to open connector: %v", err)
+	}
+
+	return nil
+}
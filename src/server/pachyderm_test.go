@@ -6284,6 +6284,46 @@ func TestCronPipeline(t *testing.T) {
 			})
 		}))
 	})
+	t.Run("RunCronAt", func(t *testing.T) {
+		defer func() {
+			require.NoError(t, c.DeleteAll())
+		}()
+		pipeline := tu.UniqueString("cronat-")
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"/bin/bash"},
+			[]string{"cp /pfs/time/* /pfs/out/"},
+			nil,
+			client.NewCronInputOpts("time", "", "@every 1h", true),
+			"",
+			false,
+		))
+		repo := fmt.Sprintf("%s_%s", pipeline, "time")
+		require.NoError(t, c.RunCron(pipeline))
+		_, err := c.WaitCommit(repo, "master", "")
+		require.NoError(t, err)
+		files, err := c.ListFileAll(client.NewCommit(repo, "master", ""), "/")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(files))
+		latest := files[0].File.Path
+
+		// a tick made with --at is added alongside the latest tick, even
+		// though the input overwrites its ticks, so that the schedule still
+		// resumes from the latest one
+		at := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, c.RunCronAt(pipeline, at))
+		_, err = c.WaitCommit(repo, "master", "")
+		require.NoError(t, err)
+		files, err = c.ListFileAll(client.NewCommit(repo, "master", ""), "/")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+		require.Equal(t, "/"+at.Format(time.RFC3339), files[0].File.Path)
+		require.Equal(t, latest, files[1].File.Path)
+
+		// ticks can't be made in the future
+		require.YesError(t, c.RunCronAt(pipeline, time.Now().Add(time.Hour)))
+	})
 	t.Run("RunCronCross", func(t *testing.T) {
 		defer func() {
 			require.NoError(t, c.DeleteAll())
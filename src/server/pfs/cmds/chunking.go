@@ -0,0 +1,172 @@
+package cmds
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	units "github.com/docker/go-units"
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// chunkingFlags are the flags used to configure the chunking of a repo.
+type chunkingFlags struct {
+	algorithm                     string
+	averageSize, minSize, maxSize string
+	reset                         bool
+}
+
+func (f *chunkingFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.algorithm, "chunking", "", "The content-defined chunking algorithm used for files in the repo: \"buzhash64\" or \"fastcdc\".")
+	cmd.Flags().StringVar(&f.averageSize, "chunk-size", "", "The average chunk size (e.g. 8MB), which must be a power of two.")
+	cmd.Flags().StringVar(&f.minSize, "min-chunk-size", "", "The minimum chunk size (e.g. 1MB).")
+	cmd.Flags().StringVar(&f.maxSize, "max-chunk-size", "", "The maximum chunk size (e.g. 20MB).")
+}
+
+// addResetFlag adds the flag that resets a repo to the default chunking,
+// which only makes sense when updating a repo.
+func (f *chunkingFlags) addResetFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.reset, "default-chunking", false, "Reset the repo to the default chunking.")
+}
+
+// spec returns the chunking spec described by the flags, or nil if none of
+// them are set. Resetting to the default chunking returns an empty spec.
+func (f *chunkingFlags) spec() (*pfs.ChunkingSpec, error) {
+	unset := f.algorithm == "" && f.averageSize == "" && f.minSize == "" && f.maxSize == ""
+	if f.reset {
+		if !unset {
+			return nil, errors.New("--default-chunking cannot be combined with other chunking flags")
+		}
+		return &pfs.ChunkingSpec{}, nil
+	}
+	if unset {
+		return nil, nil
+	}
+	spec := &pfs.ChunkingSpec{}
+	var err error
+	if spec.Algorithm, err = parseChunkingAlgorithm(f.algorithm); err != nil {
+		return nil, err
+	}
+	if spec.AverageSizeBytes, err = parseChunkSize(f.averageSize); err != nil {
+		return nil, err
+	}
+	if spec.MinSizeBytes, err = parseChunkSize(f.minSize); err != nil {
+		return nil, err
+	}
+	if spec.MaxSizeBytes, err = parseChunkSize(f.maxSize); err != nil {
+		return nil, err
+	}
+	if _, err := chunk.ChunkingConfigFromSpec(spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func parseChunkingAlgorithm(s string) (pfs.ChunkingSpec_Algorithm, error) {
+	if s == "" {
+		return pfs.ChunkingSpec_DEFAULT, nil
+	}
+	algo, ok := pfs.ChunkingSpec_Algorithm_value[strings.ToUpper(s)]
+	if !ok {
+		return 0, errors.Errorf("unknown chunking algorithm %q", s)
+	}
+	return pfs.ChunkingSpec_Algorithm(algo), nil
+}
+
+func parseChunkSize(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	size, err := units.RAMInBytes(s)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid chunk size %q", s)
+	}
+	return size, nil
+}
+
+// parseChunkingConfig parses a chunking configuration of the form
+// "<algorithm>[:<average>[:<min>:<max>]]".
+func parseChunkingConfig(s string) (*chunk.ChunkingConfig, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 1 && len(parts) != 2 && len(parts) != 4 {
+		return nil, errors.Errorf("invalid chunking config %q, expected <algorithm>[:<average>[:<min>:<max>]]", s)
+	}
+	f := chunkingFlags{algorithm: parts[0]}
+	if len(parts) > 1 {
+		f.averageSize = parts[1]
+	}
+	if len(parts) > 2 {
+		f.minSize, f.maxSize = parts[2], parts[3]
+	}
+	spec, err := f.spec()
+	if err != nil {
+		return nil, err
+	}
+	return chunk.ChunkingConfigFromSpec(spec)
+}
+
+func chunkingCmds() []*cobra.Command {
+	var commands []*cobra.Command
+
+	var configStrs []string
+	chunkingReport := &cobra.Command{
+		Use:   "{{alias}} <path>",
+		Short: "Report the deduplication and throughput of chunking configurations on a local dataset.",
+		Long: `Report the deduplication and throughput of chunking configurations on a local dataset.
+
+The files under <path> are split into chunks with each chunking configuration,
+which can be used to choose the chunking of a repo. Configurations are given as
+<algorithm>[:<average>[:<min>:<max>]], and a default set is compared if none
+are given.`,
+		Example: `
+# compare the default configurations
+$ {{alias}} ./data
+
+# compare buzhash64 and fastcdc with 1MB average chunks
+$ {{alias}} ./data --config buzhash64:1MB --config fastcdc:1MB:256KB:4MB`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			configs := pfsload.DefaultChunkingConfigs()
+			if len(configStrs) > 0 {
+				configs = nil
+				for _, s := range configStrs {
+					config, err := parseChunkingConfig(s)
+					if err != nil {
+						return err
+					}
+					configs = append(configs, config)
+				}
+			}
+			results, err := pfsload.ChunkingReport(args[0], configs)
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, "ALGORITHM\tAVERAGE\tMIN\tMAX\tSIZE\tCHUNKS\tUNIQUE\tAVG CHUNK\tDEDUP RATIO\tTHROUGHPUT\t\n")
+			for _, r := range results {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t%.3f\t%s/s\t\n",
+					r.Config.Algo,
+					units.BytesSize(float64(r.Config.AverageSize)),
+					units.BytesSize(float64(r.Config.MinSize)),
+					units.BytesSize(float64(r.Config.MaxSize)),
+					units.BytesSize(float64(r.TotalBytes)),
+					r.Chunks,
+					r.UniqueChunks,
+					units.BytesSize(float64(r.AverageChunkSize())),
+					r.DedupRatio(),
+					units.BytesSize(r.Throughput()),
+				)
+			}
+			return w.Flush()
+		}),
+	}
+	chunkingReport.Flags().StringArrayVarP(&configStrs, "config", "c", nil, "A chunking configuration to compare, of the form <algorithm>[:<average>[:<min>:<max>]]. May be repeated.")
+	commands = append(commands, cmdutil.CreateAlias(chunkingReport, "run pfs-chunking-report"))
+
+	return commands
+}
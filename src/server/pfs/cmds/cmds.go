@@ -67,6 +67,8 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var chunking chunkingFlags
+	var compaction compactionFlags
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
@@ -78,12 +80,22 @@ or type (e.g. csv, binary, images, etc).`,
 			}
 			defer c.Close()
 
+			chunkingSpec, err := chunking.spec()
+			if err != nil {
+				return err
+			}
+			compactionSpec, err := compaction.spec()
+			if err != nil {
+				return err
+			}
 			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfs.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Chunking:    chunkingSpec,
+						Compaction:  compactionSpec,
 					},
 				)
 				return errors.EnsureStack(err)
@@ -92,6 +104,8 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	chunking.addFlags(createRepo)
+	compaction.addFlags(createRepo)
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -105,12 +119,22 @@ or type (e.g. csv, binary, images, etc).`,
 			}
 			defer c.Close()
 
+			chunkingSpec, err := chunking.spec()
+			if err != nil {
+				return err
+			}
+			compactionSpec, err := compaction.spec()
+			if err != nil {
+				return err
+			}
 			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfs.CreateRepoRequest{
 						Repo:        cmdutil.ParseRepo(args[0]),
 						Description: description,
+						Chunking:    chunkingSpec,
+						Compaction:  compactionSpec,
 						Update:      true,
 					},
 				)
@@ -120,6 +144,9 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	chunking.addFlags(updateRepo)
+	chunking.addResetFlag(updateRepo)
+	compaction.addFlags(updateRepo)
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
@@ -360,6 +387,7 @@ $ {{alias}} test@fork -p XXX`,
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(finishCommit, "finish commit"))
 
+	var showCompaction bool
 	inspectCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Return info about a commit.",
@@ -377,6 +405,21 @@ $ {{alias}} test@fork -p XXX`,
 			}
 			defer c.Close()
 
+			if showCompaction {
+				info, err := c.PfsAPIClient.InspectCommitCompaction(
+					c.Ctx(),
+					&pfs.InspectCommitCompactionRequest{Commit: commit},
+				)
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(info))
+				} else if output != "" {
+					return errors.New("cannot set --output (-o) without --raw")
+				}
+				return pretty.PrintCommitCompactionInfo(os.Stdout, info)
+			}
 			commitInfo, err := c.PfsAPIClient.InspectCommit(
 				c.Ctx(),
 				&pfs.InspectCommitRequest{
@@ -402,6 +445,7 @@ $ {{alias}} test@fork -p XXX`,
 		}),
 	}
 	inspectCommit.Flags().AddFlagSet(outputFlags)
+	inspectCommit.Flags().BoolVar(&showCompaction, "compaction", false, "Show the compaction levels of the commit's file sets and whether its compaction is deferred.")
 	inspectCommit.Flags().AddFlagSet(timestampFlags)
 	shell.RegisterCompletionFunc(inspectCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectCommit, "inspect commit"))
@@ -1666,6 +1710,8 @@ Objects are a low-level resource and should not be accessed directly by most use
 	runLoadTest.Flags().StringVarP(&branchStr, "branch", "b", "", "The branch to use for generating the load.")
 	runLoadTest.Flags().Int64VarP(&seed, "seed", "s", 0, "The seed to use for generating the load.")
 	commands = append(commands, cmdutil.CreateAlias(runLoadTest, "run pfs-load-test"))
+	commands = append(commands, chunkingCmds()...)
+	commands = append(commands, storageCmds()...)
 
 	// Add the mount commands (which aren't available on Windows, so they're in
 	// their own file)
@@ -0,0 +1,42 @@
+package cmds
+
+import (
+	units "github.com/docker/go-units"
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// compactionFlags are the flags used to configure the compaction of a repo.
+type compactionFlags struct {
+	levelFactor int64
+	deferSize   string
+	window      string
+}
+
+func (f *compactionFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64Var(&f.levelFactor, "compaction-level-factor", 0, "The size ratio between consecutive compaction levels of the repo's commits.")
+	cmd.Flags().StringVar(&f.deferSize, "compaction-defer-size", "", "The size of a commit's changes (e.g. 10GB) above which its compaction is deferred to the background.")
+	cmd.Flags().StringVar(&f.window, "compaction-window", "", "The daily time window (e.g. 01:00-05:00, in UTC) in which deferred compactions of the repo's commits run.")
+}
+
+// spec returns the compaction spec described by the flags, or nil if none of
+// them are set. The spec is validated by pachd.
+func (f *compactionFlags) spec() (*pfs.CompactionSpec, error) {
+	if f.levelFactor == 0 && f.deferSize == "" && f.window == "" {
+		return nil, nil
+	}
+	spec := &pfs.CompactionSpec{
+		LevelFactor: f.levelFactor,
+		Window:      f.window,
+	}
+	if f.deferSize != "" {
+		size, err := units.RAMInBytes(f.deferSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid compaction defer size %q", f.deferSize)
+		}
+		spec.DeferSizeBytes = size
+	}
+	return spec, nil
+}
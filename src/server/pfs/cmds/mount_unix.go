@@ -10,6 +10,7 @@ import (
 	"os"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
@@ -17,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs/fuse"
 	"github.com/sirupsen/logrus"
 
+	units "github.com/docker/go-units"
 	"github.com/hanwen/go-fuse/v2/fs"
 	gofuse "github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/spf13/cobra"
@@ -116,6 +118,9 @@ func mountCmds() []*cobra.Command {
 	commands = append(commands, cmdutil.CreateAlias(mount, "mount"))
 
 	var mountDir string
+	var writeBack bool
+	var flushInterval time.Duration
+	var flushSize, cacheSize string
 	mountServer := &cobra.Command{
 		Use:   "{{alias}}",
 		Short: "Start a mount server for controlling FUSE mounts via a local REST API.",
@@ -134,11 +139,32 @@ func mountCmds() []*cobra.Command {
 			serverOpts := &fuse.ServerOptions{
 				MountDir: mountDir,
 			}
+			if writeBack {
+				serverOpts.WriteBack = &fuse.WriteBackOptions{FlushInterval: flushInterval}
+				if flushSize != "" {
+					size, err := units.FromHumanSize(flushSize)
+					if err != nil {
+						return errors.Wrapf(err, "invalid --flush-size")
+					}
+					serverOpts.WriteBack.FlushSize = size
+				}
+			}
+			if cacheSize != "" {
+				size, err := units.FromHumanSize(cacheSize)
+				if err != nil {
+					return errors.Wrapf(err, "invalid --cache-size")
+				}
+				serverOpts.CacheSize = size
+			}
 			printWarning()
 			return fuse.Server(c, serverOpts)
 		}),
 	}
 	mountServer.Flags().StringVar(&mountDir, "mount-dir", "/pfs", "Target directory for mounts e.g /pfs")
+	mountServer.Flags().BoolVar(&writeBack, "write-back", false, "Upload changes made through writeable mounts in the background, into an open commit which is finished when the mount is committed or unmounted.")
+	mountServer.Flags().DurationVar(&flushInterval, "flush-interval", 30*time.Second, "How often write-back mounts upload their changes.")
+	mountServer.Flags().StringVar(&flushSize, "flush-size", "", "Upload the changes of write-back mounts as soon as they reach this size (e.g. 100MB), rather than waiting for --flush-interval.")
+	mountServer.Flags().StringVar(&cacheSize, "cache-size", "", "Bound the space taken up locally by files read through mounts (e.g. 10GB). Least recently used files are evicted first. Unbounded by default.")
 	commands = append(commands, cmdutil.CreateAlias(mountServer, "mount-server"))
 
 	var all bool
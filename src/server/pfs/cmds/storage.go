@@ -0,0 +1,305 @@
+package cmds
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+)
+
+func storageCmds() []*cobra.Command {
+	var commands []*cobra.Command
+
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
+
+	storageDocs := &cobra.Command{
+		Short: "Docs for storage.",
+		Long: `Storage commands operate on the chunk objects that hold the content of all
+repos in the backend object store.
+
+Storage is a low-level resource and should not be accessed directly by most users.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(storageDocs, "storage", " storage "))
+
+	var repair bool
+	var rate string
+	scrub := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Verify the chunk objects in object storage.",
+		Long: `Verify the chunk objects in object storage.
+
+Every chunk object is read and its hash is checked, and the files in finished
+commits with content in missing or corrupt objects are reported. With --repair,
+bad objects are copied from the replica object stores configured with
+STORAGE_REPLICA_URLS.`,
+		Example: `
+# verify all chunk objects, reading at most 50MB per second
+$ {{alias}} --rate 50MB
+
+# verify and repair all chunk objects
+$ {{alias}} --repair`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			req := &pfs.ScrubStorageRequest{Repair: repair}
+			if rate != "" {
+				bytesPerSecond, err := units.RAMInBytes(rate)
+				if err != nil {
+					return errors.Wrapf(err, "invalid rate %q", rate)
+				}
+				req.BytesPerSecond = bytesPerSecond
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			scrubClient, err := c.PfsAPIClient.ScrubStorage(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				e := cmdutil.Encoder(output, os.Stdout)
+				return grpcutil.ScrubGRPC(clientsdk.ForEachScrubStorageResponse(scrubClient, func(resp *pfs.ScrubStorageResponse) error {
+					return errors.EnsureStack(e.EncodeProto(resp))
+				}))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			var count int64
+			var findings []*pfs.ScrubStorageFinding
+			var unreadableCommits []*pfs.Commit
+			files := make(map[string][]*pfs.File)
+			if err := clientsdk.ForEachScrubStorageResponse(scrubClient, func(resp *pfs.ScrubStorageResponse) error {
+				switch {
+				case resp.Finding != nil:
+					findings = append(findings, resp.Finding)
+				case resp.File != nil:
+					key := string(resp.File.ChunkId)
+					files[key] = append(files[key], resp.File.File)
+				case resp.UnreadableCommit != nil:
+					unreadableCommits = append(unreadableCommits, resp.UnreadableCommit)
+				default:
+					count = resp.ChunkObjectCount
+					if len(findings) > 0 {
+						fmt.Fprintf(os.Stderr, "Found %d bad chunk objects, finding the affected files.\n", len(findings))
+					}
+				}
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if len(findings) == 0 {
+				fmt.Printf("No bad chunk objects found in %d objects.\n", count)
+				return nil
+			}
+			fmt.Printf("Found %d bad chunk objects in %d objects.\n", len(findings), count)
+			w := tabwriter.NewWriter(os.Stdout, pretty.ScrubFindingHeader)
+			for _, finding := range findings {
+				pretty.PrintScrubFinding(w, finding, files[string(finding.ChunkId)])
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			for _, commit := range unreadableCommits {
+				fmt.Printf("Commit %s could not be read, it may reference a bad chunk object.\n", pretty.CompactPrintCommit(commit))
+			}
+			return nil
+		}),
+	}
+	scrub.Flags().BoolVar(&repair, "repair", false, "Copy missing or corrupt chunk objects from the replica object stores.")
+	scrub.Flags().StringVar(&rate, "rate", "", "The maximum number of bytes per second to read from object storage (e.g. 50MB).")
+	scrub.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(scrub, "storage scrub"))
+
+	replicationStatus := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Return the status of the replication of chunk objects.",
+		Long: `Return the status of the replication of chunk objects.
+
+Chunk objects are asynchronously copied to the replica object stores configured
+with STORAGE_REPLICA_URLS. The lag of a replica is the age of the oldest chunk
+object that hasn't been copied to it yet.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.ReplicationStatus(c.Ctx(), &pfs.ReplicationStatusRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			if len(resp.Replicas) == 0 {
+				fmt.Println("No replicas are configured.")
+				return nil
+			}
+			w := tabwriter.NewWriter(os.Stdout, pretty.ReplicaStatusHeader)
+			for _, status := range resp.Replicas {
+				pretty.PrintReplicaStatus(w, status)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if resp.Failover {
+				fmt.Println("Reads fail over to the replicas when the primary object store errors.")
+			}
+			return nil
+		}),
+	}
+	replicationStatus.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(replicationStatus, "storage replication status"))
+
+	var dryRun bool
+	gc := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Run garbage collection on the chunk objects.",
+		Long: `Run garbage collection on the chunk objects.
+
+Garbage collection deletes the file sets and chunk objects which are no longer
+referenced. With --dry-run, the chunk objects which would be deleted are listed
+and nothing is deleted.
+
+The chunk objects deleted by garbage collection are moved under the trash/
+prefix of the object store, and purged after STORAGE_GC_TRASH_PERIOD seconds,
+if it is set. They can be restored with 'pachctl storage gc restore'.`,
+		Example: `
+# list the chunk objects that garbage collection would delete
+$ {{alias}} --dry-run`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.GarbageCollectStorage(c.Ctx(), &pfs.GarbageCollectStorageRequest{DryRun: dryRun})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			if dryRun {
+				if len(resp.Chunks) > 0 {
+					w := tabwriter.NewWriter(os.Stdout, pretty.GarbageChunkHeader)
+					for _, chunk := range resp.Chunks {
+						pretty.PrintGarbageChunk(w, chunk)
+					}
+					if err := w.Flush(); err != nil {
+						return err
+					}
+				}
+				fmt.Printf("Would delete %d chunk objects (%s) and %d tracked objects.\n",
+					resp.ChunkCount, units.BytesSize(float64(resp.ChunkBytes)), resp.TrackerObjectCount)
+			} else {
+				fmt.Printf("Deleted %d chunk objects and %d tracked objects.\n",
+					resp.DeletedChunkCount, resp.DeletedTrackerObjectCount)
+			}
+			if resp.TrashCount > 0 {
+				fmt.Printf("%d chunk objects (%s) are in the trash.\n", resp.TrashCount, units.BytesSize(float64(resp.TrashBytes)))
+			}
+			if resp.Paused {
+				fmt.Println("Garbage collection is paused.")
+			}
+			return nil
+		}),
+	}
+	gc.Flags().BoolVar(&dryRun, "dry-run", false, "List what garbage collection would delete, without deleting anything.")
+	gc.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(gc, "storage gc"))
+
+	setGCPaused := func(paused bool) error {
+		c, err := client.NewOnUserMachine("user")
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		_, err = c.PfsAPIClient.SetStorageGCPaused(c.Ctx(), &pfs.SetStorageGCPausedRequest{Paused: paused})
+		return grpcutil.ScrubGRPC(err)
+	}
+	pauseGC := &cobra.Command{
+		Short: "Pause garbage collection.",
+		Long: `Pause garbage collection.
+
+While garbage collection is paused, nothing is deleted from the object store
+and expired file sets are kept, until it is resumed.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			return setGCPaused(true)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(pauseGC, "storage gc pause"))
+
+	resumeGC := &cobra.Command{
+		Short: "Resume garbage collection.",
+		Long:  "Resume garbage collection.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			return setGCPaused(false)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(resumeGC, "storage gc resume"))
+
+	var ttl time.Duration
+	restoreTrash := &cobra.Command{
+		Use:   "{{alias}} [<chunk-id>...]",
+		Short: "Restore chunk objects from the trash.",
+		Long: `Restore chunk objects from the trash.
+
+The chunk objects are moved out of the trash/ prefix of the object store, back
+to where they were before garbage collection deleted them, and are tracked
+again. Everything in the trash is restored if no chunk IDs are given.
+
+Restored chunk objects that are not referenced by a file set are deleted again
+by garbage collection once the --ttl expires.`,
+		Example: `
+# restore everything in the trash
+$ {{alias}}
+
+# restore a single chunk object, and keep it for a day
+$ {{alias}} 9a6f4a9c0c1b4ea8b2d58c3bd7e9e5f1e0b7c2d4a6f8e1c3b5d7f9a2c4e6b8d0 --ttl 24h`,
+		Run: cmdutil.Run(func(args []string) error {
+			req := &pfs.RestoreStorageTrashRequest{Ttl: types.DurationProto(ttl)}
+			for _, arg := range args {
+				id, err := hex.DecodeString(arg)
+				if err != nil {
+					return errors.Wrapf(err, "invalid chunk ID %q", arg)
+				}
+				req.ChunkIds = append(req.ChunkIds, id)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.RestoreStorageTrash(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Printf("Restored %d chunk objects (%s).\n", resp.RestoredCount, units.BytesSize(float64(resp.RestoredBytes)))
+			return nil
+		}),
+	}
+	restoreTrash.Flags().DurationVar(&ttl, "ttl", 30*time.Minute, "How long to keep the restored chunk objects if they are not referenced by a file set.")
+	commands = append(commands, cmdutil.CreateAlias(restoreTrash, "storage gc restore"))
+
+	return commands
+}
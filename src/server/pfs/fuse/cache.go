@@ -0,0 +1,104 @@
+package fuse
+
+import (
+	"strings"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+// readCache bounds the space taken up by file contents downloaded into the
+// loopback filesystem. Files are evicted in least recently used order, by
+// truncating them back to sparse files of the right size, so that they're
+// downloaded again the next time they're opened. Files with changes that
+// haven't been uploaded, and files that are open, are never evicted.
+//
+// A nil *readCache is valid and caches without bound. readCache isn't safe
+// for concurrent use, callers must hold loopbackRoot.mu.
+type readCache struct {
+	maxBytes int64
+	size     int64
+	// lru maps paths relative to the loopback root onto their size in bytes
+	lru *simplelru.LRU
+}
+
+func newReadCache(maxBytes int64) *readCache {
+	if maxBytes <= 0 {
+		return nil
+	}
+	// the LRU is bounded by size in bytes rather than number of entries, see
+	// add()
+	lru, err := simplelru.NewLRU(int(^uint(0)>>1), nil)
+	if err != nil {
+		panic(err)
+	}
+	return &readCache{maxBytes: maxBytes, lru: lru}
+}
+
+// cacheEntry is a file in the read cache
+type cacheEntry struct {
+	path string
+	size int64
+}
+
+// add records that the full contents of 'path' are cached locally, and returns
+// the files which should be evicted to make room for it. 'evictable' reports
+// whether a path can be evicted right now, paths which can't are kept.
+func (c *readCache) add(path string, size int64, evictable func(string) bool) []cacheEntry {
+	if c == nil {
+		return nil
+	}
+	c.remove(path)
+	c.lru.Add(path, size)
+	c.size += size
+	var evicted, kept []cacheEntry
+	for c.size > c.maxBytes && c.lru.Len() > 0 {
+		key, value, _ := c.lru.RemoveOldest()
+		e := cacheEntry{path: key.(string), size: value.(int64)}
+		// the file that was just added is kept even if it's bigger than the
+		// whole cache, as it's about to be read
+		if e.path == path || !evictable(e.path) {
+			kept = append(kept, e)
+			continue
+		}
+		c.size -= e.size
+		evicted = append(evicted, e)
+	}
+	// files that couldn't be evicted go back in as the most recently used, so
+	// that they're not considered again until they've aged
+	for _, e := range kept {
+		c.lru.Add(e.path, e.size)
+	}
+	return evicted
+}
+
+// touch marks 'path' as recently used
+func (c *readCache) touch(path string) {
+	if c == nil {
+		return
+	}
+	c.lru.Get(path)
+}
+
+// remove forgets about 'path', e.g. because it's been changed locally
+func (c *readCache) remove(path string) {
+	if c == nil {
+		return
+	}
+	if value, ok := c.lru.Peek(path); ok {
+		c.size -= value.(int64)
+		c.lru.Remove(path)
+	}
+}
+
+// removePrefix forgets about all the cached files of the mount 'name'
+func (c *readCache) removePrefix(name string) {
+	if c == nil {
+		return
+	}
+	for _, key := range c.lru.Keys() {
+		p := key.(string)
+		if p == name || strings.HasPrefix(p, name+"/") {
+			c.remove(p)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package fuse
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestReadCacheEviction(t *testing.T) {
+	c := newReadCache(10)
+	all := func(string) bool { return true }
+	require.Equal(t, 0, len(c.add("repo/a", 4, all)))
+	require.Equal(t, 0, len(c.add("repo/b", 4, all)))
+	// 'a' is the least recently used, until it's touched
+	c.touch("repo/a")
+	evicted := c.add("repo/c", 4, all)
+	require.Equal(t, 1, len(evicted))
+	require.Equal(t, "repo/b", evicted[0].path)
+	require.Equal(t, int64(4), evicted[0].size)
+	require.Equal(t, int64(8), c.size)
+
+	// files that can't be evicted are skipped
+	evicted = c.add("repo/d", 4, func(p string) bool { return p != "repo/a" })
+	require.Equal(t, 1, len(evicted))
+	require.Equal(t, "repo/c", evicted[0].path)
+
+	// a file bigger than the whole cache is kept, as it's about to be read
+	evicted = c.add("repo/e", 20, all)
+	require.Equal(t, 2, len(evicted))
+	require.Equal(t, int64(20), c.size)
+
+	c.removePrefix("repo")
+	require.Equal(t, int64(0), c.size)
+	require.Equal(t, 0, c.lru.Len())
+}
+
+func TestReadCacheUnbounded(t *testing.T) {
+	var c *readCache = newReadCache(0)
+	require.Nil(t, c)
+	require.Equal(t, 0, len(c.add("repo/a", 1<<40, func(string) bool { return true })))
+	c.touch("repo/a")
+	c.remove("repo/a")
+	c.removePrefix("repo")
+}
@@ -23,6 +23,10 @@ func NewLoopbackFile(fd int) fs.FileHandle {
 type loopbackFile struct {
 	mu sync.Mutex
 	fd int
+	// written, if set, is called after each change made through the file
+	written func()
+	// released, if set, is called when the file is released
+	released func()
 }
 
 var _ = (fs.FileHandle)((*loopbackFile)(nil))
@@ -50,6 +54,9 @@ func (f *loopbackFile) Write(ctx context.Context, data []byte, off int64) (uint3
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	n, err := syscall.Pwrite(f.fd, data, off)
+	if n > 0 && f.written != nil {
+		f.written()
+	}
 	return uint32(n), fs.ToErrno(err)
 }
 
@@ -59,6 +66,9 @@ func (f *loopbackFile) Release(ctx context.Context) syscall.Errno {
 	if f.fd != -1 {
 		err := syscall.Close(f.fd)
 		f.fd = -1
+		if f.released != nil {
+			f.released()
+		}
 		return fs.ToErrno(err)
 	}
 	return syscall.EBADF
@@ -205,6 +215,9 @@ func (f *loopbackFile) setAttr(ctx context.Context, in *fuse.SetAttrIn) syscall.
 		if errno != 0 {
 			return errno
 		}
+		if f.written != nil {
+			f.written()
+		}
 	}
 	return fs.OK
 }
@@ -5,8 +5,6 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
-	pathpkg "path"
-	"path/filepath"
 	"strings"
 
 	"github.com/hanwen/go-fuse/v2/fs"
@@ -127,21 +125,7 @@ func Mount(c *client.APIClient, target string, opts *Options) (retErr error) {
 		if err != nil {
 			return err
 		}
-		if err := func() (retErr error) {
-			f, err := progress.Open(filepath.Join(root.rootPath, path))
-			if err != nil {
-				if errors.Is(err, os.ErrNotExist) {
-					return mfc.DeleteFile(pathpkg.Join(parts[1:]...))
-				}
-				return errors.WithStack(err)
-			}
-			defer func() {
-				if err := f.Close(); err != nil && retErr == nil {
-					retErr = errors.WithStack(err)
-				}
-			}()
-			return mfc.PutFile(pathpkg.Join(parts[1:]...), f)
-		}(); err != nil {
+		if _, err := uploadFile(mfc, root.rootPath, path); err != nil {
 			return err
 		}
 	}
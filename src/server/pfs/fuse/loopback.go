@@ -14,6 +14,7 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/hanwen/go-fuse/v2/fs"
 	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/sirupsen/logrus"
@@ -77,7 +78,13 @@ type loopbackRoot struct {
 	branches map[string]string       // key is mount name
 	commits  map[string]string       // key is mount name
 	files    map[string]fileState    // key is {mount_name}/{path}
+	open     map[string]int          // key is {mount_name}/{path}, value is the number of open handles
+	cache    *readCache
 	mu       sync.Mutex
+
+	// downloads is held for reading by each download into a mount, and for
+	// writing while the mount's contents are removed. Key is mount name.
+	downloads map[string]*sync.RWMutex
 }
 
 type loopbackNode struct {
@@ -298,7 +305,13 @@ func (n *loopbackNode) Create(ctx context.Context, name string, flags uint32, mo
 
 	node := &loopbackNode{}
 	ch := n.NewInode(ctx, node, n.root().idFromStat(&st))
-	lf := NewLoopbackFile(fd)
+	rel := n.trimPath(p)
+	n.root().opened(rel)
+	lf := &loopbackFile{
+		fd:       fd,
+		written:  func() { n.setFileState(p, dirty) },
+		released: func() { n.root().released(rel) },
+	}
 
 	out.FromStat(&st)
 	return ch, lf, 0, 0
@@ -395,6 +408,15 @@ func (n *loopbackNode) Open(ctx context.Context, flags uint32) (fh fs.FileHandle
 		}
 		state = dirty
 	}
+	// count the file as open before downloading it, so that it can't be
+	// evicted from the read cache before we get to open it
+	rel := n.trimPath(p)
+	n.root().opened(rel)
+	defer func() {
+		if errno != 0 {
+			n.root().released(rel)
+		}
+	}()
 	if err := n.download(p, state); err != nil {
 		return nil, 0, fs.ToErrno(err)
 	}
@@ -409,7 +431,16 @@ func (n *loopbackNode) Open(ctx context.Context, flags uint32) (fh fs.FileHandle
 	if err != nil {
 		return nil, 0, fs.ToErrno(err)
 	}
-	lf := NewLoopbackFile(f)
+	lf := &loopbackFile{
+		fd:       f,
+		released: func() { n.root().released(rel) },
+	}
+	if isWrite(flags) {
+		// changes are uploaded in the background for write-back mounts, so
+		// the file has to be marked dirty again if it's written to after
+		// it's been uploaded
+		lf.written = func() { n.setFileState(p, dirty) }
+	}
 	return lf, 0, 0
 }
 
@@ -459,6 +490,17 @@ func (n *loopbackNode) Setattr(ctx context.Context, f fs.FileHandle, in *fuse.Se
 	if ok && fsa != nil {
 		fsa.Setattr(ctx, in, out)
 	} else {
+		// Changing the mode or mtime of a file in a writeable mount marks it
+		// dirty so that the new attributes are uploaded. It has to be fully
+		// downloaded first so that its content is uploaded along with them.
+		_, modeOK := in.GetMode()
+		_, mtimeOK := in.GetMTime()
+		if (modeOK || mtimeOK) && n.checkWrite(p) == 0 {
+			if err := n.download(p, full); err != nil {
+				return fs.ToErrno(err)
+			}
+			defer n.setFileState(p, dirty)
+		}
 		if m, ok := in.GetMode(); ok {
 			if err := syscall.Chmod(p, m); err != nil {
 				return fs.ToErrno(err)
@@ -542,13 +584,80 @@ func newLoopbackRoot(root, target string, c *client.APIClient, opts *Options) (*
 		c:          c,
 		repoOpts:   opts.getRepoOpts(),
 		branches:   opts.getBranches(),
-		commits:    make(map[string]string),
+		commits:    opts.getCommits(),
 		files:      make(map[string]fileState),
+		open:       make(map[string]int),
+		cache:      newReadCache(opts.getCacheSize()),
 		stateMap:   make(map[string]string),
+		downloads:  make(map[string]*sync.RWMutex),
 	}
 	return n, nil
 }
 
+// downloadLock returns the lock that downloads into the mount 'name' hold for
+// reading
+func (l *loopbackRoot) downloadLock(name string) *sync.RWMutex {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.downloads[name]; !ok {
+		l.downloads[name] = &sync.RWMutex{}
+	}
+	return l.downloads[name]
+}
+
+func (l *loopbackRoot) opened(path string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.open[path]++
+	l.cache.touch(path)
+}
+
+func (l *loopbackRoot) released(path string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.open[path]--
+	if l.open[path] <= 0 {
+		delete(l.open, path)
+	}
+}
+
+// cacheFile records that the full contents of 'path' have been downloaded (or
+// uploaded), and evicts other files from the read cache to make room for it
+func (l *loopbackRoot) cacheFile(path string, size int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	evicted := l.cache.add(path, size, func(p string) bool {
+		return l.files[p] == full && l.open[p] == 0
+	})
+	for _, e := range evicted {
+		// truncate the file to nothing and back, to free its blocks while
+		// leaving it the right size, as if only its metadata had been
+		// downloaded
+		fullPath := filepath.Join(l.rootPath, e.path)
+		if err := os.Truncate(fullPath, 0); err != nil {
+			logrus.Infof("Error evicting %s from the cache: %s", e.path, err)
+			continue
+		}
+		if err := os.Truncate(fullPath, e.size); err != nil {
+			logrus.Infof("Error evicting %s from the cache: %s", e.path, err)
+			continue
+		}
+		l.files[e.path] = meta
+	}
+}
+
+// setCommit pins the mount 'name' to 'commit', or, if 'commit' is "", lets it
+// follow the head of its branch
+func (l *loopbackRoot) setCommit(name, commit string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if commit == "" {
+		delete(l.commits, name)
+		return
+	}
+	l.commits[name] = commit
+}
+
 func (n *loopbackNode) mkdirMountNames() (retErr error) {
 	defer func() {
 		if retErr == nil {
@@ -579,23 +688,27 @@ func (n *loopbackNode) download(origPath string, state fileState) (retErr error)
 	}
 	path := n.trimPath(origPath)
 	parts := strings.Split(path, "/")
-	defer func() {
-		if retErr == nil {
-			n.setFileState(path, state)
-		}
-	}()
 	// Note, len(parts) < 1 should not actually be possible, but just in case
 	// no need to panic.
 	if len(parts) < 1 || parts[0] == "" {
+		n.setFileState(path, state)
 		return nil // already downloaded in downloadRepos
 	}
 	name := parts[0]
+	// hold the mount's download lock until the file states are recorded, so
+	// that remounting waits for this download before clearing the mount
+	dl := n.root().downloadLock(name)
+	dl.RLock()
+	defer dl.RUnlock()
+	defer func() {
+		if retErr == nil {
+			n.setFileState(path, state)
+		}
+	}()
 	st := n.root().getState(name)
 	// don't download while we're anything other than mounted
-	// TODO: we probably want some more locking/coordination (in the other
-	// direction) to stop the state machine changing state _during_ a download()
 	// NB: empty string case is to support pachctl mount as well as mount-server
-	if !(st == "" || st == "mounted") {
+	if !(st == "" || st == "mounted" || st == "committing") {
 		logrus.Infof(
 			"Skipping download('%s') because %s state was %s; "+
 				"getFileState(%s) -> %d, state=%d",
@@ -638,6 +751,12 @@ func (n *loopbackNode) download(origPath string, state fileState) (retErr error)
 		if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
 			return errors.WithStack(err)
 		}
+		if fi.Attributes.IsSymlink() {
+			if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+				return errors.WithStack(err)
+			}
+			return errors.WithStack(os.Symlink(fi.Attributes.SymlinkTarget, p))
+		}
 		f, err := os.Create(p)
 		if err != nil {
 			return errors.WithStack(err)
@@ -646,6 +765,9 @@ func (n *loopbackNode) download(origPath string, state fileState) (retErr error)
 			if err := f.Close(); err != nil && retErr == nil {
 				retErr = errors.WithStack(err)
 			}
+			if retErr == nil {
+				retErr = applyAttributes(p, fi.Attributes)
+			}
 		}()
 		if state < full {
 			return errors.EnsureStack(f.Truncate(int64(fi.SizeBytes)))
@@ -653,6 +775,7 @@ func (n *loopbackNode) download(origPath string, state fileState) (retErr error)
 		if err := n.c().GetFile(fi.File.Commit, fi.File.Path, f); err != nil {
 			return err
 		}
+		n.root().cacheFile(n.trimPath(p), int64(fi.SizeBytes))
 		return nil
 	}); err != nil && !errutil.IsNotFoundError(err) &&
 		!pfsserver.IsOutputCommitNotFinishedErr(err) {
@@ -661,6 +784,26 @@ func (n *loopbackNode) download(origPath string, state fileState) (retErr error)
 	return nil
 }
 
+// applyAttributes applies the mode and mtime of a file in PFS to its copy in
+// the loopback filesystem.
+func applyAttributes(p string, attrs *pfs.FileAttributes) error {
+	if attrs.GetMode() != 0 {
+		if err := os.Chmod(p, attrs.FileMode()); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if attrs.GetMtime() != nil {
+		mtime, err := types.TimestampFromProto(attrs.Mtime)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := os.Chtimes(p, mtime, mtime); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
 func (n *loopbackNode) trimPath(path string) string {
 	path = strings.TrimPrefix(path, n.root().rootPath)
 	return strings.TrimPrefix(path, "/")
@@ -672,7 +815,9 @@ func (n *loopbackNode) trimTargetPath(path string) string {
 }
 
 func (n *loopbackNode) branch(name string) string {
-	// no need to lock mu for branches since we only ever read from it.
+	// branches is written to when a mount is switched to another branch
+	n.root().mu.Lock()
+	defer n.root().mu.Unlock()
 	if branch, ok := n.root().branches[name]; ok {
 		return branch
 	}
@@ -731,7 +876,12 @@ func (n *loopbackNode) getFileState(path string) fileState {
 func (n *loopbackNode) setFileState(path string, state fileState) {
 	n.root().mu.Lock()
 	defer n.root().mu.Unlock()
-	n.root().files[n.trimPath(path)] = state
+	path = n.trimPath(path)
+	if state == dirty {
+		// local changes mustn't be evicted
+		n.root().cache.remove(path)
+	}
+	n.root().files[path] = state
 }
 
 func (n *loopbackNode) checkWrite(path string) syscall.Errno {
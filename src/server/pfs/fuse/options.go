@@ -1,6 +1,8 @@
 package fuse
 
 import (
+	"time"
+
 	"github.com/hanwen/go-fuse/v2/fs"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -25,6 +27,23 @@ type Options struct {
 	// Unmount is a channel that will be closed when the filesystem has been
 	// unmounted. It can be nil in which case it's ignored.
 	Unmount chan struct{}
+
+	// WriteBack, if set, makes writeable mounts upload changes in the
+	// background rather than only when they're committed or unmounted.
+	WriteBack *WriteBackOptions
+
+	// CacheSize bounds the space, in bytes, taken up locally by the contents
+	// of files read through the mount. 0 means unbounded.
+	CacheSize int64
+}
+
+// WriteBackOptions configure how changes are uploaded by write-back mounts.
+type WriteBackOptions struct {
+	// FlushInterval is how often changes are uploaded.
+	FlushInterval time.Duration
+	// FlushSize is the total size, in bytes, of local changes at which they're
+	// uploaded without waiting for FlushInterval. 0 means no limit.
+	FlushSize int64
 }
 
 // RepoOptions are the options associated with a mounted repo.
@@ -37,6 +56,9 @@ type RepoOptions struct {
 	Repo string
 	// Branch is the branch of the repo to mount
 	Branch string
+	// Commit is the ID of a commit (or global commit) on Branch to mount, or
+	// "" to mount the head of Branch. Commits are always mounted read-only.
+	Commit string
 	// Write indicates that the repo should be mounted for writing.
 	Write bool
 }
@@ -70,6 +92,19 @@ func (o *Options) getBranches() map[string]string {
 	return result
 }
 
+func (o *Options) getCommits() map[string]string {
+	result := make(map[string]string)
+	if o == nil {
+		return result
+	}
+	for name, opts := range o.RepoOptions {
+		if opts.Commit != "" {
+			result[name] = opts.Commit
+		}
+	}
+	return result
+}
+
 func (o *Options) getWrite() bool {
 	if o == nil {
 		return false
@@ -77,6 +112,20 @@ func (o *Options) getWrite() bool {
 	return o.Write
 }
 
+func (o *Options) getWriteBack() *WriteBackOptions {
+	if o == nil {
+		return nil
+	}
+	return o.WriteBack
+}
+
+func (o *Options) getCacheSize() int64 {
+	if o == nil {
+		return 0
+	}
+	return o.CacheSize
+}
+
 func (o *Options) getUnmount() chan struct{} {
 	if o == nil {
 		return nil
@@ -90,6 +139,9 @@ func (o *Options) validate(c *client.APIClient) error {
 	}
 	for _, opts := range o.RepoOptions {
 		if opts.Write {
+			if opts.Commit != "" {
+				return errors.Errorf("can't mount commit %s@%s as %s in Write mode (mount a branch instead)", opts.Repo, opts.Commit, opts.Name)
+			}
 			if uuid.IsUUIDWithoutDashes(opts.Branch) {
 				return errors.Errorf("can't mount commit %s@%s as %s in Write mode (mount a branch instead)", opts.Repo, opts.Branch, opts.Name)
 			}
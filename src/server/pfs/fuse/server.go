@@ -5,15 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"os/signal"
-	pathpkg "path"
-	"path/filepath"
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/hanwen/go-fuse/v2/fs"
@@ -26,6 +26,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
 /*
@@ -58,19 +59,21 @@ E.g. will result in `/pfs/{name}` being mounted.
 
 MountCommit(repo, branch, commit, name)
 ---------------------------------------
-TODO: implement this.
-
 Mount the specified commit or global commit of the given branch of the given
 repo into the configured directories.
 
 Will result in `/pfs/{name}` being mounted.
 
-If the commit is open, the mount may be writeable until it's closed.
-(TODO: Maybe commits should only be mounted read-only to simplify?)
+Commits are always mounted read-only. If the commit is closed, the reader can
+assume the data is immutable.
 
-If the commit is closed, the reader can assume the data is immutable.
+Note that repo-branch-commit mounts are disjoint from repo-branch mounts, so
+several versions of the same repo can be mounted side by side under different
+names.
 
-Note that repo-branch-commit mounts are disjoint from repo-branch mounts.
+Mounting a different branch or commit of the same repo at a name which is
+already mounted read-only switches the mount over to it in place, without
+unmounting it first.
 
 
 UnmountBranch(repo, branch)
@@ -92,14 +95,20 @@ UnmountByName(name)
 Unmount whatever is mounted at e.g. `/pfs/{name}`
 
 
-CommitBranch(repo, branch, message)
------------------------------------
-TODO: implement this
+CommitBranch(repo, branch, name, message)
+-----------------------------------------
+Persist the current state of the given mount to a new commit in Pachyderm,
+with the given commit message, without unmounting it.
 
-Persist the current state of the given mount to a new commit in Pachyderm.
+CommitBranch is not supported on repo-branch-commit mounts, as they are not
+writeable.
 
-CommitBranch is not supported on repo-branch-commit mounts (as they are not
-writeable?)
+If the server is run with write-back enabled, changes made through writeable
+mounts are uploaded into an open commit in the background, every flush
+interval or whenever the changes grow past the flush size, and CommitBranch
+finishes that commit. The progress of uploads, and any errors, are reported in
+the write_back field of each mount's state. Otherwise changes are only
+uploaded by CommitBranch or when the mount is unmounted.
 
 
 Config()
@@ -141,15 +150,23 @@ type ServerOptions struct {
 	// Unmount is a channel that will be closed when the filesystem has been
 	// unmounted. It can be nil in which case it's ignored.
 	Unmount chan struct{}
+	// WriteBack, if set, makes writeable mounts upload their changes in the
+	// background.
+	WriteBack *WriteBackOptions
+	// CacheSize bounds the space, in bytes, taken up locally by the contents
+	// of files read through mounts. 0 means unbounded.
+	CacheSize int64
 }
 
 type Request struct {
-	Mount  bool // true for desired state == mounted, false for desired state == unmounted
-	Repo   string
-	Branch string
-	Commit string // "" for no commit
-	Name   string
-	Mode   string // "ro", "rw"
+	Mount   bool // true for desired state == mounted, false for desired state == unmounted
+	Finish  bool // true to commit the changes made through a mounted branch, rather than (un)mount it
+	Repo    string
+	Branch  string
+	Commit  string // "" for no commit
+	Name    string
+	Mode    string // "ro", "rw"
+	Message string // commit message, for Finish requests
 }
 
 type Response struct {
@@ -167,12 +184,15 @@ type MountManager struct {
 	// it. i.e. when we try to mount it for the first time.
 	States map[string]*MountStateMachine
 	// map from mount name onto mfc for that mount
-	mfcs   map[string]*client.ModifyFileClient
-	root   *loopbackRoot
-	opts   *Options
-	tmpDir string
-	target string
-	mu     sync.Mutex
+	mfcs map[string]*client.ModifyFileClient
+	// map from mount name onto the open commit that changes to that mount are
+	// being uploaded into
+	openCommits map[string]*pfs.Commit
+	root        *loopbackRoot
+	opts        *Options
+	tmpDir      string
+	target      string
+	mu          sync.Mutex
 }
 
 func (mm *MountManager) ListByRepos() (ListRepoResponse, error) {
@@ -199,9 +219,10 @@ func (mm *MountManager) ListByRepos() (ListRepoResponse, error) {
 				Branch: branch.Branch.Name,
 				Commit: "",
 			}
-			// Add all mounts associated with a repo/branch
+			// Add all mounts associated with a repo/branch, including mounts
+			// of specific commits on it
 			for _, msm := range mm.States {
-				if msm.MountKey == k && msm.State != "unmounted" {
+				if msm.MountKey.Repo == k.Repo && msm.MountKey.Branch == k.Branch && msm.State != "unmounted" {
 					br.Mount = append(br.Mount, msm.MountState)
 				}
 			}
@@ -232,7 +253,7 @@ func (mm *MountManager) ListByMounts() (ListMountResponse, error) {
 	for name, msm := range mm.States {
 		if msm.State == "mounted" || msm.State == "unmouting" {
 			mr.Mounted[name] = msm.MountState
-			seen[msm.MountKey] = true
+			seen[MountKey{Repo: msm.MountKey.Repo, Branch: msm.MountKey.Branch}] = true
 		}
 	}
 	// Iterate through repos/branches because there will be some not present in
@@ -248,8 +269,6 @@ func (mm *MountManager) ListByMounts() (ListMountResponse, error) {
 		}
 		for _, branch := range bs {
 			mk := MountKey{Repo: repo.Repo.Name, Branch: branch.Branch.Name}
-			// TODO: Might need to modify this when we start using Commit and
-			// and compare only repo and branch names
 			if _, ok := seen[mk]; !ok {
 				mr.Unmounted = append(mr.Unmounted, MountState{MountKey: mk, State: "unmounted"})
 			}
@@ -321,11 +340,24 @@ func (mm *MountManager) UnmountBranch(key MountKey, name string) (Response, erro
 	return response, response.Error
 }
 
+func (mm *MountManager) FinishCommit(key MountKey, name, message string) (Response, error) {
+	mm.MaybeStartFsm(name)
+	mm.States[name].requests <- Request{
+		Finish:  true,
+		Repo:    key.Repo,
+		Branch:  key.Branch,
+		Commit:  key.Commit,
+		Name:    name,
+		Message: message,
+	}
+	response := <-mm.States[name].responses
+	return response, response.Error
+}
+
 func (mm *MountManager) UnmountAll() error {
 	for name, msm := range mm.States {
 		if msm.State == "mounted" {
-			//TODO: Add Commit field here once we support mounting specific commits
-			_, err := mm.UnmountBranch(MountKey{Repo: msm.MountKey.Repo, Branch: msm.MountKey.Branch}, name)
+			_, err := mm.UnmountBranch(msm.MountKey, name)
 			if err != nil {
 				return err
 			}
@@ -361,6 +393,8 @@ func NewMountManager(c *client.APIClient, target string, opts *Options) (ret *Mo
 		target: target,
 		tmpDir: rootDir,
 		mu:     sync.Mutex{},
+
+		openCommits: map[string]*pfs.Commit{},
 	}, nil
 }
 
@@ -398,6 +432,11 @@ func (mm *MountManager) FinishAll() (retErr error) {
 			retErr = err
 		}
 	}
+	for _, commit := range mm.openCommits {
+		if err := mm.Client.FinishCommit(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID); err != nil && retErr == nil {
+			retErr = err
+		}
+	}
 	return retErr
 }
 
@@ -415,7 +454,9 @@ func Server(c *client.APIClient, sopts *ServerOptions) error {
 		},
 		RepoOptions: make(map[string]*RepoOptions),
 		// thread this through for the tests
-		Unmount: sopts.Unmount,
+		Unmount:   sopts.Unmount,
+		WriteBack: sopts.WriteBack,
+		CacheSize: sopts.CacheSize,
 	}
 
 	mm, err := NewMountManager(c, sopts.MountDir, mountOpts)
@@ -491,14 +532,6 @@ func Server(c *client.APIClient, sopts *ServerOptions) error {
 				http.Error(w, err.Error(), http.StatusBadRequest)
 				return
 			}
-			if key.Commit != "" {
-				http.Error(
-					w,
-					"don't support mounting commits yet",
-					http.StatusBadRequest,
-				)
-				return
-			}
 			l, err := mm.ListByRepos()
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -508,6 +541,20 @@ func Server(c *client.APIClient, sopts *ServerOptions) error {
 				http.Error(w, "repo does not exist", http.StatusBadRequest)
 				return
 			}
+			if key.Commit != "" {
+				if mode != "ro" {
+					http.Error(w, "commits can only be mounted read-only", http.StatusBadRequest)
+					return
+				}
+				// resolve the commit up front, so that mounting a commit that
+				// doesn't exist fails here rather than on first access
+				ci, err := mm.Client.InspectCommit(key.Repo, key.Branch, key.Commit)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				key.Commit = ci.Commit.ID
+			}
 
 			_, err = mm.MountBranch(key, name, mode)
 			if err != nil {
@@ -682,7 +729,57 @@ func Server(c *client.APIClient, sopts *ServerOptions) error {
 		cfg.Write()
 		mm.Client.SetAuthToken("")
 	})
-	// TODO: implement _commit
+	router.Methods("PUT").
+		Queries("name", "{name}").
+		Path("/repos/{key:.+}/_commit").HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if isAuthOnAndUserUnauthenticated(mm.Client) {
+			http.Error(w, "user unauthenticated", http.StatusUnauthorized)
+			return
+		}
+
+		type CommitRequest struct {
+			Message string `json:"message"`
+		}
+
+		vs := mux.Vars(req)
+		k, ok := vs["key"]
+		if !ok {
+			http.Error(w, "no key", http.StatusBadRequest)
+			return
+		}
+		name, ok := vs["name"]
+		if !ok {
+			http.Error(w, "no name", http.StatusBadRequest)
+			return
+		}
+		key, err := mountKeyFromString(k)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// the body is optional
+		var commitReq CommitRequest
+		if err := json.NewDecoder(req.Body).Decode(&commitReq); err != nil && !errors.Is(err, io.EOF) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_, err = mm.FinishCommit(key, name, commitReq.Message)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		l, err := mm.ListByMounts()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		marshalled, err := jsonMarshal(l)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(marshalled)
+	})
 
 	// TODO: switch http server for gRPC server and bind to a unix socket not a
 	// TCP port (just for convenient manual testing with curl for now...)
@@ -808,13 +905,17 @@ type MountState struct {
 	Name       string   `json:"name"`       // where to mount it. written by client
 	MountKey   MountKey `json:"mount_key"`  // what to mount. written by client
 	Mode       string   `json:"mode"`       // "ro", "rw", or "" if unknown/unspecified. written by client
-	State      string   `json:"state"`      // "unmounted", "mounting", "mounted", "pushing", "unmounted", "error". written by fsm
+	State      string   `json:"state"`      // "unmounted", "mounting", "mounted", "remounting", "committing", "pushing", "unmounted", "error". written by fsm
 	Status     string   `json:"status"`     // human readable string with additional info wrt State, e.g. an error message for the error state. written by fsm
 	Mountpoint string   `json:"mountpoint"` // where on the filesystem it's mounted. written by fsm. can also be derived from {MountDir}/{Name}
+	// progress of uploading changes, for writeable mounts. written by fsm
+	WriteBack *WriteBackState `json:"write_back,omitempty"`
 }
 
 type MountStateMachine struct {
 	MountState
+	// when changes to the mount were last uploaded, or when it was mounted
+	lastFlush time.Time
 	manager   *MountManager
 	mu        sync.Mutex
 	requests  chan Request
@@ -918,7 +1019,17 @@ func unmountedState(m *MountStateMachine) StateFn {
 	for {
 		req := <-m.requests
 
-		if req.Mount {
+		if req.Finish {
+			m.responses <- Response{
+				Repo:       m.MountKey.Repo,
+				Branch:     m.MountKey.Branch,
+				Commit:     m.MountKey.Commit,
+				Name:       m.Name,
+				MountState: m.MountState,
+				Error:      fmt.Errorf("can't commit when we're unmounted"),
+			}
+			// stay unmounted
+		} else if req.Mount {
 			// copy data from request into fields that are documented as being
 			// written by the client (see MountState struct)
 			m.MountState.Name = req.Name
@@ -955,10 +1066,20 @@ func mountingState(m *MountStateMachine) StateFn {
 			Name:   m.Name,
 			Repo:   m.MountKey.Repo,
 			Branch: m.MountKey.Branch,
+			Commit: m.MountKey.Commit,
 			Write:  m.Mode == "rw",
 		}
 		m.manager.root.branches[m.Name] = m.MountKey.Branch
 	}()
+	m.manager.root.setCommit(m.Name, m.MountKey.Commit)
+	m.lastFlush = time.Now()
+	if m.Mode == "rw" {
+		m.updateWriteBack(func(*WriteBackState) {})
+	} else {
+		m.mu.Lock()
+		m.WriteBack = nil
+		m.mu.Unlock()
+	}
 	// re-downloading the repos with an updated RepoOptions set will have the
 	// effect of causing it to pop into existence
 	err := m.manager.root.mkdirMountNames()
@@ -979,13 +1100,41 @@ func mountingState(m *MountStateMachine) StateFn {
 
 func mountedState(m *MountStateMachine) StateFn {
 	m.transitionedTo("mounted", "")
+	// write-back mounts periodically check for changes to upload
+	var poll <-chan time.Time
+	writeBack := m.manager.opts.getWriteBack()
+	if writeBack != nil && m.Mode == "rw" {
+		ticker := time.NewTicker(writeBackPollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
 	for {
 		// TODO: check request type. unmount requests can be satisfied by going
 		// into unmounting. mount requests for already mounted repos should
 		// go back into mounting, as they can remount a fs as ro/rw.
-		req := <-m.requests
-		if req.Mount {
-			if req.Repo != m.MountKey.Repo || req.Branch != m.MountKey.Branch {
+		var req Request
+		select {
+		case <-poll:
+			m.maybeFlush(writeBack)
+			continue
+		case req = <-m.requests:
+		}
+		if req.Finish {
+			if req.Repo != m.MountKey.Repo || m.Mode != "rw" {
+				m.responses <- Response{
+					Repo:       m.MountKey.Repo,
+					Branch:     m.MountKey.Branch,
+					Commit:     m.MountKey.Commit,
+					Name:       m.Name,
+					MountState: m.MountState,
+					Error:      fmt.Errorf("mount at '%s' isn't a writeable mount of %s", m.Name, req.Repo),
+				}
+				continue
+			}
+			return committingState(req.Message)
+		} else if req.Mount {
+			key := MountKey{Repo: req.Repo, Branch: req.Branch, Commit: req.Commit}
+			if req.Repo != m.MountKey.Repo {
 				m.responses <- Response{
 					Repo:       m.MountKey.Repo,
 					Branch:     m.MountKey.Branch,
@@ -994,18 +1143,112 @@ func mountedState(m *MountStateMachine) StateFn {
 					MountState: m.MountState,
 					Error:      fmt.Errorf("mount at '%s' already in use", m.Name),
 				}
-			} else {
+			} else if key == m.MountKey && req.Mode == m.Mode {
 				m.responses <- Response{}
+			} else if req.Mode != "ro" || m.Mode != "ro" {
+				// TODO: handle remount case (switching mode):
+				// if mounted ro and switching to rw, just upgrade
+				// if mounted rw and switching to ro, upload changes, then switch the mount type
+				m.responses <- Response{
+					Repo:       m.MountKey.Repo,
+					Branch:     m.MountKey.Branch,
+					Commit:     m.MountKey.Commit,
+					Name:       m.Name,
+					MountState: m.MountState,
+					Error:      fmt.Errorf("can only remount read-only mounts, unmount '%s' first", m.Name),
+				}
+			} else {
+				m.MountState.MountKey = key
+				return remountingState
 			}
-			// TODO: handle remount case (switching mode):
-			// if mounted ro and switching to rw, just upgrade
-			// if mounted rw and switching to ro, upload changes, then switch the mount type
 		} else {
 			return unmountingState
 		}
 	}
 }
 
+// committingState uploads the changes made through the mount, and finishes
+// the commit they were uploaded into with 'message'. The mount stays mounted
+// and readable throughout.
+func committingState(message string) StateFn {
+	return func(m *MountStateMachine) StateFn {
+		// NB: this function is responsible for placing a response on
+		// m.responses _in all cases_
+		m.transitionedTo("committing", "")
+		err := m.manager.finishCommit(m.Name, message)
+		if err == nil {
+			m.lastFlush = time.Now()
+		}
+		m.updateWriteBack(func(s *WriteBackState) {
+			s.Error = ""
+			if err != nil {
+				s.Error = err.Error()
+			} else {
+				s.LastFlush = m.lastFlush
+			}
+		})
+		m.responses <- Response{
+			Repo:       m.MountKey.Repo,
+			Branch:     m.MountKey.Branch,
+			Commit:     m.MountKey.Commit,
+			Name:       m.Name,
+			MountState: m.MountState,
+			Error:      err,
+		}
+		// local changes are kept if the commit failed, so we stay mounted
+		// either way, and the user can try again
+		return mountedState
+	}
+}
+
+func remountingState(m *MountStateMachine) StateFn {
+	// NB: this function is responsible for placing a response on m.responses
+	// _in all cases_
+	// downloads that start from here on are refused, as we're no longer in
+	// the mounted state, and those already running are waited for below, so
+	// nothing from the old commit is written into the mount once it's cleared
+	m.transitionedTo("remounting", "")
+	func() {
+		m.manager.mu.Lock()
+		defer m.manager.mu.Unlock()
+		ro := m.manager.root.repoOpts[m.Name]
+		ro.Branch = m.MountKey.Branch
+		ro.Commit = m.MountKey.Commit
+	}()
+	dl := m.manager.root.downloadLock(m.Name)
+	dl.Lock()
+	func() {
+		m.manager.root.mu.Lock()
+		defer m.manager.root.mu.Unlock()
+		// forget what we downloaded from the previous commit, the new one is
+		// fetched lazily like on a fresh mount
+		m.manager.root.branches[m.Name] = m.MountKey.Branch
+		cleanByPrefixFileStates(m.manager.root.files, m.Name)
+		m.manager.root.cache.removePrefix(m.Name)
+	}()
+	m.manager.root.setCommit(m.Name, m.MountKey.Commit)
+	cleanPath := m.manager.root.rootPath + "/" + m.Name
+	err := os.RemoveAll(cleanPath)
+	if err == nil {
+		err = errors.EnsureStack(os.MkdirAll(cleanPath, 0777))
+	}
+	dl.Unlock()
+	m.responses <- Response{
+		Repo:       m.MountKey.Repo,
+		Branch:     m.MountKey.Branch,
+		Commit:     m.MountKey.Commit,
+		Name:       m.Name,
+		MountState: m.MountState,
+		Error:      err,
+	}
+	if err != nil {
+		logrus.Infof("Error while remounting! %s", err)
+		m.transitionedTo("error", err.Error())
+		return errorState
+	}
+	return mountedState
+}
+
 // match exact directory or file within directory but not directory
 // which is a substring prefix
 // e.g., for prefix abc
@@ -1043,8 +1286,9 @@ func unmountingState(m *MountStateMachine) StateFn {
 
 	// Only upload files for writeable filesystems
 	if m.Mode == "rw" {
-		// upload any files whose paths start with where we're mounted
-		err := m.manager.uploadFiles(m.Name)
+		// upload any files in the mount, and finish the commit they were
+		// uploaded into
+		err := m.manager.finishCommit(m.Name, "")
 		if err != nil {
 			logrus.Infof("Error while uploading! %s", err)
 			m.transitionedTo("error", err.Error())
@@ -1058,36 +1302,6 @@ func unmountingState(m *MountStateMachine) StateFn {
 			}
 			return errorState
 		}
-
-		// close the mfc, uploading files, then delete it
-		mfc, err := m.manager.mfc(m.Name)
-		if err != nil {
-			logrus.Infof("Error while getting mfc! %s", err)
-			m.transitionedTo("error", err.Error())
-			m.responses <- Response{
-				Repo:       m.MountKey.Repo,
-				Branch:     m.MountKey.Branch,
-				Commit:     m.MountKey.Commit,
-				Name:       m.Name,
-				MountState: m.MountState,
-				Error:      err,
-			}
-			return errorState
-		}
-		err = mfc.Close()
-		if err != nil {
-			logrus.Infof("Error while closing mfc! %s", err)
-			m.transitionedTo("error", err.Error())
-			m.responses <- Response{
-				Repo:       m.MountKey.Repo,
-				Branch:     m.MountKey.Branch,
-				Commit:     m.MountKey.Commit,
-				Name:       m.Name,
-				MountState: m.MountState,
-				Error:      err,
-			}
-			return errorState
-		}
 	}
 
 	// cleanup
@@ -1104,6 +1318,7 @@ func unmountingState(m *MountStateMachine) StateFn {
 		cleanByPrefixStrings(m.manager.root.branches, m.MountState.Name)
 		cleanByPrefixStrings(m.manager.root.commits, m.MountState.Name)
 		cleanByPrefixFileStates(m.manager.root.files, m.MountState.Name)
+		m.manager.root.cache.removePrefix(m.MountState.Name)
 	}()
 
 	// remove from loopback filesystem so that it actually disappears for the user
@@ -1151,6 +1366,14 @@ func (mm *MountManager) mfc(name string) (*client.ModifyFileClient, error) {
 	if mfc, ok := mm.mfcs[name]; ok {
 		return mfc, nil
 	}
+	if commit, ok := mm.openCommits[name]; ok {
+		mfc, err := mm.Client.NewModifyFileClient(commit)
+		if err != nil {
+			return nil, err
+		}
+		mm.mfcs[name] = mfc
+		return mfc, nil
+	}
 	var repoName string
 	opts, ok := mm.root.repoOpts[name]
 	if !ok {
@@ -1187,21 +1410,7 @@ func (mm *MountManager) uploadFiles(prefixFilter string) error {
 		if err != nil {
 			return err
 		}
-		if err := func() (retErr error) {
-			f, err := progress.Open(filepath.Join(mm.root.rootPath, path))
-			if err != nil {
-				if errors.Is(err, os.ErrNotExist) {
-					return mfc.DeleteFile(pathpkg.Join(parts[1:]...))
-				}
-				return errors.WithStack(err)
-			}
-			defer func() {
-				if err := f.Close(); err != nil && retErr == nil {
-					retErr = errors.WithStack(err)
-				}
-			}()
-			return mfc.PutFile(pathpkg.Join(parts[1:]...), f)
-		}(); err != nil {
+		if _, err := mm.uploadFile(mfc, path); err != nil {
 			return err
 		}
 	}
@@ -381,14 +381,189 @@ func TestMountNonexistentRepo(t *testing.T) {
 	})
 }
 
+func TestMountCommit(t *testing.T) {
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	require.NoError(t, env.PachClient.CreateRepo("repo"))
+	commit := client.NewCommit("repo", "master", "")
+	require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader("foo")))
+	ci1, err := env.PachClient.InspectCommit("repo", "master", "")
+	require.NoError(t, err)
+	require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader("bar")))
+	ci2, err := env.PachClient.InspectCommit("repo", "master", "")
+	require.NoError(t, err)
+
+	withServerMount(t, env.PachClient, nil, func(mountPoint string) {
+		resp, err := put(fmt.Sprintf("repos/repo/master/%s/_mount?name=old&mode=ro", ci1.Commit.ID), nil)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+		resp, err = put("repos/repo/master/_mount?name=latest&mode=ro", nil)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+
+		data, err := ioutil.ReadFile(filepath.Join(mountPoint, "old", "file"))
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(data))
+		data, err = ioutil.ReadFile(filepath.Join(mountPoint, "latest", "file"))
+		require.NoError(t, err)
+		require.Equal(t, "bar", string(data))
+
+		// commit mounts are read-only
+		require.YesError(t, ioutil.WriteFile(filepath.Join(mountPoint, "old", "file"), []byte("baz"), 0644))
+		resp, err = put(fmt.Sprintf("repos/repo/master/%s/_mount?name=rw&mode=rw", ci1.Commit.ID), nil)
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode)
+
+		resp, err = put("repos/repo/master/0123456789abcdef0123456789abcdef/_mount?name=missing&mode=ro", nil)
+		require.NoError(t, err)
+		require.Equal(t, 400, resp.StatusCode)
+
+		// remount 'old' to the newer commit in place
+		resp, err = put(fmt.Sprintf("repos/repo/master/%s/_mount?name=old&mode=ro", ci2.Commit.ID), nil)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+		data, err = ioutil.ReadFile(filepath.Join(mountPoint, "old", "file"))
+		require.NoError(t, err)
+		require.Equal(t, "bar", string(data))
+
+		resp, err = get("mounts")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		mountResp := &ListMountResponse{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(mountResp))
+		require.Equal(t, ci2.Commit.ID, mountResp.Mounted["old"].MountKey.Commit)
+		require.Equal(t, "", mountResp.Mounted["latest"].MountKey.Commit)
+	})
+}
+
+func TestWriteBack(t *testing.T) {
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	require.NoError(t, env.PachClient.CreateRepo("repo"))
+
+	sopts := &ServerOptions{
+		WriteBack: &WriteBackOptions{FlushInterval: time.Second},
+	}
+	withServerMount(t, env.PachClient, sopts, func(mountPoint string) {
+		_, err := put("repos/repo/master/_mount?name=repo&mode=rw", nil)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(mountPoint, "repo", "file1"), []byte("foo"), 0644))
+
+		// the change is uploaded into an open commit without unmounting
+		require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+			ci, err := env.PachClient.InspectCommit("repo", "master", "")
+			if err != nil {
+				return err
+			}
+			if ci.Finished != nil {
+				return errors.Errorf("expected commit %s to be open", ci.Commit.ID)
+			}
+			var buf bytes.Buffer
+			if err := env.PachClient.GetFile(ci.Commit, "file1", &buf); err != nil {
+				return err
+			}
+			if buf.String() != "foo" {
+				return errors.Errorf("unexpected contents %q", buf.String())
+			}
+			return nil
+		})
+
+		resp, err := get("mounts")
+		require.NoError(t, err)
+		mountResp := &ListMountResponse{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(mountResp))
+		resp.Body.Close()
+		writeBack := mountResp.Mounted["repo"].WriteBack
+		require.NotNil(t, writeBack)
+		require.Equal(t, "", writeBack.Error)
+		require.NotEqual(t, "", writeBack.Commit)
+		require.Equal(t, 1, writeBack.UploadedFiles)
+
+		// _commit finishes the commit with a message, and leaves it mounted
+		require.NoError(t, ioutil.WriteFile(filepath.Join(mountPoint, "repo", "file2"), []byte("bar"), 0644))
+		resp, err = put("repos/repo/master/_commit?name=repo", strings.NewReader(`{"message": "my changes"}`))
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+		resp.Body.Close()
+		ci, err := env.PachClient.InspectCommit("repo", "master", "")
+		require.NoError(t, err)
+		require.NotNil(t, ci.Finished)
+		require.Equal(t, "my changes", ci.Description)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(ci.Commit, "file2", &buf))
+		require.Equal(t, "bar", buf.String())
+
+		data, err := ioutil.ReadFile(filepath.Join(mountPoint, "repo", "file1"))
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(data))
+
+		// read-only mounts can't be committed
+		_, err = put("repos/repo/master/_mount?name=ro&mode=ro", nil)
+		require.NoError(t, err)
+		resp, err = put("repos/repo/master/_commit?name=ro", nil)
+		require.NoError(t, err)
+		require.Equal(t, 500, resp.StatusCode)
+	})
+}
+
+func TestWriteBackEviction(t *testing.T) {
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	require.NoError(t, env.PachClient.CreateRepo("repo"))
+	require.NoError(t, env.PachClient.PutFile(client.NewCommit("repo", "master", ""), "file1", strings.NewReader("old")))
+
+	// the cache only fits one of the files at a time
+	sopts := &ServerOptions{
+		WriteBack: &WriteBackOptions{FlushInterval: time.Second},
+		CacheSize: 4,
+	}
+	withServerMount(t, env.PachClient, sopts, func(mountPoint string) {
+		_, err := put("repos/repo/master/_mount?name=repo&mode=rw", nil)
+		require.NoError(t, err)
+		uploaded := func(n int) {
+			require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+				resp, err := get("mounts")
+				if err != nil {
+					return err
+				}
+				defer resp.Body.Close()
+				mountResp := &ListMountResponse{}
+				if err := json.NewDecoder(resp.Body).Decode(mountResp); err != nil {
+					return errors.EnsureStack(err)
+				}
+				writeBack := mountResp.Mounted["repo"].WriteBack
+				if writeBack == nil || writeBack.UploadedFiles < n {
+					return errors.Errorf("expected %d uploaded files, got %v", n, writeBack)
+				}
+				return nil
+			})
+		}
+		require.NoError(t, ioutil.WriteFile(filepath.Join(mountPoint, "repo", "file1"), []byte("foo"), 0644))
+		uploaded(1)
+		// uploading file2 evicts file1, which is re-read from the open commit
+		require.NoError(t, ioutil.WriteFile(filepath.Join(mountPoint, "repo", "file2"), []byte("bar"), 0644))
+		uploaded(2)
+		data, err := ioutil.ReadFile(filepath.Join(mountPoint, "repo", "file1"))
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(data))
+
+		// re-reading file1 evicted file2, which is re-read from the finished
+		// commit once it's committed
+		resp, err := put("repos/repo/master/_commit?name=repo", strings.NewReader(`{"message": "my changes"}`))
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+		resp.Body.Close()
+		data, err = ioutil.ReadFile(filepath.Join(mountPoint, "repo", "file2"))
+		require.NoError(t, err)
+		require.Equal(t, "bar", string(data))
+	})
+}
+
 // TODO: pass reference to the MountManager object to the test func, so that the
 // test can call MountBranch, UnmountBranch etc directly for convenience
 func withServerMount(tb testing.TB, c *client.APIClient, sopts *ServerOptions, f func(mountPoint string)) {
-	dir := tb.TempDir()
 	if sopts == nil {
-		sopts = &ServerOptions{
-			MountDir: dir,
-		}
+		sopts = &ServerOptions{}
+	}
+	if sopts.MountDir == "" {
+		sopts.MountDir = tb.TempDir()
 	}
 	if sopts.Unmount == nil {
 		sopts.Unmount = make(chan struct{})
@@ -414,5 +589,5 @@ func withServerMount(tb testing.TB, c *client.APIClient, sopts *ServerOptions, f
 	}()
 	// Gotta give the fuse mount time to come up.
 	time.Sleep(2 * time.Second)
-	f(dir)
+	f(sopts.MountDir)
 }
@@ -0,0 +1,288 @@
+package fuse
+
+import (
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// writeBackPollInterval is how often write-back mounts check whether they
+// have changes to upload
+const writeBackPollInterval = time.Second
+
+// WriteBackState reports the progress of uploading changes made through a
+// writeable mount.
+type WriteBackState struct {
+	Commit        string    `json:"commit"`         // the open commit that changes are uploaded into, "" if there isn't one
+	PendingFiles  int       `json:"pending_files"`  // number of files changed locally that haven't been uploaded yet
+	PendingBytes  int64     `json:"pending_bytes"`  // total size of the files changed locally that haven't been uploaded yet
+	UploadedFiles int       `json:"uploaded_files"` // number of files uploaded into the open commit so far
+	UploadedBytes int64     `json:"uploaded_bytes"` // total size of the files uploaded into the open commit so far
+	LastFlush     time.Time `json:"last_flush"`     // when changes were last uploaded, zero if they never have been
+	Error         string    `json:"error"`          // the error from the last upload, "" if it succeeded
+}
+
+// pendingFiles returns the files in the mount 'name' that have been changed
+// locally and not yet uploaded, sorted, along with their total size
+func (l *loopbackRoot) pendingFiles(name string) ([]string, int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var paths []string
+	var size int64
+	for path, state := range l.files {
+		if state != dirty || !(path == name || strings.HasPrefix(path, name+"/")) {
+			continue
+		}
+		paths = append(paths, path)
+		// deleted files don't take up any space
+		if fi, err := os.Stat(filepath.Join(l.rootPath, path)); err == nil && !fi.IsDir() {
+			size += fi.Size()
+		}
+	}
+	sort.Strings(paths)
+	return paths, size
+}
+
+// setFileStates moves each of 'paths' that's still in the state 'from' to the
+// state 'to'. Paths that have moved on since, e.g. because they've been
+// written to again, are left as they are.
+func (l *loopbackRoot) setFileStates(paths []string, from, to fileState) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, path := range paths {
+		if l.files[path] == from {
+			l.files[path] = to
+		}
+	}
+}
+
+// openCommit returns the commit that changes to the mount 'name' are uploaded
+// into. It reuses the head of the mount's branch if it's open, and otherwise
+// starts a new commit on it.
+func (mm *MountManager) openCommit(name string) (*pfs.Commit, error) {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	if commit, ok := mm.openCommits[name]; ok {
+		return commit, nil
+	}
+	repoName := name
+	if opts, ok := mm.root.repoOpts[name]; ok {
+		repoName = opts.Repo
+	}
+	branch := mm.root.branch(name)
+	var commit *pfs.Commit
+	ci, err := mm.Client.InspectCommit(repoName, branch, "")
+	if err != nil && !errutil.IsNotFoundError(err) {
+		return nil, err
+	}
+	if ci != nil && ci.Finished == nil {
+		commit = ci.Commit
+	} else {
+		commit, err = mm.Client.StartCommit(repoName, branch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	mm.openCommits[name] = commit
+	// Files that haven't been downloaded yet, or have been evicted from the
+	// read cache, have to be read from the open commit from now on, or they'd
+	// come back without the changes uploaded into it.
+	mm.root.setCommit(name, commit.ID)
+	return commit, nil
+}
+
+// flush uploads the changes made to the mount 'name' since it was last
+// flushed into its open commit, starting one if needed. It returns the number
+// of files and bytes uploaded.
+func (mm *MountManager) flush(name string) (int, int64, error) {
+	paths, _ := mm.root.pendingFiles(name)
+	if len(paths) == 0 {
+		return 0, 0, nil
+	}
+	if _, err := mm.openCommit(name); err != nil {
+		return 0, 0, err
+	}
+	// Mark the files as uploaded before uploading them, so that any changes
+	// made while they're being uploaded mark them dirty again, to be picked
+	// up by the next flush.
+	mm.root.setFileStates(paths, dirty, full)
+	var bytes int64
+	if err := func() (retErr error) {
+		mfc, err := mm.mfc(name)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			mm.mu.Lock()
+			delete(mm.mfcs, name)
+			mm.mu.Unlock()
+			if err := mfc.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		for _, path := range paths {
+			n, err := mm.uploadFile(mfc, path)
+			if err != nil {
+				return err
+			}
+			bytes += n
+		}
+		return nil
+	}(); err != nil {
+		// nothing is committed until the mfc is closed, so all of the files
+		// need uploading again
+		mm.root.setFileStates(paths, full, dirty)
+		return 0, 0, err
+	}
+	// uploaded files stay around locally, so they count towards the read cache
+	for _, path := range paths {
+		if fi, err := os.Stat(filepath.Join(mm.root.rootPath, path)); err == nil && !fi.IsDir() {
+			mm.root.cacheFile(path, fi.Size())
+		}
+	}
+	return len(paths), bytes, nil
+}
+
+// finishCommit uploads any remaining changes to the mount 'name', and
+// finishes its open commit with 'message'. It's a no-op if there are no
+// changes and no open commit.
+func (mm *MountManager) finishCommit(name, message string) error {
+	if _, _, err := mm.flush(name); err != nil {
+		return err
+	}
+	mm.mu.Lock()
+	commit, ok := mm.openCommits[name]
+	delete(mm.openCommits, name)
+	mm.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	_, err := mm.Client.PfsAPIClient.FinishCommit(
+		mm.Client.Ctx(),
+		&pfs.FinishCommitRequest{
+			Commit:      commit,
+			Description: message,
+		},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	// the finished commit is the head of the branch, which the mount follows
+	// again until the next commit is opened
+	mm.root.setCommit(name, "")
+	return nil
+}
+
+// uploadFile uploads the file at 'path', relative to the loopback root, to
+// 'mfc', or deletes it if it's been deleted locally. It returns the number of
+// bytes uploaded.
+func (mm *MountManager) uploadFile(mfc *client.ModifyFileClient, path string) (int64, error) {
+	return uploadFile(mfc, mm.root.rootPath, path)
+}
+
+// uploadFile uploads the file at 'path', relative to 'rootPath', along with
+// its mode, mtime and symlink target.
+func uploadFile(mfc *client.ModifyFileClient, rootPath, path string) (_ int64, retErr error) {
+	parts := strings.Split(path, "/")
+	dst := pathpkg.Join(parts[1:]...)
+	p := filepath.Join(rootPath, path)
+	fi, err := os.Lstat(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, mfc.DeleteFile(dst)
+		}
+		return 0, errors.WithStack(err)
+	}
+	if fi.IsDir() {
+		// pfs has no empty directories, they're implied by the files in them
+		return 0, nil
+	}
+	attrs, err := pfs.AttributesFromFileInfo(p, fi)
+	if err != nil {
+		return 0, err
+	}
+	if attrs.IsSymlink() {
+		// Symlinks created through the mount point into the loopback root,
+		// store them relative to the link so they resolve in other mounts.
+		repoRoot := filepath.Join(rootPath, parts[0])
+		if filepath.IsAbs(attrs.SymlinkTarget) && strings.HasPrefix(attrs.SymlinkTarget, repoRoot+string(os.PathSeparator)) {
+			attrs.SymlinkTarget, err = filepath.Rel(filepath.Dir(p), attrs.SymlinkTarget)
+			if err != nil {
+				return 0, errors.EnsureStack(err)
+			}
+		}
+		return 0, mfc.PutFile(dst, strings.NewReader(""), client.WithAttributesPutFile(attrs))
+	}
+	f, err := progress.Open(p)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = errors.WithStack(err)
+		}
+	}()
+	return fi.Size(), mfc.PutFile(dst, f, client.WithAttributesPutFile(attrs))
+}
+
+// maybeFlush uploads the changes made through the mount if they've been
+// pending for longer than the flush interval, or have grown past the flush
+// size, and updates its write-back state.
+func (m *MountStateMachine) maybeFlush(opts *WriteBackOptions) {
+	paths, size := m.manager.root.pendingFiles(m.Name)
+	if len(paths) > 0 && (time.Since(m.lastFlush) >= opts.FlushInterval ||
+		(opts.FlushSize > 0 && size >= opts.FlushSize)) {
+		files, bytes, err := m.manager.flush(m.Name)
+		m.lastFlush = time.Now()
+		m.updateWriteBack(func(s *WriteBackState) {
+			s.LastFlush = m.lastFlush
+			s.UploadedFiles += files
+			s.UploadedBytes += bytes
+			s.Error = ""
+			if err != nil {
+				logrus.Infof("[%s] Error while uploading! %s", m.Name, err)
+				s.Error = err.Error()
+			}
+		})
+		paths, size = m.manager.root.pendingFiles(m.Name)
+	}
+	m.updateWriteBack(func(s *WriteBackState) {
+		s.PendingFiles = len(paths)
+		s.PendingBytes = size
+	})
+}
+
+// updateWriteBack applies 'f' to a copy of the mount's write-back state, and
+// swaps it in, so that readers of the MountState always see a consistent
+// version of it.
+func (m *MountStateMachine) updateWriteBack(f func(*WriteBackState)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := &WriteBackState{}
+	if m.WriteBack != nil {
+		*s = *m.WriteBack
+	}
+	m.manager.mu.Lock()
+	if commit, ok := m.manager.openCommits[m.Name]; ok {
+		s.Commit = commit.ID
+	} else {
+		s.Commit = ""
+		s.UploadedFiles = 0
+		s.UploadedBytes = 0
+	}
+	m.manager.mu.Unlock()
+	f(s)
+	m.WriteBack = s
+}
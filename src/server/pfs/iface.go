@@ -1,6 +1,8 @@
 package pfs
 
 import (
+	"context"
+
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	pfs_client "github.com/pachyderm/pachyderm/v2/src/pfs"
 )
@@ -23,10 +25,19 @@ type APIServer interface {
 
 	InspectCommitSetInTransaction(*txncontext.TransactionContext, *pfs_client.CommitSet) ([]*pfs_client.CommitInfo, error)
 	SquashCommitSetInTransaction(*txncontext.TransactionContext, *pfs_client.SquashCommitSetRequest) error
+	DropCommitSetInTransaction(*txncontext.TransactionContext, *pfs_client.DropCommitSetRequest) error
 
 	CreateBranchInTransaction(*txncontext.TransactionContext, *pfs_client.CreateBranchRequest) error
 	InspectBranchInTransaction(*txncontext.TransactionContext, *pfs_client.InspectBranchRequest) (*pfs_client.BranchInfo, error)
 	DeleteBranchInTransaction(*txncontext.TransactionContext, *pfs_client.DeleteBranchRequest) error
+	// RepropagateBranchInTransaction adds the head of a branch to the
+	// transaction's commitset, without changing its data, so that every branch
+	// downstream of it gets a new commit.
+	RepropagateBranchInTransaction(*txncontext.TransactionContext, *pfs_client.Branch) error
 
 	AddFileSetInTransaction(*txncontext.TransactionContext, *pfs_client.AddFileSetRequest) error
+	// PinFileSet clones a file set without a TTL, so that it isn't deleted
+	// until the clone is unpinned with UnpinFileSet. It returns the clone's ID.
+	PinFileSet(context.Context, string) (string, error)
+	UnpinFileSet(context.Context, string) error
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
@@ -32,6 +33,16 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// ScrubFindingHeader is the header for bad chunk objects found by a scrub.
+	ScrubFindingHeader = "CHUNK\tPROBLEM\tREPAIRED\tFILES\t\n"
+	// ReplicaStatusHeader is the header for the replication status of replicas.
+	ReplicaStatusHeader = "REPLICA\tREPLICATED\tPENDING\tPENDING SIZE\tLAG\t\n"
+	// GarbageChunkHeader is the header for chunk objects deleted by garbage
+	// collection.
+	GarbageChunkHeader = "CHUNK\tGEN\tSIZE\tREASON\t\n"
+	// CompactionLevelHeader is the header for the levels of a commit's file
+	// sets.
+	CompactionLevelHeader = "LEVEL\tFILESET\tSIZE\tCOMPACTED\t\n"
 )
 
 // PrintRepoInfo pretty-prints repo info.
@@ -75,7 +86,9 @@ func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}{{if .Details}}
-Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .Chunking}}
+Chunking: {{printChunking .Chunking}}{{end}}{{if .Compaction}}
+Compaction: {{printCompaction .Compaction}}{{end}}{{if .AuthInfo}}
 Roles: {{ .AuthInfo.Roles | commafy }}
 Permissions: {{ .AuthInfo.Permissions | commafy }}{{end}}
 `)
@@ -85,6 +98,37 @@ Permissions: {{ .AuthInfo.Permissions | commafy }}{{end}}
 	return errors.EnsureStack(template.Execute(os.Stdout, repoInfo))
 }
 
+func printChunking(spec *pfs.ChunkingSpec) string {
+	parts := []string{strings.ToLower(spec.Algorithm.String())}
+	if spec.AverageSizeBytes > 0 {
+		parts = append(parts, fmt.Sprintf("average %s", units.BytesSize(float64(spec.AverageSizeBytes))))
+	}
+	if spec.MinSizeBytes > 0 {
+		parts = append(parts, fmt.Sprintf("min %s", units.BytesSize(float64(spec.MinSizeBytes))))
+	}
+	if spec.MaxSizeBytes > 0 {
+		parts = append(parts, fmt.Sprintf("max %s", units.BytesSize(float64(spec.MaxSizeBytes))))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func printCompaction(spec *pfs.CompactionSpec) string {
+	var parts []string
+	if spec.LevelFactor > 0 {
+		parts = append(parts, fmt.Sprintf("level factor %d", spec.LevelFactor))
+	}
+	if spec.DeferSizeBytes > 0 {
+		parts = append(parts, fmt.Sprintf("defer over %s", units.BytesSize(float64(spec.DeferSizeBytes))))
+	}
+	if spec.Window != "" {
+		parts = append(parts, fmt.Sprintf("window %s", spec.Window))
+	}
+	if len(parts) == 0 {
+		return "default"
+	}
+	return strings.Join(parts, ", ")
+}
+
 func printTrigger(trigger *pfs.Trigger) string {
 	var conds []string
 	if trigger.CronSpec != "" {
@@ -237,7 +281,8 @@ Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{.Finished}}{{else}}
 Finished: {{prettyAgo .Finished}}{{end}}{{end}}{{if .Details}}
-Size: {{prettySize .Details.SizeBytes}}{{end}}
+Size: {{prettySize .Details.SizeBytes}}{{if .Details.CompactionDeferred}}
+Compaction: deferred{{end}}{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -287,7 +332,10 @@ func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 		`Path: {{.File.Path}}
 Datum: {{.File.Datum}}
 Type: {{fileType .FileType}}
-Size: {{prettySize .SizeBytes}}
+Size: {{prettySize .SizeBytes}}{{with .Attributes}}{{if .Mode}}
+Mode: {{fileMode .}}{{end}}{{if .SymlinkTarget}}
+Symlink Target: {{.SymlinkTarget}}{{end}}{{if .ContentType}}
+Content Type: {{.ContentType}}{{end}}{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -302,12 +350,85 @@ func fileType(fileType pfs.FileType) string {
 	return "dir"
 }
 
+func fileMode(attrs *pfs.FileAttributes) string {
+	return attrs.FileMode().String()
+}
+
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
-	"commafy":      pretty.Commafy,
+	"prettyAgo":       pretty.Ago,
+	"prettySize":      pretty.Size,
+	"fileType":        fileType,
+	"fileMode":        fileMode,
+	"printTrigger":    printTrigger,
+	"printChunking":   printChunking,
+	"printCompaction": printCompaction,
+	"commafy":         pretty.Commafy,
+}
+
+// PrintScrubFinding pretty-prints a bad chunk object found by a scrub, and the
+// files with content in it.
+func PrintScrubFinding(w io.Writer, finding *pfs.ScrubStorageFinding, files []*pfs.File) {
+	fmt.Fprintf(w, "%x\t", finding.ChunkId)
+	if finding.Missing {
+		fmt.Fprint(w, "missing\t")
+	} else {
+		fmt.Fprint(w, "corrupt\t")
+	}
+	switch {
+	case finding.Repaired:
+		fmt.Fprint(w, "yes\t")
+	case finding.RepairError != "":
+		fmt.Fprintf(w, "no (%s)\t", finding.RepairError)
+	default:
+		fmt.Fprint(w, "no\t")
+	}
+	var names []string
+	for _, f := range files {
+		names = append(names, CompactPrintFile(f))
+	}
+	fmt.Fprintf(w, "%s\t", strings.Join(names, ", "))
+	fmt.Fprintln(w)
+}
+
+// PrintReplicaStatus pretty-prints the replication status of a replica.
+func PrintReplicaStatus(w io.Writer, status *pfs.ReplicaStatus) {
+	fmt.Fprintf(w, "%s\t", status.Name)
+	fmt.Fprintf(w, "%d\t", status.ReplicatedChunks)
+	fmt.Fprintf(w, "%d\t", status.PendingChunks)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(status.PendingBytes)))
+	if status.PendingChunks == 0 {
+		fmt.Fprint(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Duration(status.Lag))
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintCommitCompactionInfo pretty-prints the compaction state of a commit.
+func PrintCommitCompactionInfo(w io.Writer, info *pfs.CommitCompactionInfo) error {
+	fmt.Fprintf(w, "Commit: %s@%s\n", info.Commit.Branch.Repo.Name, info.Commit.ID)
+	if info.Spec != nil {
+		fmt.Fprintf(w, "Policy: %s\n", printCompaction(info.Spec))
+	}
+	fmt.Fprintf(w, "Deferred: %t\n", info.Deferred)
+	tw := tabwriter.NewWriter(w, CompactionLevelHeader)
+	for i, level := range info.Levels {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%t\t\n", i, level.FileSetId, units.BytesSize(float64(level.SizeBytes)), level.Compacted)
+	}
+	return errors.EnsureStack(tw.Flush())
+}
+
+// PrintGarbageChunk pretty-prints a chunk object deleted by garbage collection.
+func PrintGarbageChunk(w io.Writer, chunk *pfs.GarbageChunk) {
+	fmt.Fprintf(w, "%x\t", chunk.ChunkId)
+	fmt.Fprintf(w, "%d\t", chunk.Gen)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(chunk.SizeBytes)))
+	if chunk.Tombstone {
+		fmt.Fprint(w, "tombstone\t")
+	} else {
+		fmt.Fprint(w, "unreferenced\t")
+	}
+	fmt.Fprintln(w)
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
@@ -3,6 +3,7 @@ package s3
 import (
 	"net/http"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/s2"
 )
@@ -28,6 +29,8 @@ func maybeNotFoundError(r *http.Request, err error) *s2.Error {
 		return s2.NoSuchBucketError(r)
 	} else if pfs.IsFileNotFoundErr(err) {
 		return s2.NoSuchKeyError(r)
+	} else if auth.IsErrNotAuthorized(err) {
+		return s2.AccessDeniedError(r)
 	}
 	return s2.InternalError(r, err)
 }
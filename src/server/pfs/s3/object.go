@@ -10,7 +10,10 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/s2"
 )
@@ -61,6 +64,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 	if err != nil {
 		return nil, err
 	}
+	setObjectHeaders(r, fileInfo.Attributes)
 
 	result := s2.GetObjectResult{
 		ModTime:      modTime,
@@ -111,6 +115,8 @@ func (c *controller) CopyObject(r *http.Request, srcBucketName, srcFile string,
 			return "", invalidFileParentError(r)
 		} else if errutil.IsInvalidPathError(err) {
 			return "", invalidFilePathError(r)
+		} else if auth.IsErrNotAuthorized(err) {
+			return "", s2.AccessDeniedError(r)
 		}
 		return "", err
 	}
@@ -152,13 +158,15 @@ func (c *controller) PutObject(r *http.Request, bucketName, file string, reader
 	}
 
 	bucketCommit := bucket.Commit
-	if err := pc.PutFile(bucketCommit, file, reader); err != nil {
+	if err := pc.PutFile(bucketCommit, file, reader, client.WithAttributesPutFile(objectAttributes(r))); err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
 			return nil, writeToOutputBranchError(r)
 		} else if errutil.IsNotADirectoryError(err) {
 			return nil, invalidFileParentError(r)
 		} else if errutil.IsInvalidPathError(err) {
 			return nil, invalidFilePathError(r)
+		} else if auth.IsErrNotAuthorized(err) {
+			return nil, s2.AccessDeniedError(r)
 		}
 		return nil, err
 	}
@@ -218,3 +226,44 @@ func (c *controller) DeleteObject(r *http.Request, bucketName, file, version str
 
 	return &result, nil
 }
+
+const userMetadataPrefix = "X-Amz-Meta-"
+
+// objectAttributes returns the attributes of an object being put, from the
+// Content-Type and user metadata headers of the request.
+func objectAttributes(r *http.Request) *pfs.FileAttributes {
+	attrs := &pfs.FileAttributes{
+		ContentType: r.Header.Get("Content-Type"),
+	}
+	for k, vs := range r.Header {
+		k = http.CanonicalHeaderKey(k)
+		if !strings.HasPrefix(k, userMetadataPrefix) || len(vs) == 0 {
+			continue
+		}
+		if attrs.Xattrs == nil {
+			attrs.Xattrs = make(map[string]string)
+		}
+		attrs.Xattrs[pfs.UserXattrPrefix+strings.ToLower(strings.TrimPrefix(k, userMetadataPrefix))] = vs[0]
+	}
+	if attrs.ContentType == "" && attrs.Xattrs == nil {
+		return nil
+	}
+	return attrs
+}
+
+// setObjectHeaders sets the Content-Type and user metadata headers of an
+// object from its attributes.
+func setObjectHeaders(r *http.Request, attrs *pfs.FileAttributes) {
+	header := responseHeader(r)
+	if header == nil || attrs == nil {
+		return
+	}
+	if attrs.ContentType != "" {
+		header.Set("Content-Type", attrs.ContentType)
+	}
+	for k, v := range attrs.Xattrs {
+		if strings.HasPrefix(k, pfs.UserXattrPrefix) {
+			header.Set(userMetadataPrefix+strings.TrimPrefix(k, pfs.UserXattrPrefix), v)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	stdlog "log"
 	"net/http"
@@ -113,7 +114,30 @@ func Router(driver Driver, clientFactory ClientFactory) *mux.Router {
 	s3Server.Bucket = c
 	s3Server.Object = c
 	s3Server.Multipart = c
-	return s3Server.Router()
+	router := s3Server.Router()
+	router.Use(withResponseWriter)
+	return router
+}
+
+type responseWriterKey struct{}
+
+// withResponseWriter makes the response writer available to the controller
+// through the request context, so that it can set response headers that s2
+// doesn't support, such as the content type and user metadata of objects.
+func withResponseWriter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), responseWriterKey{}, w)))
+	})
+}
+
+// responseHeader returns the header of the response to r, or nil if it isn't
+// available.
+func responseHeader(r *http.Request) http.Header {
+	w, ok := r.Context().Value(responseWriterKey{}).(http.ResponseWriter)
+	if !ok {
+		return nil
+	}
+	return w.Header()
 }
 
 // S3Server wraps an HTTP server with an S3-like API for PFS. This allows you to
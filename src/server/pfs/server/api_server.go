@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/ghodss/yaml"
@@ -16,6 +17,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/client/limit"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
@@ -24,7 +26,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -34,6 +38,7 @@ import (
 	taskapi "github.com/pachyderm/pachyderm/v2/src/task"
 
 	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
 )
 
 // apiServer implements the public interface of the Pachyderm File System,
@@ -84,7 +89,7 @@ func (a *apiServer) CreateRepoInTransaction(txnCtx *txncontext.TransactionContex
 	if repo := request.GetRepo(); repo != nil && repo.Name == fileSetsRepo {
 		return errors.Errorf("%s is a reserved name", fileSetsRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Chunking, request.Compaction, request.Update)
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -196,6 +201,11 @@ func (a *apiServer) InspectCommit(ctx context.Context, request *pfs.InspectCommi
 	return a.driver.inspectCommit(ctx, request.Commit, request.Wait)
 }
 
+// InspectCommitCompaction implements the protobuf pfs.InspectCommitCompaction RPC
+func (a *apiServer) InspectCommitCompaction(ctx context.Context, request *pfs.InspectCommitCompactionRequest) (response *pfs.CommitCompactionInfo, retErr error) {
+	return a.driver.inspectCommitCompaction(ctx, request.Commit)
+}
+
 // ListCommit implements the protobuf pfs.ListCommit RPC
 func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.API_ListCommitServer) (retErr error) {
 	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, func(ci *pfs.CommitInfo) error {
@@ -238,11 +248,17 @@ func (a *apiServer) SquashCommitSet(ctx context.Context, request *pfs.SquashComm
 	return &types.Empty{}, nil
 }
 
+// DropCommitSetInTransaction is identical to DropCommitSet except that it can run
+// inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) DropCommitSetInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.DropCommitSetRequest) error {
+	return a.driver.dropCommitSet(txnCtx, request.CommitSet)
+}
+
 // DropCommitSet implements the protobuf pfs.DropCommitSet RPC
 func (a *apiServer) DropCommitSet(ctx context.Context, request *pfs.DropCommitSetRequest) (response *types.Empty, retErr error) {
-	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.driver.dropCommitSet(txnCtx, request.CommitSet)
-	}); err != nil {
+	if err := a.env.TxnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.DropCommitSet(request))
+	}, nil); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -310,6 +326,10 @@ func (a *apiServer) InspectBranchInTransaction(txnCtx *txncontext.TransactionCon
 	return a.driver.inspectBranch(txnCtx, request.Branch)
 }
 
+func (a *apiServer) RepropagateBranchInTransaction(txnCtx *txncontext.TransactionContext, branch *pfs.Branch) error {
+	return a.driver.repropagateBranch(txnCtx, branch)
+}
+
 // ListBranch implements the protobuf pfs.ListBranch RPC
 func (a *apiServer) ListBranch(request *pfs.ListBranchRequest, srv pfs.API_ListBranchServer) (retErr error) {
 	if request.Repo == nil {
@@ -379,14 +399,21 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			var n int64
 			p := mod.AddFile.Path
 			t := mod.AddFile.Datum
+			if err := mod.AddFile.Attributes.Validate(); err != nil {
+				return bytesRead, err
+			}
+			var opts []fileset.FileOption
+			if mod.AddFile.Attributes != nil {
+				opts = append(opts, fileset.WithAttributes(indexAttributes(mod.AddFile.Attributes)))
+			}
 			switch src := mod.AddFile.Source.(type) {
 			case *pfs.AddFile_Raw:
-				n, err = putFileRaw(uw, p, t, src.Raw)
+				n, err = putFileRaw(uw, p, t, src.Raw, opts...)
 			case *pfs.AddFile_Url:
-				n, err = putFileURL(ctx, uw, p, t, src.Url)
+				n, err = putFileURL(ctx, uw, p, t, src.Url, opts...)
 			default:
 				// need to write empty data to path
-				n, err = putFileRaw(uw, p, t, &types.BytesValue{})
+				n, err = putFileRaw(uw, p, t, &types.BytesValue{}, opts...)
 			}
 			if err != nil {
 				return bytesRead, err
@@ -410,14 +437,14 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 	return bytesRead, nil
 }
 
-func putFileRaw(uw *fileset.UnorderedWriter, path, tag string, src *types.BytesValue) (int64, error) {
-	if err := uw.Put(path, tag, true, bytes.NewReader(src.Value)); err != nil {
+func putFileRaw(uw *fileset.UnorderedWriter, path, tag string, src *types.BytesValue, opts ...fileset.FileOption) (int64, error) {
+	if err := uw.Put(path, tag, true, bytes.NewReader(src.Value), opts...); err != nil {
 		return 0, err
 	}
 	return int64(len(src.Value)), nil
 }
 
-func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag string, src *pfs.AddFile_URLSource) (n int64, retErr error) {
+func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag string, src *pfs.AddFile_URLSource, opts ...fileset.FileOption) (n int64, retErr error) {
 	url, err := url.Parse(src.URL)
 	if err != nil {
 		return 0, errors.EnsureStack(err)
@@ -437,7 +464,7 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 				retErr = err
 			}
 		}()
-		return 0, uw.Put(dstPath, tag, true, resp.Body)
+		return 0, uw.Put(dstPath, tag, true, resp.Body, opts...)
 	default:
 		url, err := obj.ParseURL(src.URL)
 		if err != nil {
@@ -447,13 +474,15 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 		if err != nil {
 			return 0, err
 		}
+		// External objects are read in a single request, rather than in
+		// ranges, since they may be overwritten while they're being read.
 		if src.Recursive {
 			path := strings.TrimPrefix(url.Object, "/")
 			err := objClient.Walk(ctx, path, func(name string) error {
 				return miscutil.WithPipe(func(w io.Writer) error {
 					return errors.EnsureStack(objClient.Get(ctx, name, w))
 				}, func(r io.Reader) error {
-					return uw.Put(filepath.Join(dstPath, strings.TrimPrefix(name, path)), tag, true, r)
+					return uw.Put(filepath.Join(dstPath, strings.TrimPrefix(name, path)), tag, true, r, opts...)
 				})
 			})
 			return 0, errors.EnsureStack(err)
@@ -461,7 +490,7 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 		return 0, miscutil.WithPipe(func(w io.Writer) error {
 			return errors.EnsureStack(objClient.Get(ctx, url.Object, w))
 		}, func(r io.Reader) error {
-			return uw.Put(dstPath, tag, true, r)
+			return uw.Put(dstPath, tag, true, r, opts...)
 		})
 	}
 }
@@ -521,7 +550,12 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 	})
 }
 
-// TODO: Parallelize and decide on appropriate config.
+// getFileURLConcurrency is the number of files uploaded at a time by
+// getFileURL. Large files are also uploaded in parts, several at a time.
+const getFileURLConcurrency = 4
+
+// getFileURL uploads the files in src to the object storage at URL, several at
+// a time.
 func getFileURL(ctx context.Context, URL string, src Source) (int64, error) {
 	parsedURL, err := obj.ParseURL(URL)
 	if err != nil {
@@ -532,21 +566,38 @@ func getFileURL(ctx context.Context, URL string, src Source) (int64, error) {
 		return 0, err
 	}
 	var bytesWritten int64
-	err = src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) (retErr error) {
+	eg, egCtx := errgroup.WithContext(ctx)
+	limiter := limit.New(getFileURLConcurrency)
+	iterErr := src.Iterate(egCtx, func(fi *pfs.FileInfo, file fileset.File) error {
 		if fi.FileType != pfs.FileType_FILE {
 			return nil
 		}
-		if err := miscutil.WithPipe(func(w io.Writer) error {
-			return errors.EnsureStack(file.Content(ctx, w))
-		}, func(r io.Reader) error {
-			return errors.EnsureStack(objClient.Put(ctx, filepath.Join(parsedURL.Object, fi.File.Path), r))
-		}); err != nil {
-			return err
+		path, size := filepath.Join(parsedURL.Object, fi.File.Path), int64(fi.SizeBytes)
+		limiter.Acquire()
+		if err := egCtx.Err(); err != nil {
+			limiter.Release()
+			return errors.EnsureStack(err)
 		}
-		bytesWritten += int64(fi.SizeBytes)
+		eg.Go(func() error {
+			defer limiter.Release()
+			if err := miscutil.WithPipe(func(w io.Writer) error {
+				return errors.EnsureStack(file.Content(egCtx, w))
+			}, func(r io.Reader) error {
+				return errors.EnsureStack(objClient.Put(egCtx, path, r))
+			}); err != nil {
+				return err
+			}
+			atomic.AddInt64(&bytesWritten, size)
+			return nil
+		})
 		return nil
 	})
-	return bytesWritten, errors.EnsureStack(err)
+	// An upload error cancels the iteration, so it's returned in preference
+	// to the iteration's error.
+	if err := eg.Wait(); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	return bytesWritten, errors.EnsureStack(iterErr)
 }
 
 func withGetFileWriter(w io.Writer, cb func(io.Writer) error) (int64, error) {
@@ -576,13 +627,31 @@ func getFileTar(ctx context.Context, w io.Writer, src Source) error {
 	// 	},
 	// }
 	if err := src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) error {
-		return fileset.WriteTarEntry(ctx, w, file)
+		return writeTarEntry(ctx, w, fi, file)
 	}); err != nil {
 		return errors.EnsureStack(err)
 	}
 	return errors.EnsureStack(tar.NewWriter(w).Close())
 }
 
+// writeTarEntry writes a tar entry for file to w, with a header that carries
+// the attributes of the file.
+func writeTarEntry(ctx context.Context, w io.Writer, fi *pfs.FileInfo, file fileset.File) error {
+	idx := file.Index()
+	hdr := tarutil.NewHeader(idx.Path, index.SizeBytes(idx))
+	fi.Attributes.ApplyToTarHeader(hdr)
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(hdr); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if hdr.Typeflag != tar.TypeSymlink {
+		if err := file.Content(ctx, tw); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return errors.EnsureStack(tw.Flush())
+}
+
 // InspectFile implements the protobuf pfs.InspectFile RPC
 func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (response *pfs.FileInfo, retErr error) {
 	return a.driver.inspectFile(ctx, request.File)
@@ -604,7 +673,7 @@ func (a *apiServer) WalkFile(request *pfs.WalkFileRequest, server pfs.API_WalkFi
 
 // GlobFile implements the protobuf pfs.GlobFile RPC
 func (a *apiServer) GlobFile(request *pfs.GlobFileRequest, respServer pfs.API_GlobFileServer) (retErr error) {
-	return a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, func(fi *pfs.FileInfo) error {
+	return a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, request.StartAfter, func(fi *pfs.FileInfo) error {
 		return errors.EnsureStack(respServer.Send(fi))
 	})
 }
@@ -662,9 +731,9 @@ func (a *apiServer) GetFileSet(ctx context.Context, req *pfs.GetFileSetRequest)
 }
 
 func (a *apiServer) AddFileSet(ctx context.Context, req *pfs.AddFileSetRequest) (_ *types.Empty, retErr error) {
-	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.AddFileSetInTransaction(txnCtx, req)
-	}); err != nil {
+	if err := a.env.TxnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.AddFileSet(req))
+	}, nil); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -681,6 +750,31 @@ func (a *apiServer) AddFileSetInTransaction(txnCtx *txncontext.TransactionContex
 	return nil
 }
 
+// PinFileSet clones a file set without a TTL, so that it isn't deleted until
+// the clone is unpinned with UnpinFileSet. It returns the clone's ID. This is
+// not an RPC.
+func (a *apiServer) PinFileSet(ctx context.Context, id string) (string, error) {
+	fsid, err := fileset.ParseID(id)
+	if err != nil {
+		return "", err
+	}
+	pinned, err := a.driver.pinFileSet(ctx, *fsid)
+	if err != nil {
+		return "", err
+	}
+	return pinned.HexString(), nil
+}
+
+// UnpinFileSet allows a file set pinned with PinFileSet to be deleted. This is
+// not an RPC.
+func (a *apiServer) UnpinFileSet(ctx context.Context, id string) error {
+	fsid, err := fileset.ParseID(id)
+	if err != nil {
+		return err
+	}
+	return a.driver.unpinFileSet(ctx, *fsid)
+}
+
 // RenewFileSet implements the pfs.RenewFileSet RPC
 func (a *apiServer) RenewFileSet(ctx context.Context, req *pfs.RenewFileSetRequest) (_ *types.Empty, retErr error) {
 	fsid, err := fileset.ParseID(req.FileSetId)
@@ -723,6 +817,36 @@ func (a *apiServer) CheckStorage(ctx context.Context, req *pfs.CheckStorageReque
 	}, nil
 }
 
+// ScrubStorage implements the protobuf pfs.ScrubStorage RPC
+func (a *apiServer) ScrubStorage(req *pfs.ScrubStorageRequest, server pfs.API_ScrubStorageServer) error {
+	return a.driver.scrubStorage(server.Context(), req, func(resp *pfs.ScrubStorageResponse) error {
+		return errors.EnsureStack(server.Send(resp))
+	})
+}
+
+// ReplicationStatus implements the protobuf pfs.ReplicationStatus RPC
+func (a *apiServer) ReplicationStatus(ctx context.Context, req *pfs.ReplicationStatusRequest) (*pfs.ReplicationStatusResponse, error) {
+	return a.driver.replicationStatus(ctx)
+}
+
+// GarbageCollectStorage implements the protobuf pfs.GarbageCollectStorage RPC
+func (a *apiServer) GarbageCollectStorage(ctx context.Context, req *pfs.GarbageCollectStorageRequest) (*pfs.GarbageCollectStorageResponse, error) {
+	return a.driver.garbageCollectStorage(ctx, req)
+}
+
+// SetStorageGCPaused implements the protobuf pfs.SetStorageGCPaused RPC
+func (a *apiServer) SetStorageGCPaused(ctx context.Context, req *pfs.SetStorageGCPausedRequest) (*types.Empty, error) {
+	if err := a.driver.setStorageGCPaused(ctx, req.Paused); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// RestoreStorageTrash implements the protobuf pfs.RestoreStorageTrash RPC
+func (a *apiServer) RestoreStorageTrash(ctx context.Context, req *pfs.RestoreStorageTrashRequest) (*pfs.RestoreStorageTrashResponse, error) {
+	return a.driver.restoreStorageTrash(ctx, req)
+}
+
 func (a *apiServer) PutCache(ctx context.Context, req *pfs.PutCacheRequest) (resp *types.Empty, retErr error) {
 	var fsids []fileset.ID
 	for _, id := range req.FileSetIds {
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// indexAttributes converts the attributes of a file in the PFS API to the
+// attributes stored in a file set index.
+func indexAttributes(attrs *pfs.FileAttributes) *index.FileAttributes {
+	if attrs == nil {
+		return nil
+	}
+	return &index.FileAttributes{
+		Mode:          attrs.Mode,
+		Mtime:         attrs.Mtime,
+		SymlinkTarget: attrs.SymlinkTarget,
+		ContentType:   attrs.ContentType,
+		Xattrs:        attrs.Xattrs,
+	}
+}
+
+// pfsAttributes converts the attributes stored in a file set index to the
+// attributes of a file in the PFS API.
+func pfsAttributes(attrs *index.FileAttributes) *pfs.FileAttributes {
+	if attrs == nil {
+		return nil
+	}
+	return &pfs.FileAttributes{
+		Mode:          attrs.Mode,
+		Mtime:         attrs.Mtime,
+		SymlinkTarget: attrs.SymlinkTarget,
+		ContentType:   attrs.ContentType,
+		Xattrs:        attrs.Xattrs,
+	}
+}
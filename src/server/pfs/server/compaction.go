@@ -29,8 +29,10 @@ func newCompactor(storage *fileset.Storage, maxFanIn int) *compactor {
 	}
 }
 
-func (c *compactor) Compact(ctx context.Context, taskDoer task.Doer, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
-	return c.storage.CompactLevelBased(ctx, ids, defaultTTL, func(ctx context.Context, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
+// Compact performs a level-based compaction of ids using config, which
+// defaults to the storage's compaction config if it is nil.
+func (c *compactor) Compact(ctx context.Context, taskDoer task.Doer, ids []fileset.ID, ttl time.Duration, config *fileset.CompactionConfig) (*fileset.ID, error) {
+	return c.storage.CompactLevelBased(ctx, ids, defaultTTL, config, func(ctx context.Context, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
 		return c.compact(ctx, taskDoer, ids, ttl)
 	})
 }
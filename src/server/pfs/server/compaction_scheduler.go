@@ -0,0 +1,520 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/semaphore"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// deferredCompactionPeriod is the period at which the deferred compactions
+// are checked against their compaction windows.
+const deferredCompactionPeriod = time.Minute
+
+// deferredCompactionParallelism is the number of chains of deferred commits
+// that are compacted concurrently.
+const deferredCompactionParallelism = 4
+
+var (
+	compactionDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_compaction",
+		Name:      "duration_seconds",
+		Help:      "Time spent compacting commits, by whether the compaction was deferred",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 16),
+	}, []string{"kind"})
+	compactingCommitsMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_compaction",
+		Name:      "compacting_commits",
+		Help:      "Number of commits being compacted",
+	})
+	deferredCommitsMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_compaction",
+		Name:      "deferred_commits",
+		Help:      "Number of commits waiting for compaction, either deferred or still being finished",
+	})
+	deferredBytesMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_compaction",
+		Name:      "deferred_bytes",
+		Help:      "Number of bytes added by the commits waiting for a deferred compaction",
+	})
+	deferralsMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "pfs_compaction",
+		Name:      "deferrals_total",
+		Help:      "Number of commits that finished without being compacted",
+	})
+)
+
+// SetupPostgresDeferredCompactionsV0 sets up the table that tracks the
+// commits whose compaction was deferred.
+func SetupPostgresDeferredCompactionsV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE pfs.deferred_compactions (
+		commit_id TEXT NOT NULL,
+		size_bytes BIGINT NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+		PRIMARY KEY(commit_id)
+	);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// compactionWindow is a daily window of time in UTC, as offsets from
+// midnight. The window wraps around midnight when end is before start.
+type compactionWindow struct {
+	start, end time.Duration
+}
+
+// parseCompactionWindow parses a window of the form "HH:MM-HH:MM". It
+// returns nil, meaning any time, for an empty string.
+func parseCompactionWindow(s string) (*compactionWindow, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var startH, startM, endH, endM int
+	if _, err := fmt.Sscanf(s, "%d:%d-%d:%d", &startH, &startM, &endH, &endM); err != nil {
+		return nil, errors.Errorf("invalid compaction window %q, expected HH:MM-HH:MM", s)
+	}
+	for _, hm := range [][2]int{{startH, startM}, {endH, endM}} {
+		if hm[0] < 0 || hm[0] > 23 || hm[1] < 0 || hm[1] > 59 {
+			return nil, errors.Errorf("invalid compaction window %q, expected HH:MM-HH:MM", s)
+		}
+	}
+	w := &compactionWindow{
+		start: time.Duration(startH)*time.Hour + time.Duration(startM)*time.Minute,
+		end:   time.Duration(endH)*time.Hour + time.Duration(endM)*time.Minute,
+	}
+	if w.start == w.end {
+		// such a window would never open
+		return nil, errors.Errorf("invalid compaction window %q, the start and end must differ", s)
+	}
+	return w, nil
+}
+
+func (w *compactionWindow) contains(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+	t = t.UTC()
+	offset := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+	if w.start <= w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
+
+func validateCompactionSpec(spec *pfs.CompactionSpec) error {
+	if spec == nil {
+		return nil
+	}
+	if spec.LevelFactor < 0 {
+		return errors.Errorf("compaction level factor cannot be negative")
+	}
+	if spec.DeferSizeBytes < 0 {
+		return errors.Errorf("compaction defer size cannot be negative")
+	}
+	_, err := parseCompactionWindow(spec.Window)
+	return err
+}
+
+// compactionPolicy is the compaction configuration of a repo.
+type compactionPolicy struct {
+	spec      *pfs.CompactionSpec
+	config    *fileset.CompactionConfig
+	deferSize int64
+	window    *compactionWindow
+}
+
+// compactionPolicy returns the compaction configuration of repo, with the
+// cluster defaults filled in.
+func (d *driver) compactionPolicy(ctx context.Context, repo *pfs.Repo) (*compactionPolicy, error) {
+	config := d.storage.CompactionConfig()
+	spec := &pfs.CompactionSpec{
+		LevelFactor:    config.LevelFactor,
+		DeferSizeBytes: d.env.StorageConfig.StorageCompactionDeferSize,
+		Window:         d.env.StorageConfig.StorageCompactionWindow,
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil && !col.IsErrNotFound(err) {
+		return nil, errors.EnsureStack(err)
+	}
+	if repoSpec := repoInfo.Compaction; repoSpec != nil {
+		if repoSpec.LevelFactor > 0 {
+			spec.LevelFactor = repoSpec.LevelFactor
+		}
+		if repoSpec.DeferSizeBytes > 0 {
+			spec.DeferSizeBytes = repoSpec.DeferSizeBytes
+		}
+		if repoSpec.Window != "" {
+			spec.Window = repoSpec.Window
+		}
+	}
+	window, err := parseCompactionWindow(spec.Window)
+	if err != nil {
+		return nil, err
+	}
+	config.LevelFactor = spec.LevelFactor
+	return &compactionPolicy{
+		spec:      spec,
+		config:    config,
+		deferSize: spec.DeferSizeBytes,
+		window:    window,
+	}, nil
+}
+
+// deferCompaction finishes the compaction of commit without compacting its
+// file set, which is compacted later by compactDeferredCommits.
+func (d *driver) deferCompaction(ctx context.Context, commit *pfs.Commit, id fileset.ID, sizeBytes int64) error {
+	if err := dbutil.WithTx(ctx, d.env.DB, func(tx *pachsql.Tx) error {
+		if err := d.commitStore.SetTotalFileSetTx(tx, commit, id); err != nil {
+			return errors.EnsureStack(err)
+		}
+		_, err := tx.Exec(`
+		INSERT INTO pfs.deferred_compactions (commit_id, size_bytes) VALUES ($1, $2)
+		ON CONFLICT (commit_id) DO UPDATE SET size_bytes = $2
+		`, pfsdb.CommitKey(commit), sizeBytes)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return err
+	}
+	deferralsMetric.Inc()
+	return nil
+}
+
+// startFinishing records that a commit is being finished, until the returned
+// function is called, so that it counts towards the compaction backlog.
+func (d *driver) startFinishing(commit *pfs.Commit) func() {
+	key := pfsdb.CommitKey(commit)
+	d.finishingMu.Lock()
+	defer d.finishingMu.Unlock()
+	d.finishing[key] = true
+	return func() {
+		d.finishingMu.Lock()
+		defer d.finishingMu.Unlock()
+		delete(d.finishing, key)
+	}
+}
+
+// finishingCommits returns the set of commits that are being finished.
+func (d *driver) finishingCommits() map[string]bool {
+	d.finishingMu.Lock()
+	defer d.finishingMu.Unlock()
+	result := make(map[string]bool, len(d.finishing))
+	for key := range d.finishing {
+		result[key] = true
+	}
+	return result
+}
+
+func (d *driver) isCompactionDeferred(ctx context.Context, commit *pfs.Commit) (bool, error) {
+	var count int
+	if err := d.env.DB.GetContext(ctx, &count, `
+	SELECT COUNT(*) FROM pfs.deferred_compactions WHERE commit_id = $1
+	`, pfsdb.CommitKey(commit)); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	return count > 0, nil
+}
+
+// isParentCompactionDeferred returns true if the total file set of the
+// parent of a commit is the uncompacted file set of a deferred commit. The
+// compaction of such a commit would include the deferred compaction, so it is
+// deferred as well.
+func (d *driver) isParentCompactionDeferred(ctx context.Context, commitInfo *pfs.CommitInfo) (bool, error) {
+	if commitInfo.ParentCommit == nil {
+		return false, nil
+	}
+	var count int
+	if err := d.env.DB.GetContext(ctx, &count, `
+	SELECT COUNT(*) FROM pfs.deferred_compactions dc
+	JOIN pfs.commit_totals ct ON ct.commit_id = dc.commit_id
+	WHERE ct.fileset_id = (SELECT fileset_id FROM pfs.commit_totals WHERE commit_id = $1)
+	`, pfsdb.CommitKey(commitInfo.ParentCommit)); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	return count > 0, nil
+}
+
+// compactDeferredCommits compacts the commits whose compaction was deferred,
+// during the compaction windows of their repos, until the context is
+// cancelled.
+func (d *driver) compactDeferredCommits(ctx context.Context, compactor *compactor) error {
+	ticker := time.NewTicker(deferredCompactionPeriod)
+	defer ticker.Stop()
+	for {
+		if err := d.compactDeferredCommitsOnce(ctx, compactor); err != nil {
+			d.log.Errorf("error compacting deferred commits: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+}
+
+func (d *driver) compactDeferredCommitsOnce(ctx context.Context, compactor *compactor) error {
+	var deferred []struct {
+		CommitID  string `db:"commit_id"`
+		SizeBytes int64  `db:"size_bytes"`
+	}
+	if err := d.env.DB.SelectContext(ctx, &deferred, `
+	SELECT commit_id, size_bytes FROM pfs.deferred_compactions ORDER BY created_at
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	backlog := d.finishingCommits()
+	var total int64
+	for _, x := range deferred {
+		backlog[x.CommitID] = true
+		total += x.SizeBytes
+	}
+	deferredCommitsMetric.Set(float64(len(backlog)))
+	deferredBytesMetric.Set(float64(total))
+	infos := make(map[string]*pfs.CommitInfo)
+	var order []*pfs.CommitInfo
+	for _, x := range deferred {
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).Get(x.CommitID, commitInfo); err != nil {
+			if !col.IsErrNotFound(err) {
+				d.log.Errorf("error inspecting deferred commit %v: %v", x.CommitID, err)
+				continue
+			}
+			// The commit was deleted.
+			if _, err := d.env.DB.ExecContext(ctx, `DELETE FROM pfs.deferred_compactions WHERE commit_id = $1`, x.CommitID); err != nil {
+				d.log.Errorf("error removing deleted commit %v from the deferred compactions: %v", x.CommitID, err)
+			}
+			continue
+		}
+		infos[x.CommitID] = commitInfo
+		order = append(order, commitInfo)
+	}
+	// A deferred commit whose parent is deferred as well is compacted after
+	// its parent, on top of the parent's compacted file set, so each chain of
+	// deferred commits is compacted from its oldest commit.
+	sem := semaphore.NewWeighted(deferredCompactionParallelism)
+	var wg sync.WaitGroup
+	for _, commitInfo := range order {
+		if commitInfo.ParentCommit != nil && infos[pfsdb.CommitKey(commitInfo.ParentCommit)] != nil {
+			continue
+		}
+		if err := sem.Acquire(ctx, 1); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func(commitInfo *pfs.CommitInfo) {
+			defer wg.Done()
+			defer sem.Release(1)
+			d.compactDeferredChain(ctx, compactor, commitInfo, infos)
+		}(commitInfo)
+	}
+	wg.Wait()
+	return errors.EnsureStack(ctx.Err())
+}
+
+// compactDeferredChain compacts a deferred commit, if its repo's compaction
+// window is open, and then the deferred commits among its descendents. Errors
+// are logged, so that one commit can't hold up the others.
+func (d *driver) compactDeferredChain(ctx context.Context, compactor *compactor, commitInfo *pfs.CommitInfo, infos map[string]*pfs.CommitInfo) {
+	policy, err := d.compactionPolicy(ctx, commitInfo.Commit.Branch.Repo)
+	if err != nil {
+		d.log.Errorf("error getting the compaction policy of deferred commit %v: %v", commitInfo.Commit, err)
+		return
+	}
+	if !policy.window.contains(time.Now()) {
+		return
+	}
+	type link struct {
+		commitInfo *pfs.CommitInfo
+		// base is the compacted total file set of the commit's parent, if it
+		// was compacted in this chain.
+		base *fileset.ID
+	}
+	links := []link{{commitInfo: commitInfo}}
+	for len(links) > 0 {
+		l := links[0]
+		links = links[1:]
+		totalID, err := d.compactDeferredCommit(ctx, compactor, l.commitInfo.Commit, l.base, policy)
+		if err != nil {
+			d.log.Errorf("error compacting deferred commit %v: %v", l.commitInfo.Commit, err)
+		}
+		for _, child := range l.commitInfo.ChildCommits {
+			if childInfo, ok := infos[pfsdb.CommitKey(child)]; ok {
+				links = append(links, link{commitInfo: childInfo, base: totalID})
+			}
+		}
+	}
+}
+
+// compactDeferredCommit compacts the total file set of a deferred commit, or
+// if 'base' is set, the compacted total file set of its parent along with its
+// diff, and validates the result. It returns the compacted file set if the
+// commit is valid.
+func (d *driver) compactDeferredCommit(ctx context.Context, compactor *compactor, commit *pfs.Commit, base *fileset.ID, policy *compactionPolicy) (*fileset.ID, error) {
+	key := pfsdb.CommitKey(commit)
+	var totalID *fileset.ID
+	if err := miscutil.LogStep(fmt.Sprintf("compacting deferred commit %v", commit), func() error {
+		var ids []fileset.ID
+		if base != nil {
+			diffID, err := d.commitStore.GetDiffFileSet(ctx, commit)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			ids = []fileset.ID{*base, *diffID}
+		} else {
+			id, err := d.commitStore.GetTotalFileSet(ctx, commit)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			ids = []fileset.ID{*id}
+		}
+		cache := d.newCache("deferred/" + key)
+		defer func() {
+			if err := cache.clear(ctx); err != nil {
+				d.log.Errorf("errored clearing compaction cache: %s", err)
+			}
+		}()
+		taskDoer := d.env.TaskService.NewDoer(storageTaskNamespace, "deferred/"+key, cache)
+		compactingCommitsMetric.Inc()
+		start := time.Now()
+		var err error
+		totalID, err = compactor.Compact(ctx, taskDoer, ids, defaultTTL, policy.config)
+		compactingCommitsMetric.Dec()
+		if err != nil {
+			return err
+		}
+		compactionDurationMetric.WithLabelValues("deferred").Observe(time.Since(start).Seconds())
+		// The validation of deferred commits is left to their compaction, as it
+		// reads the whole file set.
+		start = time.Now()
+		sizeBytes, validationError, err := compactor.Validate(ctx, taskDoer, *totalID)
+		if err != nil {
+			return err
+		}
+		validatingTime := types.DurationProto(time.Since(start))
+		compactedID := *totalID
+		if validationError != "" {
+			// An invalid commit's descendents don't build on its file set.
+			totalID = nil
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			commitInfo := &pfs.CommitInfo{}
+			if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(key, commitInfo); err != nil {
+				if !col.IsErrNotFound(err) {
+					return errors.EnsureStack(err)
+				}
+			} else {
+				if commitInfo.Details == nil {
+					commitInfo.Details = &pfs.CommitInfo_Details{}
+				}
+				commitInfo.Details.SizeBytes = sizeBytes
+				commitInfo.Details.ValidatingTime = validatingTime
+				commitInfo.SizeBytesUpperBound = sizeBytes
+				if commitInfo.Error == "" {
+					commitInfo.Error = validationError
+				}
+				if err := d.updateDeferredCommit(txnCtx, commitInfo, compactedID); err != nil {
+					return err
+				}
+			}
+			_, err := txnCtx.SqlTx.Exec(`DELETE FROM pfs.deferred_compactions WHERE commit_id = $1`, key)
+			return errors.EnsureStack(err)
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return totalID, nil
+}
+
+// updateDeferredCommit writes the commit info and the compacted total file
+// set of a deferred commit, and of the contiguous aliases of it among its
+// descendents.
+func (d *driver) updateDeferredCommit(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo, id fileset.ID) error {
+	commits := d.commits.ReadWrite(txnCtx.SqlTx)
+	if err := commits.Put(pfsdb.CommitKey(commitInfo.Commit), commitInfo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := d.commitStore.SetTotalFileSetTx(txnCtx.SqlTx, commitInfo.Commit, id); err != nil {
+		return errors.EnsureStack(err)
+	}
+	descendents := append([]*pfs.Commit{}, commitInfo.ChildCommits...)
+	for len(descendents) > 0 {
+		commit := descendents[0]
+		descendents = descendents[1:]
+		childInfo := &pfs.CommitInfo{}
+		if err := commits.Get(pfsdb.CommitKey(commit), childInfo); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if childInfo.Origin.Kind == pfs.OriginKind_ALIAS {
+			childInfo.Details = commitInfo.Details
+			childInfo.SizeBytesUpperBound = commitInfo.SizeBytesUpperBound
+			childInfo.Error = commitInfo.Error
+			if err := commits.Put(pfsdb.CommitKey(commit), childInfo); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := d.commitStore.SetTotalFileSetTx(txnCtx.SqlTx, commit, id); err != nil {
+				return errors.EnsureStack(err)
+			}
+			descendents = append(descendents, childInfo.ChildCommits...)
+		}
+	}
+	return nil
+}
+
+// inspectCommitCompaction returns the level structure of the file sets of a
+// commit.
+func (d *driver) inspectCommitCompaction(ctx context.Context, commit *pfs.Commit) (*pfs.CommitCompactionInfo, error) {
+	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := d.compactionPolicy(ctx, commitInfo.Commit.Branch.Repo)
+	if err != nil {
+		return nil, err
+	}
+	id, err := d.getFileSet(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	levels, compacted, err := d.storage.CompactionLevels(ctx, []fileset.ID{*id}, policy.config)
+	if err != nil {
+		return nil, err
+	}
+	deferred, err := d.isCompactionDeferred(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	info := &pfs.CommitCompactionInfo{
+		Commit:   commitInfo.Commit,
+		Spec:     policy.spec,
+		Deferred: deferred,
+	}
+	for i, level := range levels {
+		info.Levels = append(info.Levels, &pfs.CompactionLevel{
+			FileSetId: level.ID.HexString(),
+			SizeBytes: level.SizeBytes,
+			Compacted: i < compacted,
+		})
+	}
+	return info, nil
+}
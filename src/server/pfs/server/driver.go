@@ -8,6 +8,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -84,6 +85,11 @@ type driver struct {
 	commitStore commitStore
 
 	cache *fileset.Cache
+
+	// finishing is the set of commits being finished by the master, which
+	// counts towards the compaction backlog.
+	finishingMu sync.Mutex
+	finishing   map[string]bool
 }
 
 func newDriver(env Env) (*driver, error) {
@@ -111,6 +117,7 @@ func newDriver(env Env) (*driver, error) {
 		commits:    commits,
 		branches:   branches,
 		log:        env.Logger,
+		finishing:  make(map[string]bool),
 	}
 	// Setup tracker and chunk / fileset storage.
 	tracker := track.NewPostgresTracker(env.DB)
@@ -136,11 +143,23 @@ func newDriver(env Env) (*driver, error) {
 	return d, nil
 }
 
-func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, update bool) error {
+func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, chunking *pfs.ChunkingSpec, compaction *pfs.CompactionSpec, update bool) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
 	}
+	if _, err := chunk.ChunkingConfigFromSpec(chunking); err != nil {
+		return err
+	}
+	// An empty chunking spec resets the repo to the default chunking, which is
+	// stored as no spec at all.
+	resetChunking := chunking != nil && proto.Equal(chunking, &pfs.ChunkingSpec{})
+	if resetChunking {
+		chunking = nil
+	}
+	if err := validateCompactionSpec(compaction); err != nil {
+		return err
+	}
 
 	// Check that the user is logged in (user doesn't need any access level to
 	// create a repo, but they must be authenticated if auth is active)
@@ -175,7 +194,13 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			}
 		}
 
-		if existingRepoInfo.Description == description {
+		if chunking == nil && !resetChunking {
+			chunking = existingRepoInfo.Chunking
+		}
+		if compaction == nil {
+			compaction = existingRepoInfo.Compaction
+		}
+		if existingRepoInfo.Description == description && proto.Equal(existingRepoInfo.Chunking, chunking) && proto.Equal(existingRepoInfo.Compaction, compaction) {
 			// Don't overwrite the stored proto with an identical value. This
 			// optimization is impactful because pps will frequently update the spec
 			// repo to make sure it exists.
@@ -192,6 +217,8 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			return errors.Wrapf(err, "could not update description of %q", repo)
 		}
 		existingRepoInfo.Description = description
+		existingRepoInfo.Chunking = chunking
+		existingRepoInfo.Compaction = compaction
 		return errors.EnsureStack(repos.Put(repo, &existingRepoInfo))
 	} else {
 		// if this is a system repo, make sure the corresponding user repo already exists
@@ -206,6 +233,14 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 		}
 
 		// New repo case
+		if authIsActivated && whoAmI.Scope != nil && repo.Type == pfs.UserRepoType {
+			// Creating a repo doesn't require any permission, so it's not
+			// covered by the permissions of a scoped token
+			return &auth.ErrNotAuthorized{
+				Subject:  whoAmI.Username,
+				Resource: auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name},
+			}
+		}
 		if authIsActivated {
 			// Create ACL for new repo. Make caller the sole owner. If this is a user repo,
 			// and the ACL already exists with a different owner, this will fail.
@@ -224,6 +259,8 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			Repo:        repo,
 			Created:     txnCtx.Timestamp,
 			Description: description,
+			Chunking:    chunking,
+			Compaction:  compaction,
 		}))
 	}
 }
@@ -437,7 +474,7 @@ func (d *driver) startCommit(
 		return nil, errors.Errorf("branch must be specified")
 	}
 	// Check that caller is authorized
-	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+	if err := d.env.AuthServer.CheckBranchIsAuthorizedInTransaction(txnCtx, branch, auth.Permission_REPO_WRITE); err != nil {
 		return nil, errors.EnsureStack(err)
 	}
 
@@ -1672,7 +1709,7 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	}
 
 	var err error
-	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+	if err := d.env.AuthServer.CheckBranchIsAuthorizedInTransaction(txnCtx, branch, auth.Permission_REPO_CREATE_BRANCH); err != nil {
 		return errors.EnsureStack(err)
 	}
 	// Validate request
@@ -1820,6 +1857,26 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	return nil
 }
 
+// repropagateBranch aliases the head of 'branch' into the transaction's
+// commitset and propagates it, which creates new commits (and so new jobs) in
+// the branches downstream of it, with the same provenance as their current
+// heads.
+func (d *driver) repropagateBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch) error {
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(branch, branchInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrBranchNotFound{Branch: branch}
+		}
+		return errors.EnsureStack(err)
+	}
+	if branchInfo.Head.ID != txnCtx.CommitSetID {
+		if _, err := d.aliasCommit(txnCtx, branchInfo.Head, branch); err != nil {
+			return err
+		}
+	}
+	return txnCtx.PropagateBranch(branch)
+}
+
 func (d *driver) inspectBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch) (*pfs.BranchInfo, error) {
 	// Validate arguments
 	if branch == nil {
@@ -1931,7 +1988,7 @@ func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 		return errors.New("branch repo cannot be nil")
 	}
 
-	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_DELETE_BRANCH); err != nil {
+	if err := d.env.AuthServer.CheckBranchIsAuthorizedInTransaction(txnCtx, branch, auth.Permission_REPO_DELETE_BRANCH); err != nil {
 		return errors.EnsureStack(err)
 	}
 
@@ -8,11 +8,16 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -29,12 +34,21 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 			branch.Name = commitID
 			commitID = ""
 		}
+		opts, err := d.pathAuthOptions(ctx, branch.Repo)
+		if err != nil {
+			return err
+		}
+		chunkingOpts, err := d.chunkingOptions(ctx, branch.Repo)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, chunkingOpts...)
 		commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
 		if err != nil {
 			if !errutil.IsNotFoundError(err) || branch.Name == "" {
 				return err
 			}
-			return d.oneOffModifyFile(ctx, renewer, branch, cb)
+			return d.oneOffModifyFile(ctx, renewer, branch, cb, opts...)
 		}
 		if commitInfo.Finishing != nil {
 			// The commit is already finished - if the commit was explicitly specified,
@@ -42,7 +56,7 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 			if commitID != "" {
 				return pfsserver.ErrCommitFinished{Commit: commitInfo.Commit}
 			}
-			return d.oneOffModifyFile(ctx, renewer, branch, cb, fileset.WithParentID(func() (*fileset.ID, error) {
+			return d.oneOffModifyFile(ctx, renewer, branch, cb, append(opts, fileset.WithParentID(func() (*fileset.ID, error) {
 				parentID, err := d.getFileSet(ctx, commitInfo.Commit)
 				if err != nil {
 					return nil, err
@@ -51,12 +65,59 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 					return nil, err
 				}
 				return parentID, nil
-			}))
+			}))...)
 		}
-		return d.withCommitUnorderedWriter(ctx, renewer, commitInfo.Commit, cb)
+		return d.withCommitUnorderedWriter(ctx, renewer, commitInfo.Commit, cb, opts...)
 	})
 }
 
+// pathAuthOptions returns the unordered writer options that reject writes to
+// paths in repo which the caller isn't allowed to write under its path
+// bindings.
+func (d *driver) pathAuthOptions(ctx context.Context, repo *pfs.Repo) ([]fileset.UnorderedWriterOption, error) {
+	canWrite, err := d.env.AuthServer.AuthorizedPathFilter(ctx, repo, auth.Permission_REPO_WRITE)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if canWrite == nil {
+		return nil, nil
+	}
+	me, err := d.env.AuthServer.WhoAmI(ctx, &auth.WhoAmIRequest{})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return []fileset.UnorderedWriterOption{fileset.WithValidator(func(p string) error {
+		if err := validate(p); err != nil {
+			return err
+		}
+		if !canWrite(p) {
+			return &auth.ErrNotAuthorized{
+				Subject:  me.Username,
+				Resource: auth.Resource{Type: auth.ResourceType_PATH, Name: auth.PathResourceName(repo.Name, cleanPath(p))},
+				Required: []auth.Permission{auth.Permission_REPO_WRITE},
+			}
+		}
+		return nil
+	})}, nil
+}
+
+// chunkingOptions returns the unordered writer options that apply the
+// chunking configuration of repo.
+func (d *driver) chunkingOptions(ctx context.Context, repo *pfs.Repo) ([]fileset.UnorderedWriterOption, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	config, err := chunk.ChunkingConfigFromSpec(repoInfo.Chunking)
+	if err != nil || config == nil {
+		return nil, err
+	}
+	return []fileset.UnorderedWriterOption{fileset.WithChunking(config)}, nil
+}
+
 func (d *driver) oneOffModifyFile(ctx context.Context, renewer *fileset.Renewer, branch *pfs.Branch, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
 	id, err := d.withUnorderedWriter(ctx, renewer, cb, opts...)
 	if err != nil {
@@ -75,8 +136,8 @@ func (d *driver) oneOffModifyFile(ctx context.Context, renewer *fileset.Renewer,
 }
 
 // withCommitWriter calls cb with an unordered writer. All data written to cb is added to the commit, or an error is returned.
-func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
-	id, err := d.withUnorderedWriter(ctx, renewer, cb, fileset.WithParentID(func() (*fileset.ID, error) {
+func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
+	id, err := d.withUnorderedWriter(ctx, renewer, cb, append(opts, fileset.WithParentID(func() (*fileset.ID, error) {
 		parentID, err := d.getFileSet(ctx, commit)
 		if err != nil {
 			return nil, err
@@ -85,7 +146,7 @@ func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset
 			return nil, err
 		}
 		return parentID, nil
-	}))
+	}))...)
 	if err != nil {
 		return err
 	}
@@ -123,7 +184,11 @@ func (d *driver) openCommit(ctx context.Context, commit *pfs.Commit, opts ...ind
 		}
 		return &pfs.CommitInfo{Commit: commit}, fs, nil
 	}
-	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, commit.Branch.Repo, auth.Permission_REPO_READ); err != nil {
+	if err := d.env.AuthServer.CheckBranchIsAuthorized(ctx, commit.Branch, auth.Permission_REPO_READ); err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	canRead, err := d.env.AuthServer.AuthorizedPathFilter(ctx, commit.Branch.Repo, auth.Permission_REPO_READ)
+	if err != nil {
 		return nil, nil, errors.EnsureStack(err)
 	}
 	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
@@ -144,6 +209,12 @@ func (d *driver) openCommit(ctx context.Context, commit *pfs.Commit, opts ...ind
 	if err != nil {
 		return nil, nil, err
 	}
+	if canRead != nil {
+		// Hide the files under restricted paths that the caller can't read
+		fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			return canRead(idx.Path)
+		})
+	}
 	return commitInfo, fs, nil
 }
 
@@ -162,7 +233,7 @@ func (d *driver) copyFile(ctx context.Context, uw *fileset.UnorderedWriter, dst
 		}
 		return path.Join(dstPath, relPath)
 	}
-	_, fs, err := d.openCommit(ctx, srcCommit, index.WithPrefix(srcPath), index.WithDatum(src.Datum))
+	_, fs, err := d.openCommit(ctx, srcCommit, index.WithLookup(srcPath), index.WithDatum(src.Datum))
 	if err != nil {
 		return err
 	}
@@ -204,7 +275,7 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 	if p == "/" {
 		p = ""
 	}
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(p), index.WithDatum(file.Datum))
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithLookup(p), index.WithDatum(file.Datum))
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +303,7 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 
 func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) error {
 	name := cleanPath(file.Path)
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(name), index.WithDatum(file.Datum))
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithLookup(name), index.WithDatum(file.Datum))
 	if err != nil {
 		return err
 	}
@@ -267,7 +338,7 @@ func (d *driver) walkFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 	if p == "/" {
 		p = ""
 	}
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(p), index.WithDatum(file.Datum))
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithLookup(p), index.WithDatum(file.Datum))
 	if err != nil {
 		return err
 	}
@@ -289,9 +360,16 @@ func (d *driver) walkFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 	return err
 }
 
-func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string, cb func(*pfs.FileInfo) error) error {
+func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob, startAfter string, cb func(*pfs.FileInfo) error) error {
 	glob = cleanPath(glob)
-	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)))
+	indexOpts := []index.Option{index.WithPrefix(globLiteralPrefix(glob))}
+	if startAfter != "" {
+		// The directories inserted by the source for the files after
+		// startAfter may sort before it, so the paths are checked again below.
+		startAfter = cleanPath(startAfter)
+		indexOpts = append(indexOpts, index.WithLowerBound(startAfter))
+	}
+	commitInfo, fs, err := d.openCommit(ctx, commit, indexOpts...)
 	if err != nil {
 		return err
 	}
@@ -308,6 +386,9 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string,
 	}
 	s := NewSource(commitInfo, fs, opts...)
 	err = s.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
+		if startAfter != "" && fi.File.Path <= startAfter {
+			return nil
+		}
 		if mf(fi.File.Path) {
 			return cb(fi)
 		}
@@ -333,12 +414,12 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 	}
 	// Do READER authorization check for both newFile and oldFile
 	if oldFile != nil && oldFile.Commit != nil {
-		if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, oldFile.Commit.Branch.Repo, auth.Permission_REPO_READ); err != nil {
+		if err := d.env.AuthServer.CheckBranchIsAuthorized(ctx, oldFile.Commit.Branch, auth.Permission_REPO_READ); err != nil {
 			return errors.EnsureStack(err)
 		}
 	}
 	if newFile != nil && newFile.Commit != nil {
-		if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, newFile.Commit.Branch.Repo, auth.Permission_REPO_READ); err != nil {
+		if err := d.env.AuthServer.CheckBranchIsAuthorized(ctx, newFile.Commit.Branch, auth.Permission_REPO_READ); err != nil {
 			return errors.EnsureStack(err)
 		}
 	}
@@ -462,6 +543,18 @@ func (d *driver) addFileSet(txnCtx *txncontext.TransactionContext, commit *pfs.C
 	if err != nil {
 		return err
 	}
+	if err := d.env.AuthServer.CheckBranchIsAuthorizedInTransaction(txnCtx, commitInfo.Commit.Branch, auth.Permission_REPO_WRITE); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The paths in an existing fileset aren't validated, so callers whose
+	// writes are restricted to some paths can't add filesets.
+	canWrite, err := d.env.AuthServer.AuthorizedPathFilterInTransaction(txnCtx, commitInfo.Commit.Branch.Repo, auth.Permission_REPO_WRITE)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if canWrite != nil {
+		return pathRestrictedError(txnCtx, commitInfo.Commit.Branch.Repo, auth.Permission_REPO_WRITE)
+	}
 	// TODO: This check needs to be in the add transaction.
 	if commitInfo.Finishing != nil {
 		return pfsserver.ErrCommitFinished{Commit: commitInfo.Commit}
@@ -469,6 +562,38 @@ func (d *driver) addFileSet(txnCtx *txncontext.TransactionContext, commit *pfs.C
 	return errors.EnsureStack(d.commitStore.AddFileSetTx(txnCtx.SqlTx, commitInfo.Commit, filesetID))
 }
 
+// pathRestrictedError returns the error for operations on whole filesets of
+// repo, which callers with path restricted access to the repo can't perform.
+func pathRestrictedError(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, p auth.Permission) error {
+	me, err := txnCtx.WhoAmI()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return &auth.ErrNotAuthorized{
+		Subject:  me.Username,
+		Resource: auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name},
+		Required: []auth.Permission{p},
+	}
+}
+
+// pinFileSet clones the file set without a TTL, so that it isn't deleted
+// until the clone is unpinned.
+func (d *driver) pinFileSet(ctx context.Context, id fileset.ID) (*fileset.ID, error) {
+	var pinned *fileset.ID
+	if err := dbutil.WithTx(ctx, d.env.DB, func(tx *pachsql.Tx) error {
+		var err error
+		pinned, err = d.storage.CloneTx(tx, id, track.NoTTL)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return nil, err
+	}
+	return pinned, nil
+}
+
+func (d *driver) unpinFileSet(ctx context.Context, id fileset.ID) error {
+	return errors.EnsureStack(d.storage.Drop(ctx, id))
+}
+
 func (d *driver) renewFileSet(ctx context.Context, id fileset.ID, ttl time.Duration) error {
 	if ttl < time.Second {
 		return errors.Errorf("ttl (%d) must be at least one second", ttl)
@@ -45,6 +45,14 @@ func EnvFromServiceEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv)
 	if err != nil {
 		return nil, err
 	}
+	if spec := env.Config().StorageFaultInjection; spec != "" {
+		faultConfig, err := obj.ParseFaultConfig(spec)
+		if err != nil {
+			return nil, err
+		}
+		env.Logger().Warnf("injecting faults into object storage: %s", spec)
+		objClient = obj.NewFaultClient(objClient, *faultConfig)
+	}
 	etcdPrefix := path.Join(env.Config().EtcdPrefix, env.Config().PFSEtcdPrefix)
 	if env.AuthServer() == nil {
 		panic("auth server cannot be nil")
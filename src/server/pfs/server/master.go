@@ -55,13 +55,37 @@ func (d *driver) master(ctx context.Context) {
 		} else {
 			d.log.Infof("Starting Chunk Storage GC with period=%v", chunkPeriod)
 			eg.Go(func() error {
-				gc := chunk.NewGC(d.storage.ChunkStorage(), chunkPeriod, d.log)
+				gc := chunk.NewGC(d.storage.ChunkStorage(), chunkPeriod, d.log, chunk.GCOptions(&d.env.StorageConfig)...)
 				return gc.RunForever(ctx)
 			})
 		}
+		replicationPeriod := time.Second * time.Duration(d.env.StorageConfig.StorageReplicationPeriod)
+		if len(d.env.StorageConfig.ReplicaURLs()) == 0 || replicationPeriod <= 0 {
+			d.log.Info("Skipping Chunk Storage Replication")
+		} else {
+			d.log.Infof("Starting Chunk Storage Replication with period=%v", replicationPeriod)
+			eg.Go(func() error {
+				replicator := chunk.NewReplicator(d.storage.ChunkStorage(), replicationPeriod, d.log)
+				return replicator.RunForever(ctx)
+			})
+		}
+		scrubPeriod := time.Second * time.Duration(d.env.StorageConfig.StorageScrubPeriod)
+		if scrubPeriod <= 0 {
+			d.log.Info("Skipping Chunk Storage Scrub")
+		} else {
+			d.log.Infof("Starting Chunk Storage Scrub with period=%v", scrubPeriod)
+			eg.Go(func() error {
+				scrubber := chunk.NewScrubber(d.storage.ChunkStorage(), scrubPeriod, d.env.StorageConfig.StorageScrubRateLimit, d.log)
+				return scrubber.RunForever(ctx)
+			})
+		}
 		eg.Go(func() error {
 			return d.finishCommits(ctx)
 		})
+		eg.Go(func() error {
+			compactor := newCompactor(d.storage, d.env.StorageConfig.StorageCompactionMaxFanIn)
+			return d.compactDeferredCommits(ctx, compactor)
+		})
 		return errors.EnsureStack(eg.Wait())
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
@@ -121,6 +145,7 @@ func (d *driver) finishRepoCommits(ctx context.Context, compactor *compactor, re
 			return nil
 		}
 		commit := commitInfo.Commit
+		defer d.startFinishing(commit)()
 		cache := d.newCache(pfsdb.CommitKey(commit))
 		defer func() {
 			if err := cache.clear(ctx); err != nil {
@@ -142,25 +167,64 @@ func (d *driver) finishRepoCommits(ctx context.Context, compactor *compactor, re
 					return err
 				}
 				details := &pfs.CommitInfo_Details{}
-				// Compact the commit.
+				policy, err := d.compactionPolicy(ctx, commit.Branch.Repo)
+				if err != nil {
+					return err
+				}
+				diffID, err := d.commitStore.GetDiffFileSet(ctx, commit)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				diffSize, err := d.storage.SizeUpperBound(ctx, *diffID)
+				if err != nil {
+					return err
+				}
+				deferred := policy.deferSize > 0 && diffSize > policy.deferSize
+				if !deferred {
+					if deferred, err = d.isParentCompactionDeferred(ctx, commitInfo); err != nil {
+						return err
+					}
+				}
+				// Compact the commit, unless it is large enough for the
+				// compaction to be deferred, in which case reads are served
+				// from the uncompacted file set until it is compacted.
 				taskDoer := d.env.TaskService.NewDoer(storageTaskNamespace, commit.ID, cache)
 				var totalId *fileset.ID
 				start := time.Now()
-				if err := miscutil.LogStep(fmt.Sprintf("compacting commit %v", commit), func() error {
-					var err error
-					totalId, err = compactor.Compact(ctx, taskDoer, []fileset.ID{*id}, defaultTTL)
-					if err != nil {
+				if deferred {
+					if err := miscutil.LogStep(fmt.Sprintf("deferring compaction of commit %v (%v bytes)", commit, diffSize), func() error {
+						return d.deferCompaction(ctx, commit, *id, diffSize)
+					}); err != nil {
 						return err
 					}
-					return errors.EnsureStack(d.commitStore.SetTotalFileSet(ctx, commit, *totalId))
-				}); err != nil {
-					return err
+					totalId = id
+					details.CompactionDeferred = true
+				} else {
+					if err := miscutil.LogStep(fmt.Sprintf("compacting commit %v", commit), func() error {
+						compactingCommitsMetric.Inc()
+						defer compactingCommitsMetric.Dec()
+						var err error
+						totalId, err = compactor.Compact(ctx, taskDoer, []fileset.ID{*id}, defaultTTL, policy.config)
+						if err != nil {
+							return err
+						}
+						return errors.EnsureStack(d.commitStore.SetTotalFileSet(ctx, commit, *totalId))
+					}); err != nil {
+						return err
+					}
+					compactionDurationMetric.WithLabelValues("finish").Observe(time.Since(start).Seconds())
 				}
 				details.CompactingTime = types.DurationProto(time.Since(start))
-				// Validate the commit.
+				// Validate the commit, unless its compaction was deferred, as
+				// validation reads the whole uncompacted file set. Deferred
+				// commits are validated when they are compacted.
 				start = time.Now()
 				var validationError string
-				if err := miscutil.LogStep(fmt.Sprintf("validating commit %v", commit), func() error {
+				if deferred {
+					if details.SizeBytes, err = d.storage.SizeUpperBound(ctx, *totalId); err != nil {
+						return err
+					}
+				} else if err := miscutil.LogStep(fmt.Sprintf("validating commit %v", commit), func() error {
 					var err error
 					details.SizeBytes, validationError, err = compactor.Validate(ctx, taskDoer, *totalId)
 					return err
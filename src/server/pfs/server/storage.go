@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// scrubStorage verifies the chunk objects, passing each finding to cb as it
+// is found. The files affected by the findings are then passed to cb while
+// the file sets of the finished commits are read, which can take a long time.
+func (d *driver) scrubStorage(ctx context.Context, req *pfs.ScrubStorageRequest, cb func(*pfs.ScrubStorageResponse) error) error {
+	chunkIDs := make(map[string]struct{})
+	n, err := d.storage.ChunkStorage().Scrub(ctx, &chunk.ScrubOptions{
+		Begin:          req.ChunkBegin,
+		End:            req.ChunkEnd,
+		Repair:         req.Repair,
+		BytesPerSecond: req.BytesPerSecond,
+	}, func(f *chunk.ScrubFinding) error {
+		finding := &pfs.ScrubStorageFinding{
+			ChunkId:  f.ChunkID,
+			Missing:  f.Missing,
+			Error:    f.Err.Error(),
+			Repaired: f.Repaired,
+		}
+		if f.RepairErr != nil {
+			finding.RepairError = f.RepairErr.Error()
+		}
+		chunkIDs[string(f.ChunkID)] = struct{}{}
+		return cb(&pfs.ScrubStorageResponse{Finding: finding})
+	})
+	if err != nil {
+		return err
+	}
+	if err := cb(&pfs.ScrubStorageResponse{ChunkObjectCount: int64(n)}); err != nil {
+		return err
+	}
+	if len(chunkIDs) == 0 {
+		return nil
+	}
+	return d.findChunkFiles(ctx, chunkIDs, cb)
+}
+
+// findChunkFiles passes the files in finished commits with content in the
+// chunks to cb. Commits whose file sets can't be read are also passed to cb.
+func (d *driver) findChunkFiles(ctx context.Context, chunkIDs map[string]struct{}, cb func(*pfs.ScrubStorageResponse) error) error {
+	commitInfo := &pfs.CommitInfo{}
+	return errors.EnsureStack(d.commits.ReadOnly(ctx).List(commitInfo, col.DefaultOptions(), func(string) error {
+		if commitInfo.Finished == nil || commitInfo.Error != "" {
+			return nil
+		}
+		commit := commitInfo.Commit
+		id, err := d.commitStore.GetTotalFileSet(ctx, commit)
+		if err != nil {
+			if errors.Is(err, errNoTotalFileSet) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		fs, err := d.storage.Open(ctx, []fileset.ID{*id})
+		if err != nil {
+			return cb(&pfs.ScrubStorageResponse{UnreadableCommit: commit})
+		}
+		var sendErr error
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			idx := f.Index()
+			seen := make(map[string]struct{})
+			for _, dataRef := range idx.File.DataRefs {
+				key := string(dataRef.Ref.Id)
+				if _, ok := chunkIDs[key]; !ok {
+					continue
+				}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				if err := cb(&pfs.ScrubStorageResponse{File: &pfs.ScrubStorageFile{
+					ChunkId: dataRef.Ref.Id,
+					File:    commit.NewFile(idx.Path),
+				}}); err != nil {
+					sendErr = err
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			if sendErr != nil || errors.Is(err, context.Canceled) {
+				return errors.EnsureStack(err)
+			}
+			return cb(&pfs.ScrubStorageResponse{UnreadableCommit: commit})
+		}
+		return nil
+	}))
+}
+
+func (d *driver) replicationStatus(ctx context.Context) (*pfs.ReplicationStatusResponse, error) {
+	statuses, err := d.storage.ChunkStorage().ReplicationStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pfs.ReplicationStatusResponse{
+		Failover: d.env.StorageConfig.StorageReplicaFailover,
+	}
+	for _, status := range statuses {
+		resp.Replicas = append(resp.Replicas, &pfs.ReplicaStatus{
+			Name:             status.Name,
+			ReplicatedChunks: status.ReplicatedChunks,
+			PendingChunks:    status.PendingChunks,
+			PendingBytes:     status.PendingBytes,
+			Lag:              types.DurationProto(status.Lag),
+		})
+	}
+	return resp, nil
+}
+
+func (d *driver) garbageCollectStorage(ctx context.Context, req *pfs.GarbageCollectStorageRequest) (*pfs.GarbageCollectStorageResponse, error) {
+	resp := &pfs.GarbageCollectStorageResponse{}
+	report, err := d.storage.ChunkStorage().GCReport(ctx, func(g *chunk.Garbage) error {
+		resp.Chunks = append(resp.Chunks, &pfs.GarbageChunk{
+			ChunkId:   g.ChunkID,
+			Gen:       g.Gen,
+			SizeBytes: g.Size,
+			Tombstone: g.Tombstone,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.ChunkCount = report.ChunkCount
+	resp.ChunkBytes = report.ChunkBytes
+	resp.TrackerObjectCount = report.TrackerObjects
+	resp.TrashCount = report.TrashCount
+	resp.TrashBytes = report.TrashBytes
+	resp.Paused = report.Paused
+	if req.DryRun {
+		return resp, nil
+	}
+	if report.Paused {
+		return nil, errors.New("garbage collection is paused")
+	}
+	// The tracker garbage collector marks the chunks it deletes with a
+	// tombstone, which the chunk garbage collector then deletes.
+	tgc := d.storage.NewGC(0)
+	for {
+		n, err := tgc.RunOnce(ctx)
+		resp.DeletedTrackerObjectCount += int64(n)
+		if err != nil {
+			if errors.Is(err, track.ErrGCPaused) {
+				return nil, errors.Wrapf(err, "after deleting %d tracker objects", resp.DeletedTrackerObjectCount)
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		if n == 0 {
+			break
+		}
+	}
+	gc := chunk.NewGC(d.storage.ChunkStorage(), 0, d.log, chunk.GCOptions(&d.env.StorageConfig)...)
+	n, err := gc.RunOnce(ctx)
+	if err != nil {
+		if errors.Is(err, track.ErrGCPaused) {
+			return nil, errors.Wrapf(err, "after deleting %d tracker objects and %d chunk objects", resp.DeletedTrackerObjectCount, n)
+		}
+		return nil, err
+	}
+	resp.DeletedChunkCount = int64(n)
+	return resp, nil
+}
+
+func (d *driver) setStorageGCPaused(ctx context.Context, paused bool) error {
+	return errors.EnsureStack(track.SetGCPaused(ctx, d.env.DB, paused))
+}
+
+func (d *driver) restoreStorageTrash(ctx context.Context, req *pfs.RestoreStorageTrashRequest) (*pfs.RestoreStorageTrashResponse, error) {
+	var chunkIDs []chunk.ID
+	for _, id := range req.ChunkIds {
+		chunkIDs = append(chunkIDs, chunk.ID(id))
+	}
+	var ttl time.Duration
+	if req.Ttl != nil {
+		var err error
+		if ttl, err = types.DurationFromProto(req.Ttl); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	count, size, err := d.storage.ChunkStorage().RestoreTrash(ctx, chunkIDs, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.RestoreStorageTrashResponse{RestoredCount: count, RestoredBytes: size}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -515,6 +516,66 @@ func TestPFS(suite *testing.T) {
 		require.NoError(t, err)
 	})
 
+	suite.Run("RepoChunking", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		db := env.ServiceEnv.GetDBClient()
+		countChunks := func() int {
+			var n int
+			require.NoError(t, db.Get(&n, "select count(*) from storage.chunk_objects"))
+			return n
+		}
+		rng := rand.New(rand.NewSource(0))
+		putFile := func(repo string) int {
+			data := make([]byte, 4*units.MiB)
+			_, err := rng.Read(data)
+			require.NoError(t, err)
+			before := countChunks()
+			require.NoError(t, env.PachClient.PutFile(client.NewCommit(repo, "master", ""), "file", bytes.NewReader(data)))
+			return countChunks() - before
+		}
+
+		spec := &pfs.ChunkingSpec{
+			Algorithm:        pfs.ChunkingSpec_FASTCDC,
+			AverageSizeBytes: 64 * units.KiB,
+			MinSizeBytes:     16 * units.KiB,
+			MaxSizeBytes:     256 * units.KiB,
+		}
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+			Repo:     client.NewRepo("custom"),
+			Chunking: spec,
+		})
+		require.NoError(t, err)
+		repoInfo, err := env.PachClient.InspectRepo("custom")
+		require.NoError(t, err)
+		require.True(t, proto.Equal(spec, repoInfo.Chunking))
+		require.NoError(t, env.PachClient.CreateRepo("default"))
+
+		// No chunk of the custom repo's file is larger than 256KiB, while the
+		// default chunking doesn't split the file below 1MB
+		require.True(t, putFile("custom") >= 16)
+		require.True(t, putFile("default") < 16)
+
+		// An empty spec resets the repo to the default chunking
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+			Repo:     client.NewRepo("custom"),
+			Chunking: &pfs.ChunkingSpec{},
+			Update:   true,
+		})
+		require.NoError(t, err)
+		repoInfo, err = env.PachClient.InspectRepo("custom")
+		require.NoError(t, err)
+		require.Nil(t, repoInfo.Chunking)
+		require.True(t, putFile("custom") < 16)
+
+		// Chunks can't be arbitrarily large
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(context.Background(), &pfs.CreateRepoRequest{
+			Repo:     client.NewRepo("huge"),
+			Chunking: &pfs.ChunkingSpec{MaxSizeBytes: units.GiB},
+		})
+		require.YesError(t, err)
+	})
+
 	suite.Run("ListRepo", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -882,6 +943,57 @@ func TestPFS(suite *testing.T) {
 		require.True(t, finished.Before(tFinished))
 	})
 
+	suite.Run("DeferredCompaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		// The window opens half a day from now, so the compaction stays
+		// deferred.
+		start := time.Now().UTC().Add(12 * time.Hour)
+		end := start.Add(time.Minute)
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo: client.NewRepo(repo),
+			Compaction: &pfs.CompactionSpec{
+				DeferSizeBytes: 1,
+				Window:         fmt.Sprintf("%02d:%02d-%02d:%02d", start.Hour(), start.Minute(), end.Hour(), end.Minute()),
+			},
+		})
+		require.NoError(t, err)
+		repoInfo, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), repoInfo.Compaction.DeferSizeBytes)
+
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		fileContent := "foo\n"
+		require.NoError(t, env.PachClient.PutFile(commit, "foo", strings.NewReader(fileContent)))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+
+		commitInfo, err := env.PachClient.WaitCommit(repo, commit.Branch.Name, commit.ID)
+		require.NoError(t, err)
+		require.True(t, commitInfo.Details.CompactionDeferred)
+		// Reads are served from the uncompacted file sets.
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit, "foo", &buf))
+		require.Equal(t, fileContent, buf.String())
+
+		info, err := env.PachClient.PfsAPIClient.InspectCommitCompaction(env.PachClient.Ctx(), &pfs.InspectCommitCompactionRequest{Commit: commit})
+		require.NoError(t, err)
+		require.True(t, info.Deferred)
+		require.True(t, len(info.Levels) > 0)
+
+		// Invalid and empty windows are rejected.
+		for _, window := range []string{"25:00-26:00", "02:00-02:00"} {
+			_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+				Repo:       client.NewRepo(repo),
+				Compaction: &pfs.CompactionSpec{Window: window},
+				Update:     true,
+			})
+			require.YesError(t, err, window)
+		}
+	})
+
 	suite.Run("InspectCommitWait", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -3507,6 +3619,70 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, expected.String(), output.String())
 	})
 
+	suite.Run("FileAttributes", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+
+		mtime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+		script := "#!/bin/sh\necho hi\n"
+		var in bytes.Buffer
+		tw := tar.NewWriter(&in)
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:    "model/run.sh",
+			Mode:    0755,
+			ModTime: mtime,
+			Size:    int64(len(script)),
+			PAXRecords: map[string]string{
+				"SCHILY.xattr.user.origin": "test",
+			},
+		}))
+		_, err := tw.Write([]byte(script))
+		require.NoError(t, err)
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     "latest",
+			Typeflag: tar.TypeSymlink,
+			Linkname: "model",
+			Mode:     0777,
+			ModTime:  mtime,
+		}))
+		require.NoError(t, tw.Close())
+		require.NoError(t, env.PachClient.PutFileTAR(commit, &in))
+		require.NoError(t, env.PachClient.PutFile(commit, "index.html", strings.NewReader("<html></html>"), client.WithAttributesPutFile(&pfs.FileAttributes{
+			ContentType: "text/html",
+		})))
+		require.YesError(t, env.PachClient.PutFile(commit, "bad", strings.NewReader(""), client.WithAttributesPutFile(&pfs.FileAttributes{
+			Mode: 0170000,
+		})))
+
+		fi, err := env.PachClient.InspectFile(commit, "model/run.sh")
+		require.NoError(t, err)
+		require.Equal(t, uint32(0755), fi.Attributes.Mode)
+		require.Equal(t, "test", fi.Attributes.Xattrs["user.origin"])
+		fi, err = env.PachClient.InspectFile(commit, "index.html")
+		require.NoError(t, err)
+		require.Equal(t, "text/html", fi.Attributes.ContentType)
+
+		rc, err := env.PachClient.GetFileTAR(commit, "**")
+		require.NoError(t, err)
+		defer rc.Close()
+		hdrs := make(map[string]*tar.Header)
+		tr := tar.NewReader(rc)
+		for hdr, err := tr.Next(); err != io.EOF; hdr, err = tr.Next() {
+			require.NoError(t, err)
+			hdrs[hdr.Name] = hdr
+		}
+		require.Equal(t, int64(0755), hdrs["/model/run.sh"].Mode)
+		require.True(t, mtime.Equal(hdrs["/model/run.sh"].ModTime))
+		require.Equal(t, "test", hdrs["/model/run.sh"].PAXRecords["SCHILY.xattr.user.origin"])
+		require.Equal(t, byte(tar.TypeSymlink), hdrs["/latest"].Typeflag)
+		require.Equal(t, "model", hdrs["/latest"].Linkname)
+		require.Equal(t, "text/html", hdrs["/index.html"].PAXRecords["SCHILY.xattr.user.mime_type"])
+	})
+
 	suite.Run("ApplyWriteOrder", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -4977,6 +5153,70 @@ func TestPFS(suite *testing.T) {
 		}
 	})
 
+	suite.Run("FaultyObjectStorage", func(t *testing.T) {
+		t.Parallel()
+		// The seed can be set with PACH_TEST_FAULT_SEED to reproduce a failure.
+		seed := int64(1)
+		if s := os.Getenv("PACH_TEST_FAULT_SEED"); s != "" {
+			var err error
+			seed, err = strconv.ParseInt(s, 10, 64)
+			require.NoError(t, err)
+		}
+		spec := fmt.Sprintf("seed=%d,error=0.05,latency=0-5ms,tail=0.01:100ms,truncate=0.02", seed)
+		t.Logf("storage faults: %s", spec)
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t), testpachd.WithStorageFaults(spec))
+		faultRetry := func(t *testing.T, f func() error) {
+			require.NoError(t, backoff.Retry(func() error {
+				err := f()
+				if err != nil {
+					require.True(t, obj.IsFaultError(err), "Expected fault error (%s), faults: %s", err.Error(), spec)
+				}
+				return err
+			}, backoff.NewTestingBackOff()), "faults: %s", spec)
+		}
+		repo := "input"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		var commit *pfs.Commit
+		written := make(map[string]string)
+		for i := 0; i < 10; i++ {
+			faultRetry(t, func() error {
+				var err error
+				commit, err = env.PachClient.StartCommit(repo, "master")
+				return err
+			})
+			for j := 0; j < 5; j++ {
+				file := fmt.Sprintf("/file-%d-%d", i, j)
+				data := random.String(100 * units.KB)
+				written[file] = data
+				faultRetry(t, func() error {
+					return env.PachClient.PutFile(commit, file, strings.NewReader(data))
+				})
+			}
+			faultRetry(t, func() error {
+				return finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID)
+			})
+		}
+		// Every file is readable, with its full content, after the commits
+		// finish.
+		var files []*pfs.FileInfo
+		faultRetry(t, func() error {
+			var err error
+			files, err = env.PachClient.ListFileAll(commit, "")
+			return err
+		})
+		require.Equal(t, len(written), len(files), spec)
+		for _, fi := range files {
+			buf := &bytes.Buffer{}
+			faultRetry(t, func() error {
+				buf.Reset()
+				return env.PachClient.GetFile(commit, fi.File.Path, buf)
+			})
+			data, ok := written[fi.File.Path]
+			require.True(t, ok, "unexpected file %s, faults: %s", fi.File.Path, spec)
+			require.Equal(t, data, buf.String(), "faults: %s", spec)
+		}
+	})
+
 	suite.Run("FsckFix", func(t *testing.T) {
 		// TODO(optional 2.0): force-deleting the repo no longer creates dangling references
 		t.Skip("this test no longer creates invalid metadata")
@@ -2,9 +2,12 @@ package testing
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -23,6 +26,78 @@ func TestCheckStorage(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+// TestScrubStorage checks that the ScrubStorage rpc is wired up correctly.
+// An more extensive test lives in the `chunk` package.
+func TestScrubStorage(t *testing.T) {
+	ctx := context.Background()
+	t.Parallel()
+	client := newClient(t)
+	scrubClient, err := client.ScrubStorage(ctx, &pfs.ScrubStorageRequest{})
+	require.NoError(t, err)
+	var findings int
+	require.NoError(t, clientsdk.ForEachScrubStorageResponse(scrubClient, func(resp *pfs.ScrubStorageResponse) error {
+		if resp.Finding != nil {
+			findings++
+		}
+		return nil
+	}))
+	require.Equal(t, 0, findings)
+}
+
+// TestScrubStorageFiles checks that bad chunk objects are mapped to the files
+// with content in them.
+func TestScrubStorageFiles(t *testing.T) {
+	ctx := context.Background()
+	t.Parallel()
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+	repo := "repo"
+	require.NoError(t, c.CreateRepo(repo))
+	commit1, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit1, "a", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(repo, "master", commit1.ID))
+	commit2, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit2, "b", strings.NewReader("bar")))
+	require.NoError(t, c.FinishCommit(repo, "master", commit2.ID))
+	_, err = c.WaitCommitSetAll(commit2.ID)
+	require.NoError(t, err)
+
+	// Remove every chunk object.
+	objC, err := obj.NewLocalClient(env.ServiceEnv.Config().StorageRoot)
+	require.NoError(t, err)
+	require.NoError(t, objC.Walk(ctx, "chunk/", func(name string) error {
+		return objC.Delete(ctx, name)
+	}))
+
+	scrubClient, err := c.PfsAPIClient.ScrubStorage(ctx, &pfs.ScrubStorageRequest{})
+	require.NoError(t, err)
+	findings := make(map[string]bool)
+	chunks := make(map[string]string)
+	require.NoError(t, clientsdk.ForEachScrubStorageResponse(scrubClient, func(resp *pfs.ScrubStorageResponse) error {
+		switch {
+		case resp.Finding != nil:
+			require.True(t, resp.Finding.Missing)
+			findings[string(resp.Finding.ChunkId)] = true
+		case resp.File != nil:
+			// The findings are sent before the files.
+			require.True(t, findings[string(resp.File.ChunkId)])
+			if resp.File.File.Commit.Branch.Repo.Name == repo {
+				chunks[resp.File.File.Commit.ID+resp.File.File.Path] = string(resp.File.ChunkId)
+			}
+		case resp.UnreadableCommit != nil:
+			t.Errorf("unexpected unreadable commit %v", resp.UnreadableCommit)
+		}
+		return nil
+	}))
+	require.Equal(t, 3, len(chunks))
+	// The file is in the same chunk in both commits, and the files written in
+	// different commits are in different chunks.
+	require.Equal(t, chunks[commit1.ID+"/a"], chunks[commit2.ID+"/a"])
+	require.NotEqual(t, chunks[commit1.ID+"/a"], chunks[commit2.ID+"/b"])
+}
+
 func newClient(t testing.TB) pfs.APIClient {
 	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
 	return env.PachClient.PfsAPIClient
@@ -48,7 +48,7 @@ func (a *validatedAPIServer) FinishCommitInTransaction(txnCtx *txncontext.Transa
 	if userCommit.Branch.Repo == nil {
 		return errors.New("commit repo cannot be nil")
 	}
-	if err := a.auth.CheckRepoIsAuthorizedInTransaction(txnCtx, userCommit.Branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+	if err := a.auth.CheckBranchIsAuthorizedInTransaction(txnCtx, userCommit.Branch, auth.Permission_REPO_WRITE); err != nil {
 		return errors.EnsureStack(err)
 	}
 	return a.apiServer.FinishCommitInTransaction(txnCtx, request)
@@ -59,7 +59,7 @@ func (a *validatedAPIServer) InspectFile(ctx context.Context, request *pfs.Inspe
 	if err := validateFile(request.File); err != nil {
 		return nil, err
 	}
-	if err := a.auth.CheckRepoIsAuthorized(ctx, request.File.Commit.Branch.Repo, auth.Permission_REPO_INSPECT_FILE); err != nil {
+	if err := a.auth.CheckBranchIsAuthorized(ctx, request.File.Commit.Branch, auth.Permission_REPO_INSPECT_FILE); err != nil {
 		return nil, errors.EnsureStack(err)
 	}
 	return a.apiServer.InspectFile(ctx, request)
@@ -70,7 +70,7 @@ func (a *validatedAPIServer) ListFile(request *pfs.ListFileRequest, server pfs.A
 	if err := validateFile(request.File); err != nil {
 		return err
 	}
-	if err := a.auth.CheckRepoIsAuthorized(server.Context(), request.File.Commit.Branch.Repo, auth.Permission_REPO_LIST_FILE); err != nil {
+	if err := a.auth.CheckBranchIsAuthorized(server.Context(), request.File.Commit.Branch, auth.Permission_REPO_LIST_FILE); err != nil {
 		return errors.EnsureStack(err)
 	}
 	return a.apiServer.ListFile(request, server)
@@ -92,7 +92,7 @@ func (a *validatedAPIServer) WalkFile(request *pfs.WalkFileRequest, server pfs.A
 	if file.Commit.Branch.Repo == nil {
 		return errors.New("file commit repo cannot be nil")
 	}
-	if err := a.auth.CheckRepoIsAuthorized(server.Context(), file.Commit.Branch.Repo, auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
+	if err := a.auth.CheckBranchIsAuthorized(server.Context(), file.Commit.Branch, auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
 		return errors.EnsureStack(err)
 	}
 	return a.apiServer.WalkFile(request, server)
@@ -111,7 +111,7 @@ func (a *validatedAPIServer) GlobFile(request *pfs.GlobFileRequest, server pfs.A
 	if commit.Branch.Repo == nil {
 		return errors.New("commit repo cannot be nil")
 	}
-	if err := a.auth.CheckRepoIsAuthorized(server.Context(), commit.Branch.Repo, auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
+	if err := a.auth.CheckBranchIsAuthorized(server.Context(), commit.Branch, auth.Permission_REPO_READ, auth.Permission_REPO_LIST_FILE); err != nil {
 		return errors.EnsureStack(err)
 	}
 	return a.apiServer.GlobFile(request, server)
@@ -121,7 +121,7 @@ func (a *validatedAPIServer) ClearCommit(ctx context.Context, req *pfs.ClearComm
 	if req.Commit == nil {
 		return nil, errors.Errorf("commit cannot be nil")
 	}
-	if err := a.auth.CheckRepoIsAuthorized(ctx, req.Commit.Branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+	if err := a.auth.CheckBranchIsAuthorized(ctx, req.Commit.Branch, auth.Permission_REPO_WRITE); err != nil {
 		return nil, errors.EnsureStack(err)
 	}
 	return a.apiServer.ClearCommit(ctx, req)
@@ -187,6 +187,37 @@ func (a *validatedAPIServer) CreateBranchInTransaction(txnCtx *txncontext.Transa
 	return a.apiServer.CreateBranchInTransaction(txnCtx, request)
 }
 
+// GetFileSet implements the protobuf pfs.GetFileSet RPC
+func (a *validatedAPIServer) GetFileSet(ctx context.Context, request *pfs.GetFileSetRequest) (*pfs.CreateFileSetResponse, error) {
+	if request.Commit == nil {
+		return nil, errors.New("commit cannot be nil")
+	}
+	if request.Commit.Branch == nil || request.Commit.Branch.Repo == nil {
+		return nil, errors.New("commit repo cannot be nil")
+	}
+	if err := a.auth.CheckBranchIsAuthorized(ctx, request.Commit.Branch, auth.Permission_REPO_READ); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	// The fileset holds every file in the commit, so it can't be returned to
+	// callers who can only read some of the paths in the repo.
+	canRead, err := a.auth.AuthorizedPathFilter(ctx, request.Commit.Branch.Repo, auth.Permission_REPO_READ)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if canRead != nil {
+		me, err := a.auth.WhoAmI(ctx, &auth.WhoAmIRequest{})
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return nil, &auth.ErrNotAuthorized{
+			Subject:  me.Username,
+			Resource: auth.Resource{Type: auth.ResourceType_REPO, Name: request.Commit.Branch.Repo.Name},
+			Required: []auth.Permission{auth.Permission_REPO_READ},
+		}
+	}
+	return a.apiServer.GetFileSet(ctx, request)
+}
+
 func (a *validatedAPIServer) Egress(ctx context.Context, request *pfs.EgressRequest) (*pfs.EgressResponse, error) {
 	err := pfsserver.ValidateSQLDatabaseEgress(request.GetSqlDatabase())
 	if err != nil {
@@ -521,6 +521,36 @@ each datum.`,
 	inspectDatum.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectDatum, "inspect datum"))
 
+	explainDatum := &cobra.Command{
+		Use:   "{{alias}} <pipeline>@<job> <datum>",
+		Short: "Explain why a datum was processed or skipped.",
+		Long:  "Explain why a datum was processed or skipped by a job. Shows the datum's input file hashes, the datum hash, whether a matching datum exists in the parent job and the reason for the decision.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			job, err := cmdutil.ParseJob(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			explanation, err := client.ExplainDatum(job.Pipeline.Name, job.ID, args[1])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(explanation))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			pretty.PrintDatumExplanation(os.Stdout, explanation)
+			return nil
+		}),
+	}
+	explainDatum.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(explainDatum, "explain datum"))
+
 	var (
 		jobStr      string
 		datumID     string
@@ -714,19 +744,30 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
+	var cronAt string
 	runCron := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Run an existing Pachyderm cron pipeline now",
-		Long:  "Run an existing Pachyderm cron pipeline now",
+		Long:  "Run an existing Pachyderm cron pipeline now, or make the tick for a specific time with --at.",
 		Example: `
 		# Run a cron pipeline "clock" now
-		$ {{alias}} clock`,
+		$ {{alias}} clock
+
+		# Make the tick for midnight UTC on January 1st 2021 in the cron pipeline "clock"
+		$ {{alias}} clock --at 2021-01-01T00:00:00Z`,
 		Run: cmdutil.RunMinimumArgs(1, func(args []string) (retErr error) {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
+			if cronAt != "" {
+				at, err := time.Parse(time.RFC3339, cronAt)
+				if err != nil {
+					return errors.Wrapf(err, "could not parse --at")
+				}
+				return client.RunCronAt(args[0], at)
+			}
 			err = client.RunCron(args[0])
 			if err != nil {
 				return err
@@ -734,8 +775,30 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			return nil
 		}),
 	}
+	runCron.Flags().StringVar(&cronAt, "at", "", "Make the tick for this past time (in RFC 3339 format, e.g. 2021-01-01T00:00:00Z) instead of the current time. The existing ticks are kept.")
 	commands = append(commands, cmdutil.CreateAlias(runCron, "run cron"))
 
+	replayDeadLetter := &cobra.Command{
+		Use:   "{{alias}} <pipeline> [<datum>...]",
+		Short: "Replay datums quarantined by a dead-letter pipeline.",
+		Long:  "Release datums from a pipeline's dead-letter branch and start a new job to reprocess them. If no datums are given, every quarantined datum is replayed.",
+		Example: `
+		# Replay every datum quarantined by pipeline "edges"
+		$ {{alias}} edges
+
+		# Replay two datums quarantined by pipeline "edges"
+		$ {{alias}} edges 3a1f8e9c... 7b2d4c0e...`,
+		Run: cmdutil.RunMinimumArgs(1, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.ReplayDeadLetter(args[0], args[1:])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(replayDeadLetter, "replay deadletter"))
+
 	inspectPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Return info about a pipeline.",
@@ -955,10 +1018,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if len(args) > 0 {
 				req.Pipeline = pachdclient.NewPipeline(args[0])
 			}
-			if _, err = client.PpsAPIClient.DeletePipeline(client.Ctx(), req); err != nil {
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				_, err := txClient.PpsAPIClient.DeletePipeline(txClient.Ctx(), req)
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	deletePipeline.Flags().BoolVar(&all, "all", false, "delete all pipelines")
@@ -976,10 +1039,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StartPipeline(args[0]); err != nil {
-				return errors.Wrap(err, "error from StartPipeline")
-			}
-			return nil
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				if err := txClient.StartPipeline(args[0]); err != nil {
+					return errors.Wrap(err, "error from StartPipeline")
+				}
+				return nil
+			})
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAlias(startPipeline, "start pipeline"))
@@ -994,10 +1059,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StopPipeline(args[0]); err != nil {
-				return errors.Wrap(err, "error from StopPipeline")
-			}
-			return nil
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				if err := txClient.StopPipeline(args[0]); err != nil {
+					return errors.Wrap(err, "error from StopPipeline")
+				}
+				return nil
+			})
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAlias(stopPipeline, "stop pipeline"))
@@ -1017,16 +1084,14 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return errors.EnsureStack(err)
 			}
 
-			_, err = client.PpsAPIClient.CreateSecret(
-				client.Ctx(),
-				&ppsclient.CreateSecretRequest{
-					File: fileBytes,
-				})
-
-			if err != nil {
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				_, err := txClient.PpsAPIClient.CreateSecret(
+					txClient.Ctx(),
+					&ppsclient.CreateSecretRequest{
+						File: fileBytes,
+					})
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	createSecret.Flags().StringVarP(&file, "file", "f", "", "File containing Kubernetes secret.")
@@ -1058,9 +1123,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(deleteSecret, "delete secret"))
 
+	var secretPipeline string
 	inspectSecret := &cobra.Command{
 		Short: "Inspect a secret from the cluster.",
-		Long:  "Inspect a secret from the cluster.",
+		Long:  "Inspect a secret from the cluster. With --pipeline, the secret is inspected on behalf of a pipeline that uses it, which only requires permission to read that pipeline's secrets.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
@@ -1068,13 +1134,15 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			}
 			defer client.Close()
 
-			secretInfo, err := client.PpsAPIClient.InspectSecret(
-				client.Ctx(),
-				&ppsclient.InspectSecretRequest{
-					Secret: &ppsclient.Secret{
-						Name: args[0],
-					},
-				})
+			request := &ppsclient.InspectSecretRequest{
+				Secret: &ppsclient.Secret{
+					Name: args[0],
+				},
+			}
+			if secretPipeline != "" {
+				request.Pipeline = pachdclient.NewPipeline(secretPipeline)
+			}
+			secretInfo, err := client.PpsAPIClient.InspectSecret(client.Ctx(), request)
 
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -1084,6 +1152,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			return writer.Flush()
 		}),
 	}
+	inspectSecret.Flags().StringVar(&secretPipeline, "pipeline", "", "Inspect the secret as used by this pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(inspectSecret, "inspect secret"))
 
 	listSecret := &cobra.Command{
@@ -19,4 +19,8 @@ type APIServer interface {
 	UpdateJobStateInTransaction(*txncontext.TransactionContext, *pps_client.UpdateJobStateRequest) error
 	CreatePipelineInTransaction(*txncontext.TransactionContext, *pps_client.CreatePipelineRequest) error
 	InspectPipelineInTransaction(*txncontext.TransactionContext, string) (*pps_client.PipelineInfo, error)
+	DeletePipelineInTransaction(*txncontext.TransactionContext, *pps_client.DeletePipelineRequest) error
+	StopPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StopPipelineRequest) error
+	StartPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StartPipelineRequest) error
+	CreateSecretInTransaction(*txncontext.TransactionContext, *pps_client.CreateSecretRequest) error
 }
@@ -7,6 +7,7 @@ import (
 	"io"
 	"strings"
 	"text/template"
+	"time"
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
@@ -29,7 +30,7 @@ const (
 	// JobSetHeader is the header for jobsets
 	JobSetHeader = "ID\tSUBJOBS\tPROGRESS\tCREATED\tMODIFIED\n"
 	// DatumHeader is the header for datums
-	DatumHeader = "ID\tFILES\tSTATUS\tTIME\t\n"
+	DatumHeader = "ID\tFILES\tSTATUS\tTIME\tCPU\tPEAK MEMORY\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
 	// jobReasonLen is the amount of the job reason that we print
@@ -206,7 +207,11 @@ Data Uploaded: {{prettySize .Stats.UploadBytes}}
 Download Time: {{prettyDuration .Stats.DownloadTime}}
 Process Time: {{prettyDuration .Stats.ProcessTime}}
 Upload Time: {{prettyDuration .Stats.UploadTime}}
-Datum Timeout: {{.Details.DatumTimeout}}
+CPU Time: {{prettyDuration .Stats.CpuTime}}
+Peak Memory: {{prettySize .Stats.PeakMemoryBytes}}
+Peak Scratch Space: {{prettySize .Stats.ScratchBytes}}
+{{if .DatumStats}}Datum Stats:
+{{datumStats .DatumStats}}{{end}}Datum Timeout: {{.Details.DatumTimeout}}
 Job Timeout: {{.Details.JobTimeout}}
 Worker Status:
 {{workerStatus .}}Restarts: {{.Restart}}
@@ -300,7 +305,16 @@ func PrintDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	if datumInfo.Datum.ID == "" {
 		datumInfo.Datum.ID = "-"
 	}
-	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t", datumInfo.Datum.ID, datumFiles(datumInfo), datumState(datumInfo.State), totalTime)
+	cpuTime, peakMemory := "-", "-"
+	if datumInfo.Stats != nil {
+		if datumInfo.Stats.CpuTime != nil {
+			cpuTime = pretty.Duration(datumInfo.Stats.CpuTime)
+		}
+		if datumInfo.Stats.PeakMemoryBytes > 0 {
+			peakMemory = pretty.Size(datumInfo.Stats.PeakMemoryBytes)
+		}
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t", datumInfo.Datum.ID, datumFiles(datumInfo), datumState(datumInfo.State), totalTime, cpuTime, peakMemory)
 	fmt.Fprintln(w)
 }
 
@@ -352,6 +366,9 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 		uploadTime = ul.String()
 	}
 	fmt.Fprintf(w, "Upload Time\t%s\n", uploadTime)
+	fmt.Fprintf(w, "CPU Time\t%s\n", pretty.Duration(datumInfo.Stats.CpuTime))
+	fmt.Fprintf(w, "Peak Memory\t%s\n", pretty.Size(datumInfo.Stats.PeakMemoryBytes))
+	fmt.Fprintf(w, "Peak Scratch Space\t%s\n", pretty.Size(datumInfo.Stats.ScratchBytes))
 
 	fmt.Fprintf(w, "PFS State:\n")
 	tw := ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
@@ -367,6 +384,35 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	tw.Flush()
 }
 
+// PrintDatumExplanation pretty-prints why a datum was processed or skipped.
+func PrintDatumExplanation(w io.Writer, explanation *ppsclient.ExplainDatumResponse) {
+	tw := ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
+	fmt.Fprintf(tw, "ID\t%s\n", explanation.Datum.ID)
+	fmt.Fprintf(tw, "Job ID\t%s\n", explanation.Datum.Job.ID)
+	fmt.Fprintf(tw, "State\t%s\n", explanation.State)
+	fmt.Fprintf(tw, "Hash\t%s\n", explanation.Hash)
+	fmt.Fprintf(tw, "Salt\t%s\n", explanation.Salt)
+	if explanation.ParentJob != nil {
+		fmt.Fprintf(tw, "Parent Job ID\t%s\n", explanation.ParentJob.ID)
+		fmt.Fprintf(tw, "Parent Salt\t%s\n", explanation.ParentSalt)
+		if explanation.ParentMatch {
+			fmt.Fprintf(tw, "Parent Hash\t%s\n", explanation.ParentHash)
+			fmt.Fprintf(tw, "Parent State\t%s\n", explanation.ParentState)
+		} else {
+			fmt.Fprintf(tw, "Parent Hash\t-\n")
+		}
+	} else {
+		fmt.Fprintf(tw, "Parent Job ID\t-\n")
+	}
+	fmt.Fprintf(tw, "Reason\t%s\n", explanation.Reason)
+	fmt.Fprintf(tw, "Inputs:\n")
+	fmt.Fprintf(tw, "  REPO\tCOMMIT\tPATH\tHASH\t\n")
+	for _, d := range explanation.Data {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%x\t\n", d.File.Commit.Branch.Repo, d.File.Commit.ID, d.File.Path, d.Hash)
+	}
+	tw.Flush()
+}
+
 // PrintSecretInfo pretty-prints secret info.
 func PrintSecretInfo(w io.Writer, secretInfo *ppsclient.SecretInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t\n", secretInfo.Secret.Name, secretInfo.Type, pretty.Ago(secretInfo.CreationTimestamp))
@@ -471,6 +517,41 @@ func workerStatus(pji PrintableJobInfo) string {
 	return buffer.String()
 }
 
+// datumStats returns a table of the distribution of the per-datum process
+// stats of a job
+func datumStats(stats *ppsclient.AggregateProcessStats) string {
+	seconds := func(x float64) string {
+		return time.Duration(x * float64(time.Second)).Round(time.Millisecond).String()
+	}
+	size := func(x float64) string {
+		return pretty.Size(int64(x))
+	}
+	var buffer bytes.Buffer
+	writer := ansiterm.NewTabWriter(&buffer, 20, 1, 3, ' ', 0)
+	fmt.Fprintf(writer, "  \tMEAN\tSTDDEV\t5TH %%ILE\t95TH %%ILE\t\n")
+	for _, row := range []struct {
+		name   string
+		agg    *ppsclient.Aggregate
+		format func(float64) string
+	}{
+		{"Download Time", stats.DownloadTime, seconds},
+		{"Process Time", stats.ProcessTime, seconds},
+		{"Upload Time", stats.UploadTime, seconds},
+		{"CPU Time", stats.CpuTime, seconds},
+		{"Peak Memory", stats.PeakMemoryBytes, size},
+		{"Peak Scratch Space", stats.ScratchBytes, size},
+	} {
+		if row.agg == nil {
+			continue
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t\n", row.name, row.format(row.agg.Mean),
+			row.format(row.agg.Stddev), row.format(row.agg.FifthPercentile), row.format(row.agg.NinetyFifthPercentile))
+	}
+	// can't error because buffer can't error on Write
+	writer.Flush()
+	return buffer.String()
+}
+
 func pipelineInput(pipelineInfo *ppsclient.PipelineInfo) string {
 	if pipelineInfo.Details.Input == nil {
 		return ""
@@ -523,6 +604,8 @@ func ShorthandInput(input *ppsclient.Input) string {
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
 	case input.Cron != nil:
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
+	case input.Object != nil:
+		return fmt.Sprintf("%s:%s", input.Object.Name, input.Object.URL)
 	}
 	return ""
 }
@@ -544,6 +627,7 @@ var funcMap = template.FuncMap{
 	"jobState":             JobState,
 	"datumState":           datumState,
 	"workerStatus":         workerStatus,
+	"datumStats":           datumStats,
 	"pipelineInput":        pipelineInput,
 	"jobInput":             jobInput,
 	"prettyAgo":            pretty.Ago,
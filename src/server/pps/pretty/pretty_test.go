@@ -151,3 +151,49 @@ func TestPipelineEgressURL(t *testing.T) {
 		}
 	}
 }
+
+func TestJobDatumStats(t *testing.T) {
+	buf := new(bytes.Buffer)
+	ji := &ppsclient.JobInfo{
+		Job:   &ppsclient.Job{ID: "foo", Pipeline: &ppsclient.Pipeline{Name: "bar"}},
+		Stats: &ppsclient.ProcessStats{},
+		DatumStats: &ppsclient.AggregateProcessStats{
+			CpuTime:         &ppsclient.Aggregate{Count: 2, Mean: 1.5, Stddev: 0.5, FifthPercentile: 1, NinetyFifthPercentile: 2},
+			PeakMemoryBytes: &ppsclient.Aggregate{Count: 2, Mean: 3 << 20, FifthPercentile: 2 << 20, NinetyFifthPercentile: 4 << 20},
+		},
+		Details: &ppsclient.JobInfo_Details{},
+	}
+	if err := pretty.PrintDetailedJobInfo(buf, pretty.NewPrintableJobInfo(ji)); err != nil {
+		t.Fatal(err)
+	}
+	s := buf.String()
+	for _, value := range []string{"Datum Stats:", "CPU Time", "1.5s", "Peak Memory", "3MiB", "4MiB"} {
+		if !strings.Contains(s, value) {
+			t.Errorf("could not find %q in detailed job info:\n%s", value, s)
+		}
+	}
+}
+
+func TestDatumExplanationAligned(t *testing.T) {
+	buf := new(bytes.Buffer)
+	pretty.PrintDatumExplanation(buf, &ppsclient.ExplainDatumResponse{
+		Datum:  &ppsclient.Datum{ID: "datum", Job: &ppsclient.Job{ID: "job"}},
+		Hash:   "hash",
+		Reason: "no matching datum in the parent job",
+	})
+	// every value starts in the same column
+	var column int
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if line == "Inputs:" {
+			break
+		}
+		i := strings.LastIndex(line, "  ") + 2
+		if column == 0 {
+			column = i
+		}
+		if i != column {
+			t.Errorf("misaligned explanation:\n%s", buf.String())
+			break
+		}
+	}
+}
@@ -21,8 +21,10 @@ import (
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -35,7 +37,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	middleware_auth "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachtmpl"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
@@ -124,6 +129,11 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 			return errors.Errorf(`name "%s" was used more than once`, input.Cron.Name)
 		}
 		names[input.Cron.Name] = true
+	case input.Object != nil:
+		if names[input.Object.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.Object.Name)
+		}
+		names[input.Object.Name] = true
 	case input.Union != nil:
 		for _, input := range input.Union {
 			namesCopy := make(map[string]bool)
@@ -248,6 +258,27 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 			if _, err := cron.ParseStandard(input.Cron.Spec); err != nil {
 				return errors.Wrapf(err, "error parsing cron-spec")
 			}
+			if _, err := time.LoadLocation(input.Cron.Timezone); err != nil {
+				return errors.Wrapf(err, "error parsing cron timezone")
+			}
+			if input.Cron.BackfillLimit < 0 {
+				return errors.Errorf("cron backfill_limit cannot be negative")
+			}
+		}
+		if input.Object != nil {
+			if set {
+				return errors.Errorf("multiple input types set")
+			}
+			set = true
+			if len(input.Object.Name) == 0 {
+				return errors.Errorf("input must specify a name")
+			}
+			if _, err := obj.ParseURL(input.Object.URL); err != nil {
+				return errors.Wrapf(err, "error parsing object input url")
+			}
+			if _, err := cron.ParseStandard(input.Object.Spec); err != nil {
+				return errors.Wrapf(err, "error parsing cron-spec")
+			}
 		}
 		if !set {
 			return errors.Errorf("no input set")
@@ -355,6 +386,9 @@ const (
 	pipelineOpDelete
 	// pipelineOpStartStop is required for StartPipeline and StopPipeline
 	pipelineOpStartStop
+	// pipelineOpRunDatum is required for RestartDatum, RunCron and
+	// ReplayDeadLetter
+	pipelineOpRunDatum
 )
 
 // authorizePipelineOp checks if the user indicated by 'ctx' is authorized
@@ -374,7 +408,10 @@ func (a *apiServer) authorizePipelineOpInTransaction(txnCtx *txncontext.Transact
 		return err
 	}
 
-	if input != nil && operation != pipelineOpDelete && operation != pipelineOpStartStop {
+	// Operations that don't change or expose what the pipeline reads don't
+	// require access to its inputs. Logs can contain input data, so reading
+	// them does.
+	if input != nil && (operation == pipelineOpCreate || operation == pipelineOpUpdate || operation == pipelineOpListDatum || operation == pipelineOpGetLogs) {
 		// Check that the user is authorized to read all input repos, and write to the
 		// output repo (which the pipeline needs to be able to do on the user's
 		// behalf)
@@ -405,10 +442,21 @@ func (a *apiServer) authorizePipelineOpInTransaction(txnCtx *txncontext.Transact
 		case pipelineOpCreate:
 			// no permissions needed, we will error later if the repo already exists
 			return nil
-		case pipelineOpListDatum, pipelineOpGetLogs:
+		case pipelineOpListDatum:
 			required = auth.Permission_REPO_READ
-		case pipelineOpUpdate, pipelineOpStartStop:
-			required = auth.Permission_REPO_WRITE
+		case pipelineOpUpdate:
+			// the caller must be able to write the output repo, since the
+			// updated pipeline will write it on their behalf
+			if err := a.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, &pfs.Repo{Type: pfs.UserRepoType, Name: output}, auth.Permission_REPO_WRITE); err != nil {
+				return errors.EnsureStack(err)
+			}
+			return errors.EnsureStack(a.env.AuthServer.CheckPipelineIsAuthorizedInTransaction(txnCtx, output, auth.Permission_PIPELINE_UPDATE_SPEC))
+		case pipelineOpGetLogs:
+			return errors.EnsureStack(a.env.AuthServer.CheckPipelineIsAuthorizedInTransaction(txnCtx, output, auth.Permission_PIPELINE_READ_LOGS))
+		case pipelineOpStartStop:
+			return errors.EnsureStack(a.env.AuthServer.CheckPipelineIsAuthorizedInTransaction(txnCtx, output, auth.Permission_PIPELINE_START_STOP))
+		case pipelineOpRunDatum:
+			return errors.EnsureStack(a.env.AuthServer.CheckPipelineIsAuthorizedInTransaction(txnCtx, output, auth.Permission_PIPELINE_RUN_DATUM))
 		case pipelineOpDelete:
 			if _, err := a.env.PFSServer.InspectRepoInTransaction(txnCtx, &pfs.InspectRepoRequest{
 				Repo: client.NewRepo(output),
@@ -428,6 +476,36 @@ func (a *apiServer) authorizePipelineOpInTransaction(txnCtx *txncontext.Transact
 	return nil
 }
 
+// pipelineUserContext returns a copy of 'ctx' that is authenticated as the
+// pipeline 'name' itself. Operations that the pipeline would otherwise perform
+// on its own repos (such as resetting its branch provenance) run as the
+// pipeline, so that the caller only needs the corresponding pipeline
+// permission and not write access to the pipeline's data. If the pipeline has
+// no auth token (because auth isn't active), 'ctx' is returned unchanged.
+func (a *apiServer) pipelineUserContext(ctx context.Context, name string) (context.Context, error) {
+	var token string
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		key, err := ppsutil.FindPipelineSpecCommitInTransaction(txnCtx, a.env.PFSServer, name, "")
+		if err != nil {
+			return errors.Wrapf(err, "couldn't find up to date spec for pipeline %q", name)
+		}
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(key, pipelineInfo); err != nil {
+			return errors.EnsureStack(err)
+		}
+		token = pipelineInfo.AuthToken
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return ctx, nil
+	}
+	// clear the cached WhoAmI result so that the pipeline's token is used
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(auth.ContextTokenKey, token))
+	return middleware_auth.ClearWhoAmI(ctx), nil
+}
+
 func (a *apiServer) UpdateJobState(ctx context.Context, request *pps.UpdateJobStateRequest) (response *types.Empty, retErr error) {
 	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
 		return errors.EnsureStack(txn.UpdateJobState(request))
@@ -812,6 +890,7 @@ func (a *apiServer) getJobDetails(ctx context.Context, jobInfo *pps.JobInfo) err
 	details.DatumTimeout = pipelineInfo.Details.DatumTimeout
 	details.JobTimeout = pipelineInfo.Details.JobTimeout
 	details.DatumTries = pipelineInfo.Details.DatumTries
+	details.DeadLetter = pipelineInfo.Details.DeadLetter
 	details.SchedulingSpec = pipelineInfo.Details.SchedulingSpec
 	details.PodSpec = pipelineInfo.Details.PodSpec
 	details.PodPatch = pipelineInfo.Details.PodPatch
@@ -989,6 +1068,9 @@ func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumR
 	if err != nil {
 		return nil, err
 	}
+	if err := a.env.AuthServer.CheckPipelineIsAuthorized(ctx, jobInfo.Job.Pipeline.Name, auth.Permission_PIPELINE_RUN_DATUM); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
 	workerPoolID := ppsutil.PipelineRcName(jobInfo.Job.Pipeline.Name, jobInfo.PipelineVersion)
 	if err := workerserver.Cancel(ctx, workerPoolID, a.env.EtcdClient, a.etcdPrefix, a.workerGrpcPort, request.Job.ID, request.DataFilters); err != nil {
 		return nil, err
@@ -1019,6 +1101,154 @@ func (a *apiServer) InspectDatum(ctx context.Context, request *pps.InspectDatumR
 	return response, nil
 }
 
+// ExplainDatum implements the protobuf pps.ExplainDatum RPC
+func (a *apiServer) ExplainDatum(ctx context.Context, request *pps.ExplainDatumRequest) (response *pps.ExplainDatumResponse, retErr error) {
+	if request.Datum == nil || request.Datum.ID == "" {
+		return nil, errors.New("must specify a datum")
+	}
+	if request.Datum.Job == nil {
+		return nil, errors.New("must specify a job")
+	}
+	jobInfo, err := a.InspectJob(ctx, &pps.InspectJobRequest{
+		Job:     request.Datum.Job,
+		Details: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	metaCommit := ppsutil.MetaCommit(jobInfo.OutputCommit)
+	meta, err := findDatumMeta(pachClient, metaCommit, request.Datum.ID)
+	if err != nil {
+		return nil, err
+	}
+	if meta == nil {
+		return nil, errors.Errorf("datum %s not found in job %s", request.Datum.ID, request.Datum.Job)
+	}
+	di := convertDatumMetaToInfo(meta, jobInfo.Job)
+	response = &pps.ExplainDatumResponse{
+		Datum: &pps.Datum{Job: jobInfo.Job, ID: request.Datum.ID},
+		State: di.State,
+		Data:  di.Data,
+		Hash:  meta.Hash,
+		Salt:  jobInfo.Details.Salt,
+	}
+	pipelineInfo := &pps.PipelineInfo{}
+	if err := a.pipelines.ReadOnly(ctx).GetUniqueByIndex(
+		ppsdb.PipelinesVersionIndex,
+		ppsdb.VersionKey(jobInfo.Job.Pipeline.Name, jobInfo.PipelineVersion),
+		pipelineInfo); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	// Find the base job the same way the worker does: the most recent
+	// ancestor whose meta and output commits both succeeded.
+	baseMetaCommit, err := findBaseMetaCommit(pachClient, metaCommit)
+	if err != nil {
+		return nil, err
+	}
+	var parentMeta *datum.Meta
+	if baseMetaCommit != nil {
+		response.ParentJob = client.NewJob(jobInfo.Job.Pipeline.Name, baseMetaCommit.ID)
+		parentJobInfo, err := a.InspectJob(ctx, &pps.InspectJobRequest{
+			Job:     response.ParentJob,
+			Details: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.ParentSalt = parentJobInfo.Details.Salt
+		parentMeta, err = findDatumMeta(pachClient, baseMetaCommit, request.Datum.ID)
+		if err != nil {
+			return nil, err
+		}
+		if parentMeta != nil {
+			response.ParentMatch = true
+			response.ParentHash = parentMeta.Hash
+			response.ParentState = convertDatumMetaToInfo(parentMeta, response.ParentJob).State
+		}
+	}
+	response.Reason = explainDatum(response, pipelineInfo, meta, parentMeta)
+	return response, nil
+}
+
+// explainDatum returns the reason a datum was processed or skipped. It mirrors
+// the skipping logic in the worker's transform package.
+func explainDatum(explanation *pps.ExplainDatumResponse, pipelineInfo *pps.PipelineInfo, meta, parentMeta *datum.Meta) string {
+	if explanation.State == pps.DatumState_SKIPPED {
+		if meta.State == datum.State_FAILED {
+			return fmt.Sprintf("skipped: the datum failed in job %s and is quarantined in the dead-letter branch", meta.Job.ID)
+		}
+		return fmt.Sprintf("skipped: job %s already processed a datum with the same hash", meta.Job.ID)
+	}
+	switch {
+	case pipelineInfo.Details.S3Out:
+		return "processed: pipelines with s3_out reprocess every datum"
+	case pipelineInfo.Details.ReprocessSpec == client.ReprocessSpecEveryJob:
+		return fmt.Sprintf("processed: reprocess_spec is %q", client.ReprocessSpecEveryJob)
+	case explanation.ParentJob == nil:
+		return "processed: the job has no successful parent job"
+	case parentMeta == nil:
+		return fmt.Sprintf("processed: no datum with the same input files exists in parent job %s", explanation.ParentJob.ID)
+	case parentMeta.Hash != meta.Hash:
+		if explanation.Salt != explanation.ParentSalt {
+			return fmt.Sprintf("processed: the pipeline salt changed since parent job %s (the pipeline was updated with reprocessing)", explanation.ParentJob.ID)
+		}
+		parentHashes := make(map[string][]byte)
+		for _, input := range parentMeta.Inputs {
+			parentHashes[input.FileInfo.File.Path] = input.FileInfo.Hash
+		}
+		var changed []string
+		for _, input := range meta.Inputs {
+			if !bytes.Equal(parentHashes[input.FileInfo.File.Path], input.FileInfo.Hash) {
+				changed = append(changed, input.FileInfo.File.Path)
+			}
+		}
+		return fmt.Sprintf("processed: input file hashes changed since parent job %s: %s", explanation.ParentJob.ID, strings.Join(changed, ", "))
+	case parentMeta.State == datum.State_FAILED:
+		return fmt.Sprintf("processed: the datum failed in parent job %s", explanation.ParentJob.ID)
+	case parentMeta.State == datum.State_RECOVERED:
+		return fmt.Sprintf("processed: the datum was recovered in parent job %s", explanation.ParentJob.ID)
+	}
+	return fmt.Sprintf("processed: the datum matched parent job %s but could not be skipped (for example, because the parent job was still running)", explanation.ParentJob.ID)
+}
+
+func findDatumMeta(pachClient *client.APIClient, metaCommit *pfs.Commit, datumID string) (*datum.Meta, error) {
+	var result *datum.Meta
+	if err := datum.NewCommitIterator(pachClient, metaCommit).Iterate(func(meta *datum.Meta) error {
+		if common.DatumID(meta.Inputs) == datumID {
+			result = meta
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, errors.EnsureStack(err)
+	}
+	return result, nil
+}
+
+func findBaseMetaCommit(pachClient *client.APIClient, metaCommit *pfs.Commit) (*pfs.Commit, error) {
+	metaCI, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{Commit: metaCommit})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	baseMetaCommit := metaCI.ParentCommit
+	for baseMetaCommit != nil {
+		metaCI, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{Commit: baseMetaCommit})
+		if err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		outputCI, err := pachClient.InspectCommit(baseMetaCommit.Branch.Repo.Name, baseMetaCommit.Branch.Name, baseMetaCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if metaCI.Error == "" && outputCI.Error == "" {
+			break
+		}
+		baseMetaCommit = metaCI.ParentCommit
+	}
+	return baseMetaCommit, nil
+}
+
 func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_ListDatumServer) (retErr error) {
 	// TODO: Auth?
 	if request.Input != nil {
@@ -1047,6 +1277,9 @@ func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb fun
 		if input.Cron != nil {
 			return errors.Errorf("can't list datums with a cron input, there will be no datums until the pipeline is created")
 		}
+		if input.Object != nil {
+			return errors.Errorf("can't list datums with an object input, there will be no datums until the pipeline is created")
+		}
 		return nil
 	}); visitErr != nil {
 		return visitErr
@@ -1439,6 +1672,9 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.S3Out && ((request.Service != nil) || (request.Spout != nil)) {
 		return errors.New("s3 output is not supported in spouts or services")
 	}
+	if request.DeadLetter && (request.S3Out || request.Service != nil || request.Spout != nil) {
+		return errors.New("dead-letter output is not supported with s3 output, spouts or services")
+	}
 	if request.Transform == nil {
 		return errors.Errorf("pipeline must specify a transform")
 	}
@@ -1582,6 +1818,9 @@ func branchProvenance(input *pps.Input) []*pfs.Branch {
 		if input.Cron != nil {
 			result = append(result, client.NewBranch(input.Cron.Repo, "master"))
 		}
+		if input.Object != nil {
+			result = append(result, client.NewBranch(input.Object.Repo, "master"))
+		}
 		return nil
 	})
 	return result
@@ -1608,6 +1847,8 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 				repo = input.Pfs.Repo
 			case input.Cron != nil:
 				repo = input.Cron.Repo
+			case input.Object != nil:
+				repo = input.Object.Repo
 			default:
 				return nil // no scope to set: input is not a repo
 			}
@@ -1636,6 +1877,8 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 				repo = input.Pfs.Repo
 			case input.Cron != nil:
 				repo = input.Cron.Repo
+			case input.Object != nil:
+				repo = input.Object.Repo
 			default:
 				return nil // no scope to set: input is not a repo
 			}
@@ -1643,7 +1886,7 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 				delete(remove, repo)
 			} else {
 				addRead[repo] = struct{}{}
-				if input.Cron != nil {
+				if input.Cron != nil || input.Object != nil {
 					addWrite[repo] = struct{}{}
 				}
 			}
@@ -1790,6 +2033,7 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 			DatumTimeout:          request.DatumTimeout,
 			JobTimeout:            request.JobTimeout,
 			DatumTries:            request.DatumTries,
+			DeadLetter:            request.DeadLetter,
 			SchedulingSpec:        request.SchedulingSpec,
 			PodSpec:               request.PodSpec,
 			PodPatch:              request.PodPatch,
@@ -1865,6 +2109,16 @@ func (a *apiServer) CreatePipelineInTransaction(
 				return errors.EnsureStack(err)
 			}
 		}
+		if input.Object != nil {
+			if err := a.env.PFSServer.CreateRepoInTransaction(txnCtx,
+				&pfs.CreateRepoRequest{
+					Repo:        client.NewRepo(input.Object.Repo),
+					Description: fmt.Sprintf("Object ingestion repo for pipeline %s.", request.Pipeline.Name),
+				},
+			); err != nil && !errutil.IsAlreadyExistError(err) {
+				return errors.EnsureStack(err)
+			}
+		}
 		return nil
 	}); visitErr != nil {
 		return visitErr
@@ -2123,6 +2377,14 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
 		}
+		if input.Object != nil {
+			if input.Object.Repo == "" {
+				input.Object.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Object.Name)
+			}
+			if input.Object.Glob == "" {
+				input.Object.Glob = "/*"
+			}
+		}
 		return nil
 	})
 }
@@ -2345,6 +2607,15 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 		}); err != nil {
 			return nil, errors.EnsureStack(err)
 		}
+	} else if txn, err := client.GetTransaction(ctx); err != nil {
+		return nil, err
+	} else if txn != nil {
+		// the pipeline is deleted when the active transaction is finished
+		if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+			return errors.EnsureStack(txn.DeletePipeline(request))
+		}, nil); err != nil {
+			return nil, err
+		}
 	} else if err := a.deletePipeline(ctx, request); err != nil {
 		return nil, err
 	}
@@ -2352,12 +2623,39 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 	return &types.Empty{}, nil
 }
 
+// DeletePipelineInTransaction is identical to DeletePipeline except that it
+// can run inside an existing postgres transaction, and the pipeline is
+// stopped in the same transaction.  Deleting all pipelines isn't supported in
+// a transaction.  This is not an RPC.
+func (a *apiServer) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
+	if request.All {
+		return errors.New("cannot delete all pipelines in a transaction")
+	}
+	if request.Pipeline == nil {
+		return errors.New("request.Pipeline cannot be nil")
+	}
+	if err := a.StopPipelineInTransaction(txnCtx, &pps.StopPipelineRequest{Pipeline: request.Pipeline}); err != nil {
+		return errors.Wrapf(err, "error stopping pipeline %s", request.Pipeline.Name)
+	}
+	// as in deletePipeline, an incomplete deletion still succeeds
+	if err := a.deletePipelineInTransaction(txnCtx, request); err != nil && !errors.Is(err, errIncompleteDeletion) {
+		return err
+	}
+	pipelineName := request.Pipeline.Name
+	txnCtx.PostCommit(func(ctx context.Context) error {
+		clearJobCache(a.env.GetPachClient(ctx), pipelineName)
+		return nil
+	})
+	return nil
+}
+
 func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) error {
 	pipelineName := request.Pipeline.Name
 
 	// stop the pipeline to avoid interference from new jobs
-	if _, err := a.StopPipeline(ctx,
-		&pps.StopPipelineRequest{Pipeline: request.Pipeline}); err != nil && errutil.IsNotFoundError(err) {
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.StopPipelineInTransaction(txnCtx, &pps.StopPipelineRequest{Pipeline: request.Pipeline})
+	}); err != nil && errutil.IsNotFoundError(err) {
 		logrus.Errorf("failed to stop pipeline, continuing with delete: %v", err)
 	} else if err != nil {
 		return errors.Wrapf(err, "error stopping pipeline %s", pipelineName)
@@ -2495,8 +2793,9 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 			}
 		}
 	}
-	// delete cron after main repo is deleted or has provenance removed
-	// cron repos are only used to trigger jobs, so don't keep them even with KeepRepo
+	// delete cron and object input repos after main repo is deleted or has
+	// provenance removed. These repos are only used to trigger jobs, so don't
+	// keep them even with KeepRepo
 	if pipelineInfo.Details != nil {
 		if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
 			if input.Cron != nil {
@@ -2506,6 +2805,13 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 				})
 				return errors.EnsureStack(err)
 			}
+			if input.Object != nil {
+				err := a.env.PFSServer.DeleteRepoInTransaction(txnCtx, &pfs.DeleteRepoRequest{
+					Repo:  client.NewRepo(input.Object.Repo),
+					Force: request.Force,
+				})
+				return errors.EnsureStack(err)
+			}
 			return nil
 		}); err != nil {
 			return err
@@ -2522,100 +2828,125 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 
 // StartPipeline implements the protobuf pps.StartPipeline RPC
 func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelineRequest) (response *types.Empty, retErr error) {
-	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.StartPipeline(request))
+	}, nil); err != nil {
+		return nil, err
 	}
+	return &types.Empty{}, nil
+}
 
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err != nil {
-			return err
-		}
+// StartPipelineInTransaction is identical to StartPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StartPipelineRequest) error {
+	if request.Pipeline == nil {
+		return errors.New("request.Pipeline cannot be nil")
+	}
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
+	if err != nil {
+		return err
+	}
 
-		// check if the caller is authorized to update this pipeline
-		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
-			return err
-		}
+	// check if the caller is authorized to start this pipeline
+	if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
 
-		// Restore branch provenance, which may create a new output commit/job
-		provenance := append(branchProvenance(pipelineInfo.Details.Input),
-			client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
-		if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
-			Provenance: provenance,
-		}); err != nil {
-			return errors.EnsureStack(err)
-		}
-		// restore same provenance to meta repo
-		if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
-			Provenance: provenance,
-		}); err != nil {
-			return errors.EnsureStack(err)
-		}
+	// The pipeline restores its own branches, so the caller doesn't need
+	// write access to the output repo
+	txnCtx = pipelineUserTxnCtx(txnCtx, pipelineInfo)
 
-		newPipelineInfo := &pps.PipelineInfo{}
-		return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-			newPipelineInfo.Stopped = false
-			return nil
-		})
+	// Restore branch provenance, which may create a new output commit/job
+	provenance := append(branchProvenance(pipelineInfo.Details.Input),
+		client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
+	if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+		Provenance: provenance,
 	}); err != nil {
-		return nil, err
+		return errors.EnsureStack(err)
 	}
-	return &types.Empty{}, nil
+	// restore same provenance to meta repo
+	if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
+		Provenance: provenance,
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+
+	newPipelineInfo := &pps.PipelineInfo{}
+	return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+		newPipelineInfo.Stopped = false
+		return nil
+	})
 }
 
 // StopPipeline implements the protobuf pps.StopPipeline RPC
 func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (response *types.Empty, retErr error) {
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.StopPipeline(request))
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// StopPipelineInTransaction is identical to StopPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StopPipelineRequest) error {
 	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
+		return errors.New("request.Pipeline cannot be nil")
 	}
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
+	if err == nil {
+		// check if the caller is authorized to stop this pipeline
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
 
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err == nil {
-			// check if the caller is authorized to update this pipeline
-			// don't pass in the input - stopping the pipeline means they won't be read anymore,
-			// so we don't need to check any permissions
-			if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
-				return err
-			}
+		// The pipeline removes its own branch provenance, so the caller doesn't
+		// need write access to the output repo
+		txnCtx = pipelineUserTxnCtx(txnCtx, pipelineInfo)
 
-			// Remove branch provenance to prevent new output and meta commits from being created
+		// Remove branch provenance to prevent new output and meta commits from being created
+		if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+			Provenance: nil,
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if pipelineInfo.Details.Spout == nil && pipelineInfo.Details.Service == nil {
 			if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-				Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+				Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
 				Provenance: nil,
 			}); err != nil {
 				return errors.EnsureStack(err)
 			}
-			if pipelineInfo.Details.Spout == nil && pipelineInfo.Details.Service == nil {
-				if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-					Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
-					Provenance: nil,
-				}); err != nil {
-					return errors.EnsureStack(err)
-				}
-			}
+		}
 
-			newPipelineInfo := &pps.PipelineInfo{}
-			if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-				newPipelineInfo.Stopped = true
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else if !errutil.IsNotFoundError(err) {
+		newPipelineInfo := &pps.PipelineInfo{}
+		if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+			newPipelineInfo.Stopped = true
+			return nil
+		}); err != nil {
 			return err
 		}
+	} else if !errutil.IsNotFoundError(err) {
+		return err
+	}
 
-		// Kill any remaining jobs
-		// if the pipeline output repo doesn't exist, we technically run this without authorization,
-		// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
-		return a.stopAllJobsInPipeline(txnCtx, request.Pipeline)
-	}); err != nil {
-		return nil, err
+	// Kill any remaining jobs
+	// if the pipeline output repo doesn't exist, we technically run this without authorization,
+	// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
+	return a.stopAllJobsInPipeline(txnCtx, request.Pipeline)
+}
+
+// pipelineUserTxnCtx returns a transaction context that acts as the pipeline
+// described by 'pipelineInfo', the same as pipelineUserContext does for RPCs.
+func pipelineUserTxnCtx(txnCtx *txncontext.TransactionContext, pipelineInfo *pps.PipelineInfo) *txncontext.TransactionContext {
+	if pipelineInfo.AuthToken == "" {
+		return txnCtx
 	}
-	return &types.Empty{}, nil
+	return txnCtx.AsUser(auth.PipelinePrefix + pipelineInfo.Pipeline.Name)
 }
 
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
@@ -2631,6 +2962,9 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 	if pipelineInfo.Details.Input == nil {
 		return nil, errors.Errorf("pipeline doesn't have a cron input")
 	}
+	if err := a.authorizePipelineOp(ctx, pipelineOpRunDatum, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return nil, err
+	}
 
 	// find any cron inputs
 	var crons []*pps.CronInput
@@ -2647,14 +2981,96 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 
 	// put the same time for all ticks
 	now := time.Now()
+	tick := cronTick
+	if request.At != nil {
+		at, err := types.TimestampFromProto(request.At)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		// ticks in the future would move the point that the schedule resumes
+		// from past the ticks in between
+		if at.After(now) {
+			return nil, errors.Errorf("cannot make a cron tick in the future (%v)", at.UTC().Format(time.RFC3339))
+		}
+		now, tick = at, cronTickAt
+	}
 
-	// add all the ticks. These will be in separate transactions if there are more than one
+	// add all the ticks as the pipeline, which can write to its cron repos.
+	// These will be in separate transactions if there are more than one
+	ctx, err = a.pipelineUserContext(ctx, pipelineInfo.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
 	for _, c := range crons {
-		if err := cronTick(a.env.GetPachClient(ctx), now, c); err != nil {
+		if err := tick(a.env.GetPachClient(ctx), now, c); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.Empty{}, nil
+}
+
+// ReplayDeadLetter implements the protobuf pps.ReplayDeadLetter RPC
+func (a *apiServer) ReplayDeadLetter(ctx context.Context, request *pps.ReplayDeadLetterRequest) (response *types.Empty, retErr error) {
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ReplayDeadLetter")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	if request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name, true)
+	if err != nil {
+		return nil, err
+	}
+	if !pipelineInfo.Details.DeadLetter {
+		return nil, errors.Errorf("pipeline %q does not have dead-letter output enabled", request.Pipeline.Name)
+	}
+	if err := a.authorizePipelineOp(ctx, pipelineOpRunDatum, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return nil, err
+	}
+
+	// Release the datums from quarantine. The next job will no longer skip
+	// them, since they are recorded as failed in the parent job. Only the
+	// pipeline can write its meta repo, so the dead-letter branch is modified
+	// as the pipeline, but nothing else is done on its behalf.
+	pipelineCtx, err := a.pipelineUserContext(ctx, pipelineInfo.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+	pachClient := a.env.GetPachClient(pipelineCtx)
+	deadLetterCommit := client.NewSystemRepo(request.Pipeline.Name, pfs.MetaRepoType).NewCommit(ppsconsts.DeadLetterBranch, "")
+	for _, datumID := range request.Datums {
+		if _, err := pachClient.InspectFile(deadLetterCommit, datumID); err != nil {
+			if pfsServer.IsFileNotFoundErr(err) || pfsServer.IsBranchNotFoundErr(err) {
+				return nil, errors.Errorf("datum %s is not quarantined in pipeline %q", datumID, request.Pipeline.Name)
+			}
 			return nil, err
 		}
 	}
+	if err := pachClient.WithModifyFileClient(deadLetterCommit, func(mf client.ModifyFile) error {
+		if len(request.Datums) == 0 {
+			return errors.EnsureStack(mf.DeleteFile("/"))
+		}
+		for _, datumID := range request.Datums {
+			if err := mf.DeleteFile(datumID); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
+	// Start a new job that picks up the released datums, by propagating the
+	// current spec commit to a new output commit. The spec is unchanged, so the
+	// pipeline keeps its version and its workers aren't restarted. This runs
+	// as the caller, who has been authorized to run datums above.
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		specBranch := client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master")
+		return errors.EnsureStack(a.env.PFSServer.RepropagateBranchInTransaction(txnCtx, specBranch))
+	}); err != nil {
+		return nil, err
+	}
 	return &types.Empty{}, nil
 }
 
@@ -2719,14 +3135,27 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreateSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.CreateSecret(request))
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// CreateSecretInTransaction creates the secret in 'request' inside an
+// existing postgres transaction.  Kubernetes secrets can't be rolled back, so
+// the secret is deleted again if the transaction is rolled back, and is only
+// validated by kubernetes in a dry run.  This is not an RPC.
+func (a *apiServer) CreateSecretInTransaction(txnCtx *txncontext.TransactionContext, request *pps.CreateSecretRequest) error {
 	var s v1.Secret
 	if err := json.Unmarshal(request.GetFile(), &s); err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshal secret")
+		return errors.Wrapf(err, "failed to unmarshal secret")
 	}
 
 	labels := s.GetLabels()
 	if labels["suite"] != "" && labels["suite"] != "pachyderm" {
-		return nil, errors.Errorf("invalid suite label set on secret: suite=%s", labels["suite"])
+		return errors.Errorf("invalid suite label set on secret: suite=%s", labels["suite"])
 	}
 	if labels == nil {
 		labels = map[string]string{}
@@ -2735,10 +3164,23 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	labels["secret-source"] = "pachyderm-user"
 	s.SetLabels(labels)
 
-	if _, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Create(ctx, &s, metav1.CreateOptions{}); err != nil {
-		return nil, errors.Wrapf(err, "failed to create secret")
+	ctx := txnCtx.Context()
+	opts := metav1.CreateOptions{}
+	if txnCtx.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
 	}
-	return &types.Empty{}, nil
+	if _, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Create(ctx, &s, opts); err != nil {
+		return errors.Wrapf(err, "failed to create secret")
+	}
+	if !txnCtx.DryRun {
+		txnCtx.OnRollback(func(ctx context.Context) error {
+			if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Delete(ctx, s.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return errors.Wrapf(err, "failed to delete secret")
+			}
+			return nil
+		})
+	}
+	return nil
 }
 
 // DeleteSecret implements the protobuf pps.DeleteSecret RPC
@@ -2757,6 +3199,9 @@ func (a *apiServer) InspectSecret(ctx context.Context, request *pps.InspectSecre
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "InspectSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
+	if err := a.authorizeInspectSecret(ctx, request); err != nil {
+		return nil, err
+	}
 	secret, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Get(ctx, request.Secret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get secret")
@@ -2774,6 +3219,35 @@ func (a *apiServer) InspectSecret(ctx context.Context, request *pps.InspectSecre
 	}, nil
 }
 
+// authorizeInspectSecret checks that the caller may inspect the secret in
+// 'request'. Inspecting a secret normally requires SECRET_INSPECT on the
+// cluster, but a secret used by a pipeline may also be inspected with
+// PIPELINE_READ_SECRETS on that pipeline.
+func (a *apiServer) authorizeInspectSecret(ctx context.Context, request *pps.InspectSecretRequest) error {
+	if request.Pipeline == nil {
+		return errors.EnsureStack(a.env.AuthServer.CheckClusterIsAuthorized(ctx, auth.Permission_SECRET_INSPECT))
+	}
+	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name, true)
+	if err != nil {
+		return err
+	}
+	if err := a.env.AuthServer.CheckPipelineIsAuthorized(ctx, pipelineInfo.Pipeline.Name, auth.Permission_PIPELINE_READ_SECRETS); err != nil {
+		return errors.EnsureStack(err)
+	}
+	transform := pipelineInfo.Details.Transform
+	for _, s := range transform.GetSecrets() {
+		if s.Name == request.Secret.Name {
+			return nil
+		}
+	}
+	for _, name := range transform.GetImagePullSecrets() {
+		if name == request.Secret.Name {
+			return nil
+		}
+	}
+	return errors.Errorf("pipeline %q does not use secret %q", pipelineInfo.Pipeline.Name, request.Secret.Name)
+}
+
 // ListSecret implements the protobuf pps.ListSecret RPC
 func (a *apiServer) ListSecret(ctx context.Context, in *types.Empty) (response *pps.SecretInfos, retErr error) {
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ListSecret")
@@ -4,13 +4,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	logrus "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
@@ -32,6 +36,47 @@ func TestRenderTemplate(t *testing.T) {
 	require.Len(t, res.Specs, 1)
 }
 
+func TestExplainDatum(t *testing.T) {
+	pipelineInfo := &pps.PipelineInfo{Details: &pps.PipelineInfo_Details{}}
+	job := client.NewJob("pipeline", "job")
+	parentJob := client.NewJob("pipeline", "parent")
+	input := func(path, hash string) *common.Input {
+		return &common.Input{FileInfo: &pfs.FileInfo{
+			File: client.NewFile("repo", "master", "", path),
+			Hash: []byte(hash),
+		}}
+	}
+	meta := &datum.Meta{Job: job, Inputs: []*common.Input{input("/a", "1"), input("/b", "2")}, Hash: "new"}
+	explanation := func(state pps.DatumState, salt, parentSalt string, parent *pps.Job) *pps.ExplainDatumResponse {
+		return &pps.ExplainDatumResponse{State: state, Salt: salt, ParentSalt: parentSalt, ParentJob: parent}
+	}
+
+	reason := explainDatum(explanation(pps.DatumState_SUCCESS, "s", "", nil), pipelineInfo, meta, nil)
+	require.Equal(t, "processed: the job has no successful parent job", reason)
+
+	reason = explainDatum(explanation(pps.DatumState_SUCCESS, "s", "s", parentJob), pipelineInfo, meta, nil)
+	require.Equal(t, "processed: no datum with the same input files exists in parent job parent", reason)
+
+	parentMeta := &datum.Meta{Job: parentJob, Inputs: []*common.Input{input("/a", "1"), input("/b", "3")}, Hash: "old"}
+	reason = explainDatum(explanation(pps.DatumState_SUCCESS, "s", "s", parentJob), pipelineInfo, meta, parentMeta)
+	require.Equal(t, "processed: input file hashes changed since parent job parent: /b", reason)
+
+	reason = explainDatum(explanation(pps.DatumState_SUCCESS, "s", "t", parentJob), pipelineInfo, meta, parentMeta)
+	require.Matches(t, "salt changed", reason)
+
+	parentMeta = &datum.Meta{Job: parentJob, Inputs: meta.Inputs, Hash: "new", State: datum.State_FAILED}
+	reason = explainDatum(explanation(pps.DatumState_SUCCESS, "s", "s", parentJob), pipelineInfo, meta, parentMeta)
+	require.Equal(t, "processed: the datum failed in parent job parent", reason)
+
+	skippedMeta := &datum.Meta{Job: parentJob, Inputs: meta.Inputs, Hash: "new"}
+	reason = explainDatum(explanation(pps.DatumState_SKIPPED, "s", "s", parentJob), pipelineInfo, skippedMeta, skippedMeta)
+	require.Equal(t, "skipped: job parent already processed a datum with the same hash", reason)
+
+	pipelineInfo.Details.ReprocessSpec = client.ReprocessSpecEveryJob
+	reason = explainDatum(explanation(pps.DatumState_SUCCESS, "s", "s", parentJob), pipelineInfo, meta, skippedMeta)
+	require.Equal(t, `processed: reprocess_spec is "every_job"`, reason)
+}
+
 func newClient(t testing.TB) pps.APIClient {
 	srv := newServer(t)
 	gc := grpcutil.NewTestClient(t, func(gs *grpc.Server) {
@@ -1,10 +1,17 @@
 package server
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
+	"io"
 	"path"
+	"sort"
+	"strings"
 	"time"
+	// pachd runs in a scratch image without a zoneinfo database, so embed one
+	// for cron inputs' time zones.
+	_ "time/tzdata"
 
 	"github.com/gogo/protobuf/types"
 	opentracing "github.com/opentracing/opentracing-go"
@@ -16,21 +23,27 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 )
 
 // startMonitor starts a new goroutine running monitorPipeline for
 // 'pipelineInfo.Pipeline'.
 //
-// Every running pipeline with standby == true or a cron input has a
-// corresponding goroutine running monitorPipeline() that puts the pipeline in
-// and out of standby in response to new output commits appearing in that
-// pipeline's output repo.
+// Every running pipeline with standby == true, a cron input or an object input
+// has a corresponding goroutine running monitorPipeline() that puts the
+// pipeline in and out of standby in response to new output commits appearing
+// in that pipeline's output repo, and makes commits to its cron and object
+// input repos.
 // returns a cancel()
 func (pc *pipelineController) startMonitor(ctx context.Context, pipelineInfo *pps.PipelineInfo) func() {
 	pipeline := pipelineInfo.Pipeline.Name
@@ -97,6 +110,14 @@ func (pc *pipelineController) monitorPipeline(ctx context.Context, pipelineInfo
 					backoff.NotifyCtx(ctx, "cron for "+in.Cron.Name))
 			})
 		}
+		if in.Object != nil {
+			eg.Go(func() error {
+				return backoff.RetryNotify(func() error {
+					return makeObjectCommits(ctx, pc.env, in)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(ctx, "object input "+in.Object.Name))
+			})
+		}
 		return nil
 	})
 	if pipelineInfo.Details.Autoscaling {
@@ -291,7 +312,21 @@ func cronTick(pachClient *client.APIClient, now time.Time, cron *pps.CronInput)
 					return errors.EnsureStack(err)
 				}
 			}
-			return errors.EnsureStack(m.PutFile(now.Format(time.RFC3339), bytes.NewReader(nil)))
+			// Ticks are always named in UTC, so that they sort chronologically
+			// regardless of the input's time zone.
+			return errors.EnsureStack(m.PutFile(now.UTC().Format(time.RFC3339), bytes.NewReader(nil)))
+		})
+}
+
+// cronTickAt adds the tick for 'at' to a cron input's repo, e.g. to make a tick
+// that was missed. Unlike cronTick, it leaves the existing ticks alone even if
+// the input overwrites them, as the most recent tick is where the schedule
+// resumes, and backfills missed ticks from, when the pipeline restarts.
+func cronTickAt(pachClient *client.APIClient, at time.Time, cron *pps.CronInput) error {
+	return pachClient.WithModifyFileClient(
+		client.NewRepo(cron.Repo).NewCommit("master", ""),
+		func(m client.ModifyFile) error {
+			return errors.EnsureStack(m.PutFile(at.UTC().Format(time.RFC3339), bytes.NewReader(nil)))
 		})
 }
 
@@ -302,12 +337,25 @@ func makeCronCommits(ctx context.Context, env Env, in *pps.Input) error {
 	if err != nil {
 		return errors.EnsureStack(err) // Shouldn't happen, as the input is validated in CreatePipeline
 	}
+	loc, err := time.LoadLocation(in.Cron.Timezone)
+	if err != nil {
+		return errors.EnsureStack(err) // Shouldn't happen, as the input is validated in CreatePipeline
+	}
 	pachClient := env.GetPachClient(ctx)
 	latestTime, err := getLatestCronTime(ctx, env, in)
 	if err != nil {
 		return err
 	}
+	// Evaluate the schedule in the input's time zone, so that ticks follow
+	// local wall-clock time across DST transitions.
+	latestTime = latestTime.In(loc)
 
+	latestTime, missed := catchUpCronTicks(schedule, latestTime, time.Now(), in.Cron)
+	for _, tick := range missed {
+		if err := cronTick(pachClient, tick, in.Cron); err != nil {
+			return err
+		}
+	}
 	for {
 		// get the time of the next time from the latest time using the cron schedule
 		next := schedule.Next(latestTime)
@@ -328,6 +376,41 @@ func makeCronCommits(ctx context.Context, env Env, in *pps.Input) error {
 	}
 }
 
+// catchUpCronTicks applies 'cron's catch-up policy to the ticks between
+// 'latestTime' and 'now', which were missed while the pipeline wasn't running.
+// It returns the time from which the schedule should resume, along with the
+// missed ticks that should still be made.
+func catchUpCronTicks(schedule cron.Schedule, latestTime, now time.Time, cron *pps.CronInput) (time.Time, []time.Time) {
+	var keep int64
+	switch cron.CatchUp {
+	case pps.CronCatchUp_CRON_SKIP:
+		keep = 0
+	case pps.CronCatchUp_CRON_LATEST:
+		keep = 1
+	default:
+		if cron.BackfillLimit == 0 {
+			// Every missed tick is made by the normal schedule loop.
+			return latestTime, nil
+		}
+		keep = cron.BackfillLimit
+	}
+	var ticks []time.Time
+	for {
+		next := schedule.Next(latestTime)
+		if next.IsZero() || next.After(now) {
+			return latestTime, ticks
+		}
+		latestTime = next
+		if keep == 0 {
+			continue
+		}
+		if int64(len(ticks)) == keep {
+			ticks = ticks[1:]
+		}
+		ticks = append(ticks, next)
+	}
+}
+
 // getLatestCronTime is a helper used by m.makeCronCommits. It figures out what
 // 'in's most recently executed cron tick was and returns it (or, if no cron
 // ticks are in 'in's cron repo, it retuns the 'Start' time set in 'in.Cron'
@@ -355,3 +438,214 @@ func getLatestCronTime(ctx context.Context, env Env, in *pps.Input) (time.Time,
 	}
 	return latestTime, nil
 }
+
+// makeObjectCommits polls the bucket prefix of a single object input on its
+// schedule and commits new or changed objects to the input's repo. It's a
+// helper function called by monitorPipeline.
+func makeObjectCommits(ctx context.Context, env Env, in *pps.Input) error {
+	schedule, err := cron.ParseStandard(in.Object.Spec)
+	if err != nil {
+		return errors.EnsureStack(err) // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	url, err := obj.ParseURL(in.Object.URL)
+	if err != nil {
+		return err // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(url, false)
+	if err != nil {
+		return err
+	}
+	pachClient := env.GetPachClient(ctx)
+	if _, err := pachClient.InspectBranch(in.Object.Repo, ppsconsts.ObjectStateBranch); err != nil {
+		if !pfsServer.IsBranchNotFoundErr(err) {
+			return err
+		}
+		if err := pachClient.CreateBranch(in.Object.Repo, ppsconsts.ObjectStateBranch, "", "", nil); err != nil {
+			return err
+		}
+	}
+	for {
+		if err := objectTick(ctx, pachClient, objClient, url.Object, in.Object); err != nil {
+			return err
+		}
+		next := schedule.Next(time.Now())
+		if next.IsZero() {
+			return nil // zero time indicates there will never be another tick
+		}
+		select {
+		case <-time.After(time.Until(next)):
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+}
+
+// objectTick compares the objects under 'prefix' with the ETags recorded in
+// the state branch of 'in's repo, and commits any new or changed objects (and,
+// if 'in.Delete' is set, removes any deleted objects). The data and the new
+// ETags are written to file sets first, which are then added to commits on
+// the master and state branches in a single transaction, so the state always
+// matches the data that was ingested.
+func objectTick(ctx context.Context, pachClient *client.APIClient, objClient obj.Client, prefix string, in *pps.ObjectInput) error {
+	etags, err := getObjectETags(pachClient, client.NewCommit(in.Repo, ppsconsts.ObjectStateBranch, ""))
+	if err != nil {
+		return err
+	}
+	var infos []*obj.ObjectInfo
+	if err := objClient.WalkInfo(ctx, prefix, func(info *obj.ObjectInfo) error {
+		infos = append(infos, info)
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	updated, deleted := diffObjects(prefix, infos, etags, in.Delete)
+	if len(updated) == 0 && len(deleted) == 0 {
+		return nil
+	}
+	dataResp, err := pachClient.WithCreateFileSetClient(func(m client.ModifyFile) error {
+		for p, info := range updated {
+			if err := miscutil.WithPipe(func(w io.Writer) error {
+				return errors.EnsureStack(objClient.Get(ctx, info.Name, w))
+			}, func(r io.Reader) error {
+				return errors.EnsureStack(m.PutFile(p, r))
+			}); err != nil {
+				return err
+			}
+		}
+		for _, p := range deleted {
+			if err := m.DeleteFile(p); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	stateResp, err := pachClient.WithCreateFileSetClient(func(m client.ModifyFile) error {
+		for p, info := range updated {
+			if err := m.PutFile(p, strings.NewReader(info.ETag)); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		for _, p := range deleted {
+			if err := m.DeleteFile(p); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	_, err = pachClient.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+		for branch, fileSetID := range map[string]string{
+			"master":                    dataResp.FileSetId,
+			ppsconsts.ObjectStateBranch: stateResp.FileSetId,
+		} {
+			commit, err := txnClient.StartCommit(in.Repo, branch)
+			if err != nil {
+				return err
+			}
+			if err := txnClient.AddFileSet(in.Repo, branch, commit.ID, fileSetID); err != nil {
+				return err
+			}
+			if err := txnClient.FinishCommit(in.Repo, branch, commit.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// diffObjects compares the objects found under 'prefix' with the ETags
+// recorded for them, and returns the objects that are new or changed, keyed
+// by their path in the input repo, along with the paths of the objects that
+// were deleted, if 'delete' is set.
+func diffObjects(prefix string, infos []*obj.ObjectInfo, etags map[string]string, delete bool) (map[string]*obj.ObjectInfo, []string) {
+	updated := make(map[string]*obj.ObjectInfo)
+	seen := make(map[string]bool)
+	for _, info := range infos {
+		if strings.HasSuffix(info.Name, "/") {
+			continue // directory marker
+		}
+		p, ok := objectPath(prefix, info.Name)
+		if !ok {
+			continue
+		}
+		seen[p] = true
+		if etags[p] != info.ETag {
+			updated[p] = info
+		}
+	}
+	var deleted []string
+	if delete {
+		for p := range etags {
+			if !seen[p] {
+				deleted = append(deleted, p)
+			}
+		}
+		sort.Strings(deleted)
+	}
+	return updated, deleted
+}
+
+// getObjectETags returns the ETags recorded in an object input's state
+// commit, keyed by the path of the corresponding file in the input repo.
+func getObjectETags(pachClient *client.APIClient, stateCommit *pfs.Commit) (map[string]string, error) {
+	etags := make(map[string]string)
+	r, err := pachClient.GetFileTAR(stateCommit, "/**")
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	if err := tarutil.Iterate(r, func(f tarutil.File) error {
+		hdr, err := f.Header()
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			return nil
+		}
+		buf := &bytes.Buffer{}
+		if err := f.Content(buf); err != nil {
+			return err
+		}
+		etags[path.Clean("/"+hdr.Name)] = buf.String()
+		return nil
+	}); err != nil {
+		if pfsServer.IsFileNotFoundErr(err) {
+			return etags, nil // no objects have been ingested yet
+		}
+		return nil, err
+	}
+	return etags, nil
+}
+
+// objectPath returns the path in an object input's repo for the object 'name'
+// found under 'prefix'. Prefixes that don't end in a slash are directories, so
+// e.g. "data/x" is under the prefix "data" but "database/x" isn't, and
+// objects that aren't under the prefix return false.
+func objectPath(prefix, name string) (string, bool) {
+	var rel string
+	switch {
+	case prefix == "" || strings.HasSuffix(prefix, "/"):
+		if !strings.HasPrefix(name, prefix) {
+			return "", false
+		}
+		rel = strings.TrimPrefix(name, prefix)
+	case name == prefix:
+		// the prefix names a single object
+		rel = path.Base(name)
+	case strings.HasPrefix(name, prefix+"/"):
+		rel = strings.TrimPrefix(name, prefix+"/")
+	default:
+		return "", false
+	}
+	p := path.Clean("/" + rel)
+	if p == "/" {
+		return "", false
+	}
+	return p, true
+}
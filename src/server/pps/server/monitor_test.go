@@ -0,0 +1,164 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestCatchUpCronTicks(t *testing.T) {
+	schedule, err := cron.ParseStandard("@hourly")
+	require.NoError(t, err)
+	latest := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := latest.Add(5*time.Hour + 30*time.Minute)
+	hour := func(h int) time.Time { return latest.Add(time.Duration(h) * time.Hour) }
+
+	// By default every missed tick is left to the schedule loop
+	resume, ticks := catchUpCronTicks(schedule, latest, now, &pps.CronInput{})
+	require.Equal(t, latest, resume)
+	require.Equal(t, 0, len(ticks))
+
+	resume, ticks = catchUpCronTicks(schedule, latest, now, &pps.CronInput{BackfillLimit: 2})
+	require.Equal(t, hour(5), resume)
+	require.Equal(t, []time.Time{hour(4), hour(5)}, ticks)
+
+	resume, ticks = catchUpCronTicks(schedule, latest, now, &pps.CronInput{CatchUp: pps.CronCatchUp_CRON_LATEST})
+	require.Equal(t, hour(5), resume)
+	require.Equal(t, []time.Time{hour(5)}, ticks)
+
+	resume, ticks = catchUpCronTicks(schedule, latest, now, &pps.CronInput{CatchUp: pps.CronCatchUp_CRON_SKIP})
+	require.Equal(t, hour(5), resume)
+	require.Equal(t, 0, len(ticks))
+
+	// Nothing was missed
+	resume, ticks = catchUpCronTicks(schedule, now, now, &pps.CronInput{CatchUp: pps.CronCatchUp_CRON_LATEST})
+	require.Equal(t, now, resume)
+	require.Equal(t, 0, len(ticks))
+}
+
+func TestCronTimezone(t *testing.T) {
+	schedule, err := cron.ParseStandard("0 9 * * *")
+	require.NoError(t, err)
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// 9am in New York is 14:00 UTC before the DST transition on 2021-03-14
+	// and 13:00 UTC after it
+	next := schedule.Next(time.Date(2021, 3, 13, 0, 0, 0, 0, time.UTC).In(loc))
+	require.Equal(t, time.Date(2021, 3, 13, 14, 0, 0, 0, time.UTC), next.UTC())
+	next = schedule.Next(next)
+	require.Equal(t, time.Date(2021, 3, 14, 13, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func TestObjectPath(t *testing.T) {
+	for _, c := range []struct {
+		prefix, name, path string
+		ok                 bool
+	}{
+		{"", "a/b", "/a/b", true},
+		{"data/", "data/a", "/a", true},
+		{"data/", "database/a", "", false},
+		{"data", "data/a/b", "/a/b", true},
+		{"data", "database/a", "", false},
+		{"data", "data", "/data", true},
+		{"data/file", "data/file", "/file", true},
+		{"data/f", "data/file", "", false},
+	} {
+		p, ok := objectPath(c.prefix, c.name)
+		require.Equal(t, c.ok, ok, "prefix %q name %q", c.prefix, c.name)
+		require.Equal(t, c.path, p, "prefix %q name %q", c.prefix, c.name)
+	}
+}
+
+func TestDiffObjects(t *testing.T) {
+	infos := []*obj.ObjectInfo{
+		{Name: "data/"},
+		{Name: "data/same", ETag: "1"},
+		{Name: "data/changed", ETag: "2"},
+		{Name: "data/new", ETag: "3"},
+		{Name: "database/other", ETag: "4"},
+	}
+	etags := map[string]string{
+		"/same":    "1",
+		"/changed": "1",
+		"/gone":    "1",
+	}
+	updated, deleted := diffObjects("data", infos, etags, false)
+	require.Equal(t, 2, len(updated))
+	require.Equal(t, "data/changed", updated["/changed"].Name)
+	require.Equal(t, "data/new", updated["/new"].Name)
+	require.Equal(t, 0, len(deleted))
+
+	_, deleted = diffObjects("data", infos, etags, true)
+	require.Equal(t, []string{"/gone"}, deleted)
+}
+
+func TestObjectTick(t *testing.T) {
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	pachClient := env.PachClient
+	ctx := context.Background()
+	objClient, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	in := &pps.ObjectInput{Repo: "objects", Delete: true}
+	require.NoError(t, pachClient.CreateRepo(in.Repo))
+	require.NoError(t, pachClient.CreateBranch(in.Repo, ppsconsts.ObjectStateBranch, "", "", nil))
+
+	put := func(name, content string) {
+		require.NoError(t, objClient.Put(ctx, name, strings.NewReader(content)))
+	}
+	checkFiles := func(expected map[string]string) {
+		master := client.NewCommit(in.Repo, "master", "")
+		fis, err := pachClient.ListFileAll(master, "/")
+		require.NoError(t, err)
+		require.Equal(t, len(expected), len(fis))
+		for p, content := range expected {
+			buf := &bytes.Buffer{}
+			require.NoError(t, pachClient.GetFile(master, p, buf))
+			require.Equal(t, content, buf.String())
+		}
+		// The state branch records an ETag for exactly the ingested objects
+		etags, err := getObjectETags(pachClient, client.NewCommit(in.Repo, ppsconsts.ObjectStateBranch, ""))
+		require.NoError(t, err)
+		require.Equal(t, len(expected), len(etags))
+		for p := range expected {
+			_, ok := etags[p]
+			require.True(t, ok, "no ETag recorded for %s", p)
+		}
+	}
+
+	put("data/a", "a")
+	put("data/b", "b")
+	put("database/c", "c")
+	require.NoError(t, objectTick(ctx, pachClient, objClient, "data", in))
+	checkFiles(map[string]string{"/a": "a", "/b": "b"})
+
+	// Changing an object re-ingests it, and deleting one removes it
+	put("data/a", "changed")
+	require.NoError(t, objClient.Delete(ctx, "data/b"))
+	require.NoError(t, objectTick(ctx, pachClient, objClient, "data", in))
+	checkFiles(map[string]string{"/a": "changed"})
+
+	// Nothing changed, so no new commit is made
+	ci, err := pachClient.InspectCommit(in.Repo, "master", "")
+	require.NoError(t, err)
+	require.NoError(t, objectTick(ctx, pachClient, objClient, "data", in))
+	ci2, err := pachClient.InspectCommit(in.Repo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, ci.Commit.ID, ci2.Commit.ID)
+
+	// The data and its state are committed together
+	stateCI, err := pachClient.InspectCommit(in.Repo, ppsconsts.ObjectStateBranch, "")
+	require.NoError(t, err)
+	require.Equal(t, ci.Commit.ID, stateCI.Commit.ID)
+}
@@ -473,7 +473,7 @@ func rcIsFresh(pi *pps.PipelineInfo, rc *v1.ReplicationController) bool {
 }
 
 // startPipelineMonitor spawns a monitorPipeline() goro for this pipeline (if
-// one doesn't exist already), which manages standby and cron inputs, and
+// one doesn't exist already), which manages standby, cron and object inputs, and
 // updates the the pipeline state.
 // Note: this is called by every run through step(), so must be idempotent
 func (pc *pipelineController) startPipelineMonitor(pi *pps.PipelineInfo) {
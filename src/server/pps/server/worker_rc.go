@@ -42,6 +42,12 @@ const (
 	// UploadConcurrencyLimitEnvVar is the environment variable for the upload concurrency limit.
 	// EnvVar defined in src/internal/serviceenv/config.go
 	UploadConcurrencyLimitEnvVar = "STORAGE_UPLOAD_CONCURRENCY_LIMIT"
+	// ChunkCachePathEnvVar is the environment variable for the chunk disk cache path.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	ChunkCachePathEnvVar = "STORAGE_CHUNK_CACHE_PATH"
+	// ChunkCacheSizeEnvVar is the environment variable for the chunk disk cache size.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	ChunkCacheSizeEnvVar = "STORAGE_CHUNK_CACHE_SIZE"
 )
 
 // Parameters used when creating the kubernetes replication controller in charge
@@ -286,6 +292,24 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 		sidecarVolumeMounts = append(sidecarVolumeMounts, emptyDirVolumeMount)
 		userVolumeMounts = append(userVolumeMounts, emptyDirVolumeMount)
 	}
+	// The chunk disk cache is mounted from the host, so that the sidecars of
+	// the workers on a node share it.
+	if kd.config.StorageChunkCachePath != "" {
+		hostPathType := v1.HostPathDirectoryOrCreate
+		options.volumes = append(options.volumes, v1.Volume{
+			Name: "chunk-cache",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: kd.config.StorageChunkCachePath,
+					Type: &hostPathType,
+				},
+			},
+		})
+		sidecarVolumeMounts = append(sidecarVolumeMounts, v1.VolumeMount{
+			Name:      "chunk-cache",
+			MountPath: kd.config.StorageChunkCachePath,
+		})
+	}
 	secretVolume, secretMount := GetBackendSecretVolumeAndMount()
 	options.volumes = append(options.volumes, secretVolume)
 	sidecarVolumeMounts = append(sidecarVolumeMounts, secretMount)
@@ -491,6 +515,12 @@ func (kd *kubeDriver) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.Env
 		{Name: UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(kd.config.StorageUploadConcurrencyLimit)},
 		{Name: client.PPSPipelineNameEnv, Value: pipelineInfo.Pipeline.Name},
 	}
+	if kd.config.StorageChunkCachePath != "" {
+		vars = append(vars,
+			v1.EnvVar{Name: ChunkCachePathEnvVar, Value: kd.config.StorageChunkCachePath},
+			v1.EnvVar{Name: ChunkCacheSizeEnvVar, Value: strconv.FormatInt(kd.config.StorageChunkCacheSize, 10)},
+		)
+	}
 	return vars
 }
 
@@ -138,6 +138,7 @@ transaction' or cancelled with 'delete transaction'.`,
 	}
 	commands = append(commands, cmdutil.CreateAlias(stopTransaction, "stop transaction"))
 
+	var dryRun bool
 	finishTransaction := &cobra.Command{
 		Use:   "{{alias}} [<transaction>]",
 		Short: "Execute and clear the currently active transaction.",
@@ -161,6 +162,28 @@ transaction' or cancelled with 'delete transaction'.`,
 				}
 			}
 
+			if dryRun {
+				preview, err := c.DryRunTransaction(txn)
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					if err := cmdutil.Encoder(output, os.Stdout).EncodeProto(preview); err != nil {
+						return errors.EnsureStack(err)
+					}
+				} else if output != "" {
+					return errors.New("cannot set --output (-o) without --raw")
+				} else {
+					pretty.PrintTransactionPreview(os.Stdout, preview)
+				}
+				if !preview.Valid {
+					return errors.Errorf("transaction %s would fail", txn.ID)
+				}
+				return nil
+			} else if raw || output != "" {
+				return errors.New("--raw and --output (-o) can only be used with --dry-run")
+			}
+
 			info, err := c.FinishTransaction(txn)
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -175,6 +198,8 @@ transaction' or cancelled with 'delete transaction'.`,
 			return nil
 		}),
 	}
+	finishTransaction.Flags().BoolVar(&dryRun, "dry-run", false, "Run the transaction without applying it, and print the changes it would make. The transaction is left open.")
+	finishTransaction.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(finishTransaction, "finish transaction"))
 
 	deleteTransaction := &cobra.Command{
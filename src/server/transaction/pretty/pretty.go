@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -136,6 +137,82 @@ func sprintCreatePipeline(request *pps.CreatePipelineRequest) string {
 	return fmt.Sprintf("%s pipeline %s", verb, request.Pipeline.Name)
 }
 
+func sprintAddFileSet(request *pfs.AddFileSetRequest) string {
+	return fmt.Sprintf("add fileset %s to commit %s", request.FileSetId, pfspretty.CompactPrintCommit(request.Commit))
+}
+
+func sprintDeletePipeline(request *pps.DeletePipelineRequest) string {
+	flags := ""
+	if request.Force {
+		flags += " --force"
+	}
+	if request.KeepRepo {
+		flags += " --keep-repo"
+	}
+	return fmt.Sprintf("delete pipeline %s%s", request.Pipeline.GetName(), flags)
+}
+
+func sprintStopPipeline(request *pps.StopPipelineRequest) string {
+	return fmt.Sprintf("stop pipeline %s", request.Pipeline.GetName())
+}
+
+func sprintStartPipeline(request *pps.StartPipelineRequest) string {
+	return fmt.Sprintf("start pipeline %s", request.Pipeline.GetName())
+}
+
+func sprintDropCommitSet(request *pfs.DropCommitSetRequest) string {
+	return fmt.Sprintf("delete commitset %s", request.CommitSet.GetID())
+}
+
+func sprintCreateSecret(request *pps.CreateSecretRequest) string {
+	return "create secret"
+}
+
+func sprintModifyRoleBinding(request *auth.ModifyRoleBindingRequest) string {
+	roles := "none"
+	if len(request.Roles) > 0 {
+		roles = strings.Join(request.Roles, ",")
+	}
+	return fmt.Sprintf("set %s %s %s %s", strings.ToLower(request.Resource.GetType().String()), request.Resource.GetName(), roles, request.Principal)
+}
+
+func sprintRequest(request *transaction.TransactionRequest, response *transaction.TransactionResponse) string {
+	if request.CreateRepo != nil {
+		return sprintCreateRepo(request.CreateRepo)
+	} else if request.DeleteRepo != nil {
+		return sprintDeleteRepo(request.DeleteRepo)
+	} else if request.StartCommit != nil {
+		return sprintStartCommit(request.StartCommit, response)
+	} else if request.FinishCommit != nil {
+		return sprintFinishCommit(request.FinishCommit)
+	} else if request.SquashCommitSet != nil {
+		return sprintSquashCommitSet(request.SquashCommitSet)
+	} else if request.CreateBranch != nil {
+		return sprintCreateBranch(request.CreateBranch)
+	} else if request.DeleteBranch != nil {
+		return sprintDeleteBranch(request.DeleteBranch)
+	} else if request.UpdateJobState != nil {
+		return sprintUpdateJobState(request.UpdateJobState)
+	} else if request.CreatePipeline != nil {
+		return sprintCreatePipeline(request.CreatePipeline)
+	} else if request.AddFileSet != nil {
+		return sprintAddFileSet(request.AddFileSet)
+	} else if request.DeletePipeline != nil {
+		return sprintDeletePipeline(request.DeletePipeline)
+	} else if request.StopPipeline != nil {
+		return sprintStopPipeline(request.StopPipeline)
+	} else if request.StartPipeline != nil {
+		return sprintStartPipeline(request.StartPipeline)
+	} else if request.DropCommitSet != nil {
+		return sprintDropCommitSet(request.DropCommitSet)
+	} else if request.CreateSecret != nil {
+		return sprintCreateSecret(request.CreateSecret)
+	} else if request.ModifyRoleBinding != nil {
+		return sprintModifyRoleBinding(request.ModifyRoleBinding)
+	}
+	return "ERROR (unknown request type)"
+}
+
 func transactionRequests(
 	requests []*transaction.TransactionRequest,
 	responses []*transaction.TransactionResponse,
@@ -146,38 +223,66 @@ func transactionRequests(
 
 	lines := []string{}
 	for i, request := range requests {
-		var line string
-		if request.CreateRepo != nil {
-			line = sprintCreateRepo(request.CreateRepo)
-		} else if request.DeleteRepo != nil {
-			line = sprintDeleteRepo(request.DeleteRepo)
-		} else if request.StartCommit != nil {
-			if len(responses) > i {
-				line = sprintStartCommit(request.StartCommit, responses[i])
-			} else {
-				line = sprintStartCommit(request.StartCommit, nil)
-			}
-		} else if request.FinishCommit != nil {
-			line = sprintFinishCommit(request.FinishCommit)
-		} else if request.SquashCommitSet != nil {
-			line = sprintSquashCommitSet(request.SquashCommitSet)
-		} else if request.CreateBranch != nil {
-			line = sprintCreateBranch(request.CreateBranch)
-		} else if request.DeleteBranch != nil {
-			line = sprintDeleteBranch(request.DeleteBranch)
-		} else if request.UpdateJobState != nil {
-			line = sprintUpdateJobState(request.UpdateJobState)
-		} else if request.CreatePipeline != nil {
-			line = sprintCreatePipeline(request.CreatePipeline)
-		} else {
-			line = "ERROR (unknown request type)"
+		var response *transaction.TransactionResponse
+		if len(responses) > i {
+			response = responses[i]
 		}
-		lines = append(lines, fmt.Sprintf("  %s", line))
+		lines = append(lines, fmt.Sprintf("  %s", sprintRequest(request, response)))
 	}
 
 	return strings.Join(lines, "\n")
 }
 
+// PrintTransactionPreview prints the changes that a transaction would make,
+// as reported by DryRunTransaction, to the provided device.
+func PrintTransactionPreview(w io.Writer, preview *transaction.DryRunTransactionResponse) {
+	result := "would succeed"
+	if !preview.Valid {
+		result = "would fail"
+	}
+	fmt.Fprintf(w, "Transaction %s %s\n", preview.Transaction.GetID(), result)
+	for i, p := range preview.Requests {
+		fmt.Fprintf(w, "  %d. %s\n", i+1, sprintRequest(p.Request, p.Response))
+		printPreviewChanges(w, p)
+	}
+	if preview.Finish != nil {
+		fmt.Fprintf(w, "  finish transaction\n")
+		printPreviewChanges(w, preview.Finish)
+	}
+}
+
+func printPreviewChanges(w io.Writer, p *transaction.TransactionPreview) {
+	printList := func(label string, items []string) {
+		if len(items) > 0 {
+			fmt.Fprintf(w, "       %s: %s\n", label, strings.Join(items, ", "))
+		}
+	}
+	var commits, branches, created, updated, jobs []string
+	for _, c := range p.CommitsCreated {
+		commits = append(commits, pfspretty.CompactPrintCommit(c))
+	}
+	for _, b := range p.BranchesMoved {
+		branches = append(branches, fmt.Sprintf("%s@%s", b.Repo, b.Name))
+	}
+	for _, pipeline := range p.PipelinesCreated {
+		created = append(created, pipeline.Name)
+	}
+	for _, pipeline := range p.PipelinesUpdated {
+		updated = append(updated, pipeline.Name)
+	}
+	for _, j := range p.JobsCreated {
+		jobs = append(jobs, fmt.Sprintf("%s@%s", j.Pipeline.GetName(), j.ID))
+	}
+	printList("commits created", commits)
+	printList("branches moved", branches)
+	printList("pipelines created", created)
+	printList("pipelines updated", updated)
+	printList("jobs created", jobs)
+	if p.Error != "" {
+		fmt.Fprintf(w, "       error: %s\n", p.Error)
+	}
+}
+
 var funcMap = template.FuncMap{
 	"prettyAgo":           pretty.Ago,
 	"prettySize":          pretty.Size,
@@ -62,12 +62,22 @@ func (a *apiServer) FinishTransaction(ctx context.Context, request *transaction.
 	return a.driver.finishTransaction(ctx, request.Transaction)
 }
 
+func (a *apiServer) DryRunTransaction(ctx context.Context, request *transaction.DryRunTransactionRequest) (response *transaction.DryRunTransactionResponse, retErr error) {
+	return a.driver.dryRunTransaction(ctx, request.Transaction)
+}
+
 func (a *apiServer) DeleteAll(ctx context.Context, request *transaction.DeleteAllRequest) (response *types.Empty, retErr error) {
+	var deleted []*transaction.TransactionInfo
 	if err := dbutil.WithTx(ctx, a.driver.db, func(sqlTx *pachsql.Tx) error {
-		return a.driver.deleteAll(ctx, sqlTx, nil)
+		var err error
+		deleted, err = a.driver.deleteAll(ctx, sqlTx, nil)
+		return err
 	}); err != nil {
 		return nil, err
 	}
+	for _, info := range deleted {
+		a.driver.unpinFileSets(ctx, info.Requests)
+	}
 
 	return &types.Empty{}, nil
 }
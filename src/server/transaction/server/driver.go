@@ -6,12 +6,15 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/sirupsen/logrus"
 
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactiondb"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
@@ -21,11 +24,17 @@ import (
 )
 
 type driver struct {
+	env serviceenv.ServiceEnv
 	// txnEnv stores references to other pachyderm APIServer instances so we can
 	// make calls within the same transaction without serializing through RPCs
 	txnEnv       *txnenv.TransactionEnv
 	db           *pachsql.DB
 	transactions col.PostgresCollection
+	// the collections that a dry run reports changes to
+	commits   col.PostgresCollection
+	branches  col.PostgresCollection
+	pipelines col.PostgresCollection
+	jobs      col.PostgresCollection
 }
 
 func newDriver(
@@ -34,9 +43,14 @@ func newDriver(
 ) (*driver, error) {
 
 	return &driver{
+		env:          env,
 		txnEnv:       txnEnv,
 		db:           env.GetDBClient(),
 		transactions: transactiondb.Transactions(env.GetDBClient(), env.GetPostgresListener()),
+		commits:      pfsdb.Commits(env.GetDBClient(), env.GetPostgresListener()),
+		branches:     pfsdb.Branches(env.GetDBClient(), env.GetPostgresListener()),
+		pipelines:    ppsdb.Pipelines(env.GetDBClient(), env.GetPostgresListener()),
+		jobs:         ppsdb.Jobs(env.GetDBClient(), env.GetPostgresListener()),
 	}, nil
 }
 
@@ -101,7 +115,19 @@ func (d *driver) inspectTransaction(ctx context.Context, txn *transaction.Transa
 
 func (d *driver) deleteTransaction(ctx context.Context, txn *transaction.Transaction) error {
 	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return errors.EnsureStack(d.transactions.ReadWrite(txnCtx.SqlTx).Delete(txn.ID))
+		info := &transaction.TransactionInfo{}
+		transactions := d.transactions.ReadWrite(txnCtx.SqlTx)
+		if err := transactions.Get(txn.ID, info); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := transactions.Delete(txn.ID); err != nil {
+			return errors.EnsureStack(err)
+		}
+		txnCtx.PostCommit(func(ctx context.Context) error {
+			d.unpinFileSets(ctx, info.Requests)
+			return nil
+		})
+		return nil
 	})
 }
 
@@ -119,23 +145,63 @@ func (d *driver) listTransaction(ctx context.Context) ([]*transaction.Transactio
 }
 
 // deleteAll deletes all transactions from etcd except the currently running
-// transaction (if any).
-func (d *driver) deleteAll(ctx context.Context, sqlTx *pachsql.Tx, running *transaction.Transaction) error {
+// transaction (if any), and returns the deleted transactions.
+func (d *driver) deleteAll(ctx context.Context, sqlTx *pachsql.Tx, running *transaction.Transaction) ([]*transaction.TransactionInfo, error) {
 	txns, err := d.listTransaction(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var deleted []*transaction.TransactionInfo
 	transactions := d.transactions.ReadWrite(sqlTx)
 	for _, info := range txns {
 		if running == nil || info.Transaction.ID != running.ID {
 			err := transactions.Delete(info.Transaction.ID)
 			if err != nil {
-				return errors.EnsureStack(err)
+				return nil, errors.EnsureStack(err)
+			}
+			deleted = append(deleted, info)
+		}
+	}
+	return deleted, nil
+}
+
+// pinFileSets pins the file sets added by 'requests', so that they aren't
+// deleted while the transaction is open, and returns the requests with the
+// pinned file sets. The file sets are unpinned by unpinFileSets once the
+// transaction is finished or deleted.
+func (d *driver) pinFileSets(ctx context.Context, requests []*transaction.TransactionRequest) (_ []*transaction.TransactionRequest, retErr error) {
+	var result []*transaction.TransactionRequest
+	defer func() {
+		if retErr != nil {
+			d.unpinFileSets(ctx, result)
+		}
+	}()
+	for _, request := range requests {
+		if request.AddFileSet != nil {
+			request = proto.Clone(request).(*transaction.TransactionRequest)
+			id, err := d.env.PfsServer().PinFileSet(ctx, request.AddFileSet.FileSetId)
+			if err != nil {
+				return nil, errors.EnsureStack(err)
 			}
+			request.AddFileSet.FileSetId = id
+		}
+		result = append(result, request)
+	}
+	return result, nil
+}
+
+// unpinFileSets unpins the file sets pinned by pinFileSets. Errors are only
+// logged, since the transaction has already been finished or deleted.
+func (d *driver) unpinFileSets(ctx context.Context, requests []*transaction.TransactionRequest) {
+	for _, request := range requests {
+		if request.AddFileSet == nil {
+			continue
+		}
+		if err := d.env.PfsServer().UnpinFileSet(ctx, request.AddFileSet.FileSetId); err != nil {
+			logrus.Errorf("error unpinning file set %s: %v", request.AddFileSet.FileSetId, err)
 		}
 	}
-	return nil
 }
 
 func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *transaction.TransactionInfo) (*transaction.TransactionInfo, error) {
@@ -150,36 +216,114 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 
 	directTxn := txnenv.NewDirectTransaction(d.txnEnv, txnCtx)
 	for i, request := range info.Requests {
-		var err error
-		response := result.Responses[i]
-
-		if request.CreateRepo != nil {
-			err = directTxn.CreateRepo(request.CreateRepo)
-		} else if request.DeleteRepo != nil {
-			err = directTxn.DeleteRepo(request.DeleteRepo)
-		} else if request.StartCommit != nil {
-			response.Commit, err = directTxn.StartCommit(request.StartCommit)
-		} else if request.FinishCommit != nil {
-			err = directTxn.FinishCommit(request.FinishCommit)
-		} else if request.SquashCommitSet != nil {
-			err = directTxn.SquashCommitSet(request.SquashCommitSet)
-		} else if request.CreateBranch != nil {
-			err = directTxn.CreateBranch(request.CreateBranch)
-		} else if request.DeleteBranch != nil {
-			err = directTxn.DeleteBranch(request.DeleteBranch)
-		} else if request.UpdateJobState != nil {
-			err = directTxn.UpdateJobState(request.UpdateJobState)
-		} else if request.StopJob != nil {
-			err = directTxn.StopJob(request.StopJob)
-		} else if request.CreatePipeline != nil {
-			err = directTxn.CreatePipeline(request.CreatePipeline)
-		} else {
-			err = errors.New("unrecognized transaction request type")
+		if err := runRequest(directTxn, request, result.Responses[i]); err != nil {
+			return result, errors.Wrapf(err, "error running request %d of %d", i+1, len(info.Requests))
 		}
+	}
+	return result, nil
+}
 
-		if err != nil {
-			return result, errors.Wrapf(err, "error running request %d of %d", i+1, len(info.Requests))
+// runRequest runs a single request of a transaction through 'directTxn',
+// storing its result in 'response'
+func runRequest(directTxn txnenv.Transaction, request *transaction.TransactionRequest, response *transaction.TransactionResponse) error {
+	var err error
+	if request.CreateRepo != nil {
+		err = directTxn.CreateRepo(request.CreateRepo)
+	} else if request.DeleteRepo != nil {
+		err = directTxn.DeleteRepo(request.DeleteRepo)
+	} else if request.StartCommit != nil {
+		response.Commit, err = directTxn.StartCommit(request.StartCommit)
+	} else if request.FinishCommit != nil {
+		err = directTxn.FinishCommit(request.FinishCommit)
+	} else if request.SquashCommitSet != nil {
+		err = directTxn.SquashCommitSet(request.SquashCommitSet)
+	} else if request.CreateBranch != nil {
+		err = directTxn.CreateBranch(request.CreateBranch)
+	} else if request.DeleteBranch != nil {
+		err = directTxn.DeleteBranch(request.DeleteBranch)
+	} else if request.UpdateJobState != nil {
+		err = directTxn.UpdateJobState(request.UpdateJobState)
+	} else if request.StopJob != nil {
+		err = directTxn.StopJob(request.StopJob)
+	} else if request.CreatePipeline != nil {
+		err = directTxn.CreatePipeline(request.CreatePipeline)
+	} else if request.AddFileSet != nil {
+		err = directTxn.AddFileSet(request.AddFileSet)
+	} else if request.DeletePipeline != nil {
+		err = directTxn.DeletePipeline(request.DeletePipeline)
+	} else if request.StopPipeline != nil {
+		err = directTxn.StopPipeline(request.StopPipeline)
+	} else if request.StartPipeline != nil {
+		err = directTxn.StartPipeline(request.StartPipeline)
+	} else if request.DropCommitSet != nil {
+		err = directTxn.DropCommitSet(request.DropCommitSet)
+	} else if request.CreateSecret != nil {
+		err = directTxn.CreateSecret(request.CreateSecret)
+	} else if request.ModifyRoleBinding != nil {
+		_, err = directTxn.ModifyRoleBinding(request.ModifyRoleBinding)
+	} else {
+		err = errors.New("unrecognized transaction request type")
+	}
+	return err
+}
+
+// errDryRunFailed is returned to roll back a dry run after one of its
+// requests has failed
+var errDryRunFailed = errors.New("transaction dry run failed")
+
+// dryRunTransaction runs the requests in 'txn' in a postgres transaction that
+// is rolled back, and reports the changes made by each of them, and by
+// finishing the transaction.
+func (d *driver) dryRunTransaction(ctx context.Context, txn *transaction.Transaction) (*transaction.DryRunTransactionResponse, error) {
+	var result *transaction.DryRunTransactionResponse
+	var tracker *changeTracker
+	var ranRequests bool
+	if err := d.txnEnv.WithDryRunContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		ranRequests = false
+		info := &transaction.TransactionInfo{}
+		if err := d.transactions.ReadWrite(txnCtx.SqlTx).Get(txn.ID, info); err != nil {
+			return errors.EnsureStack(err)
+		}
+		result = &transaction.DryRunTransactionResponse{Transaction: info.Transaction}
+		tracker = newChangeTracker(ctx, d, txnCtx)
+
+		// Use the same CommitSetID as finishing the transaction would
+		txnCtx.CommitSetID = info.Transaction.ID
+		directTxn := txnenv.NewDirectTransaction(d.txnEnv, txnCtx)
+		for _, request := range info.Requests {
+			preview := &transaction.TransactionPreview{
+				Request:  request,
+				Response: &transaction.TransactionResponse{},
+			}
+			result.Requests = append(result.Requests, preview)
+			if err := runRequest(directTxn, request, preview.Response); err != nil {
+				preview.Error = err.Error()
+				return errDryRunFailed
+			}
+			if err := tracker.update(preview); err != nil {
+				return err
+			}
+		}
+		ranRequests = true
+		return nil
+	}, func(txnCtx *txncontext.TransactionContext) error {
+		ranRequests = false
+		result.Finish = &transaction.TransactionPreview{}
+		if err := tracker.update(result.Finish); err != nil {
+			return err
 		}
+		result.Valid = true
+		return nil
+	}); err != nil {
+		if errors.Is(err, errDryRunFailed) {
+			return result, nil
+		}
+		if ranRequests {
+			// the requests succeeded, but finishing the transaction failed
+			result.Finish = &transaction.TransactionPreview{Error: err.Error()}
+			return result, nil
+		}
+		return nil, err
 	}
 	return result, nil
 }
@@ -193,6 +337,12 @@ func (d *driver) finishTransaction(ctx context.Context, txn *transaction.Transac
 		if err := d.transactions.ReadWrite(txnCtx.SqlTx).Delete(txn.ID); err != nil {
 			return info, errors.EnsureStack(err)
 		}
+		// the added file sets are referenced by their commits now
+		requests := info.Requests
+		txnCtx.PostCommit(func(ctx context.Context) error {
+			d.unpinFileSets(ctx, requests)
+			return nil
+		})
 		// no need to update the transaction, since it's gone
 		// because the transaction info was read in the same sql transaction as the delete,
 		// we don't have to worry about checking for additional transaction changes
@@ -216,12 +366,21 @@ func (d *driver) appendTransaction(
 	// 1. make sure the appended request is valid
 	// 2. Capture the result of the request to be returned
 
-	return d.updateTransaction(ctx, false, txn, func(txnCtx *txncontext.TransactionContext, info *transaction.TransactionInfo, restarted bool) (*transaction.TransactionInfo, error) {
+	items, err := d.pinFileSets(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	info, err := d.updateTransaction(ctx, false, txn, func(txnCtx *txncontext.TransactionContext, info *transaction.TransactionInfo, restarted bool) (*transaction.TransactionInfo, error) {
 		if restarted {
 			info.Requests = append(info.Requests, items...)
 		}
 		return d.runTransaction(txnCtx, info)
 	})
+	if err != nil {
+		d.unpinFileSets(ctx, items)
+		return nil, err
+	}
+	return info, nil
 }
 
 // updateTransaction accepts a function that uses and (possibly) updates a transaction and runs it
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/transaction"
+)
+
+// changeTracker finds the commits, branches, pipelines and jobs written in a
+// transaction since it was last updated, so that the changes can be
+// attributed to the request that made them. Rows are compared with the
+// committed state of the cluster the first time they're written, to tell
+// new rows from modified ones.
+type changeTracker struct {
+	ctx    context.Context
+	d      *driver
+	txnCtx *txncontext.TransactionContext
+
+	commits     map[string]bool
+	branchHeads map[string]string
+	pipelines   map[string]*pps.PipelineInfo
+	jobs        map[string]bool
+}
+
+func newChangeTracker(ctx context.Context, d *driver, txnCtx *txncontext.TransactionContext) *changeTracker {
+	return &changeTracker{
+		ctx:         ctx,
+		d:           d,
+		txnCtx:      txnCtx,
+		commits:     make(map[string]bool),
+		branchHeads: make(map[string]string),
+		pipelines:   make(map[string]*pps.PipelineInfo),
+		jobs:        make(map[string]bool),
+	}
+}
+
+// update adds the changes written since it was last called to 'preview'
+func (t *changeTracker) update(preview *transaction.TransactionPreview) error {
+	commitInfo := &pfs.CommitInfo{}
+	if err := t.d.commits.ReadWrite(t.txnCtx.SqlTx).ListModified(commitInfo, func(key string) error {
+		if _, ok := t.commits[key]; ok {
+			return nil
+		}
+		existed, err := t.existed(t.d.commits, key, &pfs.CommitInfo{})
+		if err != nil {
+			return err
+		}
+		t.commits[key] = true
+		if !existed {
+			preview.CommitsCreated = append(preview.CommitsCreated, commitInfo.Commit)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+
+	branchInfo := &pfs.BranchInfo{}
+	if err := t.d.branches.ReadWrite(t.txnCtx.SqlTx).ListModified(branchInfo, func(key string) error {
+		head := ""
+		if branchInfo.Head != nil {
+			head = pfsdb.CommitKey(branchInfo.Head)
+		}
+		prevHead, ok := t.branchHeads[key]
+		if !ok {
+			prevInfo := &pfs.BranchInfo{}
+			existed, err := t.existed(t.d.branches, key, prevInfo)
+			if err != nil {
+				return err
+			}
+			if existed && prevInfo.Head != nil {
+				prevHead = pfsdb.CommitKey(prevInfo.Head)
+			}
+			ok = existed
+		}
+		t.branchHeads[key] = head
+		if !ok || head != prevHead {
+			preview.BranchesMoved = append(preview.BranchesMoved, branchInfo.Branch)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+
+	pipelineInfo := &pps.PipelineInfo{}
+	if err := t.d.pipelines.ReadWrite(t.txnCtx.SqlTx).ListModified(pipelineInfo, func(key string) error {
+		prev, ok := t.pipelines[key]
+		if ok && proto.Equal(prev, pipelineInfo) {
+			return nil
+		}
+		t.pipelines[key] = proto.Clone(pipelineInfo).(*pps.PipelineInfo)
+		if !ok {
+			existed, err := t.existed(t.d.pipelines, key, &pps.PipelineInfo{})
+			if err != nil {
+				return err
+			}
+			if !existed && pipelineInfo.Version <= 1 {
+				preview.PipelinesCreated = append(preview.PipelinesCreated, pipelineInfo.Pipeline)
+				return nil
+			}
+		}
+		preview.PipelinesUpdated = append(preview.PipelinesUpdated, pipelineInfo.Pipeline)
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+
+	jobInfo := &pps.JobInfo{}
+	if err := t.d.jobs.ReadWrite(t.txnCtx.SqlTx).ListModified(jobInfo, func(key string) error {
+		if _, ok := t.jobs[key]; ok {
+			return nil
+		}
+		existed, err := t.existed(t.d.jobs, key, &pps.JobInfo{})
+		if err != nil {
+			return err
+		}
+		t.jobs[key] = true
+		if !existed {
+			preview.JobsCreated = append(preview.JobsCreated, jobInfo.Job)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// existed reads 'key' from the committed state of 'c' into 'val', and
+// returns whether it was found
+func (t *changeTracker) existed(c col.PostgresCollection, key string, val proto.Message) (bool, error) {
+	if err := c.ReadOnly(t.ctx).Get(key, val); err != nil {
+		if col.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, errors.EnsureStack(err)
+	}
+	return true, nil
+}
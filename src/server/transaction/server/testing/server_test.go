@@ -6,8 +6,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/minikubetestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
@@ -470,6 +472,95 @@ func TestTransactions(suite *testing.T) {
 			}
 		}
 	})
+
+	suite.Run("TestAddFileSetTransaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("foo"))
+		resp, err := env.PachClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+			return errors.EnsureStack(mf.PutFile("bar", strings.NewReader("baz")))
+		})
+		require.NoError(t, err)
+
+		var commit *pfs.Commit
+		_, err = env.PachClient.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+			var err error
+			commit, err = txnClient.StartCommit("foo", "master")
+			if err != nil {
+				return err
+			}
+			if err := txnClient.AddFileSet("foo", "master", commit.ID, resp.FileSetId); err != nil {
+				return err
+			}
+			return txnClient.FinishCommit("foo", "master", commit.ID)
+		})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit, "bar", &buf))
+		require.Equal(t, "baz", buf.String())
+	})
+
+	suite.Run("TestDropCommitSetTransaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("foo"))
+		commit, err := env.PachClient.StartCommit("foo", "master")
+		require.NoError(t, err)
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+		require.NoError(t, txnClient.DropCommitSet(commit.ID))
+
+		// the commit is only dropped once the transaction is finished
+		_, err = env.PachClient.InspectCommit("foo", "", commit.ID)
+		require.NoError(t, err)
+
+		info, err := env.PachClient.FinishTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(info.Requests))
+		_, err = env.PachClient.InspectCommit("foo", "", commit.ID)
+		require.YesError(t, err)
+	})
+	suite.Run("TestDryRunTransaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+		require.NoError(t, txnClient.CreateRepo("foo"))
+		commit, err := txnClient.StartCommit("foo", "master")
+		require.NoError(t, err)
+
+		preview, err := env.PachClient.DryRunTransaction(txn)
+		require.NoError(t, err)
+		require.True(t, preview.Valid)
+		require.Equal(t, 2, len(preview.Requests))
+		require.Equal(t, "", preview.Requests[1].Error)
+		require.Equal(t, 1, len(preview.Requests[1].CommitsCreated))
+		require.Equal(t, commit.ID, preview.Requests[1].CommitsCreated[0].ID)
+		require.Equal(t, 1, len(preview.Requests[1].BranchesMoved))
+		require.Equal(t, "master", preview.Requests[1].BranchesMoved[0].Name)
+
+		// nothing is applied, and the transaction is left open
+		_, err = env.PachClient.InspectRepo("foo")
+		require.YesError(t, err)
+		_, err = env.PachClient.InspectTransaction(txn)
+		require.NoError(t, err)
+
+		// a conflicting change outside of the transaction is reported against
+		// the request it breaks
+		require.NoError(t, env.PachClient.CreateRepo("foo"))
+		preview, err = env.PachClient.DryRunTransaction(txn)
+		require.NoError(t, err)
+		require.False(t, preview.Valid)
+		require.Equal(t, 1, len(preview.Requests))
+		require.True(t, strings.Contains(preview.Requests[0].Error, "already exists"))
+	})
 }
 
 func TestCreatePipelineTransaction(t *testing.T) {
@@ -502,3 +593,133 @@ func TestCreatePipelineTransaction(t *testing.T) {
 	require.NoError(t, c.GetFile(commitInfo.Commit, "foo", &buf))
 	require.Equal(t, "bar", buf.String())
 }
+
+func TestStopAndDeletePipelineTransaction(t *testing.T) {
+	c, _ := minikubetestenv.AcquireCluster(t)
+	repo := testutil.UniqueString("in")
+	pipeline := testutil.UniqueString("pipeline")
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out", repo)},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/"),
+		"master",
+		false,
+	))
+
+	// stopping and restarting in the same transaction leaves the pipeline running
+	_, err := c.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+		if err := txnClient.StopPipeline(pipeline); err != nil {
+			return err
+		}
+		return txnClient.StartPipeline(pipeline)
+	})
+	require.NoError(t, err)
+	pipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.False(t, pipelineInfo.Stopped)
+
+	// a failed request rolls back the whole transaction
+	_, err = c.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+		require.NoError(t, builder.StopPipeline(pipeline))
+		require.NoError(t, builder.CreateRepo(repo))
+		return nil
+	})
+	require.YesError(t, err)
+	pipelineInfo, err = c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.False(t, pipelineInfo.Stopped)
+
+	_, err = c.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+		if err := txnClient.DeletePipeline(pipeline, false); err != nil {
+			return err
+		}
+		return txnClient.DeleteRepo(repo, false)
+	})
+	require.NoError(t, err)
+	_, err = c.InspectPipeline(pipeline, false)
+	require.YesError(t, err)
+	_, err = c.InspectRepo(repo)
+	require.YesError(t, err)
+}
+
+func TestCreateSecretTransaction(t *testing.T) {
+	c, _ := minikubetestenv.AcquireCluster(t)
+	repo := testutil.UniqueString("repo")
+	require.NoError(t, c.CreateRepo(repo))
+	secret := testutil.UniqueString("secret")
+	b := []byte(fmt.Sprintf(`{
+		"kind": "Secret",
+		"apiVersion": "v1",
+		"metadata": {"name": %q},
+		"data": {"mykey": "bXktdmFsdWU="}
+	}`, secret))
+
+	// the secret is deleted when a later request fails
+	_, err := c.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+		require.NoError(t, builder.CreateSecret(b))
+		require.NoError(t, builder.CreateRepo(repo))
+		return nil
+	})
+	require.YesError(t, err)
+	_, err = c.InspectSecret(secret)
+	require.YesError(t, err)
+
+	// the secret is only created once the transaction is finished
+	txn, err := c.StartTransaction()
+	require.NoError(t, err)
+	require.NoError(t, c.WithTransaction(txn).CreateSecret(b))
+	_, err = c.InspectSecret(secret)
+	require.YesError(t, err)
+	_, err = c.FinishTransaction(txn)
+	require.NoError(t, err)
+	secretInfo, err := c.InspectSecret(secret)
+	require.NoError(t, err)
+	require.Equal(t, secret, secretInfo.Secret.Name)
+	require.NoError(t, c.DeleteSecret(secret))
+
+	// invalid secrets are rejected when they're added to the transaction
+	txn, err = c.StartTransaction()
+	require.NoError(t, err)
+	require.YesError(t, c.WithTransaction(txn).CreateSecret([]byte(`{"kind": "Secret", "apiVersion": "v1"}`)))
+	require.NoError(t, c.DeleteTransaction(txn))
+}
+
+func TestModifyRoleBindingTransaction(t *testing.T) {
+	c, _ := minikubetestenv.AcquireCluster(t)
+	testutil.ActivateAuthClient(t, c)
+	alice := auth.RobotPrefix + testutil.UniqueString("alice")
+	bob := auth.RobotPrefix + testutil.UniqueString("bob")
+	aliceClient := testutil.AuthenticateClient(t, c, alice)
+	repo := testutil.UniqueString("repo")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	bobRoles := func() []string {
+		binding, err := aliceClient.GetRepoRoleBinding(repo)
+		require.NoError(t, err)
+		var roles []string
+		if entry, ok := binding.Entries[bob]; ok {
+			for role := range entry.Roles {
+				roles = append(roles, role)
+			}
+		}
+		return roles
+	}
+
+	// the role binding isn't modified when a later request fails
+	_, err := aliceClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+		require.NoError(t, builder.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoReaderRole}))
+		require.NoError(t, builder.CreateRepo(repo))
+		return nil
+	})
+	require.YesError(t, err)
+	require.Equal(t, 0, len(bobRoles()))
+
+	_, err = aliceClient.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+		return txnClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoReaderRole})
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{auth.RepoReaderRole}, bobRoles())
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
@@ -27,6 +28,7 @@ import (
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	workerStats "github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -43,6 +45,10 @@ const (
 	defaultNumRetries = 3
 )
 
+// scratchSampleInterval is how often the scratch usage of a running datum is
+// measured to find its peak.
+var scratchSampleInterval = 5 * time.Second
+
 // SetSpec specifies criteria for creating datum sets.
 type SetSpec struct {
 	Number    int64
@@ -120,6 +126,7 @@ type Set struct {
 	cacheClient                       *pfssync.CacheClient
 	storageRoot                       string
 	metaOutputClient, pfsOutputClient client.ModifyFile
+	deadLetterOutputClient            client.ModifyFile
 	stats                             *Stats
 }
 
@@ -185,6 +192,7 @@ type Datum struct {
 	storageRoot      string
 	numRetries       int
 	recoveryCallback func(context.Context) error
+	logTail          func() []string
 	timeout          time.Duration
 	IDPrefix         string
 }
@@ -223,6 +231,9 @@ func (d *Datum) finish(err error) (retErr error) {
 	}()
 	if err != nil {
 		d.handleFailed(err)
+		if err := d.uploadDeadLetter(err); err != nil {
+			return err
+		}
 		return d.uploadMetaOutput()
 	}
 	d.set.stats.Processed++
@@ -242,6 +253,33 @@ func (d *Datum) handleFailed(err error) {
 	}
 }
 
+// uploadDeadLetter records a failed datum in the dead-letter output, keyed by
+// the datum ID.
+func (d *Datum) uploadDeadLetter(err error) error {
+	if d.set.deadLetterOutputClient == nil || d.meta.State != State_FAILED {
+		return nil
+	}
+	deadLetter := &DeadLetter{
+		Job:      d.meta.Job,
+		Inputs:   d.meta.Inputs,
+		Reason:   d.meta.Reason,
+		ExitCode: -1,
+	}
+	exitErr := &exec.ExitError{}
+	if errors.As(err, &exitErr) {
+		deadLetter.ExitCode = int64(exitErr.ExitCode())
+	}
+	if d.logTail != nil {
+		deadLetter.LogLines = d.logTail()
+	}
+	marshaler := &jsonpb.Marshaler{}
+	buf := &bytes.Buffer{}
+	if err := marshaler.Marshal(buf, deadLetter); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(d.set.deadLetterOutputClient.PutFile(d.ID, buf, client.WithDatumPutFile(d.ID)))
+}
+
 func (d *Datum) withData(cb func() error) (retErr error) {
 	// Setup and defer cleanup of pfs directory.
 	if err := os.MkdirAll(path.Join(d.PFSStorageRoot(), OutputPrefix), 0777); err != nil {
@@ -298,11 +336,21 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 	return nil
 }
 
+// ProcessStats returns the process stats of the datum, which the callback
+// passed to Run may use to record the resources consumed by the user code.
+func (d *Datum) ProcessStats() *pps.ProcessStats {
+	return d.meta.Stats
+}
+
 // Run provides a scoped environment for the processing of a datum.
 func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) error {
 	start := time.Now()
+	stopSampling := d.sampleScratchUsage()
 	defer func() {
 		d.meta.Stats.ProcessTime = types.DurationProto(time.Since(start))
+		if scratchBytes := stopSampling(); scratchBytes > d.meta.Stats.ScratchBytes {
+			d.meta.Stats.ScratchBytes = scratchBytes
+		}
 	}()
 	if d.timeout > 0 {
 		timeoutCtx, cancel := context.WithTimeout(ctx, d.timeout)
@@ -327,6 +375,93 @@ func (d *Datum) run(ctx context.Context, cb func(ctx context.Context) error) (re
 	return cb(ctx)
 }
 
+// sampleScratchUsage measures the datum's scratch usage every
+// scratchSampleInterval until the returned function is called, which measures
+// it once more and returns the peak.
+func (d *Datum) sampleScratchUsage() func() int64 {
+	var peak int64
+	var logged bool
+	measure := func() {
+		size, err := d.scratchUsage()
+		if err != nil {
+			// the usage is only informational, so it doesn't fail the datum
+			if !logged {
+				logrus.Warnf("error measuring the scratch usage of datum %v: %v", d.ID, err)
+				logged = true
+			}
+			return
+		}
+		if size > peak {
+			peak = size
+		}
+	}
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(scratchSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				measure()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() int64 {
+		close(done)
+		<-stopped
+		measure()
+		return peak
+	}
+}
+
+// scratchUsage returns the disk usage of the datum's inputs and output. Only
+// the datum's own directories are measured, so that nothing else sharing its
+// storage root is counted.
+func (d *Datum) scratchUsage() (int64, error) {
+	root := d.PFSStorageRoot()
+	dirs := []string{path.Join(root, OutputPrefix)}
+	seen := make(map[string]bool)
+	for _, input := range d.meta.Inputs {
+		if input.Name == "" || input.S3 || seen[input.Name] {
+			continue
+		}
+		seen[input.Name] = true
+		dirs = append(dirs, path.Join(root, input.Name))
+	}
+	var size int64
+	for _, dir := range dirs {
+		dirSize, err := diskUsage(dir)
+		if err != nil {
+			return 0, err
+		}
+		size += dirSize
+	}
+	return size, nil
+}
+
+// diskUsage returns the total size of the regular files under root. Symlinks
+// aren't followed. Files removed while they're walked, such as temporary
+// files of the user code, aren't counted.
+func diskUsage(root string) (int64, error) {
+	var size int64
+	err := filepath.Walk(root, func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if fi.Mode().IsRegular() {
+			size += fi.Size()
+		}
+		return nil
+	})
+	return size, errors.EnsureStack(err)
+}
+
 func (d *Datum) uploadMetaOutput() (retErr error) {
 	if d.set.metaOutputClient != nil {
 		// Setup and defer cleanup of meta directory.
@@ -416,10 +551,19 @@ func (d *Datum) handleSymlinks(mf client.ModifyFile, storageRoot string) error {
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
+		linkPath := file
 		file, err = os.Readlink(file)
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
+		// Preserve symlinks that point within the uploaded directory, since
+		// their targets are uploaded as well.
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(filepath.Dir(linkPath), file)
+		}
+		if strings.HasPrefix(file, storageRoot+string(os.PathSeparator)) {
+			return d.uploadLink(mf, dstPath, linkPath, file, fi)
+		}
 		fi, err = os.Stat(file)
 		if err != nil {
 			return errors.EnsureStack(err)
@@ -450,6 +594,19 @@ func (d *Datum) handleSymlinks(mf client.ModifyFile, storageRoot string) error {
 	return errors.EnsureStack(err)
 }
 
+func (d *Datum) uploadLink(mf client.ModifyFile, dstPath, linkPath, target string, fi os.FileInfo) error {
+	attrs, err := pfs.AttributesFromFileInfo(linkPath, fi)
+	if err != nil {
+		return err
+	}
+	attrs.SymlinkTarget, err = filepath.Rel(filepath.Dir(linkPath), target)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	err = mf.PutFile(dstPath, &bytes.Buffer{}, client.WithDatumPutFile(d.ID), client.WithAttributesPutFile(attrs))
+	return errors.EnsureStack(err)
+}
+
 func (d *Datum) uploadSymlink(mf client.ModifyFile, dstPath, file string, fi os.FileInfo) error {
 	cb := func(dstPath, file string) (retErr error) {
 		f, err := os.Open(file)
@@ -235,44 +235,129 @@ func (m *Stats) GetFailedID() string {
 	return ""
 }
 
+// DeadLetter records a datum that was quarantined after exhausting its tries.
+type DeadLetter struct {
+	Job                  *pps.Job        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Inputs               []*common.Input `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Reason               string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExitCode             int64           `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	LogLines             []string        `protobuf:"bytes,5,rep,name=log_lines,json=logLines,proto3" json:"log_lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96ec7427544ac634, []int{2}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetJob() *pps.Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetInputs() []*common.Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetExitCode() int64 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetLogLines() []string {
+	if m != nil {
+		return m.LogLines
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("datum.State", State_name, State_value)
 	proto.RegisterType((*Meta)(nil), "datum.Meta")
 	proto.RegisterType((*Stats)(nil), "datum.Stats")
+	proto.RegisterType((*DeadLetter)(nil), "datum.DeadLetter")
 }
 
 func init() { proto.RegisterFile("server/worker/datum/datum.proto", fileDescriptor_96ec7427544ac634) }
 
 var fileDescriptor_96ec7427544ac634 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0x9e, 0xe2, 0xd8, 0xad, 0x95, 0x64, 0x04, 0x11, 0x86, 0x28, 0x5b, 0xea, 0x05, 0x06, 0x5e,
-	0x0f, 0x36, 0xf3, 0x4e, 0x3d, 0xae, 0x8d, 0x3b, 0x32, 0x36, 0x5a, 0x14, 0xd8, 0x61, 0x97, 0xe0,
-	0x58, 0x6f, 0x89, 0xd7, 0x25, 0x12, 0x92, 0x93, 0x6d, 0xff, 0x70, 0xc7, 0xdd, 0x07, 0x65, 0xf8,
-	0x97, 0x0c, 0x49, 0x2e, 0xed, 0x60, 0xf4, 0x62, 0xbf, 0xef, 0xfb, 0xde, 0xfb, 0x78, 0x1f, 0x7a,
-	0xf8, 0x58, 0x83, 0xda, 0x83, 0x4a, 0xbf, 0x09, 0x75, 0x0d, 0x2a, 0xe5, 0x45, 0xbd, 0xdb, 0xb8,
-	0x6f, 0x22, 0x95, 0xa8, 0x05, 0xf1, 0x2d, 0x38, 0x1a, 0xad, 0xc4, 0x4a, 0x58, 0x26, 0x35, 0x95,
-	0x13, 0x8f, 0x06, 0x52, 0xea, 0x54, 0x4a, 0xdd, 0xc2, 0xe7, 0xff, 0x9a, 0x95, 0x62, 0xb3, 0x11,
-	0xdb, 0xf6, 0xe7, 0x5a, 0x26, 0x37, 0x08, 0x77, 0x3f, 0x40, 0x5d, 0x90, 0x67, 0xd8, 0xfb, 0x22,
-	0x96, 0x14, 0x45, 0x28, 0xee, 0x65, 0xbd, 0x44, 0x4a, 0xbd, 0xd8, 0x67, 0xc9, 0x3b, 0xb1, 0x64,
-	0x86, 0x27, 0x2f, 0x70, 0x50, 0x6d, 0xe5, 0xae, 0xd6, 0xb4, 0x13, 0x79, 0x71, 0x2f, 0x1b, 0x24,
-	0xad, 0xcd, 0xcc, 0xb0, 0xac, 0x15, 0x09, 0xc1, 0xdd, 0x75, 0xa1, 0xd7, 0xd4, 0x8b, 0x50, 0x1c,
-	0x32, 0x5b, 0x93, 0x09, 0xf6, 0x75, 0x5d, 0xd4, 0x40, 0xbb, 0x11, 0x8a, 0x1f, 0x67, 0xfd, 0xc4,
-	0xc5, 0x99, 0x1b, 0x8e, 0x39, 0x89, 0x3c, 0xc1, 0x81, 0x82, 0x42, 0x8b, 0x2d, 0xf5, 0xed, 0x64,
-	0x8b, 0xc8, 0x89, 0x9b, 0xd5, 0x34, 0xb0, 0x7b, 0x8d, 0x6e, 0xf7, 0xba, 0x52, 0xa2, 0x04, 0xad,
-	0x8d, 0x87, 0x76, 0x1e, 0x9a, 0x8c, 0xb0, 0x5f, 0x6d, 0x39, 0x7c, 0xa7, 0x07, 0x11, 0x8a, 0x3d,
-	0xe6, 0xc0, 0xe4, 0x37, 0xc2, 0xbe, 0x6d, 0x23, 0xa7, 0x78, 0x20, 0xdd, 0xd8, 0xc2, 0x79, 0xa2,
-	0x07, 0x3c, 0xfb, 0xf2, 0x1e, 0x22, 0x4f, 0x71, 0xd8, 0x62, 0xe0, 0xb4, 0x63, 0xed, 0xef, 0x08,
-	0x42, 0xf1, 0x81, 0xbe, 0xae, 0xa4, 0x04, 0x6e, 0x73, 0x7b, 0xec, 0x16, 0x9a, 0x58, 0x9f, 0x8b,
-	0xea, 0x2b, 0x70, 0x9b, 0xdd, 0x63, 0x2d, 0x32, 0x7e, 0x0a, 0x4a, 0xb1, 0x07, 0x05, 0xdc, 0x26,
-	0xf6, 0xd8, 0x1d, 0x41, 0x5e, 0xe2, 0xd0, 0xf5, 0x2d, 0x2a, 0x6e, 0x83, 0x87, 0x67, 0xfd, 0xe6,
-	0xe6, 0xf8, 0xf0, 0xc2, 0x92, 0xb3, 0x29, 0x3b, 0x74, 0xf2, 0x8c, 0x9f, 0xbc, 0x72, 0xe1, 0x80,
-	0x0c, 0x70, 0x78, 0xc5, 0x2e, 0xcf, 0xf3, 0xf9, 0x3c, 0x9f, 0x0e, 0x1f, 0x11, 0x8c, 0x83, 0x8b,
-	0x37, 0xb3, 0xf7, 0xf9, 0x74, 0x88, 0x8c, 0xc4, 0xf2, 0xf3, 0xcb, 0x8f, 0x39, 0xcb, 0xa7, 0xc3,
-	0xce, 0xd9, 0xdb, 0x9f, 0xcd, 0x18, 0xfd, 0x6a, 0xc6, 0xe8, 0x4f, 0x33, 0x46, 0x9f, 0x4e, 0x57,
-	0x55, 0xbd, 0xde, 0x2d, 0xcd, 0x6b, 0xa6, 0xb2, 0x28, 0xd7, 0x3f, 0x38, 0xa8, 0xfb, 0xd5, 0x3e,
-	0x4b, 0xb5, 0x2a, 0xd3, 0xff, 0x5c, 0xe5, 0x32, 0xb0, 0x17, 0xf4, 0xfa, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x91, 0xcf, 0x21, 0xf4, 0xb3, 0x02, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x76, 0x9a, 0xa6, 0xdb, 0x4c, 0x5b, 0x29, 0x43, 0x91, 0xb0, 0x6a, 0x37, 0x16, 0x84, 0xb8,
+	0x87, 0x06, 0xeb, 0x69, 0x8f, 0x6e, 0x9b, 0x95, 0x4a, 0x65, 0x97, 0x29, 0x78, 0xf0, 0x52, 0xd2,
+	0xcc, 0xb3, 0x8d, 0xdb, 0x76, 0x86, 0x99, 0x69, 0x5d, 0xff, 0x8f, 0x3f, 0xc6, 0xa3, 0x77, 0x61,
+	0x91, 0xfe, 0x12, 0x99, 0x99, 0x2c, 0xdd, 0x05, 0xf1, 0xe4, 0x25, 0x79, 0xdf, 0xf7, 0xbd, 0xf7,
+	0xf1, 0x3e, 0x5e, 0x82, 0x4f, 0x14, 0xc8, 0x1d, 0xc8, 0xe4, 0x2b, 0x97, 0xd7, 0x20, 0x13, 0x96,
+	0xe9, 0xed, 0xda, 0x3d, 0xfb, 0x42, 0x72, 0xcd, 0x89, 0x6f, 0xc1, 0x71, 0x67, 0xc1, 0x17, 0xdc,
+	0x32, 0x89, 0xa9, 0x9c, 0x78, 0xdc, 0x12, 0x42, 0x25, 0x42, 0xa8, 0x12, 0xbe, 0x78, 0x68, 0x96,
+	0xf3, 0xf5, 0x9a, 0x6f, 0xca, 0x97, 0x6b, 0xe9, 0xdd, 0x22, 0x5c, 0xfd, 0x00, 0x3a, 0x23, 0xcf,
+	0xb1, 0xf7, 0x85, 0xcf, 0x43, 0x14, 0xa1, 0xb8, 0x31, 0x68, 0xf4, 0x85, 0x50, 0xb3, 0xdd, 0xa0,
+	0xff, 0x9e, 0xcf, 0xa9, 0xe1, 0xc9, 0x4b, 0x5c, 0x2b, 0x36, 0x62, 0xab, 0x55, 0x58, 0x89, 0xbc,
+	0xb8, 0x31, 0x68, 0xf5, 0x4b, 0x9b, 0xb1, 0x61, 0x69, 0x29, 0x12, 0x82, 0xab, 0xcb, 0x4c, 0x2d,
+	0x43, 0x2f, 0x42, 0x71, 0x40, 0x6d, 0x4d, 0x7a, 0xd8, 0x57, 0x3a, 0xd3, 0x10, 0x56, 0x23, 0x14,
+	0x3f, 0x1e, 0x34, 0xfb, 0x2e, 0xce, 0xd4, 0x70, 0xd4, 0x49, 0xe4, 0x09, 0xae, 0x49, 0xc8, 0x14,
+	0xdf, 0x84, 0xbe, 0x9d, 0x2c, 0x11, 0x39, 0x75, 0xb3, 0x2a, 0xac, 0xd9, 0xbd, 0x3a, 0x77, 0x7b,
+	0x5d, 0x49, 0x9e, 0x83, 0x52, 0xc6, 0x43, 0x39, 0x0f, 0x45, 0x3a, 0xd8, 0x2f, 0x36, 0x0c, 0x6e,
+	0xc2, 0xa3, 0x08, 0xc5, 0x1e, 0x75, 0xa0, 0xf7, 0x0b, 0x61, 0xdf, 0xb6, 0x91, 0x33, 0xdc, 0x12,
+	0x6e, 0x6c, 0xe6, 0x3c, 0xd1, 0x3f, 0x3c, 0x9b, 0xe2, 0x1e, 0x22, 0xcf, 0x70, 0x50, 0x62, 0x60,
+	0x61, 0xc5, 0xda, 0x1f, 0x08, 0x12, 0xe2, 0x23, 0x75, 0x5d, 0x08, 0x01, 0xcc, 0xe6, 0xf6, 0xe8,
+	0x1d, 0x34, 0xb1, 0x3e, 0x67, 0xc5, 0x0a, 0x98, 0xcd, 0xee, 0xd1, 0x12, 0x19, 0x3f, 0x09, 0x39,
+	0xdf, 0x81, 0x04, 0x66, 0x13, 0x7b, 0xf4, 0x40, 0x90, 0x57, 0x38, 0x70, 0x7d, 0xb3, 0x82, 0xd9,
+	0xe0, 0xc1, 0x79, 0x73, 0x7f, 0x7b, 0x52, 0xbf, 0xb0, 0xe4, 0x78, 0x44, 0xeb, 0x4e, 0x1e, 0xb3,
+	0xde, 0x77, 0x84, 0xf1, 0x08, 0x32, 0x36, 0x01, 0xad, 0x41, 0xfe, 0xa7, 0x23, 0x1e, 0x8e, 0xe1,
+	0x3d, 0x38, 0xc6, 0x53, 0x1c, 0xc0, 0x4d, 0xa1, 0x67, 0x39, 0x67, 0x50, 0x06, 0xaa, 0x1b, 0x62,
+	0xc8, 0x19, 0x18, 0x71, 0xc5, 0x17, 0xb3, 0x55, 0xb1, 0x01, 0x15, 0xfa, 0x91, 0x17, 0x07, 0xb4,
+	0xbe, 0xe2, 0x8b, 0x89, 0xc1, 0xa7, 0xaf, 0xdd, 0x0d, 0x80, 0xb4, 0x70, 0x70, 0x45, 0x2f, 0x87,
+	0xe9, 0x74, 0x9a, 0x8e, 0xda, 0x8f, 0x08, 0xc6, 0xb5, 0x8b, 0xb7, 0xe3, 0x49, 0x3a, 0x6a, 0x23,
+	0x23, 0xd1, 0x74, 0x78, 0xf9, 0x31, 0xa5, 0xe9, 0xa8, 0x5d, 0x39, 0x7f, 0xf7, 0x63, 0xdf, 0x45,
+	0x3f, 0xf7, 0x5d, 0xf4, 0x7b, 0xdf, 0x45, 0x9f, 0xce, 0x16, 0x85, 0x5e, 0x6e, 0xe7, 0x66, 0xdf,
+	0x44, 0x64, 0xf9, 0xf2, 0x1b, 0x03, 0x79, 0xbf, 0xda, 0x0d, 0x12, 0x25, 0xf3, 0xe4, 0x2f, 0x3f,
+	0xcf, 0xbc, 0x66, 0x3f, 0xf4, 0x37, 0x7f, 0x06, 0x00, 0xa4, 0x2a, 0x63, 0xb5, 0x5a, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Meta) Marshal() (dAtA []byte, err error) {
@@ -430,6 +515,80 @@ func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LogLines) > 0 {
+		for iNdEx := len(m.LogLines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LogLines[iNdEx])
+			copy(dAtA[i:], m.LogLines[iNdEx])
+			i = encodeVarintDatum(dAtA, i, uint64(len(m.LogLines[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintDatum(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDatum(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDatum(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDatum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDatum(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDatum(v)
 	base := offset
@@ -513,6 +672,41 @@ func (m *Stats) Size() (n int) {
 	return n
 }
 
+func (m *DeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovDatum(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovDatum(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDatum(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovDatum(uint64(m.ExitCode))
+	}
+	if len(m.LogLines) > 0 {
+		for _, s := range m.LogLines {
+			l = len(s)
+			n += 1 + l + sovDatum(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDatum(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -973,6 +1167,210 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &pps.Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &common.Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogLines = append(m.LogLines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDatum(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -147,6 +147,17 @@ func newCronIterator(pachClient *client.APIClient, input *pps.CronInput) Iterato
 	})
 }
 
+func newObjectIterator(pachClient *client.APIClient, input *pps.ObjectInput) Iterator {
+	return newPFSIterator(pachClient, &pps.PFSInput{
+		Name:   input.Name,
+		Repo:   input.Repo,
+		Branch: "master",
+		Commit: input.Commit,
+		Glob:   input.Glob,
+		Lazy:   input.Lazy,
+	})
+}
+
 // Hasher is the standard interface for a datum hasher.
 type Hasher interface {
 	// Hash computes the datum hash based on the inputs.
@@ -545,6 +556,8 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 		}
 	case input.Cron != nil:
 		iterator = newCronIterator(pachClient, input.Cron)
+	case input.Object != nil:
+		iterator = newObjectIterator(pachClient, input.Object)
 	default:
 		return nil, errors.Errorf("unrecognized input type: %v", input)
 	}
@@ -25,6 +25,13 @@ func WithPFSOutput(mf client.ModifyFile) SetOption {
 	}
 }
 
+// WithDeadLetterOutput sets the Client for the dead-letter output.
+func WithDeadLetterOutput(mf client.ModifyFile) SetOption {
+	return func(s *Set) {
+		s.deadLetterOutputClient = mf
+	}
+}
+
 // WithStats sets the stats to fill in.
 func WithStats(stats *Stats) SetOption {
 	return func(s *Set) {
@@ -49,6 +56,14 @@ func WithRecoveryCallback(cb func(context.Context) error) Option {
 	}
 }
 
+// WithLogTail sets the function used to collect the last log lines of a
+// failed datum for the dead-letter output.
+func WithLogTail(cb func() []string) Option {
+	return func(d *Datum) {
+		d.logTail = cb
+	}
+}
+
 // WithTimeout sets the timeout.
 func WithTimeout(timeout time.Duration) Option {
 	return func(d *Datum) {
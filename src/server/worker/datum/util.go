@@ -1,6 +1,8 @@
 package datum
 
 import (
+	"math"
+	"sort"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -35,11 +37,94 @@ func MergeProcessStats(x, y *pps.ProcessStats) error {
 	if x.UploadTime, err = plusDuration(x.UploadTime, y.UploadTime); err != nil {
 		return err
 	}
+	if x.CpuTime, err = plusDuration(x.CpuTime, y.CpuTime); err != nil {
+		return err
+	}
 	x.DownloadBytes += y.DownloadBytes
 	x.UploadBytes += y.UploadBytes
+	// Peak usage is not additive, so the merged stats keep the maximum.
+	if y.PeakMemoryBytes > x.PeakMemoryBytes {
+		x.PeakMemoryBytes = y.PeakMemoryBytes
+	}
+	if y.ScratchBytes > x.ScratchBytes {
+		x.ScratchBytes = y.ScratchBytes
+	}
 	return nil
 }
 
+// AggregateProcessStats returns the distribution of each of the process stats
+// across the datums that 'stats' were recorded for. Durations are aggregated
+// in seconds.
+func AggregateProcessStats(stats []*pps.ProcessStats) (*pps.AggregateProcessStats, error) {
+	var downloadTime, processTime, uploadTime, cpuTime []float64
+	var downloadBytes, uploadBytes, peakMemoryBytes, scratchBytes []float64
+	for _, s := range stats {
+		for _, d := range []struct {
+			duration *types.Duration
+			seconds  *[]float64
+		}{
+			{s.DownloadTime, &downloadTime},
+			{s.ProcessTime, &processTime},
+			{s.UploadTime, &uploadTime},
+			{s.CpuTime, &cpuTime},
+		} {
+			var duration time.Duration
+			if d.duration != nil {
+				var err error
+				if duration, err = types.DurationFromProto(d.duration); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+			}
+			*d.seconds = append(*d.seconds, duration.Seconds())
+		}
+		downloadBytes = append(downloadBytes, float64(s.DownloadBytes))
+		uploadBytes = append(uploadBytes, float64(s.UploadBytes))
+		peakMemoryBytes = append(peakMemoryBytes, float64(s.PeakMemoryBytes))
+		scratchBytes = append(scratchBytes, float64(s.ScratchBytes))
+	}
+	return &pps.AggregateProcessStats{
+		DownloadTime:    aggregate(downloadTime),
+		ProcessTime:     aggregate(processTime),
+		UploadTime:      aggregate(uploadTime),
+		DownloadBytes:   aggregate(downloadBytes),
+		UploadBytes:     aggregate(uploadBytes),
+		CpuTime:         aggregate(cpuTime),
+		PeakMemoryBytes: aggregate(peakMemoryBytes),
+		ScratchBytes:    aggregate(scratchBytes),
+	}, nil
+}
+
+// aggregate returns the count, mean, standard deviation and 5th and 95th
+// percentiles of 'xs'
+func aggregate(xs []float64) *pps.Aggregate {
+	if len(xs) == 0 {
+		return &pps.Aggregate{}
+	}
+	sorted := append([]float64(nil), xs...)
+	sort.Float64s(sorted)
+	var sum float64
+	for _, x := range sorted {
+		sum += x
+	}
+	mean := sum / float64(len(sorted))
+	var variance float64
+	for _, x := range sorted {
+		variance += (x - mean) * (x - mean)
+	}
+	variance /= float64(len(sorted))
+	// percentiles use the nearest rank
+	percentile := func(p float64) float64 {
+		return sorted[int(math.Ceil(p*float64(len(sorted))))-1]
+	}
+	return &pps.Aggregate{
+		Count:                 int64(len(sorted)),
+		Mean:                  mean,
+		Stddev:                math.Sqrt(variance),
+		FifthPercentile:       percentile(0.05),
+		NinetyFifthPercentile: percentile(0.95),
+	}
+}
+
 func plusDuration(x *types.Duration, y *types.Duration) (*types.Duration, error) {
 	var xd time.Duration
 	var yd time.Duration
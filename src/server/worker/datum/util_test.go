@@ -0,0 +1,112 @@
+package datum
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func TestMergeProcessStats(t *testing.T) {
+	x := &pps.ProcessStats{
+		CpuTime:         types.DurationProto(time.Second),
+		DownloadBytes:   10,
+		PeakMemoryBytes: 100,
+		ScratchBytes:    2000,
+	}
+	y := &pps.ProcessStats{
+		CpuTime:         types.DurationProto(2 * time.Second),
+		DownloadBytes:   5,
+		PeakMemoryBytes: 300,
+		ScratchBytes:    1000,
+	}
+	require.NoError(t, MergeProcessStats(x, y))
+	cpuTime, err := types.DurationFromProto(x.CpuTime)
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Second, cpuTime)
+	require.Equal(t, int64(15), x.DownloadBytes)
+	// peaks are maxed rather than summed
+	require.Equal(t, int64(300), x.PeakMemoryBytes)
+	require.Equal(t, int64(2000), x.ScratchBytes)
+}
+
+func TestAggregateProcessStats(t *testing.T) {
+	var stats []*pps.ProcessStats
+	for i := 1; i <= 100; i++ {
+		stats = append(stats, &pps.ProcessStats{
+			CpuTime:         types.DurationProto(time.Duration(i) * time.Second),
+			PeakMemoryBytes: int64(i) * 1024,
+			ScratchBytes:    int64(i),
+		})
+	}
+	agg, err := AggregateProcessStats(stats)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), agg.CpuTime.Count)
+	require.Equal(t, 50.5, agg.CpuTime.Mean)
+	require.Equal(t, 5.0, agg.CpuTime.FifthPercentile)
+	require.Equal(t, 95.0, agg.CpuTime.NinetyFifthPercentile)
+	require.Equal(t, 50.5*1024, agg.PeakMemoryBytes.Mean)
+	require.Equal(t, 50.5, agg.ScratchBytes.Mean)
+	require.True(t, agg.ScratchBytes.Stddev > 28 && agg.ScratchBytes.Stddev < 29)
+	// stats that weren't recorded count as zero
+	require.Equal(t, int64(100), agg.UploadTime.Count)
+	require.Equal(t, 0.0, agg.UploadTime.Mean)
+
+	agg, err = AggregateProcessStats(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), agg.CpuTime.Count)
+}
+
+func TestScratchUsage(t *testing.T) {
+	storageRoot := t.TempDir()
+	d := &Datum{
+		ID:          "datum",
+		storageRoot: storageRoot,
+		meta: &Meta{
+			Inputs: []*common.Input{{Name: "in"}, {Name: "in"}, {Name: "s3", S3: true}},
+			Stats:  &pps.ProcessStats{},
+		},
+	}
+	write := func(p string, size int) {
+		require.NoError(t, os.MkdirAll(path.Dir(p), 0777))
+		require.NoError(t, os.WriteFile(p, make([]byte, size), 0666))
+	}
+	root := d.PFSStorageRoot()
+	write(path.Join(root, "in", "a"), 10)
+	write(path.Join(root, OutputPrefix, "b"), 20)
+	// files outside of the datum's inputs and output aren't counted, and
+	// neither are the targets of symlinks
+	write(path.Join(root, "s3", "c"), 40)
+	write(path.Join(storageRoot, "other", "d"), 80)
+	require.NoError(t, os.Symlink(path.Join(storageRoot, "other"), path.Join(root, OutputPrefix, "link")))
+	size, err := d.scratchUsage()
+	require.NoError(t, err)
+	require.Equal(t, int64(30), size)
+}
+
+func TestScratchUsagePeak(t *testing.T) {
+	defer func(interval time.Duration) { scratchSampleInterval = interval }(scratchSampleInterval)
+	scratchSampleInterval = 10 * time.Millisecond
+	d := &Datum{
+		ID:          "datum",
+		storageRoot: t.TempDir(),
+		meta:        &Meta{Stats: &pps.ProcessStats{}},
+	}
+	// the peak is recorded even though the file is removed before the run ends
+	tmp := path.Join(d.PFSStorageRoot(), OutputPrefix, "tmp")
+	require.NoError(t, d.Run(context.Background(), func(context.Context) error {
+		require.NoError(t, os.MkdirAll(path.Dir(tmp), 0777))
+		require.NoError(t, os.WriteFile(tmp, make([]byte, 100), 0666))
+		time.Sleep(10 * scratchSampleInterval)
+		return errors.EnsureStack(os.Remove(tmp))
+	}))
+	require.Equal(t, int64(100), d.meta.Stats.ScratchBytes)
+}
@@ -16,6 +16,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/gogo/protobuf/types"
+
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
@@ -85,7 +87,9 @@ type Driver interface {
 	// launching the configured user process.
 	UserCodeEnv(string, *pfs.Commit, []*common.Input) []string
 
-	RunUserCode(context.Context, logs.TaggedLogger, []string) error
+	// RunUserCode runs the user code. If the ProcessStats are not nil, the
+	// CPU time and peak memory consumed by the user code are recorded in them.
+	RunUserCode(context.Context, logs.TaggedLogger, []string, *pps.ProcessStats) error
 
 	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string) error
 
@@ -313,6 +317,7 @@ func (d *driver) RunUserCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
 	environ []string,
+	procStats *pps.ProcessStats,
 ) (retErr error) {
 	logger.Logf("beginning to run user code")
 	defer func(start time.Time) {
@@ -353,6 +358,9 @@ func (d *driver) RunUserCode(
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
+	// the usage is recorded however the user code ended, including when it
+	// was killed or failed
+	defer recordUsage(procStats, state)
 	if common.IsDone(ctx) {
 		if err = ctx.Err(); err != nil {
 			return errors.EnsureStack(err)
@@ -386,6 +394,26 @@ func (d *driver) RunUserCode(
 	return nil
 }
 
+// recordUsage adds the resources consumed by a finished user code process to
+// the given stats.
+func recordUsage(procStats *pps.ProcessStats, state *os.ProcessState) {
+	if procStats == nil || state == nil {
+		return
+	}
+	cpuTime := state.UserTime() + state.SystemTime()
+	if procStats.CpuTime != nil {
+		// an invalid previous value is overwritten, as the stats are only
+		// informational
+		if prevCPUTime, err := types.DurationFromProto(procStats.CpuTime); err == nil {
+			cpuTime += prevCPUTime
+		}
+	}
+	procStats.CpuTime = types.DurationProto(cpuTime)
+	if peakMemory := peakMemoryBytes(state); peakMemory > procStats.PeakMemoryBytes {
+		procStats.PeakMemoryBytes = peakMemory
+	}
+}
+
 func (d *driver) RunUserErrorHandlingCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
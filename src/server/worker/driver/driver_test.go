@@ -1,5 +1,34 @@
 package driver
 
+import (
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestRecordUsage(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the worker doesn't run on windows")
+	}
+	cmd := exec.Command("sh", "-c", "exit 1")
+	require.YesError(t, cmd.Run())
+	stats := &pps.ProcessStats{CpuTime: types.DurationProto(time.Hour)}
+	// usage is recorded for processes that fail too
+	recordUsage(stats, cmd.ProcessState)
+	cpuTime, err := types.DurationFromProto(stats.CpuTime)
+	require.NoError(t, err)
+	require.True(t, cpuTime >= time.Hour)
+	require.True(t, stats.PeakMemoryBytes > 0)
+	// nil stats are ignored
+	recordUsage(nil, cmd.ProcessState)
+}
+
 // TODO(2.0 optional): Implement the driver tests with the V2 changes. I think there is a good chance
 // that we rewrite the driver a bit, so we should probably hold off on this for now.
 //var inputRepo = "inputRepo"
@@ -13,6 +13,16 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 )
 
+// peakMemoryBytes returns the peak resident set size of a finished process.
+func peakMemoryBytes(state *os.ProcessState) int64 {
+	if rusage, ok := state.SysUsage().(*syscall.Rusage); ok {
+		// Maxrss is reported in kilobytes on Linux, which is the only
+		// platform workers run on.
+		return int64(rusage.Maxrss) * 1024
+	}
+	return 0
+}
+
 func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	return &syscall.SysProcAttr{
 		Credential: &syscall.Credential{
@@ -15,6 +15,10 @@ import (
 
 // Note: these are stubs only meant for tests - the worker does not run on windows
 
+func peakMemoryBytes(state *os.ProcessState) int64 {
+	return 0
+}
+
 func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	return nil
 }
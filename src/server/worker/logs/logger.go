@@ -41,6 +41,7 @@ type TaggedLogger interface {
 	WithJob(jobID string) TaggedLogger
 	WithData(data []*common.Input) TaggedLogger
 	WithUserCode() TaggedLogger
+	WithTail(tail *Tail) TaggedLogger
 
 	JobID() string
 }
@@ -49,6 +50,7 @@ type taggedLogger struct {
 	template  pps.LogMessage
 	stderrLog *log.Logger
 	marshaler *jsonpb.Marshaler
+	tail      *Tail
 
 	buffer bytes.Buffer
 }
@@ -143,6 +145,14 @@ func (logger *taggedLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithTail clones the current logger and returns a new one that will also
+// record each logged message in the given tail.
+func (logger *taggedLogger) WithTail(tail *Tail) TaggedLogger {
+	result := logger.clone()
+	result.tail = tail
+	return result
+}
+
 // JobID returns the current job that the logger is configured with.
 func (logger *taggedLogger) JobID() string {
 	return logger.template.JobID
@@ -153,6 +163,7 @@ func (logger *taggedLogger) clone() *taggedLogger {
 		template:  logger.template,  // Copy struct
 		stderrLog: logger.stderrLog, // logger should be goroutine-safe
 		marshaler: &jsonpb.Marshaler{},
+		tail:      logger.tail,
 	}
 }
 
@@ -162,6 +173,9 @@ func (logger *taggedLogger) clone() *taggedLogger {
 // Note: this is not thread-safe, as it modifies fields of 'logger.template'
 func (logger *taggedLogger) Logf(formatString string, args ...interface{}) {
 	logger.template.Message = fmt.Sprintf(formatString, args...)
+	if logger.tail != nil {
+		logger.tail.add(logger.template.Message)
+	}
 	if ts, err := types.TimestampProto(time.Now()); err == nil {
 		logger.template.Ts = ts
 	} else {
@@ -0,0 +1,33 @@
+package logs
+
+import "sync"
+
+// Tail retains the most recent lines logged through a TaggedLogger. It is
+// safe for concurrent use, so the loggers attached to the stdout and stderr of
+// user code can share one.
+type Tail struct {
+	mu    sync.Mutex
+	lines []string
+	size  int
+}
+
+// NewTail constructs a Tail that retains at most size lines.
+func NewTail(size int) *Tail {
+	return &Tail{size: size}
+}
+
+func (t *Tail) add(line string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lines = append(t.lines, line)
+	if len(t.lines) > t.size {
+		t.lines = t.lines[len(t.lines)-t.size:]
+	}
+}
+
+// Lines returns a copy of the retained lines, oldest first.
+func (t *Tail) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.lines...)
+}
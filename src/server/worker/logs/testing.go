@@ -20,6 +20,7 @@ type MockLogger struct {
 	Job      string
 	Data     []*common.Input
 	UserCode bool
+	Tail     *Tail
 }
 
 // Not used - forces a compile-time error in this file if MockLogger does not
@@ -43,6 +44,9 @@ func (ml *MockLogger) Write(p []byte) (_ int, retErr error) {
 
 // Logf optionally logs a statement using string formatting
 func (ml *MockLogger) Logf(formatString string, args ...interface{}) {
+	if ml.Tail != nil {
+		ml.Tail.add(fmt.Sprintf(formatString, args...))
+	}
 	if ml.Writer != nil {
 		params := []interface{}{time.Now().Format(time.StampMilli), ml.Job, ml.Data, ml.UserCode}
 		params = append(params, args...)
@@ -107,6 +111,14 @@ func (ml *MockLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithTail duplicates the MockLogger and returns a new one that records its
+// log statements in the given tail.
+func (ml *MockLogger) WithTail(tail *Tail) TaggedLogger {
+	result := ml.clone()
+	result.Tail = tail
+	return result
+}
+
 // JobID returns the currently tagged job ID for the logger.
 // This is redundant for MockLogger, as you can access ml.Job directly,
 // but it is needed for the TaggedLogger interface.
@@ -103,8 +103,8 @@ func (td *testDriver) WithActiveData(inputs []*common.Input, dir string, cb func
 func (td *testDriver) UserCodeEnv(jobID string, commit *pfs.Commit, inputs []*common.Input) []string {
 	return td.inner.UserCodeEnv(jobID, commit, inputs)
 }
-func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
-	return errors.EnsureStack(td.inner.RunUserCode(ctx, logger, env))
+func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string, procStats *pps.ProcessStats) error {
+	return errors.EnsureStack(td.inner.RunUserCode(ctx, logger, env, procStats))
 }
 func (td *testDriver) RunUserErrorHandlingCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
 	return errors.EnsureStack(td.inner.RunUserErrorHandlingCode(ctx, logger, env))
@@ -47,6 +47,27 @@ func (pj *pendingJob) saveJobStats(stats *datum.Stats) {
 	pj.ji.DataRecovered += stats.Recovered
 }
 
+// aggregateDatumStats records the distribution of the process stats of the
+// datums processed by the job, rather than skipped, in the job info.
+func (pj *pendingJob) aggregateDatumStats(pachClient *client.APIClient) error {
+	var stats []*pps.ProcessStats
+	dit := datum.NewCommitIterator(pachClient, pj.metaCommitInfo.Commit)
+	if err := dit.Iterate(func(meta *datum.Meta) error {
+		if meta.Job.GetID() == pj.ji.Job.ID && meta.Stats != nil {
+			stats = append(stats, meta.Stats)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	datumStats, err := datum.AggregateProcessStats(stats)
+	if err != nil {
+		return err
+	}
+	pj.ji.DatumStats = datumStats
+	return nil
+}
+
 func (pj *pendingJob) load() error {
 	pachClient := pj.driver.PachClient()
 	var err error
@@ -120,6 +141,7 @@ func (pj *pendingJob) load() error {
 
 func (pj *pendingJob) clearJobStats() {
 	pj.ji.Stats = &pps.ProcessStats{}
+	pj.ji.DatumStats = nil
 	pj.ji.DataProcessed = 0
 	pj.ji.DataSkipped = 0
 	pj.ji.DataFailed = 0
@@ -195,10 +217,19 @@ func (pj *pendingJob) withParallelDatums(ctx context.Context, taskDoer task.Doer
 			}
 		}
 		// Create the output datum file set for the new datums (datums that do not exist in the base job).
-		outputFileSetID, err := pj.createJobDatumFileSetParallel(ctx, taskDoer, renewer, fileSetID, baseFileSetID)
+		outputFileSetID, skipped, err := pj.createJobDatumFileSetParallel(ctx, taskDoer, renewer, fileSetID, baseFileSetID)
 		if err != nil {
 			return err
 		}
+		// Record the quarantined datums that were skipped.
+		if skipped > 0 {
+			stats := &datum.Stats{ProcessStats: &pps.ProcessStats{}}
+			stats.Skipped = skipped
+			pj.saveJobStats(stats)
+			if err := pj.writeJobInfo(); err != nil {
+				return err
+			}
+		}
 		return cb(ctx, outputFileSetID)
 	})
 }
@@ -260,14 +291,20 @@ func (pj *pendingJob) createFullBaseJobDatumFileSet(ctx context.Context, taskDoe
 	return baseFileSetID, nil
 }
 
-func (pj *pendingJob) createJobDatumFileSetParallel(ctx context.Context, taskDoer task.Doer, renewer *renew.StringSet, fileSetID, baseFileSetID string) (string, error) {
+func (pj *pendingJob) createJobDatumFileSetParallel(ctx context.Context, taskDoer task.Doer, renewer *renew.StringSet, fileSetID, baseFileSetID string) (string, int64, error) {
 	var outputFileSetID string
+	var skipped int64
 	if err := pj.logger.LogStep("creating job datum file set (parallel jobs)", func() error {
-		input, err := serializeComputeParallelDatumsTask(&ComputeParallelDatumsTask{
+		computeParallelDatumsTask := &ComputeParallelDatumsTask{
 			Job:           pj.ji.Job,
 			FileSetId:     fileSetID,
 			BaseFileSetId: baseFileSetID,
-		})
+			NoSkip:        pj.noSkip,
+		}
+		if pj.driver.PipelineInfo().Details.DeadLetter {
+			computeParallelDatumsTask.DeadLetterCommit = deadLetterBranch(pj.ji.Job.Pipeline.Name).NewCommit("")
+		}
+		input, err := serializeComputeParallelDatumsTask(computeParallelDatumsTask)
 		if err != nil {
 			return err
 		}
@@ -283,11 +320,12 @@ func (pj *pendingJob) createJobDatumFileSetParallel(ctx context.Context, taskDoe
 			return err
 		}
 		outputFileSetID = result.FileSetId
+		skipped = result.Skipped
 		return nil
 	}); err != nil {
-		return "", errors.EnsureStack(err)
+		return "", 0, errors.EnsureStack(err)
 	}
-	return outputFileSetID, nil
+	return outputFileSetID, skipped, nil
 }
 
 // The datums that must be processed serially (with respect to the base job) are the datums that exist in both the current and base job.
@@ -343,12 +381,16 @@ func (pj *pendingJob) createJobDatumFileSetSerial(ctx context.Context, taskDoer
 	var outputFileSetID, deleteFileSetID string
 	var skipped int64
 	if err := pj.logger.LogStep("creating job datum file set (serial jobs)", func() error {
-		input, err := serializeComputeSerialDatumsTask(&ComputeSerialDatumsTask{
+		computeSerialDatumsTask := &ComputeSerialDatumsTask{
 			Job:            pj.ji.Job,
 			FileSetId:      fileSetID,
 			BaseMetaCommit: baseMetaCommit,
 			NoSkip:         pj.noSkip,
-		})
+		}
+		if pj.driver.PipelineInfo().Details.DeadLetter {
+			computeSerialDatumsTask.DeadLetterCommit = deadLetterBranch(pj.ji.Job.Pipeline.Name).NewCommit("")
+		}
+		input, err := serializeComputeSerialDatumsTask(computeSerialDatumsTask)
 		if err != nil {
 			return err
 		}
@@ -19,6 +19,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
@@ -34,6 +35,8 @@ import (
 
 const (
 	defaultDatumSetsPerWorker int64 = 4
+	// deadLetterLogLines is the number of log lines recorded for a quarantined datum.
+	deadLetterLogLines = 20
 )
 
 type registry struct {
@@ -293,6 +296,9 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 		}
 		return err
 	}
+	if err := pj.aggregateDatumStats(pachClient.WithCtx(ctx)); err != nil {
+		return err
+	}
 	if pj.ji.Details.Egress != nil {
 		pj.ji.State = pps.JobState_JOB_EGRESSING
 		return pj.writeJobInfo()
@@ -313,6 +319,10 @@ func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, taskDoer
 		return errors.EnsureStack(err)
 	}
 	if stats.FailedID != "" {
+		if pj.driver.PipelineInfo().Details.DeadLetter {
+			pj.logger.Logf("quarantined %v failed datums in the dead-letter branch", stats.Failed)
+			return nil
+		}
 		if err := reg.failJob(pj, fmt.Sprintf("datum %v failed", stats.FailedID)); err != nil {
 			return err
 		}
@@ -416,6 +426,11 @@ func processDatumSets(pachClient *client.APIClient, pj *pendingJob, taskDoer tas
 					); err != nil {
 						return grpcutil.ScrubGRPC(err)
 					}
+					if data.DeadLetterFileSetId != "" && data.Stats.Failed > 0 {
+						if err := quarantineDatums(pachClient, pj, data.DeadLetterFileSetId); err != nil {
+							return err
+						}
+					}
 					if err := datum.MergeStats(stats, data.Stats); err != nil {
 						return err
 					}
@@ -428,6 +443,43 @@ func processDatumSets(pachClient *client.APIClient, pj *pendingJob, taskDoer tas
 	}))
 }
 
+// quarantineDatums adds the dead-letter file set of a datum set to the
+// pipeline's dead-letter branch, creating the branch if necessary.
+func quarantineDatums(pachClient *client.APIClient, pj *pendingJob, fileSetID string) error {
+	commit, err := pachClient.PfsAPIClient.StartCommit(
+		pachClient.Ctx(),
+		&pfs.StartCommitRequest{
+			Branch:      deadLetterBranch(pj.ji.Job.Pipeline.Name),
+			Description: fmt.Sprintf("Datums quarantined by job %v", pj.ji.Job.ID),
+		},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if _, err := pachClient.PfsAPIClient.AddFileSet(
+		pachClient.Ctx(),
+		&pfs.AddFileSetRequest{
+			Commit:    commit,
+			FileSetId: fileSetID,
+		},
+	); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if _, err := pachClient.PfsAPIClient.FinishCommit(
+		pachClient.Ctx(),
+		&pfs.FinishCommitRequest{
+			Commit: commit,
+		},
+	); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return nil
+}
+
+func deadLetterBranch(pipelineName string) *pfs.Branch {
+	return client.NewSystemRepo(pipelineName, pfs.MetaRepoType).NewBranch(ppsconsts.DeadLetterBranch)
+}
+
 func serializeCreateDatumSetsTask(task *CreateDatumSetsTask) (*types.Any, error) {
 	data, err := proto.Marshal(task)
 	if err != nil {
@@ -35,6 +35,7 @@ type DatumSet struct {
 	OutputFileSetId      string       `protobuf:"bytes,4,opt,name=output_file_set_id,json=outputFileSetId,proto3" json:"output_file_set_id,omitempty"`
 	MetaFileSetId        string       `protobuf:"bytes,5,opt,name=meta_file_set_id,json=metaFileSetId,proto3" json:"meta_file_set_id,omitempty"`
 	Stats                *datum.Stats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	DeadLetterFileSetId  string       `protobuf:"bytes,7,opt,name=dead_letter_file_set_id,json=deadLetterFileSetId,proto3" json:"dead_letter_file_set_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -115,6 +116,13 @@ func (m *DatumSet) GetStats() *datum.Stats {
 	return nil
 }
 
+func (m *DatumSet) GetDeadLetterFileSetId() string {
+	if m != nil {
+		return m.DeadLetterFileSetId
+	}
+	return ""
+}
+
 type UploadDatumsTask struct {
 	Job                  *pps.Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -218,12 +226,14 @@ func (m *UploadDatumsTaskResult) GetCount() int64 {
 }
 
 type ComputeParallelDatumsTask struct {
-	Job                  *pps.Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	FileSetId            string   `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
-	BaseFileSetId        string   `protobuf:"bytes,3,opt,name=base_file_set_id,json=baseFileSetId,proto3" json:"base_file_set_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Job                  *pps.Job    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	FileSetId            string      `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	BaseFileSetId        string      `protobuf:"bytes,3,opt,name=base_file_set_id,json=baseFileSetId,proto3" json:"base_file_set_id,omitempty"`
+	NoSkip               bool        `protobuf:"varint,4,opt,name=no_skip,json=noSkip,proto3" json:"no_skip,omitempty"`
+	DeadLetterCommit     *pfs.Commit `protobuf:"bytes,5,opt,name=dead_letter_commit,json=deadLetterCommit,proto3" json:"dead_letter_commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *ComputeParallelDatumsTask) Reset()         { *m = ComputeParallelDatumsTask{} }
@@ -280,8 +290,23 @@ func (m *ComputeParallelDatumsTask) GetBaseFileSetId() string {
 	return ""
 }
 
+func (m *ComputeParallelDatumsTask) GetNoSkip() bool {
+	if m != nil {
+		return m.NoSkip
+	}
+	return false
+}
+
+func (m *ComputeParallelDatumsTask) GetDeadLetterCommit() *pfs.Commit {
+	if m != nil {
+		return m.DeadLetterCommit
+	}
+	return nil
+}
+
 type ComputeParallelDatumsTaskResult struct {
 	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	Skipped              int64    `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -327,11 +352,19 @@ func (m *ComputeParallelDatumsTaskResult) GetFileSetId() string {
 	return ""
 }
 
+func (m *ComputeParallelDatumsTaskResult) GetSkipped() int64 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
 type ComputeSerialDatumsTask struct {
 	Job                  *pps.Job    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	FileSetId            string      `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 	BaseMetaCommit       *pfs.Commit `protobuf:"bytes,3,opt,name=base_meta_commit,json=baseMetaCommit,proto3" json:"base_meta_commit,omitempty"`
 	NoSkip               bool        `protobuf:"varint,4,opt,name=no_skip,json=noSkip,proto3" json:"no_skip,omitempty"`
+	DeadLetterCommit     *pfs.Commit `protobuf:"bytes,5,opt,name=dead_letter_commit,json=deadLetterCommit,proto3" json:"dead_letter_commit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -398,6 +431,13 @@ func (m *ComputeSerialDatumsTask) GetNoSkip() bool {
 	return false
 }
 
+func (m *ComputeSerialDatumsTask) GetDeadLetterCommit() *pfs.Commit {
+	if m != nil {
+		return m.DeadLetterCommit
+	}
+	return nil
+}
+
 type ComputeSerialDatumsTaskResult struct {
 	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 	DeleteFileSetId      string   `protobuf:"bytes,2,opt,name=delete_file_set_id,json=deleteFileSetId,proto3" json:"delete_file_set_id,omitempty"`
@@ -596,44 +636,46 @@ func init() {
 }
 
 var fileDescriptor_21583a759eb7fa97 = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6a, 0xdb, 0x4e,
-	0x10, 0x66, 0xa3, 0x9f, 0x9d, 0x78, 0x1d, 0x27, 0x46, 0xbf, 0xd0, 0x38, 0x81, 0xd8, 0x46, 0x3d,
-	0x24, 0x10, 0x90, 0x5a, 0xe7, 0xd2, 0x6b, 0xe3, 0x10, 0x70, 0xa0, 0xa5, 0xc8, 0xe9, 0xa5, 0x17,
-	0xb3, 0xb2, 0xc6, 0xb6, 0x6c, 0x49, 0xbb, 0xec, 0xae, 0x5c, 0x7a, 0x2e, 0x14, 0xfa, 0x2c, 0x7d,
-	0x91, 0x1e, 0xfb, 0x04, 0xa5, 0xf8, 0x35, 0x7a, 0x29, 0xbb, 0xeb, 0xff, 0x6d, 0x1a, 0x1d, 0x7a,
-	0x11, 0x3b, 0x33, 0xdf, 0xac, 0xbe, 0xf9, 0x66, 0x66, 0xf1, 0x33, 0x01, 0x7c, 0x0a, 0xdc, 0x7b,
-	0x4f, 0xf9, 0x04, 0xb8, 0xc7, 0x22, 0x06, 0x71, 0x94, 0x82, 0x27, 0x39, 0x49, 0xc5, 0x80, 0xf2,
-	0x64, 0x75, 0x72, 0x19, 0xa7, 0x92, 0xda, 0x4f, 0x19, 0xe9, 0x8f, 0x3e, 0x84, 0xc0, 0x13, 0xd7,
-	0x24, 0xb9, 0x8b, 0x24, 0x77, 0x09, 0x3d, 0x3d, 0x1a, 0xd2, 0x21, 0xd5, 0x78, 0x4f, 0x9d, 0x4c,
-	0xea, 0x69, 0x85, 0x0d, 0x84, 0xc7, 0x06, 0x62, 0x69, 0x32, 0xe1, 0x31, 0xb6, 0x30, 0x1b, 0x9b,
-	0x54, 0x42, 0x22, 0xb3, 0xc4, 0x7c, 0x0d, 0xc0, 0xf9, 0x89, 0xf0, 0xde, 0x8d, 0xb2, 0xbb, 0x20,
-	0xed, 0x26, 0x2e, 0x8e, 0x69, 0xd0, 0x8b, 0xc2, 0x1a, 0x6a, 0xa2, 0x8b, 0xd2, 0x75, 0x69, 0xf6,
-	0xbd, 0x51, 0xb8, 0xa3, 0x41, 0xe7, 0xc6, 0x2f, 0x8c, 0x69, 0xd0, 0x09, 0xed, 0x3a, 0x2e, 0x0f,
-	0xa2, 0x18, 0x7a, 0x02, 0xa4, 0x82, 0xed, 0x28, 0x98, 0x5f, 0x52, 0xae, 0x2e, 0xc8, 0x4e, 0x68,
-	0x5f, 0xe1, 0x0a, 0xcd, 0x24, 0xcb, 0x64, 0xaf, 0x4f, 0x93, 0x24, 0x92, 0x35, 0xab, 0x89, 0x2e,
-	0xca, 0xad, 0x03, 0x97, 0x0d, 0x44, 0x6f, 0xda, 0x72, 0xdb, 0xda, 0xeb, 0xef, 0x1b, 0x90, 0xb1,
-	0xec, 0x4b, 0x6c, 0xcf, 0x93, 0xd6, 0xef, 0xfe, 0x4f, 0xdf, 0x7d, 0x68, 0x22, 0xb7, 0xcb, 0x3f,
-	0x9c, 0xe3, 0x6a, 0x02, 0x92, 0x6c, 0x40, 0x0b, 0x1a, 0x5a, 0x51, 0xfe, 0x15, 0xd0, 0xc1, 0x05,
-	0x21, 0x89, 0x14, 0xb5, 0xa2, 0xa6, 0xb0, 0xef, 0x9a, 0xb2, 0xbb, 0xca, 0xe7, 0x9b, 0x90, 0xf3,
-	0x1c, 0x57, 0xdf, 0xb2, 0x98, 0x92, 0x50, 0x4b, 0x20, 0xee, 0x89, 0x98, 0xd8, 0x67, 0xd8, 0x1a,
-	0xd3, 0x40, 0x2b, 0x50, 0x6e, 0x95, 0x5d, 0xc6, 0x34, 0xf1, 0x3b, 0x1a, 0xf8, 0xca, 0xef, 0xbc,
-	0xc6, 0x4f, 0xb6, 0x53, 0x7c, 0x10, 0x59, 0x2c, 0xb7, 0xb5, 0x41, 0xdb, 0xda, 0x1c, 0xe1, 0x42,
-	0x9f, 0x66, 0xa9, 0xd4, 0xaa, 0x59, 0xbe, 0x31, 0x9c, 0x8f, 0x08, 0x9f, 0xb4, 0x69, 0xc2, 0x32,
-	0x09, 0x6f, 0x08, 0x27, 0x71, 0x0c, 0x71, 0x6e, 0x32, 0x8f, 0xb6, 0xe3, 0x1c, 0x57, 0x03, 0x22,
-	0x60, 0x43, 0x2c, 0xcb, 0x88, 0xa5, 0xfc, 0x4b, 0xb1, 0x9c, 0x97, 0xb8, 0xf1, 0x20, 0x89, 0x7c,
-	0xe5, 0x39, 0x5f, 0x10, 0x3e, 0x9e, 0xdf, 0xd1, 0x05, 0x1e, 0x91, 0x7f, 0x58, 0xc6, 0x8b, 0x79,
-	0x19, 0xba, 0xf1, 0x7f, 0x1d, 0xac, 0x03, 0x85, 0x7b, 0x05, 0x92, 0xcc, 0x47, 0xeb, 0x18, 0xef,
-	0xa6, 0xb4, 0x27, 0x26, 0x11, 0xd3, 0xf3, 0xb4, 0xe7, 0x17, 0x53, 0xda, 0x9d, 0x44, 0xcc, 0xf9,
-	0x84, 0xf0, 0xd9, 0x03, 0x6c, 0x73, 0xb6, 0xf3, 0x12, 0xdb, 0x21, 0xc4, 0x20, 0x37, 0xd5, 0x35,
-	0xdc, 0x0f, 0x4d, 0x64, 0x35, 0x8c, 0x35, 0xbc, 0xab, 0x48, 0x30, 0x30, 0xfa, 0x5b, 0xfe, 0xc2,
-	0x74, 0x3e, 0x23, 0xfc, 0x7f, 0x9b, 0x03, 0x91, 0xb0, 0x58, 0xc3, 0x5c, 0x92, 0xfd, 0xb6, 0x68,
-	0x3b, 0x39, 0x16, 0x6d, 0xab, 0x24, 0x6b, 0xbb, 0x85, 0x23, 0x7c, 0xf2, 0x07, 0x2a, 0xf9, 0xf5,
-	0x88, 0xd2, 0xf5, 0x25, 0x16, 0x6b, 0x7a, 0xe8, 0xc8, 0x5c, 0x0e, 0xd1, 0x09, 0xaf, 0xef, 0xbf,
-	0xce, 0xea, 0xe8, 0xdb, 0xac, 0x8e, 0x7e, 0xcc, 0xea, 0xe8, 0xdd, 0xed, 0x30, 0x92, 0xa3, 0x2c,
-	0x70, 0xfb, 0x34, 0xf1, 0x96, 0x2f, 0xe1, 0xda, 0x69, 0xda, 0xf2, 0x04, 0xef, 0x7b, 0x8f, 0x3d,
-	0xab, 0x41, 0x51, 0xbf, 0x69, 0x57, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x3a, 0x53, 0xe2,
-	0x81, 0x05, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe5, 0x1a, 0xa7, 0xed, 0xa6, 0x1f, 0x91, 0x5b, 0xd1, 0xb4, 0x52, 0xd3, 0xca, 0x1c,
+	0x5a, 0xa9, 0x92, 0x0d, 0x29, 0x07, 0x0e, 0x9c, 0xda, 0xaa, 0x52, 0x2a, 0x40, 0xc8, 0x29, 0x17,
+	0x38, 0x58, 0xeb, 0x78, 0xd2, 0x3a, 0xb1, 0xbd, 0xab, 0xdd, 0x75, 0x10, 0x2f, 0x80, 0xc4, 0xe3,
+	0xf0, 0x16, 0x1c, 0x39, 0x73, 0x40, 0x28, 0x37, 0xde, 0x02, 0xed, 0xae, 0xf3, 0xd9, 0x94, 0xfa,
+	0x00, 0x97, 0x68, 0x67, 0xe6, 0x3f, 0xe3, 0x99, 0xdf, 0xae, 0x26, 0xe8, 0x29, 0x07, 0x36, 0x00,
+	0xe6, 0x7d, 0x24, 0xac, 0x0f, 0xcc, 0xa3, 0x31, 0x85, 0x24, 0xce, 0xc0, 0x13, 0x0c, 0x67, 0xbc,
+	0x4b, 0x58, 0x3a, 0x39, 0xb9, 0x94, 0x11, 0x41, 0xec, 0x27, 0x14, 0x77, 0x6e, 0x3f, 0x45, 0xc0,
+	0x52, 0x57, 0x27, 0xb9, 0xa3, 0x24, 0x77, 0x2c, 0xdd, 0xdb, 0xbe, 0x21, 0x37, 0x44, 0xe9, 0x3d,
+	0x79, 0xd2, 0xa9, 0x7b, 0xeb, 0xb4, 0xcb, 0x3d, 0xda, 0xe5, 0x63, 0x93, 0x72, 0x8f, 0xd2, 0x91,
+	0x79, 0x30, 0xdb, 0x4a, 0x84, 0x45, 0x9e, 0xea, 0x5f, 0x2d, 0x70, 0xbe, 0x2e, 0xa1, 0x95, 0x0b,
+	0x69, 0xb7, 0x41, 0xd8, 0x87, 0xa8, 0xd2, 0x23, 0x61, 0x10, 0x47, 0x75, 0xe3, 0xd0, 0x38, 0x5e,
+	0x3d, 0x5b, 0x1d, 0xfe, 0x3c, 0xb0, 0xae, 0x48, 0xd8, 0xba, 0xf0, 0xad, 0x1e, 0x09, 0x5b, 0x91,
+	0xdd, 0x40, 0xd5, 0x6e, 0x9c, 0x40, 0xc0, 0x41, 0x48, 0xd9, 0x92, 0x94, 0xf9, 0xab, 0xd2, 0xd5,
+	0x06, 0xd1, 0x8a, 0xec, 0x53, 0xb4, 0x4e, 0x72, 0x41, 0x73, 0x11, 0x74, 0x48, 0x9a, 0xc6, 0xa2,
+	0x6e, 0x1e, 0x1a, 0xc7, 0xd5, 0xe6, 0x86, 0x4b, 0xbb, 0x3c, 0x18, 0x34, 0xdd, 0x73, 0xe5, 0xf5,
+	0xd7, 0xb4, 0x48, 0x5b, 0xf6, 0x09, 0xb2, 0x8b, 0xa4, 0xe9, 0xda, 0x8f, 0x54, 0xed, 0x4d, 0x1d,
+	0xb9, 0x1c, 0x7f, 0xe1, 0x08, 0xd5, 0x52, 0x10, 0x78, 0x46, 0x6a, 0x29, 0xe9, 0xba, 0xf4, 0x4f,
+	0x84, 0x0e, 0xb2, 0xb8, 0xc0, 0x82, 0xd7, 0x2b, 0xaa, 0x85, 0x35, 0x57, 0x8f, 0xdd, 0x96, 0x3e,
+	0x5f, 0x87, 0xec, 0xe7, 0x68, 0x27, 0x02, 0x1c, 0x05, 0x09, 0x08, 0x01, 0x6c, 0xa6, 0xe6, 0xb2,
+	0xaa, 0xb9, 0x25, 0xc3, 0xaf, 0x54, 0x74, 0x5c, 0xd9, 0x79, 0x86, 0x6a, 0xef, 0x68, 0x42, 0x70,
+	0xa4, 0xc0, 0xf1, 0x6b, 0xcc, 0xfb, 0xf6, 0x3e, 0x32, 0x7b, 0x24, 0x54, 0xdc, 0xaa, 0xcd, 0xaa,
+	0x4b, 0xa9, 0x1a, 0xf7, 0x8a, 0x84, 0xbe, 0xf4, 0x3b, 0x6f, 0xd0, 0xe3, 0xf9, 0x14, 0x1f, 0x78,
+	0x9e, 0x88, 0x79, 0xa2, 0xc6, 0x3c, 0xd1, 0x6d, 0x64, 0x75, 0x48, 0x9e, 0x09, 0xc5, 0xda, 0xf4,
+	0xb5, 0xe1, 0xfc, 0x30, 0xd0, 0xee, 0x39, 0x49, 0x69, 0x2e, 0xe0, 0x2d, 0x66, 0x38, 0x49, 0x20,
+	0x29, 0xdd, 0xcc, 0x83, 0x97, 0x78, 0x84, 0x6a, 0x21, 0xe6, 0x30, 0x83, 0xc3, 0xd4, 0x88, 0xa5,
+	0x7f, 0x82, 0x78, 0x07, 0x2d, 0x67, 0x24, 0xe0, 0xfd, 0x98, 0xaa, 0xdb, 0x5a, 0xf1, 0x2b, 0x19,
+	0x69, 0xf7, 0x63, 0x6a, 0xbf, 0x44, 0xf6, 0x34, 0xd7, 0xe2, 0x2d, 0x58, 0x0b, 0xdf, 0x42, 0x6d,
+	0x82, 0x58, 0x7b, 0x9c, 0x0f, 0xe8, 0xe0, 0xde, 0xd9, 0x4a, 0x52, 0xab, 0xa3, 0x65, 0xd9, 0x16,
+	0x85, 0xa8, 0xe0, 0x36, 0x32, 0x9d, 0xdf, 0x06, 0xda, 0x29, 0xaa, 0xb7, 0x81, 0xc5, 0xf8, 0x1f,
+	0x72, 0x7b, 0x51, 0x70, 0x53, 0xef, 0xf3, 0xaf, 0xef, 0x7f, 0x43, 0xea, 0x5e, 0x83, 0xc0, 0xda,
+	0xfe, 0x5f, 0x20, 0x3f, 0x1b, 0x68, 0xff, 0x9e, 0x59, 0x4b, 0x72, 0x3c, 0x91, 0xdf, 0x4f, 0x40,
+	0x40, 0x70, 0x77, 0xf2, 0x4d, 0x1d, 0xb9, 0x5c, 0x04, 0xdd, 0x9c, 0x85, 0xfe, 0xc5, 0x40, 0x5b,
+	0xe7, 0x0c, 0xb0, 0x80, 0xd1, 0xae, 0x29, 0x05, 0xfc, 0xce, 0x36, 0x59, 0x2a, 0xb1, 0x4d, 0xe6,
+	0x46, 0x32, 0xe7, 0x46, 0x72, 0x6e, 0xd1, 0xee, 0x82, 0x56, 0xca, 0xf3, 0x88, 0xb3, 0xe9, 0x4d,
+	0xc5, 0xa7, 0x78, 0xa8, 0x48, 0x81, 0x83, 0xb7, 0xa2, 0xb3, 0xeb, 0x6f, 0xc3, 0x86, 0xf1, 0x7d,
+	0xd8, 0x30, 0x7e, 0x0d, 0x1b, 0xc6, 0xfb, 0xcb, 0x9b, 0x58, 0xdc, 0xe6, 0xa1, 0xdb, 0x21, 0xa9,
+	0x37, 0x5e, 0xf7, 0x53, 0xa7, 0x41, 0xd3, 0xe3, 0xac, 0xe3, 0x3d, 0xf4, 0xdf, 0x11, 0x56, 0xd4,
+	0xe2, 0x3e, 0xfd, 0x33, 0x00, 0xeb, 0xb0, 0x21, 0xcc, 0x66, 0x06, 0x00, 0x00,
 }
 
 func (m *DatumSet) Marshal() (dAtA []byte, err error) {
@@ -660,6 +702,13 @@ func (m *DatumSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DeadLetterFileSetId) > 0 {
+		i -= len(m.DeadLetterFileSetId)
+		copy(dAtA[i:], m.DeadLetterFileSetId)
+		i = encodeVarintTransform(dAtA, i, uint64(len(m.DeadLetterFileSetId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Stats != nil {
 		{
 			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
@@ -817,6 +866,28 @@ func (m *ComputeParallelDatumsTask) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DeadLetterCommit != nil {
+		{
+			size, err := m.DeadLetterCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransform(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NoSkip {
+		i--
+		if m.NoSkip {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.BaseFileSetId) > 0 {
 		i -= len(m.BaseFileSetId)
 		copy(dAtA[i:], m.BaseFileSetId)
@@ -870,6 +941,11 @@ func (m *ComputeParallelDatumsTaskResult) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Skipped != 0 {
+		i = encodeVarintTransform(dAtA, i, uint64(m.Skipped))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.FileSetId) > 0 {
 		i -= len(m.FileSetId)
 		copy(dAtA[i:], m.FileSetId)
@@ -904,6 +980,18 @@ func (m *ComputeSerialDatumsTask) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DeadLetterCommit != nil {
+		{
+			size, err := m.DeadLetterCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransform(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.NoSkip {
 		i--
 		if m.NoSkip {
@@ -1134,6 +1222,10 @@ func (m *DatumSet) Size() (n int) {
 		l = m.Stats.Size()
 		n += 1 + l + sovTransform(uint64(l))
 	}
+	l = len(m.DeadLetterFileSetId)
+	if l > 0 {
+		n += 1 + l + sovTransform(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1193,6 +1285,13 @@ func (m *ComputeParallelDatumsTask) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransform(uint64(l))
 	}
+	if m.NoSkip {
+		n += 2
+	}
+	if m.DeadLetterCommit != nil {
+		l = m.DeadLetterCommit.Size()
+		n += 1 + l + sovTransform(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1209,6 +1308,9 @@ func (m *ComputeParallelDatumsTaskResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransform(uint64(l))
 	}
+	if m.Skipped != 0 {
+		n += 1 + sovTransform(uint64(m.Skipped))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1236,6 +1338,10 @@ func (m *ComputeSerialDatumsTask) Size() (n int) {
 	if m.NoSkip {
 		n += 2
 	}
+	if m.DeadLetterCommit != nil {
+		l = m.DeadLetterCommit.Size()
+		n += 1 + l + sovTransform(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1544,6 +1650,38 @@ func (m *DatumSet) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterFileSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadLetterFileSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -1884,6 +2022,62 @@ func (m *ComputeParallelDatumsTask) Unmarshal(dAtA []byte) error {
 			}
 			m.BaseFileSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoSkip", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoSkip = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLetterCommit == nil {
+				m.DeadLetterCommit = &pfs.Commit{}
+			}
+			if err := m.DeadLetterCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -1967,6 +2161,25 @@ func (m *ComputeParallelDatumsTaskResult) Unmarshal(dAtA []byte) error {
 			}
 			m.FileSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			m.Skipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Skipped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -2142,6 +2355,42 @@ func (m *ComputeSerialDatumsTask) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NoSkip = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLetterCommit == nil {
+				m.DeadLetterCommit = &pfs.Commit{}
+			}
+			if err := m.DeadLetterCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"golang.org/x/sync/errgroup"
@@ -27,6 +28,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 )
 
 func newWorkerSpawnerPair(t *testing.T, dbConfig serviceenv.ConfigOption, pipelineInfo *pps.PipelineInfo) *testEnv {
@@ -171,6 +173,7 @@ func mockBasicJob(t *testing.T, env *testEnv, pi *pps.PipelineInfo) (context.Con
 			DatumTimeout:     pi.Details.DatumTimeout,
 			JobTimeout:       pi.Details.JobTimeout,
 			DatumTries:       pi.Details.DatumTries,
+			DeadLetter:       pi.Details.DeadLetter,
 			SchedulingSpec:   pi.Details.SchedulingSpec,
 			PodSpec:          pi.Details.PodSpec,
 			PodPatch:         pi.Details.PodPatch,
@@ -317,6 +320,37 @@ func TestTransformPipeline(suite *testing.T) {
 		// TODO: check job stats
 	})
 
+	suite.Run("TestJobDeadLetterDatum", func(t *testing.T) {
+		t.Parallel()
+		pi := defaultPipelineInfo()
+		pi.Details.DeadLetter = true
+		env := newWorkerSpawnerPair(t, dockertestenv.NewTestDBConfig(t), pi)
+
+		pi.Details.Transform.Cmd = []string{"bash", "-c", "echo corrupt input; exit 3"}
+		ctx, jobInfo := mockBasicJob(t, env, pi)
+		tarFiles := []tarutil.File{
+			tarutil.NewMemFile("/file", []byte("foobar")),
+		}
+		triggerJob(t, env, pi, tarFiles)
+		ctx = withTimeout(ctx, 10*time.Second)
+		<-ctx.Done()
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+
+		// Ensure the failed datum was quarantined.
+		deadLetterCommit := deadLetterBranch(pi.Pipeline.Name).NewCommit("")
+		fileInfos, err := env.PachClient.ListFileAll(deadLetterCommit, "/")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(deadLetterCommit, fileInfos[0].File.Path, buf))
+		deadLetter := &datum.DeadLetter{}
+		require.NoError(t, jsonpb.Unmarshal(buf, deadLetter))
+		require.Equal(t, int64(3), deadLetter.ExitCode)
+		require.Equal(t, 1, len(deadLetter.Inputs))
+		require.Equal(t, "/file", deadLetter.Inputs[0].FileInfo.File.Path)
+		require.OneOfEquals(t, "corrupt input", deadLetter.LogLines)
+	})
+
 	suite.Run("TestJobMultiDatum", func(t *testing.T) {
 		t.Parallel()
 		pi := defaultPipelineInfo()
@@ -1,14 +1,18 @@
 package transform
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"time"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -20,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
@@ -138,18 +143,32 @@ func processComputeParallelDatumsTask(pachClient *client.APIClient, task *Comput
 		dits = append(dits, datum.NewFileSetIterator(pachClient, task.BaseFileSetId))
 	}
 	dits = append(dits, datum.NewFileSetIterator(pachClient, task.FileSetId))
+	quarantined, err := quarantinedDatums(pachClient, task.DeadLetterCommit)
+	if err != nil {
+		return nil, err
+	}
+	var skipped int64
 	outputFileSetID, err := withDatumFileSet(pachClient, func(outputSet *datum.Set) error {
 		return datum.Merge(dits, func(metas []*datum.Meta) error {
 			if len(metas) > 1 || !proto.Equal(metas[0].Job, task.Job) {
 				return nil
 			}
+			// The datum doesn't exist in the base job, but it may have been
+			// quarantined by an earlier job with the same inputs.
+			if !task.NoSkip && quarantinedInputs(metas[0], quarantined) {
+				skipped++
+				return nil
+			}
 			return outputSet.UploadMeta(metas[0], datum.WithPrefixIndex())
 		})
 	})
 	if err != nil {
 		return nil, err
 	}
-	return serializeComputeParallelDatumsTaskResult(&ComputeParallelDatumsTaskResult{FileSetId: outputFileSetID})
+	return serializeComputeParallelDatumsTaskResult(&ComputeParallelDatumsTaskResult{
+		FileSetId: outputFileSetID,
+		Skipped:   skipped,
+	})
 }
 
 func processComputeSerialDatumsTask(pachClient *client.APIClient, task *ComputeSerialDatumsTask) (*types.Any, error) {
@@ -157,6 +176,10 @@ func processComputeSerialDatumsTask(pachClient *client.APIClient, task *ComputeS
 		datum.NewCommitIterator(pachClient, task.BaseMetaCommit),
 		datum.NewFileSetIterator(pachClient, task.FileSetId),
 	}
+	quarantined, err := quarantinedDatums(pachClient, task.DeadLetterCommit)
+	if err != nil {
+		return nil, err
+	}
 	var deleteFileSetID string
 	var skipped int64
 	outputFileSetID, err := withDatumFileSet(pachClient, func(outputSet *datum.Set) error {
@@ -172,7 +195,7 @@ func processComputeSerialDatumsTask(pachClient *client.APIClient, task *ComputeS
 					return deleteSet.UploadMeta(metas[0])
 				}
 				// Check if a skippable datum was successfully processed by the parent.
-				if !task.NoSkip && skippableDatum(metas[1], metas[0]) {
+				if !task.NoSkip && (skippableDatum(metas[1], metas[0]) || quarantinedDatum(metas[1], metas[0], quarantined)) {
 					skipped++
 					return nil
 				}
@@ -199,6 +222,70 @@ func skippableDatum(meta1, meta2 *datum.Meta) bool {
 	return meta1.Hash == meta2.Hash && meta2.State == datum.State_PROCESSED
 }
 
+// quarantinedDatums returns the hashes of the input files of the datums with
+// dead-letter records in the dead-letter commit, keyed by datum ID. The
+// records are read in a single stream, and only the hashes are kept.
+func quarantinedDatums(pachClient *client.APIClient, deadLetterCommit *pfs.Commit) (map[string][][]byte, error) {
+	quarantined := make(map[string][][]byte)
+	if deadLetterCommit == nil {
+		return quarantined, nil
+	}
+	r, err := pachClient.GetFileTAR(deadLetterCommit, "/*")
+	if err != nil {
+		if pfsserver.IsBranchNotFoundErr(err) || pfsserver.IsFileNotFoundErr(err) {
+			return quarantined, nil
+		}
+		return nil, err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) || pfsserver.IsBranchNotFoundErr(err) || pfsserver.IsFileNotFoundErr(err) {
+				return quarantined, nil
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		deadLetter := &datum.DeadLetter{}
+		if err := jsonpb.Unmarshal(tr, deadLetter); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		hashes := make([][]byte, len(deadLetter.Inputs))
+		for i, input := range deadLetter.Inputs {
+			hashes[i] = input.FileInfo.GetHash()
+		}
+		quarantined[path.Base(hdr.Name)] = hashes
+	}
+}
+
+// quarantinedInputs returns true if a datum with the same inputs, down to the
+// content of the input files, is quarantined.
+func quarantinedInputs(meta *datum.Meta, quarantined map[string][][]byte) bool {
+	hashes, ok := quarantined[common.DatumID(meta.Inputs)]
+	if !ok || len(hashes) != len(meta.Inputs) {
+		return false
+	}
+	for i, input := range meta.Inputs {
+		if !bytes.Equal(input.FileInfo.Hash, hashes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func quarantinedDatum(meta1, meta2 *datum.Meta, quarantined map[string][][]byte) bool {
+	// If the hashes are equal and the second datum failed and has not been
+	// replayed since, then it stays in quarantine.
+	if meta1.Hash != meta2.Hash || meta2.State != datum.State_FAILED {
+		return false
+	}
+	_, ok := quarantined[common.DatumID(meta2.Inputs)]
+	return ok
+}
+
 func processCreateDatumSetsTask(driver driver.Driver, task *CreateDatumSetsTask) (*types.Any, error) {
 	setSpec, err := createSetSpec(driver, task.FileSetId)
 	if err != nil {
@@ -341,6 +428,21 @@ func checkS3Gateway(driver driver.Driver, logger logs.TaggedLogger) error {
 }
 
 func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *DatumSet, status *Status) error {
+	if !driver.PipelineInfo().Details.DeadLetter {
+		return handleDatumSetOutput(driver, logger, datumSet, status, nil)
+	}
+	// Setup file operation client for the dead-letter output.
+	resp, err := driver.PachClient().WithCreateFileSetClient(func(mfDeadLetter client.ModifyFile) error {
+		return handleDatumSetOutput(driver, logger, datumSet, status, mfDeadLetter)
+	})
+	if err != nil {
+		return err
+	}
+	datumSet.DeadLetterFileSetId = resp.FileSetId
+	return nil
+}
+
+func handleDatumSetOutput(driver driver.Driver, logger logs.TaggedLogger, datumSet *DatumSet, status *Status, mfDeadLetter client.ModifyFile) error {
 	pachClient := driver.PachClient()
 	// TODO: Can this just be refactored into the datum package such that we don't need to specify a storage root for the sets?
 	// The sets would just create a temporary directory under /tmp.
@@ -355,6 +457,9 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 				datum.WithPFSOutput(mfPFS),
 				datum.WithStats(datumSet.Stats),
 			}
+			if mfDeadLetter != nil {
+				opts = append(opts, datum.WithDeadLetterOutput(mfDeadLetter))
+			}
 			return pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
 				pachClient := pachClient.WithCtx(ctx)
 				cacheClient := pfssync.NewCacheClient(pachClient, renewer)
@@ -366,6 +471,11 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						ctx := pachClient.Ctx()
 						inputs := meta.Inputs
 						logger = logger.WithData(inputs)
+						var tail *logs.Tail
+						if mfDeadLetter != nil {
+							tail = logs.NewTail(deadLetterLogLines)
+							logger = logger.WithTail(tail)
+						}
 						env := driver.UserCodeEnv(logger.JobID(), datumSet.OutputCommit, inputs)
 						var opts []datum.Option
 						if driver.PipelineInfo().Details.DatumTimeout != nil {
@@ -378,6 +488,9 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						if driver.PipelineInfo().Details.DatumTries > 0 {
 							opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().Details.DatumTries)-1))
 						}
+						if tail != nil {
+							opts = append(opts, datum.WithLogTail(tail.Lines))
+						}
 						if driver.PipelineInfo().Details.Transform.ErrCmd != nil {
 							opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
 								return errors.EnsureStack(driver.RunUserErrorHandlingCode(runCtx, logger, env))
@@ -389,7 +502,7 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 							err := status.withDatum(inputs, cancel, func() error {
 								err := driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
 									err := d.Run(cancelCtx, func(runCtx context.Context) error {
-										return errors.EnsureStack(driver.RunUserCode(runCtx, logger, env))
+										return errors.EnsureStack(driver.RunUserCode(runCtx, logger, env, d.ProcessStats()))
 									})
 									return errors.EnsureStack(err)
 								})
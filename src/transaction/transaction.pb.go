@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	auth "github.com/pachyderm/pachyderm/v2/src/auth"
 	pfs "github.com/pachyderm/pachyderm/v2/src/pfs"
 	pps "github.com/pachyderm/pachyderm/v2/src/pps"
 	grpc "google.golang.org/grpc"
@@ -71,19 +72,26 @@ var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
 
 type TransactionRequest struct {
 	// Exactly one of these fields should be set
-	CreateRepo           *pfs.CreateRepoRequest      `protobuf:"bytes,1,opt,name=create_repo,json=createRepo,proto3" json:"create_repo,omitempty"`
-	DeleteRepo           *pfs.DeleteRepoRequest      `protobuf:"bytes,2,opt,name=delete_repo,json=deleteRepo,proto3" json:"delete_repo,omitempty"`
-	StartCommit          *pfs.StartCommitRequest     `protobuf:"bytes,3,opt,name=start_commit,json=startCommit,proto3" json:"start_commit,omitempty"`
-	FinishCommit         *pfs.FinishCommitRequest    `protobuf:"bytes,4,opt,name=finish_commit,json=finishCommit,proto3" json:"finish_commit,omitempty"`
-	SquashCommitSet      *pfs.SquashCommitSetRequest `protobuf:"bytes,5,opt,name=squash_commit_set,json=squashCommitSet,proto3" json:"squash_commit_set,omitempty"`
-	CreateBranch         *pfs.CreateBranchRequest    `protobuf:"bytes,6,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
-	DeleteBranch         *pfs.DeleteBranchRequest    `protobuf:"bytes,7,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
-	UpdateJobState       *pps.UpdateJobStateRequest  `protobuf:"bytes,8,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
-	CreatePipeline       *pps.CreatePipelineRequest  `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
-	StopJob              *pps.StopJobRequest         `protobuf:"bytes,10,opt,name=stop_job,json=stopJob,proto3" json:"stop_job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	CreateRepo           *pfs.CreateRepoRequest         `protobuf:"bytes,1,opt,name=create_repo,json=createRepo,proto3" json:"create_repo,omitempty"`
+	DeleteRepo           *pfs.DeleteRepoRequest         `protobuf:"bytes,2,opt,name=delete_repo,json=deleteRepo,proto3" json:"delete_repo,omitempty"`
+	StartCommit          *pfs.StartCommitRequest        `protobuf:"bytes,3,opt,name=start_commit,json=startCommit,proto3" json:"start_commit,omitempty"`
+	FinishCommit         *pfs.FinishCommitRequest       `protobuf:"bytes,4,opt,name=finish_commit,json=finishCommit,proto3" json:"finish_commit,omitempty"`
+	SquashCommitSet      *pfs.SquashCommitSetRequest    `protobuf:"bytes,5,opt,name=squash_commit_set,json=squashCommitSet,proto3" json:"squash_commit_set,omitempty"`
+	CreateBranch         *pfs.CreateBranchRequest       `protobuf:"bytes,6,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
+	DeleteBranch         *pfs.DeleteBranchRequest       `protobuf:"bytes,7,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
+	UpdateJobState       *pps.UpdateJobStateRequest     `protobuf:"bytes,8,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
+	CreatePipeline       *pps.CreatePipelineRequest     `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
+	StopJob              *pps.StopJobRequest            `protobuf:"bytes,10,opt,name=stop_job,json=stopJob,proto3" json:"stop_job,omitempty"`
+	AddFileSet           *pfs.AddFileSetRequest         `protobuf:"bytes,11,opt,name=add_file_set,json=addFileSet,proto3" json:"add_file_set,omitempty"`
+	DeletePipeline       *pps.DeletePipelineRequest     `protobuf:"bytes,12,opt,name=delete_pipeline,json=deletePipeline,proto3" json:"delete_pipeline,omitempty"`
+	StopPipeline         *pps.StopPipelineRequest       `protobuf:"bytes,13,opt,name=stop_pipeline,json=stopPipeline,proto3" json:"stop_pipeline,omitempty"`
+	StartPipeline        *pps.StartPipelineRequest      `protobuf:"bytes,14,opt,name=start_pipeline,json=startPipeline,proto3" json:"start_pipeline,omitempty"`
+	DropCommitSet        *pfs.DropCommitSetRequest      `protobuf:"bytes,15,opt,name=drop_commit_set,json=dropCommitSet,proto3" json:"drop_commit_set,omitempty"`
+	CreateSecret         *pps.CreateSecretRequest       `protobuf:"bytes,16,opt,name=create_secret,json=createSecret,proto3" json:"create_secret,omitempty"`
+	ModifyRoleBinding    *auth.ModifyRoleBindingRequest `protobuf:"bytes,17,opt,name=modify_role_binding,json=modifyRoleBinding,proto3" json:"modify_role_binding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
@@ -189,6 +197,55 @@ func (m *TransactionRequest) GetStopJob() *pps.StopJobRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetAddFileSet() *pfs.AddFileSetRequest {
+	if m != nil {
+		return m.AddFileSet
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDeletePipeline() *pps.DeletePipelineRequest {
+	if m != nil {
+		return m.DeletePipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStopPipeline() *pps.StopPipelineRequest {
+	if m != nil {
+		return m.StopPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStartPipeline() *pps.StartPipelineRequest {
+	if m != nil {
+		return m.StartPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDropCommitSet() *pfs.DropCommitSetRequest {
+	if m != nil {
+		return m.DropCommitSet
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetCreateSecret() *pps.CreateSecretRequest {
+	if m != nil {
+		return m.CreateSecret
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetModifyRoleBinding() *auth.ModifyRoleBindingRequest {
+	if m != nil {
+		return m.ModifyRoleBinding
+	}
+	return nil
+}
+
 type TransactionResponse struct {
 	// At most, one of these fields should be set (most responses are empty)
 	Commit               *pfs.Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -676,6 +733,239 @@ func (m *FinishTransactionRequest) GetTransaction() *Transaction {
 	return nil
 }
 
+type DryRunTransactionRequest struct {
+	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DryRunTransactionRequest) Reset()         { *m = DryRunTransactionRequest{} }
+func (m *DryRunTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunTransactionRequest) ProtoMessage()    {}
+func (*DryRunTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_284c03442be38d9f, []int{12}
+}
+func (m *DryRunTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunTransactionRequest.Merge(m, src)
+}
+func (m *DryRunTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunTransactionRequest proto.InternalMessageInfo
+
+func (m *DryRunTransactionRequest) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+// TransactionPreview describes the changes that running part of a transaction
+// would make to the cluster.
+type TransactionPreview struct {
+	// The request that was run, if any
+	Request *TransactionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// The response the request would return
+	Response       *TransactionResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	CommitsCreated []*pfs.Commit        `protobuf:"bytes,3,rep,name=commits_created,json=commitsCreated,proto3" json:"commits_created,omitempty"`
+	// Branches that would be created, or whose head would change
+	BranchesMoved    []*pfs.Branch   `protobuf:"bytes,4,rep,name=branches_moved,json=branchesMoved,proto3" json:"branches_moved,omitempty"`
+	PipelinesCreated []*pps.Pipeline `protobuf:"bytes,5,rep,name=pipelines_created,json=pipelinesCreated,proto3" json:"pipelines_created,omitempty"`
+	PipelinesUpdated []*pps.Pipeline `protobuf:"bytes,6,rep,name=pipelines_updated,json=pipelinesUpdated,proto3" json:"pipelines_updated,omitempty"`
+	JobsCreated      []*pps.Job      `protobuf:"bytes,7,rep,name=jobs_created,json=jobsCreated,proto3" json:"jobs_created,omitempty"`
+	// The error the request would fail with, if any
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionPreview) Reset()         { *m = TransactionPreview{} }
+func (m *TransactionPreview) String() string { return proto.CompactTextString(m) }
+func (*TransactionPreview) ProtoMessage()    {}
+func (*TransactionPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_284c03442be38d9f, []int{13}
+}
+func (m *TransactionPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionPreview.Merge(m, src)
+}
+func (m *TransactionPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionPreview proto.InternalMessageInfo
+
+func (m *TransactionPreview) GetRequest() *TransactionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *TransactionPreview) GetResponse() *TransactionResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *TransactionPreview) GetCommitsCreated() []*pfs.Commit {
+	if m != nil {
+		return m.CommitsCreated
+	}
+	return nil
+}
+
+func (m *TransactionPreview) GetBranchesMoved() []*pfs.Branch {
+	if m != nil {
+		return m.BranchesMoved
+	}
+	return nil
+}
+
+func (m *TransactionPreview) GetPipelinesCreated() []*pps.Pipeline {
+	if m != nil {
+		return m.PipelinesCreated
+	}
+	return nil
+}
+
+func (m *TransactionPreview) GetPipelinesUpdated() []*pps.Pipeline {
+	if m != nil {
+		return m.PipelinesUpdated
+	}
+	return nil
+}
+
+func (m *TransactionPreview) GetJobsCreated() []*pps.Job {
+	if m != nil {
+		return m.JobsCreated
+	}
+	return nil
+}
+
+func (m *TransactionPreview) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DryRunTransactionResponse struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The changes made by each request that was run. Running stops at the
+	// first request that fails.
+	Requests []*TransactionPreview `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// The changes made when the transaction is finished, after all of its
+	// requests have run, such as propagating commits downstream and creating
+	// jobs. Unset if a request fails.
+	Finish *TransactionPreview `protobuf:"bytes,3,opt,name=finish,proto3" json:"finish,omitempty"`
+	// Whether the transaction would succeed
+	Valid                bool     `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DryRunTransactionResponse) Reset()         { *m = DryRunTransactionResponse{} }
+func (m *DryRunTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunTransactionResponse) ProtoMessage()    {}
+func (*DryRunTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_284c03442be38d9f, []int{14}
+}
+func (m *DryRunTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunTransactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunTransactionResponse.Merge(m, src)
+}
+func (m *DryRunTransactionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunTransactionResponse proto.InternalMessageInfo
+
+func (m *DryRunTransactionResponse) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *DryRunTransactionResponse) GetRequests() []*TransactionPreview {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *DryRunTransactionResponse) GetFinish() *TransactionPreview {
+	if m != nil {
+		return m.Finish
+	}
+	return nil
+}
+
+func (m *DryRunTransactionResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DeleteAllRequest)(nil), "transaction_v2.DeleteAllRequest")
 	proto.RegisterType((*TransactionRequest)(nil), "transaction_v2.TransactionRequest")
@@ -689,65 +979,92 @@ func init() {
 	proto.RegisterType((*DeleteTransactionRequest)(nil), "transaction_v2.DeleteTransactionRequest")
 	proto.RegisterType((*ListTransactionRequest)(nil), "transaction_v2.ListTransactionRequest")
 	proto.RegisterType((*FinishTransactionRequest)(nil), "transaction_v2.FinishTransactionRequest")
+	proto.RegisterType((*DryRunTransactionRequest)(nil), "transaction_v2.DryRunTransactionRequest")
+	proto.RegisterType((*TransactionPreview)(nil), "transaction_v2.TransactionPreview")
+	proto.RegisterType((*DryRunTransactionResponse)(nil), "transaction_v2.DryRunTransactionResponse")
 }
 
 func init() { proto.RegisterFile("transaction/transaction.proto", fileDescriptor_284c03442be38d9f) }
 
 var fileDescriptor_284c03442be38d9f = []byte{
-	// 840 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xde, 0x64, 0xbb, 0x9b, 0xcd, 0x49, 0xbb, 0xc9, 0x0e, 0x28, 0xf5, 0x7a, 0xd5, 0x6c, 0x64,
-	0x44, 0x59, 0x6e, 0x6c, 0x35, 0x70, 0x85, 0x54, 0x60, 0xd3, 0xd2, 0x2a, 0x11, 0x17, 0x95, 0x53,
-	0x84, 0x76, 0x25, 0x1a, 0xfc, 0x33, 0x4e, 0x8c, 0x12, 0xcf, 0xd4, 0x33, 0x89, 0xd4, 0x37, 0xe0,
-	0x35, 0x10, 0x2f, 0xc3, 0x25, 0x4f, 0x80, 0x50, 0x9e, 0x04, 0x79, 0x66, 0xec, 0xd8, 0xce, 0x4f,
-	0x8b, 0xba, 0x77, 0x9e, 0x73, 0xce, 0xf7, 0xf9, 0x3b, 0x3f, 0x73, 0x34, 0xf0, 0x88, 0xc7, 0x4e,
-	0xc4, 0x1c, 0x8f, 0x87, 0x24, 0xb2, 0x72, 0xdf, 0x26, 0x8d, 0x09, 0x27, 0xe8, 0x34, 0x67, 0x1a,
-	0x2f, 0x7b, 0xfa, 0xc5, 0x84, 0x90, 0xc9, 0x0c, 0x5b, 0xc2, 0xeb, 0x2e, 0x02, 0x0b, 0xcf, 0x29,
-	0x7f, 0x27, 0x83, 0xf5, 0xcb, 0xb2, 0x93, 0x87, 0x73, 0xcc, 0xb8, 0x33, 0xa7, 0x2a, 0xe0, 0xd3,
-	0x09, 0x99, 0x10, 0xf1, 0x69, 0x25, 0x5f, 0xca, 0xfa, 0x80, 0x06, 0xcc, 0xa2, 0x01, 0xcb, 0x8e,
-	0x94, 0x59, 0x94, 0xaa, 0xa3, 0x81, 0xa0, 0xf5, 0x1c, 0xcf, 0x30, 0xc7, 0xd7, 0xb3, 0x99, 0x8d,
-	0xdf, 0x2e, 0x30, 0xe3, 0xc6, 0x1f, 0x47, 0x80, 0x5e, 0xaf, 0x85, 0x29, 0x33, 0xfa, 0x06, 0x1a,
-	0x5e, 0x8c, 0x1d, 0x8e, 0xc7, 0x31, 0xa6, 0x44, 0xab, 0x74, 0x2b, 0x57, 0x8d, 0xde, 0xb9, 0x49,
-	0x03, 0x36, 0x5e, 0xf6, 0xcc, 0x67, 0xc2, 0x65, 0x63, 0x4a, 0x54, 0xbc, 0x0d, 0x5e, 0x66, 0x4a,
-	0xb0, 0xbe, 0xf8, 0x8d, 0xc4, 0x56, 0x8b, 0x58, 0xa9, 0xa0, 0x80, 0xf5, 0x33, 0x13, 0x7a, 0x0a,
-	0xf7, 0x19, 0x77, 0x62, 0x3e, 0xf6, 0xc8, 0x7c, 0x1e, 0x72, 0xed, 0x50, 0x80, 0xf5, 0x14, 0x3c,
-	0x4a, 0x7c, 0xcf, 0x84, 0x2b, 0x45, 0x37, 0xd8, 0xda, 0x86, 0xbe, 0x87, 0x07, 0x41, 0x18, 0x85,
-	0x6c, 0x9a, 0xe2, 0xef, 0x09, 0xfc, 0x45, 0x8a, 0x7f, 0x21, 0x9c, 0x45, 0x82, 0xfb, 0x41, 0xce,
-	0x88, 0x86, 0x70, 0xc6, 0xde, 0x2e, 0x9c, 0x8c, 0x61, 0xcc, 0x30, 0xd7, 0x8e, 0x04, 0x4b, 0x27,
-	0x53, 0x21, 0x02, 0x24, 0x60, 0x84, 0x33, 0xa2, 0x26, 0x2b, 0xda, 0x13, 0x35, 0xaa, 0x88, 0x6e,
-	0xec, 0x44, 0xde, 0x54, 0x3b, 0x2e, 0xaa, 0x91, 0x65, 0xec, 0x0b, 0x5f, 0xa6, 0xc6, 0xcb, 0x19,
-	0x13, 0x06, 0x55, 0x4a, 0xc5, 0x50, 0x2b, 0x32, 0xc8, 0x62, 0x96, 0x18, 0xfc, 0x9c, 0x11, 0xbd,
-	0x84, 0xd6, 0x82, 0xfa, 0x89, 0x86, 0xdf, 0x88, 0x3b, 0x66, 0xdc, 0xe1, 0x58, 0x3b, 0x11, 0x24,
-	0x8f, 0x4c, 0x4a, 0x05, 0xc9, 0x4f, 0xc2, 0x3f, 0x24, 0xee, 0x88, 0x8b, 0x16, 0x4a, 0x9a, 0xd3,
-	0x45, 0xc1, 0x8c, 0x5e, 0x40, 0x53, 0x25, 0x43, 0x43, 0x8a, 0x67, 0x61, 0x84, 0xb5, 0x7a, 0x91,
-	0x47, 0xa6, 0xf3, 0x4a, 0x79, 0x33, 0x1e, 0xaf, 0x60, 0x46, 0x4f, 0xe0, 0x84, 0x71, 0x42, 0x13,
-	0x39, 0x1a, 0x08, 0x82, 0x76, 0x4a, 0x30, 0xe2, 0x84, 0x0e, 0x89, 0x9b, 0x22, 0x6b, 0x4c, 0x9e,
-	0x8d, 0xa7, 0xf0, 0x49, 0x61, 0x44, 0x19, 0x25, 0x11, 0xc3, 0xe8, 0x31, 0x1c, 0xab, 0x2e, 0xcb,
-	0xf1, 0x3c, 0xcd, 0xea, 0x2a, 0xfb, 0xab, 0xbc, 0xc6, 0xe7, 0xd0, 0xc8, 0xc1, 0x51, 0x1b, 0xaa,
-	0xa1, 0x2f, 0x20, 0xf5, 0xfe, 0xf1, 0xea, 0x9f, 0xcb, 0xea, 0xe0, 0xb9, 0x5d, 0x0d, 0x7d, 0xe3,
-	0xcf, 0x2a, 0x34, 0x73, 0x71, 0x83, 0x28, 0x48, 0xc6, 0xb1, 0x91, 0xbb, 0xb5, 0xea, 0x3f, 0x17,
-	0x66, 0xf1, 0x26, 0x9b, 0x79, 0x71, 0xf9, 0x78, 0xf4, 0x2d, 0x9c, 0xc4, 0x32, 0x19, 0xa6, 0x55,
-	0xbb, 0x87, 0x57, 0x8d, 0x9e, 0xb1, 0x0f, 0xab, 0xf2, 0xce, 0x30, 0xe8, 0x1a, 0xea, 0xb1, 0xca,
-	0x96, 0x69, 0x87, 0x82, 0xe0, 0xb3, 0xbd, 0x04, 0x32, 0xd6, 0x5e, 0xa3, 0xd0, 0xd7, 0x50, 0x13,
-	0x17, 0x04, 0xfb, 0xea, 0x2e, 0xe8, 0xa6, 0x5c, 0x2d, 0x66, 0xba, 0x5a, 0xcc, 0xd7, 0xe9, 0x6a,
-	0xb1, 0xd3, 0x50, 0xa4, 0x41, 0x6d, 0x89, 0x63, 0x96, 0xe4, 0x9c, 0xcc, 0xfe, 0x3d, 0x3b, 0x3d,
-	0x1a, 0x6f, 0xa0, 0x55, 0x2a, 0x12, 0x43, 0x43, 0x68, 0xe5, 0x45, 0x85, 0x51, 0x90, 0x6c, 0x8c,
-	0x44, 0xed, 0xe5, 0x1e, 0xb5, 0x09, 0xd6, 0x6e, 0xf2, 0xa2, 0xc1, 0xb8, 0x81, 0x87, 0x7d, 0x87,
-	0x7b, 0xd3, 0x2d, 0x3b, 0x29, 0x5f, 0xcd, 0xca, 0xff, 0xaf, 0xa6, 0x71, 0x0e, 0x0f, 0xc5, 0xfe,
-	0xd8, 0x0c, 0x32, 0x6e, 0xe1, 0x7c, 0x10, 0x31, 0x8a, 0xbd, 0x2d, 0xce, 0x8f, 0x1c, 0x02, 0xe3,
-	0x06, 0x34, 0x79, 0x4d, 0xef, 0x9e, 0x5a, 0x83, 0xf6, 0x8f, 0x21, 0xdb, 0x96, 0xd0, 0x0d, 0x68,
-	0x72, 0xd7, 0xdd, 0xf9, 0x4f, 0x7b, 0xbf, 0x1f, 0xc1, 0xe1, 0xf5, 0xab, 0x01, 0x7a, 0x03, 0xad,
-	0x72, 0xa7, 0xd0, 0x17, 0x65, 0x96, 0x1d, 0xbd, 0xd4, 0xdf, 0x37, 0x18, 0xc6, 0x01, 0xba, 0x85,
-	0x56, 0xb9, 0x5d, 0x9b, 0xfc, 0x3b, 0x1a, 0xaa, 0xef, 0x4b, 0xc7, 0x38, 0x40, 0x2e, 0xa0, 0xcd,
-	0x7e, 0xa3, 0x2f, 0xcb, 0xa0, 0x9d, 0x33, 0xf1, 0x21, 0xfa, 0x7f, 0x86, 0xb3, 0x8d, 0xbe, 0xa3,
-	0xab, 0x32, 0x6e, 0xd7, 0x68, 0xe8, 0xed, 0x8d, 0x7b, 0xfa, 0x43, 0xf2, 0x3e, 0x30, 0x0e, 0xd0,
-	0x2f, 0xd0, 0x2c, 0x75, 0x1d, 0x3d, 0x2e, 0xd3, 0x6e, 0x1f, 0x0b, 0xbd, 0xfb, 0x1e, 0xd9, 0xcc,
-	0x38, 0x40, 0xbf, 0xc2, 0xd9, 0xc6, 0xe8, 0x6c, 0xea, 0xde, 0x35, 0x5d, 0x1f, 0x52, 0x99, 0x97,
-	0x50, 0xcf, 0xde, 0x21, 0xa8, 0xbb, 0xbd, 0x22, 0xeb, 0x27, 0xca, 0xee, 0x4a, 0xf4, 0xbf, 0xfb,
-	0x6b, 0xd5, 0xa9, 0xfc, 0xbd, 0xea, 0x54, 0xfe, 0x5d, 0x75, 0x2a, 0xb7, 0x4f, 0x26, 0x21, 0x9f,
-	0x2e, 0x5c, 0xd3, 0x23, 0x73, 0x8b, 0x3a, 0xde, 0xf4, 0x9d, 0x8f, 0xe3, 0xfc, 0xd7, 0xb2, 0x67,
-	0xb1, 0xd8, 0xcb, 0xbf, 0xcc, 0xdc, 0x63, 0x41, 0xf9, 0xd5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc8, 0x02, 0x52, 0x48, 0xbb, 0x09, 0x00, 0x00,
+	// 1221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0x8e, 0x9d, 0xc6, 0x8e, 0x8f, 0xff, 0x4f, 0xab, 0x74, 0xe3, 0xfe, 0x9a, 0xe6, 0xb7, 0x88,
+	0xd2, 0xde, 0xac, 0x55, 0x03, 0x42, 0x2a, 0x94, 0x92, 0x34, 0xa4, 0x4a, 0x44, 0xa5, 0xb0, 0x29,
+	0x42, 0x89, 0x44, 0xcd, 0x7a, 0x77, 0x6c, 0x6f, 0x64, 0xef, 0x4c, 0x77, 0xc6, 0x46, 0x79, 0x03,
+	0xde, 0x83, 0x87, 0x81, 0x4b, 0x9e, 0x00, 0xa1, 0x5c, 0x73, 0xc9, 0x03, 0xa0, 0xf9, 0xb3, 0xeb,
+	0xdd, 0xb5, 0x9d, 0x18, 0x51, 0xb8, 0xb1, 0xbc, 0xe7, 0x9c, 0xef, 0x9b, 0x33, 0x67, 0xce, 0x7c,
+	0x67, 0x17, 0xee, 0xf3, 0xd0, 0x09, 0x98, 0xe3, 0x72, 0x9f, 0x04, 0xed, 0xc4, 0x7f, 0x8b, 0x86,
+	0x84, 0x13, 0x54, 0x4b, 0x98, 0xba, 0xd3, 0x4e, 0xeb, 0xde, 0x80, 0x90, 0xc1, 0x08, 0xb7, 0xa5,
+	0xb7, 0x37, 0xe9, 0xb7, 0xf1, 0x98, 0xf2, 0x4b, 0x15, 0xdc, 0x7a, 0x90, 0x75, 0x72, 0x7f, 0x8c,
+	0x19, 0x77, 0xc6, 0x54, 0x07, 0xdc, 0x19, 0x90, 0x01, 0x91, 0x7f, 0xdb, 0xe2, 0x9f, 0xb6, 0xd6,
+	0x9d, 0x09, 0x1f, 0xb6, 0xc5, 0x8f, 0x36, 0x54, 0x69, 0x9f, 0xb5, 0x69, 0x9f, 0xc5, 0x8f, 0x94,
+	0xb5, 0x29, 0xd5, 0x8f, 0x26, 0x82, 0xc6, 0x01, 0x1e, 0x61, 0x8e, 0xf7, 0x46, 0x23, 0x1b, 0xbf,
+	0x9d, 0x60, 0xc6, 0xcd, 0x1f, 0x4b, 0x80, 0x5e, 0xcf, 0x32, 0xd5, 0x66, 0xf4, 0x14, 0xca, 0x6e,
+	0x88, 0x1d, 0x8e, 0xbb, 0x21, 0xa6, 0xc4, 0xc8, 0xed, 0xe6, 0x1e, 0x95, 0x3b, 0xdb, 0x16, 0xed,
+	0xb3, 0xee, 0xb4, 0x63, 0xbd, 0x90, 0x2e, 0x1b, 0x53, 0xa2, 0xe3, 0x6d, 0x70, 0x63, 0x93, 0xc0,
+	0x7a, 0x72, 0x19, 0x85, 0xcd, 0xa7, 0xb1, 0x2a, 0x83, 0x14, 0xd6, 0x8b, 0x4d, 0xe8, 0x19, 0x54,
+	0x18, 0x77, 0x42, 0xde, 0x75, 0xc9, 0x78, 0xec, 0x73, 0x63, 0x5d, 0x82, 0x5b, 0x11, 0xf8, 0x54,
+	0xf8, 0x5e, 0x48, 0x57, 0x84, 0x2e, 0xb3, 0x99, 0x0d, 0x7d, 0x01, 0xd5, 0xbe, 0x1f, 0xf8, 0x6c,
+	0x18, 0xe1, 0x6f, 0x49, 0xfc, 0xbd, 0x08, 0x7f, 0x28, 0x9d, 0x69, 0x82, 0x4a, 0x3f, 0x61, 0x44,
+	0xc7, 0xd0, 0x64, 0x6f, 0x27, 0x4e, 0xcc, 0xd0, 0x65, 0x98, 0x1b, 0x1b, 0x92, 0x65, 0x27, 0xce,
+	0x42, 0x06, 0x28, 0xc0, 0x29, 0x8e, 0x89, 0xea, 0x2c, 0x6d, 0x17, 0xd9, 0xe8, 0x22, 0xf6, 0x42,
+	0x27, 0x70, 0x87, 0x46, 0x21, 0x9d, 0x8d, 0x2a, 0xe3, 0xbe, 0xf4, 0xc5, 0xd9, 0xb8, 0x09, 0xa3,
+	0x60, 0xd0, 0xa5, 0xd4, 0x0c, 0xc5, 0x34, 0x83, 0x2a, 0x66, 0x86, 0xc1, 0x4b, 0x18, 0xd1, 0x4b,
+	0x68, 0x4c, 0xa8, 0x27, 0x72, 0xb8, 0x20, 0xbd, 0x2e, 0xe3, 0x0e, 0xc7, 0xc6, 0xa6, 0x24, 0xb9,
+	0x6f, 0x51, 0x2a, 0x49, 0xbe, 0x91, 0xfe, 0x63, 0xd2, 0x3b, 0xe5, 0xf2, 0x08, 0x15, 0x4d, 0x6d,
+	0x92, 0x32, 0xa3, 0x43, 0xa8, 0xeb, 0xcd, 0x50, 0x9f, 0xe2, 0x91, 0x1f, 0x60, 0xa3, 0x94, 0xe6,
+	0x51, 0xdb, 0x39, 0xd1, 0xde, 0x98, 0xc7, 0x4d, 0x99, 0xd1, 0x13, 0xd8, 0x64, 0x9c, 0x50, 0x91,
+	0x8e, 0x01, 0x92, 0x60, 0x2b, 0x22, 0x38, 0xe5, 0x84, 0x1e, 0x93, 0x5e, 0x84, 0x2c, 0x32, 0xf5,
+	0x8c, 0x3e, 0x85, 0x8a, 0xe3, 0x79, 0xdd, 0xbe, 0x3f, 0xc2, 0xf2, 0x38, 0xca, 0xe9, 0x8e, 0xda,
+	0xf3, 0xbc, 0x43, 0x7f, 0x84, 0x13, 0x27, 0x01, 0x4e, 0x6c, 0x12, 0x79, 0xeb, 0x12, 0xc6, 0x79,
+	0x57, 0xd2, 0x79, 0xab, 0x22, 0xce, 0xe5, 0xed, 0xa5, 0xcc, 0xe2, 0x28, 0x64, 0xde, 0x31, 0x4b,
+	0x35, 0x3a, 0x8a, 0x59, 0xf2, 0x59, 0x8e, 0x0a, 0x4b, 0x18, 0xd1, 0x0b, 0xa8, 0xa9, 0xde, 0x8e,
+	0x29, 0x6a, 0x92, 0xe2, 0x7f, 0x33, 0x0a, 0x27, 0xe4, 0x59, 0x8e, 0x2a, 0x4b, 0x5a, 0xd1, 0x01,
+	0xd4, 0xbd, 0x90, 0xd0, 0x64, 0x77, 0xd6, 0x23, 0x16, 0xdd, 0x13, 0x21, 0xa1, 0x73, 0xbd, 0x59,
+	0xf5, 0x92, 0xd6, 0x44, 0x67, 0x32, 0xec, 0x86, 0x98, 0x1b, 0x8d, 0xf4, 0x66, 0xd4, 0x51, 0x9e,
+	0x4a, 0x5f, 0xa6, 0x33, 0x95, 0x11, 0x7d, 0x0d, 0xb7, 0xc7, 0xc4, 0xf3, 0xfb, 0x97, 0xdd, 0x90,
+	0x8c, 0x70, 0xb7, 0xe7, 0x07, 0x9e, 0x1f, 0x0c, 0x8c, 0xa6, 0xe4, 0xf9, 0xbf, 0x25, 0x34, 0x49,
+	0x10, 0xbd, 0x92, 0x31, 0x36, 0x19, 0xe1, 0x7d, 0x15, 0x11, 0xb1, 0x35, 0xc7, 0x59, 0x8f, 0xf9,
+	0x0c, 0x6e, 0xa7, 0x94, 0x88, 0x51, 0x12, 0x30, 0x8c, 0x1e, 0x42, 0x41, 0x5f, 0x66, 0xa5, 0x42,
+	0xb5, 0xf8, 0xfa, 0x48, 0xab, 0xad, 0xbd, 0xe6, 0xfb, 0x50, 0x4e, 0xc0, 0xd1, 0x16, 0xe4, 0x7d,
+	0x4f, 0x42, 0x4a, 0xfb, 0x85, 0xab, 0xdf, 0x1e, 0xe4, 0x8f, 0x0e, 0xec, 0xbc, 0xef, 0x99, 0x3f,
+	0xe5, 0xa1, 0x9e, 0x88, 0x3b, 0x0a, 0xfa, 0x42, 0x75, 0xca, 0x09, 0xb5, 0xd6, 0xeb, 0xdc, 0xb3,
+	0xd2, 0x0a, 0x6e, 0x25, 0x93, 0x4b, 0xc6, 0xa3, 0xcf, 0x61, 0x33, 0x54, 0xdb, 0x62, 0x46, 0x7e,
+	0x77, 0xfd, 0x51, 0xb9, 0x63, 0x5e, 0x87, 0xd5, 0x15, 0x88, 0x31, 0x68, 0x0f, 0x4a, 0xa1, 0xde,
+	0x2d, 0x33, 0xd6, 0x25, 0xc1, 0x7b, 0xd7, 0x12, 0xa8, 0x58, 0x7b, 0x86, 0x42, 0x1f, 0x41, 0x51,
+	0xf6, 0x09, 0xf6, 0xb4, 0xe4, 0xb5, 0x2c, 0x35, 0x52, 0xac, 0x68, 0xa4, 0x58, 0xaf, 0xa3, 0x91,
+	0x62, 0x47, 0xa1, 0xc8, 0x80, 0xe2, 0x14, 0x87, 0x4c, 0xec, 0x59, 0x48, 0xdc, 0x2d, 0x3b, 0x7a,
+	0x34, 0xdf, 0x40, 0x23, 0x53, 0x24, 0x86, 0x8e, 0xa1, 0x91, 0x4c, 0xca, 0x0f, 0xfa, 0x62, 0x30,
+	0x88, 0x6c, 0x1f, 0x5c, 0x93, 0xad, 0xc0, 0xda, 0x75, 0x9e, 0x36, 0x98, 0x67, 0x70, 0x77, 0xdf,
+	0xe1, 0xee, 0x70, 0xc1, 0xe8, 0x49, 0x56, 0x33, 0xf7, 0xf7, 0xab, 0x69, 0x6e, 0xc3, 0x5d, 0x79,
+	0x91, 0xe6, 0x83, 0xcc, 0x73, 0xd8, 0x3e, 0x0a, 0x18, 0xc5, 0xee, 0x02, 0xe7, 0x3f, 0x6c, 0x02,
+	0xf3, 0x0c, 0x0c, 0x25, 0x24, 0xef, 0x9e, 0xda, 0x80, 0xad, 0xaf, 0x7c, 0xb6, 0x68, 0x43, 0x67,
+	0x60, 0xa8, 0x91, 0xf6, 0xef, 0xec, 0x27, 0xbc, 0xb4, 0x27, 0xc1, 0xbb, 0xa7, 0xfe, 0x79, 0x3d,
+	0xf5, 0xce, 0x71, 0x12, 0xe2, 0xa9, 0x8f, 0x7f, 0x40, 0x9f, 0x41, 0x51, 0x1f, 0xa2, 0x66, 0x5c,
+	0xe5, 0xdc, 0x23, 0x08, 0x7a, 0x0e, 0x9b, 0xd1, 0x75, 0xd0, 0xaf, 0x1c, 0x2b, 0xdd, 0xa1, 0x18,
+	0x84, 0x3e, 0x81, 0xba, 0x52, 0x12, 0xd6, 0x55, 0x4a, 0xe7, 0xe9, 0xbb, 0x98, 0x15, 0x9c, 0x9a,
+	0x0e, 0x53, 0x22, 0xe9, 0xa1, 0x8f, 0xa1, 0xa6, 0xa6, 0x33, 0x66, 0xdd, 0x31, 0x99, 0xca, 0x2b,
+	0x98, 0xc2, 0xe9, 0xf9, 0x5c, 0x8d, 0xa2, 0x5e, 0x89, 0x20, 0xf4, 0x0c, 0x9a, 0xd1, 0x20, 0x98,
+	0xad, 0xb8, 0x21, 0x91, 0x8d, 0x48, 0x87, 0xe3, 0x61, 0xd0, 0x88, 0x43, 0xa3, 0x55, 0x53, 0x70,
+	0x35, 0xab, 0x3d, 0xa3, 0x70, 0x23, 0x5c, 0x0d, 0x7b, 0x0f, 0x59, 0x50, 0xb9, 0x20, 0xbd, 0xd9,
+	0xc2, 0x45, 0x89, 0x2c, 0x47, 0x48, 0x31, 0x86, 0xcb, 0x22, 0x20, 0x5a, 0xee, 0x0e, 0x6c, 0xe0,
+	0x30, 0x24, 0xa1, 0x7c, 0x79, 0x28, 0xd9, 0xea, 0xc1, 0xfc, 0x23, 0x07, 0xdb, 0x0b, 0xba, 0x44,
+	0x57, 0xf4, 0x3f, 0x95, 0x55, 0xdd, 0x45, 0x09, 0x59, 0x7d, 0x0a, 0x05, 0xf5, 0x6a, 0xa7, 0xdf,
+	0x22, 0x57, 0x41, 0x6b, 0x84, 0xd8, 0xee, 0xd4, 0x19, 0xf9, 0x4a, 0x4d, 0x37, 0x6d, 0xf5, 0xd0,
+	0xf9, 0x73, 0x03, 0xd6, 0xf7, 0x4e, 0x8e, 0xd0, 0x1b, 0x68, 0x64, 0xd5, 0x0b, 0x7d, 0x90, 0x65,
+	0x5f, 0xa2, 0x6f, 0xad, 0x9b, 0xc4, 0xd2, 0x5c, 0x43, 0xe7, 0xd0, 0xc8, 0x4a, 0xd8, 0x3c, 0xff,
+	0x12, 0x91, 0x6b, 0x5d, 0x57, 0x60, 0x73, 0x0d, 0xf5, 0x00, 0xcd, 0x6b, 0x20, 0x7a, 0x9c, 0x05,
+	0x2d, 0xd5, 0xc9, 0x55, 0xf2, 0xff, 0x16, 0x9a, 0x73, 0x5a, 0x88, 0x1e, 0x65, 0x71, 0xcb, 0xe4,
+	0xb2, 0xb5, 0x35, 0x37, 0xbb, 0xbe, 0x14, 0xdf, 0x4a, 0xe6, 0x1a, 0xfa, 0x0e, 0xea, 0x19, 0x25,
+	0x44, 0x0f, 0xb3, 0xb4, 0x8b, 0xa5, 0xb2, 0xb5, 0x7b, 0x43, 0xda, 0xcc, 0x5c, 0x43, 0xdf, 0x43,
+	0x73, 0x4e, 0x4e, 0xe7, 0xf3, 0x5e, 0xa6, 0xb8, 0xab, 0x54, 0xe6, 0x02, 0x9a, 0x73, 0xf7, 0x65,
+	0x41, 0x65, 0x96, 0x08, 0x6f, 0xeb, 0xf1, 0x0a, 0x91, 0xea, 0xf2, 0x99, 0x6b, 0xe8, 0x25, 0x94,
+	0xe2, 0xcf, 0x3d, 0xb4, 0xbb, 0xb8, 0xfa, 0xb3, 0x2f, 0xc1, 0xe5, 0x55, 0xdf, 0x7f, 0xfe, 0xcb,
+	0xd5, 0x4e, 0xee, 0xd7, 0xab, 0x9d, 0xdc, 0xef, 0x57, 0x3b, 0xb9, 0xf3, 0x27, 0x03, 0x9f, 0x0f,
+	0x27, 0x3d, 0xcb, 0x25, 0xe3, 0x36, 0x75, 0xdc, 0xe1, 0xa5, 0x87, 0xc3, 0xe4, 0xbf, 0x69, 0xa7,
+	0xcd, 0x42, 0x37, 0xf9, 0x45, 0xdc, 0x2b, 0x48, 0xca, 0x0f, 0xff, 0x1a, 0x00, 0xe1, 0x7d, 0xf2,
+	0x13, 0x33, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -769,6 +1086,9 @@ type APIClient interface {
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	ListTransaction(ctx context.Context, in *ListTransactionRequest, opts ...grpc.CallOption) (*TransactionInfos, error)
 	FinishTransaction(ctx context.Context, in *FinishTransactionRequest, opts ...grpc.CallOption) (*TransactionInfo, error)
+	// DryRunTransaction runs a transaction without applying it, and reports
+	// the changes that finishing it would make
+	DryRunTransaction(ctx context.Context, in *DryRunTransactionRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error)
 	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
@@ -834,6 +1154,15 @@ func (c *aPIClient) FinishTransaction(ctx context.Context, in *FinishTransaction
 	return out, nil
 }
 
+func (c *aPIClient) DryRunTransaction(ctx context.Context, in *DryRunTransactionRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error) {
+	out := new(DryRunTransactionResponse)
+	err := c.cc.Invoke(ctx, "/transaction_v2.API/DryRunTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/transaction_v2.API/DeleteAll", in, out, opts...)
@@ -852,6 +1181,9 @@ type APIServer interface {
 	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*types.Empty, error)
 	ListTransaction(context.Context, *ListTransactionRequest) (*TransactionInfos, error)
 	FinishTransaction(context.Context, *FinishTransactionRequest) (*TransactionInfo, error)
+	// DryRunTransaction runs a transaction without applying it, and reports
+	// the changes that finishing it would make
+	DryRunTransaction(context.Context, *DryRunTransactionRequest) (*DryRunTransactionResponse, error)
 	DeleteAll(context.Context, *DeleteAllRequest) (*types.Empty, error)
 }
 
@@ -877,6 +1209,9 @@ func (*UnimplementedAPIServer) ListTransaction(ctx context.Context, req *ListTra
 func (*UnimplementedAPIServer) FinishTransaction(ctx context.Context, req *FinishTransactionRequest) (*TransactionInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinishTransaction not implemented")
 }
+func (*UnimplementedAPIServer) DryRunTransaction(ctx context.Context, req *DryRunTransactionRequest) (*DryRunTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunTransaction not implemented")
+}
 func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
 }
@@ -993,6 +1328,24 @@ func _API_FinishTransaction_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_DryRunTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DryRunTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/transaction_v2.API/DryRunTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DryRunTransaction(ctx, req.(*DryRunTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAllRequest)
 	if err := dec(in); err != nil {
@@ -1039,6 +1392,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinishTransaction",
 			Handler:    _API_FinishTransaction_Handler,
 		},
+		{
+			MethodName: "DryRunTransaction",
+			Handler:    _API_DryRunTransaction_Handler,
+		},
 		{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
@@ -1099,9 +1456,9 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StopJob != nil {
+	if m.ModifyRoleBinding != nil {
 		{
-			size, err := m.StopJob.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ModifyRoleBinding.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1109,11 +1466,13 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.CreatePipeline != nil {
+	if m.CreateSecret != nil {
 		{
-			size, err := m.CreatePipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreateSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1121,11 +1480,13 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.UpdateJobState != nil {
+	if m.DropCommitSet != nil {
 		{
-			size, err := m.UpdateJobState.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DropCommitSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1133,11 +1494,11 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x7a
 	}
-	if m.DeleteBranch != nil {
+	if m.StartPipeline != nil {
 		{
-			size, err := m.DeleteBranch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StartPipeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1145,11 +1506,11 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x72
 	}
-	if m.CreateBranch != nil {
+	if m.StopPipeline != nil {
 		{
-			size, err := m.CreateBranch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StopPipeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1157,11 +1518,11 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x6a
 	}
-	if m.SquashCommitSet != nil {
+	if m.DeletePipeline != nil {
 		{
-			size, err := m.SquashCommitSet.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DeletePipeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1169,11 +1530,11 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x62
 	}
-	if m.FinishCommit != nil {
+	if m.AddFileSet != nil {
 		{
-			size, err := m.FinishCommit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AddFileSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1181,11 +1542,11 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x5a
 	}
-	if m.StartCommit != nil {
+	if m.StopJob != nil {
 		{
-			size, err := m.StartCommit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StopJob.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1193,11 +1554,11 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x52
 	}
-	if m.DeleteRepo != nil {
+	if m.CreatePipeline != nil {
 		{
-			size, err := m.DeleteRepo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatePipeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1205,11 +1566,11 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if m.CreateRepo != nil {
+	if m.UpdateJobState != nil {
 		{
-			size, err := m.CreateRepo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UpdateJobState.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1217,26 +1578,110 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTransaction(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x42
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
+	if m.DeleteBranch != nil {
+		{
+			size, err := m.DeleteBranch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreateBranch != nil {
+		{
+			size, err := m.CreateBranch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SquashCommitSet != nil {
+		{
+			size, err := m.SquashCommitSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FinishCommit != nil {
+		{
+			size, err := m.FinishCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartCommit != nil {
+		{
+			size, err := m.StartCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DeleteRepo != nil {
+		{
+			size, err := m.DeleteRepo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CreateRepo != nil {
+		{
+			size, err := m.CreateRepo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
 func (m *TransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
@@ -1632,159 +2077,344 @@ func (m *FinishTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransaction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DryRunTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DeleteAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *DryRunTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DryRunTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreateRepo != nil {
-		l = m.CreateRepo.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.DeleteRepo != nil {
-		l = m.DeleteRepo.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.StartCommit != nil {
-		l = m.StartCommit.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.FinishCommit != nil {
-		l = m.FinishCommit.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.SquashCommitSet != nil {
-		l = m.SquashCommitSet.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.CreateBranch != nil {
-		l = m.CreateBranch.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.DeleteBranch != nil {
-		l = m.DeleteBranch.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.UpdateJobState != nil {
-		l = m.UpdateJobState.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.CreatePipeline != nil {
-		l = m.CreatePipeline.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.StopJob != nil {
-		l = m.StopJob.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Transaction != nil {
+		{
+			size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TransactionPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Transaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *TransactionPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransactionInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransactionPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Transaction != nil {
-		l = m.Transaction.Size()
-		n += 1 + l + sovTransaction(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovTransaction(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.JobsCreated) > 0 {
+		for iNdEx := len(m.JobsCreated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobsCreated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovTransaction(uint64(l))
+	if len(m.PipelinesUpdated) > 0 {
+		for iNdEx := len(m.PipelinesUpdated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PipelinesUpdated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovTransaction(uint64(l))
+	if len(m.PipelinesCreated) > 0 {
+		for iNdEx := len(m.PipelinesCreated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PipelinesCreated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Version != 0 {
-		n += 1 + sovTransaction(uint64(m.Version))
+	if len(m.BranchesMoved) > 0 {
+		for iNdEx := len(m.BranchesMoved) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BranchesMoved[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommitsCreated) > 0 {
+		for iNdEx := len(m.CommitsCreated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitsCreated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Finish != nil {
+		{
+			size, err := m.Finish.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Transaction != nil {
+		{
+			size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransaction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeleteAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TransactionInfos) Size() (n int) {
+func (m *TransactionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TransactionInfo) > 0 {
-		for _, e := range m.TransactionInfo {
-			l = e.Size()
-			n += 1 + l + sovTransaction(uint64(l))
-		}
+	if m.CreateRepo != nil {
+		l = m.CreateRepo.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeleteRepo != nil {
+		l = m.DeleteRepo.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StartCommit != nil {
+		l = m.StartCommit.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.FinishCommit != nil {
+		l = m.FinishCommit.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.SquashCommitSet != nil {
+		l = m.SquashCommitSet.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.CreateBranch != nil {
+		l = m.CreateBranch.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeleteBranch != nil {
+		l = m.DeleteBranch.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.UpdateJobState != nil {
+		l = m.UpdateJobState.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.CreatePipeline != nil {
+		l = m.CreatePipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StopJob != nil {
+		l = m.StopJob.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.AddFileSet != nil {
+		l = m.AddFileSet.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeletePipeline != nil {
+		l = m.DeletePipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StopPipeline != nil {
+		l = m.StopPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StartPipeline != nil {
+		l = m.StartPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DropCommitSet != nil {
+		l = m.DropCommitSet.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.CreateSecret != nil {
+		l = m.CreateSecret.Size()
+		n += 2 + l + sovTransaction(uint64(l))
+	}
+	if m.ModifyRoleBinding != nil {
+		l = m.ModifyRoleBinding.Size()
+		n += 2 + l + sovTransaction(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1792,17 +2422,15 @@ func (m *TransactionInfos) Size() (n int) {
 	return n
 }
 
-func (m *BatchTransactionRequest) Size() (n int) {
+func (m *TransactionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovTransaction(uint64(l))
-		}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTransaction(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1810,19 +2438,23 @@ func (m *BatchTransactionRequest) Size() (n int) {
 	return n
 }
 
-func (m *StartTransactionRequest) Size() (n int) {
+func (m *Transaction) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *InspectTransactionRequest) Size() (n int) {
+func (m *TransactionInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1832,63 +2464,1046 @@ func (m *InspectTransactionRequest) Size() (n int) {
 		l = m.Transaction.Size()
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTransaction(uint64(m.Version))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DeleteTransactionRequest) Size() (n int) {
+func (m *TransactionInfos) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Transaction != nil {
-		l = m.Transaction.Size()
-		n += 1 + l + sovTransaction(uint64(l))
+	if len(m.TransactionInfo) > 0 {
+		for _, e := range m.TransactionInfo {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *BatchTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinishTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DryRunTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransactionPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.CommitsCreated) > 0 {
+		for _, e := range m.CommitsCreated {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.BranchesMoved) > 0 {
+		for _, e := range m.BranchesMoved {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.PipelinesCreated) > 0 {
+		for _, e := range m.PipelinesCreated {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.PipelinesUpdated) > 0 {
+		for _, e := range m.PipelinesUpdated {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.JobsCreated) > 0 {
+		for _, e := range m.JobsCreated {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DryRunTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.Finish != nil {
+		l = m.Finish.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTransaction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransaction(x uint64) (n int) {
+	return sovTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeleteAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateRepo == nil {
+				m.CreateRepo = &pfs.CreateRepoRequest{}
+			}
+			if err := m.CreateRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRepo == nil {
+				m.DeleteRepo = &pfs.DeleteRepoRequest{}
+			}
+			if err := m.DeleteRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartCommit == nil {
+				m.StartCommit = &pfs.StartCommitRequest{}
+			}
+			if err := m.StartCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishCommit == nil {
+				m.FinishCommit = &pfs.FinishCommitRequest{}
+			}
+			if err := m.FinishCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquashCommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SquashCommitSet == nil {
+				m.SquashCommitSet = &pfs.SquashCommitSetRequest{}
+			}
+			if err := m.SquashCommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBranch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateBranch == nil {
+				m.CreateBranch = &pfs.CreateBranchRequest{}
+			}
+			if err := m.CreateBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteBranch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteBranch == nil {
+				m.DeleteBranch = &pfs.DeleteBranchRequest{}
+			}
+			if err := m.DeleteBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateJobState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateJobState == nil {
+				m.UpdateJobState = &pps.UpdateJobStateRequest{}
+			}
+			if err := m.UpdateJobState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatePipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatePipeline == nil {
+				m.CreatePipeline = &pps.CreatePipelineRequest{}
+			}
+			if err := m.CreatePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopJob == nil {
+				m.StopJob = &pps.StopJobRequest{}
+			}
+			if err := m.StopJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddFileSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddFileSet == nil {
+				m.AddFileSet = &pfs.AddFileSetRequest{}
+			}
+			if err := m.AddFileSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePipeline == nil {
+				m.DeletePipeline = &pps.DeletePipelineRequest{}
+			}
+			if err := m.DeletePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopPipeline == nil {
+				m.StopPipeline = &pps.StopPipelineRequest{}
+			}
+			if err := m.StopPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartPipeline == nil {
+				m.StartPipeline = &pps.StartPipelineRequest{}
+			}
+			if err := m.StartPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropCommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DropCommitSet == nil {
+				m.DropCommitSet = &pfs.DropCommitSetRequest{}
+			}
+			if err := m.DropCommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateSecret == nil {
+				m.CreateSecret = &pps.CreateSecretRequest{}
+			}
+			if err := m.CreateSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyRoleBinding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifyRoleBinding == nil {
+				m.ModifyRoleBinding = &auth.ModifyRoleBindingRequest{}
+			}
+			if err := m.ModifyRoleBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *ListTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *FinishTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Transaction != nil {
-		l = m.Transaction.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTransaction(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransaction(x uint64) (n int) {
-	return sovTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DeleteAllRequest) Unmarshal(dAtA []byte) error {
+func (m *Transaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1911,12 +3526,44 @@ func (m *DeleteAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -1939,7 +3586,7 @@ func (m *DeleteAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1962,15 +3609,15 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1997,16 +3644,16 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateRepo == nil {
-				m.CreateRepo = &pfs.CreateRepoRequest{}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			if err := m.CreateRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2033,16 +3680,14 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRepo == nil {
-				m.DeleteRepo = &pfs.DeleteRepoRequest{}
-			}
-			if err := m.DeleteRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &TransactionRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2069,16 +3714,14 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartCommit == nil {
-				m.StartCommit = &pfs.StartCommitRequest{}
-			}
-			if err := m.StartCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Responses = append(m.Responses, &TransactionResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2105,18 +3748,18 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishCommit == nil {
-				m.FinishCommit = &pfs.FinishCommitRequest{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.FinishCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SquashCommitSet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2126,31 +3769,65 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTransaction
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SquashCommitSet == nil {
-				m.SquashCommitSet = &pfs.SquashCommitSetRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
 			}
-			if err := m.SquashCommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2177,16 +3854,65 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateBranch == nil {
-				m.CreateBranch = &pfs.CreateBranchRequest{}
-			}
-			if err := m.CreateBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransactionInfo = append(m.TransactionInfo, &TransactionInfo{})
+			if err := m.TransactionInfo[len(m.TransactionInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2213,52 +3939,116 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteBranch == nil {
-				m.DeleteBranch = &pfs.DeleteBranchRequest{}
-			}
-			if err := m.DeleteBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &TransactionRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateJobState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTransaction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTransaction
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateJobState == nil {
-				m.UpdateJobState = &pps.UpdateJobStateRequest{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.UpdateJobState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatePipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2285,16 +4075,67 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatePipeline == nil {
-				m.CreatePipeline = &pps.CreatePipelineRequest{}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			if err := m.CreatePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2321,10 +4162,10 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StopJob == nil {
-				m.StopJob = &pps.StopJobRequest{}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			if err := m.StopJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2350,7 +4191,7 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2373,15 +4214,66 @@ func (m *TransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinishTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinishTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinishTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2408,10 +4300,10 @@ func (m *TransactionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2437,7 +4329,7 @@ func (m *TransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transaction) Unmarshal(dAtA []byte) error {
+func (m *DryRunTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2460,17 +4352,17 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRunTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRunTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2480,23 +4372,27 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTransaction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2520,7 +4416,7 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
+func (m *TransactionPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2543,15 +4439,15 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2578,16 +4474,16 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transaction == nil {
-				m.Transaction = &Transaction{}
+			if m.Request == nil {
+				m.Request = &TransactionRequest{}
 			}
-			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2614,14 +4510,16 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &TransactionRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Response == nil {
+				m.Response = &TransactionResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitsCreated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2648,14 +4546,14 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, &TransactionResponse{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommitsCreated = append(m.CommitsCreated, &pfs.Commit{})
+			if err := m.CommitsCreated[len(m.CommitsCreated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchesMoved", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2682,18 +4580,16 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BranchesMoved = append(m.BranchesMoved, &pfs.Branch{})
+			if err := m.BranchesMoved[len(m.BranchesMoved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelinesCreated", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2703,65 +4599,29 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransactionInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PipelinesCreated = append(m.PipelinesCreated, &pps.Pipeline{})
+			if err := m.PipelinesCreated[len(m.PipelinesCreated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelinesUpdated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2788,67 +4648,50 @@ func (m *TransactionInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionInfo = append(m.TransactionInfo, &TransactionInfo{})
-			if err := m.TransactionInfo[len(m.TransactionInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PipelinesUpdated = append(m.PipelinesUpdated, &pps.Pipeline{})
+			if err := m.PipelinesUpdated[len(m.PipelinesUpdated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsCreated", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTransaction
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchTransactionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTransactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.JobsCreated = append(m.JobsCreated, &pps.Job{})
+			if err := m.JobsCreated[len(m.JobsCreated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2858,25 +4701,23 @@ func (m *BatchTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTransaction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &TransactionRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2900,58 +4741,7 @@ func (m *BatchTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartTransactionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartTransactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *DryRunTransactionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2974,10 +4764,10 @@ func (m *InspectTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRunTransactionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRunTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3016,60 +4806,9 @@ func (m *InspectTransactionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteTransactionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTransactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3096,118 +4835,14 @@ func (m *DeleteTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transaction == nil {
-				m.Transaction = &Transaction{}
-			}
-			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &TransactionPreview{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTransactionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTransactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FinishTransactionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FinishTransactionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3234,13 +4869,33 @@ func (m *FinishTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transaction == nil {
-				m.Transaction = &Transaction{}
+			if m.Finish == nil {
+				m.Finish = &TransactionPreview{}
 			}
-			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Finish.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])